@@ -0,0 +1,201 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// AutoRenewerConfig contains all of the required dependencies for the
+// AutoRenewer to carry out its duties.
+type AutoRenewerConfig struct {
+	// Manager is used to quote and execute the account renewals.
+	Manager Manager
+
+	// Store is used to look up the accounts that need to be renewed.
+	Store Store
+
+	// ChainNotifier is used to receive block notifications to find out
+	// when accounts are getting close to their expiry.
+	ChainNotifier lndclient.ChainNotifierClient
+
+	// Threshold is the number of blocks before its expiry an open account
+	// is renewed.
+	Threshold uint32
+
+	// RelativeExpiry is the number of blocks from the current height an
+	// account is renewed for.
+	RelativeExpiry uint32
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of a renewal transaction.
+	ConfTarget uint32
+
+	// MaxFeeRate is the highest fee rate a renewal transaction is allowed
+	// to pay. If the estimated fee rate is higher, the renewal is retried
+	// on the next block.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// MinBackoff is the minimum time that is waited before registering
+	// for block notifications again after the subscription failed, for
+	// example because lnd was restarted.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time that is waited between the attempts
+	// to register for block notifications again.
+	MaxBackoff time.Duration
+}
+
+// AutoRenewer renews open accounts once they get within a configured number
+// of blocks of their expiry.
+type AutoRenewer struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *AutoRenewerConfig
+
+	wg     sync.WaitGroup
+	quit   chan struct{}
+	cancel func()
+}
+
+// NewAutoRenewer creates a new account auto renewer with the given config.
+func NewAutoRenewer(cfg *AutoRenewerConfig) *AutoRenewer {
+	return &AutoRenewer{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start registers for block notifications and starts renewing accounts that
+// are close to their expiry.
+func (r *AutoRenewer) Start() error {
+	var err error
+	r.started.Do(func() {
+		err = r.start()
+	})
+	return err
+}
+
+// start registers for block notifications and starts renewing accounts that
+// are close to their expiry.
+func (r *AutoRenewer) start() error {
+	ctxc, cancel := context.WithCancel(context.Background())
+	sub := newBlockSubscription(
+		ctxc, r.cfg.ChainNotifier, r.cfg.MinBackoff, r.cfg.MaxBackoff,
+	)
+	if err := sub.register(); err != nil {
+		cancel()
+		return err
+	}
+	r.cancel = cancel
+
+	log.Infof("Auto renewing accounts %d blocks before their expiry",
+		r.cfg.Threshold)
+
+	r.wg.Add(1)
+	go r.blockHandler(ctxc, sub)
+
+	return nil
+}
+
+// Stop stops renewing accounts and waits for any ongoing renewal to finish.
+func (r *AutoRenewer) Stop() {
+	r.stopped.Do(func() {
+		close(r.quit)
+		r.cancel()
+		r.wg.Wait()
+	})
+}
+
+// blockHandler checks all accounts for upcoming expiries on every new block.
+// If the block subscription fails, we register for block notifications again.
+//
+// NOTE: This must be run as a goroutine.
+func (r *AutoRenewer) blockHandler(ctx context.Context,
+	sub *blockSubscription) {
+
+	defer r.wg.Done()
+
+	for {
+		select {
+		case height := <-sub.blockChan:
+			r.renewAccounts(ctx, uint32(height))
+
+		case err := <-sub.errChan:
+			log.Errorf("Unable to receive block notification: %v",
+				err)
+
+			if !sub.reregister(r.quit) {
+				return
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// renewAccounts renews all open accounts that expire within the configured
+// threshold from the given height. A failed renewal is only logged as it is
+// retried on the next block anyway.
+func (r *AutoRenewer) renewAccounts(ctx context.Context, height uint32) {
+	accounts, err := r.cfg.Store.Accounts()
+	if err != nil {
+		log.Errorf("Unable to fetch accounts for auto renewal: %v", err)
+		return
+	}
+
+	for _, account := range accounts {
+		if account.State != StateOpen {
+			continue
+		}
+		if account.Expiry > height+r.cfg.Threshold {
+			continue
+		}
+
+		if err := r.renewAccount(ctx, account, height); err != nil {
+			log.Errorf("Unable to auto renew account %x: %v",
+				account.TraderKey.PubKey.SerializeCompressed(),
+				err)
+		}
+	}
+}
+
+// renewAccount renews the given account if the estimated fee rate doesn't
+// exceed the configured maximum.
+func (r *AutoRenewer) renewAccount(ctx context.Context, account *Account,
+	height uint32) error {
+
+	traderKey := account.TraderKey.PubKey
+	newExpiry := height + r.cfg.RelativeExpiry
+
+	feeRate, _, err := r.cfg.Manager.QuoteAccountRenewal(
+		ctx, traderKey, newExpiry, r.cfg.ConfTarget, height,
+		account.Version,
+	)
+	if err != nil {
+		return err
+	}
+
+	if feeRate > r.cfg.MaxFeeRate {
+		log.Warnf("Not auto renewing account %x yet, estimated fee "+
+			"rate %v exceeds maximum of %v",
+			traderKey.SerializeCompressed(), feeRate,
+			r.cfg.MaxFeeRate)
+
+		return nil
+	}
+
+	log.Infof("Auto renewing account %x expiring at height %d to height "+
+		"%d", traderKey.SerializeCompressed(), account.Expiry,
+		newExpiry)
+
+	_, _, err = r.cfg.Manager.RenewAccount(
+		ctx, traderKey, newExpiry, feeRate, height, account.Version,
+	)
+	return err
+}
@@ -0,0 +1,185 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRenewThreshold = 144
+	testRenewExpiry    = 1000
+	testRenewHeight    = 500
+	testRenewMaxFee    = chainfee.SatPerKWeight(5000)
+)
+
+var autoRenewTestCases = []struct {
+	name        string
+	state       State
+	expiry      uint32
+	feeRate     chainfee.SatPerKWeight
+	expectQuote bool
+	expectRenew bool
+}{{
+	name:        "open account within threshold",
+	state:       StateOpen,
+	expiry:      testRenewHeight + testRenewThreshold,
+	feeRate:     chainfee.FeePerKwFloor,
+	expectQuote: true,
+	expectRenew: true,
+}, {
+	name:   "open account outside of threshold",
+	state:  StateOpen,
+	expiry: testRenewHeight + testRenewThreshold + 1,
+}, {
+	name:        "fee rate above maximum",
+	state:       StateOpen,
+	expiry:      testRenewHeight + 1,
+	feeRate:     testRenewMaxFee + 1,
+	expectQuote: true,
+}, {
+	name:   "account not open",
+	state:  StatePendingUpdate,
+	expiry: testRenewHeight + 1,
+}}
+
+// TestAutoRenewAccounts makes sure only open accounts within the renewal
+// threshold of their expiry are renewed and only if the estimated fee rate
+// doesn't exceed the maximum.
+func TestAutoRenewAccounts(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range autoRenewTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			store := newMockStore()
+			account := &Account{
+				Value:     maxAccountValue,
+				Expiry:    tc.expiry,
+				TraderKey: testTraderKeyDesc,
+				State:     tc.state,
+				Version:   VersionTaprootEnabled,
+			}
+			require.NoError(t, store.AddAccount(account))
+
+			newExpiry := uint32(testRenewHeight + testRenewExpiry)
+			manager := NewMockManager(mockCtrl)
+			if tc.expectQuote {
+				manager.EXPECT().QuoteAccountRenewal(
+					gomock.Any(), testTraderKey, newExpiry,
+					uint32(6), uint32(testRenewHeight),
+					VersionTaprootEnabled,
+				).Return(tc.feeRate, btcutil.Amount(0), nil)
+			}
+			if tc.expectRenew {
+				manager.EXPECT().RenewAccount(
+					gomock.Any(), testTraderKey, newExpiry,
+					tc.feeRate, uint32(testRenewHeight),
+					VersionTaprootEnabled,
+				).Return(account, &wire.MsgTx{}, nil)
+			}
+
+			renewer := NewAutoRenewer(&AutoRenewerConfig{
+				Manager:        manager,
+				Store:          store,
+				Threshold:      testRenewThreshold,
+				RelativeExpiry: testRenewExpiry,
+				ConfTarget:     6,
+				MaxFeeRate:     testRenewMaxFee,
+			})
+			renewer.renewAccounts(context.Background(), testRenewHeight)
+		})
+	}
+}
+
+// TestAutoRenewerBlockNotification makes sure a renewal is triggered once a
+// block notification brings an account within the renewal threshold, also
+// after the block subscription failed because lnd was restarted.
+func TestAutoRenewerBlockNotification(t *testing.T) {
+	t.Parallel()
+
+	for _, lndRestart := range []bool{false, true} {
+		lndRestart := lndRestart
+
+		t.Run(fmt.Sprintf("lnd restart=%v", lndRestart), func(t *testing.T) {
+			t.Parallel()
+
+			testAutoRenewerBlockNotification(t, lndRestart)
+		})
+	}
+}
+
+func testAutoRenewerBlockNotification(t *testing.T, lndRestart bool) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	store := newMockStore()
+	account := &Account{
+		Value:     maxAccountValue,
+		Expiry:    testRenewHeight + testRenewThreshold,
+		TraderKey: testTraderKeyDesc,
+		State:     StateOpen,
+		Version:   VersionTaprootEnabled,
+	}
+	require.NoError(t, store.AddAccount(account))
+
+	renewed := make(chan struct{})
+	manager := NewMockManager(mockCtrl)
+	manager.EXPECT().QuoteAccountRenewal(
+		gomock.Any(), testTraderKey, gomock.Any(), gomock.Any(),
+		uint32(testRenewHeight), VersionTaprootEnabled,
+	).Return(chainfee.FeePerKwFloor, btcutil.Amount(0), nil)
+	manager.EXPECT().RenewAccount(
+		gomock.Any(), testTraderKey, gomock.Any(),
+		chainfee.FeePerKwFloor, uint32(testRenewHeight),
+		VersionTaprootEnabled,
+	).DoAndReturn(func(context.Context, *btcec.PublicKey, uint32,
+		chainfee.SatPerKWeight, uint32, Version) (*Account,
+		*wire.MsgTx, error) {
+
+		close(renewed)
+		return account, &wire.MsgTx{}, nil
+	})
+
+	notifier := newMockChainNotifier()
+	renewer := NewAutoRenewer(&AutoRenewerConfig{
+		Manager:        manager,
+		Store:          store,
+		ChainNotifier:  notifier,
+		Threshold:      testRenewThreshold,
+		RelativeExpiry: testRenewExpiry,
+		ConfTarget:     6,
+		MaxFeeRate:     testRenewMaxFee,
+		MinBackoff:     time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	})
+	require.NoError(t, renewer.Start())
+	defer renewer.Stop()
+
+	// The initial block notification at height 0 is too far away from the
+	// account's expiry, so only the next one should trigger the renewal.
+	if lndRestart {
+		notifier.failBlockEpochNtfn(t)
+	}
+	notifier.blockChan <- testRenewHeight
+
+	select {
+	case <-renewed:
+	case <-time.After(timeout):
+		t.Fatal("account not renewed")
+	}
+}
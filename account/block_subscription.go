@@ -0,0 +1,103 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/internal/retry"
+)
+
+const (
+	// defaultBlockNtfnMinBackoff is the minimum time that is waited before
+	// registering for block notifications again if no back off is
+	// configured.
+	defaultBlockNtfnMinBackoff = 5 * time.Second
+
+	// defaultBlockNtfnMaxBackoff is the maximum time that is waited
+	// between the attempts to register for block notifications again if
+	// no back off is configured.
+	defaultBlockNtfnMaxBackoff = time.Minute
+)
+
+// blockSubscription is a block notification registration with lnd that can be
+// renewed after its stream failed, for example because lnd was restarted.
+type blockSubscription struct {
+	notifier   lndclient.ChainNotifierClient
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// ctx is the context of the component that owns the subscription. All
+	// registrations are removed once it is canceled.
+	ctx context.Context
+
+	// cancel removes the current registration.
+	cancel func()
+
+	blockChan chan int32
+	errChan   chan error
+}
+
+// newBlockSubscription creates a new block subscription that is registered
+// with lnd through the given notifier.
+func newBlockSubscription(ctx context.Context,
+	notifier lndclient.ChainNotifierClient, minBackoff,
+	maxBackoff time.Duration) *blockSubscription {
+
+	if minBackoff == 0 {
+		minBackoff = defaultBlockNtfnMinBackoff
+	}
+	if maxBackoff == 0 {
+		maxBackoff = defaultBlockNtfnMaxBackoff
+	}
+
+	return &blockSubscription{
+		notifier:   notifier,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		ctx:        ctx,
+	}
+}
+
+// register registers for block notifications with lnd.
+func (s *blockSubscription) register() error {
+	ctxc, cancel := context.WithCancel(s.ctx)
+	blockChan, errChan, err := s.notifier.RegisterBlockEpochNtfn(ctxc)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	s.cancel = cancel
+	s.blockChan = blockChan
+	s.errChan = errChan
+
+	return nil
+}
+
+// reregister removes the current, failed registration and registers for block
+// notifications again, backing off between the attempts until it succeeds.
+// False is returned if the quit channel is closed in the meantime.
+func (s *blockSubscription) reregister(quit <-chan struct{}) bool {
+	s.cancel()
+
+	var backoff time.Duration
+	for {
+		backoff = retry.NextBackoff(backoff, s.minBackoff, s.maxBackoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-quit:
+			return false
+		}
+
+		err := s.register()
+		if err == nil {
+			log.Infof("Registered for block notifications again")
+			return true
+		}
+
+		log.Warnf("Unable to register for block notifications again, "+
+			"retrying: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// CoinSelectionDefault is the coin selection strategy that leaves the
+	// selection of the inputs to lnd, which then uses its own configured
+	// strategy.
+	CoinSelectionDefault = ""
+
+	// CoinSelectionLargest is the coin selection strategy that always
+	// spends the largest wallet outputs first.
+	CoinSelectionLargest = "largest"
+
+	// CoinSelectionRandom is the coin selection strategy that spends the
+	// wallet outputs in a random order.
+	CoinSelectionRandom = "random"
+)
+
+// ValidateCoinSelectionStrategy makes sure the given coin selection strategy
+// is one of the supported values.
+func ValidateCoinSelectionStrategy(strategy string) error {
+	switch strategy {
+	case CoinSelectionDefault, CoinSelectionLargest, CoinSelectionRandom:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown coin selection strategy %q, must be "+
+			"one of [%s, %s]", strategy, CoinSelectionLargest,
+			CoinSelectionRandom)
+	}
+}
+
+// selectCoins selects the wallet outputs that are used to fund the given
+// outputs with the configured coin selection strategy, paying the given fee
+// rate for a transaction that also has an input of the given weight that isn't
+// selected from the wallet. Nil is returned if coin selection is left to lnd.
+//
+// NOTE: lnd's FundPsbt call doesn't expose a coin selection strategy, so we
+// select the coins ourselves and pass them as the inputs of the template. lnd
+// then only adds the change output and leases the inputs.
+func (m *manager) selectCoins(ctx context.Context, outputs []*wire.TxOut,
+	extraInputWeight int64, feeRate chainfee.SatPerKWeight) ([]*wire.OutPoint,
+	error) {
+
+	if m.cfg.CoinSelectionStrategy == CoinSelectionDefault {
+		return nil, nil
+	}
+
+	utxos, err := m.cfg.Wallet.ListUnspent(ctx, 1, math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list wallet outputs: %v", err)
+	}
+
+	switch m.cfg.CoinSelectionStrategy {
+	case CoinSelectionLargest:
+		sort.SliceStable(utxos, func(i, j int) bool {
+			return utxos[i].Value > utxos[j].Value
+		})
+
+	case CoinSelectionRandom:
+		if err := shuffleUtxos(utxos); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, ValidateCoinSelectionStrategy(
+			m.cfg.CoinSelectionStrategy,
+		)
+	}
+
+	// We always account for a change output, overpaying slightly in the
+	// rare case that lnd doesn't need to add one.
+	var (
+		weightEstimator input.TxWeightEstimator
+		outputSum       btcutil.Amount
+	)
+	for _, txOut := range outputs {
+		weightEstimator.AddTxOutput(txOut)
+		outputSum += btcutil.Amount(txOut.Value)
+	}
+	weightEstimator.AddP2TROutput()
+
+	var (
+		selected []*wire.OutPoint
+		inputSum btcutil.Amount
+	)
+	for _, utxo := range utxos {
+		if !addUtxoWeight(&weightEstimator, utxo) {
+			continue
+		}
+
+		op := utxo.OutPoint
+		selected = append(selected, &op)
+		inputSum += utxo.Value
+
+		weight := int64(weightEstimator.Weight()) + extraInputWeight
+		if inputSum >= outputSum+feeRate.FeeForWeight(weight) {
+			return selected, nil
+		}
+	}
+
+	return nil, fmt.Errorf("insufficient wallet balance to fund %v with "+
+		"coin selection strategy %s", outputSum,
+		m.cfg.CoinSelectionStrategy)
+}
+
+// addUtxoWeight adds the weight of spending the given wallet output to the
+// estimator. False is returned if the output is of a type we can't estimate
+// and therefore don't select.
+func addUtxoWeight(weightEstimator *input.TxWeightEstimator,
+	utxo *lnwallet.Utxo) bool {
+
+	switch utxo.AddressType {
+	case lnwallet.WitnessPubKey:
+		weightEstimator.AddP2WKHInput()
+
+	case lnwallet.NestedWitnessPubKey:
+		weightEstimator.AddNestedP2WKHInput()
+
+	case lnwallet.TaprootPubkey:
+		weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// shuffleUtxos shuffles the given wallet outputs in place, using a
+// cryptographically secure source of randomness.
+func shuffleUtxos(utxos []*lnwallet.Utxo) error {
+	for i := len(utxos) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return fmt.Errorf("unable to shuffle wallet outputs: %v",
+				err)
+		}
+
+		utxos[i], utxos[j.Int64()] = utxos[j.Int64()], utxos[i]
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+var coinSelectionTestCases = []struct {
+	name           string
+	strategy       string
+	amount         btcutil.Amount
+	expectedInputs []wire.OutPoint
+	expectedErr    string
+}{{
+	name:     "left to lnd",
+	strategy: CoinSelectionDefault,
+	amount:   100_000,
+}, {
+	name:     "largest first",
+	strategy: CoinSelectionLargest,
+	amount:   100_000,
+	expectedInputs: []wire.OutPoint{
+		{Index: 2},
+	},
+}, {
+	name:     "largest first, multiple inputs",
+	strategy: CoinSelectionLargest,
+	amount:   350_000,
+	expectedInputs: []wire.OutPoint{
+		{Index: 2}, {Index: 3},
+	},
+}, {
+	name:        "insufficient balance",
+	strategy:    CoinSelectionLargest,
+	amount:      600_000,
+	expectedErr: "insufficient wallet balance",
+}, {
+	name:     "random",
+	strategy: CoinSelectionRandom,
+	amount:   550_000,
+	expectedInputs: []wire.OutPoint{
+		{Index: 1}, {Index: 2}, {Index: 3},
+	},
+}, {
+	name:        "unknown strategy",
+	strategy:    "smallest",
+	amount:      100_000,
+	expectedErr: "unknown coin selection strategy",
+}}
+
+// TestFundAccountOutputCoinSelection makes sure the wallet outputs selected
+// with the configured coin selection strategy are forwarded to lnd's FundPsbt
+// call.
+func TestFundAccountOutputCoinSelection(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range coinSelectionTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			wallet := newMockWallet()
+			wallet.utxos = []*lnwallet.Utxo{{
+				AddressType: lnwallet.WitnessPubKey,
+				Value:       100_000,
+				PkScript:    p2wpkh,
+				OutPoint:    wire.OutPoint{Index: 1},
+			}, {
+				AddressType: lnwallet.WitnessPubKey,
+				Value:       300_000,
+				PkScript:    p2wpkh,
+				OutPoint:    wire.OutPoint{Index: 2},
+			}, {
+				AddressType: lnwallet.TaprootPubkey,
+				Value:       200_000,
+				PkScript:    p2wpkh,
+				OutPoint:    wire.OutPoint{Index: 3},
+			}}
+
+			m := &manager{
+				cfg: ManagerConfig{
+					Wallet:                wallet,
+					CoinSelectionStrategy: tc.strategy,
+				},
+			}
+
+			accountOutput := &wire.TxOut{
+				Value:    int64(tc.amount),
+				PkScript: p2wpkh,
+			}
+			_, _, err := m.fundAccountOutput(
+				context.Background(), accountOutput,
+				chainfee.FeePerKwFloor,
+			)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, wallet.fundPsbtReqs)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, wallet.fundPsbtReqs, 1)
+			template, err := psbt.NewFromRawBytes(
+				bytes.NewReader(wallet.fundPsbtReqs[0].GetPsbt()),
+				false,
+			)
+			require.NoError(t, err)
+
+			var inputs []wire.OutPoint
+			for _, txIn := range template.UnsignedTx.TxIn {
+				inputs = append(inputs, txIn.PreviousOutPoint)
+			}
+			require.ElementsMatch(t, tc.expectedInputs, inputs)
+		})
+	}
+}
+
+// TestSendAccountOutputReleasesInputs makes sure the wallet inputs leased by
+// FundPsbt are released again if the funding transaction can't be published.
+func TestSendAccountOutputReleasesInputs(t *testing.T) {
+	t.Parallel()
+
+	wallet := newMockWallet()
+	wallet.utxos = []*lnwallet.Utxo{{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       300_000,
+		PkScript:    p2wpkh,
+		OutPoint:    wire.OutPoint{Index: 1},
+	}}
+	wallet.publishErr = errors.New("rejected")
+
+	m := &manager{
+		cfg: ManagerConfig{
+			Wallet:                wallet,
+			CoinSelectionStrategy: CoinSelectionLargest,
+		},
+	}
+
+	accountOutput := &wire.TxOut{
+		Value:    100_000,
+		PkScript: p2wpkh,
+	}
+	_, err := m.sendAccountOutput(
+		context.Background(), accountOutput, chainfee.FeePerKwFloor,
+		"",
+	)
+	require.ErrorContains(t, err, "rejected")
+	require.Equal(t, []wire.OutPoint{{Index: 1}}, wallet.releasedOutputs)
+}
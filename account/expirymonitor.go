@@ -0,0 +1,291 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+)
+
+const (
+	// expiryWarningBuffer is the number of warnings that are buffered for
+	// each subscriber. Warnings for subscribers that don't keep up are
+	// dropped.
+	expiryWarningBuffer = 20
+)
+
+// ExpiryMonitorConfig contains all of the required dependencies for the
+// ExpiryMonitor to carry out its duties.
+type ExpiryMonitorConfig struct {
+	// Store is used to look up the accounts that are close to their
+	// expiry.
+	Store Store
+
+	// ChainNotifier is used to receive block notifications to find out
+	// when accounts are getting close to their expiry.
+	ChainNotifier lndclient.ChainNotifierClient
+
+	// Threshold is the number of blocks before its expiry we start to warn
+	// about an open account.
+	Threshold uint32
+
+	// MinBackoff is the minimum time that is waited before registering
+	// for block notifications again after the subscription failed, for
+	// example because lnd was restarted.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time that is waited between the attempts
+	// to register for block notifications again.
+	MaxBackoff time.Duration
+}
+
+// ExpiryWarning is a warning about an open account that is getting close to
+// its expiry.
+type ExpiryWarning struct {
+	// TraderKey is the trader's key of the account.
+	TraderKey *btcec.PublicKey
+
+	// Expiry is the height at which the account expires.
+	Expiry uint32
+
+	// Height is the current block height.
+	Height uint32
+
+	// BlocksLeft is the number of blocks until the account expires.
+	BlocksLeft uint32
+
+	// Level is the escalation level of the warning, starting at 1 once the
+	// account is within the threshold of its expiry and increasing each
+	// time the number of blocks left is halved.
+	Level uint32
+}
+
+// ExpiryMonitor warns about open accounts that are getting close to their
+// expiry. The warnings are logged and sent to all subscribers. Each account is
+// warned about again whenever its number of blocks left is halved, with the
+// later warnings being logged as errors.
+type ExpiryMonitor struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *ExpiryMonitorConfig
+
+	// levels is the escalation level of the last warning about each
+	// account. This is only accessed by the block handler.
+	levels map[[33]byte]uint32
+
+	subscribers    map[uint64]chan *ExpiryWarning
+	nextSubscriber uint64
+	subscribersMtx sync.Mutex
+
+	wg     sync.WaitGroup
+	quit   chan struct{}
+	cancel func()
+}
+
+// NewExpiryMonitor creates a new account expiry monitor with the given config.
+func NewExpiryMonitor(cfg *ExpiryMonitorConfig) *ExpiryMonitor {
+	return &ExpiryMonitor{
+		cfg:         cfg,
+		levels:      make(map[[33]byte]uint32),
+		subscribers: make(map[uint64]chan *ExpiryWarning),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Start registers for block notifications and starts warning about accounts
+// that are close to their expiry.
+func (m *ExpiryMonitor) Start() error {
+	var err error
+	m.started.Do(func() {
+		err = m.start()
+	})
+	return err
+}
+
+// start registers for block notifications and starts warning about accounts
+// that are close to their expiry.
+func (m *ExpiryMonitor) start() error {
+	ctxc, cancel := context.WithCancel(context.Background())
+	sub := newBlockSubscription(
+		ctxc, m.cfg.ChainNotifier, m.cfg.MinBackoff, m.cfg.MaxBackoff,
+	)
+	if err := sub.register(); err != nil {
+		cancel()
+		return err
+	}
+	m.cancel = cancel
+
+	log.Infof("Warning about accounts %d blocks before their expiry",
+		m.cfg.Threshold)
+
+	m.wg.Add(1)
+	go m.blockHandler(sub)
+
+	return nil
+}
+
+// Stop stops warning about accounts and closes all subscriptions.
+func (m *ExpiryMonitor) Stop() {
+	m.stopped.Do(func() {
+		close(m.quit)
+		m.cancel()
+		m.wg.Wait()
+
+		m.subscribersMtx.Lock()
+		defer m.subscribersMtx.Unlock()
+
+		for id, subscriber := range m.subscribers {
+			delete(m.subscribers, id)
+			close(subscriber)
+		}
+	})
+}
+
+// Subscribe returns a channel that receives all future expiry warnings and a
+// function to cancel the subscription. The channel is closed once the
+// subscription is canceled.
+func (m *ExpiryMonitor) Subscribe() (<-chan *ExpiryWarning, func()) {
+	m.subscribersMtx.Lock()
+	defer m.subscribersMtx.Unlock()
+
+	id := m.nextSubscriber
+	m.nextSubscriber++
+
+	warnings := make(chan *ExpiryWarning, expiryWarningBuffer)
+	m.subscribers[id] = warnings
+
+	cancel := func() {
+		m.subscribersMtx.Lock()
+		defer m.subscribersMtx.Unlock()
+
+		if _, ok := m.subscribers[id]; ok {
+			delete(m.subscribers, id)
+			close(warnings)
+		}
+	}
+
+	return warnings, cancel
+}
+
+// blockHandler checks all accounts for upcoming expiries on every new block.
+// If the block subscription fails, we register for block notifications again.
+//
+// NOTE: This must be run as a goroutine.
+func (m *ExpiryMonitor) blockHandler(sub *blockSubscription) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case height := <-sub.blockChan:
+			m.checkAccounts(uint32(height))
+
+		case err := <-sub.errChan:
+			log.Errorf("Unable to receive block notification: %v",
+				err)
+
+			if !sub.reregister(m.quit) {
+				return
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// checkAccounts warns about all open accounts that expire within the
+// configured threshold from the given height and whose escalation level
+// increased since the last warning.
+func (m *ExpiryMonitor) checkAccounts(height uint32) {
+	accounts, err := m.cfg.Store.Accounts()
+	if err != nil {
+		log.Errorf("Unable to fetch accounts for expiry check: %v",
+			err)
+		return
+	}
+
+	levels := make(map[[33]byte]uint32, len(m.levels))
+	for _, account := range accounts {
+		if account.State != StateOpen {
+			continue
+		}
+
+		var blocksLeft uint32
+		if account.Expiry > height {
+			blocksLeft = account.Expiry - height
+		}
+
+		level := expiryWarningLevel(blocksLeft, m.cfg.Threshold)
+		if level == 0 {
+			continue
+		}
+
+		var traderKey [33]byte
+		pubKey := account.TraderKey.PubKey
+		copy(traderKey[:], pubKey.SerializeCompressed())
+		levels[traderKey] = level
+
+		// Only warn again once the account got closer to its expiry.
+		if level <= m.levels[traderKey] {
+			continue
+		}
+
+		m.warn(&ExpiryWarning{
+			TraderKey:  pubKey,
+			Expiry:     account.Expiry,
+			Height:     height,
+			BlocksLeft: blocksLeft,
+			Level:      level,
+		})
+	}
+
+	// Accounts that were renewed or closed are forgotten, so they are
+	// warned about again once they get close to their new expiry.
+	m.levels = levels
+}
+
+// warn logs the given warning and sends it to all subscribers.
+func (m *ExpiryMonitor) warn(warning *ExpiryWarning) {
+	traderKey := warning.TraderKey.SerializeCompressed()
+	if warning.Level > 2 {
+		log.Errorf("Account %x expires in %d blocks at height %d! "+
+			"Renew it now to keep it open for new orders",
+			traderKey, warning.BlocksLeft, warning.Expiry)
+	} else {
+		log.Warnf("Account %x expires in %d blocks at height %d and "+
+			"auto renewal is disabled, renew it to keep it open "+
+			"for new orders", traderKey, warning.BlocksLeft,
+			warning.Expiry)
+	}
+
+	m.subscribersMtx.Lock()
+	defer m.subscribersMtx.Unlock()
+
+	for _, subscriber := range m.subscribers {
+		select {
+		case subscriber <- warning:
+		default:
+			log.Warnf("Dropping expiry warning for slow " +
+				"subscriber")
+		}
+	}
+}
+
+// expiryWarningLevel returns the escalation level of a warning about an
+// account with the given number of blocks left. The level is 0 outside of the
+// threshold, 1 within it and increases by one each time the blocks left are
+// halved.
+func expiryWarningLevel(blocksLeft, threshold uint32) uint32 {
+	var level uint32
+	for horizon := threshold; horizon > 0; horizon /= 2 {
+		if blocksLeft > horizon {
+			break
+		}
+		level++
+	}
+
+	return level
+}
@@ -0,0 +1,210 @@
+package account
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testWarnThreshold = 144
+	testWarnHeight    = 500
+)
+
+var expiryWarningLevelTestCases = []struct {
+	name          string
+	blocksLeft    uint32
+	threshold     uint32
+	expectedLevel uint32
+}{{
+	name:          "outside of threshold",
+	blocksLeft:    testWarnThreshold + 1,
+	threshold:     testWarnThreshold,
+	expectedLevel: 0,
+}, {
+	name:          "at threshold",
+	blocksLeft:    testWarnThreshold,
+	threshold:     testWarnThreshold,
+	expectedLevel: 1,
+}, {
+	name:          "just outside of half threshold",
+	blocksLeft:    testWarnThreshold/2 + 1,
+	threshold:     testWarnThreshold,
+	expectedLevel: 1,
+}, {
+	name:          "at half threshold",
+	blocksLeft:    testWarnThreshold / 2,
+	threshold:     testWarnThreshold,
+	expectedLevel: 2,
+}, {
+	name:          "at quarter threshold",
+	blocksLeft:    testWarnThreshold / 4,
+	threshold:     testWarnThreshold,
+	expectedLevel: 3,
+}, {
+	name:          "last block",
+	blocksLeft:    1,
+	threshold:     testWarnThreshold,
+	expectedLevel: 8,
+}, {
+	name:          "expired",
+	blocksLeft:    0,
+	threshold:     testWarnThreshold,
+	expectedLevel: 8,
+}, {
+	name:          "disabled",
+	blocksLeft:    0,
+	threshold:     0,
+	expectedLevel: 0,
+}}
+
+// TestExpiryWarningLevel makes sure the warning level increases each time the
+// number of blocks left until the expiry is halved.
+func TestExpiryWarningLevel(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range expiryWarningLevelTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			level := expiryWarningLevel(tc.blocksLeft, tc.threshold)
+			require.Equal(t, tc.expectedLevel, level)
+		})
+	}
+}
+
+// TestExpiryMonitorWarnings makes sure a warning is sent once an open account
+// gets within the configured threshold of its expiry and repeated only once the
+// warning level escalates.
+func TestExpiryMonitorWarnings(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	account := &Account{
+		Value:     maxAccountValue,
+		Expiry:    testWarnHeight + testWarnThreshold,
+		TraderKey: testTraderKeyDesc,
+		State:     StateOpen,
+	}
+	require.NoError(t, store.AddAccount(account))
+
+	monitor := NewExpiryMonitor(&ExpiryMonitorConfig{
+		Store:     store,
+		Threshold: testWarnThreshold,
+	})
+	warnings, cancel := monitor.Subscribe()
+	defer cancel()
+
+	assertWarning := func(height, level uint32) {
+		t.Helper()
+
+		select {
+		case warning := <-warnings:
+			require.Equal(t, testTraderKey, warning.TraderKey)
+			require.Equal(t, account.Expiry, warning.Expiry)
+			require.Equal(t, height, warning.Height)
+			require.Equal(
+				t, account.Expiry-height, warning.BlocksLeft,
+			)
+			require.Equal(t, level, warning.Level)
+
+		default:
+			t.Fatalf("no warning at height %d", height)
+		}
+	}
+	assertNoWarning := func(height uint32) {
+		t.Helper()
+
+		select {
+		case warning := <-warnings:
+			t.Fatalf("unexpected warning at height %d: %v", height,
+				warning)
+		default:
+		}
+	}
+
+	// One block before the threshold is reached, there should be no
+	// warning yet.
+	monitor.checkAccounts(testWarnHeight - 1)
+	assertNoWarning(testWarnHeight - 1)
+
+	// Reaching the threshold results in the first warning, which is not
+	// repeated on the next block.
+	monitor.checkAccounts(testWarnHeight)
+	assertWarning(testWarnHeight, 1)
+	monitor.checkAccounts(testWarnHeight + 1)
+	assertNoWarning(testWarnHeight + 1)
+
+	// Once half of the threshold is left, the warning escalates.
+	height := uint32(testWarnHeight + testWarnThreshold/2)
+	monitor.checkAccounts(height)
+	assertWarning(height, 2)
+
+	// An account that is no longer open isn't warned about.
+	err := store.UpdateAccount(account, StateModifier(StatePendingClosed))
+	require.NoError(t, err)
+	monitor.checkAccounts(height + testWarnThreshold/4)
+	assertNoWarning(height + testWarnThreshold/4)
+}
+
+// TestExpiryMonitorBlockNotification makes sure a warning is sent once a block
+// notification brings an account within the warning threshold, also after the
+// block subscription failed because lnd was restarted.
+func TestExpiryMonitorBlockNotification(t *testing.T) {
+	t.Parallel()
+
+	for _, lndRestart := range []bool{false, true} {
+		lndRestart := lndRestart
+
+		t.Run(fmt.Sprintf("lnd restart=%v", lndRestart), func(t *testing.T) {
+			t.Parallel()
+
+			testExpiryMonitorBlockNotification(t, lndRestart)
+		})
+	}
+}
+
+func testExpiryMonitorBlockNotification(t *testing.T, lndRestart bool) {
+	store := newMockStore()
+	account := &Account{
+		Value:     maxAccountValue,
+		Expiry:    testWarnHeight + testWarnThreshold,
+		TraderKey: testTraderKeyDesc,
+		State:     StateOpen,
+	}
+	require.NoError(t, store.AddAccount(account))
+
+	notifier := newMockChainNotifier()
+	monitor := NewExpiryMonitor(&ExpiryMonitorConfig{
+		Store:         store,
+		ChainNotifier: notifier,
+		Threshold:     testWarnThreshold,
+		MinBackoff:    time.Millisecond,
+		MaxBackoff:    time.Millisecond,
+	})
+	warnings, cancel := monitor.Subscribe()
+	defer cancel()
+
+	require.NoError(t, monitor.Start())
+	defer monitor.Stop()
+
+	// The initial block notification at height 0 is too far away from the
+	// account's expiry, so only the next one should trigger the warning.
+	if lndRestart {
+		notifier.failBlockEpochNtfn(t)
+	}
+	notifier.blockChan <- testWarnHeight
+
+	select {
+	case warning := <-warnings:
+		require.Equal(t, uint32(testWarnHeight), warning.Height)
+		require.Equal(t, uint32(1), warning.Level)
+
+	case <-time.After(timeout):
+		t.Fatal("no expiry warning received")
+	}
+}
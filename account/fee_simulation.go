@@ -0,0 +1,69 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// EstimateActionWeight returns the estimated weight of the transaction that
+// performs the given action on an account of the given version. The account is
+// assumed to be spent through the cooperative path and the inputs and change
+// outputs of the lnd wallet are assumed to be P2WKH. The result is only meant
+// for informational purposes, the actual weight depends on the coins selected
+// by the wallet.
+func EstimateActionWeight(action Action, version Version) (int64, error) {
+	spendWitnessType := multiSigWitness
+	if version >= VersionTaprootEnabled {
+		spendWitnessType = muSig2Taproot
+	}
+
+	var weightEstimator input.TxWeightEstimator
+	switch action {
+	// The funding transaction spends a wallet input and creates the
+	// account output and a change output.
+	case CREATE:
+		weightEstimator.AddP2WKHInput()
+		weightEstimator.AddP2WSHOutput()
+		weightEstimator.AddP2WKHOutput()
+
+	// A deposit additionally spends a wallet input and sends the change
+	// back to the wallet.
+	case DEPOSIT:
+		err := addBaseAccountModificationWeight(
+			&weightEstimator, spendWitnessType,
+		)
+		if err != nil {
+			return 0, err
+		}
+		weightEstimator.AddP2WKHInput()
+		weightEstimator.AddP2WKHOutput()
+
+	// A withdrawal re-creates the account and sends the withdrawn funds to
+	// a single output.
+	case WITHDRAW:
+		err := addBaseAccountModificationWeight(
+			&weightEstimator, spendWitnessType,
+		)
+		if err != nil {
+			return 0, err
+		}
+		weightEstimator.AddP2WKHOutput()
+
+	// Closing spends the account into a single output without re-creating
+	// it.
+	case CLOSE:
+		witnessSize, err := spendWitnessType.witnessSize()
+		if err != nil {
+			return 0, err
+		}
+		weightEstimator.AddWitnessInput(witnessSize)
+		weightEstimator.AddP2WKHOutput()
+
+	default:
+		return 0, fmt.Errorf("unable to estimate weight of account "+
+			"action %v", action)
+	}
+
+	return int64(weightEstimator.Weight()), nil
+}
@@ -0,0 +1,236 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// defaultFundingPreviewExpiry is the time after which a funding
+	// preview expires if lnd didn't tell us when the leases of its inputs
+	// expire. This is the default lease time lnd uses in FundPsbt.
+	defaultFundingPreviewExpiry = 10 * time.Minute
+
+	// fundingPreviewLeaseTime is the time we lease the inputs of a funding
+	// preview for while it is signed and published.
+	fundingPreviewLeaseTime = 10 * time.Minute
+
+	// minFundingSatPerVByte is the lowest fee rate in sat/vByte we ask lnd
+	// to fund a PSBT with.
+	minFundingSatPerVByte = 1
+)
+
+// fundingPreview is a funding transaction that was funded from lnd's wallet by
+// PreviewAccountFunding but not yet signed or published.
+type fundingPreview struct {
+	// traderKey is the trader key of the account the preview funds.
+	traderKey *btcec.PublicKey
+
+	// packet is the funded but unsigned transaction.
+	packet *psbt.Packet
+
+	// lockedCoins are the wallet inputs of the transaction that are
+	// leased until it is published.
+	lockedCoins []*walletrpc.UtxoLease
+
+	// expiry is the time the earliest lease of the inputs expires at.
+	expiry time.Time
+
+	// expiryTimer cancels the preview once the leases of its inputs
+	// expire.
+	expiryTimer *time.Timer
+}
+
+// fundingSatPerVByte converts the given fee rate to the sat/vByte rate
+// FundPsbt expects. The rate is rounded to the nearest integer instead of
+// being truncated, and it never goes below minFundingSatPerVByte.
+func fundingSatPerVByte(feeRate chainfee.SatPerKWeight) uint64 {
+	satPerVByte := (uint64(feeRate.FeePerKVByte()) + 500) / 1000
+	if satPerVByte < minFundingSatPerVByte {
+		return minFundingSatPerVByte
+	}
+
+	return satPerVByte
+}
+
+// leaseExpiry returns the time the earliest of the given leases expires at.
+func leaseExpiry(lockedCoins []*walletrpc.UtxoLease) time.Time {
+	expiry := time.Now().Add(defaultFundingPreviewExpiry)
+	for _, coin := range lockedCoins {
+		if coin.Expiration == 0 {
+			continue
+		}
+
+		coinExpiry := time.Unix(int64(coin.Expiration), 0)
+		if coinExpiry.Before(expiry) {
+			expiry = coinExpiry
+		}
+	}
+
+	return expiry
+}
+
+// addFundingPreview keeps track of a funding preview and cancels it once the
+// leases of its inputs expire.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) addFundingPreview(traderKey *btcec.PublicKey,
+	packet *psbt.Packet, lockedCoins []*walletrpc.UtxoLease) {
+
+	var key [33]byte
+	copy(key[:], traderKey.SerializeCompressed())
+
+	preview := &fundingPreview{
+		traderKey:   traderKey,
+		packet:      packet,
+		lockedCoins: lockedCoins,
+		expiry:      leaseExpiry(lockedCoins),
+	}
+	preview.expiryTimer = time.AfterFunc(
+		time.Until(preview.expiry), func() {
+			m.expireFundingPreview(key)
+		},
+	)
+
+	m.fundingPreviews[key] = preview
+}
+
+// expireFundingPreview cancels the account of a funding preview whose inputs
+// are no longer leased to us.
+func (m *manager) expireFundingPreview(key [33]byte) {
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	select {
+	case <-m.quit:
+		return
+	default:
+	}
+
+	// The preview might have been confirmed, canceled or renewed while we
+	// were waiting for the lock.
+	preview, ok := m.fundingPreviews[key]
+	if !ok || time.Now().Before(preview.expiry) {
+		return
+	}
+
+	account, err := m.cfg.Store.Account(preview.traderKey)
+	if err != nil {
+		log.Errorf("Unable to fetch account %x of expired funding "+
+			"preview: %v", key[:], err)
+		return
+	}
+
+	log.Infof("Funding preview of account %x expired", key[:])
+
+	err = m.cancelPendingAccount(context.Background(), account)
+	if err != nil {
+		log.Errorf("Unable to cancel account %x of expired funding "+
+			"preview: %v", key[:], err)
+	}
+}
+
+// removeFundingPreview stops tracking the funding preview of the account with
+// the given trader key, if there is one. Its inputs are released if requested.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) removeFundingPreview(ctx context.Context,
+	traderKey *btcec.PublicKey, release bool) {
+
+	var key [33]byte
+	copy(key[:], traderKey.SerializeCompressed())
+
+	preview, ok := m.fundingPreviews[key]
+	if !ok {
+		return
+	}
+
+	preview.expiryTimer.Stop()
+	delete(m.fundingPreviews, key)
+
+	if release {
+		m.releaseLockedCoins(ctx, preview.lockedCoins)
+	}
+}
+
+// cancelPendingAccount cancels an account that is waiting for its funding
+// transaction and releases the inputs of its funding preview, if it has one.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) cancelPendingAccount(ctx context.Context,
+	account *Account) error {
+
+	m.removeFundingPreview(ctx, account.TraderKey.PubKey, true)
+
+	return m.cfg.Store.UpdateAccount(
+		account, StateModifier(StateExternalFundingCanceled),
+	)
+}
+
+// renewFundingPreviewLeases leases the inputs of a funding preview again, so
+// they can't expire or be used by anything else while the preview is signed
+// and published. lnd doesn't allow its internal lock ID, which FundPsbt locks
+// the inputs with, to be used for new leases, so the inputs are released and
+// leased again with our own lock ID. An error is returned if any of them is no
+// longer available.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) renewFundingPreviewLeases(ctx context.Context,
+	preview *fundingPreview) error {
+
+	lockID, err := m.cfg.Store.LockID()
+	if err != nil {
+		return err
+	}
+
+	for idx, coin := range preview.lockedCoins {
+		hash, err := chainhash.NewHash(coin.Outpoint.TxidBytes)
+		if err != nil {
+			return err
+		}
+		op := wire.OutPoint{
+			Hash:  *hash,
+			Index: coin.Outpoint.OutputIndex,
+		}
+
+		var coinLockID wtxmgr.LockID
+		copy(coinLockID[:], coin.Id)
+		if coinLockID != lockID {
+			err := m.cfg.Wallet.ReleaseOutput(ctx, coinLockID, op)
+			if err != nil {
+				return fmt.Errorf("unable to release input %v: "+
+					"%v", op, err)
+			}
+		}
+
+		expiry, err := m.cfg.Wallet.LeaseOutput(
+			ctx, lockID, op, fundingPreviewLeaseTime,
+		)
+		if err != nil {
+			return fmt.Errorf("input %v is no longer available: %v",
+				op, err)
+		}
+
+		preview.lockedCoins[idx] = &walletrpc.UtxoLease{
+			Id:         lockID[:],
+			Outpoint:   coin.Outpoint,
+			Expiration: uint64(expiry.Unix()),
+			PkScript:   coin.PkScript,
+			Value:      coin.Value,
+		}
+	}
+
+	preview.expiry = leaseExpiry(preview.lockedCoins)
+	preview.expiryTimer.Reset(time.Until(preview.expiry))
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/wtxmgr"
@@ -24,6 +25,13 @@ var (
 	// ErrNoPendingBatch is an error returned when we attempt to retrieve
 	// the ID of a pending batch, but one does not exist.
 	ErrNoPendingBatch = errors.New("no pending batch found")
+
+	// ErrBelowAnchorReserve is returned if funding an account would leave
+	// less than the reserve lnd requires to fee bump anchor channels in
+	// its wallet. poold returns it to its RPC clients with the
+	// FailedPrecondition code.
+	ErrBelowAnchorReserve = errors.New("funding would drop the lnd " +
+		"wallet balance below the anchor reserve")
 )
 
 // Reservation contains information about the different keys required for to
@@ -145,6 +153,24 @@ const (
 	// that has yet to confirm. This state exists to ensure an account can
 	// only be renewed once confirmed and expired.
 	StateExpiredPendingUpdate State = 9
+
+	// StatePendingExternalFunding denotes an account that was reserved
+	// with the auctioneer and is waiting for its funding transaction to be
+	// created and signed by an external service instead of lnd's wallet.
+	StatePendingExternalFunding State = 10
+
+	// StateExternalFundingCanceled denotes an account that was waiting for
+	// its external funding transaction but was canceled before it was
+	// funded. No funds were ever sent to the account, so it can be
+	// considered to never have been opened in the first place.
+	StateExternalFundingCanceled State = 11
+
+	// StatePendingFundingPreview denotes an account that was reserved with
+	// the auctioneer and whose funding transaction was funded from lnd's
+	// wallet by PreviewAccountFunding, but not yet signed or published.
+	// Previews are only kept in memory, so accounts in this state are
+	// canceled on restart.
+	StatePendingFundingPreview State = 12
 )
 
 // String returns a human-readable description of an account's state.
@@ -170,6 +196,12 @@ func (s State) String() string {
 		return "StatePendingBatch"
 	case StateExpiredPendingUpdate:
 		return "StateExpiredPendingUpdate"
+	case StatePendingExternalFunding:
+		return "StatePendingExternalFunding"
+	case StateExternalFundingCanceled:
+		return "StateExternalFundingCanceled"
+	case StatePendingFundingPreview:
+		return "StatePendingFundingPreview"
 	default:
 		return "unknown"
 	}
@@ -179,7 +211,9 @@ func (s State) String() string {
 // state.
 func (s State) IsActive() bool {
 	switch s {
-	case StateClosed, StateCanceledAfterRecovery:
+	case StateClosed, StateCanceledAfterRecovery,
+		StateExternalFundingCanceled:
+
 		return false
 
 	default:
@@ -187,6 +221,22 @@ func (s State) IsActive() bool {
 	}
 }
 
+// IsPending returns true if the state indicates that the latest transaction of
+// an account is not yet confirmed.
+func (s State) IsPending() bool {
+	switch s {
+	case StateInitiated, StatePendingOpen, StatePendingUpdate,
+		StatePendingBatch, StateExpiredPendingUpdate,
+		StatePendingClosed, StatePendingExternalFunding,
+		StatePendingFundingPreview:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
 // Account encapsulates all of the details of a CLM account on-chain from
 // the trader's perspective.
 type Account struct {
@@ -472,6 +522,16 @@ type TxSource interface {
 		endHeight int32) ([]lndclient.Transaction, error)
 }
 
+// FeeEstimator is a type that provides us with the fee rate to use for an
+// account transaction that should confirm within the given number of blocks.
+// The WalletKitClient of lndclient implements it with lnd's fee estimator.
+type FeeEstimator interface {
+	// EstimateFeeRate returns the fee rate to use for a transaction that
+	// should confirm within the given target number of blocks.
+	EstimateFeeRate(ctx context.Context,
+		confTarget int32) (chainfee.SatPerKWeight, error)
+}
+
 // TxFeeEstimator is a type that provides us with a realistic fee estimation to
 // send coins in a transaction.
 type TxFeeEstimator interface {
@@ -482,6 +542,20 @@ type TxFeeEstimator interface {
 		confTarget int32) (btcutil.Amount, error)
 }
 
+// WalletReserve is a type that provides us with the balance of the backing lnd
+// node's wallet and the part of it that needs to be kept to fee bump anchor
+// channels.
+type WalletReserve interface {
+	// ConfirmedBalance returns the confirmed balance of the backing lnd
+	// node's wallet.
+	ConfirmedBalance(ctx context.Context) (btcutil.Amount, error)
+
+	// RequiredReserve returns the minimum amount of satoshis that should
+	// be kept in the backing lnd node's wallet in order to fee bump anchor
+	// channels if necessary.
+	RequiredReserve(ctx context.Context) (btcutil.Amount, error)
+}
+
 // FeeExpr represents the different ways a transaction fee can be expressed in
 // terms of a transaction's resulting outputs.
 type FeeExpr interface {
@@ -603,6 +677,47 @@ type Manager interface {
 		feeRate chainfee.SatPerKWeight, expiry,
 		bestHeight uint32) (*Account, error)
 
+	// InitExternalAccount reserves a new account with the provided
+	// parameters that is funded by a transaction created and signed outside
+	// of lnd's wallet. The account waits in StatePendingExternalFunding
+	// until that transaction is handed over through FundExternalAccount.
+	InitExternalAccount(ctx context.Context, value btcutil.Amount,
+		version Version, expiry, bestHeight uint32) (*Account, error)
+
+	// FundExternalAccount verifies that the given fully signed transaction
+	// pays the exact account output of an account that is waiting for its
+	// external funding, then publishes it and tracks its confirmation.
+	FundExternalAccount(ctx context.Context, traderKey *btcec.PublicKey,
+		tx *wire.MsgTx) (*Account, error)
+
+	// CancelExternalAccount cancels an account that is still waiting for
+	// its external funding transaction or the confirmation of its funding
+	// preview, so its reservation no longer prevents new accounts from
+	// being created. The inputs of a funding preview are released. The
+	// auctioneer keeps its reservation bound to the old trader key and
+	// hands it out again on the next reservation.
+	CancelExternalAccount(ctx context.Context,
+		traderKey *btcec.PublicKey) (*Account, error)
+
+	// PreviewAccountFunding reserves a new account with the provided
+	// parameters and funds its output from lnd's wallet without signing or
+	// publishing the funding transaction. The returned packet contains the
+	// unsigned transaction and the wallet inputs it spends, the fee it
+	// pays is returned as well. The account waits in
+	// StatePendingFundingPreview until the previewed transaction is
+	// published with ConfirmAccountFunding. If the leases of the inputs
+	// expire first, the account is canceled.
+	PreviewAccountFunding(ctx context.Context, value btcutil.Amount,
+		version Version, feeRate chainfee.SatPerKWeight, expiry,
+		bestHeight uint32) (*Account, *psbt.Packet, btcutil.Amount,
+		error)
+
+	// ConfirmAccountFunding signs and publishes the funding transaction of
+	// an account that was created by PreviewAccountFunding and tracks its
+	// confirmation.
+	ConfirmAccountFunding(ctx context.Context,
+		traderKey *btcec.PublicKey) (*Account, error)
+
 	// WatchMatchedAccounts resumes accounts that were just matched in a batch and
 	// are expecting the batch transaction to confirm as their next account output.
 	// This will cancel all previous spend and conf watchers of all accounts
@@ -647,6 +762,21 @@ type Manager interface {
 		bestHeight, expiryHeight uint32, newVersion Version) (*Account,
 		*wire.MsgTx, error)
 
+	// SplitAccount moves the given amount out of the account associated with the
+	// given trader key into a newly reserved account of the same version. Both
+	// the modified and the new account are returned.
+	SplitAccount(ctx context.Context, traderKey *btcec.PublicKey,
+		splitValue btcutil.Amount, feeRate chainfee.SatPerKWeight,
+		expiry, bestHeight uint32) (*Account, *Account, *wire.MsgTx,
+		error)
+
+	// MergeAccounts moves all funds of the account associated with
+	// mergeTraderKey into the account associated with traderKey. The merged
+	// account is closed once the transaction confirms.
+	MergeAccounts(ctx context.Context, traderKey,
+		mergeTraderKey *btcec.PublicKey, feeRate chainfee.SatPerKWeight,
+		bestHeight uint32) (*Account, *wire.MsgTx, error)
+
 	// RenewAccount updates the expiration of an open/expired account. This will
 	// always require a signature from the auctioneer, even after the account has
 	// expired, to ensure the auctioneer is aware the account is being renewed.
@@ -655,6 +785,13 @@ type Manager interface {
 		bestHeight uint32, newVersion Version) (*Account, *wire.MsgTx,
 		error)
 
+	// QuoteAccountRenewal returns the expected fee rate and total miner fee for
+	// renewing an open/expired account to the given expiry with the fee rate lnd
+	// estimates for the given confTarget. Nothing is signed or broadcast.
+	QuoteAccountRenewal(ctx context.Context, traderKey *btcec.PublicKey,
+		newExpiry, confTarget, bestHeight uint32,
+		newVersion Version) (chainfee.SatPerKWeight, btcutil.Amount, error)
+
 	// BumpAccountFee attempts to bump the fee of an account's most recent
 	// transaction. This is done by locating an eligible output for lnd to CPFP,
 	// otherwise the fee bump will not succeed. Further invocations of this call for
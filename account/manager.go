@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -222,10 +223,30 @@ type ManagerConfig struct {
 	// broadcast by us.
 	TxSource TxSource
 
+	// FeeEstimator provides the fee rates for account transactions that
+	// are created with a confirmation target. If nil, lnd's fee estimator
+	// of the Wallet is used.
+	FeeEstimator FeeEstimator
+
 	// TxFeeEstimator is an estimator that can calculate the total on-chain
 	// fees to send to an account output.
 	TxFeeEstimator TxFeeEstimator
 
+	// WalletReserve is used to make sure funding an account doesn't take
+	// the funds lnd needs to fee bump anchor channels. If nil, the reserve
+	// isn't checked.
+	WalletReserve WalletReserve
+
+	// IgnoreAnchorReserve, if set, only logs a warning instead of refusing
+	// to fund an account that would drop the wallet balance below the
+	// anchor reserve.
+	IgnoreAnchorReserve bool
+
+	// CoinSelectionStrategy is the strategy used to select the wallet
+	// outputs that fund account creations and deposits. If empty, the
+	// selection is left to lnd.
+	CoinSelectionStrategy string
+
 	// TxLabelPrefix is set, then all transactions the account manager
 	// makes will use this string as a prefix for added transaction labels.
 	TxLabelPrefix string
@@ -235,6 +256,15 @@ type ManagerConfig struct {
 
 	// LndVersion is the version of the connected lnd node.
 	LndVersion *verrpc.Version
+
+	// MinBackoff is the minimum time that is waited before registering
+	// for an account's confirmation or spend notification again after
+	// the stream failed, for example because lnd was restarted.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time that is waited between the attempts
+	// to register for a notification again.
+	MaxBackoff time.Duration
 }
 
 // Manager is responsible for the management of accounts on-chain.
@@ -257,6 +287,11 @@ type manager struct {
 	// time to fund it.
 	reservationMtx sync.Mutex
 
+	// fundingPreviews holds the funded but unsigned transactions of
+	// accounts created by PreviewAccountFunding, keyed by the account's
+	// trader key. It is guarded by the reservation mutex.
+	fundingPreviews map[[33]byte]*fundingPreview
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -267,8 +302,12 @@ var _ Manager = (*manager)(nil)
 // NewManager instantiates a new Manager backed by the given config.
 func NewManager(cfg *ManagerConfig) *manager { // nolint:golint
 	m := &manager{
-		cfg:  *cfg,
-		quit: make(chan struct{}),
+		cfg:             *cfg,
+		fundingPreviews: make(map[[33]byte]*fundingPreview),
+		quit:            make(chan struct{}),
+	}
+	if m.cfg.FeeEstimator == nil {
+		m.cfg.FeeEstimator = cfg.Wallet
 	}
 
 	m.watcherCtrl = watcher.NewController(&watcher.CtrlConfig{
@@ -276,6 +315,9 @@ func NewManager(cfg *ManagerConfig) *manager { // nolint:golint
 
 		// The manager implements the EventHandler interface.
 		Handlers: m,
+
+		MinBackoff: cfg.MinBackoff,
+		MaxBackoff: cfg.MaxBackoff,
 	})
 
 	return m
@@ -311,7 +353,7 @@ func (m *manager) start() error {
 	// We calculate the default fee rate that will be used
 	// for resuming accounts for which we haven't created and broadcast
 	// a transaction yet
-	feeRate, err := m.cfg.Wallet.EstimateFeeRate(
+	feeRate, err := m.cfg.FeeEstimator.EstimateFeeRate(
 		ctx, int32(DefaultFundingConfTarget),
 	)
 	if err != nil {
@@ -351,6 +393,14 @@ func (m *manager) Stop() {
 
 		close(m.quit)
 		m.wg.Wait()
+
+		// Previews are canceled on the next start anyway, so there's
+		// no need to let them expire in the meantime.
+		m.reservationMtx.Lock()
+		for _, preview := range m.fundingPreviews {
+			preview.expiryTimer.Stop()
+		}
+		m.reservationMtx.Unlock()
 	})
 }
 
@@ -373,7 +423,7 @@ func (m *manager) QuoteAccount(ctx context.Context, value btcutil.Amount,
 	}
 
 	// Now calculate the estimated fee rate from the confTarget.
-	feeRate, err := m.cfg.Wallet.EstimateFeeRate(ctx, int32(confTarget))
+	feeRate, err := m.cfg.FeeEstimator.EstimateFeeRate(ctx, int32(confTarget))
 	if err != nil {
 		return 0, 0, fmt.Errorf("error estimating fee rate: %v", err)
 	}
@@ -404,6 +454,29 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 	m.reservationMtx.Lock()
 	defer m.reservationMtx.Unlock()
 
+	account, err := m.reserveAccount(
+		ctx, value, version, expiry, bestHeight, StateInitiated,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.resumeAccount(ctx, account, false, false, feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// reserveAccount reserves a new account with the auctioneer and stores it in
+// the given initial state.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) reserveAccount(ctx context.Context, value btcutil.Amount,
+	version Version, expiry, bestHeight uint32,
+	state State) (*Account, error) {
+
 	// First, make sure we have a valid amount to create the account. We
 	// need to ask the auctioneer for the maximum as it dynamically defines
 	// that value.
@@ -419,6 +492,14 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 		return nil, err
 	}
 
+	// Only accounts funded by lnd's wallet can take the funds lnd needs to
+	// fee bump anchor channels.
+	if state == StateInitiated {
+		if err := m.checkAnchorReserve(ctx, value); err != nil {
+			return nil, err
+		}
+	}
+
 	// We'll start by deriving a key for ourselves that we'll use in our
 	// 2-of-2 multi-sig construction.
 	keyDesc, err := m.cfg.Wallet.DeriveNextKey(
@@ -437,6 +518,9 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.checkReservationAvailable(reservation); err != nil {
+		return nil, err
+	}
 
 	// We'll also need to compute a shared secret based on both base keys
 	// (the trader and auctioneer's) to ensure only they are able to
@@ -458,7 +542,7 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 		AuctioneerKey: reservation.AuctioneerKey,
 		BatchKey:      reservation.InitialBatchKey,
 		Secret:        secret,
-		State:         StateInitiated,
+		State:         state,
 		HeightHint:    bestHeight,
 		Version:       version,
 	}
@@ -469,14 +553,459 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 	log.Infof("Creating new account %x of %v that expires at height %v",
 		keyDesc.PubKey.SerializeCompressed(), value, expiry)
 
-	err = m.resumeAccount(ctx, account, false, false, feeRate)
+	return account, nil
+}
+
+// checkReservationAvailable makes sure the given reservation isn't held by an
+// account that is still waiting for its external funding transaction. Only one
+// reservation can be active per trader with the auctioneer, which hands out
+// the existing one instead of reserving a new one. That reservation can't be
+// used for another account until the waiting one is funded or canceled.
+func (m *manager) checkReservationAvailable(reservation *Reservation) error {
+	accounts, err := m.cfg.Store.Accounts()
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if account.State != StatePendingExternalFunding &&
+			account.State != StatePendingFundingPreview {
+
+			continue
+		}
+
+		if account.AuctioneerKey.IsEqual(reservation.AuctioneerKey) &&
+			account.BatchKey.IsEqual(reservation.InitialBatchKey) {
+
+			return fmt.Errorf("reservation is held by account %x "+
+				"which is still waiting for its external "+
+				"funding transaction, fund or cancel it first",
+				account.TraderKey.PubKey.SerializeCompressed())
+		}
+	}
+
+	return nil
+}
+
+// InitExternalAccount reserves a new account with the provided parameters
+// that is funded by a transaction created and signed outside of lnd's wallet.
+// The account waits in StatePendingExternalFunding until that transaction is
+// handed over through FundExternalAccount.
+func (m *manager) InitExternalAccount(ctx context.Context,
+	value btcutil.Amount, version Version, expiry,
+	bestHeight uint32) (*Account, error) {
+
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	return m.reserveAccount(
+		ctx, value, version, expiry, bestHeight,
+		StatePendingExternalFunding,
+	)
+}
+
+// FundExternalAccount verifies that the given fully signed transaction pays
+// the exact account output of an account that is waiting for its external
+// funding, then publishes it and tracks its confirmation.
+func (m *manager) FundExternalAccount(ctx context.Context,
+	traderKey *btcec.PublicKey, tx *wire.MsgTx) (*Account, error) {
+
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	account, err := m.pendingExternalAccount(
+		traderKey, StatePendingExternalFunding,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.publishExternalFunding(ctx, account, tx)
+}
+
+// CancelExternalAccount cancels an account that is still waiting for its
+// external funding transaction or the confirmation of its funding preview, so
+// its reservation no longer prevents new accounts from being created. The
+// inputs of a funding preview are released. The account is only canceled
+// locally, the auctioneer keeps its reservation bound to the old trader key
+// and hands it out again on the next reservation.
+func (m *manager) CancelExternalAccount(ctx context.Context,
+	traderKey *btcec.PublicKey) (*Account, error) {
+
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	account, err := m.pendingExternalAccount(
+		traderKey, StatePendingExternalFunding,
+		StatePendingFundingPreview,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.cancelPendingAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Canceled account %x that was waiting for its external "+
+		"funding transaction", traderKey.SerializeCompressed())
+
+	return account, nil
+}
+
+// pendingExternalAccount returns the account with the given trader key and
+// makes sure it is in one of the given states that wait for a funding
+// transaction.
+func (m *manager) pendingExternalAccount(traderKey *btcec.PublicKey,
+	states ...State) (*Account, error) {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, state := range states {
+		if account.State == state {
+			return account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account %x is not waiting for an external "+
+		"funding transaction, state is %v",
+		traderKey.SerializeCompressed(), account.State)
+}
+
+// publishExternalFunding verifies and publishes the funding transaction of an
+// account that is waiting for its external funding and tracks its
+// confirmation.
+//
+// NOTE: The reservation mutex must be held when calling this method.
+func (m *manager) publishExternalFunding(ctx context.Context, account *Account,
+	tx *wire.MsgTx) (*Account, error) {
+
+	traderKey := account.TraderKey.PubKey
+	op, err := validateExternalFundingTx(account, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only track the account once the transaction was accepted by the
+	// network, otherwise the external service can still fix it and try
+	// again.
+	contextLabel := actionTxLabel(
+		account, CREATE, false, nil, account.Value,
+	)
+	label := makeTxnLabel(m.cfg.TxLabelPrefix, contextLabel)
+	err = m.cfg.Wallet.PublishTransaction(ctx, tx, label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish funding transaction: "+
+			"%v", err)
+	}
+
+	log.Infof("Funded new account %x with external transaction %v",
+		traderKey.SerializeCompressed(), tx.TxHash())
+
+	if err := m.trackAccountFunding(ctx, account, *op, tx); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// trackAccountFunding marks an account that was funded by the given, already
+// published transaction as pending open and waits for its confirmation.
+func (m *manager) trackAccountFunding(ctx context.Context, account *Account,
+	op wire.OutPoint, tx *wire.MsgTx) error {
+
+	err := m.cfg.Store.UpdateAccount(
+		account, StateModifier(StatePendingOpen), OutPointModifier(op),
+		LatestTxModifier(tx),
+	)
+	if err != nil {
+		return err
+	}
+
+	return m.resumeAccount(ctx, account, false, false, 0)
+}
+
+// PreviewAccountFunding reserves a new account with the provided parameters
+// and funds its output from lnd's wallet without signing or publishing the
+// funding transaction. The returned packet contains the unsigned transaction
+// and the wallet inputs it spends, the fee it pays is returned as well. The
+// account waits in StatePendingFundingPreview until the previewed transaction
+// is published with ConfirmAccountFunding. The preview is canceled once the
+// leases of its inputs expire.
+func (m *manager) PreviewAccountFunding(ctx context.Context,
+	value btcutil.Amount, version Version, feeRate chainfee.SatPerKWeight,
+	expiry, bestHeight uint32) (*Account, *psbt.Packet, btcutil.Amount,
+	error) {
+
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	// The funds are taken from lnd's wallet, so we need to respect the
+	// anchor reserve the same way a normal account funding does.
+	if err := m.checkAnchorReserve(ctx, value); err != nil {
+		return nil, nil, 0, err
+	}
+
+	account, err := m.reserveAccount(
+		ctx, value, version, expiry, bestHeight,
+		StatePendingFundingPreview,
+	)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// If we can't fund the account, we cancel it right away so its
+	// reservation doesn't block new accounts.
+	var packet *psbt.Packet
+	accountOutput, err := account.Output()
+	if err == nil {
+		var lockedCoins []*walletrpc.UtxoLease
+		packet, lockedCoins, err = m.fundAccountOutput(
+			ctx, accountOutput, feeRate,
+		)
+		if err == nil {
+			m.addFundingPreview(
+				account.TraderKey.PubKey, packet, lockedCoins,
+			)
+		}
+	}
+	if err != nil {
+		if cancelErr := m.cancelPendingAccount(
+			ctx, account,
+		); cancelErr != nil {
+			log.Errorf("Unable to cancel account %x: %v",
+				account.TraderKey.PubKey.SerializeCompressed(),
+				cancelErr)
+		}
+
+		return nil, nil, 0, fmt.Errorf("unable to fund account: %v",
+			err)
+	}
+
+	fee := deriveFeeFromPsbt(packet)
+	log.Infof("Previewed funding transaction %v of new account %x paying "+
+		"a fee of %v", packet.UnsignedTx.TxHash(),
+		account.TraderKey.PubKey.SerializeCompressed(), fee)
+
+	return account, packet, fee, nil
+}
+
+// ConfirmAccountFunding signs and publishes the funding transaction of an
+// account that was created by PreviewAccountFunding and tracks its
+// confirmation.
+func (m *manager) ConfirmAccountFunding(ctx context.Context,
+	traderKey *btcec.PublicKey) (*Account, error) {
+
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	account, err := m.pendingExternalAccount(
+		traderKey, StatePendingFundingPreview,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Previews are only kept in memory and canceled on restart, so every
+	// account in the preview state has one.
+	var key [33]byte
+	copy(key[:], traderKey.SerializeCompressed())
+	preview, ok := m.fundingPreviews[key]
+	if !ok {
+		return nil, fmt.Errorf("no funding transaction was previewed "+
+			"for account %x", key[:])
+	}
+
+	// The inputs must still be ours until the transaction is published,
+	// otherwise we'd sign a transaction that can't confirm.
+	if err := m.renewFundingPreviewLeases(ctx, preview); err != nil {
+		if cancelErr := m.cancelPendingAccount(
+			ctx, account,
+		); cancelErr != nil {
+			log.Errorf("Unable to cancel account %x: %v", key[:],
+				cancelErr)
+		}
+
+		return nil, fmt.Errorf("funding preview of account %x is no "+
+			"longer valid and was canceled: %v", key[:], err)
+	}
+
+	_, tx, err := m.cfg.Wallet.FinalizePsbt(ctx, preview.packet, "")
+	if err != nil {
+		return nil, fmt.Errorf("error signing funding transaction: %v",
+			err)
+	}
+
+	account, err = m.publishExternalFunding(ctx, account, tx)
 	if err != nil {
 		return nil, err
 	}
+	m.removeFundingPreview(ctx, traderKey, false)
 
 	return account, nil
 }
 
+// fundAccountOutput creates an unsigned transaction that pays to the given
+// account output from lnd's wallet, paying the given fee rate. The inputs of
+// the transaction are locked in the wallet and returned as well.
+func (m *manager) fundAccountOutput(ctx context.Context,
+	accountOutput *wire.TxOut,
+	feeRate chainfee.SatPerKWeight) (*psbt.Packet, []*walletrpc.UtxoLease,
+	error) {
+
+	inputs, err := m.selectCoins(
+		ctx, []*wire.TxOut{accountOutput}, 0, feeRate,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tplPacket, err := psbt.New(
+		inputs, []*wire.TxOut{accountOutput}, 2, 0,
+		make([]uint32, len(inputs)),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating template PSBT: %v",
+			err)
+	}
+
+	var tplBytes bytes.Buffer
+	if err := tplPacket.Serialize(&tplBytes); err != nil {
+		return nil, nil, fmt.Errorf("error serializing template PSBT: "+
+			"%v", err)
+	}
+
+	packet, changeOutputIdx, lockedCoins, err := m.cfg.Wallet.FundPsbt(
+		ctx, &walletrpc.FundPsbtRequest{
+			Template: &walletrpc.FundPsbtRequest_Psbt{
+				Psbt: tplBytes.Bytes(),
+			},
+			MinConfs: 1,
+			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
+				SatPerVbyte: fundingSatPerVByte(feeRate),
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error funding PSBT: %v", err)
+	}
+
+	// Besides the change, the only output must be the account output,
+	// funded with exactly the account value.
+	for idx, txOut := range packet.UnsignedTx.TxOut {
+		if changeOutputIdx >= 0 && changeOutputIdx == int32(idx) {
+			continue
+		}
+
+		if !bytes.Equal(txOut.PkScript, accountOutput.PkScript) ||
+			txOut.Value != accountOutput.Value {
+
+			m.releaseLockedCoins(ctx, lockedCoins)
+			return nil, nil, fmt.Errorf("unexpected output %d in "+
+				"funded packet", idx)
+		}
+	}
+
+	return packet, lockedCoins, nil
+}
+
+// sendAccountOutput funds, signs and publishes a transaction that pays to the
+// given account output from lnd's wallet. If a coin selection strategy is
+// configured, the transaction is funded through a PSBT with the inputs we
+// selected, otherwise lnd selects the inputs.
+func (m *manager) sendAccountOutput(ctx context.Context,
+	accountOutput *wire.TxOut, feeRate chainfee.SatPerKWeight,
+	label string) (*wire.MsgTx, error) {
+
+	if m.cfg.CoinSelectionStrategy == CoinSelectionDefault {
+		return m.cfg.Wallet.SendOutputs(
+			ctx, []*wire.TxOut{accountOutput}, feeRate, label,
+		)
+	}
+
+	packet, lockedCoins, err := m.fundAccountOutput(
+		ctx, accountOutput, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inputs stay leased until the leases expire if we don't release
+	// them, so we do that as soon as anything goes wrong.
+	_, tx, err := m.cfg.Wallet.FinalizePsbt(ctx, packet, "")
+	if err != nil {
+		m.releaseLockedCoins(ctx, lockedCoins)
+		return nil, fmt.Errorf("error finalizing PSBT: %v", err)
+	}
+
+	if err := m.cfg.Wallet.PublishTransaction(ctx, tx, label); err != nil {
+		m.releaseLockedCoins(ctx, lockedCoins)
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// releaseLockedCoins releases the given wallet coins that were locked by a
+// FundPsbt call.
+func (m *manager) releaseLockedCoins(ctx context.Context,
+	lockedCoins []*walletrpc.UtxoLease) {
+
+	for _, coin := range lockedCoins {
+		var lockID wtxmgr.LockID
+		copy(lockID[:], coin.Id)
+
+		hash, _ := chainhash.NewHash(coin.Outpoint.TxidBytes)
+		op := wire.OutPoint{
+			Hash:  *hash,
+			Index: coin.Outpoint.OutputIndex,
+		}
+		_ = m.cfg.Wallet.ReleaseOutput(ctx, lockID, op)
+	}
+}
+
+// validateExternalFundingTx makes sure the given transaction is fully signed
+// and pays exactly the account value to the account output. The outpoint of
+// the account output is returned.
+func validateExternalFundingTx(account *Account,
+	tx *wire.MsgTx) (*wire.OutPoint, error) {
+
+	for idx, txIn := range tx.TxIn {
+		if len(txIn.Witness) == 0 && len(txIn.SignatureScript) == 0 {
+			return nil, fmt.Errorf("input %d of funding transaction "+
+				"is not signed", idx)
+		}
+	}
+
+	accountOutput, err := account.Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct account output: %v",
+			err)
+	}
+
+	outputIndex, ok := poolscript.LocateOutputScript(
+		tx, accountOutput.PkScript,
+	)
+	if !ok {
+		return nil, fmt.Errorf("funding transaction %v does not pay "+
+			"to account script %x", tx.TxHash(),
+			accountOutput.PkScript)
+	}
+
+	txOut := tx.TxOut[outputIndex]
+	if txOut.Value != accountOutput.Value {
+		return nil, fmt.Errorf("funding transaction %v pays %v to "+
+			"the account output, expected %v", tx.TxHash(),
+			btcutil.Amount(txOut.Value),
+			btcutil.Amount(accountOutput.Value))
+	}
+
+	return &wire.OutPoint{Hash: tx.TxHash(), Index: outputIndex}, nil
+}
+
 // WatchMatchedAccounts resumes accounts that were just matched in a batch and
 // are expecting the batch transaction to confirm as their next account output.
 // This will cancel all previous spend and conf watchers of all accounts
@@ -654,9 +1183,8 @@ func (m *manager) resumeAccount(ctx context.Context, account *Account, // nolint
 			label := makeTxnLabel(m.cfg.TxLabelPrefix, contextLabel)
 
 			// TODO(wilmer): Expose manual controls to bump fees.
-			tx, err := m.cfg.Wallet.SendOutputs(
-				ctx, []*wire.TxOut{accountOutput}, feeRate,
-				label,
+			tx, err := m.sendAccountOutput(
+				ctx, accountOutput, feeRate, label,
 			)
 			if err != nil {
 				return err
@@ -819,6 +1347,24 @@ func (m *manager) resumeAccount(ctx context.Context, account *Account, // nolint
 			}
 		}
 
+	// In StatePendingExternalFunding, the funding transaction of the
+	// account is created by an external service, so there's nothing to do
+	// until it is handed to us through FundExternalAccount.
+	case StatePendingExternalFunding:
+		log.Infof("Waiting for external funding transaction of "+
+			"account %x", account.TraderKey.PubKey.SerializeCompressed())
+
+	// In StatePendingFundingPreview, the funding preview of the account
+	// was lost with the restart, so we cancel the account to free its
+	// reservation. lnd releases the preview's inputs once their leases
+	// expire.
+	case StatePendingFundingPreview:
+		log.Infof("Canceling account %x as its funding preview didn't "+
+			"survive the restart",
+			account.TraderKey.PubKey.SerializeCompressed())
+
+		return m.cancelPendingAccount(ctx, account)
+
 	// In StateOpen, the funding transaction for the account has already
 	// confirmed, so we only need to watch for its spend and expiration and
 	// register for account updates.
@@ -899,7 +1445,9 @@ func (m *manager) resumeAccount(ctx context.Context, account *Account, // nolint
 
 	// If the account has already been closed or canceled, there's nothing
 	// to be done.
-	case StateClosed, StateCanceledAfterRecovery:
+	case StateClosed, StateCanceledAfterRecovery,
+		StateExternalFundingCanceled:
+
 		break
 
 	default:
@@ -1262,6 +1810,10 @@ func (m *manager) DepositAccount(ctx context.Context,
 			"accepted maximum of %v", terms.MaxAccountValue)
 	}
 
+	if err := m.checkAnchorReserve(ctx, depositAmount); err != nil {
+		return nil, nil, err
+	}
+
 	var newExpiry *uint32
 	if expiryHeight != 0 {
 		// Validate the new expiry.
@@ -1386,48 +1938,275 @@ func (m *manager) WithdrawAccount(ctx context.Context,
 	return modifiedAccount, spendTx, nil
 }
 
-// RenewAccount updates the expiration of an open/expired account. This will
-// always require a signature from the auctioneer, even after the account has
-// expired, to ensure the auctioneer is aware the account is being renewed.
-func (m *manager) RenewAccount(ctx context.Context,
-	traderKey *btcec.PublicKey, newExpiry uint32,
-	feeRate chainfee.SatPerKWeight, bestHeight uint32,
-	newVersion Version) (*Account, *wire.MsgTx, error) {
+// SplitAccount moves the given amount out of the account associated with the
+// given trader key into a newly reserved account of the same version. The new
+// account is funded by a withdrawal from the existing account, so both of them
+// are pending until that transaction confirms. If the withdrawal fails, the new
+// account is canceled again so its reservation doesn't block new accounts.
+func (m *manager) SplitAccount(ctx context.Context,
+	traderKey *btcec.PublicKey, splitValue btcutil.Amount,
+	feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32) (*Account,
+	*Account, *wire.MsgTx, error) {
 
-	// The account can only have its expiry updated if it has confirmed
-	// and/or has expired.
+	m.reservationMtx.Lock()
+	defer m.reservationMtx.Unlock()
+
+	// The account can only be modified in `StateOpen` and must keep enough
+	// funds to pay for the transaction fee.
 	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if account.State != StateOpen {
+		return nil, nil, nil, fmt.Errorf("account must be in %v to be "+
+			"split", StateOpen)
+	}
+	if determineWitnessType(account, bestHeight).IsExpirySpend() {
+		return nil, nil, nil, errors.New("expired accounts cannot be " +
+			"split")
+	}
+	if splitValue >= account.Value {
+		return nil, nil, nil, fmt.Errorf("split value %v must be "+
+			"below the account value of %v", splitValue,
+			account.Value)
+	}
+
+	// The new account is only funded once the withdrawal to its output was
+	// published, so we reserve it as if it was funded externally.
+	newAccount, err := m.reserveAccount(
+		ctx, splitValue, account.Version, expiry, bestHeight,
+		StatePendingExternalFunding,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	newAccountOutput, err := newAccount.Output()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	modifiedAccount, tx, err := m.WithdrawAccount(
+		ctx, traderKey, []*wire.TxOut{newAccountOutput}, feeRate,
+		bestHeight, 0, account.Version,
+	)
+	if err != nil {
+		cancelErr := m.cancelPendingAccount(ctx, newAccount)
+		if cancelErr != nil {
+			log.Errorf("Unable to cancel split account %x: %v",
+				newAccount.TraderKey.PubKey.SerializeCompressed(),
+				cancelErr)
+		}
+
+		return nil, nil, nil, err
+	}
+
+	outputIndex, ok := poolscript.LocateOutputScript(
+		tx, newAccountOutput.PkScript,
+	)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("transaction %v does not "+
+			"include expected script %x", tx.TxHash(),
+			newAccountOutput.PkScript)
+	}
+	op := wire.OutPoint{Hash: tx.TxHash(), Index: outputIndex}
+
+	log.Infof("Split account %x of %v off account %x with transaction %v",
+		newAccount.TraderKey.PubKey.SerializeCompressed(), splitValue,
+		traderKey.SerializeCompressed(), tx.TxHash())
+
+	if err := m.trackAccountFunding(ctx, newAccount, op, tx); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return modifiedAccount, newAccount, tx, nil
+}
+
+// MergeAccounts moves all funds of the account associated with mergeTraderKey
+// into the account associated with traderKey. Both account outputs are spent
+// by a single transaction that only re-creates the latter, the merged account
+// is closed once it confirms. Only Taproot accounts can be merged as each input
+// is signed through the MuSig2 key spend path with the auctioneer.
+func (m *manager) MergeAccounts(ctx context.Context, traderKey,
+	mergeTraderKey *btcec.PublicKey, feeRate chainfee.SatPerKWeight,
+	bestHeight uint32) (*Account, *wire.MsgTx, error) {
+
+	if traderKey.IsEqual(mergeTraderKey) {
+		return nil, nil, errors.New("cannot merge an account into " +
+			"itself")
+	}
+
+	account, err := m.mergeableAccount(traderKey, bestHeight)
 	if err != nil {
 		return nil, nil, err
 	}
-	switch account.State {
-	case StateOpen, StateExpired:
-	default:
-		return nil, nil, fmt.Errorf("account must be in either of %v "+
-			"to be renewed",
-			[]State{StateOpen, StateExpired})
+	mergeAccount, err := m.mergeableAccount(mergeTraderKey, bestHeight)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Validate the new expiry.
-	if err := validateAccountExpiry(newExpiry, bestHeight); err != nil {
+	// The transaction spends both account inputs and only creates the new
+	// output of the account we merge into.
+	var weightEstimator input.TxWeightEstimator
+	err = addBaseAccountModificationWeight(&weightEstimator, muSig2Taproot)
+	if err != nil {
+		return nil, nil, err
+	}
+	witnessSize, err := muSig2Taproot.witnessSize()
+	if err != nil {
+		return nil, nil, err
+	}
+	weightEstimator.AddWitnessInput(witnessSize)
+	fee := feeRate.FeeForWeight(int64(weightEstimator.Weight()))
+
+	// The auctioneer defines the maximum account size.
+	terms, err := m.cfg.Auctioneer.Terms(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not query auctioneer "+
+			"terms: %v", err)
+	}
+	newAccountValue := account.Value + mergeAccount.Value - fee
+	if newAccountValue > terms.MaxAccountValue {
+		return nil, nil, fmt.Errorf("new account value is above "+
+			"accepted maximum of %v", terms.MaxAccountValue)
+	}
+
+	newAccountOutput, modifiers, err := createNewAccountOutput(
+		account, newAccountValue, nil, nil,
+	)
+	if err != nil {
 		return nil, nil, err
 	}
 
-	// Can't downgrade an account.
-	if newVersion < account.Version {
-		return nil, nil, fmt.Errorf("cannot downgrade account "+
-			"version to %s", newVersion)
+	tx := wire.NewMsgTx(2)
+	tx.TxIn = []*wire.TxIn{{
+		PreviousOutPoint: account.OutPoint,
+	}, {
+		PreviousOutPoint: mergeAccount.OutPoint,
+	}}
+	tx.TxOut = []*wire.TxOut{newAccountOutput}
+	txsort.InPlaceSort(tx)
+
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for idx, txIn := range tx.TxIn {
+		inputAccount := account
+		if txIn.PreviousOutPoint == mergeAccount.OutPoint {
+			inputAccount = mergeAccount
+		}
+
+		packet.Inputs[idx].WitnessUtxo, err = inputAccount.Output()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	// Determine the new account output after attempting the expiry update.
-	// We'll always use the multisig spend path, even if the account is
-	// expired, to make sure the auctioneer is aware of the change. We
-	// achieve this by setting the best height to 0 which means our account
-	// is never seen as expired.
-	spendWitnessType := multiSigWitness
-	if account.Version >= VersionTaprootEnabled {
-		spendWitnessType = muSig2Taproot
+	err = sanityCheckAccountSpendTx(account, packet, muSig2Taproot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The account we merge into is re-created by the only output of the
+	// transaction while the merged account is closed from the auctioneer's
+	// point of view.
+	modifiers = append(
+		modifiers, StateModifier(StatePendingUpdate),
+		OutPointModifier(wire.OutPoint{Hash: tx.TxHash(), Index: 0}),
+	)
+	_, err = m.addAccountSpendSignature(
+		ctx, account, packet, muSig2Taproot, 0, modifiers,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = m.addAccountSpendSignature(
+		ctx, mergeAccount, packet, muSig2Taproot, -1, nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return nil, nil, fmt.Errorf("error finalizing PSBT: %v", err)
+	}
+	spendTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error extracting TX: %v", err)
 	}
+
+	// Both accounts are updated before the broadcast so the spend of either
+	// of them is expected.
+	prevAccountValue := account.Value
+	modifiers = append(
+		modifiers, HeightHintModifier(bestHeight),
+		LatestTxModifier(spendTx),
+	)
+	if err := m.cfg.Store.UpdateAccount(account, modifiers...); err != nil {
+		return nil, nil, err
+	}
+	err = m.cfg.Store.UpdateAccount(
+		mergeAccount, ValueModifier(0), StateModifier(StatePendingClosed),
+		HeightHintModifier(bestHeight), LatestTxModifier(spendTx),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Merging account %x into account %x with transaction %v",
+		mergeTraderKey.SerializeCompressed(),
+		traderKey.SerializeCompressed(), spendTx.TxHash())
+
+	txFee := deriveFeeFromPsbt(packet)
+	contextLabel := actionTxLabel(
+		account, DEPOSIT, false, &txFee, account.Value-prevAccountValue,
+	)
+	label := makeTxnLabel(m.cfg.TxLabelPrefix, contextLabel)
+	if err := m.maybeBroadcastTx(ctx, spendTx, label); err != nil {
+		return nil, nil, err
+	}
+
+	return account, spendTx, nil
+}
+
+// mergeableAccount returns the account with the given trader key and makes
+// sure it can be spent cooperatively as part of a merge.
+func (m *manager) mergeableAccount(traderKey *btcec.PublicKey,
+	bestHeight uint32) (*Account, error) {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+	if account.State != StateOpen {
+		return nil, fmt.Errorf("account %x must be in %v to be merged",
+			traderKey.SerializeCompressed(), StateOpen)
+	}
+	if determineWitnessType(account, bestHeight) != muSig2Taproot {
+		return nil, fmt.Errorf("account %x must be a non-expired "+
+			"Taproot account to be merged",
+			traderKey.SerializeCompressed())
+	}
+
+	return account, nil
+}
+
+// RenewAccount updates the expiration of an open/expired account. This will
+// always require a signature from the auctioneer, even after the account has
+// expired, to ensure the auctioneer is aware the account is being renewed.
+func (m *manager) RenewAccount(ctx context.Context,
+	traderKey *btcec.PublicKey, newExpiry uint32,
+	feeRate chainfee.SatPerKWeight, bestHeight uint32,
+	newVersion Version) (*Account, *wire.MsgTx, error) {
+
+	account, spendWitnessType, err := m.validateRenewal(
+		traderKey, newExpiry, bestHeight, newVersion,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Determine the new account output after attempting the expiry update.
 	newAccountValue, err := valueAfterAccountUpdate(
 		account, nil, spendWitnessType, feeRate,
 	)
@@ -1465,6 +2244,82 @@ func (m *manager) RenewAccount(ctx context.Context,
 	return modifiedAccount, spendTx, nil
 }
 
+// QuoteAccountRenewal returns the expected fee rate and total miner fee for
+// renewing an open/expired account to the given expiry with the fee rate lnd
+// estimates for the given confTarget. Nothing is signed or broadcast.
+func (m *manager) QuoteAccountRenewal(ctx context.Context,
+	traderKey *btcec.PublicKey, newExpiry, confTarget, bestHeight uint32,
+	newVersion Version) (chainfee.SatPerKWeight, btcutil.Amount, error) {
+
+	account, spendWitnessType, err := m.validateRenewal(
+		traderKey, newExpiry, bestHeight, newVersion,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	feeRate, err := m.cfg.FeeEstimator.EstimateFeeRate(ctx, int32(confTarget))
+	if err != nil {
+		return 0, 0, fmt.Errorf("error estimating fee rate: %v", err)
+	}
+
+	// The renewal transaction only spends the account and re-creates it,
+	// so the fee is the difference between the current and new value. The
+	// account input is always spent with the witness of its current
+	// version, even if the account is upgraded during the renewal.
+	newAccountValue, err := valueAfterAccountUpdate(
+		account, nil, spendWitnessType, feeRate,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return feeRate, account.Value - newAccountValue, nil
+}
+
+// validateRenewal makes sure the account with the given trader key can be
+// renewed to the new expiry and version. The account and the witness type that
+// is used to spend it are returned.
+func (m *manager) validateRenewal(traderKey *btcec.PublicKey, newExpiry,
+	bestHeight uint32, newVersion Version) (*Account, witnessType, error) {
+
+	// The account can only have its expiry updated if it has confirmed
+	// and/or has expired.
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch account.State {
+	case StateOpen, StateExpired:
+	default:
+		return nil, 0, fmt.Errorf("account must be in either of %v "+
+			"to be renewed",
+			[]State{StateOpen, StateExpired})
+	}
+
+	// Validate the new expiry.
+	if err := validateAccountExpiry(newExpiry, bestHeight); err != nil {
+		return nil, 0, err
+	}
+
+	// Can't downgrade an account.
+	if newVersion < account.Version {
+		return nil, 0, fmt.Errorf("cannot downgrade account "+
+			"version to %s", newVersion)
+	}
+
+	// We'll always use the multisig spend path, even if the account is
+	// expired, to make sure the auctioneer is aware of the change. We
+	// achieve this by setting the best height to 0 which means our account
+	// is never seen as expired.
+	spendWitnessType := multiSigWitness
+	if account.Version >= VersionTaprootEnabled {
+		spendWitnessType = muSig2Taproot
+	}
+
+	return account, spendWitnessType, nil
+}
+
 // BumpAccountFee attempts to bump the fee of an account's most recent
 // transaction. This is done by locating an eligible output for lnd to CPFP,
 // otherwise the fee bump will not succeed. Further invocations of this call for
@@ -2161,6 +3016,45 @@ func valueAfterAccountUpdate(account *Account, outputs []*wire.TxOut,
 	return newAccountValue, nil
 }
 
+// checkAnchorReserve makes sure that funding the given amount from the backing
+// lnd node's wallet leaves enough funds in it to fee bump anchor channels. The
+// chain fees of the funding transaction aren't known yet, so they aren't taken
+// into account.
+func (m *manager) checkAnchorReserve(ctx context.Context,
+	fundingAmt btcutil.Amount) error {
+
+	if m.cfg.WalletReserve == nil {
+		return nil
+	}
+
+	balance, err := m.cfg.WalletReserve.ConfirmedBalance(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query wallet balance: %v", err)
+	}
+
+	reserve, err := m.cfg.WalletReserve.RequiredReserve(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query anchor reserve: %v", err)
+	}
+
+	remaining := balance - fundingAmt
+	if remaining >= reserve {
+		return nil
+	}
+
+	if m.cfg.IgnoreAnchorReserve {
+		log.Warnf("Funding %v leaves %v in the lnd wallet which is "+
+			"below the %v required to fee bump anchor channels",
+			fundingAmt, remaining, reserve)
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: funding %v would leave %v in the lnd wallet "+
+		"which is below the %v required to fee bump anchor channels",
+		ErrBelowAnchorReserve, fundingAmt, remaining, reserve)
+}
+
 // inputsForDeposit returns a list of inputs sources from the backing lnd node's
 // wallet which we can use to satisfy an account deposit. A closure to release
 // the inputs is also provided to use when coming across an unexpected failure.
@@ -2190,7 +3084,17 @@ func (m *manager) inputsForDeposit(ctx context.Context, account *Account,
 		Value:    int64(depositAmount + acctInputFee),
 		PkScript: newAccountOutput.PkScript,
 	}
-	tplPacket, err := psbt.New(nil, []*wire.TxOut{outputToFund}, 2, 0, nil)
+	inputs, err := m.selectCoins(
+		ctx, []*wire.TxOut{outputToFund}, 0, feeRate,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tplPacket, err := psbt.New(
+		inputs, []*wire.TxOut{outputToFund}, 2, 0,
+		make([]uint32, len(inputs)),
+	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating template PSBT: %v",
 			err)
@@ -2209,9 +3113,7 @@ func (m *manager) inputsForDeposit(ctx context.Context, account *Account,
 			},
 			MinConfs: 1,
 			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
-				SatPerVbyte: uint64(
-					feeRate.FeePerKVByte() / 1000,
-				),
+				SatPerVbyte: fundingSatPerVByte(feeRate),
 			},
 		},
 	)
@@ -2220,17 +3122,7 @@ func (m *manager) inputsForDeposit(ctx context.Context, account *Account,
 	}
 
 	releaseInputs := func() {
-		for _, coin := range lockedCoins {
-			var lockID wtxmgr.LockID
-			copy(lockID[:], coin.Id)
-
-			hash, _ := chainhash.NewHash(coin.Outpoint.TxidBytes)
-			op := wire.OutPoint{
-				Hash:  *hash,
-				Index: coin.Outpoint.OutputIndex,
-			}
-			_ = m.cfg.Wallet.ReleaseOutput(ctx, lockID, op)
-		}
+		m.releaseLockedCoins(ctx, lockedCoins)
 	}
 
 	// Due to a bug in lnd 0.14.2 up to 0.15.0 we can't use SignPsbt for
@@ -571,6 +571,406 @@ func TestNewAccountHappyFlow(t *testing.T) {
 	})
 }
 
+// TestExternalAccountFunding makes sure an account reserved for external
+// funding is only opened once a transaction paying the exact account output is
+// handed over.
+func TestExternalAccountFunding(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	account, err := h.manager.InitExternalAccount(
+		ctx, maxAccountValue, VersionTaprootEnabled,
+		bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.NoError(t, err)
+	require.Equal(t, StatePendingExternalFunding, account.State)
+	h.assertAccountExists(account)
+	h.assertAccountNotSubscribed(account.TraderKey.PubKey)
+
+	// No other account can be reserved while this one is waiting for its
+	// funding.
+	_, err = h.manager.InitAccount(
+		ctx, maxAccountValue, VersionTaprootEnabled,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.ErrorContains(t, err, "waiting for its external funding")
+
+	accountOutput, err := account.Output()
+	require.NoError(t, err)
+
+	signedInput := &wire.TxIn{Witness: wire.TxWitness{{0x01}}}
+	fundingTx := &wire.MsgTx{
+		Version: 2,
+		TxIn:    []*wire.TxIn{signedInput},
+		TxOut: []*wire.TxOut{{
+			Value:    1000,
+			PkScript: p2wpkh,
+		}, {
+			Value:    accountOutput.Value - 1,
+			PkScript: accountOutput.PkScript,
+		}},
+	}
+
+	// A transaction paying the wrong amount is refused.
+	_, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, fundingTx,
+	)
+	require.ErrorContains(t, err, "expected")
+
+	// So is one that isn't fully signed yet.
+	fundingTx.TxOut[1].Value = accountOutput.Value
+	fundingTx.TxIn = append(fundingTx.TxIn, &wire.TxIn{})
+	_, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, fundingTx,
+	)
+	require.ErrorContains(t, err, "input 1 of funding transaction")
+
+	// Once the transaction is correct, it is published and the account is
+	// waiting for its confirmation.
+	fundingTx.TxIn = []*wire.TxIn{signedInput}
+	account, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, fundingTx,
+	)
+	require.NoError(t, err)
+
+	select {
+	case tx := <-h.wallet.publishChan:
+		require.Equal(t, fundingTx.TxHash(), tx.TxHash())
+
+	case <-time.After(timeout):
+		t.Fatal("funding transaction not published")
+	}
+
+	require.Equal(t, StatePendingOpen, account.State)
+	require.Equal(t, wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: 1,
+	}, account.OutPoint)
+	h.assertAccountExists(account)
+
+	// The account can't be funded a second time.
+	_, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, fundingTx,
+	)
+	require.ErrorContains(t, err, "not waiting for an external funding")
+
+	// The confirmation opens the account like any other.
+	confHeight := uint32(bestHeight + 6)
+	h.notifier.confChan <- &chainntnfs.TxConfirmation{
+		BlockHeight: confHeight,
+	}
+	account.State = StateOpen
+	account.HeightHint = confHeight
+	h.assertAccountExists(account)
+	h.assertAccountSubscribed(account.TraderKey.PubKey)
+}
+
+// TestCancelExternalAccount makes sure an account waiting for its external
+// funding blocks new accounts that would get its reservation until it is
+// canceled, after which the next account reuses that reservation.
+func TestCancelExternalAccount(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	h.wallet.queueNextKey(testSecondTraderKeyDesc)
+	account, err := h.manager.InitExternalAccount(
+		ctx, maxAccountValue, VersionTaprootEnabled,
+		bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.NoError(t, err)
+
+	// The reservation of the waiting account can't be used for another
+	// account.
+	_, err = h.manager.InitAccount(
+		ctx, maxAccountValue, VersionTaprootEnabled,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.ErrorContains(t, err, "reservation is held by account")
+
+	// Once canceled, the account can't be funded anymore and its
+	// reservation can be used again.
+	account, err = h.manager.CancelExternalAccount(
+		ctx, account.TraderKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, StateExternalFundingCanceled, account.State)
+	h.assertAccountExists(account)
+
+	_, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, &wire.MsgTx{},
+	)
+	require.ErrorContains(t, err, "not waiting for an external funding")
+
+	_, err = h.manager.CancelExternalAccount(
+		ctx, account.TraderKey.PubKey,
+	)
+	require.ErrorContains(t, err, "not waiting for an external funding")
+
+	// The auctioneer hands out the reservation of the canceled account
+	// again, which the next account can now use with a new trader key.
+	newAccount := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+		VersionTaprootEnabled,
+	)
+	require.True(t, newAccount.AuctioneerKey.IsEqual(account.AuctioneerKey))
+	require.True(t, newAccount.BatchKey.IsEqual(account.BatchKey))
+	require.False(t, newAccount.TraderKey.PubKey.IsEqual(
+		account.TraderKey.PubKey,
+	))
+}
+
+// TestExternalAccountReservationScope makes sure an account waiting for its
+// external funding doesn't block new accounts that get a different
+// reservation.
+func TestExternalAccountReservationScope(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	h.wallet.queueNextKey(testSecondTraderKeyDesc)
+	_, err := h.manager.InitExternalAccount(
+		context.Background(), maxAccountValue, VersionTaprootEnabled,
+		bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.NoError(t, err)
+
+	h.auctioneer.setBatchKey(testAuctioneerKey)
+	h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+		VersionTaprootEnabled,
+	)
+}
+
+// TestPreviewAccountFunding makes sure a previewed account funding transaction
+// is only published once confirmed and matches the preview.
+func TestPreviewAccountFunding(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	account, packet, fee, err := h.manager.PreviewAccountFunding(
+		ctx, maxAccountValue, VersionTaprootEnabled,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.NoError(t, err)
+	require.Equal(t, StatePendingFundingPreview, account.State)
+	require.Equal(t, mockFundPsbtFee, fee)
+	h.assertAccountExists(account)
+
+	accountOutput, err := account.Output()
+	require.NoError(t, err)
+	require.Len(t, packet.UnsignedTx.TxOut, 1)
+	require.Equal(t, accountOutput, packet.UnsignedTx.TxOut[0])
+	previewTxHash := packet.UnsignedTx.TxHash()
+
+	// Nothing is published before the preview is confirmed.
+	select {
+	case <-h.wallet.publishChan:
+		t.Fatal("unexpected transaction published")
+
+	default:
+	}
+
+	// A previewed account can't be funded externally.
+	_, err = h.manager.FundExternalAccount(
+		ctx, account.TraderKey.PubKey, &wire.MsgTx{},
+	)
+	require.ErrorContains(t, err, "not waiting for an external funding")
+
+	// Confirming the preview leases its inputs again before signing.
+	account, err = h.manager.ConfirmAccountFunding(
+		ctx, account.TraderKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.Len(t, h.wallet.leasedOutputs, len(packet.UnsignedTx.TxIn))
+
+	select {
+	case tx := <-h.wallet.publishChan:
+		require.Equal(t, previewTxHash, tx.TxHash())
+		require.Equal(t, packet.UnsignedTx.TxOut, tx.TxOut)
+
+	case <-time.After(timeout):
+		t.Fatal("funding transaction not published")
+	}
+
+	require.Equal(t, StatePendingOpen, account.State)
+	require.Equal(t, wire.OutPoint{
+		Hash:  previewTxHash,
+		Index: 0,
+	}, account.OutPoint)
+	h.assertAccountExists(account)
+
+	// A preview can only be confirmed once.
+	_, err = h.manager.ConfirmAccountFunding(
+		ctx, account.TraderKey.PubKey,
+	)
+	require.ErrorContains(t, err, "not waiting for an external funding")
+}
+
+// TestPreviewAccountFundingRelease makes sure a funding preview releases its
+// account's reservation and inputs whenever it can't be confirmed anymore.
+func TestPreviewAccountFundingRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	preview := func(h *testHarness) *Account {
+		account, _, _, err := h.manager.PreviewAccountFunding(
+			ctx, maxAccountValue, VersionTaprootEnabled,
+			chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry,
+			bestHeight,
+		)
+		require.NoError(t, err)
+
+		return account
+	}
+	assertCanceled := func(h *testHarness, account *Account) {
+		StateModifier(StateExternalFundingCanceled)(account)
+		h.assertAccountExists(account)
+
+		// The reservation can be used by a new account again.
+		h.openAccount(
+			maxAccountValue, bestHeight+maxAccountExpiry,
+			bestHeight, VersionTaprootEnabled,
+		)
+	}
+
+	t.Run("funding fails", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.start()
+		defer h.stop()
+
+		h.wallet.fundPsbtErr = errors.New("insufficient funds")
+		h.wallet.queueNextKey(testSecondTraderKeyDesc)
+		_, _, _, err := h.manager.PreviewAccountFunding(
+			ctx, maxAccountValue, VersionTaprootEnabled,
+			chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry,
+			bestHeight,
+		)
+		require.ErrorContains(t, err, "insufficient funds")
+
+		account, err := h.store.Account(testSecondTraderKeyDesc.PubKey)
+		require.NoError(t, err)
+		require.Equal(t, StateExternalFundingCanceled, account.State)
+
+		h.wallet.fundPsbtErr = nil
+		h.openAccount(
+			maxAccountValue, bestHeight+maxAccountExpiry,
+			bestHeight, VersionTaprootEnabled,
+		)
+	})
+
+	t.Run("leases expire", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.start()
+		defer h.stop()
+
+		h.wallet.fundPsbtLeaseTime = time.Second
+		h.wallet.queueNextKey(testSecondTraderKeyDesc)
+		account := preview(h)
+
+		require.Eventually(t, func() bool {
+			return h.wallet.releasedOutputCount() == 1
+		}, 3*time.Second, 10*time.Millisecond)
+
+		_, err := h.manager.ConfirmAccountFunding(
+			ctx, account.TraderKey.PubKey,
+		)
+		require.ErrorContains(t, err, "not waiting for an external")
+		assertCanceled(h, account)
+	})
+
+	t.Run("inputs no longer available", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.start()
+		defer h.stop()
+
+		h.wallet.queueNextKey(testSecondTraderKeyDesc)
+		account := preview(h)
+
+		h.wallet.leaseErr = errors.New("output already locked")
+		_, err := h.manager.ConfirmAccountFunding(
+			ctx, account.TraderKey.PubKey,
+		)
+		require.ErrorContains(t, err, "output already locked")
+		require.Equal(t, 2, h.wallet.releasedOutputCount())
+
+		select {
+		case <-h.wallet.publishChan:
+			t.Fatal("unexpected transaction published")
+
+		default:
+		}
+
+		h.wallet.leaseErr = nil
+		assertCanceled(h, account)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.start()
+		defer h.stop()
+
+		h.wallet.queueNextKey(testSecondTraderKeyDesc)
+		account := preview(h)
+
+		_, err := h.manager.CancelExternalAccount(
+			ctx, account.TraderKey.PubKey,
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, h.wallet.releasedOutputCount())
+		assertCanceled(h, account)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.start()
+		defer h.stop()
+
+		h.wallet.queueNextKey(testSecondTraderKeyDesc)
+		account := preview(h)
+
+		h.restartManager()
+		assertCanceled(h, account)
+	})
+}
+
+// TestFundingSatPerVByte makes sure fee rates are rounded instead of truncated
+// when converting them for FundPsbt and never drop below the minimum.
+func TestFundingSatPerVByte(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		feeRate  chainfee.SatPerKWeight
+		expected uint64
+	}{
+		{0, minFundingSatPerVByte},
+		{chainfee.FeePerKwFloor, 1},
+		{chainfee.SatPerKVByte(1499).FeePerKWeight(), 1},
+		{chainfee.SatPerKVByte(1500).FeePerKWeight(), 2},
+		{chainfee.SatPerKVByte(4900).FeePerKWeight(), 5},
+		{chainfee.SatPerKVByte(20000).FeePerKWeight(), 20},
+	}
+	for _, tc := range testCases {
+		require.Equal(
+			t, tc.expected, fundingSatPerVByte(tc.feeRate),
+			"fee rate %v", tc.feeRate,
+		)
+	}
+}
+
 // TestResumeAccountAfterRestart ensures we're able to properly create a new
 // account even if we've shut down during the process.
 func TestResumeAccountAfterRestart(t *testing.T) {
@@ -884,6 +1284,75 @@ func TestAccountExpiration(t *testing.T) {
 	})
 }
 
+// TestQuoteAccountRenewal makes sure the renewal fee quote uses the fee rate
+// estimated by lnd and doesn't modify the account.
+func TestQuoteAccountRenewal(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = chainfee.SatPerKWeight(1000)
+
+	cases := []*testCase{
+		{
+			name:    "version 0",
+			version: VersionInitialNoVersion,
+
+			// A P2WSH account input and output weigh 607 WU.
+			fee: 607,
+		},
+		{
+			name:    "version 1",
+			version: VersionTaprootEnabled,
+
+			// A P2TR account input and output weigh 444 WU.
+			fee: 444,
+		},
+		{
+			name:        "downgrade not allowed",
+			version:     VersionTaprootEnabled,
+			newVersion:  VersionInitialNoVersion,
+			expectedErr: "cannot downgrade account version",
+		},
+		{
+			name:       "upgrade to version 1",
+			version:    VersionInitialNoVersion,
+			newVersion: VersionTaprootEnabled,
+
+			// The account input is still spent as a P2WSH
+			// account, so the renewal weighs 607 WU.
+			fee: 607,
+		},
+	}
+
+	runSubTests(t, cases, func(t *testing.T, h *testHarness, tc *testCase) {
+		account := h.openAccount(
+			maxAccountValue, bestHeight+maxAccountExpiry,
+			bestHeight, tc.version,
+		)
+		h.wallet.setFeeRate(feeRate)
+
+		newVersion := tc.newVersion
+		if tc.expectedErr == "" && newVersion < tc.version {
+			newVersion = tc.version
+		}
+
+		quotedFeeRate, fee, err := h.manager.QuoteAccountRenewal(
+			context.Background(), account.TraderKey.PubKey,
+			bestHeight+maxAccountExpiry-1, 6, bestHeight,
+			newVersion,
+		)
+		if tc.expectedErr != "" {
+			require.ErrorContains(t, err, tc.expectedErr)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, feeRate, quotedFeeRate)
+		require.Equal(t, tc.fee, fee)
+
+		// Quoting shouldn't have changed anything about the account.
+		h.assertAccountExists(account)
+	})
+}
+
 // TestAccountSpendBatchNotFinalized ensures that if a pending batch exists at
 // the time of an account spend, then its updates are applied to the account in
 // order to properly locate the latest account output.
@@ -1237,6 +1706,191 @@ func TestAccountDeposit(t *testing.T) {
 	})
 }
 
+// TestAccountSplit ensures that splitting an account funds a new account from
+// the existing one with a single transaction.
+func TestAccountSplit(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+		VersionTaprootEnabled,
+	)
+
+	// We can't split off more than the account holds.
+	_, _, _, err := h.manager.SplitAccount(
+		ctx, account.TraderKey.PubKey, account.Value,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.ErrorContains(t, err, "must be below the account value")
+
+	// If the account can't pay for the withdrawal, the new account that
+	// was already reserved is canceled again.
+	h.wallet.queueNextKey(testSecondTraderKeyDesc)
+	_, _, _, err = h.manager.SplitAccount(
+		ctx, account.TraderKey.PubKey, account.Value-1,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.Error(t, err)
+
+	canceledAccount, err := h.store.Account(testSecondTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, StateExternalFundingCanceled, canceledAccount.State)
+
+	// Split off a quarter of the account into a new account with its own
+	// trader key.
+	h.wallet.queueNextKey(testSecondTraderKeyDesc)
+	splitValue := account.Value / 4
+	modifiedAccount, newAccount, splitTx, err := h.manager.SplitAccount(
+		ctx, account.TraderKey.PubKey, splitValue,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.NoError(t, err)
+
+	select {
+	case tx := <-h.wallet.publishChan:
+		require.Equal(t, splitTx.TxHash(), tx.TxHash())
+
+	case <-time.After(timeout):
+		t.Fatal("split transaction not published")
+	}
+
+	// The transaction spends the existing account and creates both
+	// account outputs.
+	require.Len(t, splitTx.TxIn, 1)
+	require.Equal(t, account.OutPoint, splitTx.TxIn[0].PreviousOutPoint)
+	require.Len(t, splitTx.TxOut, 2)
+
+	var outputSum btcutil.Amount
+	for _, txOut := range splitTx.TxOut {
+		outputSum += btcutil.Amount(txOut.Value)
+	}
+	fee := account.Value - outputSum
+	require.Positive(t, fee)
+
+	// The existing account pays the transaction fee and waits for its
+	// update to confirm.
+	require.Equal(t, StatePendingUpdate, modifiedAccount.State)
+	require.Equal(t, account.Value-splitValue-fee, modifiedAccount.Value)
+	require.Equal(t, splitTx.TxHash(), modifiedAccount.OutPoint.Hash)
+	h.assertAccountExists(modifiedAccount)
+
+	// The new account holds exactly the split value and waits for its
+	// confirmation like any other new account.
+	require.True(t, newAccount.TraderKey.PubKey.IsEqual(testSecondTraderKey))
+	require.Equal(t, StatePendingOpen, newAccount.State)
+	require.Equal(t, splitValue, newAccount.Value)
+	require.Equal(t, account.Version, newAccount.Version)
+	require.Equal(t, splitTx.TxHash(), newAccount.OutPoint.Hash)
+	require.EqualValues(
+		t, splitValue, splitTx.TxOut[newAccount.OutPoint.Index].Value,
+	)
+	h.assertAccountExists(newAccount)
+
+	// The existing account can't be split again until its update
+	// confirmed.
+	_, _, _, err = h.manager.SplitAccount(
+		ctx, account.TraderKey.PubKey, splitValue,
+		chainfee.FeePerKwFloor, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	require.ErrorContains(t, err, "must be in")
+}
+
+// TestAccountMerge ensures that merging two accounts spends both of them in a
+// single transaction that only re-creates the account merged into.
+func TestAccountMerge(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	account := h.openAccount(
+		maxAccountValue/2, bestHeight+maxAccountExpiry, bestHeight,
+		VersionTaprootEnabled,
+	)
+	h.wallet.queueNextKey(testSecondTraderKeyDesc)
+	mergeAccount := h.openAccount(
+		maxAccountValue/4, bestHeight+maxAccountExpiry, bestHeight,
+		VersionTaprootEnabled,
+	)
+	mergeOutPoint := mergeAccount.OutPoint
+
+	// An account can't be merged into itself.
+	_, _, err := h.manager.MergeAccounts(
+		ctx, account.TraderKey.PubKey, account.TraderKey.PubKey,
+		chainfee.FeePerKwFloor, bestHeight,
+	)
+	require.ErrorContains(t, err, "into itself")
+
+	modifiedAccount, mergeTx, err := h.manager.MergeAccounts(
+		ctx, account.TraderKey.PubKey, mergeAccount.TraderKey.PubKey,
+		chainfee.FeePerKwFloor, bestHeight,
+	)
+	require.NoError(t, err)
+
+	select {
+	case tx := <-h.wallet.publishChan:
+		require.Equal(t, mergeTx.TxHash(), tx.TxHash())
+
+	case <-time.After(timeout):
+		t.Fatal("merge transaction not published")
+	}
+
+	// Both account inputs are spent through the MuSig2 key spend path and
+	// only a single account output is created.
+	require.Len(t, mergeTx.TxIn, 2)
+	for _, txIn := range mergeTx.TxIn {
+		require.Len(t, txIn.Witness, 1)
+	}
+	require.Len(t, mergeTx.TxOut, 1)
+
+	newValue := btcutil.Amount(mergeTx.TxOut[0].Value)
+	fee := account.Value + mergeAccount.Value - newValue
+	require.Positive(t, fee)
+
+	// The auctioneer saw the merged account as an additional input of the
+	// modification and the new account output as the only output of the
+	// merged account's close.
+	h.auctioneer.mu.Lock()
+	require.Len(t, h.auctioneer.inputsReceived, 1)
+	require.Equal(
+		t, mergeOutPoint, h.auctioneer.inputsReceived[0].PreviousOutPoint,
+	)
+	require.Len(t, h.auctioneer.outputsReceived, 1)
+	require.Equal(t, *mergeTx.TxOut[0], h.auctioneer.outputsReceived[0])
+	h.auctioneer.mu.Unlock()
+
+	// The account merged into holds the funds of both accounts minus the
+	// transaction fee.
+	require.Equal(t, StatePendingUpdate, modifiedAccount.State)
+	require.Equal(t, newValue, modifiedAccount.Value)
+	require.Equal(t, wire.OutPoint{
+		Hash:  mergeTx.TxHash(),
+		Index: 0,
+	}, modifiedAccount.OutPoint)
+	h.assertAccountExists(modifiedAccount)
+
+	// While the merged account is consumed.
+	mergeAccount.Value = 0
+	mergeAccount.State = StatePendingClosed
+	mergeAccount.HeightHint = bestHeight
+	mergeAccount.LatestTx = mergeTx
+	h.assertAccountExists(mergeAccount)
+
+	// Neither account can be merged again until the merge confirmed.
+	_, _, err = h.manager.MergeAccounts(
+		ctx, account.TraderKey.PubKey, mergeAccount.TraderKey.PubKey,
+		chainfee.FeePerKwFloor, bestHeight,
+	)
+	require.ErrorContains(t, err, "must be in")
+}
+
 // TestAccountConsecutiveBatches ensures that we can process an account update
 // through multiple consecutive batches that only confirm after we've already
 // updated our database state.
@@ -1484,3 +2138,88 @@ func TestParseTxLabel(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 }
+
+// mockWalletReserve is a WalletReserve implementation that returns static
+// values.
+type mockWalletReserve struct {
+	balance btcutil.Amount
+	reserve btcutil.Amount
+}
+
+func (r *mockWalletReserve) ConfirmedBalance(
+	context.Context) (btcutil.Amount, error) {
+
+	return r.balance, nil
+}
+
+func (r *mockWalletReserve) RequiredReserve(
+	context.Context) (btcutil.Amount, error) {
+
+	return r.reserve, nil
+}
+
+var checkAnchorReserveTestCases = []struct {
+	name          string
+	walletReserve WalletReserve
+	ignore        bool
+	fundingAmt    btcutil.Amount
+	expectedErr   string
+}{{
+	name:       "no wallet reserve available",
+	fundingAmt: btcutil.SatoshiPerBitcoin,
+}, {
+	name: "enough left for reserve",
+	walletReserve: &mockWalletReserve{
+		balance: 200_000,
+		reserve: 100_000,
+	},
+	fundingAmt: 100_000,
+}, {
+	name: "funding drops below reserve",
+	walletReserve: &mockWalletReserve{
+		balance: 200_000,
+		reserve: 100_000,
+	},
+	fundingAmt:  100_001,
+	expectedErr: "below the 0.001 BTC required to fee bump anchor",
+}, {
+	name: "funding drops below reserve but ignored",
+	walletReserve: &mockWalletReserve{
+		balance: 200_000,
+		reserve: 100_000,
+	},
+	ignore:     true,
+	fundingAmt: 150_000,
+}}
+
+// TestCheckAnchorReserve makes sure funding an account is refused if it would
+// leave less than the anchor reserve in the lnd wallet, unless overridden.
+func TestCheckAnchorReserve(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range checkAnchorReserveTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &manager{
+				cfg: ManagerConfig{
+					WalletReserve:       tc.walletReserve,
+					IgnoreAnchorReserve: tc.ignore,
+				},
+			}
+
+			err := m.checkAnchorReserve(
+				context.Background(), tc.fundingAmt,
+			)
+			if tc.expectedErr != "" {
+				require.ErrorIs(t, err, ErrBelowAnchorReserve)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	v2 "github.com/btcsuite/btcd/btcec/v2"
 	btcutil "github.com/btcsuite/btcd/btcutil"
+	psbt "github.com/btcsuite/btcd/btcutil/psbt"
 	wire "github.com/btcsuite/btcd/wire"
 	wtxmgr "github.com/btcsuite/btcwallet/wtxmgr"
 	gomock "github.com/golang/mock/gomock"
@@ -284,6 +285,44 @@ func (mr *MockTxSourceMockRecorder) ListTransactions(ctx, startHeight, endHeight
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockTxSource)(nil).ListTransactions), ctx, startHeight, endHeight)
 }
 
+// MockFeeEstimator is a mock of FeeEstimator interface.
+type MockFeeEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeEstimatorMockRecorder
+}
+
+// MockFeeEstimatorMockRecorder is the mock recorder for MockFeeEstimator.
+type MockFeeEstimatorMockRecorder struct {
+	mock *MockFeeEstimator
+}
+
+// NewMockFeeEstimator creates a new mock instance.
+func NewMockFeeEstimator(ctrl *gomock.Controller) *MockFeeEstimator {
+	mock := &MockFeeEstimator{ctrl: ctrl}
+	mock.recorder = &MockFeeEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeEstimator) EXPECT() *MockFeeEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateFeeRate mocks base method.
+func (m *MockFeeEstimator) EstimateFeeRate(ctx context.Context, confTarget int32) (chainfee.SatPerKWeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateFeeRate", ctx, confTarget)
+	ret0, _ := ret[0].(chainfee.SatPerKWeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateFeeRate indicates an expected call of EstimateFeeRate.
+func (mr *MockFeeEstimatorMockRecorder) EstimateFeeRate(ctx, confTarget interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFeeRate", reflect.TypeOf((*MockFeeEstimator)(nil).EstimateFeeRate), ctx, confTarget)
+}
+
 // MockTxFeeEstimator is a mock of TxFeeEstimator interface.
 type MockTxFeeEstimator struct {
 	ctrl     *gomock.Controller
@@ -322,6 +361,59 @@ func (mr *MockTxFeeEstimatorMockRecorder) EstimateFeeToP2WSH(ctx, amt, confTarge
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFeeToP2WSH", reflect.TypeOf((*MockTxFeeEstimator)(nil).EstimateFeeToP2WSH), ctx, amt, confTarget)
 }
 
+// MockWalletReserve is a mock of WalletReserve interface.
+type MockWalletReserve struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletReserveMockRecorder
+}
+
+// MockWalletReserveMockRecorder is the mock recorder for MockWalletReserve.
+type MockWalletReserveMockRecorder struct {
+	mock *MockWalletReserve
+}
+
+// NewMockWalletReserve creates a new mock instance.
+func NewMockWalletReserve(ctrl *gomock.Controller) *MockWalletReserve {
+	mock := &MockWalletReserve{ctrl: ctrl}
+	mock.recorder = &MockWalletReserveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletReserve) EXPECT() *MockWalletReserveMockRecorder {
+	return m.recorder
+}
+
+// ConfirmedBalance mocks base method.
+func (m *MockWalletReserve) ConfirmedBalance(ctx context.Context) (btcutil.Amount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmedBalance", ctx)
+	ret0, _ := ret[0].(btcutil.Amount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmedBalance indicates an expected call of ConfirmedBalance.
+func (mr *MockWalletReserveMockRecorder) ConfirmedBalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmedBalance", reflect.TypeOf((*MockWalletReserve)(nil).ConfirmedBalance), ctx)
+}
+
+// RequiredReserve mocks base method.
+func (m *MockWalletReserve) RequiredReserve(ctx context.Context) (btcutil.Amount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequiredReserve", ctx)
+	ret0, _ := ret[0].(btcutil.Amount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequiredReserve indicates an expected call of RequiredReserve.
+func (mr *MockWalletReserveMockRecorder) RequiredReserve(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiredReserve", reflect.TypeOf((*MockWalletReserve)(nil).RequiredReserve), ctx)
+}
+
 // MockFeeExpr is a mock of FeeExpr interface.
 type MockFeeExpr struct {
 	ctrl     *gomock.Controller
@@ -397,6 +489,21 @@ func (mr *MockManagerMockRecorder) BumpAccountFee(ctx, traderKey, newFeeRate int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BumpAccountFee", reflect.TypeOf((*MockManager)(nil).BumpAccountFee), ctx, traderKey, newFeeRate)
 }
 
+// CancelExternalAccount mocks base method.
+func (m *MockManager) CancelExternalAccount(ctx context.Context, traderKey *v2.PublicKey) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelExternalAccount", ctx, traderKey)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelExternalAccount indicates an expected call of CancelExternalAccount.
+func (mr *MockManagerMockRecorder) CancelExternalAccount(ctx, traderKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelExternalAccount", reflect.TypeOf((*MockManager)(nil).CancelExternalAccount), ctx, traderKey)
+}
+
 // CloseAccount mocks base method.
 func (m *MockManager) CloseAccount(ctx context.Context, traderKey *v2.PublicKey, feeExpr FeeExpr, bestHeight uint32) (*wire.MsgTx, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +519,21 @@ func (mr *MockManagerMockRecorder) CloseAccount(ctx, traderKey, feeExpr, bestHei
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockManager)(nil).CloseAccount), ctx, traderKey, feeExpr, bestHeight)
 }
 
+// ConfirmAccountFunding mocks base method.
+func (m *MockManager) ConfirmAccountFunding(ctx context.Context, traderKey *v2.PublicKey) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmAccountFunding", ctx, traderKey)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmAccountFunding indicates an expected call of ConfirmAccountFunding.
+func (mr *MockManagerMockRecorder) ConfirmAccountFunding(ctx, traderKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmAccountFunding", reflect.TypeOf((*MockManager)(nil).ConfirmAccountFunding), ctx, traderKey)
+}
+
 // DepositAccount mocks base method.
 func (m *MockManager) DepositAccount(ctx context.Context, traderKey *v2.PublicKey, depositAmount btcutil.Amount, feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32, newVersion Version) (*Account, *wire.MsgTx, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +550,21 @@ func (mr *MockManagerMockRecorder) DepositAccount(ctx, traderKey, depositAmount,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositAccount", reflect.TypeOf((*MockManager)(nil).DepositAccount), ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight, newVersion)
 }
 
+// FundExternalAccount mocks base method.
+func (m *MockManager) FundExternalAccount(ctx context.Context, traderKey *v2.PublicKey, tx *wire.MsgTx) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundExternalAccount", ctx, traderKey, tx)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FundExternalAccount indicates an expected call of FundExternalAccount.
+func (mr *MockManagerMockRecorder) FundExternalAccount(ctx, traderKey, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundExternalAccount", reflect.TypeOf((*MockManager)(nil).FundExternalAccount), ctx, traderKey, tx)
+}
+
 // HandleAccountConf mocks base method.
 func (m *MockManager) HandleAccountConf(traderKey *v2.PublicKey, confDetails *chainntnfs.TxConfirmation) error {
 	m.ctrl.T.Helper()
@@ -485,6 +622,54 @@ func (mr *MockManagerMockRecorder) InitAccount(ctx, value, version, feeRate, exp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAccount", reflect.TypeOf((*MockManager)(nil).InitAccount), ctx, value, version, feeRate, expiry, bestHeight)
 }
 
+// InitExternalAccount mocks base method.
+func (m *MockManager) InitExternalAccount(ctx context.Context, value btcutil.Amount, version Version, expiry, bestHeight uint32) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitExternalAccount", ctx, value, version, expiry, bestHeight)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitExternalAccount indicates an expected call of InitExternalAccount.
+func (mr *MockManagerMockRecorder) InitExternalAccount(ctx, value, version, expiry, bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitExternalAccount", reflect.TypeOf((*MockManager)(nil).InitExternalAccount), ctx, value, version, expiry, bestHeight)
+}
+
+// MergeAccounts mocks base method.
+func (m *MockManager) MergeAccounts(ctx context.Context, traderKey, mergeTraderKey *v2.PublicKey, feeRate chainfee.SatPerKWeight, bestHeight uint32) (*Account, *wire.MsgTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeAccounts", ctx, traderKey, mergeTraderKey, feeRate, bestHeight)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(*wire.MsgTx)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MergeAccounts indicates an expected call of MergeAccounts.
+func (mr *MockManagerMockRecorder) MergeAccounts(ctx, traderKey, mergeTraderKey, feeRate, bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeAccounts", reflect.TypeOf((*MockManager)(nil).MergeAccounts), ctx, traderKey, mergeTraderKey, feeRate, bestHeight)
+}
+
+// PreviewAccountFunding mocks base method.
+func (m *MockManager) PreviewAccountFunding(ctx context.Context, value btcutil.Amount, version Version, feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32) (*Account, *psbt.Packet, btcutil.Amount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewAccountFunding", ctx, value, version, feeRate, expiry, bestHeight)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(*psbt.Packet)
+	ret2, _ := ret[2].(btcutil.Amount)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// PreviewAccountFunding indicates an expected call of PreviewAccountFunding.
+func (mr *MockManagerMockRecorder) PreviewAccountFunding(ctx, value, version, feeRate, expiry, bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewAccountFunding", reflect.TypeOf((*MockManager)(nil).PreviewAccountFunding), ctx, value, version, feeRate, expiry, bestHeight)
+}
+
 // QuoteAccount mocks base method.
 func (m *MockManager) QuoteAccount(ctx context.Context, value btcutil.Amount, confTarget uint32) (chainfee.SatPerKWeight, btcutil.Amount, error) {
 	m.ctrl.T.Helper()
@@ -501,6 +686,22 @@ func (mr *MockManagerMockRecorder) QuoteAccount(ctx, value, confTarget interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAccount", reflect.TypeOf((*MockManager)(nil).QuoteAccount), ctx, value, confTarget)
 }
 
+// QuoteAccountRenewal mocks base method.
+func (m *MockManager) QuoteAccountRenewal(ctx context.Context, traderKey *v2.PublicKey, newExpiry, confTarget, bestHeight uint32, newVersion Version) (chainfee.SatPerKWeight, btcutil.Amount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAccountRenewal", ctx, traderKey, newExpiry, confTarget, bestHeight, newVersion)
+	ret0, _ := ret[0].(chainfee.SatPerKWeight)
+	ret1, _ := ret[1].(btcutil.Amount)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QuoteAccountRenewal indicates an expected call of QuoteAccountRenewal.
+func (mr *MockManagerMockRecorder) QuoteAccountRenewal(ctx, traderKey, newExpiry, confTarget, bestHeight, newVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAccountRenewal", reflect.TypeOf((*MockManager)(nil).QuoteAccountRenewal), ctx, traderKey, newExpiry, confTarget, bestHeight, newVersion)
+}
+
 // RecoverAccount mocks base method.
 func (m *MockManager) RecoverAccount(ctx context.Context, account *Account) error {
 	m.ctrl.T.Helper()
@@ -531,6 +732,23 @@ func (mr *MockManagerMockRecorder) RenewAccount(ctx, traderKey, newExpiry, feeRa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccount", reflect.TypeOf((*MockManager)(nil).RenewAccount), ctx, traderKey, newExpiry, feeRate, bestHeight, newVersion)
 }
 
+// SplitAccount mocks base method.
+func (m *MockManager) SplitAccount(ctx context.Context, traderKey *v2.PublicKey, splitValue btcutil.Amount, feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32) (*Account, *Account, *wire.MsgTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitAccount", ctx, traderKey, splitValue, feeRate, expiry, bestHeight)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(*Account)
+	ret2, _ := ret[2].(*wire.MsgTx)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SplitAccount indicates an expected call of SplitAccount.
+func (mr *MockManagerMockRecorder) SplitAccount(ctx, traderKey, splitValue, feeRate, expiry, bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitAccount", reflect.TypeOf((*MockManager)(nil).SplitAccount), ctx, traderKey, splitValue, feeRate, expiry, bestHeight)
+}
+
 // Start mocks base method.
 func (m *MockManager) Start() error {
 	m.ctrl.T.Helper()
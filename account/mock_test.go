@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -25,10 +27,12 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -46,6 +50,17 @@ var (
 		PubKey: testTraderKey,
 	}
 
+	testRawSecondTraderKey, _ = hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	testSecondTraderKey, _    = btcec.ParsePubKey(testRawSecondTraderKey)
+
+	testSecondTraderKeyDesc = &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: poolscript.AccountKeyFamily,
+			Index:  1,
+		},
+		PubKey: testSecondTraderKey,
+	}
+
 	testRawBatchKey, _ = hex.DecodeString("02824d0cbac65e01712124c50ff2cc74ce22851d7b444c1bf2ae66afefb8eaf27f")
 	testBatchKey, _    = btcec.ParsePubKey(testRawBatchKey)
 
@@ -168,6 +183,10 @@ type mockAuctioneer struct {
 	outputsReceived     []wire.TxOut
 	noncesReceived      []byte
 	prevOutputsReceived []*wire.TxOut
+
+	// batchKey overwrites the initial batch key of the reservations that
+	// are handed out if set.
+	batchKey *btcec.PublicKey
 }
 
 var _ Auctioneer = (*mockAuctioneer)(nil)
@@ -182,12 +201,27 @@ func (a *mockAuctioneer) ReserveAccount(context.Context,
 	btcutil.Amount, uint32, *btcec.PublicKey, Version) (*Reservation,
 	error) {
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	batchKey := testBatchKey
+	if a.batchKey != nil {
+		batchKey = a.batchKey
+	}
+
 	return &Reservation{
 		AuctioneerKey:   testAuctioneerKey,
-		InitialBatchKey: testBatchKey,
+		InitialBatchKey: batchKey,
 	}, nil
 }
 
+func (a *mockAuctioneer) setBatchKey(batchKey *btcec.PublicKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.batchKey = batchKey
+}
+
 func (a *mockAuctioneer) InitAccount(context.Context, *Account) error {
 	return nil
 }
@@ -242,6 +276,7 @@ type mockWallet struct {
 	lndclient.SignerClient
 	sync.Mutex
 
+	nextKeys              []*keychain.KeyDescriptor
 	txs                   []lndclient.Transaction
 	publishChan           chan *wire.MsgTx
 	muSig2Sessions        map[input.MuSig2SessionID]*input.MuSig2SessionInfo
@@ -249,11 +284,25 @@ type mockWallet struct {
 	utxos                 []*lnwallet.Utxo
 	fundPsbt              *psbt.Packet
 	fundPsbtChangeIdx     int32
+	fundPsbtReqs          []*walletrpc.FundPsbtRequest
+	fundPsbtErr           error
+	fundPsbtLeaseTime     time.Duration
+	leaseErr              error
+	publishErr            error
+	leasedOutputs         []wire.OutPoint
+	releasedOutputs       []wire.OutPoint
+	feeRate               chainfee.SatPerKWeight
 
 	sendOutputs func(context.Context, []*wire.TxOut,
 		chainfee.SatPerKWeight) (*wire.MsgTx, error)
 }
 
+// mockFundPsbtFee is the fee the mock wallet pays when funding a template PSBT.
+const mockFundPsbtFee = btcutil.Amount(1234)
+
+// mockFundPsbtLockID is the lock ID the mock wallet leases funded inputs with.
+var mockFundPsbtLockID = wtxmgr.LockID{9}
+
 func newMockWallet() *mockWallet {
 	return &mockWallet{
 		publishChan: make(chan *wire.MsgTx, 1),
@@ -269,9 +318,27 @@ func newMockWallet() *mockWallet {
 func (w *mockWallet) DeriveNextKey(ctx context.Context,
 	family int32) (*keychain.KeyDescriptor, error) {
 
+	w.Lock()
+	defer w.Unlock()
+
+	// Keys queued by a test are handed out first, afterwards we always
+	// return the same key.
+	if len(w.nextKeys) > 0 {
+		keyDesc := w.nextKeys[0]
+		w.nextKeys = w.nextKeys[1:]
+		return keyDesc, nil
+	}
+
 	return testTraderKeyDesc, nil
 }
 
+func (w *mockWallet) queueNextKey(keyDesc *keychain.KeyDescriptor) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.nextKeys = append(w.nextKeys, keyDesc)
+}
+
 func (w *mockWallet) DeriveSharedKey(ctx context.Context,
 	ephemeralKey *btcec.PublicKey,
 	keyLocator *keychain.KeyLocator) ([32]byte, error) {
@@ -282,6 +349,10 @@ func (w *mockWallet) DeriveSharedKey(ctx context.Context,
 func (w *mockWallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx,
 	label string) error {
 
+	if w.publishErr != nil {
+		return w.publishErr
+	}
+
 	w.publishChan <- tx
 	return nil
 }
@@ -336,15 +407,36 @@ func (w *mockWallet) ListUnspent(_ context.Context, _, _ int32) (
 func (w *mockWallet) LeaseOutput(_ context.Context, lockID wtxmgr.LockID,
 	op wire.OutPoint, _ time.Duration) (time.Time, error) {
 
+	w.Lock()
+	defer w.Unlock()
+
+	if w.leaseErr != nil {
+		return time.Time{}, w.leaseErr
+	}
+	w.leasedOutputs = append(w.leasedOutputs, op)
+
 	return time.Now().Add(10 * time.Minute), nil
 }
 
 func (w *mockWallet) ReleaseOutput(_ context.Context, lockID wtxmgr.LockID,
 	op wire.OutPoint) error {
 
+	w.Lock()
+	defer w.Unlock()
+
+	w.releasedOutputs = append(w.releasedOutputs, op)
+
 	return nil
 }
 
+// releasedOutputCount returns the number of outputs that were released.
+func (w *mockWallet) releasedOutputCount() int {
+	w.Lock()
+	defer w.Unlock()
+
+	return len(w.releasedOutputs)
+}
+
 func (w *mockWallet) SignOutputRaw(context.Context, *wire.MsgTx,
 	[]*lndclient.SignDescriptor, []*wire.TxOut) ([][]byte, error) {
 
@@ -366,9 +458,23 @@ func (w *mockWallet) ComputeInputScript(context.Context, *wire.MsgTx,
 func (w *mockWallet) EstimateFeeRate(_ context.Context,
 	_ int32) (chainfee.SatPerKWeight, error) {
 
+	w.Lock()
+	defer w.Unlock()
+
+	if w.feeRate != 0 {
+		return w.feeRate, nil
+	}
+
 	return chainfee.FeePerKwFloor, nil
 }
 
+func (w *mockWallet) setFeeRate(feeRate chainfee.SatPerKWeight) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.feeRate = feeRate
+}
+
 func (w *mockWallet) EstimateFeeToP2WSH(_ context.Context, _ btcutil.Amount,
 	_ int32) (btcutil.Amount, error) {
 
@@ -379,7 +485,60 @@ func (w *mockWallet) FundPsbt(_ context.Context,
 	req *walletrpc.FundPsbtRequest) (*psbt.Packet, int32,
 	[]*walletrpc.UtxoLease, error) {
 
-	return w.fundPsbt, w.fundPsbtChangeIdx, nil, nil
+	w.Lock()
+	w.fundPsbtReqs = append(w.fundPsbtReqs, req)
+	fundPsbtErr := w.fundPsbtErr
+	leaseTime := w.fundPsbtLeaseTime
+	w.Unlock()
+
+	if fundPsbtErr != nil {
+		return nil, 0, nil, fundPsbtErr
+	}
+
+	if w.fundPsbt != nil {
+		return w.fundPsbt, w.fundPsbtChangeIdx, nil, nil
+	}
+
+	// Without a prepared packet, we fund the template with a single leased
+	// wallet input that pays mockFundPsbtFee and already carries a
+	// signature, as the FinalizePsbt mock doesn't create any.
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.GetPsbt()), false,
+	)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	var outputSum int64
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		outputSum += txOut.Value
+	}
+	packet.UnsignedTx.TxIn = append(packet.UnsignedTx.TxIn, &wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+	})
+	packet.Inputs = append(packet.Inputs, psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    outputSum + int64(mockFundPsbtFee),
+			PkScript: p2wpkh,
+		},
+		PartialSigs: []*psbt.PartialSig{{
+			Signature: []byte{1, 2, 3},
+		}},
+	})
+
+	if leaseTime == 0 {
+		leaseTime = 10 * time.Minute
+	}
+	lockedCoins := []*walletrpc.UtxoLease{{
+		Id: mockFundPsbtLockID[:],
+		Outpoint: &lnrpc.OutPoint{
+			TxidBytes:   make([]byte, 32),
+			OutputIndex: 1,
+		},
+		Expiration: uint64(time.Now().Add(leaseTime).Unix()),
+	}}
+
+	return packet, -1, lockedCoins, nil
 }
 
 func (w *mockWallet) SignPsbt(_ context.Context,
@@ -563,6 +722,10 @@ type mockChainNotifier struct {
 	spendChan chan *chainntnfs.SpendDetail
 	blockChan chan int32
 	errChan   chan error
+
+	// numBlockRegistrations is the number of block notification
+	// registrations. It must be accessed atomically.
+	numBlockRegistrations uint32
 }
 
 func newMockChainNotifier() *mockChainNotifier {
@@ -591,6 +754,8 @@ func (n *mockChainNotifier) RegisterSpendNtfn(ctx context.Context,
 func (n *mockChainNotifier) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
+	atomic.AddUint32(&n.numBlockRegistrations, 1)
+
 	// Mimic the actual ChainNotifier by sending a notification upon
 	// registration.
 	go func() {
@@ -599,3 +764,16 @@ func (n *mockChainNotifier) RegisterBlockEpochNtfn(
 
 	return n.blockChan, n.errChan, nil
 }
+
+// failBlockEpochNtfn fails the current block notification registration, as
+// it happens when lnd is restarted, and waits for the subscriber to register
+// again.
+func (n *mockChainNotifier) failBlockEpochNtfn(t *testing.T) {
+	registrations := atomic.LoadUint32(&n.numBlockRegistrations)
+	n.errChan <- errors.New("lnd restarted")
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&n.numBlockRegistrations) >
+			registrations
+	}, timeout, 10*time.Millisecond)
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+var (
+	// ErrPolicyMaxOrders is returned if a new order would exceed the
+	// maximum number of active orders an account's policy allows.
+	ErrPolicyMaxOrders = errors.New("account policy maximum number of " +
+		"active orders reached")
+
+	// ErrPolicyMaxOutstanding is returned if a new order would exceed the
+	// maximum amount an account's policy allows to be committed to active
+	// orders.
+	ErrPolicyMaxOutstanding = errors.New("account policy maximum " +
+		"outstanding amount exceeded")
+)
+
+// Policy is a set of spending limits that is attached to an individual account
+// and enforced when submitting orders backed by it. A limit of zero means the
+// limit isn't enforced.
+type Policy struct {
+	// MaxOrders is the maximum number of orders backed by the account that
+	// can be active at the same time.
+	MaxOrders uint32
+
+	// MaxOutstanding is the maximum amount that can be committed to the
+	// unfilled part of all active orders backed by the account.
+	MaxOutstanding btcutil.Amount
+}
+
+// IsEmpty returns true if the policy doesn't enforce any limits.
+func (p *Policy) IsEmpty() bool {
+	return p.MaxOrders == 0 && p.MaxOutstanding == 0
+}
+
+// CheckOrder makes sure a new order of the given amount can be added to an
+// account that already backs numActive orders with the given outstanding
+// amount.
+func (p *Policy) CheckOrder(numActive int, outstanding,
+	amt btcutil.Amount) error {
+
+	if p.MaxOrders > 0 && numActive >= int(p.MaxOrders) {
+		return fmt.Errorf("%w: %d orders allowed", ErrPolicyMaxOrders,
+			p.MaxOrders)
+	}
+
+	if p.MaxOutstanding > 0 && outstanding+amt > p.MaxOutstanding {
+		return fmt.Errorf("%w: %v already committed, order amount %v, "+
+			"maximum %v", ErrPolicyMaxOutstanding, outstanding, amt,
+			p.MaxOutstanding)
+	}
+
+	return nil
+}
@@ -110,6 +110,15 @@ type RecoveryConfig struct {
 	// Number of accounts that we are trying to find.
 	AccountTarget uint32
 
+	// FirstKeyIndex is the index of the first account key that is derived
+	// from lnd's key ring and checked during recovery.
+	FirstKeyIndex uint32
+
+	// KeyWindow is the number of successive account keys, starting at
+	// FirstKeyIndex, that are derived and checked during recovery. If this
+	// is zero, AccountTarget keys are derived.
+	KeyWindow uint32
+
 	// FirstBlock block marks the initial height for our search.
 	FirstBlock uint32
 
@@ -123,9 +132,10 @@ type RecoveryConfig struct {
 	// the btcd/bitcoind backend.
 	BitcoinConfig *BitcoinConfig
 
-	// bitcoinClient is the rpc client used to interact with the bitcoin
-	// backend.
-	bitcoinClient *rpcclient.Client
+	// ChainSource is used to fetch the blocks that are scanned for account
+	// modifications. If this is nil, a connection to the backend described
+	// by BitcoinConfig is established instead.
+	ChainSource ChainSource
 
 	// Transactions is a list of all transactions that the lnd wallet is
 	// currently aware of.
@@ -150,6 +160,89 @@ type RecoveryConfig struct {
 	Quit chan struct{}
 }
 
+// ChainSource is the interface the recovery process uses to read blocks from
+// the chain when following the on-chain footprint of accounts.
+type ChainSource interface {
+	// BestHeight returns the height of the current best block.
+	BestHeight() (int64, error)
+
+	// BlockTransactions returns all transactions of the block with the
+	// given height that are relevant for the recovery.
+	BlockTransactions(height int64) ([]*wire.MsgTx, error)
+}
+
+// bitcoinChainSource is a ChainSource that is backed by a full bitcoind/btcd
+// node.
+type bitcoinChainSource struct {
+	client *rpcclient.Client
+}
+
+// A compile-time check to make sure bitcoinChainSource implements the
+// ChainSource interface.
+var _ ChainSource = (*bitcoinChainSource)(nil)
+
+// BestHeight returns the height of the current best block.
+func (b *bitcoinChainSource) BestHeight() (int64, error) {
+	return b.client.GetBlockCount()
+}
+
+// BlockTransactions returns all transactions of the block with the given
+// height.
+func (b *bitcoinChainSource) BlockTransactions(height int64) ([]*wire.MsgTx,
+	error) {
+
+	return getBlockTxs(b.client, height)
+}
+
+// LndChainSource is a ChainSource that only knows about the transactions lnd's
+// wallet is aware of. This allows recovering accounts without access to a
+// full bitcoin node, as long as all account modifications involved the lnd
+// wallet (which is the case for all deposits, withdrawals and batches that
+// opened channels).
+type LndChainSource struct {
+	bestHeight int64
+	blockTxs   map[int64][]*wire.MsgTx
+}
+
+// A compile-time check to make sure LndChainSource implements the ChainSource
+// interface.
+var _ ChainSource = (*LndChainSource)(nil)
+
+// NewLndChainSource creates a new chain source from the list of transactions
+// lnd's wallet knows of and the current best height. Unconfirmed transactions
+// are ignored.
+func NewLndChainSource(bestHeight int64,
+	txs []lndclient.Transaction) *LndChainSource {
+
+	blockTxs := make(map[int64][]*wire.MsgTx)
+	for _, tx := range txs {
+		if tx.Confirmations <= 0 || tx.Tx == nil {
+			continue
+		}
+
+		height := bestHeight - int64(tx.Confirmations) + 1
+		blockTxs[height] = append(blockTxs[height], tx.Tx)
+	}
+
+	return &LndChainSource{
+		bestHeight: bestHeight,
+		blockTxs:   blockTxs,
+	}
+}
+
+// BestHeight returns the height of the current best block.
+func (l *LndChainSource) BestHeight() (int64, error) {
+	return l.bestHeight, nil
+}
+
+// BlockTransactions returns all wallet transactions that were confirmed in the
+// block with the given height.
+func (l *LndChainSource) BlockTransactions(height int64) ([]*wire.MsgTx,
+	error) {
+
+	return l.blockTxs[height], nil
+}
+
 // getBitcoinConn returns a new rpc client for the btc backend node.
 func getBitcoinConn(cfg *BitcoinConfig) (*rpcclient.Client, error) {
 	// In case we use TLS and a certificate argument is provided, we need to
@@ -203,13 +296,20 @@ func getBlockTxs(client *rpcclient.Client, height int64) ([]*wire.MsgTx, error)
 func RecoverAccounts(ctx context.Context, cfg RecoveryConfig) ([]*Account,
 	error) {
 
-	client, err := getBitcoinConn(cfg.BitcoinConfig)
-	if err != nil {
-		return nil, err
+	if cfg.ChainSource == nil {
+		if cfg.BitcoinConfig == nil {
+			return nil, fmt.Errorf("either a chain source or a " +
+				"bitcoin backend config must be provided")
+		}
+
+		client, err := getBitcoinConn(cfg.BitcoinConfig)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ChainSource = &bitcoinChainSource{client: client}
 	}
-	cfg.bitcoinClient = client
 
-	currentHeight, err := client.GetBlockCount()
+	currentHeight, err := cfg.ChainSource.BestHeight()
 	if err != nil {
 		return nil, err
 	}
@@ -255,12 +355,16 @@ func recoverInitialState(ctx context.Context, cfg RecoveryConfig) ([]*Account,
 func recreatePossibleAccounts(ctx context.Context,
 	cfg RecoveryConfig) ([]*Account, error) {
 
-	possibleAccounts := make([]*Account, 0, cfg.AccountTarget)
+	keyWindow := cfg.KeyWindow
+	if keyWindow == 0 {
+		keyWindow = cfg.AccountTarget
+	}
+	possibleAccounts := make([]*Account, 0, keyWindow)
 
 	// Prepare the keys we are going to try. Possibly not all of them will
 	// be used.
-	KeyDescriptors, err := GenerateRecoveryKeys(
-		ctx, cfg.AccountTarget, cfg.Wallet,
+	KeyDescriptors, err := GenerateRecoveryKeyRange(
+		ctx, cfg.FirstKeyIndex, keyWindow, cfg.Wallet,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error generating keys: %v", err)
@@ -403,7 +507,9 @@ func updateAccountStates(cfg RecoveryConfig,
 	for i := cfg.FirstBlock; i < uint32(cfg.CurrentBlockHeight); i++ {
 		blockHeight := i
 
-		txs, err := getBlockTxs(cfg.bitcoinClient, int64(blockHeight))
+		txs, err := cfg.ChainSource.BlockTransactions(
+			int64(blockHeight),
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -510,11 +616,19 @@ func findAccountUpdate(cfg RecoveryConfig, acc *Account,
 func GenerateRecoveryKeys(ctx context.Context, accountTarget uint32,
 	wallet lndclient.WalletKitClient) ([]*keychain.KeyDescriptor, error) {
 
-	acctKeys := make([]*keychain.KeyDescriptor, accountTarget)
-	for i := uint32(0); i < accountTarget; i++ {
+	return GenerateRecoveryKeyRange(ctx, 0, accountTarget, wallet)
+}
+
+// GenerateRecoveryKeyRange generates a list of key descriptors for the given
+// number of successive account keys, starting at the given key index.
+func GenerateRecoveryKeyRange(ctx context.Context, firstIndex, numKeys uint32,
+	wallet lndclient.WalletKitClient) ([]*keychain.KeyDescriptor, error) {
+
+	acctKeys := make([]*keychain.KeyDescriptor, numKeys)
+	for i := uint32(0); i < numKeys; i++ {
 		key, err := wallet.DeriveKey(ctx, &keychain.KeyLocator{
 			Family: poolscript.AccountKeyFamily,
-			Index:  i,
+			Index:  firstIndex + i,
 		})
 		if err != nil {
 			return nil, err
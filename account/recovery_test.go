@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/internal/test"
 	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -269,3 +270,170 @@ func TestGenerateRecoveryKeys(t *testing.T) {
 			len(keys), DefaultAccountKeyWindow)
 	}
 }
+
+// mockChainSource is a ChainSource that serves a fixed set of blocks.
+type mockChainSource struct {
+	bestHeight int64
+	blocks     map[int64][]*wire.MsgTx
+}
+
+// BestHeight returns the height of the current best block.
+func (m *mockChainSource) BestHeight() (int64, error) {
+	return m.bestHeight, nil
+}
+
+// BlockTransactions returns all transactions of the block with the given
+// height.
+func (m *mockChainSource) BlockTransactions(height int64) ([]*wire.MsgTx,
+	error) {
+
+	return m.blocks[height], nil
+}
+
+// TestRecoverAccountsFromChain makes sure an account can be rebuilt from its
+// on-chain footprint by re-deriving a range of account keys from lnd.
+func TestRecoverAccountsFromChain(t *testing.T) {
+	t.Parallel()
+
+	const (
+		acctKeyIndex = 3
+		expiry       = 150
+	)
+
+	var (
+		ctx           = context.Background()
+		walletKit     = test.NewMockWalletKit()
+		signer        = test.NewMockSigner()
+		auctioneerKey = getAuctioneerKey()
+		batchKey      = getInitialBatchKey()
+	)
+
+	traderKey, err := walletKit.DeriveKey(ctx, &keychain.KeyLocator{
+		Family: poolscript.AccountKeyFamily,
+		Index:  acctKeyIndex,
+	})
+	require.NoError(t, err)
+	secret, err := signer.DeriveSharedKey(
+		ctx, auctioneerKey, &traderKey.KeyLocator,
+	)
+	require.NoError(t, err)
+
+	// Create the transaction that opened the account and the one that
+	// modified it in a later batch.
+	openScript, err := poolscript.AccountScript(
+		poolscript.VersionWitnessScript, expiry, traderKey.PubKey,
+		auctioneerKey, batchKey, secret,
+	)
+	require.NoError(t, err)
+	openTx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{{
+			Value:    200_000,
+			PkScript: openScript,
+		}},
+	}
+
+	updateScript, err := poolscript.AccountScript(
+		poolscript.VersionWitnessScript, expiry, traderKey.PubKey,
+		auctioneerKey, poolscript.IncrementKey(batchKey), secret,
+	)
+	require.NoError(t, err)
+	updateTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  openTx.TxHash(),
+				Index: 0,
+			},
+		}},
+		TxOut: []*wire.TxOut{{
+			Value:    150_000,
+			PkScript: updateScript,
+		}},
+	}
+
+	cfg := RecoveryConfig{
+		AccountTarget:    1,
+		FirstKeyIndex:    2,
+		KeyWindow:        3,
+		FirstBlock:       100,
+		Transactions:     []*wire.MsgTx{openTx},
+		Signer:           signer,
+		Wallet:           walletKit,
+		InitialBatchKey:  batchKey,
+		AuctioneerPubKey: auctioneerKey,
+		ChainSource: &mockChainSource{
+			bestHeight: 110,
+			blocks: map[int64][]*wire.MsgTx{
+				101: {openTx},
+				105: {updateTx},
+			},
+		},
+		Quit: make(chan struct{}),
+	}
+
+	accounts, err := RecoverAccounts(ctx, cfg)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	acct := accounts[0]
+	require.Equal(t, uint32(acctKeyIndex), acct.TraderKey.Index)
+	require.Equal(t, StateOpen, acct.State)
+	require.Equal(t, uint32(expiry), acct.Expiry)
+	require.Equal(t, btcutil.Amount(150_000), acct.Value)
+	require.Equal(t, uint32(105), acct.HeightHint)
+	require.Equal(t, updateTx.TxHash(), acct.OutPoint.Hash)
+	require.True(t, acct.BatchKey.IsEqual(poolscript.IncrementKey(batchKey)))
+}
+
+// TestGenerateRecoveryKeyRange tests that a range of account keys is derived
+// starting at the requested index.
+func TestGenerateRecoveryKeyRange(t *testing.T) {
+	t.Parallel()
+
+	walletKit := test.NewMockWalletKit()
+	keys, err := GenerateRecoveryKeyRange(
+		context.Background(), 10, 5, walletKit,
+	)
+	require.NoError(t, err)
+	require.Len(t, keys, 5)
+
+	for idx, key := range keys {
+		require.Equal(t, uint32(10+idx), key.Index)
+		require.Equal(t, poolscript.AccountKeyFamily, key.Family)
+	}
+}
+
+// TestLndChainSource makes sure the lnd backed chain source sorts the wallet
+// transactions into the blocks they were confirmed in.
+func TestLndChainSource(t *testing.T) {
+	t.Parallel()
+
+	tx1 := &wire.MsgTx{LockTime: 1}
+	tx2 := &wire.MsgTx{LockTime: 2}
+	tx3 := &wire.MsgTx{LockTime: 3}
+	source := NewLndChainSource(200, []lndclient.Transaction{{
+		Tx:            tx1,
+		Confirmations: 1,
+	}, {
+		Tx:            tx2,
+		Confirmations: 11,
+	}, {
+		Tx:            tx3,
+		Confirmations: 0,
+	}})
+
+	bestHeight, err := source.BestHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 200, bestHeight)
+
+	txs, err := source.BlockTransactions(200)
+	require.NoError(t, err)
+	require.Equal(t, []*wire.MsgTx{tx1}, txs)
+
+	txs, err = source.BlockTransactions(190)
+	require.NoError(t, err)
+	require.Equal(t, []*wire.MsgTx{tx2}, txs)
+
+	txs, err = source.BlockTransactions(199)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
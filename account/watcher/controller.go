@@ -3,11 +3,13 @@ package watcher
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/internal/retry"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,8 +24,28 @@ type CtrlConfig struct {
 
 	// Handlers define the handler to be used after receiving every event.
 	Handlers EventHandler
+
+	// MinBackoff is the minimum time that is waited before registering
+	// for a notification again after its stream failed, for example
+	// because lnd was restarted. If not set, a default is used.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time that is waited between the attempts
+	// to register for a notification again. If not set, a default is used.
+	MaxBackoff time.Duration
 }
 
+const (
+	// defaultMinBackoff is the minimum time that is waited before
+	// registering for a notification again if no back off is configured.
+	defaultMinBackoff = 5 * time.Second
+
+	// defaultMaxBackoff is the maximum time that is waited between the
+	// attempts to register for a notification again if no back off is
+	// configured.
+	defaultMaxBackoff = time.Minute
+)
+
 // controller implements the Controller interface.
 type controller struct {
 	started sync.Once
@@ -48,6 +70,13 @@ var _ Controller = (*controller)(nil)
 // NewController returns an internal struct type that implements the
 // Controller interface.
 func NewController(cfg *CtrlConfig) *controller { // nolint:golint
+	if cfg.MinBackoff == 0 {
+		cfg.MinBackoff = defaultMinBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+
 	watcher := NewExpiryWatcher(cfg.Handlers)
 	return &controller{
 		cfg:          cfg,
@@ -112,18 +141,6 @@ func (c *controller) Stop() {
 func (c *controller) expiryHandler(blockChan chan int32, errChan chan error) {
 	defer c.wg.Done()
 
-	// Wait for the initial block notification to be received before we
-	// begin handling requests.
-	select {
-	case newBlock := <-blockChan:
-		c.watcher.NewBlock(uint32(newBlock))
-	case err := <-errChan:
-		log.Errorf("Unable to receive initial block notification: %v",
-			err)
-	case <-c.quit:
-		return
-	}
-
 	for {
 		select {
 		// A new block notification has arrived, update our known
@@ -132,9 +149,34 @@ func (c *controller) expiryHandler(blockChan chan int32, errChan chan error) {
 			c.watcher.NewBlock(uint32(newBlock))
 
 		// An error occurred while being sent a block notification.
+		// The stream is terminated on any error, for example if lnd
+		// restarts, so we need to register again. lnd sends the
+		// current block right after the registration, so we don't
+		// miss any expiration in the meantime.
 		case err := <-errChan:
-			log.Errorf("Unable to receive block notification: %v",
-				err)
+			log.Errorf("Unable to receive block notification, "+
+				"registering again: %v", err)
+
+			ok := c.reregister(context.Background(), func() error {
+				ctxc, cancel := context.WithCancel(
+					context.Background(),
+				)
+				notifier := c.cfg.ChainNotifier
+
+				var err error
+				blockChan, errChan, err = notifier.
+					RegisterBlockEpochNtfn(ctxc)
+				if err != nil {
+					cancel()
+					return err
+				}
+				c.ctxCancels = append(c.ctxCancels, cancel)
+
+				return nil
+			})
+			if !ok {
+				return
+			}
 
 		case <-c.quit:
 			return
@@ -142,6 +184,36 @@ func (c *controller) expiryHandler(blockChan chan int32, errChan chan error) {
 	}
 }
 
+// reregister calls the given registration function until it succeeds, backing
+// off between the attempts. False is returned if the controller is stopped or
+// the given context is canceled in the meantime.
+func (c *controller) reregister(ctx context.Context,
+	register func() error) bool {
+
+	var backoff time.Duration
+	for {
+		backoff = retry.NextBackoff(
+			backoff, c.cfg.MinBackoff, c.cfg.MaxBackoff,
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return false
+		case <-c.quit:
+			return false
+		}
+
+		err := register()
+		if err == nil {
+			return true
+		}
+
+		log.Warnf("Unable to register for notification again, "+
+			"retrying: %v", err)
+	}
+}
+
 // WatchAccountConf watches a new account on-chain for its confirmation. Only
 // one conf watcher per account can be used at any time.
 //
@@ -163,9 +235,15 @@ func (c *controller) WatchAccountConf(traderKey *btcec.PublicKey,
 	}
 
 	ctxc, cancel := context.WithCancel(context.Background())
-	confChan, errChan, err := c.cfg.ChainNotifier.RegisterConfirmationsNtfn(
-		ctxc, &txHash, script, int32(numConfs), int32(heightHint),
-	)
+	register := func() (chan *chainntnfs.TxConfirmation, chan error,
+		error) {
+
+		return c.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+			ctxc, &txHash, script, int32(numConfs),
+			int32(heightHint),
+		)
+	}
+	confChan, errChan, err := register()
 	if err != nil {
 		cancel()
 		return err
@@ -173,18 +251,23 @@ func (c *controller) WatchAccountConf(traderKey *btcec.PublicKey,
 	c.confCancels[traderKeyRaw] = cancel
 
 	c.wg.Add(1)
-	go c.waitForAccountConf(traderKey, traderKeyRaw, confChan, errChan)
+	go c.waitForAccountConf(
+		ctxc, traderKey, traderKeyRaw, register, confChan, errChan,
+	)
 
 	return nil
 }
 
 // waitForAccountConf waits for an account's confirmation and takes the
-// necessary steps once confirmed.
+// necessary steps once confirmed. If the notification stream fails, for
+// example because lnd was restarted, the given registration function is used
+// to register for the confirmation again.
 //
 // NOTE: This method must be run as a goroutine.
-func (c *controller) waitForAccountConf(traderKey *btcec.PublicKey,
-	traderKeyRaw [33]byte, confChan chan *chainntnfs.TxConfirmation,
-	errChan chan error) {
+func (c *controller) waitForAccountConf(ctx context.Context,
+	traderKey *btcec.PublicKey, traderKeyRaw [33]byte,
+	register func() (chan *chainntnfs.TxConfirmation, chan error, error),
+	confChan chan *chainntnfs.TxConfirmation, errChan chan error) {
 
 	defer func() {
 		c.wg.Done()
@@ -194,30 +277,41 @@ func (c *controller) waitForAccountConf(traderKey *btcec.PublicKey,
 		c.cancelMtx.Unlock()
 	}()
 
-	select {
-	case conf := <-confChan:
-		err := c.cfg.Handlers.HandleAccountConf(traderKey, conf)
-		if err != nil {
-			log.Errorf("Unable to handle confirmation for account "+
-				"%x: %v", traderKey.SerializeCompressed(), err)
-		}
+	for {
+		select {
+		case conf := <-confChan:
+			err := c.cfg.Handlers.HandleAccountConf(traderKey, conf)
+			if err != nil {
+				log.Errorf("Unable to handle confirmation for "+
+					"account %x: %v",
+					traderKey.SerializeCompressed(), err)
+			}
 
-	case err := <-errChan:
-		if err != nil {
+			return
+
+		case err := <-errChan:
 			// Ignore context canceled error due to possible manual
 			// cancellation.
-			s, ok := status.FromError(err)
-			if ok && s.Code() == codes.Canceled {
+			if err == nil || isCanceled(err) {
 				return
 			}
 
 			log.Errorf("Unable to determine confirmation for "+
-				"account %x: %v",
+				"account %x, registering again: %v",
 				traderKey.SerializeCompressed(), err)
-		}
 
-	case <-c.quit:
-		return
+			ok := c.reregister(ctx, func() error {
+				var err error
+				confChan, errChan, err = register()
+				return err
+			})
+			if !ok {
+				return
+			}
+
+		case <-c.quit:
+			return
+		}
 	}
 }
 
@@ -242,9 +336,12 @@ func (c *controller) WatchAccountSpend(traderKey *btcec.PublicKey,
 	}
 
 	ctxc, cancel := context.WithCancel(context.Background())
-	spendChan, errChan, err := c.cfg.ChainNotifier.RegisterSpendNtfn(
-		ctxc, &accountPoint, script, int32(heightHint),
-	)
+	register := func() (chan *chainntnfs.SpendDetail, chan error, error) {
+		return c.cfg.ChainNotifier.RegisterSpendNtfn(
+			ctxc, &accountPoint, script, int32(heightHint),
+		)
+	}
+	spendChan, errChan, err := register()
 	if err != nil {
 		cancel()
 		return err
@@ -252,18 +349,23 @@ func (c *controller) WatchAccountSpend(traderKey *btcec.PublicKey,
 	c.spendCancels[traderKeyRaw] = cancel
 
 	c.wg.Add(1)
-	go c.waitForAccountSpend(traderKey, traderKeyRaw, spendChan, errChan)
+	go c.waitForAccountSpend(
+		ctxc, traderKey, traderKeyRaw, register, spendChan, errChan,
+	)
 
 	return nil
 }
 
 // waitForAccountSpend waits for an account's spend and takes the necessary
-// steps once spent.
+// steps once spent. If the notification stream fails, for example because lnd
+// was restarted, the given registration function is used to register for the
+// spend again.
 //
 // NOTE: This method must be run as a goroutine.
-func (c *controller) waitForAccountSpend(traderKey *btcec.PublicKey,
-	traderKeyRaw [33]byte, spendChan chan *chainntnfs.SpendDetail,
-	errChan chan error) {
+func (c *controller) waitForAccountSpend(ctx context.Context,
+	traderKey *btcec.PublicKey, traderKeyRaw [33]byte,
+	register func() (chan *chainntnfs.SpendDetail, chan error, error),
+	spendChan chan *chainntnfs.SpendDetail, errChan chan error) {
 
 	defer func() {
 		c.wg.Done()
@@ -273,32 +375,53 @@ func (c *controller) waitForAccountSpend(traderKey *btcec.PublicKey,
 		c.cancelMtx.Unlock()
 	}()
 
-	select {
-	case spend := <-spendChan:
-		err := c.cfg.Handlers.HandleAccountSpend(traderKey, spend)
-		if err != nil {
-			log.Errorf("Unable to handle spend for account %x: %v",
-				traderKey.SerializeCompressed(), err)
-		}
+	for {
+		select {
+		case spend := <-spendChan:
+			err := c.cfg.Handlers.HandleAccountSpend(
+				traderKey, spend,
+			)
+			if err != nil {
+				log.Errorf("Unable to handle spend for "+
+					"account %x: %v",
+					traderKey.SerializeCompressed(), err)
+			}
+
+			return
 
-	case err := <-errChan:
-		if err != nil {
+		case err := <-errChan:
 			// Ignore context canceled error due to possible manual
 			// cancellation.
-			s, ok := status.FromError(err)
-			if ok && s.Code() == codes.Canceled {
+			if err == nil || isCanceled(err) {
 				return
 			}
 
-			log.Errorf("Unable to determine spend for account %x: "+
-				"%v", traderKey.SerializeCompressed(), err)
-		}
+			log.Errorf("Unable to determine spend for account %x, "+
+				"registering again: %v",
+				traderKey.SerializeCompressed(), err)
 
-	case <-c.quit:
-		return
+			ok := c.reregister(ctx, func() error {
+				var err error
+				spendChan, errChan, err = register()
+				return err
+			})
+			if !ok {
+				return
+			}
+
+		case <-c.quit:
+			return
+		}
 	}
 }
 
+// isCanceled returns true if the given error is the result of a canceled
+// notification context.
+func isCanceled(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Canceled
+}
+
 // WatchAccountExpiration watches for the expiration of an account on-chain.
 // Successive calls for the same account will cancel any previous expiration
 // watch requests and the new expiration will be tracked instead.
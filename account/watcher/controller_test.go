@@ -343,3 +343,146 @@ func TestWatcherControllerWatchAccountSpend(t *testing.T) {
 		})
 	}
 }
+
+// TestWatcherControllerReregister makes sure the controller registers for
+// block, confirmation and spend notifications again if their streams fail, for
+// example because lnd was restarted.
+func TestWatcherControllerReregister(t *testing.T) {
+	t.Parallel()
+
+	traderKeyStr := "036b51e0cc2d9e5988ee4967e0ba67ef3727bb633fea21a0af58e0c9395446ba09"
+	traderKeyRaw, _ := hex.DecodeString(traderKeyStr)
+	traderKey, _ := btcec.ParsePubKey(traderKeyRaw)
+
+	var (
+		txHash     chainhash.Hash
+		outpoint   wire.OutPoint
+		script     = []byte{1, 2, 3}
+		numConfs   = uint32(6)
+		heightHint = uint32(8)
+	)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	chainNotifier := test.NewMockChainNotifierClient(mockCtrl)
+	watcher := NewMockExpiryWatcher(mockCtrl)
+	handlers := NewMockEventHandler(mockCtrl)
+
+	// Each stream fails once and is then registered again, the second
+	// registration attempt fails as well.
+	blockChan1, blockErrChan1 := make(chan int32), make(chan error)
+	blockChan2, blockErrChan2 := make(chan int32), make(chan error)
+	gomock.InOrder(
+		chainNotifier.EXPECT().
+			RegisterBlockEpochNtfn(gomock.Any()).
+			Return(blockChan1, blockErrChan1, nil),
+		chainNotifier.EXPECT().
+			RegisterBlockEpochNtfn(gomock.Any()).
+			Return(nil, nil, errCtrlExpected),
+		chainNotifier.EXPECT().
+			RegisterBlockEpochNtfn(gomock.Any()).
+			Return(blockChan2, blockErrChan2, nil),
+	)
+
+	confChan1, confErrChan1 := make(chan *chainntnfs.TxConfirmation),
+		make(chan error)
+	confChan2, confErrChan2 := make(chan *chainntnfs.TxConfirmation),
+		make(chan error)
+	gomock.InOrder(
+		chainNotifier.EXPECT().
+			RegisterConfirmationsNtfn(
+				gomock.Any(), &txHash, script,
+				int32(numConfs), int32(heightHint),
+			).
+			Return(confChan1, confErrChan1, nil),
+		chainNotifier.EXPECT().
+			RegisterConfirmationsNtfn(
+				gomock.Any(), &txHash, script,
+				int32(numConfs), int32(heightHint),
+			).
+			Return(confChan2, confErrChan2, nil),
+	)
+
+	spendChan1, spendErrChan1 := make(chan *chainntnfs.SpendDetail),
+		make(chan error)
+	spendChan2, spendErrChan2 := make(chan *chainntnfs.SpendDetail),
+		make(chan error)
+	gomock.InOrder(
+		chainNotifier.EXPECT().
+			RegisterSpendNtfn(
+				gomock.Any(), &outpoint, script,
+				int32(heightHint),
+			).
+			Return(spendChan1, spendErrChan1, nil),
+		chainNotifier.EXPECT().
+			RegisterSpendNtfn(
+				gomock.Any(), &outpoint, script,
+				int32(heightHint),
+			).
+			Return(spendChan2, spendErrChan2, nil),
+	)
+
+	const height = 123
+	blockDone := make(chan struct{})
+	watcher.EXPECT().
+		NewBlock(uint32(height)).
+		Do(func(uint32) { close(blockDone) })
+
+	confirmation := &chainntnfs.TxConfirmation{}
+	confDone := make(chan struct{})
+	handlers.EXPECT().
+		HandleAccountConf(traderKey, confirmation).
+		Return(nil).
+		Do(func(*btcec.PublicKey, *chainntnfs.TxConfirmation) {
+			close(confDone)
+		})
+
+	spendDetails := &chainntnfs.SpendDetail{}
+	spendDone := make(chan struct{})
+	handlers.EXPECT().
+		HandleAccountSpend(traderKey, spendDetails).
+		Return(nil).
+		Do(func(*btcec.PublicKey, *chainntnfs.SpendDetail) {
+			close(spendDone)
+		})
+
+	watcherController := NewController(&CtrlConfig{
+		ChainNotifier: chainNotifier,
+		Handlers:      handlers,
+		MinBackoff:    time.Millisecond,
+		MaxBackoff:    time.Millisecond,
+	})
+	watcherController.watcher = watcher
+
+	require.NoError(t, watcherController.Start())
+	defer watcherController.Stop()
+
+	err := watcherController.WatchAccountConf(
+		traderKey, txHash, script, numConfs, heightHint,
+	)
+	require.NoError(t, err)
+	err = watcherController.WatchAccountSpend(
+		traderKey, outpoint, script, heightHint,
+	)
+	require.NoError(t, err)
+
+	// Simulate lnd restarting, which terminates all streams. The events
+	// must then be received through the new registrations.
+	lndErr := errors.New("lnd shutting down")
+	blockErrChan1 <- lndErr
+	confErrChan1 <- lndErr
+	spendErrChan1 <- lndErr
+
+	blockChan2 <- height
+	confChan2 <- confirmation
+	spendChan2 <- spendDetails
+
+	for _, done := range []chan struct{}{blockDone, confDone, spendDone} {
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("event not processed after re-registration")
+		}
+	}
+}
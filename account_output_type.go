@@ -0,0 +1,57 @@
+package pool
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+)
+
+const (
+	// AccountOutputTypeP2WSH is the account output type of legacy SegWit v0
+	// accounts that use a single p2wsh script.
+	AccountOutputTypeP2WSH = "p2wsh"
+
+	// AccountOutputTypeP2TR is the account output type of Taproot accounts
+	// that use a MuSig2 combined key with the expiry script as a single
+	// tap script leaf.
+	AccountOutputTypeP2TR = "p2tr"
+)
+
+// accountVersionForOutputType returns the account version that produces
+// account outputs of the given type. An empty type selects the default, which
+// are Taproot accounts.
+func accountVersionForOutputType(outputType string) (account.Version, error) {
+	switch outputType {
+	case AccountOutputTypeP2TR, "":
+		return account.VersionTaprootEnabled, nil
+
+	case AccountOutputTypeP2WSH:
+		return account.VersionInitialNoVersion, nil
+
+	default:
+		return 0, fmt.Errorf("unknown account output type %q",
+			outputType)
+	}
+}
+
+// validateAccountOutputType makes sure the auction server supports accounts
+// with the given output type in the batch version we negotiated with it.
+func validateAccountOutputType(outputType string,
+	batchVersion order.BatchVersion) error {
+
+	version, err := accountVersionForOutputType(outputType)
+	if err != nil {
+		return err
+	}
+
+	if version >= account.VersionTaprootEnabled &&
+		!batchVersion.SupportsAccountTaprootUpgrade() {
+
+		return fmt.Errorf("account output type %v is not supported "+
+			"by batch version %v, use %v instead", outputType,
+			batchVersion, AccountOutputTypeP2WSH)
+	}
+
+	return nil
+}
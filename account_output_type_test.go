@@ -0,0 +1,165 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// newOutputTypeTestAccount creates an open account with random keys.
+func newOutputTypeTestAccount(t *testing.T,
+	version account.Version) *account.Account {
+
+	newKey := func() *btcec.PublicKey {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return privKey.PubKey()
+	}
+
+	return &account.Account{
+		Value:  btcutil.SatoshiPerBitcoin,
+		Expiry: 1000,
+		TraderKey: &keychain.KeyDescriptor{
+			PubKey: newKey(),
+		},
+		AuctioneerKey: newKey(),
+		BatchKey:      newKey(),
+		State:         account.StateOpen,
+		Version:       version,
+		LatestTx: &wire.MsgTx{
+			Version: 2,
+			TxIn:    []*wire.TxIn{{}},
+			TxOut: []*wire.TxOut{{
+				Value: btcutil.SatoshiPerBitcoin,
+			}},
+		},
+	}
+}
+
+// TestAccountOutputType makes sure each configured account output type
+// creates accounts with outputs of that script type.
+func TestAccountOutputType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		outputType string
+		isType     func([]byte) bool
+	}{{
+		outputType: "",
+		isType:     txscript.IsPayToTaproot,
+	}, {
+		outputType: AccountOutputTypeP2TR,
+		isType:     txscript.IsPayToTaproot,
+	}, {
+		outputType: AccountOutputTypeP2WSH,
+		isType:     txscript.IsPayToWitnessScriptHash,
+	}}
+	for _, tc := range testCases {
+		cfg := &Config{
+			AccountOutputType: tc.outputType,
+			AccountVersion:    -1,
+		}
+		accountVersion, err := selectAccountVersion(
+			cfg, order.LatestBatchVersion,
+		)
+		require.NoError(t, err)
+
+		srv := &rpcServer{accountVersion: accountVersion}
+		version, err := srv.determineAccountVersion(
+			poolrpc.AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT,
+		)
+		require.NoError(t, err)
+
+		acct := newOutputTypeTestAccount(t, version)
+		output, err := acct.Output()
+		require.NoError(t, err)
+		require.True(
+			t, tc.isType(output.PkScript), "output type %q",
+			tc.outputType,
+		)
+	}
+
+	// An explicitly requested version always wins.
+	srv := &rpcServer{accountVersion: account.VersionInitialNoVersion}
+	version, err := srv.determineAccountVersion(
+		poolrpc.AccountVersion_ACCOUNT_VERSION_TAPROOT,
+	)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionTaprootEnabled, version)
+
+	_, err = accountVersionForOutputType("p2pkh")
+	require.ErrorContains(t, err, "unknown account output type")
+}
+
+// TestValidateAccountOutputType makes sure Taproot accounts are only allowed
+// if the batch version supports them.
+func TestValidateAccountOutputType(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validateAccountOutputType(
+		AccountOutputTypeP2TR, order.UpgradeAccountTaprootBatchVersion,
+	))
+	require.NoError(t, validateAccountOutputType(
+		AccountOutputTypeP2WSH, order.UnannouncedChannelsBatchVersion,
+	))
+
+	err := validateAccountOutputType(
+		AccountOutputTypeP2TR, order.UnannouncedChannelsBatchVersion,
+	)
+	require.ErrorContains(t, err, "not supported by batch version")
+}
+
+// TestModifiedAccountOutputType makes sure modified accounts use the
+// configured output type but are never downgraded.
+func TestModifiedAccountOutputType(t *testing.T) {
+	t.Parallel()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	legacyAcct := newOutputTypeTestAccount(
+		t, account.VersionInitialNoVersion,
+	)
+	require.NoError(t, db.AddAccount(legacyAcct))
+	taprootAcct := newOutputTypeTestAccount(
+		t, account.VersionTaprootEnabled,
+	)
+	require.NoError(t, db.AddAccount(taprootAcct))
+
+	srv := &rpcServer{
+		server:         &Server{db: db},
+		accountVersion: account.VersionInitialNoVersion,
+	}
+	lndDependent := poolrpc.AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
+
+	version, err := srv.determineModifiedAccountVersion(
+		legacyAcct.TraderKey.PubKey, lndDependent,
+	)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionInitialNoVersion, version)
+
+	version, err = srv.determineModifiedAccountVersion(
+		taprootAcct.TraderKey.PubKey, lndDependent,
+	)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionTaprootEnabled, version)
+
+	// With Taproot accounts as the default, legacy accounts are upgraded.
+	srv.accountVersion = account.VersionTaprootEnabled
+	version, err = srv.determineModifiedAccountVersion(
+		legacyAcct.TraderKey.PubKey, lndDependent,
+	)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionTaprootEnabled, version)
+}
@@ -0,0 +1,40 @@
+package pool
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+)
+
+// checkAccountPolicy makes sure a new order doesn't violate the spending policy
+// of the account backing it, taking all orders of that account that are still
+// active into account. If the new order replaces an order, the replaced order
+// is ignored, as it is canceled before the new one is submitted.
+func checkAccountPolicy(policy *account.Policy, orders []order.Order,
+	newOrder order.Order, replaced order.Nonce) error {
+
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	var (
+		acctKey     = newOrder.Details().AcctKey
+		numActive   int
+		outstanding btcutil.Amount
+	)
+	for _, o := range orders {
+		details := o.Details()
+		if details.AcctKey != acctKey || details.State.Archived() ||
+			o.Nonce() == replaced {
+
+			continue
+		}
+
+		numActive++
+		outstanding += details.UnitsUnfulfilled.ToSatoshis()
+	}
+
+	return policy.CheckOrder(
+		numActive, outstanding, newOrder.Details().Amt,
+	)
+}
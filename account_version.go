@@ -0,0 +1,85 @@
+package pool
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+)
+
+// localAccountVersions is the list of account versions this trader client
+// supports, ordered from the lowest to the highest version.
+var localAccountVersions = []account.Version{
+	account.VersionInitialNoVersion,
+	account.VersionTaprootEnabled,
+}
+
+// serverAccountVersions returns the set of account versions the auctioneer
+// supports in the given batch version. Every auctioneer supports legacy
+// accounts, Taproot accounts require a batch version that allows upgrading
+// accounts to Taproot.
+func serverAccountVersions(
+	batchVersion order.BatchVersion) map[account.Version]struct{} {
+
+	versions := map[account.Version]struct{}{
+		account.VersionInitialNoVersion: {},
+	}
+	if batchVersion.SupportsAccountTaprootUpgrade() {
+		versions[account.VersionTaprootEnabled] = struct{}{}
+	}
+
+	return versions
+}
+
+// negotiateAccountVersion returns the highest account version that is
+// supported by both this trader client and the auctioneer with the batch
+// version we negotiated with it.
+func negotiateAccountVersion(
+	batchVersion order.BatchVersion) (account.Version, error) {
+
+	supported := serverAccountVersions(batchVersion)
+	for i := len(localAccountVersions) - 1; i >= 0; i-- {
+		version := localAccountVersions[i]
+		if _, ok := supported[version]; ok {
+			return version, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no mutually supported account version in "+
+		"batch version %v, we support %v", batchVersion,
+		localAccountVersions)
+}
+
+// selectAccountVersion returns the version of new accounts and of accounts that
+// are modified without requesting a specific version. An account version set
+// in the config takes precedence over the configured account output type.
+// Either way, the version must not be newer than the one negotiated with the
+// auctioneer.
+func selectAccountVersion(cfg *Config,
+	batchVersion order.BatchVersion) (account.Version, error) {
+
+	negotiated, err := negotiateAccountVersion(batchVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	// We set the default value of the config flag to -1, so we can
+	// differentiate between no value set and the first version (0).
+	version := account.Version(cfg.AccountVersion)
+	if cfg.AccountVersion < 0 {
+		version, err = accountVersionForOutputType(
+			cfg.AccountOutputType,
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if version > negotiated {
+		return 0, fmt.Errorf("configured account version %v is not "+
+			"supported by the auctioneer, the latest supported "+
+			"version is %v", version, negotiated)
+	}
+
+	return version, nil
+}
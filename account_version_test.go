@@ -0,0 +1,78 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNegotiateAccountVersion makes sure the highest account version both the
+// trader and the auctioneer support is picked.
+func TestNegotiateAccountVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		batchVersion order.BatchVersion
+		expected     account.Version
+	}{{
+		name:         "latest batch version",
+		batchVersion: order.LatestBatchVersion,
+		expected:     account.VersionTaprootEnabled,
+	}, {
+		name:         "taproot batch version",
+		batchVersion: order.UpgradeAccountTaprootBatchVersion,
+		expected:     account.VersionTaprootEnabled,
+	}, {
+		name:         "legacy batch version",
+		batchVersion: order.UnannouncedChannelsBatchVersion,
+		expected:     account.VersionInitialNoVersion,
+	}}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := negotiateAccountVersion(tc.batchVersion)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+// TestSelectAccountVersion makes sure an account version set in the config
+// overrides the account output type, and that both fail the same way if the
+// auctioneer doesn't support the version.
+func TestSelectAccountVersion(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		AccountOutputType: AccountOutputTypeP2TR,
+		AccountVersion:    -1,
+	}
+	version, err := selectAccountVersion(cfg, order.LatestBatchVersion)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionTaprootEnabled, version)
+
+	cfg.AccountVersion = int32(account.VersionInitialNoVersion)
+	version, err = selectAccountVersion(cfg, order.LatestBatchVersion)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionInitialNoVersion, version)
+
+	// A configured version must be supported by the auctioneer, no
+	// matter which option it was configured with.
+	legacyBatchVersion := order.UnannouncedChannelsBatchVersion
+	cfg.AccountVersion = int32(account.VersionTaprootEnabled)
+	_, err = selectAccountVersion(cfg, legacyBatchVersion)
+	require.ErrorContains(t, err, "is not supported by the auctioneer")
+
+	cfg.AccountVersion = -1
+	_, err = selectAccountVersion(cfg, legacyBatchVersion)
+	require.ErrorContains(t, err, "is not supported by the auctioneer")
+
+	cfg.AccountOutputType = AccountOutputTypeP2WSH
+	version, err = selectAccountVersion(cfg, legacyBatchVersion)
+	require.NoError(t, err)
+	require.Equal(t, account.VersionInitialNoVersion, version)
+}
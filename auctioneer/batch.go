@@ -39,7 +39,7 @@ type BatchCleaner interface {
 	// channels from a batch that was never finalized. Some non-terminal
 	// errors are logged only and not returned. Therefore if this method
 	// returns an error, it should be handled as terminal error.
-	RemovePendingBatchArtifacts(
+	RemovePendingBatchArtifacts(ctx context.Context,
 		matchedOrders map[order.Nonce][]*order.MatchedOrder,
 		batchTx *wire.MsgTx) error
 }
@@ -71,7 +71,7 @@ func (c *Client) checkPendingBatch() error {
 
 	if snapshot.BatchTX.TxHash() != finalizedTx.TxHash() {
 		err := c.cfg.BatchCleaner.RemovePendingBatchArtifacts(
-			snapshot.MatchedOrders, snapshot.BatchTX,
+			context.Background(), snapshot.MatchedOrders, snapshot.BatchTX,
 		)
 		if err != nil {
 			return fmt.Errorf("error removing pending batch "+
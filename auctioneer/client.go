@@ -72,6 +72,11 @@ type Config struct {
 	// connection.
 	ProxyAddress string
 
+	// ProxyBypass is a list of host names, IP addresses and networks in
+	// CIDR notation that should be connected to directly, even if a proxy
+	// address is set.
+	ProxyBypass []string
+
 	// Insecure signals that no TLS should be used if set to true.
 	Insecure bool
 
@@ -84,6 +89,20 @@ type Config struct {
 	// dialing the gRPC connection.
 	DialOpts []grpc.DialOption
 
+	// Metadata is a set of custom gRPC headers that are attached to every
+	// call to the auction server.
+	Metadata map[string]string
+
+	// ClientAlias is an optional alias the trader identifies itself with
+	// to the auction server. It is sent in the ClientAliasMetadataKey
+	// header of every call.
+	ClientAlias string
+
+	// Compression is the name of the compressor that is used for all calls
+	// to the auction server. If empty or CompressionNone, messages are not
+	// compressed.
+	Compression string
+
 	// Signer is the signing interface that is used to sign messages during
 	// the authentication handshake with the auctioneer server.
 	Signer lndclient.SignerClient
@@ -97,6 +116,15 @@ type Config struct {
 	// attempts.
 	MaxBackoff time.Duration
 
+	// OrderSubmitRetries is the number of times an order submission that
+	// failed because the auction server was temporarily unavailable or
+	// didn't answer in time is retried.
+	OrderSubmitRetries int
+
+	// OrderSubmitRetryBackoff is the time that is waited before retrying a
+	// failed order submission.
+	OrderSubmitRetryBackoff time.Duration
+
 	// BatchSource provides information about the current pending batch, if
 	// any.
 	BatchSource BatchSource
@@ -117,6 +145,23 @@ type Config struct {
 	// default SubscribeBatchAuction RPC the client should connect to
 	// SubscribeSidecar for getting batch updates.
 	ConnectSidecar bool
+
+	// ConnectionStateCallback is an optional function that is called each
+	// time the state of the stream connection to the auction server
+	// changes. It is called without holding any of the client's locks but
+	// blocks the connection handling, so it should return quickly.
+	ConnectionStateCallback func(state ConnState)
+
+	// AuctioneerClient is an optional, already connected client of the
+	// auction server's RPC. If it is set, the auction server isn't dialed
+	// and all calls are made through this client instead, which allows
+	// tests to run the trader against a mock auctioneer.
+	AuctioneerClient auctioneerrpc.ChannelAuctioneerClient
+
+	// HashMailClient is an optional client of the auction server's mailbox
+	// RPC that is used together with AuctioneerClient. It is only needed
+	// for negotiating sidecar channels.
+	HashMailClient auctioneerrpc.HashMailClient
 }
 
 // Client performs the client side part of auctions. This interface exists to be
@@ -143,18 +188,46 @@ type Client struct {
 
 	subscribedAccts    map[[33]byte]*acctSubscription
 	subscribedAcctsMtx sync.Mutex
+
+	// batchVersion is the batch version we authenticate with. It starts
+	// out as the configured version and can be lowered once we know which
+	// version the auction server supports.
+	batchVersion    order.BatchVersion
+	batchVersionMtx sync.Mutex
 }
 
 // NewClient returns a new instance to initiate auctions with.
 func NewClient(cfg *Config) (*Client, error) {
 	var err error
 	cfg.DialOpts, err = getAuctionServerDialOpts(
-		cfg.Insecure, cfg.ProxyAddress, cfg.TLSPathServer, cfg.DialOpts...,
+		cfg.Insecure, cfg.ProxyAddress, cfg.ProxyBypass,
+		cfg.TLSPathServer, cfg.DialOpts...,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := ValidateMetadata(cfg.Metadata); err != nil {
+		return nil, err
+	}
+	if err := ValidateClientAlias(cfg.ClientAlias); err != nil {
+		return nil, err
+	}
+	md := outgoingMetadata(cfg.Metadata, cfg.ClientAlias)
+	if len(md) > 0 {
+		cfg.DialOpts = append(cfg.DialOpts, metadataDialOpts(md)...)
+	}
+
+	if err := ValidateCompression(cfg.Compression); err != nil {
+		return nil, err
+	}
+	callOpts := compressionCallOptions(cfg.Compression)
+	if len(callOpts) > 0 {
+		cfg.DialOpts = append(
+			cfg.DialOpts, grpc.WithDefaultCallOptions(callOpts...),
+		)
+	}
+
 	mainErrChan := make(chan error)
 	errChanSwitch := NewErrChanSwitch(mainErrChan)
 	return &Client{
@@ -164,6 +237,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		errChanSwitch:   errChanSwitch,
 		quit:            make(chan struct{}),
 		subscribedAccts: make(map[[33]byte]*acctSubscription),
+		batchVersion:    cfg.BatchVersion,
 	}, nil
 }
 
@@ -173,6 +247,16 @@ func (c *Client) Start() error {
 		return nil
 	}
 
+	// If we were given a client to use, we don't need to connect to the
+	// auction server ourselves.
+	if c.cfg.AuctioneerClient != nil {
+		c.client = c.cfg.AuctioneerClient
+		c.hashMailClient = c.cfg.HashMailClient
+		c.errChanSwitch.Start()
+
+		return nil
+	}
+
 	serverConn, err := grpc.Dial(c.cfg.ServerAddress, c.cfg.DialOpts...)
 	if err != nil {
 		return fmt.Errorf("unable to connect to RPC server: %v",
@@ -188,9 +272,38 @@ func (c *Client) Start() error {
 	return nil
 }
 
+// CheckConnection dials a new connection to the auction server and makes sure
+// the server responds to a terms request. The client's main connection is not
+// used, so this doesn't interfere with any ongoing subscriptions.
+func (c *Client) CheckConnection(ctx context.Context) error {
+	// There is no connection to check if we were given a client to use.
+	if c.cfg.AuctioneerClient != nil {
+		_, err := c.cfg.AuctioneerClient.Terms(
+			ctx, &auctioneerrpc.TermsRequest{},
+		)
+		return err
+	}
+
+	dialOpts := make([]grpc.DialOption, 0, len(c.cfg.DialOpts)+1)
+	dialOpts = append(dialOpts, c.cfg.DialOpts...)
+	dialOpts = append(dialOpts, grpc.WithBlock())
+
+	conn, err := grpc.DialContext(ctx, c.cfg.ServerAddress, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("unable to connect to auction server: %v",
+			err)
+	}
+	defer conn.Close()
+
+	client := auctioneerrpc.NewChannelAuctioneerClient(conn)
+	_, err = client.Terms(ctx, &auctioneerrpc.TermsRequest{})
+	return err
+}
+
 // getAuctionServerDialOpts returns the dial options to connect to the auction
 // server.
-func getAuctionServerDialOpts(insecure bool, proxyAddress, tlsPath string,
+func getAuctionServerDialOpts(insecure bool, proxyAddress string,
+	proxyBypass []string, tlsPath string,
 	dialOpts ...grpc.DialOption) ([]grpc.DialOption, error) {
 
 	// Create a copy of the dial options array.
@@ -228,7 +341,16 @@ func getAuctionServerDialOpts(insecure bool, proxyAddress, tlsPath string,
 				tor.DefaultConnTimeout,
 			)
 		}
-		opts = append(opts, grpc.WithContextDialer(torDialer))
+
+		// Hosts on the bypass list are connected to directly, all
+		// others still go through the proxy.
+		bypass, err := parseProxyBypass(proxyBypass)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithContextDialer(
+			bypass.dialer(dialDirect, torDialer),
+		))
 	}
 
 	return opts, nil
@@ -249,6 +371,12 @@ func (c *Client) Stop() error {
 	c.wg.Wait()
 	close(c.FromServerChan)
 	c.errChanSwitch.Stop()
+
+	// The connection is only ours to close if we dialed it.
+	if c.serverConn == nil {
+		return nil
+	}
+
 	return c.serverConn.Close()
 }
 
@@ -517,7 +645,7 @@ func (c *Client) SubmitOrder(ctx context.Context, o order.Order,
 	}
 
 	// Submit the finished request and parse the response.
-	resp, err := c.client.SubmitOrder(ctx, rpcRequest)
+	resp, err := c.submitOrderWithRetry(ctx, rpcRequest)
 	if err != nil {
 		return err
 	}
@@ -636,7 +764,7 @@ func (c *Client) connectAndAuthenticate(ctx context.Context,
 		sendMsg:      c.SendAuctionMessage,
 		signer:       c.cfg.Signer,
 		msgChan:      make(chan *auctioneerrpc.ServerAuctionMessage),
-		batchVersion: c.cfg.BatchVersion,
+		batchVersion: c.BatchVersion(),
 		errChan:      tempErrChan,
 		quit:         make(chan struct{}),
 	}
@@ -933,7 +1061,15 @@ func (c *Client) IsSubscribed() bool {
 // connectServerStream opens the initial connection to the server for the stream
 // of account updates and handles reconnect trials with incremental backoff.
 func (c *Client) connectServerStream(initialBackoff time.Duration,
-	numRetries int) error {
+	numRetries int) (err error) {
+
+	// The deferred functions run in reverse order, so we only notify about
+	// the new connection after the stream mutex was released.
+	defer func() {
+		if err == nil {
+			c.notifyConnState(ConnStateConnected)
+		}
+	}()
 
 	c.streamMutex.Lock()
 	defer c.streamMutex.Unlock()
@@ -942,7 +1078,6 @@ func (c *Client) connectServerStream(initialBackoff time.Duration,
 		backoff = initialBackoff
 		ctxb    = context.Background()
 		ctx     context.Context
-		err     error
 	)
 	for i := 0; i < numRetries; i++ {
 		// Wait before connecting in case this is a reconnect trial.
@@ -1047,6 +1182,8 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 		// reconnect scheduled. On an improper shutdown, we'll get an
 		// error, usually "transport is closing".
 		case err == io.EOF:
+			c.notifyConnState(ConnStateDisconnected)
+
 			select {
 			case c.errChanSwitch.ErrChan() <- ErrServerShutdown:
 			case <-c.quit:
@@ -1065,6 +1202,7 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 			}
 
 			log.Errorf("Server connection error received: %v", err)
+			c.notifyConnState(ConnStateDisconnected)
 
 			// For any other error type, we'll attempt to trigger
 			// the reconnect logic so we'll always try to connect
@@ -1237,6 +1375,8 @@ func (c *Client) HandleServerShutdown(err error) error {
 	} else {
 		log.Errorf("Error in stream, trying to reconnect: %v", err)
 	}
+	c.notifyConnState(ConnStateReconnecting)
+
 	err = c.closeStream()
 	if err != nil {
 		log.Errorf("Error closing stream connection: %v", err)
@@ -1245,6 +1385,7 @@ func (c *Client) HandleServerShutdown(err error) error {
 	// Try to get a new connection, retry if not successful immediately.
 	err = c.connectServerStream(c.cfg.MinBackoff, reconnectRetries)
 	if err != nil {
+		c.notifyConnState(ConnStateDisconnected)
 		return err
 	}
 
@@ -1358,6 +1499,25 @@ func unmarshallServerRecoveredAccount(keyDesc *keychain.KeyDescriptor,
 	}, nil
 }
 
+// BatchVersion returns the batch version that is used when authenticating
+// with the auction server.
+func (c *Client) BatchVersion() order.BatchVersion {
+	c.batchVersionMtx.Lock()
+	defer c.batchVersionMtx.Unlock()
+
+	return c.batchVersion
+}
+
+// SetBatchVersion changes the batch version that is used when authenticating
+// with the auction server. Accounts that are already subscribed keep the
+// version they authenticated with.
+func (c *Client) SetBatchVersion(version order.BatchVersion) {
+	c.batchVersionMtx.Lock()
+	defer c.batchVersionMtx.Unlock()
+
+	c.batchVersion = version
+}
+
 // Terms returns the current dynamic auctioneer terms like max account size, max
 // order duration in blocks and the auction fee schedule.
 func (c *Client) Terms(ctx context.Context) (*terms.AuctioneerTerms, error) {
@@ -0,0 +1,240 @@
+package auctioneer
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// termsTestServer is a minimal auction server that only answers terms
+// requests.
+type termsTestServer struct {
+	auctioneerrpc.UnimplementedChannelAuctioneerServer
+}
+
+// Terms returns empty auctioneer terms.
+func (s *termsTestServer) Terms(context.Context,
+	*auctioneerrpc.TermsRequest) (*auctioneerrpc.TermsResponse, error) {
+
+	return &auctioneerrpc.TermsResponse{}, nil
+}
+
+var checkConnectionTestCases = []struct {
+	name      string
+	reachable bool
+}{{
+	name:      "auction server reachable",
+	reachable: true,
+}, {
+	name:      "auction server unreachable",
+	reachable: false,
+}}
+
+// TestCheckConnection makes sure a reachable auction server is detected and
+// dialing an unreachable one fails once the context is done.
+func TestCheckConnection(t *testing.T) {
+	for _, tc := range checkConnectionTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			listener := bufconn.Listen(1024 * 1024)
+			server := grpc.NewServer()
+			auctioneerrpc.RegisterChannelAuctioneerServer(
+				server, &termsTestServer{},
+			)
+			if tc.reachable {
+				go func() { _ = server.Serve(listener) }()
+				defer server.Stop()
+			} else {
+				require.NoError(t, listener.Close())
+			}
+
+			client, err := NewClient(&Config{
+				ServerAddress: "bufnet",
+				Insecure:      true,
+				DialOpts: []grpc.DialOption{
+					grpc.WithContextDialer(func(
+						context.Context,
+						string) (net.Conn, error) {
+
+						return listener.Dial()
+					}),
+				},
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(
+				context.Background(), 500*time.Millisecond,
+			)
+			defer cancel()
+
+			err = client.CheckConnection(ctx)
+			if tc.reachable {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(
+				t, err.Error(), "unable to connect to auction "+
+					"server",
+			)
+		})
+	}
+}
+
+// termsTestClient is a mock of the auction server's RPC that only answers
+// terms requests.
+type termsTestClient struct {
+	auctioneerrpc.ChannelAuctioneerClient
+
+	numCalls int32
+}
+
+// Terms returns empty auctioneer terms.
+func (c *termsTestClient) Terms(context.Context, *auctioneerrpc.TermsRequest,
+	...grpc.CallOption) (*auctioneerrpc.TermsResponse, error) {
+
+	atomic.AddInt32(&c.numCalls, 1)
+	return &auctioneerrpc.TermsResponse{
+		ExecutionFee: &auctioneerrpc.ExecutionFee{},
+	}, nil
+}
+
+// TestInjectedAuctioneerClient makes sure an injected auctioneer client is
+// used for all calls instead of dialing the auction server.
+func TestInjectedAuctioneerClient(t *testing.T) {
+	t.Parallel()
+
+	mock := &termsTestClient{}
+	client, err := NewClient(&Config{
+		ServerAddress:    "unreachable.invalid:12010",
+		AuctioneerClient: mock,
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Start())
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 500*time.Millisecond,
+	)
+	defer cancel()
+
+	require.NoError(t, client.CheckConnection(ctx))
+	_, err = client.Terms(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, atomic.LoadInt32(&mock.numCalls))
+
+	require.NoError(t, client.Stop())
+}
+
+// streamTestServer is a minimal auction server that closes the first batch
+// auction stream a client opens and keeps all later ones open.
+type streamTestServer struct {
+	termsTestServer
+
+	numStreams int32
+}
+
+// SubscribeBatchAuction closes the first stream right away, simulating a
+// server shutdown, and keeps all later ones open until the client closes them.
+func (s *streamTestServer) SubscribeBatchAuction(
+	stream auctioneerrpc.ChannelAuctioneer_SubscribeBatchAuctionServer) error {
+
+	if atomic.AddInt32(&s.numStreams, 1) == 1 {
+		return nil
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+// noPendingBatchSource is a batch source without a pending batch.
+type noPendingBatchSource struct{}
+
+// PendingBatchSnapshot always returns account.ErrNoPendingBatch.
+func (s *noPendingBatchSource) PendingBatchSnapshot() (
+	*clientdb.LocalBatchSnapshot, error) {
+
+	return nil, account.ErrNoPendingBatch
+}
+
+// TestConnectionStateCallback makes sure the connection state callback is
+// invoked for each transition in order and without holding the stream mutex.
+func TestConnectionStateCallback(t *testing.T) {
+	t.Parallel()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	auctioneerrpc.RegisterChannelAuctioneerServer(
+		server, &streamTestServer{},
+	)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	var (
+		client   *Client
+		statesMu sync.Mutex
+		states   []ConnState
+	)
+	client, err := NewClient(&Config{
+		ServerAddress: "bufnet",
+		Insecure:      true,
+		DialOpts: []grpc.DialOption{
+			grpc.WithContextDialer(func(context.Context,
+				string) (net.Conn, error) {
+
+				return listener.Dial()
+			}),
+		},
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		BatchSource: &noPendingBatchSource{},
+		ConnectionStateCallback: func(state ConnState) {
+			// The callback must be free to call back into the
+			// client, so the stream mutex must not be held.
+			require.True(t, client.streamMutex.TryLock())
+			client.streamMutex.Unlock()
+
+			statesMu.Lock()
+			states = append(states, state)
+			statesMu.Unlock()
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Start())
+
+	// The first stream is closed by the server right after connecting,
+	// which triggers the reconnect logic.
+	require.NoError(t, client.connectServerStream(0, 1))
+
+	select {
+	case err := <-client.StreamErrChan:
+		require.Equal(t, ErrServerShutdown, err)
+	case <-time.After(time.Second):
+		t.Fatalf("stream not closed")
+	}
+	require.NoError(t, client.HandleServerShutdown(nil))
+
+	// Shutting down the client closes the second stream, which isn't a
+	// state transition that is reported.
+	require.NoError(t, client.Stop())
+
+	statesMu.Lock()
+	defer statesMu.Unlock()
+	require.Equal(t, []ConnState{
+		ConnStateConnected, ConnStateDisconnected,
+		ConnStateReconnecting, ConnStateConnected,
+	}, states)
+}
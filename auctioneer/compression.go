@@ -0,0 +1,44 @@
+package auctioneer
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// CompressionNone disables the compression of the messages exchanged
+	// with the auction server.
+	CompressionNone = "none"
+
+	// CompressionGzip compresses all messages sent to the auction server
+	// with gzip. The server is expected to compress its responses the same
+	// way.
+	CompressionGzip = gzip.Name
+)
+
+// ValidateCompression makes sure the given name is one of the supported
+// compressors. An empty name is treated the same as CompressionNone.
+func ValidateCompression(name string) error {
+	switch name {
+	case "", CompressionNone, CompressionGzip:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown compression %q, must be one of %s "+
+			"or %s", name, CompressionNone, CompressionGzip)
+	}
+}
+
+// compressionCallOptions returns the call options that make all calls to the
+// auction server use the compressor with the given name.
+func compressionCallOptions(name string) []grpc.CallOption {
+	switch name {
+	case CompressionGzip:
+		return []grpc.CallOption{grpc.UseCompressor(CompressionGzip)}
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package auctioneer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+var compressionTestCases = []struct {
+	name            string
+	compression     string
+	expectedCallOps []grpc.CallOption
+	expectedErr     string
+}{{
+	name: "not set",
+}, {
+	name:        "none",
+	compression: CompressionNone,
+}, {
+	name:        "gzip",
+	compression: CompressionGzip,
+	expectedCallOps: []grpc.CallOption{
+		grpc.CompressorCallOption{CompressorType: "gzip"},
+	},
+}, {
+	name:        "unknown",
+	compression: "zstd",
+	expectedErr: "unknown compression \"zstd\"",
+}}
+
+// TestCompression makes sure only supported compressors are accepted and that
+// the compressor call option is installed if compression is enabled.
+func TestCompression(t *testing.T) {
+	for _, tc := range compressionTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateCompression(tc.compression)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t, tc.expectedCallOps,
+				compressionCallOptions(tc.compression),
+			)
+		})
+	}
+}
+
+// TestNewClientCompression makes sure the client adds a dial option for the
+// compressor and refuses unknown compressors.
+func TestNewClientCompression(t *testing.T) {
+	t.Parallel()
+
+	plainClient, err := NewClient(&Config{Insecure: true})
+	require.NoError(t, err)
+
+	gzipClient, err := NewClient(&Config{
+		Insecure:    true,
+		Compression: CompressionGzip,
+	})
+	require.NoError(t, err)
+	require.Len(
+		t, gzipClient.cfg.DialOpts, len(plainClient.cfg.DialOpts)+1,
+	)
+
+	_, err = NewClient(&Config{
+		Insecure:    true,
+		Compression: "zstd",
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown compression")
+}
@@ -0,0 +1,50 @@
+package auctioneer
+
+// ConnState is the state of the long-lived stream connection to the auction
+// server.
+type ConnState uint8
+
+const (
+	// ConnStateConnected means the stream to the auction server was
+	// established and messages can be exchanged.
+	ConnStateConnected ConnState = iota
+
+	// ConnStateDisconnected means the stream to the auction server was
+	// lost, either because the server shut down or because of a
+	// connection error.
+	ConnStateDisconnected
+
+	// ConnStateReconnecting means the client is trying to re-establish a
+	// lost stream to the auction server.
+	ConnStateReconnecting
+)
+
+// String returns a human readable representation of the connection state.
+func (s ConnState) String() string {
+	switch s {
+	case ConnStateConnected:
+		return "connected"
+
+	case ConnStateDisconnected:
+		return "disconnected"
+
+	case ConnStateReconnecting:
+		return "reconnecting"
+
+	default:
+		return "unknown"
+	}
+}
+
+// notifyConnState informs the connection state callback, if any, about the
+// new state of the stream connection.
+//
+// NOTE: This must be called without holding any of the client's mutexes, so
+// the callback is free to call back into the client.
+func (c *Client) notifyConnState(state ConnState) {
+	log.Debugf("Auction server connection state changed to %v", state)
+
+	if c.cfg.ConnectionStateCallback != nil {
+		c.cfg.ConnectionStateCallback(state)
+	}
+}
@@ -0,0 +1,143 @@
+package auctioneer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ClientAliasMetadataKey is the gRPC header the client alias is sent
+	// to the auction server in. Servers that don't know the header simply
+	// ignore it.
+	ClientAliasMetadataKey = "pool-client-alias"
+
+	// MaxClientAliasLength is the maximum length of the client alias in
+	// bytes.
+	MaxClientAliasLength = 32
+)
+
+// ValidateClientAlias makes sure the given client alias isn't too long and
+// only contains the characters 0-9, a-z, A-Z, '-', '_' and '.'.
+func ValidateClientAlias(alias string) error {
+	if len(alias) > MaxClientAliasLength {
+		return fmt.Errorf("client alias cannot be longer than %d "+
+			"characters", MaxClientAliasLength)
+	}
+
+	for _, c := range alias {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'z',
+			c >= 'A' && c <= 'Z', c == '-', c == '_', c == '.':
+
+		default:
+			return fmt.Errorf("invalid character %q in client "+
+				"alias", c)
+		}
+	}
+
+	return nil
+}
+
+// outgoingMetadata returns the metadata that is attached to all calls to the
+// auction server, which is the custom metadata plus the client alias, if one
+// is set.
+func outgoingMetadata(md map[string]string,
+	clientAlias string) map[string]string {
+
+	if clientAlias == "" {
+		return md
+	}
+
+	outgoing := make(map[string]string, len(md)+1)
+	for key, value := range md {
+		outgoing[key] = value
+	}
+	outgoing[ClientAliasMetadataKey] = clientAlias
+
+	return outgoing
+}
+
+// ValidateMetadata makes sure all keys and values of the given metadata can be
+// sent as gRPC headers. Keys may only contain the characters 0-9, a-z, '-',
+// '_' and '.' and must not use the reserved "grpc-" prefix or the "-bin"
+// suffix of binary headers. Values must be printable ASCII.
+func ValidateMetadata(md map[string]string) error {
+	for key, value := range md {
+		if key == "" {
+			return fmt.Errorf("empty metadata key")
+		}
+
+		for _, c := range key {
+			switch {
+			case c >= '0' && c <= '9', c >= 'a' && c <= 'z',
+				c == '-', c == '_', c == '.':
+
+			default:
+				return fmt.Errorf("invalid character %q in "+
+					"metadata key %s", c, key)
+			}
+		}
+
+		if strings.HasPrefix(key, "grpc-") {
+			return fmt.Errorf("metadata key %s uses reserved "+
+				"prefix grpc-", key)
+		}
+		if strings.HasSuffix(key, "-bin") {
+			return fmt.Errorf("binary metadata key %s not "+
+				"supported", key)
+		}
+
+		for _, c := range value {
+			if c < 0x20 || c > 0x7e {
+				return fmt.Errorf("invalid character %q in "+
+					"value of metadata key %s", c, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// metadataDialOpts returns the dial options that attach the given metadata to
+// all outgoing unary and streaming calls.
+func metadataDialOpts(md map[string]string) []grpc.DialOption {
+	pairs := make([]string, 0, len(md)*2)
+	for key, value := range md {
+		pairs = append(pairs, key, value)
+	}
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(metadataUnaryInterceptor(pairs)),
+		grpc.WithChainStreamInterceptor(
+			metadataStreamInterceptor(pairs),
+		),
+	}
+}
+
+// metadataUnaryInterceptor returns a client interceptor that adds the given
+// key/value pairs to the outgoing context of every unary call.
+func metadataUnaryInterceptor(pairs []string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+
+		ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// metadataStreamInterceptor returns a client interceptor that adds the given
+// key/value pairs to the outgoing context of every streaming call.
+func metadataStreamInterceptor(pairs []string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+		ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
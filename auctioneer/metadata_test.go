@@ -0,0 +1,188 @@
+package auctioneer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var validateMetadataTestCases = []struct {
+	name        string
+	md          map[string]string
+	expectedErr string
+}{{
+	name: "valid metadata",
+	md: map[string]string{
+		"x-gateway-route": "pool",
+		"x_tenant.id":     "abc 123",
+	},
+}, {
+	name:        "empty key",
+	md:          map[string]string{"": "value"},
+	expectedErr: "empty metadata key",
+}, {
+	name:        "uppercase key",
+	md:          map[string]string{"X-Route": "pool"},
+	expectedErr: "invalid character 'X' in metadata key X-Route",
+}, {
+	name:        "reserved prefix",
+	md:          map[string]string{"grpc-timeout": "1s"},
+	expectedErr: "uses reserved prefix",
+}, {
+	name:        "binary key",
+	md:          map[string]string{"x-data-bin": "AAAA"},
+	expectedErr: "binary metadata key x-data-bin not supported",
+}, {
+	name:        "non printable value",
+	md:          map[string]string{"x-route": "pool\n"},
+	expectedErr: "in value of metadata key x-route",
+}}
+
+// TestValidateMetadata makes sure only metadata that can be sent as gRPC
+// headers is accepted.
+func TestValidateMetadata(t *testing.T) {
+	for _, tc := range validateMetadataTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateMetadata(tc.md)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// TestMetadataInterceptors makes sure the configured metadata is added to the
+// outgoing context of unary and streaming calls without dropping metadata that
+// was already present.
+func TestMetadataInterceptors(t *testing.T) {
+	t.Parallel()
+
+	md := map[string]string{
+		"x-gateway-route": "pool",
+		"x-tenant":        "abc",
+	}
+	pairs := make([]string, 0, len(md)*2)
+	for key, value := range md {
+		pairs = append(pairs, key, value)
+	}
+
+	assertMetadata := func(ctx context.Context) {
+		outgoing, ok := metadata.FromOutgoingContext(ctx)
+		require.True(t, ok)
+		for key, value := range md {
+			require.Equal(t, []string{value}, outgoing.Get(key))
+		}
+		require.Equal(t, []string{"yes"}, outgoing.Get("existing"))
+	}
+
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(), "existing", "yes",
+	)
+
+	var unaryCalled bool
+	unary := metadataUnaryInterceptor(pairs)
+	err := unary(
+		ctx, "/test/Unary", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{},
+			_ *grpc.ClientConn, _ ...grpc.CallOption) error {
+
+			assertMetadata(ctx)
+			unaryCalled = true
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, unaryCalled)
+
+	var streamCalled bool
+	stream := metadataStreamInterceptor(pairs)
+	_, err = stream(
+		ctx, &grpc.StreamDesc{}, nil, "/test/Stream",
+		func(ctx context.Context, _ *grpc.StreamDesc,
+			_ *grpc.ClientConn, _ string,
+			_ ...grpc.CallOption) (grpc.ClientStream, error) {
+
+			assertMetadata(ctx)
+			streamCalled = true
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, streamCalled)
+}
+
+// TestValidateClientAlias makes sure only short aliases with a restricted set
+// of characters are accepted.
+func TestValidateClientAlias(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateClientAlias(""))
+	require.NoError(t, ValidateClientAlias("Node-1_eu.west"))
+	require.NoError(t, ValidateClientAlias(
+		strings.Repeat("a", MaxClientAliasLength),
+	))
+
+	err := ValidateClientAlias(strings.Repeat("a", MaxClientAliasLength+1))
+	require.ErrorContains(t, err, "cannot be longer than 32 characters")
+
+	err = ValidateClientAlias("node 1")
+	require.ErrorContains(t, err, "invalid character ' ' in client alias")
+}
+
+// TestClientAliasMetadata makes sure the client alias is sent in the outgoing
+// metadata of calls to the auction server next to the custom metadata.
+func TestClientAliasMetadata(t *testing.T) {
+	t.Parallel()
+
+	// Without an alias, the custom metadata is used as is.
+	md := map[string]string{"x-tenant": "abc"}
+	require.Equal(t, md, outgoingMetadata(md, ""))
+
+	outgoing := outgoingMetadata(md, "node-1")
+	require.Equal(t, map[string]string{
+		"x-tenant":             "abc",
+		ClientAliasMetadataKey: "node-1",
+	}, outgoing)
+
+	// The custom metadata must not be modified.
+	require.Len(t, md, 1)
+
+	pairs := make([]string, 0, len(outgoing)*2)
+	for key, value := range outgoing {
+		pairs = append(pairs, key, value)
+	}
+
+	var called bool
+	unary := metadataUnaryInterceptor(pairs)
+	err := unary(
+		context.Background(), "/test/Unary", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{},
+			_ *grpc.ClientConn, _ ...grpc.CallOption) error {
+
+			sent, ok := metadata.FromOutgoingContext(ctx)
+			require.True(t, ok)
+			require.Equal(
+				t, []string{"node-1"},
+				sent.Get(ClientAliasMetadataKey),
+			)
+			require.Equal(t, []string{"abc"}, sent.Get("x-tenant"))
+
+			called = true
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, called)
+}
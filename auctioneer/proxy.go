@@ -0,0 +1,119 @@
+package auctioneer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// dialFunc is a function that establishes a connection to the given address.
+type dialFunc func(ctx context.Context, addr string) (net.Conn, error)
+
+// proxyBypass is a list of hosts and networks that should be connected to
+// directly instead of through the SOCKS proxy.
+type proxyBypass struct {
+	hosts map[string]struct{}
+	nets  []*net.IPNet
+}
+
+// ValidateProxyBypass makes sure all entries of a proxy bypass list are either
+// a host name, an IP address or a network in CIDR notation.
+func ValidateProxyBypass(entries []string) error {
+	_, err := parseProxyBypass(entries)
+	return err
+}
+
+// parseProxyBypass parses a list of host names, IP addresses and networks in
+// CIDR notation into a proxy bypass list.
+func parseProxyBypass(entries []string) (*proxyBypass, error) {
+	bypass := &proxyBypass{
+		hosts: make(map[string]struct{}),
+	}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		switch {
+		case entry == "":
+			return nil, fmt.Errorf("empty proxy bypass entry")
+
+		case strings.Contains(entry, "/"):
+			_, ipNet, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy bypass "+
+					"network %s: %v", entry, err)
+			}
+			bypass.nets = append(bypass.nets, ipNet)
+
+		case net.ParseIP(entry) != nil:
+			ip := net.ParseIP(entry)
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			bypass.nets = append(bypass.nets, &net.IPNet{
+				IP:   ip,
+				Mask: net.CIDRMask(bits, bits),
+			})
+
+		// Anything that isn't an IP address must be a plain host name
+		// without a port.
+		case strings.ContainsAny(entry, ": "):
+			return nil, fmt.Errorf("invalid proxy bypass host %s, "+
+				"must be a host name, IP address or CIDR",
+				entry)
+
+		default:
+			bypass.hosts[strings.ToLower(entry)] = struct{}{}
+		}
+	}
+
+	return bypass, nil
+}
+
+// bypassed returns true if the host of the given address is on the bypass
+// list.
+//
+// NOTE: Host names are never resolved to be matched against the bypassed
+// networks, as that would leak DNS requests outside of the proxy.
+func (b *proxyBypass) bypassed(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		for _, ipNet := range b.nets {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	_, ok := b.hosts[strings.ToLower(host)]
+	return ok
+}
+
+// dialer returns a dial function that connects to bypassed hosts directly and
+// to all other hosts through the proxy.
+func (b *proxyBypass) dialer(dialDirect, dialProxy dialFunc) dialFunc {
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		if b.bypassed(addr) {
+			log.Debugf("Bypassing proxy for connection to %v",
+				addr)
+
+			return dialDirect(ctx, addr)
+		}
+
+		return dialProxy(ctx, addr)
+	}
+}
+
+// dialDirect connects to the given address over TCP without using a proxy.
+func dialDirect(ctx context.Context, addr string) (net.Conn, error) {
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", addr)
+}
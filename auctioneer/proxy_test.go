@@ -0,0 +1,136 @@
+package auctioneer
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var parseProxyBypassTestCases = []struct {
+	name        string
+	entries     []string
+	expectedErr string
+}{{
+	name: "valid entries",
+	entries: []string{
+		"localhost", "Auctioneer.Regtest", "127.0.0.1", "::1",
+		"10.0.0.0/8", "fd00::/8",
+	},
+}, {
+	name:        "empty entry",
+	entries:     []string{"localhost", " "},
+	expectedErr: "empty proxy bypass entry",
+}, {
+	name:        "invalid network",
+	entries:     []string{"10.0.0.0/33"},
+	expectedErr: "invalid proxy bypass network 10.0.0.0/33",
+}, {
+	name:        "host with port",
+	entries:     []string{"localhost:12009"},
+	expectedErr: "invalid proxy bypass host localhost:12009",
+}}
+
+// TestParseProxyBypass makes sure only host names, IP addresses and networks in
+// CIDR notation are accepted as proxy bypass entries.
+func TestParseProxyBypass(t *testing.T) {
+	for _, tc := range parseProxyBypassTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseProxyBypass(tc.entries)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+var proxyBypassDialerTestCases = []struct {
+	name           string
+	addr           string
+	expectedDirect bool
+}{{
+	name:           "bypassed host name",
+	addr:           "localhost:12009",
+	expectedDirect: true,
+}, {
+	name:           "bypassed host name different case",
+	addr:           "AUCTIONEER.regtest:12009",
+	expectedDirect: true,
+}, {
+	name:           "bypassed IPv4 network",
+	addr:           "10.1.2.3:12009",
+	expectedDirect: true,
+}, {
+	name:           "bypassed IPv6 address",
+	addr:           "[::1]:12009",
+	expectedDirect: true,
+}, {
+	name:           "other host name",
+	addr:           "pool.lightning.finance:12010",
+	expectedDirect: false,
+}, {
+	name:           "sub domain of bypassed host name",
+	addr:           "sub.localhost:12009",
+	expectedDirect: false,
+}, {
+	name:           "IPv4 address outside of bypassed network",
+	addr:           "192.168.1.1:12009",
+	expectedDirect: false,
+}, {
+	name:           "other IPv6 address",
+	addr:           "[2001:db8::1]:12009",
+	expectedDirect: false,
+}}
+
+// TestProxyBypassDialer makes sure bypassed hosts are dialed directly while
+// all other hosts are dialed through the proxy.
+func TestProxyBypassDialer(t *testing.T) {
+	bypass, err := parseProxyBypass([]string{
+		"localhost", "auctioneer.regtest", "10.0.0.0/8", "::1",
+	})
+	require.NoError(t, err)
+
+	for _, tc := range proxyBypassDialerTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var direct, proxied []string
+			dial := bypass.dialer(
+				func(_ context.Context, addr string) (net.Conn,
+					error) {
+
+					direct = append(direct, addr)
+					return nil, nil
+				},
+				func(_ context.Context, addr string) (net.Conn,
+					error) {
+
+					proxied = append(proxied, addr)
+					return nil, nil
+				},
+			)
+
+			_, err := dial(context.Background(), tc.addr)
+			require.NoError(t, err)
+
+			if tc.expectedDirect {
+				require.Equal(t, []string{tc.addr}, direct)
+				require.Empty(t, proxied)
+			} else {
+				require.Empty(t, direct)
+				require.Equal(t, []string{tc.addr}, proxied)
+			}
+		})
+	}
+}
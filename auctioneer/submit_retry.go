@@ -0,0 +1,127 @@
+package auctioneer
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isRetryableSubmitErr returns true if an order submission that failed with
+// the given error can be retried. Only transient connection problems are
+// retried, never an order the auction server refused.
+func isRetryableSubmitErr(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// acceptedDetails are the details of a submit response for an order the
+// auction server accepted.
+var acceptedDetails = &auctioneerrpc.ServerSubmitOrderResponse_Accepted{
+	Accepted: true,
+}
+
+// submitRequestNonce returns the nonce of the order that is submitted with
+// the given request.
+func submitRequestNonce(req *auctioneerrpc.ServerSubmitOrderRequest) []byte {
+	switch {
+	case req.GetAsk() != nil:
+		return req.GetAsk().GetDetails().GetOrderNonce()
+
+	case req.GetBid() != nil:
+		return req.GetBid().GetDetails().GetOrderNonce()
+
+	default:
+		return nil
+	}
+}
+
+// submitOrderWithRetry sends the order submission request to the auction
+// server. If the call fails with a retryable error, it is retried up to the
+// configured number of times, waiting the configured backoff before each new
+// attempt. A failed attempt might still have reached the server, so the order
+// state is queried before each retry and the order is only sent again if the
+// server doesn't know it yet.
+func (c *Client) submitOrderWithRetry(ctx context.Context,
+	req *auctioneerrpc.ServerSubmitOrderRequest) (
+	*auctioneerrpc.ServerSubmitOrderResponse, error) {
+
+	var (
+		resp *auctioneerrpc.ServerSubmitOrderResponse
+		err  error
+	)
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			var known bool
+			known, err = c.orderKnown(ctx, req)
+			if known {
+				log.Infof("Order was already submitted by a " +
+					"previous attempt")
+
+				return &auctioneerrpc.ServerSubmitOrderResponse{
+					Details: acceptedDetails,
+				}, nil
+			}
+		}
+
+		// Only send the order if we know for sure the server doesn't
+		// have it yet. Otherwise we treat the failed state query as a
+		// failed attempt.
+		if err == nil {
+			resp, err = c.client.SubmitOrder(ctx, req)
+		}
+		if err == nil || attempt >= c.cfg.OrderSubmitRetries ||
+			!isRetryableSubmitErr(err) {
+
+			return resp, err
+		}
+
+		log.Warnf("Order submission failed (attempt %d of %d), "+
+			"retrying in %v: %v", attempt+1,
+			c.cfg.OrderSubmitRetries+1, c.cfg.OrderSubmitRetryBackoff,
+			err)
+
+		select {
+		case <-time.After(c.cfg.OrderSubmitRetryBackoff):
+
+		case <-ctx.Done():
+			return nil, err
+
+		case <-c.quit:
+			return nil, ErrClientShutdown
+		}
+	}
+}
+
+// orderKnown queries the auction server for the state of the order that is
+// submitted with the given request. It returns true if the server knows the
+// order. A retryable error is returned if the server couldn't be reached, in
+// which case it's not known whether the order was received.
+func (c *Client) orderKnown(ctx context.Context,
+	req *auctioneerrpc.ServerSubmitOrderRequest) (bool, error) {
+
+	stateReq := &auctioneerrpc.ServerOrderStateRequest{
+		OrderNonce: submitRequestNonce(req),
+	}
+	_, err := c.client.OrderState(ctx, stateReq)
+	switch {
+	case err == nil:
+		return true, nil
+
+	case isRetryableSubmitErr(err):
+		return false, err
+
+	default:
+		log.Debugf("Order not known to the server, submitting "+
+			"again: %v", err)
+
+		return false, nil
+	}
+}
@@ -0,0 +1,204 @@
+package auctioneer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// submitTestClient is a mock of the auction server's RPC that fails order
+// submissions with the given errors before accepting them.
+type submitTestClient struct {
+	auctioneerrpc.ChannelAuctioneerClient
+
+	errs     []error
+	numCalls int
+
+	stateErrs      []error
+	numStateCalls  int
+	knownAfterFail bool
+}
+
+// OrderState returns the next error of the state error list. Once there are
+// no errors left, the order is reported as known if a previous submission
+// failed but reached the server, otherwise it's reported as not found.
+func (c *submitTestClient) OrderState(context.Context,
+	*auctioneerrpc.ServerOrderStateRequest, ...grpc.CallOption) (
+	*auctioneerrpc.ServerOrderStateResponse, error) {
+
+	c.numStateCalls++
+	if len(c.stateErrs) > 0 {
+		err := c.stateErrs[0]
+		c.stateErrs = c.stateErrs[1:]
+
+		return nil, err
+	}
+
+	if c.knownAfterFail {
+		return &auctioneerrpc.ServerOrderStateResponse{}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "order not found")
+}
+
+// SubmitOrder returns the next error of the list or accepts the order once
+// there are no errors left.
+func (c *submitTestClient) SubmitOrder(context.Context,
+	*auctioneerrpc.ServerSubmitOrderRequest, ...grpc.CallOption) (
+	*auctioneerrpc.ServerSubmitOrderResponse, error) {
+
+	c.numCalls++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+
+		return nil, err
+	}
+
+	return &auctioneerrpc.ServerSubmitOrderResponse{
+		Details: &auctioneerrpc.ServerSubmitOrderResponse_Accepted{
+			Accepted: true,
+		},
+	}, nil
+}
+
+var submitOrderRetryTestCases = []struct {
+	name          string
+	retries       int
+	errs          []error
+	stateErrs     []error
+	known         bool
+	expectedCalls int
+	expectedState int
+	expectedCode  codes.Code
+}{{
+	name: "unavailable is retried",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	retries:       2,
+	expectedCalls: 3,
+	expectedState: 2,
+	expectedCode:  codes.OK,
+}, {
+	name: "deadline exceeded is retried",
+	errs: []error{
+		status.Error(codes.DeadlineExceeded, "timeout"),
+	},
+	retries:       1,
+	expectedCalls: 2,
+	expectedState: 1,
+	expectedCode:  codes.OK,
+}, {
+	name: "retries exhausted",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	retries:       1,
+	expectedCalls: 2,
+	expectedState: 1,
+	expectedCode:  codes.Unavailable,
+}, {
+	name: "retries disabled",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	expectedCalls: 1,
+	expectedCode:  codes.Unavailable,
+}, {
+	name: "order received by failed attempt is not sent again",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	known:         true,
+	retries:       2,
+	expectedCalls: 1,
+	expectedState: 1,
+	expectedCode:  codes.OK,
+}, {
+	name: "failed state query counts as attempt",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	stateErrs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	retries:       2,
+	expectedCalls: 2,
+	expectedState: 2,
+	expectedCode:  codes.OK,
+}, {
+	name: "failed state query exhausts retries",
+	errs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	stateErrs: []error{
+		status.Error(codes.Unavailable, "connection reset"),
+	},
+	retries:       1,
+	expectedCalls: 1,
+	expectedState: 1,
+	expectedCode:  codes.Unavailable,
+}, {
+	name: "duplicate on first attempt is refused",
+	errs: []error{
+		status.Error(codes.AlreadyExists, "order already exists"),
+	},
+	retries:       2,
+	expectedCalls: 1,
+	expectedCode:  codes.AlreadyExists,
+}, {
+	name: "invalid order is not retried",
+	errs: []error{
+		status.Error(codes.InvalidArgument, "invalid order"),
+	},
+	retries:       3,
+	expectedCalls: 1,
+	expectedCode:  codes.InvalidArgument,
+}}
+
+// TestSubmitOrderRetry makes sure order submissions that fail with a transient
+// error are retried up to the configured number of times, an order the server
+// already received is not sent again and all other errors are returned
+// immediately.
+func TestSubmitOrderRetry(t *testing.T) {
+	for _, tc := range submitOrderRetryTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &submitTestClient{
+				errs:           tc.errs,
+				stateErrs:      tc.stateErrs,
+				knownAfterFail: tc.known,
+			}
+			client, err := NewClient(&Config{
+				AuctioneerClient:        mock,
+				OrderSubmitRetries:      tc.retries,
+				OrderSubmitRetryBackoff: time.Millisecond,
+			})
+			require.NoError(t, err)
+			require.NoError(t, client.Start())
+			defer func() {
+				require.NoError(t, client.Stop())
+			}()
+
+			_, err = client.submitOrderWithRetry(
+				context.Background(),
+				&auctioneerrpc.ServerSubmitOrderRequest{},
+			)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Equal(t, tc.expectedCalls, mock.numCalls)
+			require.Equal(t, tc.expectedState, mock.numStateCalls)
+		})
+	}
+}
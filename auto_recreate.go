@@ -0,0 +1,66 @@
+package pool
+
+import (
+	"context"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+// autoRecreateHandler submits a fresh copy of our orders that were fully
+// filled in a batch if they are set to be recreated.
+type autoRecreateHandler struct {
+	// getOrder fetches the current state of an order from the local
+	// database.
+	getOrder func(order.Nonce) (order.Order, error)
+
+	// checkPendingLimit returns an error if no new order can be submitted
+	// because the maximum number of pending orders is reached.
+	checkPendingLimit func() error
+
+	// submitOrder submits the given new order to the auctioneer.
+	submitOrder func(context.Context, order.Order) error
+}
+
+// handleBatch recreates all our orders that were fully filled in the given
+// batch. Errors are only logged as the batch itself was already finalized
+// successfully.
+func (h *autoRecreateHandler) handleBatch(ctx context.Context,
+	batch *order.Batch) {
+
+	for nonce := range batch.MatchedOrders {
+		if err := h.handleOrder(ctx, nonce); err != nil {
+			rpcLog.Errorf("Unable to recreate order %v: %v", nonce,
+				err)
+		}
+	}
+}
+
+// handleOrder recreates a single order if it was fully filled and is set to be
+// recreated.
+func (h *autoRecreateHandler) handleOrder(ctx context.Context,
+	nonce order.Nonce) error {
+
+	o, err := h.getOrder(nonce)
+	if err != nil {
+		return err
+	}
+
+	details := o.Details()
+	if details.State != order.StateExecuted || !details.AutoRecreate {
+		return nil
+	}
+
+	if err := h.checkPendingLimit(); err != nil {
+		return err
+	}
+
+	recreated, err := order.RecreateOrder(o)
+	if err != nil {
+		return err
+	}
+
+	rpcLog.Infof("Recreating fully filled order %v as order %v", nonce,
+		recreated.Nonce())
+
+	return h.submitOrder(ctx, recreated)
+}
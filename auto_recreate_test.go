@@ -0,0 +1,131 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+var autoRecreateTestCases = []struct {
+	name                string
+	autoRecreate        bool
+	limit               uint32
+	state               order.State
+	pendingLimitReached bool
+	expectRecreated     bool
+	expectAutoRecreate  bool
+	expectLimit         uint32
+}{{
+	name:  "not auto recreate",
+	state: order.StateExecuted,
+}, {
+	name:               "auto recreate without limit",
+	autoRecreate:       true,
+	state:              order.StateExecuted,
+	expectRecreated:    true,
+	expectAutoRecreate: true,
+}, {
+	name:               "auto recreate with limit",
+	autoRecreate:       true,
+	limit:              3,
+	state:              order.StateExecuted,
+	expectRecreated:    true,
+	expectAutoRecreate: true,
+	expectLimit:        2,
+}, {
+	name:            "last recreation",
+	autoRecreate:    true,
+	limit:           1,
+	state:           order.StateExecuted,
+	expectRecreated: true,
+}, {
+	name:         "partially filled order",
+	autoRecreate: true,
+	state:        order.StatePartiallyFilled,
+}, {
+	name:                "max pending orders reached",
+	autoRecreate:        true,
+	state:               order.StateExecuted,
+	pendingLimitReached: true,
+}}
+
+// TestAutoRecreateHandler makes sure a fully filled order is submitted again
+// if it is set to be recreated.
+func TestAutoRecreateHandler(t *testing.T) {
+	for _, tc := range autoRecreateTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kit := order.NewKit(order.Nonce{1, 2, 3})
+			kit.State = tc.state
+			kit.AutoRecreate = tc.autoRecreate
+			kit.AutoRecreateLimit = tc.limit
+			kit.FixedRate = 100
+			kit.LeaseDuration = 2016
+			kit.Units = 10
+			kit.UnitsUnfulfilled = 0
+			kit.Amt = kit.Units.ToSatoshis()
+			ask := &order.Ask{
+				Kit: *kit,
+			}
+
+			batch := &order.Batch{
+				MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+					ask.Nonce(): nil,
+				},
+			}
+
+			var submitted []order.Order
+			handler := &autoRecreateHandler{
+				getOrder: func(nonce order.Nonce) (order.Order,
+					error) {
+
+					require.Equal(t, ask.Nonce(), nonce)
+					return ask, nil
+				},
+				checkPendingLimit: func() error {
+					if tc.pendingLimitReached {
+						return errors.New("limit reached")
+					}
+					return nil
+				},
+				submitOrder: func(_ context.Context,
+					o order.Order) error {
+
+					submitted = append(submitted, o)
+					return nil
+				},
+			}
+			handler.handleBatch(context.Background(), batch)
+
+			if !tc.expectRecreated {
+				require.Empty(t, submitted)
+				return
+			}
+
+			require.Len(t, submitted, 1)
+			newAsk, ok := submitted[0].(*order.Ask)
+			require.True(t, ok)
+
+			details := newAsk.Details()
+			require.NotEqual(t, ask.Nonce(), newAsk.Nonce())
+			require.Equal(
+				t, newAsk.Nonce(),
+				order.Nonce(details.Preimage.Hash()),
+			)
+			require.Equal(t, order.StateSubmitted, details.State)
+			require.Equal(t, ask.FixedRate, details.FixedRate)
+			require.Equal(t, ask.Units, details.Units)
+			require.Equal(t, ask.Units, details.UnitsUnfulfilled)
+			require.Equal(
+				t, tc.expectAutoRecreate, details.AutoRecreate,
+			)
+			require.Equal(t, tc.expectLimit, details.AutoRecreateLimit)
+		})
+	}
+}
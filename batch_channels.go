@@ -0,0 +1,163 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// expectedBatchChannel is a channel the trader expects to be opened by the
+// transaction of a batch.
+type expectedBatchChannel struct {
+	chanPoint wire.OutPoint
+	amt       btcutil.Amount
+	purchased bool
+	nodeKey   [33]byte
+}
+
+// locateBatchChannel derives the funding output of the channel created for
+// the given match of our order and returns its outpoint in the batch
+// transaction. False is returned if there is no channel to look for because
+// the recipient of a sidecar order isn't known yet.
+func (s *rpcServer) locateBatchChannel(ctx context.Context,
+	batchTx *wire.MsgTx, ourOrder order.Order,
+	match *order.MatchedOrder) (*wire.OutPoint, bool, error) {
+
+	ourMultiSigKey, err := s.server.lndServices.WalletKit.DeriveKey(
+		ctx, &ourOrder.Details().MultiSigKeyLocator,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	ourMultiSigPubKey := ourMultiSigKey.PubKey
+
+	// For sidecar orders the multisig key used isn't our own.
+	ourBid, isBid := ourOrder.(*order.Bid)
+	if isBid && ourBid.SidecarTicket != nil {
+		if ourBid.SidecarTicket.Recipient == nil {
+			return nil, false, nil
+		}
+
+		ourMultiSigPubKey = ourBid.SidecarTicket.Recipient.MultiSigPubKey
+	}
+
+	// Derive the channel output script, which we'll use to locate the
+	// channel outpoint within the batch transaction.
+	_, chanOutput, err := input.GenFundingPkScript(
+		ourMultiSigPubKey.SerializeCompressed(), match.MultiSigKey[:],
+		int64(match.UnitsFilled.ToSatoshis()),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	batchTxHash := batchTx.TxHash()
+	outputIdx, ok := poolscript.LocateOutputScript(
+		batchTx, chanOutput.PkScript,
+	)
+	if !ok {
+		return nil, false, fmt.Errorf("channel with script %x not "+
+			"found in batch transaction %v", chanOutput.PkScript,
+			batchTxHash)
+	}
+
+	return &wire.OutPoint{Hash: batchTxHash, Index: outputIdx}, true, nil
+}
+
+// expectedBatchChannels returns all channels of the trader that are created
+// by the transaction of the given batch.
+func (s *rpcServer) expectedBatchChannels(ctx context.Context,
+	batch *clientdb.LocalBatchSnapshot) ([]*expectedBatchChannel, error) {
+
+	var channels []*expectedBatchChannel
+	for nonce, matches := range batch.MatchedOrders {
+		ourOrder, ok := batch.Orders[nonce]
+		if !ok {
+			return nil, fmt.Errorf("order %v not found in batch "+
+				"snapshot", nonce)
+		}
+
+		for _, match := range matches {
+			chanPoint, ok, err := s.locateBatchChannel(
+				ctx, batch.BatchTX, ourOrder, match,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			channels = append(channels, &expectedBatchChannel{
+				chanPoint: *chanPoint,
+				amt:       match.UnitsFilled.ToSatoshis(),
+				purchased: ourOrder.Type() == order.TypeBid,
+				nodeKey:   match.NodeKey,
+			})
+		}
+	}
+
+	return channels, nil
+}
+
+// batchChannelStates cross-references the expected channels of a batch with
+// the pending and open channels reported by lnd.
+func batchChannelStates(expected []*expectedBatchChannel,
+	pendingChans *lnrpc.PendingChannelsResponse,
+	openChans *lnrpc.ListChannelsResponse,
+	bestHeight uint32) []*poolrpc.PendingBatchChannel {
+
+	pending := make(map[string]struct{})
+	for _, pendingChan := range pendingChans.PendingOpenChannels {
+		if pendingChan.Channel == nil {
+			continue
+		}
+
+		pending[pendingChan.Channel.ChannelPoint] = struct{}{}
+	}
+
+	// For open channels we know the height the batch transaction
+	// confirmed in from the short channel ID.
+	confHeights := make(map[string]uint32)
+	for _, channel := range openChans.Channels {
+		sid := lnwire.NewShortChanIDFromInt(channel.ChanId)
+		confHeights[channel.ChannelPoint] = sid.BlockHeight
+	}
+
+	rpcChannels := make([]*poolrpc.PendingBatchChannel, 0, len(expected))
+	for _, channel := range expected {
+		chanPoint := channel.chanPoint.String()
+		rpcChannel := &poolrpc.PendingBatchChannel{
+			ChannelPoint:   chanPoint,
+			ChannelAmtSat:  uint64(channel.amt),
+			Purchased:      channel.purchased,
+			ChannelNodeKey: channel.nodeKey[:],
+			State:          poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_UNKNOWN,
+		}
+
+		if _, ok := pending[chanPoint]; ok {
+			rpcChannel.State = poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_PENDING_OPEN
+		}
+
+		if confHeight, ok := confHeights[chanPoint]; ok {
+			rpcChannel.State = poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_OPEN
+			if bestHeight >= confHeight {
+				rpcChannel.NumConfirmations =
+					bestHeight - confHeight + 1
+			}
+		}
+
+		rpcChannels = append(rpcChannels, rpcChannel)
+	}
+
+	return rpcChannels
+}
@@ -0,0 +1,92 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchChannelStates makes sure the expected channels of a batch are
+// correctly matched against the pending and open channels lnd reports.
+func TestBatchChannelStates(t *testing.T) {
+	t.Parallel()
+
+	batchTxHash := chainhash.Hash{1, 2, 3}
+	newChannel := func(idx uint32, purchased bool) *expectedBatchChannel {
+		return &expectedBatchChannel{
+			chanPoint: wire.OutPoint{Hash: batchTxHash, Index: idx},
+			amt:       100_000,
+			purchased: purchased,
+			nodeKey:   [33]byte{byte(idx)},
+		}
+	}
+	expected := []*expectedBatchChannel{
+		newChannel(0, true), newChannel(1, false), newChannel(2, true),
+	}
+
+	// The first channel is still pending, the second one is open and the
+	// third one is unknown to lnd. Channels that aren't part of the batch
+	// are ignored.
+	pendingChans := &lnrpc.PendingChannelsResponse{
+		PendingOpenChannels: []*lnrpc.PendingChannelsResponse_PendingOpenChannel{{
+			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+				ChannelPoint: expected[0].chanPoint.String(),
+			},
+		}, {
+			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
+				ChannelPoint: wire.OutPoint{Index: 7}.String(),
+			},
+		}, {
+			// A broken entry without channel must be skipped.
+		}},
+	}
+	confHeight := uint32(100)
+	openChans := &lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{{
+			ChannelPoint: expected[1].chanPoint.String(),
+			ChanId: lnwire.ShortChannelID{
+				BlockHeight: confHeight,
+			}.ToUint64(),
+		}},
+	}
+
+	channels := batchChannelStates(
+		expected, pendingChans, openChans, confHeight+5,
+	)
+	require.Len(t, channels, 3)
+
+	require.Equal(t, expected[0].chanPoint.String(), channels[0].ChannelPoint)
+	require.EqualValues(t, 100_000, channels[0].ChannelAmtSat)
+	require.True(t, channels[0].Purchased)
+	require.Equal(t, expected[0].nodeKey[:], channels[0].ChannelNodeKey)
+	require.Equal(
+		t, poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_PENDING_OPEN,
+		channels[0].State,
+	)
+	require.Zero(t, channels[0].NumConfirmations)
+
+	require.False(t, channels[1].Purchased)
+	require.Equal(
+		t, poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_OPEN,
+		channels[1].State,
+	)
+	require.EqualValues(t, 6, channels[1].NumConfirmations)
+
+	require.Equal(
+		t, poolrpc.BatchChannelState_BATCH_CHANNEL_STATE_UNKNOWN,
+		channels[2].State,
+	)
+	require.Zero(t, channels[2].NumConfirmations)
+
+	// A best height that lags behind the confirmation height must not
+	// underflow.
+	channels = batchChannelStates(
+		expected, pendingChans, openChans, confHeight-1,
+	)
+	require.Zero(t, channels[1].NumConfirmations)
+}
@@ -0,0 +1,312 @@
+package pool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// defaultBatchConfirmTimeout is the default time we wait for a batch
+	// that requires confirmation to be accepted before rejecting it.
+	defaultBatchConfirmTimeout = 10 * time.Second
+
+	// batchConfirmationBuffer is the number of batches that are buffered
+	// for each subscriber. Batches for subscribers that don't keep up are
+	// dropped.
+	batchConfirmationBuffer = 20
+)
+
+var (
+	// errBatchNotConfirmed is the reason we reject a batch with if it
+	// required confirmation but wasn't accepted in time.
+	errBatchNotConfirmed = errors.New("batch was not confirmed")
+
+	// errUnknownBatchConfirmation is returned if a batch that isn't
+	// waiting for its confirmation is accepted or rejected.
+	errUnknownBatchConfirmation = errors.New("no batch with that ID is " +
+		"waiting for its confirmation")
+)
+
+// batchEconomics sums up what the trader would earn and pay in a batch.
+type batchEconomics struct {
+	// premiumEarned is the premium the trader's asks earn.
+	premiumEarned btcutil.Amount
+
+	// premiumPaid is the premium the trader's bids pay.
+	premiumPaid btcutil.Amount
+
+	// fees is the execution fee paid to the auctioneer plus the estimated
+	// chain fee of the batch transaction.
+	fees btcutil.Amount
+
+	// hasAsks is true if any of the trader's asks were matched.
+	hasAsks bool
+}
+
+// computeBatchEconomics calculates the premiums and fees of all our orders
+// that were matched in the given batch.
+//
+// NOTE: Just like for the Leases call, the chain fee is only an approximation
+// based on the number of channels created for the trader.
+func computeBatchEconomics(batch *order.Batch,
+	getOrder order.Fetcher) (*batchEconomics, error) {
+
+	var (
+		economics batchEconomics
+		numChans  uint32
+	)
+	for nonce, matches := range batch.MatchedOrders {
+		ourOrder, err := getOrder(nonce)
+		if err != nil {
+			return nil, fmt.Errorf("order %v not found: %v", nonce,
+				err)
+		}
+
+		for _, match := range matches {
+			// The duration of the channel is always that specified
+			// by the bid order.
+			duration := match.Order.Details().LeaseDuration
+			if ourOrder.Type() == order.TypeBid {
+				duration = ourOrder.Details().LeaseDuration
+			}
+
+			// Older batch versions only have a single clearing
+			// price in the legacy bucket.
+			clearingPrice, ok := batch.ClearingPrices[duration]
+			if !ok {
+				clearingPrice = batch.ClearingPrices[order.LegacyLeaseDurationBucket]
+			}
+
+			chanAmt := match.UnitsFilled.ToSatoshis()
+			premium := clearingPrice.LumpSumPremium(chanAmt, duration)
+			if ourOrder.Type() == order.TypeAsk {
+				economics.premiumEarned += premium
+				economics.hasAsks = true
+			} else {
+				economics.premiumPaid += premium
+			}
+
+			economics.fees += batch.ExecutionFee.BaseFee() +
+				batch.ExecutionFee.ExecutionFee(chanAmt)
+			numChans++
+		}
+	}
+
+	economics.fees += order.EstimateTraderFee(
+		numChans, batch.BatchTxFeeRate, account.VersionInitialNoVersion,
+	)
+
+	return &economics, nil
+}
+
+// confirmationReason returns why a batch with these economics can't be
+// accepted automatically with the given limits. An empty string is returned if
+// the batch is within the limits. Limits that are zero aren't enforced.
+func (e *batchEconomics) confirmationReason(minPremium,
+	maxFee btcutil.Amount) string {
+
+	switch {
+	case minPremium > 0 && e.hasAsks && e.premiumEarned < minPremium:
+		return fmt.Sprintf("premium earned %v is below the minimum "+
+			"of %v", e.premiumEarned, minPremium)
+
+	case maxFee > 0 && e.fees > maxFee:
+		return fmt.Sprintf("fees of %v exceed the maximum of %v",
+			e.fees, maxFee)
+
+	default:
+		return ""
+	}
+}
+
+// pendingBatchConfirmation is a batch that is waiting to be accepted or
+// rejected by the user.
+type pendingBatchConfirmation struct {
+	economics *batchEconomics
+	reason    string
+	expiry    time.Time
+
+	// decision receives true if the batch is accepted and false if it is
+	// rejected. It is buffered so a decision never blocks.
+	decision chan bool
+}
+
+// batchConfirmations keeps track of all batches that are waiting for their
+// confirmation.
+type batchConfirmations struct {
+	clock clock.Clock
+
+	mu      sync.Mutex
+	pending map[order.BatchID]*pendingBatchConfirmation
+
+	subscribers    map[uint64]chan *poolrpc.BatchConfirmation
+	nextSubscriber uint64
+}
+
+// newBatchConfirmations creates a new store for batches waiting for their
+// confirmation.
+func newBatchConfirmations(clk clock.Clock) *batchConfirmations {
+	return &batchConfirmations{
+		clock:       clk,
+		pending:     make(map[order.BatchID]*pendingBatchConfirmation),
+		subscribers: make(map[uint64]chan *poolrpc.BatchConfirmation),
+	}
+}
+
+// await registers the batch as waiting for its confirmation and blocks until
+// it is either accepted or rejected, the timeout expires or the quit channel is
+// closed. True is only returned if the batch was accepted.
+func (c *batchConfirmations) await(id order.BatchID,
+	economics *batchEconomics, reason string, timeout time.Duration,
+	quit <-chan struct{}) bool {
+
+	pending := &pendingBatchConfirmation{
+		economics: economics,
+		reason:    reason,
+		expiry:    c.clock.Now().Add(timeout),
+		decision:  make(chan bool, 1),
+	}
+
+	c.mu.Lock()
+	c.pending[id] = pending
+	c.notify(marshalBatchConfirmation(id, pending))
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		if c.pending[id] == pending {
+			delete(c.pending, id)
+		}
+		c.mu.Unlock()
+	}()
+
+	select {
+	case accepted := <-pending.decision:
+		return accepted
+
+	case <-c.clock.TickAfter(timeout):
+		return false
+
+	case <-quit:
+		return false
+	}
+}
+
+// decide accepts or rejects the batch with the given ID that is waiting for its
+// confirmation.
+func (c *batchConfirmations) decide(id order.BatchID, accept bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pending, ok := c.pending[id]
+	if !ok {
+		return errUnknownBatchConfirmation
+	}
+	delete(c.pending, id)
+
+	pending.decision <- accept
+
+	return nil
+}
+
+// notify sends a batch that started waiting for its confirmation to all
+// subscribers.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *batchConfirmations) notify(batch *poolrpc.BatchConfirmation) {
+	for _, subscriber := range c.subscribers {
+		select {
+		case subscriber <- batch:
+		default:
+			log.Warnf("Dropping batch confirmation for slow " +
+				"subscriber")
+		}
+	}
+}
+
+// subscribe returns a channel that receives all batches that are currently
+// waiting for their confirmation, followed by every batch that starts waiting
+// for it in the future, and a function to cancel the subscription. The channel
+// is closed once the subscription is canceled.
+func (c *batchConfirmations) subscribe() (<-chan *poolrpc.BatchConfirmation,
+	func()) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := c.nextSubscriber
+	c.nextSubscriber++
+
+	pending := c.sortedPending()
+	batches := make(
+		chan *poolrpc.BatchConfirmation,
+		batchConfirmationBuffer+len(pending),
+	)
+	for _, batch := range pending {
+		batches <- batch
+	}
+	c.subscribers[id] = batches
+
+	cancel := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if _, ok := c.subscribers[id]; ok {
+			delete(c.subscribers, id)
+			close(batches)
+		}
+	}
+
+	return batches, cancel
+}
+
+// list returns all batches that are waiting for their confirmation, ordered
+// by their expiry.
+func (c *batchConfirmations) list() []*poolrpc.BatchConfirmation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sortedPending()
+}
+
+// sortedPending returns all batches that are waiting for their confirmation,
+// ordered by their expiry.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *batchConfirmations) sortedPending() []*poolrpc.BatchConfirmation {
+	batches := make([]*poolrpc.BatchConfirmation, 0, len(c.pending))
+	for id, pending := range c.pending {
+		batches = append(batches, marshalBatchConfirmation(id, pending))
+	}
+
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].ExpiryTimestampNs <
+			batches[j].ExpiryTimestampNs
+	})
+
+	return batches
+}
+
+// marshalBatchConfirmation converts a batch waiting for its confirmation into
+// its RPC representation.
+func marshalBatchConfirmation(id order.BatchID,
+	pending *pendingBatchConfirmation) *poolrpc.BatchConfirmation {
+
+	return &poolrpc.BatchConfirmation{
+		BatchId:           id[:],
+		PremiumEarnedSat:  uint64(pending.economics.premiumEarned),
+		PremiumPaidSat:    uint64(pending.economics.premiumPaid),
+		FeesSat:           uint64(pending.economics.fees),
+		Reason:            pending.reason,
+		ExpiryTimestampNs: pending.expiry.UnixNano(),
+	}
+}
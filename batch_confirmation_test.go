@@ -0,0 +1,300 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// newEconomicsBatch returns a batch in which one of our asks and one of our
+// bids are matched, together with the function to look up our orders.
+func newEconomicsBatch() (*order.Batch, order.Fetcher) {
+	ask := &order.Ask{Kit: *order.NewKit(order.Nonce{1})}
+	ask.LeaseDuration = 4032
+	bid := &order.Bid{Kit: *order.NewKit(order.Nonce{2})}
+	bid.LeaseDuration = 2016
+
+	otherBid := &order.Bid{Kit: *order.NewKit(order.Nonce{3})}
+	otherBid.LeaseDuration = 4032
+	otherAsk := &order.Ask{Kit: *order.NewKit(order.Nonce{4})}
+	otherAsk.LeaseDuration = 2016
+
+	ours := map[order.Nonce]order.Order{
+		ask.Nonce(): ask,
+		bid.Nonce(): bid,
+	}
+	batch := &order.Batch{
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			ask.Nonce(): {{
+				Order:       otherBid,
+				UnitsFilled: 10,
+			}},
+			bid.Nonce(): {{
+				Order:       otherAsk,
+				UnitsFilled: 5,
+			}},
+		},
+		ExecutionFee: terms.NewLinearFeeSchedule(1, 1000),
+		ClearingPrices: map[uint32]order.FixedRatePremium{
+			4032: 1000,
+			2016: 500,
+		},
+		BatchTxFeeRate: chainfee.FeePerKwFloor,
+	}
+
+	return batch, func(nonce order.Nonce) (order.Order, error) {
+		o, ok := ours[nonce]
+		if !ok {
+			return nil, clientdb.ErrNoOrder
+		}
+		return o, nil
+	}
+}
+
+// TestBatchEconomics makes sure the premiums and fees of a batch are summed up
+// correctly and that only batches outside of the limits require confirmation.
+func TestBatchEconomics(t *testing.T) {
+	t.Parallel()
+
+	batch, getOrder := newEconomicsBatch()
+	economics, err := computeBatchEconomics(batch, getOrder)
+	require.NoError(t, err)
+
+	// The ask earns 1M sats * 1000 ppb * 4032 blocks, the bid pays 500k
+	// sats * 500 ppb * 2016 blocks.
+	chainFee := order.EstimateTraderFee(
+		2, chainfee.FeePerKwFloor, account.VersionInitialNoVersion,
+	)
+	require.Equal(t, btcutil.Amount(4032), economics.premiumEarned)
+	require.Equal(t, btcutil.Amount(504), economics.premiumPaid)
+	require.Equal(t, 1+1000+1+500+chainFee, economics.fees)
+	require.True(t, economics.hasAsks)
+
+	testCases := []struct {
+		name       string
+		minPremium btcutil.Amount
+		maxFee     btcutil.Amount
+		confirm    bool
+	}{{
+		name: "no limits",
+	}, {
+		name:       "within limits",
+		minPremium: 4032,
+		maxFee:     economics.fees,
+	}, {
+		name:       "premium too low",
+		minPremium: 4033,
+		confirm:    true,
+	}, {
+		name:    "fees too high",
+		maxFee:  economics.fees - 1,
+		confirm: true,
+	}}
+	for _, tc := range testCases {
+		reason := economics.confirmationReason(tc.minPremium, tc.maxFee)
+		require.Equal(t, tc.confirm, reason != "", tc.name)
+	}
+
+	// The minimum premium doesn't apply to batches we only buy in.
+	bidsOnly := &batchEconomics{premiumPaid: 100}
+	require.Empty(t, bidsOnly.confirmationReason(1000, 0))
+
+	// Unknown orders can't be evaluated.
+	batch.MatchedOrders[order.Nonce{5}] = nil
+	_, err = computeBatchEconomics(batch, getOrder)
+	require.Error(t, err)
+}
+
+// TestBatchConfirmations tests that a batch waiting for its confirmation is
+// only accepted if it's confirmed in time.
+func TestBatchConfirmations(t *testing.T) {
+	t.Parallel()
+
+	var (
+		startTime  = time.Unix(1_000_000, 0)
+		timeout    = 10 * time.Second
+		batchID    = order.BatchID{1}
+		economics  = &batchEconomics{fees: 1000}
+		tickSignal = make(chan time.Duration)
+		testClock  = clock.NewTestClockWithTickSignal(
+			startTime, tickSignal,
+		)
+		confirmations = newBatchConfirmations(testClock)
+		quit          = make(chan struct{})
+	)
+
+	awaitAsync := func() chan bool {
+		result := make(chan bool, 1)
+		go func() {
+			result <- confirmations.await(
+				batchID, economics, "fees too high", timeout,
+				quit,
+			)
+		}()
+
+		// Wait for the timer to be registered, after which the batch
+		// is listed.
+		<-tickSignal
+
+		return result
+	}
+
+	// Batches that aren't waiting can't be decided on.
+	require.ErrorIs(
+		t, confirmations.decide(batchID, true),
+		errUnknownBatchConfirmation,
+	)
+
+	// An accepted batch is accepted.
+	result := awaitAsync()
+	pending := confirmations.list()
+	require.Len(t, pending, 1)
+	require.Equal(t, batchID[:], pending[0].BatchId)
+	require.EqualValues(t, 1000, pending[0].FeesSat)
+	require.Equal(t, "fees too high", pending[0].Reason)
+	require.Equal(
+		t, startTime.Add(timeout).UnixNano(),
+		pending[0].ExpiryTimestampNs,
+	)
+
+	require.NoError(t, confirmations.decide(batchID, true))
+	require.True(t, <-result)
+	require.Empty(t, confirmations.list())
+
+	// A rejected batch is rejected.
+	result = awaitAsync()
+	require.NoError(t, confirmations.decide(batchID, false))
+	require.False(t, <-result)
+
+	// A batch that isn't confirmed in time is rejected.
+	result = awaitAsync()
+	testClock.SetTime(startTime.Add(timeout))
+	require.False(t, <-result)
+	require.Empty(t, confirmations.list())
+	require.ErrorIs(
+		t, confirmations.decide(batchID, true),
+		errUnknownBatchConfirmation,
+	)
+
+	// And so is a batch that is waiting while we shut down.
+	testClock.SetTime(startTime)
+	result = awaitAsync()
+	close(quit)
+	require.False(t, <-result)
+}
+
+// TestSubscribeBatchConfirmations tests that subscribers receive the batches
+// that are already waiting for their confirmation and all that start waiting
+// afterwards.
+func TestSubscribeBatchConfirmations(t *testing.T) {
+	t.Parallel()
+
+	var (
+		timeout    = 10 * time.Second
+		economics  = &batchEconomics{fees: 1000}
+		tickSignal = make(chan time.Duration)
+		testClock  = clock.NewTestClockWithTickSignal(
+			time.Unix(1_000_000, 0), tickSignal,
+		)
+		confirmations = newBatchConfirmations(testClock)
+		quit          = make(chan struct{})
+	)
+	defer close(quit)
+
+	awaitAsync := func(id order.BatchID) {
+		go func() {
+			_ = confirmations.await(
+				id, economics, "fees too high", timeout, quit,
+			)
+		}()
+
+		<-tickSignal
+	}
+
+	// A batch that is already waiting is sent as soon as we subscribe.
+	awaitAsync(order.BatchID{1})
+	batches, cancel := confirmations.subscribe()
+
+	batch := <-batches
+	require.Equal(t, []byte{1}, batch.BatchId[:1])
+	require.Equal(t, "fees too high", batch.Reason)
+
+	// And so is every batch that starts waiting later on.
+	awaitAsync(order.BatchID{2})
+	batch = <-batches
+	require.Equal(t, []byte{2}, batch.BatchId[:1])
+	require.EqualValues(t, 1000, batch.FeesSat)
+
+	// Once the subscription is canceled, its channel is closed and no
+	// longer receives any batches.
+	cancel()
+	_, ok := <-batches
+	require.False(t, ok)
+
+	awaitAsync(order.BatchID{3})
+	require.Len(t, confirmations.list(), 3)
+}
+
+// TestAwaitBatchConfirmationLimiter makes sure a batch step that waits for its
+// confirmation doesn't hold up the steps of other batches.
+func TestAwaitBatchConfirmationLimiter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		batchID    = order.BatchID{1}
+		tickSignal = make(chan time.Duration)
+		testClock  = clock.NewTestClockWithTickSignal(
+			time.Unix(1_000_000, 0), tickSignal,
+		)
+		limiter = newBatchLimiter(1)
+		quit    = make(chan struct{})
+	)
+	defer close(quit)
+
+	srv := &rpcServer{
+		server: &Server{
+			cfg: &Config{
+				BatchConfirmTimeout: 10 * time.Second,
+			},
+			batchLimiter: limiter,
+		},
+		batchConfirmations: newBatchConfirmations(testClock),
+		quit:               quit,
+	}
+
+	// The batch step holds the only slot of the limiter when it starts
+	// waiting for the confirmation.
+	require.True(t, limiter.acquire(quit))
+	result := make(chan bool, 1)
+	go func() {
+		result <- srv.awaitBatchConfirmation(
+			batchID, &batchEconomics{fees: 1000}, "fees too high",
+		)
+	}()
+	<-tickSignal
+
+	// While it's waiting, the step of another batch can be processed.
+	require.True(t, limiter.acquire(quit))
+
+	// Once the batch is accepted, the waiting step only continues after
+	// it got its slot back.
+	require.NoError(t, srv.batchConfirmations.decide(batchID, true))
+	select {
+	case <-result:
+		t.Fatalf("batch step continued without a limiter slot")
+
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	limiter.release()
+	require.True(t, <-result)
+	require.Len(t, limiter.sem, 1)
+}
@@ -0,0 +1,129 @@
+package pool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// batchHistoryPruneInterval is the interval in which the persisted
+	// batch snapshots are checked for entries that are older than the
+	// configured retention window.
+	batchHistoryPruneInterval = time.Hour
+)
+
+// batchSnapshotPruner is the store that persists the snapshots of the batches
+// we participated in and is able to remove old ones.
+type batchSnapshotPruner interface {
+	// PruneLocalBatchSnapshots removes all batch snapshots that were
+	// finalized before the given time, except for the batches in the keep
+	// set, and returns the number of removed snapshots.
+	PruneLocalBatchSnapshots(olderThan, now time.Time,
+		keep map[order.BatchID]struct{}) (int, error)
+}
+
+// batchHistoryPruner periodically removes persisted batch snapshots that are
+// older than the configured retention window and didn't create any lease that
+// is still active.
+type batchHistoryPruner struct {
+	started sync.Once
+	stopped sync.Once
+
+	store         batchSnapshotPruner
+	activeBatches func() (map[order.BatchID]struct{}, error)
+	clock         clock.Clock
+	retention     time.Duration
+	interval      time.Duration
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newBatchHistoryPruner creates a new pruner that removes the batch snapshots
+// of the given store once they are older than the retention window. The
+// snapshots of the batches returned by activeBatches are never removed.
+func newBatchHistoryPruner(store batchSnapshotPruner,
+	activeBatches func() (map[order.BatchID]struct{}, error),
+	clk clock.Clock, retention,
+	interval time.Duration) *batchHistoryPruner {
+
+	return &batchHistoryPruner{
+		store:         store,
+		activeBatches: activeBatches,
+		clock:         clk,
+		retention:     retention,
+		interval:      interval,
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start prunes old batch snapshots once and then starts pruning them
+// periodically.
+func (p *batchHistoryPruner) Start() {
+	p.started.Do(func() {
+		log.Infof("Pruning batch snapshots older than %v",
+			p.retention)
+
+		p.prune()
+
+		p.wg.Add(1)
+		go p.pruneLoop()
+	})
+}
+
+// Stop stops pruning batch snapshots and waits for an ongoing prune run to
+// finish.
+func (p *batchHistoryPruner) Stop() {
+	p.stopped.Do(func() {
+		close(p.quit)
+		p.wg.Wait()
+	})
+}
+
+// pruneLoop prunes old batch snapshots in the configured interval.
+//
+// NOTE: This must be run as a goroutine.
+func (p *batchHistoryPruner) pruneLoop() {
+	defer p.wg.Done()
+
+	ticker := p.clock.TickAfter(p.interval)
+	for {
+		select {
+		case <-ticker:
+			p.prune()
+			ticker = p.clock.TickAfter(p.interval)
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// prune removes all batch snapshots that are older than the retention window
+// and don't have any active lease. If we can't tell which leases are active,
+// nothing is removed.
+func (p *batchHistoryPruner) prune() {
+	active, err := p.activeBatches()
+	if err != nil {
+		log.Errorf("Unable to determine batches with active leases, "+
+			"not pruning batch snapshots: %v", err)
+		return
+	}
+
+	now := p.clock.Now()
+	numPruned, err := p.store.PruneLocalBatchSnapshots(
+		now.Add(-p.retention), now, active,
+	)
+	if err != nil {
+		log.Errorf("Unable to prune batch snapshots: %v", err)
+		return
+	}
+
+	if numPruned > 0 {
+		log.Infof("Pruned %d batch snapshots older than %v", numPruned,
+			p.retention)
+	}
+}
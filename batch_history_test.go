@@ -0,0 +1,88 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotPruner is a batch snapshot store that records the times it was
+// asked to prune snapshots for.
+type mockSnapshotPruner struct {
+	t *testing.T
+
+	keep map[order.BatchID]struct{}
+
+	calls chan [2]time.Time
+}
+
+// PruneLocalBatchSnapshots records the prune request.
+func (m *mockSnapshotPruner) PruneLocalBatchSnapshots(olderThan,
+	now time.Time, keep map[order.BatchID]struct{}) (int, error) {
+
+	require.Equal(m.t, m.keep, keep)
+
+	m.calls <- [2]time.Time{olderThan, now}
+	return 1, nil
+}
+
+// TestBatchHistoryPruner makes sure the pruner removes snapshots older than
+// the retention window on start and then in the configured interval, keeping
+// the ones with active leases.
+func TestBatchHistoryPruner(t *testing.T) {
+	t.Parallel()
+
+	const (
+		retention = 7 * 24 * time.Hour
+		interval  = time.Hour
+	)
+
+	start := time.Unix(1_700_000_000, 0)
+	tickSignal := make(chan time.Duration, 1)
+	testClock := clock.NewTestClockWithTickSignal(start, tickSignal)
+	active := map[order.BatchID]struct{}{{1, 2, 3}: {}}
+	activeBatches := func() (map[order.BatchID]struct{}, error) {
+		return active, nil
+	}
+	store := &mockSnapshotPruner{
+		t:     t,
+		keep:  active,
+		calls: make(chan [2]time.Time, 1),
+	}
+	pruner := newBatchHistoryPruner(
+		store, activeBatches, testClock, retention, interval,
+	)
+
+	assertPrune := func(now time.Time) {
+		select {
+		case call := <-store.calls:
+			require.Equal(t, now.Add(-retention), call[0])
+			require.Equal(t, now, call[1])
+
+		case <-time.After(time.Second):
+			t.Fatalf("snapshots not pruned")
+		}
+	}
+
+	// The first prune run happens right on start.
+	pruner.Start()
+	defer pruner.Stop()
+	assertPrune(start)
+
+	// Wait for the pruner to wait for the next tick before advancing the
+	// clock.
+	select {
+	case d := <-tickSignal:
+		require.Equal(t, interval, d)
+
+	case <-time.After(time.Second):
+		t.Fatalf("pruner not waiting for next tick")
+	}
+
+	next := start.Add(interval)
+	testClock.SetTime(next)
+	assertPrune(next)
+}
@@ -0,0 +1,68 @@
+package pool
+
+import (
+	"github.com/lightninglabs/pool/auctioneerrpc"
+)
+
+// batchLimiter limits the number of batch execution steps that are processed
+// at the same time. Both the trader's own orders and the sidecar channels it
+// receives are executed in batches, each on their own connection to the
+// auction server, so without a limit they can contend on lnd's signer.
+type batchLimiter struct {
+	// sem holds one element for each batch step in progress. It is nil if
+	// the number of concurrent steps isn't limited.
+	sem chan struct{}
+}
+
+// newBatchLimiter creates a new limiter that lets at most maxConcurrent batch
+// steps run at the same time. A value of 0 means no limit.
+func newBatchLimiter(maxConcurrent int) *batchLimiter {
+	l := &batchLimiter{}
+	if maxConcurrent > 0 {
+		l.sem = make(chan struct{}, maxConcurrent)
+	}
+
+	return l
+}
+
+// acquire blocks until a batch step can be processed. False is returned if
+// the quit channel is closed before that. A nil limiter doesn't limit
+// anything.
+func (l *batchLimiter) acquire(quit <-chan struct{}) bool {
+	if l == nil || l.sem == nil {
+		return true
+	}
+
+	select {
+	case l.sem <- struct{}{}:
+		return true
+
+	case <-quit:
+		return false
+	}
+}
+
+// release marks a batch step acquired before as done, letting the next queued
+// one proceed.
+func (l *batchLimiter) release() {
+	if l == nil || l.sem == nil {
+		return
+	}
+
+	<-l.sem
+}
+
+// isBatchStep returns true if the given message of the auction server is one
+// of the steps of the batch execution.
+func isBatchStep(rpcMsg *auctioneerrpc.ServerAuctionMessage) bool {
+	switch rpcMsg.Msg.(type) {
+	case *auctioneerrpc.ServerAuctionMessage_Prepare,
+		*auctioneerrpc.ServerAuctionMessage_Sign,
+		*auctioneerrpc.ServerAuctionMessage_Finalize:
+
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,87 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchLimiter makes sure batch steps beyond the configured limit are
+// queued until a running one completes.
+func TestBatchLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxConcurrent = 2
+		numSteps      = 5
+	)
+
+	var (
+		limiter  = newBatchLimiter(maxConcurrent)
+		quit     = make(chan struct{})
+		started  = make(chan int, numSteps)
+		proceed  = make(chan struct{})
+		finished = make(chan struct{}, numSteps)
+	)
+	for i := 0; i < numSteps; i++ {
+		go func(i int) {
+			require.True(t, limiter.acquire(quit))
+			started <- i
+			<-proceed
+			limiter.release()
+			finished <- struct{}{}
+		}(i)
+	}
+
+	// assertNumStarted waits for exactly num batch steps to be started.
+	assertNumStarted := func(num int) {
+		for i := 0; i < num; i++ {
+			select {
+			case <-started:
+			case <-time.After(time.Second):
+				t.Fatalf("batch step %d not started", i)
+			}
+		}
+
+		select {
+		case i := <-started:
+			t.Fatalf("batch step %d started beyond limit", i)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// Only the first steps up to the limit can run, all others are queued
+	// until one of the running steps completes.
+	assertNumStarted(maxConcurrent)
+	for i := maxConcurrent; i < numSteps; i++ {
+		proceed <- struct{}{}
+		assertNumStarted(1)
+	}
+
+	close(proceed)
+	for i := 0; i < numSteps; i++ {
+		<-finished
+	}
+
+	// A queued step is abandoned on shutdown.
+	for i := 0; i < maxConcurrent; i++ {
+		require.True(t, limiter.acquire(quit))
+	}
+	close(quit)
+	require.False(t, limiter.acquire(quit))
+}
+
+// TestBatchLimiterUnlimited makes sure batch steps are never queued if no
+// limit is configured.
+func TestBatchLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	quit := make(chan struct{})
+	for _, limiter := range []*batchLimiter{newBatchLimiter(0), nil} {
+		for i := 0; i < 100; i++ {
+			require.True(t, limiter.acquire(quit))
+		}
+		limiter.release()
+	}
+}
@@ -0,0 +1,105 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+var (
+	// errBatchPrepareTimeout is the reason we reject a batch with if its
+	// preparation didn't complete in time.
+	errBatchPrepareTimeout = errors.New("batch preparation timed out")
+
+	// errBatchPrepareShutdown is returned if the trader daemon shuts down
+	// while a batch is being prepared.
+	errBatchPrepareShutdown = errors.New("server shutting down")
+)
+
+// batchPreparation bounds the total time the steps of preparing a batch are
+// allowed to take. Most of these steps involve calls to lnd, which could hang
+// and let us miss the batch without any indication why.
+type batchPreparation struct {
+	batchID order.BatchID
+
+	// timeout is the total time all steps can take. A value of 0 means
+	// the preparation isn't limited.
+	timeout time.Duration
+
+	// elapsed is the time the completed steps took so far.
+	elapsed time.Duration
+
+	quit <-chan struct{}
+}
+
+// newBatchPreparation creates a new preparation of the given batch that is
+// allowed to take the given time in total.
+func newBatchPreparation(batchID order.BatchID, timeout time.Duration,
+	quit <-chan struct{}) *batchPreparation {
+
+	return &batchPreparation{
+		batchID: batchID,
+		timeout: timeout,
+		quit:    quit,
+	}
+}
+
+// run executes a single preparation step and waits for it to complete within
+// the remaining preparation time. The step is described by the given name in
+// case it doesn't. The context passed to the step is derived from the given
+// parent context. If the step times out or we shut down, it is canceled and we
+// wait for the step to return, so it can't change any state after the batch
+// was rejected.
+func (p *batchPreparation) run(parentCtx context.Context, step string,
+	prepare func(ctx context.Context) error) error {
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	if p.timeout == 0 {
+		return prepare(ctx)
+	}
+
+	remaining := p.timeout - p.elapsed
+	if remaining <= 0 {
+		return fmt.Errorf("%w after %v before %s", errBatchPrepareTimeout,
+			p.timeout, step)
+	}
+
+	start := time.Now()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- prepare(ctx)
+	}()
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+
+	select {
+	case err := <-errChan:
+		p.elapsed += time.Since(start)
+		return err
+
+	case <-timer.C:
+		p.elapsed = p.timeout
+
+		rpcLog.Errorf("Batch %x: %s didn't complete within the "+
+			"preparation timeout of %v, abandoning batch",
+			p.batchID[:], step, p.timeout)
+
+		cancel()
+		<-errChan
+
+		return fmt.Errorf("%w after %v while %s",
+			errBatchPrepareTimeout, p.timeout, step)
+
+	case <-p.quit:
+		cancel()
+		<-errChan
+
+		return errBatchPrepareShutdown
+	}
+}
@@ -0,0 +1,199 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// slowLightningClient is a lightning client whose GetInfo call blocks until
+// its context is canceled.
+type slowLightningClient struct {
+	lndclient.LightningClient
+
+	// exited is closed once a GetInfo call returned.
+	exited chan struct{}
+}
+
+// GetInfo blocks until the context is canceled.
+func (c *slowLightningClient) GetInfo(
+	ctx context.Context) (*lndclient.Info, error) {
+
+	<-ctx.Done()
+	close(c.exited)
+
+	return nil, ctx.Err()
+}
+
+// assertExited makes sure the GetInfo call of the client returned.
+func (c *slowLightningClient) assertExited(t *testing.T) {
+	t.Helper()
+
+	select {
+	case <-c.exited:
+	default:
+		t.Fatalf("GetInfo call is still running")
+	}
+}
+
+// TestBatchPreparationTimeout makes sure a batch preparation step that hangs
+// in a call to lnd is canceled once the preparation timeout is reached.
+func TestBatchPreparationTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	quit := make(chan struct{})
+
+	// A step that completes in time returns its own result.
+	prep := newBatchPreparation(order.BatchID{1}, time.Second, quit)
+	errStep := errors.New("step failed")
+	require.NoError(t, prep.run(ctx, "fast step", func(
+		context.Context) error {
+
+		return nil
+	}))
+	require.ErrorIs(t, prep.run(ctx, "failing step", func(
+		context.Context) error {
+
+		return errStep
+	}), errStep)
+
+	// A hanging lnd call triggers the timeout, which names the step. The
+	// call is canceled and has returned by the time the step is rejected.
+	lnd := &slowLightningClient{exited: make(chan struct{})}
+	prep = newBatchPreparation(
+		order.BatchID{2}, 50*time.Millisecond, quit,
+	)
+	err := prep.run(ctx, "preparing channel funding", func(
+		ctx context.Context) error {
+
+		_, err := lnd.GetInfo(ctx)
+		return err
+	})
+	require.ErrorIs(t, err, errBatchPrepareTimeout)
+	require.ErrorContains(t, err, "while preparing channel funding")
+	lnd.assertExited(t)
+
+	// The timeout applies to all steps together, so any further step is
+	// rejected right away.
+	err = prep.run(ctx, "next step", func(context.Context) error {
+		t.Fatalf("step must not be executed")
+		return nil
+	})
+	require.ErrorIs(t, err, errBatchPrepareTimeout)
+
+	// Without a timeout, the preparation isn't limited.
+	prep = newBatchPreparation(order.BatchID{3}, 0, quit)
+	require.NoError(t, prep.run(ctx, "unlimited step", func(
+		context.Context) error {
+
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}))
+
+	// The step's context is derived from the parent context, so it's
+	// canceled together with it.
+	parentCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = prep.run(parentCtx, "canceled step", func(
+		ctx context.Context) error {
+
+		return ctx.Err()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	// Shutting down cancels the step as well.
+	lnd = &slowLightningClient{exited: make(chan struct{})}
+	prep = newBatchPreparation(order.BatchID{4}, time.Minute, quit)
+	close(quit)
+	err = prep.run(ctx, "validating batch", func(ctx context.Context) error {
+		_, err := lnd.GetInfo(ctx)
+		return err
+	})
+	require.ErrorIs(t, err, errBatchPrepareShutdown)
+	lnd.assertExited(t)
+}
+
+// TestHandlePrepareTimeout makes sure a prepare message whose channel funding
+// preparation hangs in a call to lnd is rejected once the preparation timeout
+// is reached, after the call was canceled.
+func TestHandlePrepareTimeout(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	lnd := &slowLightningClient{exited: make(chan struct{})}
+	orderMgr := order.NewMockManager(mockCtrl)
+	orderMgr.EXPECT().HasPendingBatch().Return(false)
+	orderMgr.EXPECT().OrderMatchValidate(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	srv := &rpcServer{
+		server: &Server{
+			cfg: &Config{
+				BatchPrepareTimeout: 50 * time.Millisecond,
+				DebugConfig: &DebugConfig{
+					BatchVersion: int32(order.DefaultBatchVersion),
+				},
+			},
+			db: db,
+			channelAcceptor: NewChannelAcceptor(
+				lnd, ChannelAcceptorConfig{},
+			),
+			fundingManager: funding.NewManager(
+				&funding.ManagerConfig{
+					LightningClient:  lnd,
+					BatchStepTimeout: time.Minute,
+				},
+			),
+		},
+		orderManager: orderMgr,
+		auctioneer:   &auctioneer.Client{},
+		quit:         make(chan struct{}),
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{Value: 1})
+
+	var batchTx bytes.Buffer
+	require.NoError(t, tx.Serialize(&batchTx))
+
+	start := time.Now()
+	prepare := &auctioneerrpc.OrderMatchPrepare{
+		BatchId:          traderKeyRaw,
+		BatchTransaction: batchTx.Bytes(),
+		ExecutionFee:     &auctioneerrpc.ExecutionFee{},
+	}
+	err = srv.handleServerMessage(
+		context.Background(), &auctioneerrpc.ServerAuctionMessage{
+			Msg: &auctioneerrpc.ServerAuctionMessage_Prepare{
+				Prepare: prepare,
+			},
+		},
+	)
+
+	// We're not connected to the auctioneer, so sending the reject
+	// message fails. But we only get that far once the hanging GetInfo
+	// call of the funding preparation was canceled.
+	require.ErrorContains(t, err, "error sending reject message")
+	require.Less(t, time.Since(start), time.Minute)
+	lnd.assertExited(t)
+}
@@ -4,13 +4,51 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/internal/retry"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+const (
+	// defaultBatchExecutionWindow is the default maximum amount of time we
+	// consider a batch to be executing after we received the sign message
+	// for it.
+	defaultBatchExecutionWindow = time.Minute
+)
+
+// ChannelAcceptorConfig contains the preferences that determine how the channel
+// acceptor handles incoming channel requests.
+type ChannelAcceptorConfig struct {
+	// MinConfs is the number of confirmations we require before lnd
+	// considers a channel that was opened to us in a batch as open. If
+	// this is zero, lnd's default is used.
+	MinConfs uint32
+
+	// RejectExternalDuringBatch signals that all channel requests that
+	// aren't part of a Pool batch should be rejected while a batch is
+	// being executed.
+	RejectExternalDuringBatch bool
+
+	// BatchExecutionWindow is the maximum amount of time we consider a
+	// batch to be executing after we received the sign message for it. If
+	// we don't hear back from the auctioneer within this time, we assume
+	// the batch execution failed and stop gating external channels. If
+	// this is zero, defaultBatchExecutionWindow is used.
+	BatchExecutionWindow time.Duration
+
+	// MinBackoff is the minimum time that is waited before the acceptor is
+	// registered with lnd again after its stream was terminated.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum time that is waited between attempts to
+	// register the acceptor with lnd again.
+	MaxBackoff time.Duration
+}
+
 // ChannelAcceptor is a type that adds an RPC level interceptor for accepting
 // channels in lnd. Its main task is to validate the self channel balance (or
 // as it's known in the LN lingo: push amount) of incoming channels against the
@@ -18,19 +56,31 @@ import (
 type ChannelAcceptor struct {
 	lightning lndclient.LightningClient
 
+	cfg ChannelAcceptorConfig
+
 	expectedChans    map[[32]byte]*order.Bid
 	expectedChansMtx sync.Mutex
 
+	// batchDeadline is the time until which we consider a batch to be
+	// executing. A zero value means no batch is currently executing.
+	batchDeadline    time.Time
+	batchDeadlineMtx sync.Mutex
+
+	acceptorCtx    context.Context
 	acceptorCancel func()
 	errChan        chan error
 	quit           chan struct{}
 	wg             sync.WaitGroup
 }
 
-// NewChannelAcceptor creates a new channel acceptor with the given lnd client.
-func NewChannelAcceptor(lightning lndclient.LightningClient) *ChannelAcceptor {
+// NewChannelAcceptor creates a new channel acceptor with the given lnd client
+// and preferences.
+func NewChannelAcceptor(lightning lndclient.LightningClient,
+	cfg ChannelAcceptorConfig) *ChannelAcceptor {
+
 	return &ChannelAcceptor{
 		lightning:     lightning,
+		cfg:           cfg,
 		expectedChans: make(map[[32]byte]*order.Bid),
 		quit:          make(chan struct{}),
 	}
@@ -42,10 +92,10 @@ func (s *ChannelAcceptor) Start(errChan chan error) error {
 	s.errChan = errChan
 
 	ctxc := context.Background()
-	ctxc, s.acceptorCancel = context.WithCancel(ctxc)
+	s.acceptorCtx, s.acceptorCancel = context.WithCancel(ctxc)
 
 	rpcErrChan, err := s.lightning.ChannelAcceptor(
-		ctxc, order.DefaultBatchStepTimeout, s.acceptChannel,
+		s.acceptorCtx, order.DefaultBatchStepTimeout, s.acceptChannel,
 	)
 	if err != nil {
 		return err
@@ -58,7 +108,8 @@ func (s *ChannelAcceptor) Start(errChan chan error) error {
 }
 
 // subscribe subscribes to errors coming from the RPC error channel and forwards
-// them to our main error channel.
+// them to our main error channel. Because the acceptor stream is terminated on
+// any error, for example when lnd restarts, it is registered again afterwards.
 func (s *ChannelAcceptor) subscribe(rpcErrChan chan error) {
 	defer s.wg.Done()
 
@@ -70,6 +121,11 @@ func (s *ChannelAcceptor) subscribe(rpcErrChan chan error) {
 			case <-s.quit:
 			}
 
+			rpcErrChan = s.resubscribe()
+			if rpcErrChan == nil {
+				return
+			}
+
 		case <-s.quit:
 			s.acceptorCancel()
 
@@ -78,6 +134,40 @@ func (s *ChannelAcceptor) subscribe(rpcErrChan chan error) {
 	}
 }
 
+// resubscribe registers the acceptor with lnd again, backing off between the
+// attempts. Nil is returned if the acceptor is shutting down.
+func (s *ChannelAcceptor) resubscribe() chan error {
+	var backoff time.Duration
+	for {
+		if s.acceptorCtx.Err() != nil {
+			return nil
+		}
+
+		backoff = retry.NextBackoff(
+			backoff, s.cfg.MinBackoff, s.cfg.MaxBackoff,
+		)
+		select {
+		case <-time.After(backoff):
+		case <-s.quit:
+			return nil
+		}
+
+		rpcErrChan, err := s.lightning.ChannelAcceptor(
+			s.acceptorCtx, order.DefaultBatchStepTimeout,
+			s.acceptChannel,
+		)
+		if err != nil {
+			log.Warnf("Unable to register channel acceptor "+
+				"again: %v", err)
+			continue
+		}
+
+		log.Infof("Channel acceptor registered again")
+
+		return rpcErrChan
+	}
+}
+
 // Stop shuts down the channel acceptor.
 func (s *ChannelAcceptor) Stop() {
 	s.acceptorCancel()
@@ -108,6 +198,38 @@ func (s *ChannelAcceptor) ShimRemoved(bid *order.Bid) {
 	}
 }
 
+// BatchExecutionStarted is a function that should be called whenever we start
+// executing a batch, meaning that channels belonging to the batch are about to
+// be opened.
+func (s *ChannelAcceptor) BatchExecutionStarted() {
+	s.batchDeadlineMtx.Lock()
+	defer s.batchDeadlineMtx.Unlock()
+
+	window := s.cfg.BatchExecutionWindow
+	if window == 0 {
+		window = defaultBatchExecutionWindow
+	}
+
+	s.batchDeadline = time.Now().Add(window)
+}
+
+// BatchExecutionEnded is a function that should be called whenever a batch was
+// either finalized or rejected.
+func (s *ChannelAcceptor) BatchExecutionEnded() {
+	s.batchDeadlineMtx.Lock()
+	defer s.batchDeadlineMtx.Unlock()
+
+	s.batchDeadline = time.Time{}
+}
+
+// batchExecuting returns true if we are currently executing a batch.
+func (s *ChannelAcceptor) batchExecuting() bool {
+	s.batchDeadlineMtx.Lock()
+	defer s.batchDeadlineMtx.Unlock()
+
+	return time.Now().Before(s.batchDeadline)
+}
+
 // acceptChannel is the callback that is invoked each time a new incoming
 // channel message is received in lnd. We inspect it here and if it corresponds
 // to a pending channel ID that we have an expectation for, we check whether the
@@ -122,7 +244,21 @@ func (s *ChannelAcceptor) acceptChannel(_ context.Context,
 
 	// It's not a channel we've registered within the funding manager so we
 	// just accept it to not interfere with the normal node operation.
+	// Unless the user doesn't want any other channels to interfere with an
+	// executing batch.
 	if !ok {
+		if s.cfg.RejectExternalDuringBatch && s.batchExecuting() {
+			log.Debugf("Rejecting external channel %x from %v "+
+				"during batch execution", req.PendingChanID[:],
+				req.NodePubkey)
+
+			return &lndclient.AcceptorResponse{
+				Accept: false,
+				Error: "node is busy executing a batch, please " +
+					"try again later",
+			}, nil
+		}
+
 		return &lndclient.AcceptorResponse{Accept: true}, nil
 	}
 
@@ -212,7 +348,10 @@ func (s *ChannelAcceptor) acceptChannel(_ context.Context,
 		}, nil
 	}
 
+	// Let lnd know how many confirmations we want to see before the
+	// channel is considered ready to be used.
 	return &lndclient.AcceptorResponse{
-		Accept: true,
+		Accept:         true,
+		MinAcceptDepth: s.cfg.MinConfs,
 	}, nil
 }
@@ -0,0 +1,194 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var acceptChannelMinConfsTestCases = []struct {
+	name             string
+	minConfs         uint32
+	zeroConf         bool
+	registered       bool
+	expectedAccept   bool
+	expectedMinDepth uint32
+	expectedZeroConf bool
+}{{
+	name:             "pool channel with lnd default depth",
+	registered:       true,
+	expectedAccept:   true,
+	expectedMinDepth: 0,
+}, {
+	name:             "pool channel with custom depth",
+	minConfs:         6,
+	registered:       true,
+	expectedAccept:   true,
+	expectedMinDepth: 6,
+}, {
+	name:             "zero conf pool channel ignores custom depth",
+	minConfs:         6,
+	zeroConf:         true,
+	registered:       true,
+	expectedAccept:   true,
+	expectedMinDepth: 0,
+	expectedZeroConf: true,
+}, {
+	name:             "non pool channel ignores custom depth",
+	minConfs:         6,
+	expectedAccept:   true,
+	expectedMinDepth: 0,
+}}
+
+// TestAcceptChannelMinConfs makes sure the configured minimum confirmation
+// depth is only applied to channels that are opened as part of a batch.
+func TestAcceptChannelMinConfs(t *testing.T) {
+	for _, tc := range acceptChannelMinConfsTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			acceptor := NewChannelAcceptor(nil, ChannelAcceptorConfig{
+				MinConfs: tc.minConfs,
+			})
+
+			pendingChanID := [32]byte{1, 2, 3}
+			if tc.registered {
+				bid := &order.Bid{
+					Kit:             *order.NewKit(order.Nonce{9}),
+					SelfChanBalance: 1000,
+					ZeroConfChannel: tc.zeroConf,
+				}
+				acceptor.ShimRegistered(bid, pendingChanID)
+			}
+
+			resp, err := acceptor.acceptChannel(
+				context.Background(), &lndclient.AcceptorRequest{
+					PendingChanID: pendingChanID,
+					PushAmt:       1000 * 1000,
+					ChannelFlags: uint32(
+						lnwire.FFAnnounceChannel,
+					),
+					WantsZeroConf: tc.zeroConf,
+				},
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedAccept, resp.Accept)
+			require.Equal(t, tc.expectedMinDepth, resp.MinAcceptDepth)
+			require.Equal(t, tc.expectedZeroConf, resp.ZeroConf)
+		})
+	}
+}
+
+var acceptChannelDuringBatchTestCases = []struct {
+	name           string
+	rejectExternal bool
+	batchExecuting bool
+	registered     bool
+	expectedAccept bool
+}{{
+	name:           "external channel without batch",
+	rejectExternal: true,
+	expectedAccept: true,
+}, {
+	name:           "external channel during batch, not rejecting",
+	batchExecuting: true,
+	expectedAccept: true,
+}, {
+	name:           "external channel during batch, rejecting",
+	rejectExternal: true,
+	batchExecuting: true,
+	expectedAccept: false,
+}, {
+	name:           "pool channel during batch, rejecting",
+	rejectExternal: true,
+	batchExecuting: true,
+	registered:     true,
+	expectedAccept: true,
+}}
+
+// TestAcceptChannelDuringBatch makes sure external channels are only rejected
+// while a batch is executing and the user asked us to do so, while channels
+// that are part of the batch are always accepted.
+func TestAcceptChannelDuringBatch(t *testing.T) {
+	for _, tc := range acceptChannelDuringBatchTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			acceptor := NewChannelAcceptor(nil, ChannelAcceptorConfig{
+				RejectExternalDuringBatch: tc.rejectExternal,
+			})
+
+			pendingChanID := [32]byte{1, 2, 3}
+			if tc.registered {
+				bid := &order.Bid{
+					Kit:             *order.NewKit(order.Nonce{9}),
+					SelfChanBalance: 1000,
+				}
+				acceptor.ShimRegistered(bid, pendingChanID)
+			}
+
+			if tc.batchExecuting {
+				acceptor.BatchExecutionStarted()
+			}
+
+			req := &lndclient.AcceptorRequest{
+				PendingChanID: pendingChanID,
+				PushAmt:       1000 * 1000,
+				ChannelFlags: uint32(
+					lnwire.FFAnnounceChannel,
+				),
+			}
+			resp, err := acceptor.acceptChannel(
+				context.Background(), req,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAccept, resp.Accept)
+
+			// Once the batch execution ended, all channels should
+			// be accepted again.
+			acceptor.BatchExecutionEnded()
+			resp, err = acceptor.acceptChannel(
+				context.Background(), req,
+			)
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+		})
+	}
+}
+
+// TestBatchExecutionWindow makes sure we stop gating external channels once
+// the batch execution window expired, even if we never heard back from the
+// auctioneer.
+func TestBatchExecutionWindow(t *testing.T) {
+	acceptor := NewChannelAcceptor(nil, ChannelAcceptorConfig{
+		RejectExternalDuringBatch: true,
+	})
+
+	acceptor.BatchExecutionStarted()
+	require.True(t, acceptor.batchExecuting())
+
+	acceptor.batchDeadline = time.Now().Add(-time.Second)
+	require.False(t, acceptor.batchExecuting())
+
+	// A configured window is used instead of the default.
+	acceptor = NewChannelAcceptor(nil, ChannelAcceptorConfig{
+		RejectExternalDuringBatch: true,
+		BatchExecutionWindow:      50 * time.Millisecond,
+	})
+
+	acceptor.BatchExecutionStarted()
+	require.True(t, acceptor.batchExecuting())
+	require.Eventually(t, func() bool {
+		return !acceptor.batchExecuting()
+	}, time.Second, 10*time.Millisecond)
+}
@@ -7,8 +7,8 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/tlv"
-	"go.etcd.io/bbolt"
 )
 
 const (
@@ -58,14 +58,18 @@ func getAccountKey(account *account.Account) []byte {
 
 // AddAccount adds a record for the account to the database.
 func (db *DB) AddAccount(account *account.Account) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		accounts, err := getBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
 
-		return storeAccount(accounts, account)
-	})
+		if err := storeAccount(accounts, account); err != nil {
+			return err
+		}
+
+		return storeAccountEventTX(tx, NewAccountCreatedEvent(account))
+	}, func() {})
 }
 
 // UpdateAccount updates an account in the database according to the given
@@ -73,15 +77,28 @@ func (db *DB) AddAccount(account *account.Account) error {
 func (db *DB) UpdateAccount(acct *account.Account,
 	modifiers ...account.Modifier) error {
 
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
 		accounts, err := getBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
 		accountKey := getAccountKey(acct)
-		_, err = updateAccount(accounts, accounts, accountKey, modifiers)
-		return err
-	})
+		prev, err := readAccount(accounts, accountKey)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updateAccount(
+			accounts, accounts, accountKey, modifiers,
+		)
+		if err != nil {
+			return err
+		}
+
+		return storeAccountEventTX(
+			tx, NewAccountUpdatedEvent(prev, updated),
+		)
+	}, func() {})
 	if err != nil {
 		return err
 	}
@@ -95,7 +112,7 @@ func (db *DB) UpdateAccount(acct *account.Account,
 
 // updateAccount reads an account from the src bucket, applies the given
 // modifiers to it, and store it back into dst bucket.
-func updateAccount(src, dst *bbolt.Bucket, accountKey []byte,
+func updateAccount(src, dst kvdb.RwBucket, accountKey []byte,
 	modifiers []account.Modifier) (*account.Account, error) {
 
 	dbAccount, err := readAccount(src, accountKey)
@@ -114,8 +131,8 @@ func updateAccount(src, dst *bbolt.Bucket, accountKey []byte,
 // ErrAccountNotFound if it's not found.
 func (db *DB) Account(traderKey *btcec.PublicKey) (*account.Account, error) {
 	var acct *account.Account
-	err := db.View(func(tx *bbolt.Tx) error {
-		accounts, err := getBucket(tx, accountBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
@@ -124,7 +141,7 @@ func (db *DB) Account(traderKey *btcec.PublicKey) (*account.Account, error) {
 			accounts, traderKey.SerializeCompressed(),
 		)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -135,8 +152,8 @@ func (db *DB) Account(traderKey *btcec.PublicKey) (*account.Account, error) {
 // Accounts retrieves all known accounts from the database.
 func (db *DB) Accounts() ([]*account.Account, error) {
 	var res []*account.Account
-	err := db.View(func(tx *bbolt.Tx) error {
-		accounts, err := getBucket(tx, accountBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
@@ -155,6 +172,8 @@ func (db *DB) Accounts() ([]*account.Account, error) {
 			res = append(res, acct)
 			return nil
 		})
+	}, func() {
+		res = nil
 	})
 	if err != nil {
 		return nil, err
@@ -163,7 +182,7 @@ func (db *DB) Accounts() ([]*account.Account, error) {
 	return res, nil
 }
 
-func storeAccount(targetBucket *bbolt.Bucket, a *account.Account) error {
+func storeAccount(targetBucket kvdb.RwBucket, a *account.Account) error {
 	accountKey := getAccountKey(a)
 
 	var accountBuf bytes.Buffer
@@ -174,7 +193,7 @@ func storeAccount(targetBucket *bbolt.Bucket, a *account.Account) error {
 	return targetBucket.Put(accountKey, accountBuf.Bytes())
 }
 
-func readAccount(sourceBucket *bbolt.Bucket,
+func readAccount(sourceBucket kvdb.RBucket,
 	accountKey []byte) (*account.Account, error) {
 
 	accountBytes := sourceBucket.Get(accountKey)
@@ -200,10 +219,14 @@ func serializeAccount(w *bytes.Buffer, a *account.Account) error {
 		return err
 	}
 
-	// The latest transaction is not found within StateInitiated and
-	// StateCanceledAfterRecovery.
+	// The latest transaction is not found within StateInitiated,
+	// StatePendingExternalFunding, StatePendingFundingPreview,
+	// StateExternalFundingCanceled and StateCanceledAfterRecovery.
 	switch a.State {
-	case account.StateInitiated, account.StateCanceledAfterRecovery:
+	case account.StateInitiated, account.StatePendingExternalFunding,
+		account.StatePendingFundingPreview,
+		account.StateExternalFundingCanceled,
+		account.StateCanceledAfterRecovery:
 
 	default:
 		if err := WriteElement(w, a.LatestTx); err != nil {
@@ -312,10 +335,14 @@ func deserializeAccount(r io.Reader) (*account.Account, error) {
 	// the flag again.
 	a.State = clearVersionBit(rawState)
 
-	// The latest transaction is not found within StateInitiated and
-	// StateCanceledAfterRecovery.
+	// The latest transaction is not found within StateInitiated,
+	// StatePendingExternalFunding, StatePendingFundingPreview,
+	// StateExternalFundingCanceled and StateCanceledAfterRecovery.
 	switch a.State {
-	case account.StateInitiated, account.StateCanceledAfterRecovery:
+	case account.StateInitiated, account.StatePendingExternalFunding,
+		account.StatePendingFundingPreview,
+		account.StateExternalFundingCanceled,
+		account.StateCanceledAfterRecovery:
 
 	default:
 		if err := ReadElement(r, &a.LatestTx); err != nil {
@@ -0,0 +1,321 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// AccountAction is a numerical type that describes what kind of modification
+// an account update event represents.
+type AccountAction uint8
+
+const (
+	// AccountActionStateChange denotes an account update that didn't
+	// change the account's value or expiry, for example the confirmation
+	// of its latest transaction.
+	AccountActionStateChange AccountAction = 0
+
+	// AccountActionCreated denotes the creation of an account.
+	AccountActionCreated AccountAction = 1
+
+	// AccountActionDeposit denotes funds being deposited into an account.
+	AccountActionDeposit AccountAction = 2
+
+	// AccountActionWithdraw denotes funds being withdrawn from an account.
+	AccountActionWithdraw AccountAction = 3
+
+	// AccountActionRenew denotes an account's expiry being extended.
+	AccountActionRenew AccountAction = 4
+
+	// AccountActionBatch denotes an account participating in a batch.
+	AccountActionBatch AccountAction = 5
+
+	// AccountActionExpired denotes an account reaching its expiry.
+	AccountActionExpired AccountAction = 6
+
+	// AccountActionClosed denotes an account being closed.
+	AccountActionClosed AccountAction = 7
+)
+
+// String returns a human readable representation of the account action.
+func (a AccountAction) String() string {
+	switch a {
+	case AccountActionStateChange:
+		return "StateChange"
+
+	case AccountActionCreated:
+		return "Created"
+
+	case AccountActionDeposit:
+		return "Deposit"
+
+	case AccountActionWithdraw:
+		return "Withdraw"
+
+	case AccountActionRenew:
+		return "Renew"
+
+	case AccountActionBatch:
+		return "Batch"
+
+	case AccountActionExpired:
+		return "Expired"
+
+	case AccountActionClosed:
+		return "Closed"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", a)
+	}
+}
+
+// AccountUpdatedEvent is an event implementation that tracks the modifications
+// of an account that are persisted in the database.
+type AccountUpdatedEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
+
+	// traderKey is the trader key of the account this event refers to.
+	traderKey [33]byte
+
+	// Action is the kind of modification the update represents.
+	Action AccountAction
+
+	// PrevState is the state the account had previous to the update.
+	PrevState account.State
+
+	// NewState is the state the account had after the update.
+	NewState account.State
+
+	// PrevValue is the value the account had previous to the update.
+	PrevValue btcutil.Amount
+
+	// NewValue is the value the account had after the update.
+	NewValue btcutil.Amount
+
+	// PrevExpiry is the expiry the account had previous to the update.
+	PrevExpiry uint32
+
+	// NewExpiry is the expiry the account had after the update.
+	NewExpiry uint32
+
+	// Txid is the hash of the account's latest transaction after the
+	// update. This is all zeroes if the account doesn't have a transaction
+	// yet.
+	Txid chainhash.Hash
+}
+
+// NewAccountCreatedEvent creates a new AccountUpdatedEvent for a newly added
+// account with the current system time as the timestamp.
+func NewAccountCreatedEvent(acct *account.Account) *AccountUpdatedEvent {
+	evt := newAccountUpdatedEvent(acct, acct)
+	evt.Action = AccountActionCreated
+
+	return evt
+}
+
+// NewAccountUpdatedEvent creates a new AccountUpdatedEvent from an account's
+// previous and updated version with the current system time as the timestamp.
+// Nil is returned if none of the tracked fields changed.
+func NewAccountUpdatedEvent(prev,
+	updated *account.Account) *AccountUpdatedEvent {
+
+	evt := newAccountUpdatedEvent(prev, updated)
+	if evt.PrevState == evt.NewState && evt.PrevValue == evt.NewValue &&
+		evt.PrevExpiry == evt.NewExpiry &&
+		evt.Txid == latestTxHash(prev) {
+
+		return nil
+	}
+
+	switch {
+	case evt.PrevState == evt.NewState:
+		// Same state, classify by value and expiry below.
+
+	case evt.NewState == account.StateExpired:
+		evt.Action = AccountActionExpired
+		return evt
+
+	case evt.NewState == account.StatePendingClosed,
+		evt.NewState == account.StateClosed:
+
+		evt.Action = AccountActionClosed
+		return evt
+
+	case evt.NewState == account.StatePendingBatch:
+		evt.Action = AccountActionBatch
+		return evt
+	}
+
+	switch {
+	case evt.NewValue > evt.PrevValue:
+		evt.Action = AccountActionDeposit
+
+	case evt.NewValue < evt.PrevValue:
+		evt.Action = AccountActionWithdraw
+
+	case evt.NewExpiry > evt.PrevExpiry:
+		evt.Action = AccountActionRenew
+
+	default:
+		evt.Action = AccountActionStateChange
+	}
+
+	return evt
+}
+
+// newAccountUpdatedEvent creates a new AccountUpdatedEvent with all fields
+// except the action populated.
+func newAccountUpdatedEvent(prev,
+	updated *account.Account) *AccountUpdatedEvent {
+
+	evt := &AccountUpdatedEvent{
+		timestamp:  time.Now(),
+		PrevState:  prev.State,
+		NewState:   updated.State,
+		PrevValue:  prev.Value,
+		NewValue:   updated.Value,
+		PrevExpiry: prev.Expiry,
+		NewExpiry:  updated.Expiry,
+		Txid:       latestTxHash(updated),
+	}
+	copy(evt.traderKey[:], getAccountKey(updated))
+
+	return evt
+}
+
+// latestTxHash returns the hash of the account's latest transaction or all
+// zeroes if it isn't known yet.
+func latestTxHash(acct *account.Account) chainhash.Hash {
+	if acct.LatestTx == nil {
+		return chainhash.Hash{}
+	}
+
+	return acct.LatestTx.TxHash()
+}
+
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) Type() event.Type {
+	return event.TypeAccountUpdate
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) String() string {
+	return fmt.Sprintf("AccountUpdate(%v, %v)", e.Action, e.NewState)
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) Serialize(w *bytes.Buffer) error {
+	return WriteElements(
+		w, e.traderKey, uint8(e.Action), e.PrevState, e.NewState,
+		e.PrevValue, e.NewValue, e.PrevExpiry, e.NewExpiry,
+		[32]byte(e.Txid),
+	)
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdatedEvent) Deserialize(r io.Reader) error {
+	var action uint8
+	err := ReadElements(
+		r, &e.traderKey, &action, &e.PrevState, &e.NewState,
+		&e.PrevValue, &e.NewValue, &e.PrevExpiry, &e.NewExpiry,
+		(*[32]byte)(&e.Txid),
+	)
+	if err != nil {
+		return err
+	}
+
+	e.Action = AccountAction(action)
+	return nil
+}
+
+// TraderKey returns the trader key of the account this event refers to.
+func (e *AccountUpdatedEvent) TraderKey() [33]byte {
+	return e.traderKey
+}
+
+// A compile time assertion to make sure AccountUpdatedEvent implements the
+// event.Event interface.
+var _ event.Event = (*AccountUpdatedEvent)(nil)
+
+// storeAccountEventTX stores a single account event in the main events bucket,
+// ensuring the uniqueness of its timestamp in the process.
+func storeAccountEventTX(tx kvdb.RwTx, evt *AccountUpdatedEvent) error {
+	// For convenience, the event is allowed to be nil, in case no update
+	// really happened.
+	if evt == nil {
+		return nil
+	}
+
+	return event.StoreEvent(tx.ReadWriteBucket(eventBucketKey), evt)
+}
+
+// GetAccountEvents returns all update events of the account with the given
+// trader key that have their timestamps between the given start and end time
+// (inclusive). The events are sorted by their timestamps.
+func (db *DB) GetAccountEvents(traderKey *btcec.PublicKey, start,
+	end time.Time) ([]*AccountUpdatedEvent, error) {
+
+	events, err := db.GetEventsInRange(start, end, event.TypeAccountUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawKey [33]byte
+	copy(rawKey[:], traderKey.SerializeCompressed())
+
+	result := make([]*AccountUpdatedEvent, 0, len(events))
+	for _, evt := range events {
+		acctEvent, ok := evt.(*AccountUpdatedEvent)
+		if !ok {
+			return nil, fmt.Errorf("invalid account event: %v", evt)
+		}
+
+		if acctEvent.traderKey != rawKey {
+			continue
+		}
+
+		result = append(result, acctEvent)
+	}
+
+	return result, nil
+}
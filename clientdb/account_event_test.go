@@ -0,0 +1,155 @@
+package clientdb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAccountTx creates a dummy account transaction that has a unique hash
+// for the given index.
+func newTestAccountTx(idx uint32) *wire.MsgTx {
+	return &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: idx},
+		}},
+		TxOut: []*wire.TxOut{{Value: 1}},
+	}
+}
+
+// TestAccountEvents makes sure all account modifications are recorded as events
+// in the correct order, with the correct action and transaction ID.
+func TestAccountEvents(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	a := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(a))
+
+	// Add a second account to make sure its events are not returned for
+	// the first one.
+	otherKey := *testTraderKeyDesc
+	otherKey.PubKey = testBatchKey
+	other := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		TraderKey:     &otherKey,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		State:         account.StateInitiated,
+	}
+	require.NoError(t, db.AddAccount(other))
+
+	openTx, depositTx := newTestAccountTx(1), newTestAccountTx(2)
+	renewTx, batchTx := newTestAccountTx(3), newTestAccountTx(4)
+	withdrawTx, closeTx := newTestAccountTx(5), newTestAccountTx(6)
+
+	updates := [][]account.Modifier{{
+		account.StateModifier(account.StatePendingOpen),
+		account.LatestTxModifier(openTx),
+	}, {
+		account.StateModifier(account.StateOpen),
+	}, {
+		// Updating a field that isn't tracked shouldn't create an
+		// event.
+		account.HeightHintModifier(100),
+	}, {
+		account.StateModifier(account.StatePendingUpdate),
+		account.ValueModifier(2 * btcutil.SatoshiPerBitcoin),
+		account.LatestTxModifier(depositTx),
+	}, {
+		account.StateModifier(account.StatePendingUpdate),
+		account.ExpiryModifier(2000),
+		account.LatestTxModifier(renewTx),
+	}, {
+		account.StateModifier(account.StatePendingBatch),
+		account.ValueModifier(btcutil.SatoshiPerBitcoin),
+		account.LatestTxModifier(batchTx),
+	}, {
+		account.StateModifier(account.StatePendingUpdate),
+		account.ValueModifier(btcutil.SatoshiPerBitcoin / 2),
+		account.LatestTxModifier(withdrawTx),
+	}, {
+		account.StateModifier(account.StateExpired),
+	}, {
+		account.StateModifier(account.StatePendingClosed),
+		account.LatestTxModifier(closeTx),
+	}}
+	for _, modifiers := range updates {
+		require.NoError(t, db.UpdateAccount(a, modifiers...))
+	}
+	require.NoError(t, db.UpdateAccount(
+		other, account.StateModifier(account.StatePendingOpen),
+		account.LatestTxModifier(newTestAccountTx(7)),
+	))
+
+	expected := []struct {
+		action AccountAction
+		state  account.State
+		value  btcutil.Amount
+		txid   chainhash.Hash
+	}{
+		{AccountActionCreated, account.StateInitiated,
+			btcutil.SatoshiPerBitcoin, chainhash.Hash{}},
+		{AccountActionStateChange, account.StatePendingOpen,
+			btcutil.SatoshiPerBitcoin, openTx.TxHash()},
+		{AccountActionStateChange, account.StateOpen,
+			btcutil.SatoshiPerBitcoin, openTx.TxHash()},
+		{AccountActionDeposit, account.StatePendingUpdate,
+			2 * btcutil.SatoshiPerBitcoin, depositTx.TxHash()},
+		{AccountActionRenew, account.StatePendingUpdate,
+			2 * btcutil.SatoshiPerBitcoin, renewTx.TxHash()},
+		{AccountActionBatch, account.StatePendingBatch,
+			btcutil.SatoshiPerBitcoin, batchTx.TxHash()},
+		{AccountActionWithdraw, account.StatePendingUpdate,
+			btcutil.SatoshiPerBitcoin / 2, withdrawTx.TxHash()},
+		{AccountActionExpired, account.StateExpired,
+			btcutil.SatoshiPerBitcoin / 2, withdrawTx.TxHash()},
+		{AccountActionClosed, account.StatePendingClosed,
+			btcutil.SatoshiPerBitcoin / 2, closeTx.TxHash()},
+	}
+
+	events, err := db.GetAccountEvents(
+		testTraderKey, time.Unix(0, 0), time.Unix(0, math.MaxInt64),
+	)
+	require.NoError(t, err)
+	require.Len(t, events, len(expected))
+
+	for idx, evt := range events {
+		require.Equal(t, testRawTraderKeyArr, evt.TraderKey())
+		require.Equal(t, expected[idx].action, evt.Action)
+		require.Equal(t, expected[idx].state, evt.NewState)
+		require.Equal(t, expected[idx].value, evt.NewValue)
+		require.Equal(t, expected[idx].txid, evt.Txid)
+
+		if idx > 0 {
+			require.True(t, evt.Timestamp().After(
+				events[idx-1].Timestamp(),
+			))
+		}
+	}
+
+	// Finally, make sure the time range filter is applied.
+	start := events[3].Timestamp()
+	end := events[5].Timestamp()
+	rangeEvents, err := db.GetAccountEvents(testTraderKey, start, end)
+	require.NoError(t, err)
+	require.Equal(t, events[3:6], rangeEvents)
+}
@@ -0,0 +1,85 @@
+package clientdb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// accountPolicyBucketKey is the top level bucket that stores the
+	// spending policies of individual accounts. The policies are indexed
+	// by the account's trader key.
+	accountPolicyBucketKey = []byte("account-policy")
+)
+
+// SetAccountPolicy stores the spending policy of the account with the given
+// trader key, replacing any previous policy. An empty policy removes the
+// account's policy.
+func (db *DB) SetAccountPolicy(traderKey *btcec.PublicKey,
+	policy *account.Policy) error {
+
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		accounts, err := getBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		accountKey := traderKey.SerializeCompressed()
+		if accounts.Get(accountKey) == nil {
+			return ErrAccountNotFound
+		}
+
+		policies, err := getBucket(tx, accountPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if policy.IsEmpty() {
+			return policies.Delete(accountKey)
+		}
+
+		var b bytes.Buffer
+		err = WriteElements(
+			&b, policy.MaxOrders, policy.MaxOutstanding,
+		)
+		if err != nil {
+			return err
+		}
+
+		return policies.Put(accountKey, b.Bytes())
+	}, func() {})
+}
+
+// AccountPolicy returns the spending policy of the account with the given
+// trader key. If no policy was set, an empty policy is returned.
+func (db *DB) AccountPolicy(traderKey *btcec.PublicKey) (*account.Policy,
+	error) {
+
+	policy := &account.Policy{}
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		policies, err := getReadBucket(tx, accountPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+
+		policyBytes := policies.Get(traderKey.SerializeCompressed())
+		if policyBytes == nil {
+			return nil
+		}
+
+		return ReadElements(
+			bytes.NewReader(policyBytes), &policy.MaxOrders,
+			&policy.MaxOutstanding,
+		)
+	}, func() {
+		policy = &account.Policy{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,52 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountPolicy makes sure account policies can be stored, updated and
+// removed and that they can only be set for existing accounts.
+func TestAccountPolicy(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// Without a policy set, an empty policy is returned.
+	policy, err := db.AccountPolicy(testTraderKey)
+	require.NoError(t, err)
+	require.True(t, policy.IsEmpty())
+
+	// A policy can't be set for an unknown account.
+	newPolicy := &account.Policy{
+		MaxOrders:      3,
+		MaxOutstanding: 500_000,
+	}
+	err = db.SetAccountPolicy(testTraderKey, newPolicy)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	acct := *testAccount
+	require.NoError(t, db.AddAccount(&acct))
+
+	require.NoError(t, db.SetAccountPolicy(testTraderKey, newPolicy))
+	policy, err = db.AccountPolicy(testTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, newPolicy, policy)
+
+	// Setting a new policy replaces the previous one.
+	newPolicy = &account.Policy{MaxOutstanding: 1_000_000}
+	require.NoError(t, db.SetAccountPolicy(testTraderKey, newPolicy))
+	policy, err = db.AccountPolicy(testTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, newPolicy, policy)
+
+	// And an empty policy removes it.
+	err = db.SetAccountPolicy(testTraderKey, &account.Policy{})
+	require.NoError(t, err)
+	policy, err = db.AccountPolicy(testTraderKey)
+	require.NoError(t, err)
+	require.True(t, policy.IsEmpty())
+}
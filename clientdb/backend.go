@@ -0,0 +1,160 @@
+package clientdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/kvdb/etcd"
+	"github.com/lightningnetwork/lnd/kvdb/postgres"
+)
+
+const (
+	// BackendBolt is the name of the default database backend that stores
+	// all data in a local bolt file within the pool data directory.
+	BackendBolt = "bolt"
+
+	// BackendEtcd is the name of the database backend that stores all data
+	// in a remote etcd cluster. This requires pool to be built with the
+	// kvdb_etcd build tag.
+	BackendEtcd = "etcd"
+
+	// BackendPostgres is the name of the database backend that stores all
+	// data in a remote postgres database. This requires pool to be built
+	// with the kvdb_postgres build tag.
+	BackendPostgres = "postgres"
+
+	// postgresTablePrefix is the prefix of all tables pool creates in a
+	// postgres database. This allows pool to share the database with other
+	// applications, for example lnd.
+	postgresTablePrefix = "pool"
+)
+
+// BackendConfig holds all information required to open the client database
+// with the selected backend.
+type BackendConfig struct {
+	// Backend is the name of the database backend to use. Must be one of
+	// BackendBolt, BackendEtcd or BackendPostgres.
+	Backend string
+
+	// Dir is the directory the bolt database file is located in.
+	Dir string
+
+	// FileName is the name of the bolt database file.
+	FileName string
+
+	// AutoCompact specifies whether the bolt database file should be
+	// compacted on startup if it wasn't compacted within the last
+	// AutoCompactMinAge.
+	AutoCompact bool
+
+	// AutoCompactMinAge is the minimum time that must have passed since
+	// the bolt database file was last compacted for it to be compacted
+	// again. A value of 0 compacts the file on every startup.
+	AutoCompactMinAge time.Duration
+
+	// Etcd holds the connection parameters of the etcd backend.
+	Etcd *etcd.Config
+
+	// Postgres holds the connection parameters of the postgres backend.
+	Postgres *postgres.Config
+}
+
+// Validate makes sure the backend config contains all parameters required by
+// the selected backend.
+func (c *BackendConfig) Validate() error {
+	switch c.Backend {
+	case BackendBolt:
+		if c.Dir == "" || c.FileName == "" {
+			return errors.New("bolt backend requires a directory " +
+				"and file name")
+		}
+
+		if c.AutoCompactMinAge < 0 {
+			return errors.New("auto compaction min age cannot be " +
+				"negative")
+		}
+
+	case BackendEtcd:
+		if c.Etcd == nil || (c.Etcd.Host == "" && !c.Etcd.Embedded) {
+			return errors.New("etcd backend requires a host")
+		}
+
+	case BackendPostgres:
+		if c.Postgres == nil || c.Postgres.Dsn == "" {
+			return errors.New("postgres backend requires a dsn")
+		}
+
+	default:
+		return fmt.Errorf("unknown database backend <%s>, must be one "+
+			"of %s, %s or %s", c.Backend, BackendBolt, BackendEtcd,
+			BackendPostgres)
+	}
+
+	// The remote backends take care of their storage themselves, we can
+	// only compact local bolt files.
+	if c.AutoCompact && c.Backend != BackendBolt {
+		return fmt.Errorf("auto compaction is not supported by the "+
+			"%s backend", c.Backend)
+	}
+
+	return nil
+}
+
+// Open opens the client database with the backend selected in the given
+// config, initializes all required buckets and migrates it to the latest
+// version if necessary.
+func Open(cfg *BackendConfig) (*DB, error) {
+	backend, err := openBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDB(backend)
+}
+
+// openBackend opens the raw key/value store of the backend selected in the
+// given config.
+func openBackend(cfg *BackendConfig) (kvdb.Backend, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		backend kvdb.Backend
+		err     error
+	)
+	switch cfg.Backend {
+	case BackendBolt:
+		return openBoltBackend(cfg)
+
+	case BackendEtcd:
+		backend, err = kvdb.Open(
+			kvdb.EtcdBackendName, context.Background(), cfg.Etcd,
+		)
+
+	case BackendPostgres:
+		backend, err = kvdb.Open(
+			kvdb.PostgresBackendName, context.Background(),
+			cfg.Postgres, postgresTablePrefix,
+		)
+	}
+
+	// The remote backends only register their drivers if the binary was
+	// compiled with the corresponding build tag. Give the user a hint on
+	// what to do in that case.
+	if errors.Is(err, walletdb.ErrDbUnknownType) {
+		return nil, fmt.Errorf("database backend %s not available, "+
+			"pool must be built with the kvdb_%s build tag",
+			cfg.Backend, cfg.Backend)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s database backend: %v",
+			cfg.Backend, err)
+	}
+
+	return backend, nil
+}
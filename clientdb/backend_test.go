@@ -0,0 +1,221 @@
+package clientdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/kvdb/etcd"
+	"github.com/lightningnetwork/lnd/kvdb/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+var backendConfigTestCases = []struct {
+	name        string
+	cfg         *BackendConfig
+	expectedErr string
+}{{
+	name: "unknown backend",
+	cfg: &BackendConfig{
+		Backend: "leveldb",
+	},
+	expectedErr: "unknown database backend <leveldb>",
+}, {
+	name: "bolt without file name",
+	cfg: &BackendConfig{
+		Backend: BackendBolt,
+		Dir:     "/tmp",
+	},
+	expectedErr: "bolt backend requires a directory and file name",
+}, {
+	name: "etcd without host",
+	cfg: &BackendConfig{
+		Backend: BackendEtcd,
+		Etcd:    &etcd.Config{},
+	},
+	expectedErr: "etcd backend requires a host",
+}, {
+	name: "etcd not compiled in",
+	cfg: &BackendConfig{
+		Backend: BackendEtcd,
+		Etcd: &etcd.Config{
+			Host: "localhost:2379",
+		},
+	},
+	expectedErr: "pool must be built with the kvdb_etcd build tag",
+}, {
+	name: "postgres without dsn",
+	cfg: &BackendConfig{
+		Backend: BackendPostgres,
+	},
+	expectedErr: "postgres backend requires a dsn",
+}, {
+	name: "auto compaction of remote backend",
+	cfg: &BackendConfig{
+		Backend: BackendPostgres,
+		Postgres: &postgres.Config{
+			Dsn: "postgres://pool@localhost:5432/pool",
+		},
+		AutoCompact: true,
+	},
+	expectedErr: "auto compaction is not supported by the postgres",
+}, {
+	name: "postgres not compiled in",
+	cfg: &BackendConfig{
+		Backend: BackendPostgres,
+		Postgres: &postgres.Config{
+			Dsn: "postgres://pool@localhost:5432/pool",
+		},
+	},
+	expectedErr: "pool must be built with the kvdb_postgres build tag",
+}}
+
+// TestOpenBackend makes sure the database factory validates the backend config
+// and selects the driver of the configured backend.
+//
+// NOTE: The remote backends are only available if the corresponding build tag
+// is set, so the test expects them to fail when being compiled without.
+func TestOpenBackend(t *testing.T) {
+	for _, tc := range backendConfigTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Open(tc.cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// TestOpenBoltBackend makes sure the bolt backend is selected by the factory,
+// creates the database file in the configured directory and persists data
+// across restarts.
+func TestOpenBoltBackend(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "client-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := &BackendConfig{
+		Backend:  BackendBolt,
+		Dir:      tempDir,
+		FileName: DBFilename,
+	}
+	db, err := Open(cfg)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(tempDir, DBFilename))
+
+	a := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(a))
+
+	lockID, err := db.LockID()
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Re-opening the database shouldn't re-initialize it, so both the
+	// account and the lock ID should still be the same.
+	db, err = Open(cfg)
+	require.NoError(t, err)
+	defer db.Close()
+
+	assertAccountExists(t, db, a)
+
+	newLockID, err := db.LockID()
+	require.NoError(t, err)
+	require.Equal(t, lockID, newLockID)
+}
+
+// TestBoltAutoCompaction makes sure the bolt database is only compacted on
+// startup if the last compaction is older than the configured minimum age.
+func TestBoltAutoCompaction(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "client-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	dbFile := filepath.Join(tempDir, DBFilename)
+	compactionFile := dbFile + kvdb.LastCompactionFileNameSuffix
+	cfg := &BackendConfig{
+		Backend:           BackendBolt,
+		Dir:               tempDir,
+		FileName:          DBFilename,
+		AutoCompact:       true,
+		AutoCompactMinAge: time.Hour,
+	}
+
+	// A new database doesn't need to be compacted, so no compaction
+	// timestamp should be written.
+	db, err := Open(cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.NoFileExists(t, compactionFile)
+
+	// The database has never been compacted before, so the age threshold
+	// is exceeded and the compaction should run on the next startup.
+	db, err = Open(cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.FileExists(t, compactionFile)
+
+	// If the last compaction is more recent than the minimum age, the
+	// compaction is skipped and the timestamp isn't updated.
+	recentCompaction := time.Now().Add(-time.Minute)
+	writeCompactionTimestamp(t, compactionFile, recentCompaction)
+
+	db, err = Open(cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.Equal(
+		t, recentCompaction.UnixNano(),
+		readCompactionTimestamp(t, compactionFile).UnixNano(),
+	)
+
+	// Once the last compaction is older than the minimum age, the database
+	// is compacted again.
+	oldCompaction := time.Now().Add(-2 * time.Hour)
+	writeCompactionTimestamp(t, compactionFile, oldCompaction)
+
+	db, err = Open(cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.True(
+		t, readCompactionTimestamp(t, compactionFile).After(
+			recentCompaction,
+		),
+	)
+}
+
+// writeCompactionTimestamp overwrites the last compaction timestamp of a bolt
+// database file.
+func writeCompactionTimestamp(t *testing.T, file string, ts time.Time) {
+	var tsBytes [8]byte
+	byteOrder.PutUint64(tsBytes[:], uint64(ts.UnixNano()))
+	require.NoError(t, ioutil.WriteFile(file, tsBytes[:], 0600))
+}
+
+// readCompactionTimestamp reads the last compaction timestamp of a bolt
+// database file.
+func readCompactionTimestamp(t *testing.T, file string) time.Time {
+	tsBytes, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+
+	return time.Unix(0, int64(byteOrder.Uint64(tsBytes)))
+}
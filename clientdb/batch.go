@@ -2,10 +2,11 @@ package clientdb
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/order"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -48,7 +49,7 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 	}
 
 	// Wrap the whole batch update in a single update transaction.
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		// Before updating the set of orders and accounts, we'll first
 		// delete the buckets containing any existing staged updates.
 		// This is to done to handle the case where the first version of
@@ -59,12 +60,12 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 		if err != nil {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchAccountsBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		err = bucket.DeleteNestedBucket(pendingBatchAccountsBucketKey)
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchOrdersBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		err = bucket.DeleteNestedBucket(pendingBatchOrdersBucketKey)
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
@@ -135,25 +136,25 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 		}
 
 		return storePendingBatchSnapshot(tx, snapshot)
-	})
+	}, func() {})
 }
 
 // PendingBatchSnapshot retrieves the snapshot of the currently pending batch.
 // If there isn't one, account.ErrNoPendingBatch is returned.
 func (db *DB) PendingBatchSnapshot() (*LocalBatchSnapshot, error) {
 	var batchSnapshot *LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
 		var err error
 		batchSnapshot, err = fetchPendingBatchSnapshot(tx)
 		return err
-	})
+	}, func() {})
 	return batchSnapshot, err
 }
 
 // pendingBatchID retrieves the stored pending batch ID within a database
 // transaction.
-func pendingBatchID(tx *bbolt.Tx) (order.BatchID, error) {
-	bucket, err := getBucket(tx, batchBucketKey)
+func pendingBatchID(tx kvdb.RTx) (order.BatchID, error) {
+	bucket, err := getReadBucket(tx, batchBucketKey)
 	if err != nil {
 		return zeroBatchID, err
 	}
@@ -172,7 +173,7 @@ func pendingBatchID(tx *bbolt.Tx) (order.BatchID, error) {
 // without applying its staged updates to accounts and orders. If no pending
 // batch exists, this acts as a no-op.
 func (db *DB) DeletePendingBatch() error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		bucket, err := getBucket(tx, batchBucketKey)
 		if err != nil {
 			return err
@@ -181,26 +182,26 @@ func (db *DB) DeletePendingBatch() error {
 		if err := bucket.Delete(pendingBatchIDKey); err != nil {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchAccountsBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		err = bucket.DeleteNestedBucket(pendingBatchAccountsBucketKey)
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchOrdersBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		err = bucket.DeleteNestedBucket(pendingBatchOrdersBucketKey)
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
 
 		// Also delete the pending batch snapshot, as it will be stored
 		// together with the pending batch.
 		return deletePendingSnapshot(tx)
-	})
+	}, func() {})
 }
 
 // MarkBatchComplete marks a pending batch as complete, applying any staged
 // modifications necessary, and allowing a trader to participate in a new batch.
 // If a pending batch is not found, account.ErrNoPendingBatch is returned.
 func (db *DB) MarkBatchComplete() error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		pendingID, err := pendingBatchID(tx)
 		if err != nil {
 			return err
@@ -209,13 +210,13 @@ func (db *DB) MarkBatchComplete() error {
 			return err
 		}
 
-		return finalizeBatchSnapshot(tx, pendingID)
-	})
+		return finalizeBatchSnapshot(tx, pendingID, time.Now())
+	}, func() {})
 }
 
 // applyBatchUpdates applies the staged updates for any accounts and orders that
 // participated in the latest pending batch.
-func applyBatchUpdates(tx *bbolt.Tx) error {
+func applyBatchUpdates(tx kvdb.RwTx) error {
 	bucket, err := getBucket(tx, batchBucketKey)
 	if err != nil {
 		return err
@@ -239,8 +240,19 @@ func applyBatchUpdates(tx *bbolt.Tx) error {
 		if len(k) != 33 {
 			return nil
 		}
-		_, err := updateAccount(pendingAccounts, accounts, k, nil)
-		return err
+		prev, err := readAccount(accounts, k)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updateAccount(pendingAccounts, accounts, k, nil)
+		if err != nil {
+			return err
+		}
+
+		return storeAccountEventTX(
+			tx, NewAccountUpdatedEvent(prev, updated),
+		)
 	})
 	if err != nil {
 		return err
@@ -248,7 +260,8 @@ func applyBatchUpdates(tx *bbolt.Tx) error {
 
 	// Once we've updated all of the accounts, we can remove the pending
 	// updates as they've been committed.
-	if err := bucket.DeleteBucket(pendingBatchAccountsBucketKey); err != nil {
+	err = bucket.DeleteNestedBucket(pendingBatchAccountsBucketKey)
+	if err != nil {
 		return err
 	}
 
@@ -278,7 +291,8 @@ func applyBatchUpdates(tx *bbolt.Tx) error {
 
 	// Once again, remove the pending order updates as they've been
 	// committed.
-	if err := bucket.DeleteBucket(pendingBatchOrdersBucketKey); err != nil {
+	err = bucket.DeleteNestedBucket(pendingBatchOrdersBucketKey)
+	if err != nil {
 		return err
 	}
 
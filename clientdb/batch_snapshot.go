@@ -5,13 +5,22 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// batchSnapshotFeeRebateType is the tlv type we use to store the fee
+	// rebate the auctioneer granted us for a batch.
+	batchSnapshotFeeRebateType tlv.Type = 0
 )
 
 // batch-snapshot-bucket
@@ -23,6 +32,8 @@ import (
 //         |              |-- <sequence num>
 //         |              |        |
 //         |              |        |-- batch-snapshot-batch: <batch snapshot>
+//         |              |        |
+//         |              |        |-- batch-snapshot-timestamp: <unix nanos>
 //         |              |
 //         |              |-- <sequence num>
 //         |              |        |
@@ -55,6 +66,10 @@ var (
 	// batchSnapshotBatchKey is the key under where we'll store the
 	// serialized batch snapshot.
 	batchSnapshotBatchKey = []byte("batch-snapshot-batch")
+
+	// batchSnapshotTimestampKey is the key under where we'll store the
+	// time the batch snapshot was finalized, in unix nanoseconds.
+	batchSnapshotTimestampKey = []byte("batch-snapshot-timestamp")
 )
 
 // LocalBatchSnapshot holds key information about our participation in a batch.
@@ -81,6 +96,10 @@ type LocalBatchSnapshot struct {
 	// the batch transaction.
 	BatchTxFeeRate chainfee.SatPerKWeight
 
+	// FeeRebate is the rebate the auctioneer granted us if another batch
+	// participant wanted to pay more fees for a faster confirmation.
+	FeeRebate btcutil.Amount
+
 	// Account holds snapshots of the ending state of the local accounts
 	// that participated in this batch.
 	Accounts map[[33]byte]*account.Account
@@ -125,6 +144,7 @@ func NewSnapshot(batch *order.Batch, ourOrders []order.Order,
 		ExecutionFee:   *feeSched,
 		BatchTX:        batch.BatchTX,
 		BatchTxFeeRate: batch.BatchTxFeeRate,
+		FeeRebate:      batch.FeeRebate,
 		Accounts:       as,
 		Orders:         os,
 		MatchedOrders:  batch.MatchedOrders,
@@ -137,11 +157,11 @@ func NewSnapshot(batch *order.Batch, ourOrders []order.Order,
 // participated in.
 func (db *DB) GetLocalBatchSnapshots() ([]*LocalBatchSnapshot, error) {
 	var snapshots []*LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
 		var err error
 		snapshots, err = db.fetchLocalBatchSnapshots(tx)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -154,8 +174,8 @@ func (db *DB) GetLocalBatchSnapshot(id order.BatchID) (
 	*LocalBatchSnapshot, error) {
 
 	var snapshot *LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
-		_, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		_, seqBucket, indexBucket, err := getSnapshotReadBuckets(tx)
 		if err != nil {
 			return err
 		}
@@ -165,7 +185,7 @@ func (db *DB) GetLocalBatchSnapshot(id order.BatchID) (
 			return fmt.Errorf("snapshot of batch %x not found",
 				id[:])
 		}
-		rootOrderBucket, err := getBucket(tx, ordersBucketKey)
+		rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
@@ -174,7 +194,7 @@ func (db *DB) GetLocalBatchSnapshot(id order.BatchID) (
 			seqBucket, seq, rootOrderBucket,
 		)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -182,14 +202,14 @@ func (db *DB) GetLocalBatchSnapshot(id order.BatchID) (
 	return snapshot, nil
 }
 
-func (db *DB) fetchLocalBatchSnapshots(tx *bbolt.Tx) ([]*LocalBatchSnapshot,
+func (db *DB) fetchLocalBatchSnapshots(tx kvdb.RTx) ([]*LocalBatchSnapshot,
 	error) {
 
-	_, seqBucket, _, err := getSnapshotBuckets(tx)
+	_, seqBucket, _, err := getSnapshotReadBuckets(tx)
 	if err != nil {
 		return nil, err
 	}
-	rootOrderBucket, err := getBucket(tx, ordersBucketKey)
+	rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
 	if err != nil {
 		return nil, err
 	}
@@ -216,10 +236,10 @@ func (db *DB) fetchLocalBatchSnapshots(tx *bbolt.Tx) ([]*LocalBatchSnapshot,
 	return snapshots, nil
 }
 
-func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
-	rootOrderBucket *bbolt.Bucket) (*LocalBatchSnapshot, error) {
+func fetchLocalBatchSnapshot(seqBucket kvdb.RBucket, seqNum []byte,
+	rootOrderBucket kvdb.RBucket) (*LocalBatchSnapshot, error) {
 
-	snapshotBucket, err := getNestedBucket(seqBucket, seqNum, false)
+	snapshotBucket, err := getNestedReadBucket(seqBucket, seqNum)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +261,8 @@ func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
 	// The snapshot doesn't contain all the order information needed, so
 	// we'll retrieve the missing data.
 	for nonce, o := range batchSnapshot.Orders {
-		orderBucket, err := getNestedBucket(
-			rootOrderBucket, nonce[:], false,
+		orderBucket, err := getNestedReadBucket(
+			rootOrderBucket, nonce[:],
 		)
 		if err != nil {
 			return nil, ErrNoOrder
@@ -299,7 +319,7 @@ func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
 	return batchSnapshot, nil
 }
 
-func storePendingBatchSnapshot(tx *bbolt.Tx,
+func storePendingBatchSnapshot(tx kvdb.RwTx,
 	snapshot *LocalBatchSnapshot) error {
 
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
@@ -319,8 +339,8 @@ func storePendingBatchSnapshot(tx *bbolt.Tx,
 
 // fetchPendingBatchSnapshot retrieves the currently pending batch snapshot from
 // the database or returns the account.ErrNoPendingBatch error if none exists.
-func fetchPendingBatchSnapshot(tx *bbolt.Tx) (*LocalBatchSnapshot, error) {
-	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
+func fetchPendingBatchSnapshot(tx kvdb.RTx) (*LocalBatchSnapshot, error) {
+	topBucket, err := getReadBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
 		return nil, err
 	}
@@ -334,8 +354,11 @@ func fetchPendingBatchSnapshot(tx *bbolt.Tx) (*LocalBatchSnapshot, error) {
 }
 
 // finalizeBatchSnapshot moves the pending batch snapshot into the sub-bucket
-// indexed by sequence numbers.
-func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
+// indexed by sequence numbers, recording the given time as its finalization
+// time.
+func finalizeBatchSnapshot(tx kvdb.RwTx, batchID order.BatchID,
+	finalized time.Time) error {
+
 	topBucket, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
 	if err != nil {
 		return err
@@ -351,6 +374,17 @@ func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
 		return err
 	}
 
+	return storeBatchSnapshot(
+		seqBucket, indexBucket, batchID, rawSnapshot, finalized,
+	)
+}
+
+// storeBatchSnapshot stores the raw batch snapshot under the next sequence
+// number and indexes it by the given batch ID. The finalization time is only
+// recorded if it is non-zero.
+func storeBatchSnapshot(seqBucket, indexBucket kvdb.RwBucket,
+	batchID order.BatchID, rawSnapshot []byte, finalized time.Time) error {
+
 	// Get the next sequence number we will store this batch under.
 	sequence, err := seqBucket.NextSequence()
 	if err != nil {
@@ -370,6 +404,13 @@ func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
 		return err
 	}
 
+	if !finalized.IsZero() {
+		err = putSnapshotTimestamp(snapshotBucket, finalized)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Finally add a entry mapping this batch ID to the sequence number.
 	err = indexBucket.Put(batchID[:], seqBytes[:])
 	if err != nil {
@@ -379,8 +420,126 @@ func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
 	return nil
 }
 
-func getSnapshotBuckets(tx *bbolt.Tx) (*bbolt.Bucket, *bbolt.Bucket,
-	*bbolt.Bucket, error) {
+// PruneLocalBatchSnapshots removes all finalized batch snapshots that were
+// finalized before the given time, except for the batches in the keep set, and
+// returns the number of removed snapshots. Snapshots that were stored before
+// their finalization time was recorded are assigned the given current time
+// instead, so they are removed once they are older than the retention window
+// as well.
+func (db *DB) PruneLocalBatchSnapshots(olderThan, now time.Time,
+	keep map[order.BatchID]struct{}) (int, error) {
+
+	var numPruned int
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
+		if err != nil {
+			return err
+		}
+
+		keepSeqs := make(map[string]struct{}, len(keep))
+		for batchID := range keep {
+			seq := indexBucket.Get(batchID[:])
+			if seq != nil {
+				keepSeqs[string(seq)] = struct{}{}
+			}
+		}
+
+		// We can't modify the buckets while iterating over them, so we
+		// first collect all sequence numbers of snapshots to remove.
+		var (
+			pruneSeqs  = make(map[string]struct{})
+			backfilled [][]byte
+		)
+		err = seqBucket.ForEach(func(seq, _ []byte) error {
+			snapshotBucket := seqBucket.NestedReadBucket(seq)
+			if snapshotBucket == nil {
+				return fmt.Errorf("snapshot bucket %x not "+
+					"found", seq)
+			}
+
+			tsBytes := snapshotBucket.Get(batchSnapshotTimestampKey)
+			switch {
+			case tsBytes == nil:
+				backfilled = append(backfilled, seq)
+
+			case len(tsBytes) != 8:
+				return fmt.Errorf("invalid timestamp of "+
+					"snapshot %x", seq)
+
+			default:
+				tsNanos := binary.BigEndian.Uint64(tsBytes)
+				ts := time.Unix(0, int64(tsNanos))
+				_, kept := keepSeqs[string(seq)]
+				if ts.Before(olderThan) && !kept {
+					pruneSeqs[string(seq)] = struct{}{}
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, seq := range backfilled {
+			snapshotBucket := seqBucket.NestedReadWriteBucket(seq)
+			err := putSnapshotTimestamp(snapshotBucket, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(pruneSeqs) == 0 {
+			return nil
+		}
+
+		var pruneIDs [][]byte
+		err = indexBucket.ForEach(func(batchID, seq []byte) error {
+			if _, ok := pruneSeqs[string(seq)]; ok {
+				pruneIDs = append(pruneIDs, batchID)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, batchID := range pruneIDs {
+			if err := indexBucket.Delete(batchID); err != nil {
+				return err
+			}
+		}
+		for seq := range pruneSeqs {
+			err := seqBucket.DeleteNestedBucket([]byte(seq))
+			if err != nil {
+				return err
+			}
+		}
+
+		numPruned = len(pruneSeqs)
+		return nil
+	}, func() {
+		numPruned = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// putSnapshotTimestamp stores the given time as the finalization time of the
+// batch snapshot in the given bucket.
+func putSnapshotTimestamp(snapshotBucket kvdb.RwBucket, ts time.Time) error {
+	var tsBytes [8]byte
+	binary.BigEndian.PutUint64(tsBytes[:], uint64(ts.UnixNano()))
+
+	return snapshotBucket.Put(batchSnapshotTimestampKey, tsBytes[:])
+}
+
+func getSnapshotBuckets(tx kvdb.RwTx) (kvdb.RwBucket, kvdb.RwBucket,
+	kvdb.RwBucket, error) {
 
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
@@ -406,6 +565,33 @@ func getSnapshotBuckets(tx *bbolt.Tx) (*bbolt.Bucket, *bbolt.Bucket,
 	return topBucket, seqBucket, indexBucket, nil
 }
 
+// getSnapshotReadBuckets returns the top level snapshot bucket and its
+// sequence and batch ID index sub-buckets for reading only.
+func getSnapshotReadBuckets(tx kvdb.RTx) (kvdb.RBucket, kvdb.RBucket,
+	kvdb.RBucket, error) {
+
+	topBucket, err := getReadBucket(tx, batchSnapshotBucketKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	seqBucket, err := getNestedReadBucket(
+		topBucket, batchSnapshotSeqBucketKey,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	indexBucket, err := getNestedReadBucket(
+		topBucket, batchSnapshotBatchIDIndexBucketKey,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return topBucket, seqBucket, indexBucket, nil
+}
+
 func serializeLocalBatchSnapshot(w *bytes.Buffer, b *LocalBatchSnapshot) error {
 	// The previous batch versions had a single clearing price but because
 	// we now always store the price map afterwards, we signal a new batch
@@ -470,7 +656,9 @@ func serializeLocalBatchSnapshot(w *bytes.Buffer, b *LocalBatchSnapshot) error {
 		}
 	}
 
-	return nil
+	// Any additional fields are stored as a tlv stream at the very end of
+	// the snapshot.
+	return serializeBatchSnapshotTlvData(w, b)
 }
 
 func deserializeLocalBatchSnapshot(r io.Reader) (*LocalBatchSnapshot, error) {
@@ -540,9 +728,54 @@ func deserializeLocalBatchSnapshot(r io.Reader) (*LocalBatchSnapshot, error) {
 		b.ClearingPrices[duration] = price
 	}
 
+	// Snapshots stored before any additional fields were added end after
+	// the clearing prices, which results in an empty tlv stream.
+	if err := deserializeBatchSnapshotTlvData(r, b); err != nil {
+		return nil, err
+	}
+
 	return b, nil
 }
 
+// serializeBatchSnapshotTlvData writes all additional tlv fields of a batch
+// snapshot to the given writer.
+func serializeBatchSnapshotTlvData(w io.Writer, b *LocalBatchSnapshot) error {
+	feeRebate := uint64(b.FeeRebate)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(batchSnapshotFeeRebateType, &feeRebate),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeBatchSnapshotTlvData reads all additional tlv fields of a batch
+// snapshot from the given reader.
+func deserializeBatchSnapshotTlvData(r io.Reader,
+	b *LocalBatchSnapshot) error {
+
+	var feeRebate uint64
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(batchSnapshotFeeRebateType, &feeRebate),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	if t, ok := parsedTypes[batchSnapshotFeeRebateType]; ok && t == nil {
+		b.FeeRebate = btcutil.Amount(feeRebate)
+	}
+
+	return nil
+}
+
 func serializeAccounts(w *bytes.Buffer,
 	accounts map[[33]byte]*account.Account) error {
 
@@ -694,7 +927,7 @@ func deserializeMatchedOrder(r io.Reader) (order.Nonce,
 }
 
 // deletePendingSnapshot deletes the pending batch snapshot.
-func deletePendingSnapshot(tx *bbolt.Tx) error {
+func deletePendingSnapshot(tx kvdb.RwTx) error {
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
 		return err
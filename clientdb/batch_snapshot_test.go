@@ -2,18 +2,20 @@ package clientdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/bbolt"
 )
 
 var (
@@ -142,6 +144,7 @@ var (
 		ExecutionFee:   *terms.NewLinearFeeSchedule(101, 900),
 		BatchTX:        testBatchTx,
 		BatchTxFeeRate: 123456,
+		FeeRebate:      2100,
 		Accounts:       testAccounts,
 		Orders:         testOrders,
 		MatchedOrders:  testMatchedOrders,
@@ -194,6 +197,58 @@ func TestSerializeLocalBatchSnapshot(t *testing.T) {
 	}
 }
 
+// TestDeserializeSnapshotWithoutFeeRebate makes sure snapshots that were
+// stored before the fee rebate was added can still be read.
+func TestDeserializeSnapshotWithoutFeeRebate(t *testing.T) {
+	t.Parallel()
+
+	buf := bytes.Buffer{}
+	err := serializeLocalBatchSnapshot(&buf, testSnapshot)
+	require.NoError(t, err)
+
+	// Strip the tlv stream with the fee rebate from the end of the
+	// serialized snapshot.
+	var tlvBuf bytes.Buffer
+	err = serializeBatchSnapshotTlvData(&tlvBuf, testSnapshot)
+	require.NoError(t, err)
+
+	raw := buf.Bytes()
+	raw = raw[:len(raw)-tlvBuf.Len()]
+
+	post, err := deserializeLocalBatchSnapshot(bytes.NewReader(raw))
+	require.NoError(t, err)
+	require.Zero(t, post.FeeRebate)
+
+	post.FeeRebate = testSnapshot.FeeRebate
+	require.Equal(t, testSnapshot, post)
+}
+
+// TestNewSnapshotFeeRebate makes sure the fee rebate the auctioneer granted for
+// a batch is recorded in its snapshot.
+func TestNewSnapshotFeeRebate(t *testing.T) {
+	t.Parallel()
+
+	batch := &order.Batch{
+		Version:        order.DefaultBatchVersion,
+		ID:             testBatchID,
+		ExecutionFee:   terms.NewLinearFeeSchedule(101, 900),
+		ClearingPrices: testSnapshot.ClearingPrices,
+		BatchTX:        testBatchTx,
+		BatchTxFeeRate: 123456,
+		FeeRebate:      4321,
+	}
+	snapshot, err := NewSnapshot(batch, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(4321), snapshot.FeeRebate)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, serializeLocalBatchSnapshot(&buf, snapshot))
+
+	post, err := deserializeLocalBatchSnapshot(&buf)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(4321), post.FeeRebate)
+}
+
 // TestStoreLocalBatchSnapshot tests that snapshots stored to the database get
 // returned in the same order.
 func TestGetLocalBatchSnapshots(t *testing.T) {
@@ -219,15 +274,17 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 	// Store the same batch 10 times, only changing the batch ID each time.
 	for i := 0; i < 10; i++ {
 		i := i
-		err := store.Update(func(tx *bbolt.Tx) error {
+		err := kvdb.Update(store, func(tx kvdb.RwTx) error {
 			testSnapshot.BatchID[0] = byte(i)
 			err := storePendingBatchSnapshot(tx, testSnapshot)
 			if err != nil {
 				return err
 			}
 
-			return finalizeBatchSnapshot(tx, testSnapshot.BatchID)
-		})
+			return finalizeBatchSnapshot(
+				tx, testSnapshot.BatchID, time.Now(),
+			)
+		}, func() {})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -247,14 +304,14 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 	// Store and delete a pending snapshot, and make sure all other batches are
 	// still there.
 	testSnapshot.BatchID[0] = byte(99)
-	err = store.Update(func(tx *bbolt.Tx) error {
+	err = kvdb.Update(store, func(tx kvdb.RwTx) error {
 		return storePendingBatchSnapshot(tx, testSnapshot)
-	})
+	}, func() {})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = store.Update(deletePendingSnapshot)
+	err = kvdb.Update(store, deletePendingSnapshot, func() {})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -291,3 +348,97 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 			spew.Sdump(testSnapshot), spew.Sdump(snapshot))
 	}
 }
+
+// TestPruneLocalBatchSnapshots makes sure snapshots finalized before the
+// retention window are removed while recent ones and the ones that should be
+// kept are retained, and that snapshots without a finalization time are
+// assigned the current time.
+func TestPruneLocalBatchSnapshots(t *testing.T) {
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// Storing a batch snapshot requires its orders to be stored as well.
+	for _, order := range testSnapshot.Orders {
+		err := store.SubmitOrder(order)
+		require.NoError(t, err)
+	}
+
+	// Store four batches, each finalized a day after the previous one.
+	now := time.Unix(1_700_000_000, 0)
+	start := now.Add(-4 * 24 * time.Hour)
+	snapshot := *testSnapshot
+	for i := 0; i < 4; i++ {
+		snapshot.BatchID[0] = byte(i)
+		finalized := start.Add(time.Duration(i) * 24 * time.Hour)
+		err := kvdb.Update(store, func(tx kvdb.RwTx) error {
+			err := storePendingBatchSnapshot(tx, &snapshot)
+			if err != nil {
+				return err
+			}
+
+			return finalizeBatchSnapshot(
+				tx, snapshot.BatchID, finalized,
+			)
+		}, func() {})
+		require.NoError(t, err)
+	}
+
+	// Remove the finalization time of the last batch to simulate a
+	// snapshot that was stored before it was recorded.
+	err := kvdb.Update(store, func(tx kvdb.RwTx) error {
+		_, seqBucket, _, err := getSnapshotBuckets(tx)
+		if err != nil {
+			return err
+		}
+
+		var seq [8]byte
+		binary.BigEndian.PutUint64(seq[:], 4)
+		snapshotBucket := seqBucket.NestedReadWriteBucket(seq[:])
+		return snapshotBucket.Delete(batchSnapshotTimestampKey)
+	}, func() {})
+	require.NoError(t, err)
+
+	// With a retention window of two and a half days, the first two
+	// batches are outside of the window. But the first one should be kept,
+	// for example because one of its leases is still active.
+	keepID := snapshot.BatchID
+	keepID[0] = 0
+	keep := map[order.BatchID]struct{}{keepID: {}}
+	olderThan := now.Add(-60 * time.Hour)
+	numPruned, err := store.PruneLocalBatchSnapshots(olderThan, now, keep)
+	require.NoError(t, err)
+	require.Equal(t, 1, numPruned)
+
+	snapshots, err := store.GetLocalBatchSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, byte(0), snapshots[0].BatchID[0])
+	require.Equal(t, byte(2), snapshots[1].BatchID[0])
+	require.Equal(t, byte(3), snapshots[2].BatchID[0])
+
+	// The pruned batch can't be looked up by its ID anymore.
+	id := snapshot.BatchID
+	id[0] = 1
+	_, err = store.GetLocalBatchSnapshot(id)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	// Pruning again at the same time shouldn't change anything.
+	numPruned, err = store.PruneLocalBatchSnapshots(olderThan, now, keep)
+	require.NoError(t, err)
+	require.Equal(t, 0, numPruned)
+
+	// Three days later all remaining batches are outside of the retention
+	// window, including the one that was assigned the previous time. Once
+	// the first batch doesn't need to be kept anymore, it is removed too.
+	later := now.Add(3 * 24 * time.Hour)
+	numPruned, err = store.PruneLocalBatchSnapshots(
+		later.Add(-60*time.Hour), later, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, numPruned)
+
+	snapshots, err = store.GetLocalBatchSnapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -396,24 +396,24 @@ func TestDeletePendingBatch(t *testing.T) {
 	assertPendingBatch := func(exists bool) {
 		t.Helper()
 
-		err := db.View(func(tx *bbolt.Tx) error {
-			root := tx.Bucket(batchBucketKey)
+		err := kvdb.View(db, func(tx kvdb.RTx) error {
+			root := tx.ReadBucket(batchBucketKey)
 
 			if (root.Get(pendingBatchIDKey) != nil) != exists {
 				return fmt.Errorf("found unexpected key %v",
 					string(pendingBatchIDKey))
 			}
-			if (root.Bucket(pendingBatchAccountsBucketKey) != nil) != exists {
+			if (root.NestedReadBucket(pendingBatchAccountsBucketKey) != nil) != exists {
 				return fmt.Errorf("found unexpected bucket %v",
 					string(pendingBatchAccountsBucketKey))
 			}
-			if (root.Bucket(pendingBatchOrdersBucketKey) != nil) != exists {
+			if (root.NestedReadBucket(pendingBatchOrdersBucketKey) != nil) != exists {
 				return fmt.Errorf("found unexpected bucket %v",
 					string(pendingBatchOrdersBucketKey))
 			}
 
 			return nil
-		})
+		}, func() {})
 		if err != nil {
 			t.Fatal(err)
 		}
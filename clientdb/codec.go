@@ -14,10 +14,10 @@ import (
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
-	"go.etcd.io/bbolt"
 )
 
 var (
@@ -282,24 +282,34 @@ func ReadElement(r io.Reader, element interface{}) error { // nolint:gocyclo
 
 // getAdditionalDataBucket returns the bucket for additional data of a database
 // object located at mainKey.
-func getAdditionalDataBucket(parentBucket *bbolt.Bucket,
-	mainKey []byte, createBucket bool) (*bbolt.Bucket, error) {
+func getAdditionalDataBucket(parentBucket kvdb.RwBucket,
+	mainKey []byte) (kvdb.RwBucket, error) {
 
+	return parentBucket.CreateBucketIfNotExists(additionalDataKey(mainKey))
+}
+
+// getAdditionalDataReadBucket returns the bucket for additional data of a
+// database object located at mainKey for reading only. When only reading from
+// the DB we don't want to create the bucket if it doesn't exist as that would
+// fail on the read-only TX anyway. Nil is returned if the bucket doesn't exist.
+func getAdditionalDataReadBucket(parentBucket kvdb.RBucket,
+	mainKey []byte) kvdb.RBucket {
+
+	return parentBucket.NestedReadBucket(additionalDataKey(mainKey))
+}
+
+// additionalDataKey returns the key of the additional data bucket of a
+// database object located at mainKey.
+func additionalDataKey(mainKey []byte) []byte {
 	keyLen := len(mainKey) + len(additionalDataSuffix)
 	additionalDataKey := make([]byte, keyLen)
 	copy(additionalDataKey, mainKey)
 	copy(additionalDataKey[len(mainKey):], additionalDataSuffix)
 
-	// When only reading from the DB we don't want to create the bucket if
-	// it doesn't exist as that would fail on the read-only TX anyway.
-	if createBucket {
-		return parentBucket.CreateBucketIfNotExists(additionalDataKey)
-	}
-
-	return parentBucket.Bucket(additionalDataKey), nil
+	return additionalDataKey
 }
 
-func writeAdditionalValue(targetBucket *bbolt.Bucket, valueKey []byte,
+func writeAdditionalValue(targetBucket kvdb.RwBucket, valueKey []byte,
 	value interface{}) error {
 
 	var valueBuf bytes.Buffer
@@ -310,7 +320,7 @@ func writeAdditionalValue(targetBucket *bbolt.Bucket, valueKey []byte,
 	return targetBucket.Put(valueKey, valueBuf.Bytes())
 }
 
-func readAdditionalValue(sourceBucket *bbolt.Bucket, valueKey []byte,
+func readAdditionalValue(sourceBucket kvdb.RBucket, valueKey []byte,
 	valueTarget interface{}, defaultValue interface{}) error {
 
 	// First of all, check that the valueTarget is a pointer to the same
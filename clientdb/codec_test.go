@@ -5,8 +5,8 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/bbolt"
 )
 
 var (
@@ -48,24 +48,21 @@ func TestAdditionalData(t *testing.T) {
 	// Now try to read the additional value that does not exist yet. We
 	// should instead get back the default value.
 	myAdditionalValue := uint32(0)
-	require.NoError(t, db.DB.View(func(tx *bbolt.Tx) error {
-		subBucket, err := getAdditionalDataBucket(
-			tx.Bucket(accountBucketKey), accountKey, false,
+	require.NoError(t, kvdb.View(db, func(tx kvdb.RTx) error {
+		subBucket := getAdditionalDataReadBucket(
+			tx.ReadBucket(accountBucketKey), accountKey,
 		)
-		if err != nil {
-			return err
-		}
 		return readAdditionalValue(
 			subBucket, additionalDataKeyTest, &myAdditionalValue,
 			additionalDataTestDefaultValue,
 		)
-	}))
+	}, func() {}))
 	require.Equal(t, additionalDataTestDefaultValue, myAdditionalValue)
 
 	// Write the additional into the sub bucket of the account now.
-	require.NoError(t, db.DB.Update(func(tx *bbolt.Tx) error {
+	require.NoError(t, kvdb.Update(db, func(tx kvdb.RwTx) error {
 		subBucket, err := getAdditionalDataBucket(
-			tx.Bucket(accountBucketKey), accountKey, true,
+			tx.ReadWriteBucket(accountBucketKey), accountKey,
 		)
 		if err != nil {
 			return err
@@ -74,38 +71,32 @@ func TestAdditionalData(t *testing.T) {
 		return writeAdditionalValue(
 			subBucket, additionalDataKeyTest, uint32(6543),
 		)
-	}))
+	}, func() {}))
 
 	// Read the additional info again, now we shouldn't get the default
 	// value anymore.
-	require.NoError(t, db.DB.View(func(tx *bbolt.Tx) error {
-		subBucket, err := getAdditionalDataBucket(
-			tx.Bucket(accountBucketKey), accountKey, false,
+	require.NoError(t, kvdb.View(db, func(tx kvdb.RTx) error {
+		subBucket := getAdditionalDataReadBucket(
+			tx.ReadBucket(accountBucketKey), accountKey,
 		)
-		if err != nil {
-			return err
-		}
 		return readAdditionalValue(
 			subBucket, additionalDataKeyTest, &myAdditionalValue,
 			additionalDataTestDefaultValue,
 		)
-	}))
+	}, func() {}))
 	require.Equal(t, uint32(6543), myAdditionalValue)
 
 	// Finally, make sure we can't use the wrong type for the default value
 	// accidentally when using the readAdditionalValue function.
 	// Read the additional info again, now we shouldn't get the default
 	// value anymore.
-	require.Error(t, db.DB.View(func(tx *bbolt.Tx) error {
-		subBucket, err := getAdditionalDataBucket(
-			tx.Bucket(accountBucketKey), accountKey, false,
+	require.Error(t, kvdb.View(db, func(tx kvdb.RTx) error {
+		subBucket := getAdditionalDataReadBucket(
+			tx.ReadBucket(accountBucketKey), accountKey,
 		)
-		if err != nil {
-			return err
-		}
 		return readAdditionalValue(
 			subBucket, additionalDataKeyTest, &myAdditionalValue,
 			uint64(additionalDataTestDefaultValue),
 		)
-	}))
+	}, func() {}))
 }
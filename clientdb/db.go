@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/lightningnetwork/lnd/kvdb"
 	"go.etcd.io/bbolt"
 )
 
@@ -14,10 +15,6 @@ const (
 	// DBFilename is the default filename of the client database.
 	DBFilename = "pool.db"
 
-	// dbFilePermission is the default permission the client database file
-	// is created with.
-	dbFilePermission = 0600
-
 	// DefaultPoolDBTimeout is the default maximum time we wait for the
 	// Pool bbolt database to be opened. If the database is already opened
 	// by another process, the unique lock cannot be obtained. With the
@@ -32,67 +29,104 @@ var (
 	byteOrder = binary.BigEndian
 )
 
-// DB is a bolt-backed persistent store.
+// DB is a kvdb-backed persistent store. Depending on the configured backend,
+// the data is either stored in a local bolt file or a remote etcd or postgres
+// database.
 type DB struct {
-	*bbolt.DB
+	kvdb.Backend
 }
 
 // New creates a new bolt database that can be found at the given directory.
 func New(dir, fileName string) (*DB, error) {
-	firstInit := false
-	path := filepath.Join(dir, fileName)
-
-	// If the database file does not exist yet, create its directory.
-	if !fileExists(path) {
-		if err := os.MkdirAll(dir, 0700); err != nil {
-			return nil, err
-		}
-		firstInit = true
+	backend, err := openBoltBackend(&BackendConfig{
+		Backend:  BackendBolt,
+		Dir:      dir,
+		FileName: fileName,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := initDB(path, firstInit)
-	if err != nil {
+	return newDB(backend)
+}
+
+// newDB initializes all required buckets of the given backend and migrates the
+// database to the latest known version if necessary.
+func newDB(backend kvdb.Backend) (*DB, error) {
+	if err := initDB(backend); err != nil {
+		_ = backend.Close()
 		return nil, err
 	}
 
 	// Attempt to sync the database's current version with the latest known
 	// version available.
-	if err := syncVersions(db); err != nil {
+	if err := syncVersions(backend); err != nil {
+		_ = backend.Close()
 		return nil, err
 	}
 
-	return &DB{DB: db}, nil
+	return &DB{Backend: backend}, nil
 }
 
-// fileExists reports whether the named file or directory exists.
-func fileExists(path string) bool {
-	if _, err := os.Stat(path); err != nil {
-		if os.IsNotExist(err) {
-			return false
-		}
+// openBoltBackend opens or creates the bolt database file configured in the
+// given backend config. If auto compaction is enabled, the file is compacted
+// first if it wasn't compacted within the configured minimum age.
+func openBoltBackend(cfg *BackendConfig) (kvdb.Backend, error) {
+	path := filepath.Join(cfg.Dir, cfg.FileName)
+
+	var sizeBefore int64
+	if info, err := os.Stat(path); err == nil {
+		sizeBefore = info.Size()
 	}
-	return true
-}
 
-// initDB initializes all of the required top-level buckets for the database.
-func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
-	db, err := bbolt.Open(filepath, dbFilePermission, &bbolt.Options{
-		Timeout: DefaultPoolDBTimeout,
+	backend, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:            cfg.Dir,
+		DBFileName:        cfg.FileName,
+		DBTimeout:         DefaultPoolDBTimeout,
+		AutoCompact:       cfg.AutoCompact,
+		AutoCompactMinAge: cfg.AutoCompactMinAge,
 	})
 	if err == bbolt.ErrTimeout {
 		return nil, fmt.Errorf("error while trying to open %s: timed "+
 			"out after %v when trying to obtain exclusive lock - "+
 			"make sure no other pool daemon process (standalone "+
 			"or embedded in lightning-terminal) is running",
-			filepath, DefaultPoolDBTimeout)
+			path, DefaultPoolDBTimeout)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	err = db.Update(func(tx *bbolt.Tx) error {
+	// The compaction happens before the database is opened, so we can
+	// find out if it was compacted by comparing the file sizes. A new
+	// database file obviously doesn't need to be compacted.
+	if cfg.AutoCompact && sizeBefore > 0 {
+		info, err := os.Stat(path)
+		if err != nil {
+			_ = backend.Close()
+			return nil, err
+		}
+
+		if info.Size() != sizeBefore {
+			log.Infof("Compacted database %v: %d -> %d bytes", path,
+				sizeBefore, info.Size())
+		} else {
+			log.Debugf("Database %v not compacted, size is %d "+
+				"bytes", path, sizeBefore)
+		}
+	}
+
+	return backend, nil
+}
+
+// initDB initializes all of the required top-level buckets for the database.
+func initDB(db kvdb.Backend) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		// If the metadata bucket doesn't exist yet, this is a fresh
+		// database that needs its version and lock ID initialized.
+		firstInit := tx.ReadWriteBucket(metadataBucketKey) == nil
 		if firstInit {
-			metadataBucket, err := tx.CreateBucketIfNotExists(
+			metadataBucket, err := tx.CreateTopLevelBucket(
 				metadataBucketKey,
 			)
 			if err != nil {
@@ -107,27 +141,31 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 			}
 		}
 
-		_, err = tx.CreateBucketIfNotExists(accountBucketKey)
+		_, err := tx.CreateTopLevelBucket(accountBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(ordersBucketKey)
+		_, err = tx.CreateTopLevelBucket(ordersBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(sidecarsBucketKey)
+		_, err = tx.CreateTopLevelBucket(sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(batchBucketKey)
+		_, err = tx.CreateTopLevelBucket(batchBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(eventBucketKey)
+		_, err = tx.CreateTopLevelBucket(eventBucketKey)
 		if err != nil {
 			return err
 		}
-		snapshotBucket, err := tx.CreateBucketIfNotExists(
+		_, err = tx.CreateTopLevelBucket(accountPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+		snapshotBucket, err := tx.CreateTopLevelBucket(
 			batchSnapshotBucketKey,
 		)
 		if err != nil {
@@ -145,10 +183,5 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 			batchSnapshotBatchIDIndexBucketKey,
 		)
 		return err
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return db, nil
+	}, func() {})
 }
@@ -7,8 +7,8 @@ import (
 
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/bbolt"
 )
 
 // TestOrderEvents tests that storing and updating orders creates the correct
@@ -118,10 +118,10 @@ func getOrderEventTimestamps(store *DB, o order.Nonce, evtType event.Type) (
 	map[time.Time]struct{}, error) {
 
 	orderEventTimestamps := make(map[time.Time]struct{})
-	err := store.DB.View(func(tx *bbolt.Tx) error {
-		ordersBucket := tx.Bucket(ordersBucketKey)
-		orderBucket := ordersBucket.Bucket(o[:])
-		eventBucket := orderBucket.Bucket(eventRefSubBucket)
+	err := kvdb.View(store, func(tx kvdb.RTx) error {
+		ordersBucket := tx.ReadBucket(ordersBucketKey)
+		orderBucket := ordersBucket.NestedReadBucket(o[:])
+		eventBucket := orderBucket.NestedReadBucket(eventRefSubBucket)
 		return eventBucket.ForEach(func(k, v []byte) error {
 			// Only look at keys with correct length.
 			if len(k) != event.TimestampLength {
@@ -146,6 +146,6 @@ func getOrderEventTimestamps(store *DB, o order.Nonce, evtType event.Type) (
 
 			return nil
 		})
-	})
+	}, func() {})
 	return orderEventTimestamps, err
 }
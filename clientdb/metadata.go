@@ -7,13 +7,13 @@ import (
 
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightninglabs/pool/clientdb/migrations"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 // migration is a function which takes a prior outdated version of the database
 // instance and mutates the key/bucket structure to arrive at a more up-to-date
 // version of the database.
-type migration func(tx *bbolt.Tx) error
+type migration func(tx kvdb.RwTx) error
 
 var (
 	// metadataBucketKey stores all the metadata concerning the state of the
@@ -47,7 +47,7 @@ var (
 )
 
 // getDBVersion retrieves the current database version.
-func getDBVersion(bucket *bbolt.Bucket) (uint32, error) {
+func getDBVersion(bucket kvdb.RBucket) (uint32, error) {
 	versionBytes := bucket.Get(dbVersionKey)
 	if versionBytes == nil {
 		return 0, errors.New("database version not found")
@@ -56,15 +56,25 @@ func getDBVersion(bucket *bbolt.Bucket) (uint32, error) {
 }
 
 // setDBVersion updates the current database version.
-func setDBVersion(bucket *bbolt.Bucket, version uint32) error {
+func setDBVersion(bucket kvdb.RwBucket, version uint32) error {
 	var b [4]byte
 	byteOrder.PutUint32(b[:], version)
 	return bucket.Put(dbVersionKey, b[:])
 }
 
-// getBucket retrieves the bucket with the given key.
-func getBucket(tx *bbolt.Tx, key []byte) (*bbolt.Bucket, error) {
-	bucket := tx.Bucket(key)
+// getBucket retrieves the bucket with the given key for writing.
+func getBucket(tx kvdb.RwTx, key []byte) (kvdb.RwBucket, error) {
+	bucket := tx.ReadWriteBucket(key)
+	if bucket == nil {
+		return nil, fmt.Errorf("bucket \"%v\" does not exist",
+			string(key))
+	}
+	return bucket, nil
+}
+
+// getReadBucket retrieves the bucket with the given key for reading only.
+func getReadBucket(tx kvdb.RTx, key []byte) (kvdb.RBucket, error) {
+	bucket := tx.ReadBucket(key)
 	if bucket == nil {
 		return nil, fmt.Errorf("bucket \"%v\" does not exist",
 			string(key))
@@ -75,10 +85,10 @@ func getBucket(tx *bbolt.Tx, key []byte) (*bbolt.Bucket, error) {
 // getNestedBucket retrieves the nested bucket with the given key found within
 // the given bucket. If the bucket does not exist and `create` is true, then the
 // bucket is created.
-func getNestedBucket(bucket *bbolt.Bucket, key []byte,
-	create bool) (*bbolt.Bucket, error) {
+func getNestedBucket(bucket kvdb.RwBucket, key []byte,
+	create bool) (kvdb.RwBucket, error) {
 
-	nestedBucket := bucket.Bucket(key)
+	nestedBucket := bucket.NestedReadWriteBucket(key)
 	if nestedBucket == nil && create {
 		return bucket.CreateBucketIfNotExists(key)
 	}
@@ -89,19 +99,32 @@ func getNestedBucket(bucket *bbolt.Bucket, key []byte,
 	return nestedBucket, nil
 }
 
+// getNestedReadBucket retrieves the nested bucket with the given key found
+// within the given bucket for reading only.
+func getNestedReadBucket(bucket kvdb.RBucket, key []byte) (kvdb.RBucket,
+	error) {
+
+	nestedBucket := bucket.NestedReadBucket(key)
+	if nestedBucket == nil {
+		return nil, fmt.Errorf("nested bucket \"%v\" does not exist",
+			string(key))
+	}
+	return nestedBucket, nil
+}
+
 // syncVersions function is used for safe db version synchronization. It
 // applies migration functions to the current database and recovers the
 // previous state of db if at least one error/panic appeared during migration.
-func syncVersions(db *bbolt.DB) error {
+func syncVersions(db kvdb.Backend) error {
 	var currentVersion uint32
-	err := db.View(func(tx *bbolt.Tx) error {
-		metadata, err := getBucket(tx, metadataBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		metadata, err := getReadBucket(tx, metadataBucketKey)
 		if err != nil {
 			return err
 		}
 		currentVersion, err = getDBVersion(metadata)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return err
 	}
@@ -130,7 +153,7 @@ func syncVersions(db *bbolt.DB) error {
 
 	// Otherwise we execute the migrations serially within a single database
 	// transaction to ensure the migration is atomic.
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		for v := currentVersion; v < latestDBVersion; v++ {
 			log.Infof("Applying migration #%v", v+1)
 
@@ -146,12 +169,12 @@ func syncVersions(db *bbolt.DB) error {
 			return err
 		}
 		return setDBVersion(metadata, latestDBVersion)
-	})
+	}, func() {})
 }
 
 // storeRandomLockID generates a random lock ID backed by the system's CSPRNG
 // and stores it under the metadata bucket.
-func storeRandomLockID(metadata *bbolt.Bucket) error {
+func storeRandomLockID(metadata kvdb.RwBucket) error {
 	var lockID wtxmgr.LockID
 	if _, err := rand.Read(lockID[:]); err != nil {
 		return err
@@ -163,8 +186,8 @@ func storeRandomLockID(metadata *bbolt.Bucket) error {
 // backing lnd node's wallet.
 func (db *DB) LockID() (wtxmgr.LockID, error) {
 	var lockID wtxmgr.LockID
-	err := db.View(func(tx *bbolt.Tx) error {
-		metadata, err := getBucket(tx, metadataBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		metadata, err := getReadBucket(tx, metadataBucketKey)
 		if err != nil {
 			return err
 		}
@@ -176,6 +199,6 @@ func (db *DB) LockID() (wtxmgr.LockID, error) {
 
 		copy(lockID[:], lockIDBytes)
 		return nil
-	})
+	}, func() {})
 	return lockID, err
 }
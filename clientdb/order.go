@@ -10,8 +10,8 @@ import (
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/tlv"
-	"go.etcd.io/bbolt"
 )
 
 const (
@@ -58,6 +58,31 @@ const (
 	// orderIsPublicType is the tlv type we use to store a flag value when
 	// it is ok to share details of this order in public markets.
 	orderIsPublicType tlv.Type = 11
+
+	// orderTagType is the tlv type we use to store the optional local tag
+	// of an order.
+	orderTagType tlv.Type = 12
+
+	// orderPartialMatchPolicyType is the tlv type we use to store the
+	// policy that is applied to the remainder of a partially matched
+	// order.
+	orderPartialMatchPolicyType tlv.Type = 13
+
+	// bidMaxPremiumType is the tlv type we use to store the maximum premium
+	// a bid is willing to pay in a batch.
+	bidMaxPremiumType tlv.Type = 14
+
+	// askMinPremiumType is the tlv type we use to store the minimum premium
+	// an ask wants to earn in a batch.
+	askMinPremiumType tlv.Type = 15
+
+	// orderAutoRecreateType is the tlv type we use to store a flag value
+	// when an order should be recreated once it is fully filled.
+	orderAutoRecreateType tlv.Type = 16
+
+	// orderAutoRecreateLimitType is the tlv type we use to store the
+	// number of times an order is still recreated.
+	orderAutoRecreateLimitType tlv.Type = 17
 )
 
 var (
@@ -116,7 +141,7 @@ type orderCallback func(nonce order.Nonce, rawOrder []byte,
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) SubmitOrder(newOrder order.Order) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
@@ -180,7 +205,7 @@ func (db *DB) SubmitOrder(newOrder order.Order) error {
 		}
 
 		return nil
-	})
+	}, func() {})
 }
 
 // UpdateOrder updates an order in the database according to the given
@@ -188,14 +213,14 @@ func (db *DB) SubmitOrder(newOrder order.Order) error {
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) UpdateOrder(nonce order.Nonce, modifiers ...order.Modifier) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
 		_, err = updateOrder(rootBucket, rootBucket, nonce, modifiers)
 		return err
-	})
+	}, func() {})
 }
 
 // UpdateOrders atomically updates a list of orders in the database according to
@@ -211,7 +236,7 @@ func (db *DB) UpdateOrders(nonces []order.Nonce,
 
 	// Read and update the orders in one single transaction that they are
 	// updated atomically.
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
@@ -225,7 +250,7 @@ func (db *DB) UpdateOrders(nonces []order.Nonce,
 			}
 		}
 		return nil
-	})
+	}, func() {})
 }
 
 // GetOrder returns an order by looking up the nonce. If no order with that
@@ -239,35 +264,17 @@ func (db *DB) GetOrder(nonce order.Nonce) (order.Order, error) {
 		callback = func(nonce order.Nonce, rawOrder []byte,
 			extraData *extraOrderData) error {
 
-			r := bytes.NewReader(rawOrder)
-			o, err = DeserializeOrder(nonce, r)
-			if err != nil {
-				return err
-			}
-
-			tlvReader := bytes.NewReader(extraData.tlvData)
-			err = deserializeOrderTlvData(tlvReader, o)
-			if err != nil {
-				return err
-			}
-
-			// TODO(roasbeef): factory func to de-dup w/ all other
-			// instances?
-			if bidOrder, ok := o.(*order.Bid); ok {
-				bidOrder.MinNodeTier = extraData.minNodeTier
-			}
-			o.Details().MinUnitsMatch = extraData.minUnitsMatch
-
-			return nil
+			o, err = decodeOrder(nonce, rawOrder, extraData)
+			return err
 		}
 	)
-	err = db.View(func(tx *bbolt.Tx) error {
-		rootBucket, err := getBucket(tx, ordersBucketKey)
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		rootBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
 		return fetchOrderTX(rootBucket, nonce, callback)
-	})
+	}, func() {})
 	return o, err
 }
 
@@ -280,30 +287,18 @@ func (db *DB) GetOrders() ([]order.Order, error) {
 		callback = func(nonce order.Nonce, rawOrder []byte,
 			extraData *extraOrderData) error {
 
-			r := bytes.NewReader(rawOrder)
-			o, err := DeserializeOrder(nonce, r)
+			o, err := decodeOrder(nonce, rawOrder, extraData)
 			if err != nil {
 				return err
 			}
 
-			tlvReader := bytes.NewReader(extraData.tlvData)
-			err = deserializeOrderTlvData(tlvReader, o)
-			if err != nil {
-				return err
-			}
-
-			if bidOrder, ok := o.(*order.Bid); ok {
-				bidOrder.MinNodeTier = extraData.minNodeTier
-			}
-			o.Details().MinUnitsMatch = extraData.minUnitsMatch
-
 			orders = append(orders, o)
 			return nil
 		}
 	)
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
 		// First, we'll grab our main order bucket key.
-		rootBucket, err := getBucket(tx, ordersBucketKey)
+		rootBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
@@ -322,6 +317,8 @@ func (db *DB) GetOrders() ([]order.Order, error) {
 			copy(nonce[:], nonceBytes)
 			return fetchOrderTX(rootBucket, nonce, callback)
 		})
+	}, func() {
+		orders = nil
 	})
 	if err != nil {
 		return nil, err
@@ -329,12 +326,35 @@ func (db *DB) GetOrders() ([]order.Order, error) {
 	return orders, nil
 }
 
+// decodeOrder deserializes an order from its raw bytes and populates the
+// fields that are stored separately from the extra order data.
+func decodeOrder(nonce order.Nonce, rawOrder []byte,
+	extraData *extraOrderData) (order.Order, error) {
+
+	o, err := DeserializeOrder(nonce, bytes.NewReader(rawOrder))
+	if err != nil {
+		return nil, err
+	}
+
+	tlvReader := bytes.NewReader(extraData.tlvData)
+	if err := deserializeOrderTlvData(tlvReader, o); err != nil {
+		return nil, err
+	}
+
+	if bidOrder, ok := o.(*order.Bid); ok {
+		bidOrder.MinNodeTier = extraData.minNodeTier
+	}
+	o.Details().MinUnitsMatch = extraData.minUnitsMatch
+
+	return o, nil
+}
+
 // DeleteOrder removes the order with the given nonce. If no order with that
 // nonce exists in the store, ErrNoOrder is returned.
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) DeleteOrder(nonce order.Nonce) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		// First, we'll grab our main order bucket key.
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
@@ -342,18 +362,18 @@ func (db *DB) DeleteOrder(nonce order.Nonce) error {
 		}
 
 		// Check that the order exists in the main bucket.
-		orderBucket := rootBucket.Bucket(nonce[:])
+		orderBucket := rootBucket.NestedReadWriteBucket(nonce[:])
 		if orderBucket == nil {
 			return ErrNoOrder
 		}
 
-		return rootBucket.DeleteBucket(nonce[:])
-	})
+		return rootBucket.DeleteNestedBucket(nonce[:])
+	}, func() {})
 }
 
 // storeOrderTX saves a byte serialized order in its specific sub bucket within
 // the root orders bucket.
-func storeOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	orderBytes []byte, evt event.Event) error {
 
 	// From the root bucket, we'll make a new sub order bucket using
@@ -375,7 +395,7 @@ func storeOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 
 // storeOrderMinNoderTierTX saves the current node tier for a given order to
 // the proper bucket.
-func storeOrderMinNoderTierTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderMinNoderTierTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	minNodeTier order.NodeTier) error {
 
 	orderBucket, err := rootBucket.CreateBucketIfNotExists(nonce[:])
@@ -393,7 +413,7 @@ func storeOrderMinNoderTierTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 
 // storeOrderMinUnitsMatchTX saves the order's minimum units match within the
 // order's root bucket.
-func storeOrderMinUnitsMatchTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderMinUnitsMatchTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	minUnitsMatch order.SupplyUnit) error {
 
 	// From the root bucket, we'll make a new sub order bucket using
@@ -414,7 +434,7 @@ func storeOrderMinUnitsMatchTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 
 // storeOrderTlvTX saves the order's additional information as a tlv stream
 // within the order's root bucket.
-func storeOrderTlvTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderTlvTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	o order.Order) error {
 
 	orderBucket, err := rootBucket.CreateBucketIfNotExists(nonce[:])
@@ -432,11 +452,11 @@ func storeOrderTlvTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 
 // fetchOrderTX fetches the binary data of one order specified by its nonce from
 // the root orders bucket.
-func fetchOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func fetchOrderTX(rootBucket kvdb.RBucket, nonce order.Nonce,
 	callback orderCallback) error {
 
 	// Get the main order bucket next.
-	orderBucket := rootBucket.Bucket(nonce[:])
+	orderBucket := rootBucket.NestedReadBucket(nonce[:])
 	if orderBucket == nil {
 		return ErrNoOrder
 	}
@@ -489,7 +509,7 @@ func fetchOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 // update should be written to a staging area instead of being applied to the
 // original order directly. Do not use this function for applying the staged
 // changes to the final bucket but use copyOrder instead.
-func updateOrder(ordersBucket, dst *bbolt.Bucket, nonce order.Nonce,
+func updateOrder(ordersBucket, dst kvdb.RwBucket, nonce order.Nonce,
 	modifiers []order.Modifier) (order.Order, error) {
 
 	var (
@@ -542,7 +562,7 @@ func updateOrder(ordersBucket, dst *bbolt.Bucket, nonce order.Nonce,
 	// reference to the order in the main order bucket, even if the
 	// destination bucket is the pending orders bucket.
 	evt := NewUpdatedEvent(prevState, o)
-	orderBucket := ordersBucket.Bucket(nonce[:])
+	orderBucket := ordersBucket.NestedReadWriteBucket(nonce[:])
 	if orderBucket == nil {
 		return nil, ErrNoOrder
 	}
@@ -580,7 +600,7 @@ func updateOrder(ordersBucket, dst *bbolt.Bucket, nonce order.Nonce,
 
 // copyOrder copies a single order from the source to destination bucket. No
 // event references are copied, only the order itself.
-func copyOrder(src, dst *bbolt.Bucket, nonce order.Nonce) error {
+func copyOrder(src, dst kvdb.RwBucket, nonce order.Nonce) error {
 	var (
 		o             order.Order
 		orderBytes    []byte
@@ -704,6 +724,12 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		askConfirmationConstraints uint8
 		auctionType                uint8
 		isPublic                   uint8
+		tag                        []byte
+		partialMatchPolicy         uint8
+		bidMaxPremium              uint64
+		askMinPremium              uint64
+		autoRecreate               uint8
+		autoRecreateLimit          uint32
 	)
 
 	// We'll add records for all possible additional order data fields here
@@ -733,6 +759,16 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		),
 		tlv.MakePrimitiveRecord(orderAuctionType, &auctionType),
 		tlv.MakePrimitiveRecord(orderIsPublicType, &isPublic),
+		tlv.MakePrimitiveRecord(orderTagType, &tag),
+		tlv.MakePrimitiveRecord(
+			orderPartialMatchPolicyType, &partialMatchPolicy,
+		),
+		tlv.MakePrimitiveRecord(bidMaxPremiumType, &bidMaxPremium),
+		tlv.MakePrimitiveRecord(askMinPremiumType, &askMinPremium),
+		tlv.MakePrimitiveRecord(orderAutoRecreateType, &autoRecreate),
+		tlv.MakePrimitiveRecord(
+			orderAutoRecreateLimitType, &autoRecreateLimit,
+		),
 	)
 	if err != nil {
 		return err
@@ -763,6 +799,10 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 			castOrder.ConfirmationConstraints = constraint
 		}
 
+		if t, ok := parsedTypes[askMinPremiumType]; ok && t == nil {
+			castOrder.MinPremium = btcutil.Amount(askMinPremium)
+		}
+
 	case *order.Bid:
 		if t, ok := parsedTypes[bidSelfChanBalanceType]; ok && t == nil {
 			castOrder.SelfChanBalance = btcutil.Amount(
@@ -788,6 +828,10 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		if ok && t == nil && bidZeroConf == 1 {
 			castOrder.ZeroConfChannel = true
 		}
+
+		if t, ok := parsedTypes[bidMaxPremiumType]; ok && t == nil {
+			castOrder.MaxPremium = btcutil.Amount(bidMaxPremium)
+		}
 	}
 
 	if t, ok := parsedTypes[orderChannelType]; ok && t == nil {
@@ -819,6 +863,26 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		o.Details().IsPublic = true
 	}
 
+	if t, ok := parsedTypes[orderTagType]; ok && t == nil {
+		o.Details().Tag = string(tag)
+	}
+
+	if t, ok := parsedTypes[orderPartialMatchPolicyType]; ok && t == nil {
+		o.Details().PartialMatchPolicy = order.PartialMatchPolicy(
+			partialMatchPolicy,
+		)
+	}
+
+	t, ok = parsedTypes[orderAutoRecreateType]
+	if ok && t == nil && autoRecreate == 1 {
+		o.Details().AutoRecreate = true
+	}
+
+	t, ok = parsedTypes[orderAutoRecreateLimitType]
+	if ok && t == nil {
+		o.Details().AutoRecreateLimit = autoRecreateLimit
+	}
+
 	return nil
 }
 
@@ -831,6 +895,8 @@ func serializeOrderTlvData(w io.Writer, o order.Order) error {
 		bidUnannouncedChannel      uint8
 		askConfirmationConstraints uint8
 		bidZeroConfChannel         uint8
+		bidMaxPremium              uint64
+		askMinPremium              uint64
 	)
 
 	switch castOrder := o.(type) {
@@ -843,6 +909,8 @@ func serializeOrderTlvData(w io.Writer, o order.Order) error {
 			castOrder.ConfirmationConstraints,
 		)
 
+		askMinPremium = uint64(castOrder.MinPremium)
+
 	case *order.Bid:
 		if castOrder.SelfChanBalance != 0 {
 			selfChanBalance := uint64(castOrder.SelfChanBalance)
@@ -872,6 +940,8 @@ func serializeOrderTlvData(w io.Writer, o order.Order) error {
 		if castOrder.ZeroConfChannel {
 			bidZeroConfChannel = uint8(1)
 		}
+
+		bidMaxPremium = uint64(castOrder.MaxPremium)
 	}
 
 	channelType := uint8(o.Details().ChannelType)
@@ -927,6 +997,46 @@ func serializeOrderTlvData(w io.Writer, o order.Order) error {
 		))
 	}
 
+	if o.Details().Tag != "" {
+		tag := []byte(o.Details().Tag)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderTagType, &tag,
+		))
+	}
+
+	if o.Details().PartialMatchPolicy != order.PartialMatchKeep {
+		policy := uint8(o.Details().PartialMatchPolicy)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderPartialMatchPolicyType, &policy,
+		))
+	}
+
+	if bidMaxPremium != 0 {
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			bidMaxPremiumType, &bidMaxPremium,
+		))
+	}
+
+	if askMinPremium != 0 {
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			askMinPremiumType, &askMinPremium,
+		))
+	}
+
+	if o.Details().AutoRecreate {
+		autoRecreate := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderAutoRecreateType, &autoRecreate,
+		))
+	}
+
+	if o.Details().AutoRecreateLimit != 0 {
+		limit := o.Details().AutoRecreateLimit
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderAutoRecreateLimitType, &limit,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return err
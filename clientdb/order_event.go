@@ -10,7 +10,7 @@ import (
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 // OrderEvent is the main interface for order specific events.
@@ -318,18 +318,20 @@ var _ OrderEvent = (*MatchEvent)(nil)
 // reference keys in the order bucket.
 func (db *DB) GetOrderEvents(o order.Nonce) ([]event.Event, error) {
 	var events []event.Event
-	err := db.View(func(tx *bbolt.Tx) error {
-		ordersBucket, err := getBucket(tx, ordersBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		ordersBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
 
-		orderBucket := ordersBucket.Bucket(o[:])
+		orderBucket := ordersBucket.NestedReadBucket(o[:])
 		if orderBucket == nil {
 			return ErrNoOrder
 		}
 
-		eventSubBucket := orderBucket.Bucket(eventRefSubBucket)
+		eventSubBucket := orderBucket.NestedReadBucket(
+			eventRefSubBucket,
+		)
 		if eventSubBucket == nil {
 			return fmt.Errorf("order event sub bucket not found")
 		}
@@ -368,7 +370,7 @@ func (db *DB) GetOrderEvents(o order.Nonce) ([]event.Event, error) {
 		}
 		events, err = getEventsTX(tx, predicate)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -389,7 +391,7 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 	}
 	event.MakeUniqueTimestamps(baseEvents)
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		ordersBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
@@ -399,7 +401,9 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 		// up the individual order bucket in each iteration.
 		for _, evt := range events {
 			nonce := evt.Nonce()
-			orderBucket := ordersBucket.Bucket(nonce[:])
+			orderBucket := ordersBucket.NestedReadWriteBucket(
+				nonce[:],
+			)
 			if orderBucket == nil {
 				return ErrNoOrder
 			}
@@ -410,7 +414,7 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 		}
 
 		return nil
-	})
+	}, func() {})
 }
 
 // StoreBatchEvents creates a match event of the given match state for each of
@@ -40,6 +40,7 @@ var submitOrderTestCases = []struct {
 		}
 		o.Details().NotAllowedNodeIDs = [][33]byte{{4, 5, 6}, {5, 6, 7}}
 		o.Details().IsPublic = true
+		o.Details().Tag = "strategy-a"
 
 		return o
 	},
@@ -77,6 +78,51 @@ var submitOrderTestCases = []struct {
 			{1, 2, 3}, {2, 3, 4}, {3, 4, 5},
 		}
 		o.Details().NotAllowedNodeIDs = [][33]byte{{4, 5, 6}, {5, 6, 7}}
+		return o
+	},
+}, {
+	name: "submit order with partial match policy",
+	getOrder: func() order.Order {
+		o := &order.Ask{
+			Kit: *dummyOrder(500000, 1337),
+		}
+		o.Details().MinUnitsMatch = 10
+		o.Details().PartialMatchPolicy = order.PartialMatchReprice
+
+		return o
+	},
+}, {
+	name: "submit ask with min premium",
+	getOrder: func() order.Order {
+		o := &order.Ask{
+			Kit:        *dummyOrder(500000, 1337),
+			MinPremium: 4567,
+		}
+		o.Details().MinUnitsMatch = 10
+
+		return o
+	},
+}, {
+	name: "submit bid with max premium",
+	getOrder: func() order.Order {
+		o := &order.Bid{
+			Kit:        *dummyOrder(500000, 1337),
+			MaxPremium: 8910,
+		}
+		o.Details().MinUnitsMatch = 10
+
+		return o
+	},
+}, {
+	name: "submit order with auto recreate",
+	getOrder: func() order.Order {
+		o := &order.Ask{
+			Kit: *dummyOrder(500000, 1337),
+		}
+		o.Details().MinUnitsMatch = 10
+		o.Details().AutoRecreate = true
+		o.Details().AutoRecreateLimit = 5
+
 		return o
 	},
 }}
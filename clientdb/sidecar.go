@@ -8,7 +8,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -60,7 +60,7 @@ func (db *DB) AddSidecar(ticket *sidecar.Ticket) error {
 		return err
 	}
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
@@ -73,7 +73,7 @@ func (db *DB) AddSidecar(ticket *sidecar.Ticket) error {
 		}
 
 		return storeSidecar(sidecarBucket, sidecarKey, ticket)
-	})
+	}, func() {})
 }
 
 // AddSidecarWithBid is identical to the AddSidecar method, but it also inserts
@@ -92,7 +92,7 @@ func (db *DB) AddSidecarWithBid(ticket *sidecar.Ticket, bid *order.Bid) error {
 	bidNonce := bid.Nonce()
 	copy(ticket.Order.BidNonce[:], bidNonce[:])
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
@@ -116,7 +116,7 @@ func (db *DB) AddSidecarWithBid(ticket *sidecar.Ticket, bid *order.Bid) error {
 		}
 
 		return storeBidTemplate(bidBucket, bid, bidNonce)
-	})
+	}, func() {})
 }
 
 // UpdateSidecar updates a sidecar in the database.
@@ -126,7 +126,7 @@ func (db *DB) UpdateSidecar(ticket *sidecar.Ticket) error {
 		return err
 	}
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
@@ -149,7 +149,7 @@ func (db *DB) UpdateSidecar(ticket *sidecar.Ticket) error {
 		}
 
 		return storeSidecar(sidecarBucket, sidecarKey, ticket)
-	})
+	}, func() {})
 }
 
 // Sidecar retrieves a specific sidecar by its ID and provider signing key
@@ -163,15 +163,15 @@ func (db *DB) Sidecar(id [8]byte,
 	}
 
 	var s *sidecar.Ticket
-	err = db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
 		s, err = readSidecar(sidecarBucket, sidecarKey)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -189,8 +189,8 @@ func (db *DB) Sidecar(id [8]byte,
 // same ID and tricking the victim into registering that.
 func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 	var res []*sidecar.Ticket
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
@@ -198,7 +198,7 @@ func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 		// The first 8 bytes of the key is a sidecar ticket's ID. So as
 		// long as the key's prefix is matching, we still have tickets
 		// with the same ID.
-		cursor := sidecarBucket.Cursor()
+		cursor := sidecarBucket.ReadCursor()
 		key, val := cursor.Seek(id[:])
 		for ; key != nil && bytes.HasPrefix(key, id[:]); key, val = cursor.Next() {
 			// The main sidecar bucket has a sub-bucket that's used
@@ -216,6 +216,8 @@ func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 		}
 
 		return nil
+	}, func() {
+		res = nil
 	})
 	if err != nil {
 		return nil, err
@@ -229,20 +231,20 @@ func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 func (db *DB) SidecarBidTemplate(ticket *sidecar.Ticket) (*order.Bid, error) {
 	var bid *order.Bid
 
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
-		bidBucket := sidecarBucket.Bucket(bidTemplateBucket)
+		bidBucket := sidecarBucket.NestedReadBucket(bidTemplateBucket)
 		if bidBucket == nil {
 			return err
 		}
 
 		bid, err = readBidTemplate(bidBucket, ticket.Order.BidNonce)
 		return err
-	})
+	}, func() {})
 	if err != nil {
 		return nil, err
 	}
@@ -253,8 +255,8 @@ func (db *DB) SidecarBidTemplate(ticket *sidecar.Ticket) (*order.Bid, error) {
 // Sidecars retrieves all known sidecars from the database.
 func (db *DB) Sidecars() ([]*sidecar.Ticket, error) {
 	var res []*sidecar.Ticket
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
@@ -275,6 +277,8 @@ func (db *DB) Sidecars() ([]*sidecar.Ticket, error) {
 
 			return nil
 		})
+	}, func() {
+		res = nil
 	})
 	if err != nil {
 		return nil, err
@@ -283,7 +287,7 @@ func (db *DB) Sidecars() ([]*sidecar.Ticket, error) {
 	return res, nil
 }
 
-func storeSidecar(targetBucket *bbolt.Bucket, key []byte,
+func storeSidecar(targetBucket kvdb.RwBucket, key []byte,
 	ticket *sidecar.Ticket) error {
 
 	var sidecarBuf bytes.Buffer
@@ -296,7 +300,7 @@ func storeSidecar(targetBucket *bbolt.Bucket, key []byte,
 	return targetBucket.Put(key, sidecarBuf.Bytes())
 }
 
-func readSidecar(sourceBucket *bbolt.Bucket, id []byte) (*sidecar.Ticket,
+func readSidecar(sourceBucket kvdb.RBucket, id []byte) (*sidecar.Ticket,
 	error) {
 
 	sidecarBytes := sourceBucket.Get(id)
@@ -307,7 +311,7 @@ func readSidecar(sourceBucket *bbolt.Bucket, id []byte) (*sidecar.Ticket,
 	return sidecar.DeserializeTicket(bytes.NewReader(sidecarBytes))
 }
 
-func storeBidTemplate(bidBucket *bbolt.Bucket, bid *order.Bid,
+func storeBidTemplate(bidBucket kvdb.RwBucket, bid *order.Bid,
 	ticketNonce order.Nonce) error {
 
 	var w bytes.Buffer
@@ -331,7 +335,7 @@ func storeBidTemplate(bidBucket *bbolt.Bucket, bid *order.Bid,
 	return storeOrderMinNoderTierTX(bidBucket, ticketNonce, bid.MinNodeTier)
 }
 
-func readBidTemplate(bidBucket *bbolt.Bucket,
+func readBidTemplate(bidBucket kvdb.RBucket,
 	ticketNonce order.Nonce) (*order.Bid, error) {
 
 	var (
@@ -370,10 +374,10 @@ func readBidTemplate(bidBucket *bbolt.Bucket,
 	return o.(*order.Bid), nil
 }
 
-func removeBidTemplate(sidecarBucket *bbolt.Bucket,
+func removeBidTemplate(sidecarBucket kvdb.RwBucket,
 	ticketNonce order.Nonce) error {
 
-	bidBucket := sidecarBucket.Bucket(bidTemplateBucket)
+	bidBucket := sidecarBucket.NestedReadWriteBucket(bidTemplateBucket)
 
 	// If there is no bucket for the bid templates yet, we don't have
 	// anything to do.
@@ -388,8 +392,8 @@ func removeBidTemplate(sidecarBucket *bbolt.Bucket,
 	}
 
 	// If the template was already removed, we ignore the error.
-	err := bidBucket.DeleteBucket(ticketNonce[:])
-	if err != bbolt.ErrBucketNotFound {
+	err := bidBucket.DeleteNestedBucket(ticketNonce[:])
+	if err != kvdb.ErrBucketNotFound {
 		return err
 	}
 
@@ -0,0 +1,464 @@
+package clientdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// stateExportVersion is the version of the serialization format of an
+	// exported trader state.
+	stateExportVersion uint32 = 1
+)
+
+// StateExport is a portable copy of the accounts, orders and batch snapshots
+// (and therefore the leases) of a trader. It doesn't contain any secrets, the
+// shared secrets of the accounts are zeroed and need to be derived again from
+// the lnd node that owns the trader keys when importing the state.
+type StateExport struct {
+	// Accounts are all accounts of the trader.
+	Accounts []*account.Account
+
+	// Orders are all orders of the trader.
+	Orders []order.Order
+
+	// BatchSnapshots are the snapshots of all batches the trader
+	// participated in, in the order they were finalized.
+	BatchSnapshots []*ExportedBatchSnapshot
+}
+
+// ExportedBatchSnapshot is a batch snapshot together with the time it was
+// finalized.
+type ExportedBatchSnapshot struct {
+	*LocalBatchSnapshot
+
+	// Finalized is the time the batch snapshot was finalized. It is zero
+	// for snapshots that were stored before the finalization time was
+	// recorded.
+	Finalized time.Time
+}
+
+// ForEachAccount calls the given function for all accounts of the export,
+// including the ones that are part of the batch snapshots.
+func (s *StateExport) ForEachAccount(cb func(*account.Account) error) error {
+	for _, acct := range s.Accounts {
+		if err := cb(acct); err != nil {
+			return err
+		}
+	}
+
+	for _, snapshot := range s.BatchSnapshots {
+		for _, acct := range snapshot.Accounts {
+			if err := cb(acct); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportState returns a copy of all accounts, orders and batch snapshots of the
+// database with the shared secrets of all accounts removed.
+func (db *DB) ExportState() (*StateExport, error) {
+	var state *StateExport
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		state = &StateExport{}
+
+		accounts, err := getReadBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+		err = accounts.ForEach(func(k, v []byte) error {
+			// Skip any sub-buckets (identified by nil value).
+			if v == nil {
+				return nil
+			}
+
+			acct, err := readAccount(accounts, k)
+			if err != nil {
+				return err
+			}
+			state.Accounts = append(state.Accounts, acct)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+		callback := func(nonce order.Nonce, rawOrder []byte,
+			extraData *extraOrderData) error {
+
+			o, err := decodeOrder(nonce, rawOrder, extraData)
+			if err != nil {
+				return err
+			}
+
+			state.Orders = append(state.Orders, o)
+			return nil
+		}
+		err = rootOrderBucket.ForEach(func(nonceBytes, v []byte) error {
+			// Only go into things that we know are sub-bucket keys.
+			if v != nil {
+				return nil
+			}
+
+			var nonce order.Nonce
+			copy(nonce[:], nonceBytes)
+			return fetchOrderTX(rootOrderBucket, nonce, callback)
+		})
+		if err != nil {
+			return err
+		}
+
+		_, seqBucket, _, err := getSnapshotReadBuckets(tx)
+		if err != nil {
+			return err
+		}
+		return seqBucket.ForEach(func(seq, _ []byte) error {
+			snapshot, err := fetchLocalBatchSnapshot(
+				seqBucket, seq, rootOrderBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			snapshotBucket := seqBucket.NestedReadBucket(seq)
+			finalized, err := readSnapshotTimestamp(snapshotBucket)
+			if err != nil {
+				return err
+			}
+
+			state.BatchSnapshots = append(
+				state.BatchSnapshots, &ExportedBatchSnapshot{
+					LocalBatchSnapshot: snapshot,
+					Finalized:          finalized,
+				},
+			)
+			return nil
+		})
+	}, func() {
+		state = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The shared secrets are derived from the trader keys by lnd, so they
+	// are never part of an export.
+	_ = state.ForEachAccount(func(acct *account.Account) error {
+		acct.Secret = [32]byte{}
+		return nil
+	})
+
+	return state, nil
+}
+
+// ImportState stores all accounts, orders and batch snapshots of the given
+// export in the database. The import is atomic and fails without changing
+// anything if any of the accounts, orders or batches already exist.
+func (db *DB) ImportState(state *StateExport) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		accounts, err := getBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+		for _, acct := range state.Accounts {
+			if accounts.Get(getAccountKey(acct)) != nil {
+				return fmt.Errorf("account %x already exists",
+					getAccountKey(acct))
+			}
+
+			if err := storeAccount(accounts, acct); err != nil {
+				return err
+			}
+		}
+
+		rootOrderBucket, err := getBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+		for _, o := range state.Orders {
+			err := importOrderTX(rootOrderBucket, o)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
+		if err != nil {
+			return err
+		}
+		for _, snapshot := range state.BatchSnapshots {
+			batchID := snapshot.BatchID
+			if indexBucket.Get(batchID[:]) != nil {
+				return fmt.Errorf("snapshot of batch %x "+
+					"already exists", batchID[:])
+			}
+
+			var buf bytes.Buffer
+			err := serializeLocalBatchSnapshot(
+				&buf, snapshot.LocalBatchSnapshot,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = storeBatchSnapshot(
+				seqBucket, indexBucket, batchID, buf.Bytes(),
+				snapshot.Finalized,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// importOrderTX stores an imported order with all its extra data. Contrary to
+// submitting a new order, no creation event is added for it.
+func importOrderTX(rootBucket kvdb.RwBucket, o order.Order) error {
+	nonce := o.Nonce()
+	err := fetchOrderTX(rootBucket, nonce, nil)
+	switch err {
+	case nil:
+		return fmt.Errorf("order %v: %v", nonce, ErrOrderExists)
+
+	case ErrNoOrder:
+
+	default:
+		return err
+	}
+
+	var w bytes.Buffer
+	if err := SerializeOrder(o, &w); err != nil {
+		return err
+	}
+	if err := storeOrderTX(rootBucket, nonce, w.Bytes(), nil); err != nil {
+		return err
+	}
+
+	err = storeOrderMinUnitsMatchTX(
+		rootBucket, nonce, o.Details().MinUnitsMatch,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := storeOrderTlvTX(rootBucket, nonce, o); err != nil {
+		return err
+	}
+
+	if bidOrder, ok := o.(*order.Bid); ok {
+		return storeOrderMinNoderTierTX(
+			rootBucket, nonce, bidOrder.MinNodeTier,
+		)
+	}
+
+	return nil
+}
+
+// readSnapshotTimestamp reads the finalization time of the batch snapshot in
+// the given bucket. The zero time is returned if none was recorded.
+func readSnapshotTimestamp(snapshotBucket kvdb.RBucket) (time.Time, error) {
+	tsBytes := snapshotBucket.Get(batchSnapshotTimestampKey)
+	switch {
+	case tsBytes == nil:
+		return time.Time{}, nil
+
+	case len(tsBytes) != 8:
+		return time.Time{}, fmt.Errorf("invalid snapshot timestamp")
+	}
+
+	tsNanos := binary.BigEndian.Uint64(tsBytes)
+	return time.Unix(0, int64(tsNanos)), nil
+}
+
+// SerializeStateExport binary serializes an exported trader state to a writer
+// using the common LN wire format.
+func SerializeStateExport(w *bytes.Buffer, state *StateExport) error {
+	err := WriteElements(w, stateExportVersion, uint32(len(state.Accounts)))
+	if err != nil {
+		return err
+	}
+	for _, acct := range state.Accounts {
+		if err := serializeAccount(w, acct); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElements(w, uint32(len(state.Orders))); err != nil {
+		return err
+	}
+	for _, o := range state.Orders {
+		if err := serializeExportedOrder(w, o); err != nil {
+			return err
+		}
+	}
+
+	err = WriteElements(w, uint32(len(state.BatchSnapshots)))
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range state.BatchSnapshots {
+		var finalized uint64
+		if !snapshot.Finalized.IsZero() {
+			finalized = uint64(snapshot.Finalized.UnixNano())
+		}
+
+		var buf bytes.Buffer
+		err := serializeLocalBatchSnapshot(
+			&buf, snapshot.LocalBatchSnapshot,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = WriteElements(
+			w, finalized, uint32(buf.Len()), buf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeserializeStateExport deserializes an exported trader state from the binary
+// LN wire format.
+func DeserializeStateExport(r io.Reader) (*StateExport, error) {
+	var version, numAccounts uint32
+	if err := ReadElements(r, &version); err != nil {
+		return nil, err
+	}
+	if version != stateExportVersion {
+		return nil, fmt.Errorf("unsupported state export version %d",
+			version)
+	}
+
+	state := &StateExport{}
+	if err := ReadElements(r, &numAccounts); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numAccounts; i++ {
+		acct, err := deserializeAccount(r)
+		if err != nil {
+			return nil, err
+		}
+		state.Accounts = append(state.Accounts, acct)
+	}
+
+	var numOrders uint32
+	if err := ReadElements(r, &numOrders); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numOrders; i++ {
+		o, err := deserializeExportedOrder(r)
+		if err != nil {
+			return nil, err
+		}
+		state.Orders = append(state.Orders, o)
+	}
+
+	var numSnapshots uint32
+	if err := ReadElements(r, &numSnapshots); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numSnapshots; i++ {
+		var (
+			finalized   uint64
+			snapshotLen uint32
+		)
+		err := ReadElements(r, &finalized, &snapshotLen)
+		if err != nil {
+			return nil, err
+		}
+
+		rawSnapshot := make([]byte, snapshotLen)
+		if err := ReadElement(r, rawSnapshot); err != nil {
+			return nil, err
+		}
+		snapshot, err := deserializeLocalBatchSnapshot(
+			bytes.NewReader(rawSnapshot),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := &ExportedBatchSnapshot{
+			LocalBatchSnapshot: snapshot,
+		}
+		if finalized != 0 {
+			exported.Finalized = time.Unix(0, int64(finalized))
+		}
+		state.BatchSnapshots = append(state.BatchSnapshots, exported)
+	}
+
+	return state, nil
+}
+
+// serializeExportedOrder writes an order together with all its extra data that
+// is usually stored separately.
+func serializeExportedOrder(w *bytes.Buffer, o order.Order) error {
+	var rawOrder, tlvData bytes.Buffer
+	if err := SerializeOrder(o, &rawOrder); err != nil {
+		return err
+	}
+	if err := serializeOrderTlvData(&tlvData, o); err != nil {
+		return err
+	}
+
+	minNodeTier := order.DefaultMinNodeTier
+	if bidOrder, ok := o.(*order.Bid); ok {
+		minNodeTier = bidOrder.MinNodeTier
+	}
+
+	return WriteElements(
+		w, o.Nonce(), uint32(rawOrder.Len()), rawOrder.Bytes(),
+		o.Details().MinUnitsMatch, minNodeTier, uint32(tlvData.Len()),
+		tlvData.Bytes(),
+	)
+}
+
+// deserializeExportedOrder reads an order together with all its extra data.
+func deserializeExportedOrder(r io.Reader) (order.Order, error) {
+	var (
+		nonce     order.Nonce
+		rawLen    uint32
+		extraData extraOrderData
+		tlvLen    uint32
+	)
+	if err := ReadElements(r, &nonce, &rawLen); err != nil {
+		return nil, err
+	}
+
+	rawOrder := make([]byte, rawLen)
+	err := ReadElements(
+		r, rawOrder, &extraData.minUnitsMatch, &extraData.minNodeTier,
+		&tlvLen,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	extraData.tlvData = make([]byte, tlvLen)
+	if err := ReadElement(r, extraData.tlvData); err != nil {
+		return nil, err
+	}
+
+	return decodeOrder(nonce, rawOrder, &extraData)
+}
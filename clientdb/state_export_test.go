@@ -0,0 +1,181 @@
+package clientdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportImportState makes sure the accounts, orders and batch snapshots of
+// a database survive a round trip through an export and an import into a new
+// database, without the shared secrets of the accounts.
+func TestExportImportState(t *testing.T) {
+	t.Parallel()
+
+	src, cleanupSrc := newTestDB(t)
+	defer cleanupSrc()
+
+	acct := *testAccountTaproot
+	acct.State = account.StateOpen
+	acct.LatestTx = testBatchTx
+	require.NoError(t, src.AddAccount(&acct))
+
+	// Add the orders of the snapshot and some orders with all their extra
+	// data set.
+	for _, o := range testSnapshot.Orders {
+		require.NoError(t, src.SubmitOrder(o))
+	}
+	for _, tc := range submitOrderTestCases {
+		require.NoError(t, src.SubmitOrder(tc.getOrder()))
+	}
+
+	// Store two batch snapshots, the second one without a finalization
+	// time to simulate a snapshot that was stored before it was recorded.
+	finalized := time.Unix(1_700_000_000, 0)
+	snapshot := *testSnapshot
+	for i := 0; i < 2; i++ {
+		snapshot.BatchID[0] = byte(i)
+		err := kvdb.Update(src, func(tx kvdb.RwTx) error {
+			err := storePendingBatchSnapshot(tx, &snapshot)
+			if err != nil {
+				return err
+			}
+
+			return finalizeBatchSnapshot(
+				tx, snapshot.BatchID, finalized,
+			)
+		}, func() {})
+		require.NoError(t, err)
+	}
+	err := kvdb.Update(src, func(tx kvdb.RwTx) error {
+		_, seqBucket, _, err := getSnapshotBuckets(tx)
+		if err != nil {
+			return err
+		}
+
+		var seq [8]byte
+		binary.BigEndian.PutUint64(seq[:], 2)
+		snapshotBucket := seqBucket.NestedReadWriteBucket(seq[:])
+		return snapshotBucket.Delete(batchSnapshotTimestampKey)
+	}, func() {})
+	require.NoError(t, err)
+
+	state, err := src.ExportState()
+	require.NoError(t, err)
+	require.Len(t, state.Accounts, 1)
+	require.Len(
+		t, state.Orders,
+		len(testSnapshot.Orders)+len(submitOrderTestCases),
+	)
+	require.Len(t, state.BatchSnapshots, 2)
+	require.Equal(t, finalized, state.BatchSnapshots[0].Finalized)
+	require.True(t, state.BatchSnapshots[1].Finalized.IsZero())
+
+	// None of the accounts may contain their shared secret.
+	err = state.ForEachAccount(func(a *account.Account) error {
+		require.Equal(t, [32]byte{}, a.Secret)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, SerializeStateExport(&buf, state))
+	decoded, err := DeserializeStateExport(&buf)
+	require.NoError(t, err)
+	require.Equal(t, state, decoded)
+
+	dst, cleanupDst := newTestDB(t)
+	defer cleanupDst()
+
+	require.NoError(t, dst.ImportState(decoded))
+
+	expectedAcct := acct
+	expectedAcct.Secret = [32]byte{}
+	accounts, err := dst.Accounts()
+	require.NoError(t, err)
+	require.Equal(t, []*account.Account{&expectedAcct}, accounts)
+
+	srcOrders, err := src.GetOrders()
+	require.NoError(t, err)
+	dstOrders, err := dst.GetOrders()
+	require.NoError(t, err)
+	require.Equal(t, srcOrders, dstOrders)
+
+	srcSnapshots, err := src.GetLocalBatchSnapshots()
+	require.NoError(t, err)
+	dstSnapshots, err := dst.GetLocalBatchSnapshots()
+	require.NoError(t, err)
+	require.Len(t, dstSnapshots, len(srcSnapshots))
+	for idx, dstSnapshot := range dstSnapshots {
+		require.Equal(
+			t, state.BatchSnapshots[idx].LocalBatchSnapshot,
+			dstSnapshot,
+		)
+	}
+
+	// Leases are derived from the batch snapshots, so looking a batch up
+	// by its ID must work on the new database as well.
+	id := snapshot.BatchID
+	dstSnapshot, err := dst.GetLocalBatchSnapshot(id)
+	require.NoError(t, err)
+	require.Equal(t, state.BatchSnapshots[1].LocalBatchSnapshot, dstSnapshot)
+
+	// Exporting the new database results in the same state, including the
+	// finalization times.
+	dstState, err := dst.ExportState()
+	require.NoError(t, err)
+	require.Equal(t, state, dstState)
+
+	// Importing the same state again must fail without changing anything.
+	err = dst.ImportState(decoded)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already exists")
+
+	dstState, err = dst.ExportState()
+	require.NoError(t, err)
+	require.Equal(t, state, dstState)
+}
+
+// TestDeserializeStateExportVersion makes sure an export with an unknown
+// version is rejected.
+func TestDeserializeStateExportVersion(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteElements(&buf, stateExportVersion+1))
+
+	_, err := DeserializeStateExport(&buf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported state export version")
+}
+
+// TestImportStateOrderExists makes sure an import fails atomically if one of
+// its orders already exists.
+func TestImportStateOrderExists(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	o := &order.Bid{Kit: *dummyOrder(500000, 1337)}
+	require.NoError(t, store.SubmitOrder(o))
+
+	acct := *testAccount
+	err := store.ImportState(&StateExport{
+		Accounts: []*account.Account{&acct},
+		Orders:   []order.Order{o},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ErrOrderExists.Error())
+
+	// The account of the failed import must not have been stored.
+	accounts, err := store.Accounts()
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
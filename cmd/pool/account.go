@@ -5,12 +5,17 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/pool/poolrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var accountsCommands = []cli.Command{
@@ -21,12 +26,24 @@ var accountsCommands = []cli.Command{
 		Category:  "Accounts",
 		Subcommands: []cli.Command{
 			newAccountCommand,
+			newExternalAccountCommand,
+			publishExternalAccountCommand,
+			cancelExternalAccountCommand,
+			previewAccountFundingCommand,
+			confirmAccountFundingCommand,
 			listAccountsCommand,
+			exportAccountKeyCommand,
+			setAccountPolicyCommand,
 			depositAccountCommand,
 			withdrawAccountCommand,
+			splitAccountCommand,
+			mergeAccountsCommand,
 			renewAccountCommand,
+			quoteRenewAccountCommand,
+			simulateAccountFeesCommand,
 			closeAccountCommand,
 			listAccountFeesCommand,
+			accountAuditLogCommand,
 			bumpAccountFeeCommand,
 			recoverAccountsCommand,
 		},
@@ -42,6 +59,8 @@ type Account struct {
 	State            string `json:"state"`
 	LatestTxid       string `json:"latest_txid"`
 	Version          string `json:"version"`
+	ConfirmedBalance uint64 `json:"confirmed_balance"`
+	PendingBalance   uint64 `json:"pending_balance"`
 }
 
 // NewAccountFromProto creates a display Account from its proto.
@@ -59,6 +78,8 @@ func NewAccountFromProto(a *poolrpc.Account) *Account {
 		State:            a.State.String(),
 		LatestTxid:       latestTxHash.String(),
 		Version:          a.Version.String(),
+		ConfirmedBalance: a.ConfirmedBalance,
+		PendingBalance:   a.PendingBalance,
 	}
 }
 
@@ -223,6 +244,405 @@ func newAccount(ctx *cli.Context) error {
 	}
 
 	resp, err := client.InitAccount(context.Background(), req)
+	if err != nil {
+		return withAnchorReserveHint(err)
+	}
+
+	printJSON(NewAccountFromProto(resp))
+
+	return nil
+}
+
+// withAnchorReserveHint adds a hint on how to fund an account anyway to an
+// error that was returned because the funding would drop the lnd wallet balance
+// below the anchor reserve. poold is the only one that returns errors with the
+// FailedPrecondition code for the funding calls.
+func withAnchorReserveHint(err error) error {
+	if status.Code(err) != codes.FailedPrecondition {
+		return err
+	}
+
+	return fmt.Errorf("%w; start poold with --ignoreanchorreserve to fund "+
+		"anyway", err)
+}
+
+var newExternalAccountCommand = cli.Command{
+	Name:      "newexternal",
+	Usage:     "reserve an account that is funded externally",
+	ArgsUsage: "amt [--expiry_height | --expiry_blocks]",
+	Description: `
+		Reserve a new account with the amount in satoshis specified by
+		the amt argument that is funded by a transaction created outside
+		of the connected lnd node's wallet, for example by a treasury
+		service. The returned address must be paid the exact account
+		amount, the signed transaction is then handed over with the
+		publishexternal command.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to create account for",
+		},
+		expiryAbsoluteFlag,
+		cli.Uint64Flag{
+			Name: accountExpiryRelative,
+			Usage: "the relative height (from the current chain " +
+				"height) that the account should expire at " +
+				"(default of 30 days equivalent in blocks)",
+			Value: defaultExpiryRelative,
+		},
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "the account version to use; version 0 means " +
+				"auto-decide dependent on lnd version; " +
+				"version 1 is a p2wsh account while version " +
+				"2 is a p2tr account; version 2 requires lnd " +
+				"0.15 or later",
+		},
+	},
+	Action: newExternalAccount,
+}
+
+func newExternalAccount(ctx *cli.Context) error {
+	cmd := "newexternal"
+
+	amt, err := parseUint64(ctx, 0, "amt", cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.InitExternalAccountRequest{
+		AccountValue: amt,
+		Initiator:    defaultInitiator,
+		Version:      poolrpc.AccountVersion(ctx.Uint64("version")),
+	}
+
+	// Parse the expiry in either of its forms. We'll always prefer the
+	// absolute expiry over the relative as the relative has a default value
+	// present.
+	switch {
+	case ctx.Uint64(accountExpiryAbsolute) != 0:
+		req.AccountExpiry = &poolrpc.InitExternalAccountRequest_AbsoluteHeight{
+			AbsoluteHeight: uint32(ctx.Uint64(accountExpiryAbsolute)),
+		}
+
+	default:
+		req.AccountExpiry = &poolrpc.InitExternalAccountRequest_RelativeHeight{
+			RelativeHeight: uint32(ctx.Uint64(accountExpiryRelative)),
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.InitExternalAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Account      *Account `json:"account"`
+		OutputScript string   `json:"output_script"`
+		Address      string   `json:"address"`
+	}{
+		Account:      NewAccountFromProto(resp.Account),
+		OutputScript: hex.EncodeToString(resp.OutputScript),
+		Address:      resp.Address,
+	})
+
+	return nil
+}
+
+var publishExternalAccountCommand = cli.Command{
+	Name:      "publishexternal",
+	Usage:     "publish the funding transaction of an external account",
+	ArgsUsage: "trader_key funding_tx",
+	Description: `
+		Publish the fully signed funding transaction of an account
+		reserved with the newexternal command. The transaction must pay
+		the exact account amount to the account's address.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account " +
+				"to fund",
+		},
+		cli.StringFlag{
+			Name: "funding_tx",
+			Usage: "the fully signed funding transaction in its " +
+				"raw, hex encoded form",
+		},
+	},
+	Action: publishExternalAccount,
+}
+
+func publishExternalAccount(ctx *cli.Context) error {
+	cmd := "publishexternal"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	fundingTx, err := parseHexStr(ctx, 1, "funding_tx", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PublishExternalAccount(
+		context.Background(), &poolrpc.PublishExternalAccountRequest{
+			TraderKey: traderKey,
+			FundingTx: fundingTx,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printJSON(NewAccountFromProto(resp))
+
+	return nil
+}
+
+var cancelExternalAccountCommand = cli.Command{
+	Name:      "cancelexternal",
+	Usage:     "cancel an account waiting for its external funding",
+	ArgsUsage: "trader_key",
+	Description: `
+		Cancel an account reserved with the newexternal or
+		previewfunding command that is still waiting for its funding
+		transaction. Afterwards, the reservation no longer prevents new
+		accounts from being created. The account is only canceled
+		locally, the auction server keeps its reservation bound to the
+		account's trader key and hands out the same reservation again
+		for the next account that is created.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account " +
+				"to cancel",
+		},
+	},
+	Action: cancelExternalAccount,
+}
+
+func cancelExternalAccount(ctx *cli.Context) error {
+	cmd := "cancelexternal"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelExternalAccount(
+		context.Background(), &poolrpc.CancelExternalAccountRequest{
+			TraderKey: traderKey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printJSON(NewAccountFromProto(resp))
+
+	return nil
+}
+
+var previewAccountFundingCommand = cli.Command{
+	Name:      "previewfunding",
+	Usage:     "create an account without broadcasting its funding tx",
+	ArgsUsage: "amt [--expiry_height | --expiry_blocks]",
+	Description: `
+		Reserve a new account with the amount in satoshis specified by
+		the amt argument and create its funding transaction from the
+		connected lnd node's wallet, but don't sign or broadcast it.
+		The unsigned transaction, its fee, inputs and outputs are
+		returned for inspection. The transaction is broadcast with the
+		confirmfunding command.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to create account for",
+		},
+		expiryAbsoluteFlag,
+		cli.Uint64Flag{
+			Name: accountExpiryRelative,
+			Usage: "the relative height (from the current chain " +
+				"height) that the account should expire at " +
+				"(default of 30 days equivalent in blocks)",
+			Value: defaultExpiryRelative,
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the target number of blocks the on-chain " +
+				"account funding transaction should confirm " +
+				"within",
+			Value: defaultFundingConfTarget,
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the account creation " +
+				"transaction",
+		},
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "the account version to use; version 0 means " +
+				"auto-decide dependent on lnd version; " +
+				"version 1 is a p2wsh account while version " +
+				"2 is a p2tr account; version 2 requires lnd " +
+				"0.15 or later",
+		},
+	},
+	Action: previewAccountFunding,
+}
+
+func previewAccountFunding(ctx *cli.Context) error {
+	cmd := "previewfunding"
+
+	amt, err := parseUint64(ctx, 0, "amt", cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.PreviewAccountFundingRequest{
+		AccountValue: amt,
+		Initiator:    defaultInitiator,
+		Version:      poolrpc.AccountVersion(ctx.Uint64("version")),
+	}
+
+	satPerVByte := ctx.Uint64("sat_per_vbyte")
+	switch {
+	case satPerVByte > 0:
+		// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+		// sat/byte is used.
+		feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+		if feeRate < chainfee.FeePerKwFloor {
+			feeRate = chainfee.FeePerKwFloor
+		}
+		req.Fees = &poolrpc.PreviewAccountFundingRequest_FeeRateSatPerKw{
+			FeeRateSatPerKw: uint64(feeRate),
+		}
+
+	case ctx.Uint64("conf_target") > 0:
+		req.Fees = &poolrpc.PreviewAccountFundingRequest_ConfTarget{
+			ConfTarget: uint32(ctx.Uint64("conf_target")),
+		}
+
+	default:
+		return fmt.Errorf("either sat/vbyte or confirmation target " +
+			"must be specified")
+	}
+
+	// Parse the expiry in either of its forms. We'll always prefer the
+	// absolute expiry over the relative as the relative has a default value
+	// present.
+	switch {
+	case ctx.Uint64(accountExpiryAbsolute) != 0:
+		req.AccountExpiry = &poolrpc.PreviewAccountFundingRequest_AbsoluteHeight{
+			AbsoluteHeight: uint32(ctx.Uint64(accountExpiryAbsolute)),
+		}
+
+	default:
+		req.AccountExpiry = &poolrpc.PreviewAccountFundingRequest_RelativeHeight{
+			RelativeHeight: uint32(ctx.Uint64(accountExpiryRelative)),
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PreviewAccountFunding(context.Background(), req)
+	if err != nil {
+		return withAnchorReserveHint(err)
+	}
+
+	type fundingInput struct {
+		OutPoint string `json:"outpoint"`
+		ValueSat uint64 `json:"value_sat"`
+	}
+	inputs := make([]fundingInput, len(resp.Inputs))
+	for idx, in := range resp.Inputs {
+		opHash, err := chainhash.NewHash(in.Outpoint.Txid)
+		if err != nil {
+			return err
+		}
+
+		inputs[idx] = fundingInput{
+			OutPoint: fmt.Sprintf("%v:%d", opHash,
+				in.Outpoint.OutputIndex),
+			ValueSat: in.ValueSat,
+		}
+	}
+
+	printJSON(struct {
+		Account            *Account          `json:"account"`
+		UnsignedTx         string            `json:"unsigned_tx"`
+		FeeSat             uint64            `json:"fee_sat"`
+		Inputs             []fundingInput    `json:"inputs"`
+		Outputs            []*poolrpc.Output `json:"outputs"`
+		AccountOutputIndex uint32            `json:"account_output_index"`
+	}{
+		Account:            NewAccountFromProto(resp.Account),
+		UnsignedTx:         hex.EncodeToString(resp.UnsignedTx),
+		FeeSat:             resp.FeeSat,
+		Inputs:             inputs,
+		Outputs:            resp.Outputs,
+		AccountOutputIndex: resp.AccountOutputIndex,
+	})
+
+	return nil
+}
+
+var confirmAccountFundingCommand = cli.Command{
+	Name:      "confirmfunding",
+	Usage:     "broadcast the previewed funding tx of an account",
+	ArgsUsage: "trader_key",
+	Description: `
+		Sign and broadcast the funding transaction of an account that
+		was created with the previewfunding command.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account " +
+				"to fund",
+		},
+	},
+	Action: confirmAccountFunding,
+}
+
+func confirmAccountFunding(ctx *cli.Context) error {
+	cmd := "confirmfunding"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ConfirmAccountFunding(
+		context.Background(), &poolrpc.ConfirmAccountFundingRequest{
+			TraderKey: traderKey,
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -280,6 +700,20 @@ var listAccountsCommand = cli.Command{
 			Name:  "show_archived",
 			Usage: "include accounts that are no longer active",
 		},
+		cli.StringSliceFlag{
+			Name: "state",
+			Usage: "only list accounts in the given state (for " +
+				"example open, expired); can be specified " +
+				"multiple times",
+		},
+		cli.Uint64Flag{
+			Name:  "min_value",
+			Usage: "only list accounts with at least this value in sats",
+		},
+		cli.Uint64Flag{
+			Name:  "max_value",
+			Usage: "only list accounts with at most this value in sats",
+		},
 	},
 }
 
@@ -296,9 +730,23 @@ func listAccounts(ctx *cli.Context) error {
 		activeOnly = false
 	}
 
+	var states []poolrpc.AccountState
+	for _, state := range ctx.StringSlice("state") {
+		rpcState, ok := poolrpc.AccountState_value[strings.ToUpper(
+			state,
+		)]
+		if !ok {
+			return fmt.Errorf("unknown account state %q", state)
+		}
+		states = append(states, poolrpc.AccountState(rpcState))
+	}
+
 	resp, err := client.ListAccounts(
 		context.Background(), &poolrpc.ListAccountsRequest{
-			ActiveOnly: activeOnly,
+			ActiveOnly:  activeOnly,
+			States:      states,
+			MinValueSat: ctx.Uint64("min_value"),
+			MaxValueSat: ctx.Uint64("max_value"),
 		},
 	)
 	if err != nil {
@@ -320,6 +768,119 @@ func listAccounts(ctx *cli.Context) error {
 	return nil
 }
 
+var exportAccountKeyCommand = cli.Command{
+	Name:      "exportkey",
+	Usage:     "show the derivation of an account's trader key",
+	ArgsUsage: "trader_key",
+	Description: `
+		Show the key family, index and full derivation path of the
+		trader key of an account within the wallet of the connected lnd
+		node, so it can be documented for recovery. No private key
+		material is exported.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account to " +
+				"export the key of",
+		},
+	},
+	Action: exportAccountKey,
+}
+
+func exportAccountKey(ctx *cli.Context) error {
+	cmd := "exportkey"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportAccountKey(
+		context.Background(), &poolrpc.ExportAccountKeyRequest{
+			TraderKey: traderKey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setAccountPolicyCommand = cli.Command{
+	Name:      "setpolicy",
+	Usage:     "set the spending policy of an account",
+	ArgsUsage: "trader_key",
+	Description: `
+		Limit the number of orders that can be active at the same time
+		and the amount that can be committed to orders backed by an
+		account. New orders that would exceed either limit are rejected.
+		The policy replaces the account's current policy, a limit of 0
+		isn't enforced. Setting both limits to 0 removes the policy.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account to " +
+				"set the policy of",
+		},
+		cli.Uint64Flag{
+			Name: "max_orders",
+			Usage: "the maximum number of active orders backed by " +
+				"the account",
+		},
+		cli.Uint64Flag{
+			Name: "max_outstanding",
+			Usage: "the maximum amount in satoshis that can be " +
+				"committed to active orders backed by the account",
+		},
+	},
+	Action: setAccountPolicy,
+}
+
+func setAccountPolicy(ctx *cli.Context) error {
+	cmd := "setpolicy"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	maxOrders := ctx.Uint64("max_orders")
+	if maxOrders > math.MaxUint32 {
+		return fmt.Errorf("max_orders cannot exceed %d",
+			uint32(math.MaxUint32))
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SetAccountPolicy(
+		context.Background(), &poolrpc.SetAccountPolicyRequest{
+			TraderKey: traderKey,
+			Policy: &poolrpc.AccountPolicy{
+				MaxOrders:         uint32(maxOrders),
+				MaxOutstandingSat: ctx.Uint64("max_outstanding"),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var renewAccountCommand = cli.Command{
 	Name:      "renew",
 	ShortName: "r",
@@ -378,24 +939,118 @@ func renewAccount(ctx *cli.Context) error {
 		feeRate = chainfee.FeePerKwFloor
 	}
 
-	// Parse the expiry in either of its forms. We'll always prefer the
-	// absolute expiry over the relative as the relative has a default value
-	// present.
-	req := &poolrpc.RenewAccountRequest{
-		AccountKey:      traderKey,
-		FeeRateSatPerKw: uint64(feeRate),
+	// Parse the expiry in either of its forms. We'll always prefer the
+	// absolute expiry over the relative as the relative has a default value
+	// present.
+	req := &poolrpc.RenewAccountRequest{
+		AccountKey:      traderKey,
+		FeeRateSatPerKw: uint64(feeRate),
+		NewVersion: poolrpc.AccountVersion(
+			ctx.Int64("new_version"),
+		),
+	}
+	switch {
+	case ctx.Uint64(accountExpiryAbsolute) != 0:
+		req.AccountExpiry = &poolrpc.RenewAccountRequest_AbsoluteExpiry{
+			AbsoluteExpiry: uint32(ctx.Uint64(accountExpiryAbsolute)),
+		}
+
+	default:
+		req.AccountExpiry = &poolrpc.RenewAccountRequest_RelativeExpiry{
+			RelativeExpiry: uint32(ctx.Uint64(accountExpiryRelative)),
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RenewAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	var renewalTxid chainhash.Hash
+	copy(renewalTxid[:], resp.RenewalTxid)
+
+	var renewResp = struct {
+		Account     *Account `json:"account"`
+		RenewalTxid string   `json:"renewal_txid"`
+	}{
+		Account:     NewAccountFromProto(resp.Account),
+		RenewalTxid: renewalTxid.String(),
+	}
+
+	printJSON(renewResp)
+
+	return nil
+}
+
+var quoteRenewAccountCommand = cli.Command{
+	Name:  "quoterenew",
+	Usage: "estimate the fees for renewing the expiration of an account",
+	Description: `
+	Estimates the on-chain fee of renewing the expiration of an account
+	without signing or broadcasting any transaction. The fee rate is
+	estimated by lnd for the given confirmation target.`,
+	ArgsUsage: "trader_key [--conf_target] [--expiry_height | " +
+		"--expiry_blocks]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"renew",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the target number of blocks the renewal " +
+				"transaction should confirm within",
+			Value: defaultFundingConfTarget,
+		},
+		expiryAbsoluteFlag,
+		cli.Uint64Flag{
+			Name: accountExpiryRelative,
+			Usage: "the new relative height (from the current " +
+				"chain height) that the account should expire " +
+				"at (default of 30 days equivalent in blocks)",
+			Value: defaultExpiryRelative,
+		},
+		cli.Uint64Flag{
+			Name: "new_version",
+			Usage: "the new account version to use; version 0 " +
+				"means auto-decide dependent on lnd version; " +
+				"version 1 is a p2wsh account while version " +
+				"2 is a p2tr account; version 2 requires lnd " +
+				"0.15 or later",
+		},
+	},
+	Action: quoteRenewAccount,
+}
+
+func quoteRenewAccount(ctx *cli.Context) error {
+	cmd := "quoterenew"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.QuoteAccountRenewalRequest{
+		AccountKey: traderKey,
+		ConfTarget: uint32(ctx.Uint64("conf_target")),
 		NewVersion: poolrpc.AccountVersion(
 			ctx.Int64("new_version"),
 		),
 	}
 	switch {
 	case ctx.Uint64(accountExpiryAbsolute) != 0:
-		req.AccountExpiry = &poolrpc.RenewAccountRequest_AbsoluteExpiry{
+		req.AccountExpiry = &poolrpc.QuoteAccountRenewalRequest_AbsoluteExpiry{
 			AbsoluteExpiry: uint32(ctx.Uint64(accountExpiryAbsolute)),
 		}
 
 	default:
-		req.AccountExpiry = &poolrpc.RenewAccountRequest_RelativeExpiry{
+		req.AccountExpiry = &poolrpc.QuoteAccountRenewalRequest_RelativeExpiry{
 			RelativeExpiry: uint32(ctx.Uint64(accountExpiryRelative)),
 		}
 	}
@@ -406,23 +1061,65 @@ func renewAccount(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.RenewAccount(context.Background(), req)
+	resp, err := client.QuoteAccountRenewal(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	var renewalTxid chainhash.Hash
-	copy(renewalTxid[:], resp.RenewalTxid)
+	printRespJSON(resp)
 
-	var renewResp = struct {
-		Account     *Account `json:"account"`
-		RenewalTxid string   `json:"renewal_txid"`
-	}{
-		Account:     NewAccountFromProto(resp.Account),
-		RenewalTxid: renewalTxid.String(),
+	return nil
+}
+
+var simulateAccountFeesCommand = cli.Command{
+	Name:  "simulatefees",
+	Usage: "estimate the fees of account operations",
+	Description: `
+	Estimates the on-chain fees of opening, depositing to, withdrawing from
+	and closing an account for each of the given confirmation targets. The
+	fee rates are estimated by lnd, the transaction sizes assume a single
+	wallet input and output where needed. No transaction is created.`,
+	Flags: []cli.Flag{
+		cli.IntSliceFlag{
+			Name: "conf_target",
+			Usage: "a target number of blocks the transactions " +
+				"should confirm within; can be specified " +
+				"multiple times (default 2, 6, 12 and 144)",
+		},
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "the account version to use; version 0 means " +
+				"auto-decide dependent on lnd version; " +
+				"version 1 is a p2wsh account while version " +
+				"2 is a p2tr account",
+		},
+	},
+	Action: simulateAccountFees,
+}
+
+func simulateAccountFees(ctx *cli.Context) error {
+	req := &poolrpc.SimulateAccountFeesRequest{
+		AccountVersion: poolrpc.AccountVersion(ctx.Uint64("version")),
+	}
+	for _, confTarget := range ctx.IntSlice("conf_target") {
+		if confTarget < 1 {
+			return fmt.Errorf("conf_target must be greater than 0")
+		}
+		req.ConfTargets = append(req.ConfTargets, uint32(confTarget))
 	}
 
-	printJSON(renewResp)
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SimulateAccountFees(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
 
 	return nil
 }
@@ -526,7 +1223,7 @@ func depositAccount(ctx *cli.Context) error {
 
 	resp, err := client.DepositAccount(context.Background(), req)
 	if err != nil {
-		return err
+		return withAnchorReserveHint(err)
 	}
 
 	var depositTxid chainhash.Hash
@@ -677,6 +1374,208 @@ func withdrawAccount(ctx *cli.Context) error {
 	return nil
 }
 
+var splitAccountCommand = cli.Command{
+	Name:  "split",
+	Usage: "split parts of an existing account into a new account",
+	Description: `
+	Move the given amount out of an existing account into a new account
+	with its own expiry. The new account is funded by a withdrawal from the
+	existing account, which pays the fee of the transaction.
+	`,
+	ArgsUsage: "trader_key amt sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"split",
+		},
+		cli.Uint64Flag{
+			Name: "amt",
+			Usage: "the amount that will be moved into the new " +
+				"account",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the split",
+		},
+		cli.Uint64Flag{
+			Name: accountExpiryAbsolute,
+			Usage: "the absolute block height at which the new " +
+				"account should expire",
+		},
+		cli.Uint64Flag{
+			Name: accountExpiryRelative,
+			Usage: "the relative height (from the current chain " +
+				"height) that the new account should expire at",
+		},
+	},
+	Action: splitAccount,
+}
+
+func splitAccount(ctx *cli.Context) error {
+	cmd := "split"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	amt, err := parseUint64(ctx, 1, "amt", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 2, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	req := &poolrpc.SplitAccountRequest{
+		TraderKey:       traderKey,
+		AmountSat:       amt,
+		FeeRateSatPerKw: uint64(feeRate),
+	}
+
+	absoluteExpiry := ctx.Uint64(accountExpiryAbsolute)
+	relativeExpiry := ctx.Uint64(accountExpiryRelative)
+	switch {
+	case absoluteExpiry != 0 && relativeExpiry != 0:
+		return errors.New("relative and absolute height cannot be " +
+			"set in the same request")
+
+	case absoluteExpiry != 0:
+		req.AccountExpiry = &poolrpc.SplitAccountRequest_AbsoluteExpiry{
+			AbsoluteExpiry: uint32(absoluteExpiry),
+		}
+
+	case relativeExpiry != 0:
+		req.AccountExpiry = &poolrpc.SplitAccountRequest_RelativeExpiry{
+			RelativeExpiry: uint32(relativeExpiry),
+		}
+
+	default:
+		return fmt.Errorf("either %v or %v must be set",
+			accountExpiryAbsolute, accountExpiryRelative)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SplitAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	var splitTxid chainhash.Hash
+	copy(splitTxid[:], resp.SplitTxid)
+
+	var splitAccountResp = struct {
+		Account    *Account `json:"account"`
+		NewAccount *Account `json:"new_account"`
+		SplitTxid  string   `json:"split_txid"`
+	}{
+		Account:    NewAccountFromProto(resp.Account),
+		NewAccount: NewAccountFromProto(resp.NewAccount),
+		SplitTxid:  splitTxid.String(),
+	}
+
+	printJSON(splitAccountResp)
+
+	return nil
+}
+
+var mergeAccountsCommand = cli.Command{
+	Name:  "merge",
+	Usage: "merge an existing account into another account",
+	Description: `
+	Move all funds of an account into another account with a single
+	transaction that spends both accounts. The merged account is closed
+	once the transaction confirms. Only Taproot accounts can be merged.
+	`,
+	ArgsUsage: "trader_key merge_trader_key sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account the " +
+				"funds are merged into",
+		},
+		cli.StringFlag{
+			Name: "merge_trader_key",
+			Usage: "the hex-encoded trader key of the account " +
+				"that is merged and closed",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the merge",
+		},
+	},
+	Action: mergeAccounts,
+}
+
+func mergeAccounts(ctx *cli.Context) error {
+	cmd := "merge"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	mergeTraderKey, err := parseHexStr(ctx, 1, "merge_trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 2, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.MergeAccounts(
+		context.Background(), &poolrpc.MergeAccountsRequest{
+			TraderKey:       traderKey,
+			MergeTraderKey:  mergeTraderKey,
+			FeeRateSatPerKw: uint64(feeRate),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	var mergeTxid chainhash.Hash
+	copy(mergeTxid[:], resp.MergeTxid)
+
+	var mergeAccountsResp = struct {
+		Account   *Account `json:"account"`
+		MergeTxid string   `json:"merge_txid"`
+	}{
+		Account:   NewAccountFromProto(resp.Account),
+		MergeTxid: mergeTxid.String(),
+	}
+
+	printJSON(mergeAccountsResp)
+
+	return nil
+}
+
 var closeAccountCommand = cli.Command{
 	Name:      "close",
 	ShortName: "c",
@@ -685,8 +1584,12 @@ var closeAccountCommand = cli.Command{
 	Close an existing account. An optional address can be provided which the
 	funds of the account to close will be sent to, otherwise they are sent
 	to an address under control of the connected lnd node.
+
+	The fee of the closing transaction is either set explicitly with the
+	sat_per_vbyte argument or estimated by lnd for the given confirmation
+	target.
 	`,
-	ArgsUsage: "trader_key sat_per_vbyte",
+	ArgsUsage: "trader_key [sat_per_vbyte | --conf_target]",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "trader_key",
@@ -698,6 +1601,12 @@ var closeAccountCommand = cli.Command{
 			Usage: "the fee rate expressed in sat/vbyte that " +
 				"should be used for the closing transaction",
 		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the target number of blocks the closing " +
+				"transaction should confirm within, used " +
+				"instead of sat_per_vbyte",
+		},
 		cli.StringFlag{
 			Name: "addr",
 			Usage: "an optional address which the funds of the " +
@@ -713,13 +1622,35 @@ func closeAccount(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	satPerVByte, err := parseUint64(ctx, 1, "sat_per_vbyte", cmd)
-	if err != nil {
-		return err
+
+	outputWithFee := &poolrpc.OutputWithFee{
+		Address: ctx.String("addr"),
 	}
-	satPerKw := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
-	if satPerKw < chainfee.FeePerKwFloor {
-		satPerKw = chainfee.FeePerKwFloor
+	if ctx.IsSet("conf_target") {
+		confTarget := ctx.Uint64("conf_target")
+		if confTarget == 0 {
+			return fmt.Errorf("specified confirmation target must be " +
+				"greater than 0")
+		}
+
+		outputWithFee.Fees = &poolrpc.OutputWithFee_ConfTarget{
+			ConfTarget: uint32(confTarget),
+		}
+	} else {
+		satPerVByte, err := parseUint64(ctx, 1, "sat_per_vbyte", cmd)
+		if err != nil {
+			return err
+		}
+		satPerKw := chainfee.SatPerKVByte(
+			satPerVByte * 1000,
+		).FeePerKWeight()
+		if satPerKw < chainfee.FeePerKwFloor {
+			satPerKw = chainfee.FeePerKwFloor
+		}
+
+		outputWithFee.Fees = &poolrpc.OutputWithFee_FeeRateSatPerKw{
+			FeeRateSatPerKw: uint64(satPerKw),
+		}
 	}
 
 	client, cleanup, err := getClient(ctx)
@@ -732,12 +1663,7 @@ func closeAccount(ctx *cli.Context) error {
 		context.Background(), &poolrpc.CloseAccountRequest{
 			TraderKey: traderKey,
 			FundsDestination: &poolrpc.CloseAccountRequest_OutputWithFee{
-				OutputWithFee: &poolrpc.OutputWithFee{
-					Address: ctx.String("addr"),
-					Fees: &poolrpc.OutputWithFee_FeeRateSatPerKw{
-						FeeRateSatPerKw: uint64(satPerKw),
-					},
-				},
+				OutputWithFee: outputWithFee,
 			},
 		},
 	)
@@ -849,6 +1775,72 @@ func listAccountFees(ctx *cli.Context) error {
 	return nil
 }
 
+var accountAuditLogCommand = cli.Command{
+	Name:      "auditlog",
+	ShortName: "a",
+	Usage:     "list the history of an account",
+	Description: `
+	This command prints a chronological list of all events of an account,
+	like its creation, deposits, withdrawals, renewals, batch participations,
+	expiry and closure, together with the associated transaction IDs.
+	`,
+	ArgsUsage: "trader_key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account to " +
+				"list the events for",
+		},
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "only list events that happened at or after " +
+				"the given unix timestamp in seconds",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "only list events that happened at or before " +
+				"the given unix timestamp in seconds",
+		},
+	},
+	Action: accountAuditLog,
+}
+
+func accountAuditLog(ctx *cli.Context) error {
+	cmd := "auditlog"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	var startNs, endNs uint64
+	if ctx.IsSet("start") {
+		startNs = uint64(time.Unix(ctx.Int64("start"), 0).UnixNano())
+	}
+	if ctx.IsSet("end") {
+		endNs = uint64(time.Unix(ctx.Int64("end"), 0).UnixNano())
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.AccountAuditLog(
+		context.Background(), &poolrpc.AccountAuditLogRequest{
+			TraderKey:        traderKey,
+			StartTimestampNs: startNs,
+			EndTimestampNs:   endNs,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var recoverAccountsCommand = cli.Command{
 	Name: "recover",
 	Usage: "recover accounts after data loss with the help of the " +
@@ -879,6 +1871,18 @@ var recoverAccountsCommand = cli.Command{
 				"20 accounts",
 			Value: defaultAccountTarget,
 		},
+		cli.Uint64Flag{
+			Name: "first_key_index",
+			Usage: "the index of the first account key to " +
+				"re-derive from lnd's key ring",
+		},
+		cli.Uint64Flag{
+			Name: "key_window",
+			Usage: "the number of successive account keys to " +
+				"re-derive and check, starting at " +
+				"first_key_index; defaults to account_target " +
+				"if not set",
+		},
 		cli.StringFlag{
 			Name: "auctioneer_key",
 			Usage: "Auctioneer's public key. Only used during " +
@@ -892,7 +1896,9 @@ var recoverAccountsCommand = cli.Command{
 		cli.StringFlag{
 			Name: "bitcoin_host",
 			Usage: "bitcoind/btcd instance address. Only used " +
-				"during `full_client` recoveries",
+				"during `full_client` recoveries. If not " +
+				"set, only the transactions known to lnd are " +
+				"scanned",
 		},
 		cli.StringFlag{
 			Name: "bitcoin_user",
@@ -939,6 +1945,8 @@ func recoverAccounts(ctx *cli.Context) error {
 			AccountTarget: uint32(ctx.Uint64("account_target")),
 			AuctioneerKey: ctx.String("auctioneer_key"),
 			HeightHint:    uint32(ctx.Uint64("height_hint")),
+			FirstKeyIndex: uint32(ctx.Uint64("first_key_index")),
+			KeyWindow:     uint32(ctx.Uint64("key_window")),
 
 			// bitcoind/btcd configuration, ignored unless
 			// full_client is true.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/pool/auctioneerrpc"
@@ -28,6 +29,8 @@ type Lease struct {
 	Purchased             bool   `json:"purchased"`
 	SelfChanBalance       uint64 `json:"self_chan_balance"`
 	SidecarChannel        bool   `json:"sidecar_channel"`
+	Status                string `json:"status"`
+	RemainingBlocks       uint32 `json:"remaining_duration_blocks"`
 }
 
 // NewLeaseFromProto creates a display Lease from its proto.
@@ -53,6 +56,8 @@ func NewLeaseFromProto(a *poolrpc.Lease) *Lease {
 		Purchased:             a.Purchased,
 		SelfChanBalance:       a.SelfChanBalance,
 		SidecarChannel:        a.SidecarChannel,
+		Status:                a.Status.String(),
+		RemainingBlocks:       a.RemainingDurationBlocks,
 	}
 }
 
@@ -108,8 +113,14 @@ var auctionCommands = []cli.Command{
 			auctionFeeCommand,
 			batchSnapshotCommand,
 			leasesCommand,
+			leaseReportCommand,
+			pendingChannelsCommand,
 			leaseDurationsCommand,
 			nextBatchInfoCommand,
+			serverParamsCommand,
+			pendingBatchesCommand,
+			subscribeBatchesCommand,
+			acceptBatchCommand,
 			ratingsCommand,
 		},
 	},
@@ -225,7 +236,8 @@ var leasesCommand = cli.Command{
 	Description: `
 	Returns the list of leases (i.e., channels) that were either purchased
 	or sold by the trader within the auction. An optional list of batch IDs
-	and accounts can be specified to filter the leases returned.
+	and accounts as well as a role and a list of statuses can be specified
+	to filter the leases returned.
 	`,
 	Flags: []cli.Flag{
 		cli.StringSliceFlag{
@@ -240,6 +252,18 @@ var leasesCommand = cli.Command{
 				"left blank, leases from all accounts are " +
 				"returned",
 		},
+		cli.StringFlag{
+			Name: "role",
+			Usage: "only show leases that were purchased (taker) " +
+				"or sold (maker), if left blank, leases of " +
+				"both roles are returned",
+		},
+		cli.StringSliceFlag{
+			Name: "status",
+			Usage: "only show leases with the given status " +
+				"(pending, active, matured or closed), can " +
+				"be specified multiple times",
+		},
 	},
 	Action: leases,
 }
@@ -267,6 +291,21 @@ func leases(ctx *cli.Context) error {
 		accounts = append(accounts, accountKey)
 	}
 
+	roleFilter, err := parseLeaseRoleFilter(ctx.String("role"))
+	if err != nil {
+		return err
+	}
+
+	statusNames := ctx.StringSlice("status")
+	statuses := make([]poolrpc.LeaseStatus, 0, len(statusNames))
+	for _, statusName := range statusNames {
+		status, err := parseLeaseStatus(statusName)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
 		return err
@@ -274,8 +313,10 @@ func leases(ctx *cli.Context) error {
 	defer cleanup()
 
 	resp, err := client.Leases(context.Background(), &poolrpc.LeasesRequest{
-		BatchIds: batchIDs,
-		Accounts: accounts,
+		BatchIds:   batchIDs,
+		Accounts:   accounts,
+		RoleFilter: roleFilter,
+		Statuses:   statuses,
 	})
 	if err != nil {
 		return err
@@ -301,6 +342,144 @@ func leases(ctx *cli.Context) error {
 	return nil
 }
 
+var leaseReportCommand = cli.Command{
+	Name:      "leasereport",
+	ShortName: "lr",
+	Usage: "returns the premiums, fees and effective APR of executed " +
+		"leases",
+	Description: `
+	Returns the premiums earned and paid, the execution and chain fees
+	paid and the effective annual percentage rate of all leases that were
+	executed within an optional time range, optionally only for leases of
+	the given role.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "only include leases that were executed at or " +
+				"after the given unix timestamp in seconds",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "only include leases that were executed at or " +
+				"before the given unix timestamp in seconds",
+		},
+		cli.StringFlag{
+			Name: "role",
+			Usage: "only include leases that were purchased " +
+				"(taker) or sold (maker), if left blank, " +
+				"leases of both roles are included",
+		},
+	},
+	Action: leaseReport,
+}
+
+func leaseReport(ctx *cli.Context) error {
+	var startNs, endNs uint64
+	if ctx.IsSet("start") {
+		startNs = uint64(time.Unix(ctx.Int64("start"), 0).UnixNano())
+	}
+	if ctx.IsSet("end") {
+		endNs = uint64(time.Unix(ctx.Int64("end"), 0).UnixNano())
+	}
+
+	roleFilter, err := parseLeaseRoleFilter(ctx.String("role"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.LeasePerformanceReport(
+		context.Background(), &poolrpc.LeasePerformanceReportRequest{
+			StartTimestampNs: startNs,
+			EndTimestampNs:   endNs,
+			RoleFilter:       roleFilter,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseLeaseRoleFilter parses the lease role filter from its string
+// representation.
+func parseLeaseRoleFilter(role string) (poolrpc.LeaseRoleFilter, error) {
+	switch role {
+	case "":
+		return poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_ALL, nil
+
+	case "taker":
+		return poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_TAKER, nil
+
+	case "maker":
+		return poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER, nil
+
+	default:
+		return 0, fmt.Errorf("unknown role filter %q", role)
+	}
+}
+
+// parseLeaseStatus parses a lease status from its string representation.
+func parseLeaseStatus(status string) (poolrpc.LeaseStatus, error) {
+	switch status {
+	case "pending":
+		return poolrpc.LeaseStatus_LEASE_STATUS_PENDING, nil
+
+	case "active":
+		return poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE, nil
+
+	case "matured":
+		return poolrpc.LeaseStatus_LEASE_STATUS_MATURED, nil
+
+	case "closed":
+		return poolrpc.LeaseStatus_LEASE_STATUS_CLOSED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown lease status %q", status)
+	}
+}
+
+var pendingChannelsCommand = cli.Command{
+	Name:      "pendingchannels",
+	ShortName: "pc",
+	Usage:     "show the channels of the last batch and their progress",
+	Description: `
+		Lists the channels that are expected to be opened by the last
+		batch the trader participated in, together with their state
+		and number of confirmations as reported by lnd.
+		`,
+	Action: pendingChannels,
+}
+
+func pendingChannels(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListPendingBatchChannels(
+		context.Background(),
+		&poolrpc.ListPendingBatchChannelsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var leaseDurationsCommand = cli.Command{
 	Name:      "leasedurations",
 	ShortName: "ld",
@@ -354,6 +533,172 @@ func nextBatchInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var serverParamsCommand = cli.Command{
+	Name:      "params",
+	ShortName: "p",
+	Usage: "returns the order parameters the auction server currently " +
+		"enforces",
+	Description: `
+		Returns the smallest order amount, the maximum account value,
+		the accepted lease durations and the execution fee of the
+		auction server.
+		`,
+	Action: serverParams,
+}
+
+func serverParams(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetServerParams(
+		context.Background(), &poolrpc.ServerParamsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pendingBatchesCommand = cli.Command{
+	Name:      "pendingbatches",
+	ShortName: "pb",
+	Usage:     "list the batches that are waiting for confirmation",
+	Description: `
+		Lists the batches that are outside of the configured minimum
+		premium or maximum fee and are therefore not accepted
+		automatically. Each batch must be confirmed with the
+		acceptbatch command before it expires, otherwise it is
+		rejected.
+		`,
+	Action: pendingBatches,
+}
+
+func pendingBatches(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListBatchConfirmations(
+		context.Background(),
+		&poolrpc.ListBatchConfirmationsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var subscribeBatchesCommand = cli.Command{
+	Name:      "subscribebatches",
+	ShortName: "sb",
+	Usage:     "print batches as soon as they wait for confirmation",
+	Description: `
+		Prints every batch that is waiting for confirmation, starting
+		with the ones that are already waiting, until the command is
+		interrupted. Each batch must be confirmed with the acceptbatch
+		command before it expires, otherwise it is rejected.
+		`,
+	Action: subscribeBatches,
+}
+
+func subscribeBatches(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeBatchConfirmations(
+		context.Background(),
+		&poolrpc.SubscribeBatchConfirmationsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(batch)
+	}
+}
+
+var acceptBatchCommand = cli.Command{
+	Name:      "acceptbatch",
+	ShortName: "ab",
+	Usage:     "accept or reject a batch that is waiting for confirmation",
+	ArgsUsage: "batch_id",
+	Description: `
+		Accepts a batch that is waiting for confirmation because it is
+		outside of the configured minimum premium or maximum fee. With
+		the --reject flag, the batch is rejected right away instead.
+		`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "batch_id",
+			Usage: "the ID of the batch to accept or reject",
+		},
+		cli.BoolFlag{
+			Name:  "reject",
+			Usage: "reject the batch instead of accepting it",
+		},
+	},
+	Action: acceptBatch,
+}
+
+func acceptBatch(ctx *cli.Context) error {
+	var batchIDStr string
+	switch {
+	case ctx.Args().Present():
+		batchIDStr = ctx.Args().First()
+
+	case ctx.IsSet("batch_id"):
+		batchIDStr = ctx.String("batch_id")
+
+	default:
+		return cli.ShowCommandHelp(ctx, "acceptbatch")
+	}
+
+	batchID, err := hex.DecodeString(batchIDStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode batch ID: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.AcceptBatch(
+		context.Background(), &poolrpc.AcceptBatchRequest{
+			BatchId: batchID,
+			Reject:  ctx.Bool("reject"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var ratingsCommand = cli.Command{
 	Name:      "ratings",
 	ShortName: "r",
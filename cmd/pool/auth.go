@@ -24,6 +24,7 @@ type printableToken struct {
 	TimeCreated     string `json:"time_created"`
 	Expired         bool   `json:"expired"`
 	FileName        string `json:"file_name"`
+	Current         bool   `json:"current"`
 }
 
 var listAuthCommand = cli.Command{
@@ -73,9 +74,66 @@ func listAuth(ctx *cli.Context) error {
 			),
 			Expired:  t.Expired,
 			FileName: t.StorageName,
+			Current:  t.Current,
 		}
 	}
 
 	printJSON(tokens)
 	return nil
 }
+
+var deleteAuthCommand = cli.Command{
+	Name:      "deleteauth",
+	Usage:     "delete an LSAT token",
+	ArgsUsage: "id",
+	Description: `
+	Delete the LSAT token with the given ID. If the token that is currently
+	used to authenticate with the auction server is deleted, poold pays for
+	a new one on the next request that requires it.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the token to delete as shown by listauth",
+		},
+	},
+	Action: deleteAuth,
+}
+
+func deleteAuth(ctx *cli.Context) error {
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "deleteauth")
+		return nil
+	}
+
+	var idHex string
+	switch {
+	case ctx.IsSet("id"):
+		idHex = ctx.String("id")
+	case ctx.Args().Present():
+		idHex = ctx.Args().First()
+	default:
+		return fmt.Errorf("id argument missing")
+	}
+	tokenID, err := hex.DecodeString(idHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode token ID: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DeleteLsatToken(
+		context.Background(), &poolrpc.DeleteLsatTokenRequest{
+			TokenId: tokenID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
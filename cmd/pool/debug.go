@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"path"
@@ -30,6 +31,7 @@ var debugCommands = []cli.Command{
 			dumpPendingBatcheCommand,
 			removePendingBatchCommand,
 			deleteOrderCommand,
+			dumpRuntimeCommand,
 		},
 	},
 }
@@ -280,6 +282,38 @@ func removePendingBatch(ctx *cli.Context) error {
 	return db.DeletePendingBatch()
 }
 
+var dumpRuntimeCommand = cli.Command{
+	Name:      "dumpruntime",
+	ShortName: "dr",
+	Usage:     "dump runtime statistics and the config of the daemon",
+	Description: `
+	Fetch the number of goroutines, heap statistics and the active
+	configuration with all secrets redacted from the running daemon. Unlike
+	the other debug commands this connects to poold over its RPC interface
+	and requires the admin macaroon.
+	`,
+	Action: dumpRuntime,
+}
+
+func dumpRuntime(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DebugDump(
+		context.Background(), &poolrpc.DebugDumpRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 func getPoolDB(ctx *cli.Context) (*clientdb.DB, error) {
 	fullDbPath := filepath.Join(
 		pool.DefaultBaseDir, ctx.GlobalString("network"),
@@ -33,3 +33,88 @@ func getInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var tlsInfoCommand = cli.Command{
+	Name:  "tlsinfo",
+	Usage: "show info about the daemon's TLS certificate",
+	Description: "Displays the validity period, domains, IP addresses and " +
+		"SHA-256 fingerprint of the TLS certificate currently served " +
+		"by the Pool trader daemon",
+	Action: tlsInfo,
+}
+
+func tlsInfo(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetTLSInfo(
+		context.Background(), &poolrpc.GetTLSInfoRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var testConnectionsCommand = cli.Command{
+	Name:  "testconnections",
+	Usage: "test the connections to lnd and the auction server",
+	Description: "Dials the connected lnd node and the auction server on " +
+		"new connections and shows whether each endpoint could be " +
+		"reached and how long it took to respond, without creating " +
+		"any accounts or orders",
+	Action: testConnections,
+}
+
+func testConnections(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.TestConnections(
+		context.Background(), &poolrpc.TestConnectionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var connectionStatusCommand = cli.Command{
+	Name:  "connectionstatus",
+	Usage: "show the state of the connection to lnd",
+	Description: "Shows whether poold is currently connected to lnd, the " +
+		"error that caused the last disconnect and how often poold " +
+		"reconnected to lnd since it was started",
+	Action: connectionStatus,
+}
+
+func connectionStatus(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetConnectionStatus(
+		context.Background(), &poolrpc.GetConnectionStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -57,6 +57,11 @@ var (
 		Usage: "path to macaroon file",
 		Value: pool.DefaultMacaroonPath,
 	}
+	noMacaroonsFlag = cli.BoolFlag{
+		Name: "nomacaroons",
+		Usage: "don't send a macaroon, only works if poold was " +
+			"started with --nomacaroons",
+	}
 )
 
 const (
@@ -130,13 +135,19 @@ func main() {
 		baseDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		noMacaroonsFlag,
 	}
 	app.Commands = append(app.Commands, accountsCommands...)
 	app.Commands = append(app.Commands, ordersCommands...)
 	app.Commands = append(app.Commands, sidecarCommands...)
 	app.Commands = append(app.Commands, auctionCommands...)
 	app.Commands = append(app.Commands, listAuthCommand)
+	app.Commands = append(app.Commands, deleteAuthCommand)
 	app.Commands = append(app.Commands, getInfoCommand)
+	app.Commands = append(app.Commands, tlsInfoCommand)
+	app.Commands = append(app.Commands, testConnectionsCommand)
+	app.Commands = append(app.Commands, connectionStatusCommand)
+	app.Commands = append(app.Commands, stateCommands...)
 	app.Commands = append(app.Commands, debugCommands...)
 	app.Commands = append(app.Commands, stopDaemonCommand)
 
@@ -175,6 +186,9 @@ func getClient(ctx *cli.Context) (poolrpc.TraderClient, func(),
 	if err != nil {
 		return nil, nil, err
 	}
+	if ctx.GlobalBool(noMacaroonsFlag.Name) {
+		macaroonPath = ""
+	}
 	conn, err := getClientConn(rpcServer, tlsCertPath, macaroonPath)
 	if err != nil {
 		return nil, nil, err
@@ -236,15 +250,18 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 func getClientConn(address, tlsCertPath, macaroonPath string) (*grpc.ClientConn,
 	error) {
 
-	// We always need to send a macaroon.
-	macOption, err := readMacaroon(macaroonPath)
-	if err != nil {
-		return nil, err
-	}
-
 	opts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
-		macOption,
+	}
+
+	// We need to send a macaroon unless macaroons are disabled, which is
+	// signaled by an empty path.
+	if macaroonPath != "" {
+		macOption, err := readMacaroon(macaroonPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, macOption)
 	}
 
 	// TLS cannot be disabled, we'll always have a cert file to read.
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/auctioneer"
@@ -28,6 +29,10 @@ const (
 	auctionTypeInboundLiquidity  = "inbound"
 	auctionTypeOutboundLiquidity = "outbound"
 
+	partialMatchPolicyKeep    = "keep"
+	partialMatchPolicyCancel  = "cancel"
+	partialMatchPolicyReprice = "reprice"
+
 	defaultMaxBatchFeeRateSatPerVByte = 100
 
 	defaultConfirmationConstraints = 1
@@ -64,6 +69,8 @@ var ordersCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			ordersListCommand,
 			ordersCancelCommand,
+			ordersReplaceCommand,
+			ordersConfirmCommand,
 			{
 				Name:        "submit",
 				Aliases:     []string{"s"},
@@ -100,8 +107,10 @@ var baseBidFlags = []cli.Flag{
 	cli.Uint64Flag{
 		Name: "lease_duration_blocks",
 		Usage: "the number of blocks that the " +
-			"liquidity should be provided for",
-		Value: defaultBidMinDuration,
+			"liquidity should be provided for; if not set, the " +
+			"daemon's default lease duration is used or " +
+			fmt.Sprintf("%d blocks if it has none",
+				defaultBidMinDuration),
 	},
 	cli.Uint64Flag{
 		Name: "min_node_tier",
@@ -182,6 +191,39 @@ var sharedFlags = []cli.Flag{
 		Usage: "flag used to signal that this order's details can be " +
 			"shared in public market places.",
 	},
+	cli.BoolFlag{
+		Name: "prepare_only",
+		Usage: "only validate the order and print a preview and a " +
+			"confirmation token instead of submitting it; use " +
+			"'orders confirm' with the token to submit the order",
+	},
+	cli.StringFlag{
+		Name: "partial_match_policy",
+		Usage: fmt.Sprintf("what to do with the remainder of the "+
+			"order if it is only partially matched in a batch "+
+			"(%q, %q, %q); %q cancels the remainder and submits "+
+			"it again at the batch's clearing rate, within the "+
+			"limit of the order's own rate",
+			partialMatchPolicyKeep, partialMatchPolicyCancel,
+			partialMatchPolicyReprice, partialMatchPolicyReprice),
+		Value: partialMatchPolicyKeep,
+	},
+	cli.BoolFlag{
+		Name: "auto_recreate",
+		Usage: "submit an identical new order as soon as this " +
+			"order is fully filled",
+	},
+	cli.Uint64Flag{
+		Name: "auto_recreate_limit",
+		Usage: "the number of times the order is recreated if " +
+			"--auto_recreate is set; 0 means no limit",
+	},
+	cli.StringFlag{
+		Name: "tag",
+		Usage: "an optional tag to attach to the order, for example " +
+			"to group orders by trading strategy; the tag is only " +
+			"stored locally",
+	},
 }
 
 // promptForConfirmation continuously prompts the user for the message until
@@ -377,49 +419,20 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 		return nil, fmt.Errorf("unable to parse acct_key: %v", err)
 	}
 
-	// Convert the cmd line flag from sat/vByte to sat/kw which is used
-	// internally.
-	satPerByte := ctx.Uint64("max_batch_fee_rate")
-	if satPerByte == 0 {
-		return nil, fmt.Errorf("max batch fee rate must be at " +
-			"least 1 sat/vByte")
-	}
-
-	satPerKw := chainfee.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
-
-	// Because of rounding, we ensure the set rate is at least our fee
-	// floor.
-	if satPerKw < chainfee.FeePerKwFloor {
-		satPerKw = chainfee.FeePerKwFloor
+	params.MaxBatchFeeRateSatPerKw, err = parseMaxBatchFeeRate(
+		ctx.Uint64("max_batch_fee_rate"),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	params.MaxBatchFeeRateSatPerKw = uint64(satPerKw)
-
-	// We'll map the interest rate specified on the command line to our
-	// internal "rate_fixed" unit.
-	//
-	// rate = % / 100
-	// rate = rateFixed / totalParts
-	// rateFixed = rate * totalParts
-	interestPercent := ctx.Float64("interest_rate_percent")
-	interestRate := interestPercent / 100
-	rateFixedFloat := interestRate * order.FeeRateTotalParts
-
-	// We then take this rate fixed, and divide it by the number of blocks
-	// as the user wants this rate to be the final lump sum they pay.
-	rateFixed := uint32(rateFixedFloat / float64(blockDuration))
-
-	// At this point, if this value is less than 1, then we aren't able to
-	// express it given the current precision allowed by our fixed point.
-	if rateFixed < 1 {
-		return nil, fmt.Errorf("fixed rate of %v is too small "+
-			"(%v%% over %v blocks), min is 1 (%v%%)", rateFixed,
-			interestPercent, blockDuration,
-			float64(1)/order.FeeRateTotalParts)
+	params.RateFixed, err = parseInterestRate(
+		ctx.Float64("interest_rate_percent"), blockDuration,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	params.RateFixed = rateFixed
-
 	// Determine the appropriate channel type that should be opened upon an
 	// order match.
 	channelType := ctx.String("channel_type")
@@ -481,9 +494,75 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 		params.IsPublic = ctx.Bool("public")
 	}
 
+	params.Tag = ctx.String("tag")
+	params.AutoRecreate = ctx.Bool("auto_recreate")
+	params.AutoRecreateLimit = uint32(ctx.Uint64("auto_recreate_limit"))
+
+	policy := ctx.String("partial_match_policy")
+	switch policy {
+	case partialMatchPolicyKeep:
+		params.PartialMatchPolicy = poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_KEEP
+
+	case partialMatchPolicyCancel:
+		params.PartialMatchPolicy = poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_CANCEL
+
+	case partialMatchPolicyReprice:
+		params.PartialMatchPolicy = poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_REPRICE
+
+	default:
+		return nil, fmt.Errorf("unknown partial match policy %q",
+			policy)
+	}
+
 	return params, nil
 }
 
+// parseMaxBatchFeeRate converts the max batch fee rate given on the command
+// line from sat/vByte to sat/kw which is used internally.
+func parseMaxBatchFeeRate(satPerByte uint64) (uint64, error) {
+	if satPerByte == 0 {
+		return 0, fmt.Errorf("max batch fee rate must be at " +
+			"least 1 sat/vByte")
+	}
+
+	satPerKw := chainfee.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
+
+	// Because of rounding, we ensure the set rate is at least our fee
+	// floor.
+	if satPerKw < chainfee.FeePerKwFloor {
+		satPerKw = chainfee.FeePerKwFloor
+	}
+
+	return uint64(satPerKw), nil
+}
+
+// parseInterestRate maps the interest rate specified on the command line to
+// our internal "rate_fixed" unit.
+func parseInterestRate(interestPercent float64, blockDuration uint32) (uint32,
+	error) {
+
+	// rate = % / 100
+	// rate = rateFixed / totalParts
+	// rateFixed = rate * totalParts
+	interestRate := interestPercent / 100
+	rateFixedFloat := interestRate * order.FeeRateTotalParts
+
+	// We then take this rate fixed, and divide it by the number of blocks
+	// as the user wants this rate to be the final lump sum they pay.
+	rateFixed := uint32(rateFixedFloat / float64(blockDuration))
+
+	// At this point, if this value is less than 1, then we aren't able to
+	// express it given the current precision allowed by our fixed point.
+	if rateFixed < 1 {
+		return 0, fmt.Errorf("fixed rate of %v is too small "+
+			"(%v%% over %v blocks), min is 1 (%v%%)", rateFixed,
+			interestPercent, blockDuration,
+			float64(1)/order.FeeRateTotalParts)
+	}
+
+	return rateFixed, nil
+}
+
 // parseAccountKey tries to read the account key parameter from the command
 // line positional arguments and/or flags.
 func parseAccountKey(ctx *cli.Context, args cli.Args) ([]byte, error) {
@@ -579,6 +658,13 @@ var ordersSubmitAskCommand = cli.Command{
 				"ones. The default value is \"only confirmed\"",
 			Value: defaultConfirmationConstraints,
 		},
+		cli.Uint64Flag{
+			Name: "min_premium",
+			Usage: "the minimum total premium in satoshis to earn " +
+				"for all matches of this ask in a single " +
+				"batch; batches that pay less are rejected by " +
+				"the daemon; 0 means no limit",
+		},
 		cli.BoolFlag{
 			Name:  "force",
 			Usage: "skip order placement confirmation",
@@ -609,6 +695,7 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 		Version:                 uint32(order.VersionChannelType),
 		AnnouncementConstraints: announcement,
 		ConfirmationConstraints: confirmations,
+		MinPremiumSat:           ctx.Uint64("min_premium"),
 	}
 
 	params, err := parseCommonParams(ctx, ask.LeaseDurationBlocks)
@@ -633,6 +720,25 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 	}
 	defer cleanup()
 
+	req := &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Ask{
+			Ask: ask,
+		},
+		Initiator: defaultInitiator,
+	}
+
+	// A prepared order isn't submitted yet, so there's nothing to confirm
+	// at this point.
+	if ctx.Bool("prepare_only") {
+		resp, err := client.PrepareOrder(context.Background(), req)
+		if err != nil {
+			return err
+		}
+		printPreparedOrder(resp)
+
+		return nil
+	}
+
 	// If the user didn't opt to force submit this order, then we'll show a
 	// break down of the final order details and request a confirmation
 	// before we submit.
@@ -656,14 +762,7 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 		}
 	}
 
-	resp, err := client.SubmitOrder(
-		context.Background(), &poolrpc.SubmitOrderRequest{
-			Details: &poolrpc.SubmitOrderRequest_Ask{
-				Ask: ask,
-			},
-			Initiator: defaultInitiator,
-		},
-	)
+	resp, err := client.SubmitOrder(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -750,6 +849,14 @@ var ordersSubmitBidCommand = cli.Command{
 					"amt, min_chan_amt, lease_duration_blocks " +
 					"and self_chan_balance fields",
 			},
+			cli.Uint64Flag{
+				Name: "max_premium",
+				Usage: "the maximum total premium in satoshis " +
+					"to pay for all matches of this bid in " +
+					"a single batch; batches that charge " +
+					"more are rejected by the daemon; 0 " +
+					"means no limit",
+			},
 		), sharedFlags...,
 	),
 	Action: ordersSubmitBid,
@@ -828,6 +935,21 @@ func parseBaseBid(ctx *cli.Context) (*poolrpc.Bid, *sidecar.Ticket, error) {
 		bid.SidecarTicket = ctx.String("sidecar_ticket")
 	}
 
+	// Without an explicit lease duration, we use the daemon's default. We
+	// need to know it to calculate the fixed rate from the interest rate
+	// and to show it in the order details, so we set it on the bid
+	// directly instead of leaving it to the daemon. If the daemon has no
+	// default, we use ours.
+	if bid.LeaseDurationBlocks == 0 {
+		bid.LeaseDurationBlocks, err = daemonDefaultLeaseDuration(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bid.LeaseDurationBlocks == 0 {
+			bid.LeaseDurationBlocks = defaultBidMinDuration
+		}
+	}
+
 	params, err := parseCommonParams(ctx, bid.LeaseDurationBlocks)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to parse order "+
@@ -863,6 +985,26 @@ func parseBaseBid(ctx *cli.Context) (*poolrpc.Bid, *sidecar.Ticket, error) {
 	return bid, ticket, nil
 }
 
+// daemonDefaultLeaseDuration returns the lease duration the daemon uses for
+// bids that don't specify one or 0 if it doesn't have a default.
+func daemonDefaultLeaseDuration(ctx *cli.Context) (uint32, error) {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer cleanup()
+
+	info, err := client.GetInfo(
+		context.Background(), &poolrpc.GetInfoRequest{},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query default lease duration: "+
+			"%v", err)
+	}
+
+	return info.DefaultLeaseDurationBlocks, nil
+}
+
 func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 	// Show help if no arguments or flags are provided.
 	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
@@ -874,6 +1016,7 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 	if err != nil {
 		return err
 	}
+	bid.MaxPremiumSat = ctx.Uint64("max_premium")
 
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -881,6 +1024,25 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 	}
 	defer cleanup()
 
+	req := &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Bid{
+			Bid: bid,
+		},
+		Initiator: defaultInitiator,
+	}
+
+	// A prepared order isn't submitted yet, so there's nothing to confirm
+	// at this point.
+	if ctx.Bool("prepare_only") {
+		resp, err := client.PrepareOrder(context.Background(), req)
+		if err != nil {
+			return err
+		}
+		printPreparedOrder(resp)
+
+		return nil
+	}
+
 	// If the user didn't opt to force submit this order, then we'll show a
 	// break down of the final order details and request a confirmation
 	// before we submit.
@@ -905,14 +1067,7 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 		}
 	}
 
-	resp, err := client.SubmitOrder(
-		context.Background(), &poolrpc.SubmitOrderRequest{
-			Details: &poolrpc.SubmitOrderRequest_Bid{
-				Bid: bid,
-			},
-			Initiator: defaultInitiator,
-		},
-	)
+	resp, err := client.SubmitOrder(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -937,10 +1092,97 @@ var ordersListCommand = cli.Command{
 			Name:  "show_archived",
 			Usage: "include orders no longer active",
 		},
+		cli.StringFlag{
+			Name:  "tag",
+			Usage: "only list orders with the given tag",
+		},
+		cli.StringFlag{
+			Name: "state",
+			Usage: "only list orders in the given state category " +
+				"(active, archived, all); overrides " +
+				"show_archived if set",
+		},
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "only list orders of the given type (ask, bid)",
+		},
+		cli.StringFlag{
+			Name: "sort_by",
+			Usage: "the field to sort the orders by (creation_time, " +
+				"rate), asks and bids are sorted together but " +
+				"listed separately",
+			Value: "creation_time",
+		},
+		cli.BoolFlag{
+			Name:  "reversed",
+			Usage: "sort the orders in descending order",
+		},
+		cli.Uint64Flag{
+			Name:  "offset",
+			Usage: "the number of orders to skip",
+		},
+		cli.Uint64Flag{
+			Name: "limit",
+			Usage: "the maximum number of orders to list; if zero, " +
+				"all orders are listed",
+		},
 	},
 	Action: ordersList,
 }
 
+// parseOrderStateFilter parses the order state filter from its string
+// representation.
+func parseOrderStateFilter(state string) (poolrpc.OrderStateFilter, error) {
+	switch state {
+	case "":
+		return poolrpc.OrderStateFilter_ORDER_STATE_FILTER_DEFAULT, nil
+
+	case "active":
+		return poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ACTIVE, nil
+
+	case "archived":
+		return poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ARCHIVED, nil
+
+	case "all":
+		return poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ALL, nil
+
+	default:
+		return 0, fmt.Errorf("unknown state filter %q", state)
+	}
+}
+
+// parseOrderTypeFilter parses the order type filter from its string
+// representation.
+func parseOrderTypeFilter(orderType string) (poolrpc.OrderTypeFilter, error) {
+	switch orderType {
+	case "":
+		return poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_ALL, nil
+
+	case "ask":
+		return poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_ASK, nil
+
+	case "bid":
+		return poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_BID, nil
+
+	default:
+		return 0, fmt.Errorf("unknown type filter %q", orderType)
+	}
+}
+
+// parseOrderSortBy parses the order sort field from its string representation.
+func parseOrderSortBy(sortBy string) (poolrpc.OrderSortBy, error) {
+	switch sortBy {
+	case "creation_time":
+		return poolrpc.OrderSortBy_ORDER_SORT_BY_CREATION_TIME, nil
+
+	case "rate":
+		return poolrpc.OrderSortBy_ORDER_SORT_BY_RATE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown sort field %q", sortBy)
+	}
+}
+
 func ordersList(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -954,10 +1196,32 @@ func ordersList(ctx *cli.Context) error {
 		activeOnly = false
 	}
 
+	stateFilter, err := parseOrderStateFilter(ctx.String("state"))
+	if err != nil {
+		return err
+	}
+
+	typeFilter, err := parseOrderTypeFilter(ctx.String("type"))
+	if err != nil {
+		return err
+	}
+
+	sortBy, err := parseOrderSortBy(ctx.String("sort_by"))
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.ListOrders(
 		context.Background(), &poolrpc.ListOrdersRequest{
-			Verbose:    ctx.Bool("verbose"),
-			ActiveOnly: activeOnly,
+			Verbose:     ctx.Bool("verbose"),
+			ActiveOnly:  activeOnly,
+			Tag:         ctx.String("tag"),
+			StateFilter: stateFilter,
+			TypeFilter:  typeFilter,
+			Offset:      uint32(ctx.Uint64("offset")),
+			Limit:       uint32(ctx.Uint64("limit")),
+			SortBy:      sortBy,
+			Reversed:    ctx.Bool("reversed"),
 		},
 	)
 	if err != nil {
@@ -968,6 +1232,94 @@ func ordersList(ctx *cli.Context) error {
 	return nil
 }
 
+// printPreparedOrder prints the preview of a prepared order with its
+// confirmation token hex encoded so it can be passed to 'orders confirm'.
+func printPreparedOrder(resp *poolrpc.PrepareOrderResponse) {
+	expiry := time.Unix(0, resp.ExpiryTimestampNs)
+
+	var prepareResp = struct {
+		TotalPremiumSat       uint64  `json:"total_premium_sat"`
+		RatePerBlock          float64 `json:"rate_per_block"`
+		TotalExecutionFeeSat  uint64  `json:"total_execution_fee_sat"`
+		WorstCaseChainFeeSat  uint64  `json:"worst_case_chain_fee_sat"`
+		LastClearingRateFixed uint32  `json:"last_clearing_rate_fixed"`
+		ConfirmationToken     string  `json:"confirmation_token"`
+		Expiry                string  `json:"expiry"`
+	}{
+		TotalPremiumSat:       resp.Quote.TotalPremiumSat,
+		RatePerBlock:          resp.Quote.RatePerBlock,
+		TotalExecutionFeeSat:  resp.Quote.TotalExecutionFeeSat,
+		WorstCaseChainFeeSat:  resp.Quote.WorstCaseChainFeeSat,
+		LastClearingRateFixed: resp.LastClearingRateFixed,
+		ConfirmationToken:     hex.EncodeToString(resp.ConfirmationToken),
+		Expiry:                expiry.Format(time.RFC3339),
+	}
+
+	printJSON(prepareResp)
+}
+
+var ordersConfirmCommand = cli.Command{
+	Name:      "confirm",
+	Usage:     "submit a previously prepared order",
+	ArgsUsage: "confirmation_token",
+	Description: `
+	Submit an order that was prepared with 'orders submit ask/bid
+	--prepare_only' by presenting its confirmation token. A token can only
+	be used once and expires shortly after it was issued.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "confirmation_token",
+			Usage: "the confirmation token of the prepared order",
+		},
+	},
+	Action: ordersConfirm,
+}
+
+func ordersConfirm(ctx *cli.Context) error { // nolint: dupl
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "confirm")
+		return nil
+	}
+
+	var (
+		tokenHex string
+		args     = ctx.Args()
+	)
+	switch {
+	case ctx.IsSet("confirmation_token"):
+		tokenHex = ctx.String("confirmation_token")
+	case args.Present():
+		tokenHex = args.First()
+	default:
+		return fmt.Errorf("confirmation_token argument missing")
+	}
+	token, err := hex.DecodeString(tokenHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode confirmation token: %v",
+			err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ConfirmOrder(
+		context.Background(), &poolrpc.ConfirmOrderRequest{
+			ConfirmationToken: token,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var ordersCancelCommand = cli.Command{
 	Name:      "cancel",
 	Aliases:   []string{"c"},
@@ -1026,3 +1378,169 @@ func ordersCancel(ctx *cli.Context) error { // nolint: dupl
 	printRespJSON(resp)
 	return nil
 }
+
+var ordersReplaceCommand = cli.Command{
+	Name:      "replace",
+	Usage:     "cancel an order and submit a copy with new parameters",
+	ArgsUsage: "order_nonce",
+	Description: `
+	Cancel an active order and submit a copy of it with the given parameters
+	changed. The replacement is only submitted if the old order could be
+	canceled, so both orders are never active at the same time. If the
+	submission of the replacement fails, the old order stays canceled.
+
+	Orders for sidecar tickets cannot be replaced.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "order_nonce",
+			Usage: "the order nonce of the order to replace",
+		},
+		cli.Float64Flag{
+			Name: "interest_rate_percent",
+			Usage: "the new total percent one is willing to " +
+				"pay or accept as yield for the specified " +
+				"interval",
+		},
+		cli.Uint64Flag{
+			Name:  "amt",
+			Usage: "the new amount of the order in satoshis",
+		},
+		cli.Uint64Flag{
+			Name: "max_batch_fee_rate",
+			Usage: "the new maximum fee rate (sat/vByte) to " +
+				"use for the batch transaction",
+		},
+	},
+	Action: ordersReplace,
+}
+
+func ordersReplace(ctx *cli.Context) error {
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "replace")
+		return nil
+	}
+
+	var nonceHex string
+	switch {
+	case ctx.IsSet("order_nonce"):
+		nonceHex = ctx.String("order_nonce")
+	case ctx.Args().Present():
+		nonceHex = ctx.Args().First()
+	default:
+		return fmt.Errorf("order_nonce argument missing")
+	}
+	nonce, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode order nonce: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	orders, err := client.ListOrders(
+		context.Background(), &poolrpc.ListOrdersRequest{
+			ActiveOnly: true,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// The replacement is a copy of the old order with the given
+	// parameters changed.
+	var (
+		replacement   = &poolrpc.SubmitOrderRequest{}
+		details       *poolrpc.Order
+		leaseDuration uint32
+	)
+	for _, ask := range orders.Asks {
+		if hex.EncodeToString(ask.Details.OrderNonce) == nonceHex {
+			details = ask.Details
+			leaseDuration = ask.LeaseDurationBlocks
+			replacement.Details = &poolrpc.SubmitOrderRequest_Ask{
+				Ask: ask,
+			}
+		}
+	}
+	for _, bid := range orders.Bids {
+		if hex.EncodeToString(bid.Details.OrderNonce) == nonceHex {
+			if bid.SidecarTicket != "" {
+				return fmt.Errorf("orders for sidecar " +
+					"tickets cannot be replaced")
+			}
+
+			details = bid.Details
+			leaseDuration = bid.LeaseDurationBlocks
+			replacement.Details = &poolrpc.SubmitOrderRequest_Bid{
+				Bid: bid,
+			}
+		}
+	}
+	if details == nil {
+		return fmt.Errorf("no active order with nonce %s found",
+			nonceHex)
+	}
+
+	err = applyReplacementParams(ctx, details, leaseDuration)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ReplaceOrder(
+		context.Background(), &poolrpc.ReplaceOrderRequest{
+			OrderNonce:  nonce,
+			Replacement: replacement,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// applyReplacementParams turns the details of an existing order into the
+// details of its replacement by clearing all state of the existing order and
+// applying the parameters given on the command line.
+func applyReplacementParams(ctx *cli.Context, details *poolrpc.Order,
+	leaseDuration uint32) error {
+
+	// The server creates a new nonce if none is set and derives all
+	// other state of the new order itself.
+	details.OrderNonce = nil
+	details.State = 0
+	details.Units = 0
+	details.UnitsUnfulfilled = 0
+	details.ReservedValueSat = 0
+	details.CreationTimestampNs = 0
+	details.Events = nil
+	details.RateApr = 0
+
+	var err error
+	if ctx.IsSet("amt") {
+		details.Amt = ctx.Uint64("amt")
+	}
+	if ctx.IsSet("max_batch_fee_rate") {
+		details.MaxBatchFeeRateSatPerKw, err = parseMaxBatchFeeRate(
+			ctx.Uint64("max_batch_fee_rate"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if ctx.IsSet("interest_rate_percent") {
+		details.RateFixed, err = parseInterestRate(
+			ctx.Float64("interest_rate_percent"), leaseDuration,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
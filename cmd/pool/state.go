@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var stateCommands = []cli.Command{
+	{
+		Name:  "state",
+		Usage: "Migrate the local trader state to a new host.",
+		Subcommands: []cli.Command{
+			exportStateCommand,
+			importStateCommand,
+		},
+	},
+}
+
+var exportStateCommand = cli.Command{
+	Name:      "export",
+	Usage:     "export the accounts, orders and leases to a file",
+	ArgsUsage: "file",
+	Description: `
+	Export all accounts, orders and batch snapshots (which the leases are
+	derived from) of the daemon to the given file. The file doesn't contain
+	any secrets, the account secrets are derived from lnd again when the
+	file is imported on the new host.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "the file to write the exported state to",
+		},
+	},
+	Action: exportState,
+}
+
+func exportState(ctx *cli.Context) error {
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "export")
+		return nil
+	}
+
+	fileName, err := stateFileName(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportState(
+		context.Background(), &poolrpc.ExportStateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(fileName, resp.State, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to write state file: %v", err)
+	}
+
+	// The state itself is binary, so we only print the numbers.
+	resp.State = nil
+	printRespJSON(resp)
+	return nil
+}
+
+var importStateCommand = cli.Command{
+	Name:      "import",
+	Usage:     "import the accounts, orders and leases from a file",
+	ArgsUsage: "file",
+	Description: `
+	Import a state that was exported with the state export command into the
+	daemon. The daemon must be connected to the same lnd node (or one
+	restored from the same seed) as the daemon the state was exported from.
+	Restart the daemon after the import to resume the imported accounts.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "the file to read the exported state from",
+		},
+	},
+	Action: importState,
+}
+
+func importState(ctx *cli.Context) error {
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "import")
+		return nil
+	}
+
+	fileName, err := stateFileName(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("unable to read state file: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ImportState(
+		context.Background(), &poolrpc.ImportStateRequest{
+			State: state,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// stateFileName returns the name of the state file given either as flag or as
+// the first argument.
+func stateFileName(ctx *cli.Context) (string, error) {
+	var fileName string
+	switch {
+	case ctx.IsSet("file"):
+		fileName = ctx.String("file")
+	case ctx.Args().Present():
+		fileName = ctx.Args().First()
+	default:
+		return "", fmt.Errorf("file argument missing")
+	}
+
+	return lncfg.CleanAndExpandPath(fileName), nil
+}
@@ -38,17 +38,32 @@ func start() error {
 		return err
 	}
 
-	// Parse ini file.
+	// A network passed on the command line selects the network profile of
+	// the config files, even if a file sets a different network.
+	var cliNetwork string
+	if parser.FindOptionByLongName("network").IsSet() {
+		cliNetwork = config.Network
+	}
+
+	// Parse the config file shared by all networks in the base directory
+	// first. It might also set the network, so we parse the command line
+	// flags again to restore flags overwritten by it.
+	sharedConfigFile := filepath.Join(config.BaseDir, defaultConfigFilename)
+	err = pool.LoadConfigFile(&config, sharedConfigFile, cliNetwork)
+	if err != nil {
+		return err
+	}
+	if _, err := parser.Parse(); err != nil {
+		return err
+	}
+
+	// The config file in the network's directory takes precedence over
+	// the shared one.
 	poolDir := filepath.Join(config.BaseDir, config.Network)
 	configFile := filepath.Join(poolDir, defaultConfigFilename)
-
-	if err := flags.IniParse(configFile, &config); err != nil {
-		// If it's a parsing related error, then we'll return
-		// immediately, otherwise we can proceed as possibly the config
-		// file doesn't exist which is OK.
-		if _, ok := err.(*flags.IniError); ok {
-			return err
-		}
+	err = pool.LoadConfigFile(&config, configFile, config.Network)
+	if err != nil {
+		return err
 	}
 
 	// Parse command line flags again to restore flags overwritten by ini
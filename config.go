@@ -3,16 +3,34 @@ package pool
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/jessevdk/go-flags"
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/perms"
 	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/kvdb/etcd"
+	"github.com/lightningnetwork/lnd/kvdb/postgres"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/signal"
@@ -58,6 +76,10 @@ var (
 	// deprecated --lnd.macaroondir config option is used.
 	defaultLndMacaroon = "admin.macaroon"
 
+	// DefaultLndChain is the default chain lnd stores its chain specific
+	// data, like the macaroons, for.
+	DefaultLndChain = "bitcoin"
+
 	// DefaultTLSCertPath is the default full path of the autogenerated TLS
 	// certificate.
 	DefaultTLSCertPath = filepath.Join(
@@ -86,10 +108,7 @@ var (
 
 	// DefaultLndMacaroonPath is the default location where we look for a
 	// macaroon to use when connecting to lnd.
-	DefaultLndMacaroonPath = filepath.Join(
-		DefaultLndDir, "data", "chain", "bitcoin", DefaultNetwork,
-		defaultLndMacaroon,
-	)
+	DefaultLndMacaroonPath = lndMacaroonPath(DefaultLndChain, DefaultNetwork)
 
 	// DefaultAutogenValidity is the default validity of a self-signed
 	// certificate. The value corresponds to 14 months
@@ -97,8 +116,22 @@ var (
 	DefaultAutogenValidity = 14 * 30 * 24 * time.Hour
 )
 
+const (
+	// EnvAuctionServer is the environment variable that sets the auction
+	// server address if --auctionserver isn't set.
+	EnvAuctionServer = "POOL_AUCTIONSERVER"
+
+	// EnvProxy is the environment variable that sets the SOCKS proxy if
+	// --proxy isn't set.
+	EnvProxy = "POOL_PROXY"
+
+	// EnvLndHost is the environment variable that sets the lnd address if
+	// --lnd.host isn't set.
+	EnvLndHost = "POOL_LND_HOST"
+)
+
 type LndConfig struct {
-	Host string `long:"host" description:"lnd instance rpc address"`
+	Host string `long:"host" description:"lnd instance rpc address, can also be set with the POOL_LND_HOST environment variable"`
 
 	// MacaroonDir is the directory that contains all the macaroon files
 	// required for the remote connection.
@@ -111,19 +144,44 @@ type LndConfig struct {
 	// will occur.
 	MacaroonPath string `long:"macaroonpath" description:"The full path to the single macaroon to use, either the admin.macaroon or a custom baked one. Cannot be specified at the same time as macaroondir. A custom macaroon must contain ALL permissions required for all subservers to work, otherwise permission errors will occur."`
 
+	// MacaroonPaths is a list of macaroon files of which the first one
+	// that contains all permissions poold requires is used. This allows
+	// users to point poold to all macaroons they have without needing to
+	// know which of them is sufficient.
+	MacaroonPaths []string `long:"macaroonpaths" description:"A list of macaroon files to choose from, can be specified multiple times. The first macaroon that contains all permissions required by pool is used. If only the combination of the macaroons covers all permissions, pool prints the command to bake a single sufficient macaroon. Cannot be specified at the same time as macaroonpath or macaroondir."`
+
 	TLSPath string `long:"tlspath" description:"Path to lnd tls certificate"`
+
+	// MacaroonWaitTimeout is the maximum time we wait for the macaroon
+	// file to appear on startup, for example if it is mounted by a
+	// container orchestrator after poold was started.
+	MacaroonWaitTimeout time.Duration `long:"macaroonwaittimeout" description:"If the macaroon file at lnd.macaroonpath doesn't exist on startup, wait up to this long for it to appear before failing. Set to 0 to fail immediately. Valid time units are {s, m, h}."`
+
+	// Chain is the chain directory lnd uses below data/chain. It is only
+	// used to find the default macaroon if no macaroon path is specified.
+	Chain string `long:"chain" description:"The name of the chain directory of lnd's data directory the default macaroon is located in, for example litecoin or the chain name of a custom lnd layout. Only used if lnd.macaroonpath isn't set."`
+}
+
+// lndMacaroonPath returns the path of lnd's admin macaroon in the default lnd
+// directory for the given chain and network.
+func lndMacaroonPath(chain, network string) string {
+	return filepath.Join(
+		DefaultLndDir, "data", "chain", chain, network,
+		defaultLndMacaroon,
+	)
 }
 
 type Config struct {
-	ShowVersion    bool   `long:"version" description:"Display version information and exit"`
-	Insecure       bool   `long:"insecure" description:"disable tls"`
-	Network        string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet"`
-	AuctionServer  string `long:"auctionserver" description:"auction server address host:port"`
-	Proxy          string `long:"proxy" description:"The host:port of a SOCKS proxy through which all connections to the pool server will be established over"`
-	TLSPathAuctSrv string `long:"tlspathauctserver" description:"Path to auction server tls certificate"`
-	RPCListen      string `long:"rpclisten" description:"Address to listen on for gRPC clients"`
-	RESTListen     string `long:"restlisten" description:"Address to listen on for REST clients"`
-	BaseDir        string `long:"basedir" description:"The base directory where pool stores all its data. If set, this option overwrites --logdir, --macaroonpath, --tlscertpath and --tlskeypath."`
+	ShowVersion    bool     `long:"version" description:"Display version information and exit"`
+	Insecure       bool     `long:"insecure" description:"disable tls"`
+	Network        string   `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet"`
+	AuctionServer  string   `long:"auctionserver" description:"auction server address host:port, can also be set with the POOL_AUCTIONSERVER environment variable"`
+	Proxy          string   `long:"proxy" description:"The host:port of a SOCKS proxy through which all connections to the pool server will be established over, can also be set with the POOL_PROXY environment variable"`
+	ProxyBypass    []string `long:"proxybypass" description:"A host name, IP address or network in CIDR notation of an auction server that should be connected to directly instead of through the SOCKS proxy; can be specified multiple times"`
+	TLSPathAuctSrv string   `long:"tlspathauctserver" description:"Path to auction server tls certificate"`
+	RPCListen      string   `long:"rpclisten" description:"Address to listen on for gRPC clients"`
+	RESTListen     string   `long:"restlisten" description:"Address to listen on for REST clients"`
+	BaseDir        string   `long:"basedir" description:"The base directory where pool stores all its data. If set, this option overwrites --logdir, --macaroonpath, --tlscertpath and --tlskeypath."`
 
 	LogDir         string `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
@@ -133,26 +191,128 @@ type Config struct {
 	MaxBackoff time.Duration `long:"maxbackoff" description:"Longest backoff when reconnecting to the server. Valid time units are {s, m, h}."`
 	DebugLevel string        `long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 
-	TLSCertPath        string   `long:"tlscertpath" description:"Path to write the TLS certificate for pool's RPC and REST services."`
-	TLSKeyPath         string   `long:"tlskeypath" description:"Path to write the TLS private key for pool's RPC and REST services."`
-	TLSExtraIPs        []string `long:"tlsextraip" description:"Adds an extra IP to the generated certificate."`
-	TLSExtraDomains    []string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate."`
-	TLSAutoRefresh     bool     `long:"tlsautorefresh" description:"Re-generate TLS certificate and key if the IPs or domains are changed."`
-	TLSDisableAutofill bool     `long:"tlsdisableautofill" description:"Do not include the interface IPs or the system hostname in TLS certificate, use first --tlsextradomain as Common Name instead, if set."`
+	TLSCertPath        string        `long:"tlscertpath" description:"Path to write the TLS certificate for pool's RPC and REST services."`
+	TLSKeyPath         string        `long:"tlskeypath" description:"Path to write the TLS private key for pool's RPC and REST services."`
+	TLSExtraIPs        []string      `long:"tlsextraip" description:"Adds an extra IP to the generated certificate."`
+	TLSExtraDomains    []string      `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate."`
+	TLSAutoRefresh     bool          `long:"tlsautorefresh" description:"Re-generate TLS certificate and key if the IPs or domains are changed."`
+	TLSDisableAutofill bool          `long:"tlsdisableautofill" description:"Do not include the interface IPs or the system hostname in TLS certificate, use first --tlsextradomain as Common Name instead, if set."`
+	TLSCommonName      string        `long:"tlscommonname" description:"The Common Name of the TLS certificate, also added as a domain. Overwrites the Common Name chosen by the autofill or --tlsdisableautofill. Only applies when a new certificate is generated."`
+	TLSCertRenewBefore time.Duration `long:"tlscertrenewbefore" description:"Re-generate the TLS certificate and key on startup if the certificate expires within this duration, instead of only once it is expired. Valid time units are {s, m, h}."`
 
 	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for pool's RPC and REST services if it doesn't exist."`
+	NoMacaroons  bool   `long:"nomacaroons" description:"Disable macaroon authentication for pool's RPC and REST services. No macaroon is created and all calls are allowed without one. Only use this if access to poold is secured by other means."`
+
+	ServerMaxRecvMsgSize int `long:"servermaxrecvmsgsize" description:"The maximum size in bytes of a message poold's own gRPC server accepts from its clients. Does not affect the connection to the auction server. If set to 0, the default is used."`
+	ServerMaxSendMsgSize int `long:"servermaxsendmsgsize" description:"The maximum size in bytes of a message poold's own gRPC server sends to its clients. Does not affect the connection to the auction server. If set to 0, the default is used."`
+
+	DisabledRPCs []string `long:"disablerpc" description:"The name of an RPC method of poold's own RPC server that is refused with an Unimplemented error, for example WithdrawAccount or /poolrpc.Trader/WithdrawAccount; can be specified multiple times. Also applies to the REST proxy and to calls forwarded by lnd when running as a subserver."`
 
 	NewNodesOnly bool `long:"newnodesonly" description:"Only accept channels from nodes that the connected lnd node doesn't already have open or pending channels with."`
 
-	LsatMaxRoutingFee btcutil.Amount `long:"lsatmaxroutingfee" description:"The maximum amount in satoshis we are willing to pay in routing fees when paying for the one-time LSAT auth token that is required to use the Pool service."`
+	DuplicateChannelPolicy string `long:"duplicatechannelpolicy" description:"The policy for matched orders that would open a channel to a node the connected lnd node already has open or pending channels with. Setting newnodesonly always rejects such orders." choice:"allow" choice:"reject"`
+
+	RejectExternalChans bool `long:"rejectexternalchans" description:"Reject incoming channel requests that are not part of a Pool batch while a batch is being executed."`
+
+	RejectExternalChansWindow time.Duration `long:"rejectexternalchanswindow" description:"The maximum time a batch is considered to be executing after we signed it if the auction server doesn't finalize or cancel it, for the purpose of rejectexternalchans. Valid time units are {s, m, h}."`
+
+	MinChanConfs uint32 `long:"minchanconfs" description:"The minimum number of confirmations a channel opened to us in a batch must reach before lnd considers it open. Does not apply to zero conf channels. If set to 0, lnd's default is used."`
+
+	AuctioneerCompression string `long:"auctioneercompression" description:"The compression to use for all messages exchanged with the auction server. Compressing messages reduces the amount of data transferred over slow connections like Tor at the cost of some CPU time. The auction server must support the chosen compression." choice:"none" choice:"gzip"`
+
+	AuctioneerMetadata map[string]string `long:"auctioneermetadata" description:"A custom gRPC header in the format key:value that is attached to every call to the auction server, for example for gateways that route on headers. Keys may only contain lowercase letters, digits, '-', '_' and '.'. Can be specified multiple times."`
+
+	ClientAlias string `long:"clientalias" description:"An alias this trader daemon identifies itself with to the auction server, for example to tell several nodes of the same operator apart when asking for support. Can be at most 32 characters long and may only contain letters, digits, '-', '_' and '.'."`
+
+	LogAuctioneerPayloads bool `long:"logauctioneerpayloads" description:"Log the payloads of all requests and responses exchanged with the auction server. Signatures and other secrets are redacted. The payloads are logged at the trace level of the AUCT subsystem, which must be enabled with debuglevel."`
+
+	LsatMaxRoutingFee    btcutil.Amount `long:"lsatmaxroutingfee" description:"The maximum amount in satoshis we are willing to pay in routing fees when paying for the one-time LSAT auth token that is required to use the Pool service."`
+	LsatMaxRoutingFeePPM uint64         `long:"lsatmaxroutingfeeppm" description:"The maximum routing fee for paying the LSAT auth token in parts per million of the token's cost. If set, the lower of this and lsatmaxroutingfee is used. Set to 0 to only use lsatmaxroutingfee."`
+	LsatPaymentTimeout   time.Duration  `long:"lsatpaymenttimeout" description:"The maximum time to wait for the payment of the LSAT auth token to complete. If the payment takes longer, the request to the auction server fails and the payment is tracked again on the next request. Cannot exceed 1 minute. Set to 0 to use the maximum of 1 minute. Valid time units are {s, m, h}."`
 
 	Profile  string `long:"profile" description:"Enable HTTP profiling on given ip:port -- NOTE port must be between 1024 and 65535"`
 	FakeAuth bool   `long:"fakeauth" description:"Disable LSAT authentication and instead use a fake LSAT ID to identify. For testing only, cannot be set on mainnet."`
 
+	DefaultLeaseDuration uint32 `long:"defaultleaseduration" description:"The lease duration in blocks that is used for bids that don't specify one. Must be one of the lease duration buckets the auction server currently accepts orders for. If not set, bids always need to specify a lease duration."`
+
+	RequireOrderConfirmation bool `long:"requireorderconfirmation" description:"Reject orders submitted directly through SubmitOrder. Orders must instead be prepared with PrepareOrder and then submitted with ConfirmOrder using the returned short-lived confirmation token."`
+
+	NodeRole string `long:"noderole" description:"The role of this node in the auction. A maker only sells liquidity and can only submit ask orders, a taker only buys liquidity and can only submit bid orders." choice:"maker" choice:"taker" choice:"both"`
+
+	OrderRateTick uint32 `long:"orderratetick" description:"The tick size in parts per billion per block the fixed rate of every order must be a multiple of, if the auction server only accepts rates on such a grid. Set to 0 to submit any rate as is."`
+
+	OrderRateTickPolicy string `long:"orderratetickpolicy" description:"What to do with orders whose fixed rate isn't a multiple of the configured orderratetick. round rounds the rate to the closest valid tick in the trader's favor, down for bids and up for asks, reject refuses to submit the order." choice:"round" choice:"reject"`
+
+	ProtocolMismatch string `long:"protocolmismatch" description:"What to do if the auction server prepares a batch with a newer batch version than this version of poold supports. The batch is rejected either way. fail shuts down poold and asks to upgrade it, warn only logs a warning and keeps running." choice:"fail" choice:"warn"`
+
+	BatchConfirmMinPremium btcutil.Amount `long:"batchconfirmminpremium" description:"Batches in which the asks of this node earn less premium in total than this amount in satoshis are not accepted automatically. They need to be confirmed with the AcceptBatch RPC instead. Set to 0 to not enforce a minimum premium."`
+	BatchConfirmMaxFee     btcutil.Amount `long:"batchconfirmmaxfee" description:"Batches in which this node pays more execution and chain fees in total than this amount in satoshis are not accepted automatically. They need to be confirmed with the AcceptBatch RPC instead. Set to 0 to not enforce a maximum fee."`
+	BatchConfirmTimeout    time.Duration  `long:"batchconfirmtimeout" description:"The time to wait for a batch that needs to be confirmed to be accepted with the AcceptBatch RPC before it is rejected. Must be shorter than the time the auction server waits for traders to accept a batch. Valid time units are {s, m, h}."`
+	BatchPrepareTimeout    time.Duration  `long:"batchpreparetimeout" description:"The maximum time the preparation of a batch, which involves several calls to lnd, is allowed to take before the batch is rejected. The time spent waiting for a batch to be confirmed isn't included. The limit is disabled by default and should be set well below the time the auction server waits for traders to accept a batch. Valid time units are {s, m, h}."`
+
+	OrderReceiptDir string `long:"orderreceiptdir" description:"If set, a JSON receipt with the time, parameters, nonce and account of every order accepted by the auction server is written to this directory for audit purposes."`
+
+	OrderSubmitRetries      int           `long:"ordersubmitretries" description:"The number of times an order submission is retried if the auction server is temporarily unavailable or doesn't answer in time. Orders the auction server refused are never retried. Set to 0 to disable retries."`
+	OrderSubmitRetryBackoff time.Duration `long:"ordersubmitretrybackoff" description:"The time to wait before retrying a failed order submission. Valid time units are {s, m, h}."`
+
+	MaxPendingOrders int `long:"maxpendingorders" description:"The maximum number of orders that can be pending (not yet archived) at the same time. New orders are rejected once the limit is reached until a pending order is canceled, filled or expires. Protects against automated strategies that submit orders in a loop. Set to 0 to allow any number of orders."`
+
 	TxLabelPrefix string `long:"txlabelprefix" description:"If set, then every transaction poold makes will be created with a label that has this string as a prefix."`
 
+	FeeSource     string `long:"feesource" description:"The source of the fee rates for account transactions that are created with a confirmation target. The lnd source uses the fee estimator of the connected lnd node, static always uses --staticfeerate and external-url queries the fee estimation API set with --feeurl." choice:"lnd" choice:"static" choice:"external-url"`
+	StaticFeeRate uint64 `long:"staticfeerate" description:"The fee rate in sat/vByte used for all account transactions if --feesource=static."`
+	FeeURL        string `long:"feeurl" description:"The URL of the fee estimation API queried if --feesource=external-url. The API must return its estimates in the same format as the one lnd's feeurl option points to."`
+
+	MaxFeeRate       uint64 `long:"maxfeerate" description:"The maximum fee rate in sat/vByte poold uses for any transaction, regardless of whether the fee rate was estimated or set explicitly. Also limits the maximum batch fee rate of new orders. Set to 0 to not enforce a maximum."`
+	MaxFeeRatePolicy string `long:"maxfeeratepolicy" description:"What to do with fee rates above --maxfeerate. clamp lowers them to the maximum, reject refuses to create the transaction or order." choice:"clamp" choice:"reject"`
+
+	IgnoreAnchorReserve bool `long:"ignoreanchorreserve" description:"Fund accounts even if doing so drops the lnd wallet balance below the reserve lnd requires to fee bump anchor channels. A warning is logged instead."`
+
+	CoinSelectionStrategy string `long:"coinselectionstrategy" description:"The strategy used to select the lnd wallet outputs that fund account creations and deposits. largest spends the largest outputs first, random spends them in a random order. If not set, lnd selects the outputs using its own configured strategy." choice:"largest" choice:"random"`
+
+	AccountOutputType string `long:"accountoutputtype" description:"The output type of new accounts and of accounts that are modified without requesting a specific version. p2tr creates Taproot accounts and requires the auction server to support them, p2wsh creates legacy SegWit v0 accounts. Existing Taproot accounts are never downgraded. Ignored if --accountversion is set." choice:"p2wsh" choice:"p2tr"`
+	AccountVersion    int32  `long:"accountversion" description:"The version of new accounts and of accounts that are modified without requesting a specific version, overriding --accountoutputtype. 0 creates legacy SegWit v0 accounts, 1 creates Taproot accounts. Like with --accountoutputtype, poold fails to start if the version is newer than the latest account version supported by both poold and the auction server."`
+
+	AutoRenewAccounts   bool   `long:"autorenewaccounts" description:"Automatically renew open accounts once they get within --autorenewthreshold blocks of their expiry."`
+	AutoRenewThreshold  uint32 `long:"autorenewthreshold" description:"The number of blocks before its expiry an open account is renewed if --autorenewaccounts is set."`
+	AutoRenewExpiry     uint32 `long:"autorenewexpiry" description:"The number of blocks from the current height an account is renewed for if --autorenewaccounts is set."`
+	AutoRenewConfTarget uint32 `long:"autorenewconftarget" description:"The confirmation target used to estimate the fee rate of an automatic account renewal."`
+	AutoRenewMaxFeeRate uint64 `long:"autorenewmaxfeerate" description:"The maximum fee rate in sat/vByte an automatic account renewal is allowed to pay. If the estimated fee rate is higher, the renewal is retried on the next block."`
+
+	ExpiryWarningThreshold uint32 `long:"expirywarningthreshold" description:"The number of blocks before its expiry to start warning about an open account if --autorenewaccounts is not set. The warning is repeated with escalating severity each time the number of blocks left is halved. Set to 0 to disable the warnings."`
+
+	ShutdownDrainTimeout time.Duration `long:"shutdowndraintimeout" description:"The maximum time to wait for in-flight RPCs to complete on shutdown. New RPCs are rejected while draining. If the timeout is exceeded, the remaining RPCs are canceled. Set to 0 to wait for in-flight RPCs without a timeout. Valid time units are {s, m, h}."`
+
+	CancelOrdersOnShutdown      bool          `long:"cancelordersonshutdown" description:"Cancel all active orders with the auction server on a graceful shutdown so the node isn't matched while it is offline. Orders are not resubmitted automatically on the next start."`
+	CancelOrdersShutdownTimeout time.Duration `long:"cancelordersshutdowntimeout" description:"The maximum time to spend canceling active orders on shutdown if cancelordersonshutdown is set. Orders that couldn't be canceled within this time remain active. Valid time units are {s, m, h}."`
+
+	ChainSyncTimeout time.Duration `long:"chainsynctimeout" description:"The maximum time to wait on startup for lnd to be synced to the chain before giving up. The RPC server isn't started before lnd is synced. Set to 0 to wait indefinitely. Valid time units are {s, m, h}."`
+
+	SignerSelfTest bool `long:"signerselftest" description:"Verify on startup that lnd can sign with pool's account keys by signing and verifying a test message. Startup fails with a descriptive error if lnd's signer is unavailable, for example because the lnd macaroon lacks the signer permissions."`
+
+	BatchSignTimeout time.Duration `long:"batchsigntimeout" description:"The maximum time to wait for lnd to sign our inputs of a batch. If signing takes longer, the batch is rejected instead of risking a removal by the auctioneer. Set to 0 to disable. Valid time units are {s, m, h}."`
+
+	AllowedPeers []string `long:"allowedpeer" description:"The hex encoded identity public key of a node channels may be opened with in a batch; can be specified multiple times. If set, matches of our orders with nodes that aren't on the list are rejected. This applies in addition to the allowed and not allowed node IDs of each order."`
+
+	MaxBatchAccountUtilization float64 `long:"maxbatchaccountutilization" description:"The maximum fraction of an account's balance a single batch is allowed to consume, for example 0.8 for 80%. If a batch would reduce the balance of one of our accounts by more than that, the matches of that account's orders are rejected. Set to 0 to not limit the utilization."`
+
+	MaxConcurrentBatches int `long:"maxconcurrentbatches" description:"The maximum number of batch execution steps, like setting up the channels or signing, that are processed at the same time. This covers batches of our own orders as well as sidecar channels we receive. Further steps are queued until a running one completes. Set to 0 for no limit."`
+
+	BatchHistoryRetention time.Duration `long:"batchhistoryretention" description:"The duration for which the snapshots of batches we participated in are kept. Older snapshots are removed periodically and no longer show up in the list of leases and the lease reports, unless one of their leases is still pending or active. Set to 0 to keep all snapshots. Valid time units are {s, m, h}."`
+
+	MarketDataCacheTTL time.Duration `long:"marketdatacachettl" description:"How long the market data of the auction server, like its fees and lease durations, is cached for RPCs that only display or quote it, such as QuoteOrder and GetServerParams. Submitting orders always uses fresh data. Set to 0 to disable the cache. Valid time units are {s, m, h}."`
+
+	DBBackend string           `long:"dbbackend" description:"The database backend to store all orders, accounts and other pool data in. The etcd and postgres backends require poold to be built with the kvdb_etcd or kvdb_postgres build tag respectively." choice:"bolt" choice:"etcd" choice:"postgres"`
+	Etcd      *etcd.Config     `group:"etcd" namespace:"etcd"`
+	Postgres  *postgres.Config `group:"postgres" namespace:"postgres"`
+
+	DBAutoCompact       bool          `long:"dbautocompact" description:"Compact the bolt database file on startup if it wasn't compacted within the time set by --dbautocompactminage. Requires additional disk space for the compacted copy during startup. Only supported by the bolt backend."`
+	DBAutoCompactMinAge time.Duration `long:"dbautocompactminage" description:"How long ago the last compaction of the bolt database file must be for it to be compacted again. Set to 0 to compact on every startup. Valid time units are {s, m, h}."`
+
 	Lnd *LndConfig `group:"lnd" namespace:"lnd"`
 
+	Tracing *TracingConfig `group:"tracing" namespace:"tracing"`
+
 	// RPCListener is a network listener that can be set if poold should be
 	// used as a library and listen on the given listener instead of what is
 	// configured in the --rpclisten parameter. Setting this will also
@@ -163,6 +323,37 @@ type Config struct {
 	// dialing the auctioneer server.
 	AuctioneerDialOpts []grpc.DialOption
 
+	// AuctioneerClient is an optional client of the auction server's RPC
+	// that is used instead of dialing the configured auction server. This
+	// lets tests and library users run the trader daemon against a mock
+	// auctioneer, for example on simnet or regtest where no auction server
+	// is available.
+	AuctioneerClient auctioneerrpc.ChannelAuctioneerClient
+
+	// HashMailClient is an optional client of the auction server's mailbox
+	// RPC that is used together with AuctioneerClient for negotiating
+	// sidecar channels.
+	HashMailClient auctioneerrpc.HashMailClient
+
+	// UnaryInterceptors is a list of additional unary interceptors that
+	// are appended to the interceptor chain of poold's own RPC server.
+	// They are invoked in the given order after the built-in error
+	// logging, lnd connection and macaroon interceptors, so they only see
+	// calls that passed the macaroon check.
+	UnaryInterceptors []grpc.UnaryServerInterceptor
+
+	// StreamInterceptors is a list of additional stream interceptors that
+	// are appended to the interceptor chain of poold's own RPC server in
+	// the same way as UnaryInterceptors.
+	StreamInterceptors []grpc.StreamServerInterceptor
+
+	// ConnectionStateCallback is an optional function that is called each
+	// time the state of the connection to the auction server changes, for
+	// example to update the UI of an application that uses poold as a
+	// library. It must return quickly as it blocks the connection
+	// handling.
+	ConnectionStateCallback func(state auctioneer.ConnState)
+
 	// DebugConfig is a set of debug options used for development and
 	// testing only.
 	DebugConfig *DebugConfig `group:"debug" namespace:"debug" hidden:"true"`
@@ -192,11 +383,108 @@ const (
 	defaultRPCTimeout  = 30 * time.Second
 	defaultLsatMaxCost = btcutil.Amount(1000)
 	defaultLsatMaxFee  = btcutil.Amount(50)
+
+	// defaultAutoRenewThreshold is the default number of blocks before its
+	// expiry an account is renewed automatically.
+	defaultAutoRenewThreshold = 7 * 144
+
+	// defaultAutoRenewExpiry is the default number of blocks an account is
+	// automatically renewed for, which matches the default expiry of new
+	// accounts created through the CLI.
+	defaultAutoRenewExpiry = 30 * 144
+
+	// defaultAutoRenewConfTarget is the default confirmation target of an
+	// automatic account renewal.
+	defaultAutoRenewConfTarget = 6
+
+	// defaultAutoRenewMaxFeeRate is the default maximum fee rate in
+	// sat/vByte of an automatic account renewal.
+	defaultAutoRenewMaxFeeRate = 50
+
+	// defaultExpiryWarningThreshold is the default number of blocks before
+	// its expiry we start to warn about an account that isn't renewed
+	// automatically.
+	defaultExpiryWarningThreshold = 3 * 144
+
+	// defaultShutdownDrainTimeout is the default maximum time we wait for
+	// in-flight RPCs to complete on shutdown.
+	defaultShutdownDrainTimeout = 10 * time.Second
+
+	// defaultCancelOrdersShutdownTimeout is the default maximum time we
+	// spend canceling active orders on shutdown.
+	defaultCancelOrdersShutdownTimeout = 10 * time.Second
+
+	// defaultOrderSubmitRetryBackoff is the default time we wait before
+	// retrying a failed order submission.
+	defaultOrderSubmitRetryBackoff = time.Second
+
+	// defaultMarketDataCacheTTL is the default time for which the market
+	// data of the auction server is cached.
+	defaultMarketDataCacheTTL = time.Minute
+
+	// defaultServerMaxRecvMsgSize is the default maximum size of a message
+	// poold's gRPC server accepts, which is the same as gRPC's default.
+	defaultServerMaxRecvMsgSize = 4 * 1024 * 1024
+
+	// defaultServerMaxSendMsgSize is the default maximum size of a message
+	// poold's gRPC server sends. This matches the maximum receive size of
+	// the pool CLI so large responses like the order list aren't capped.
+	defaultServerMaxSendMsgSize = 200 * 1024 * 1024
+
+	// minServerMsgSize is the smallest message size limit that can be
+	// configured for poold's gRPC server. Anything below would break even
+	// the most basic calls.
+	minServerMsgSize = 64 * 1024
 )
 
-// DefaultConfig returns the default value for the Config struct.
+// envOptions maps the environment variables that can be used instead of some
+// of the config options to the options they set.
+var envOptions = []struct {
+	name   string
+	option func(*Config) *string
+}{{
+	name: EnvAuctionServer,
+	option: func(cfg *Config) *string {
+		return &cfg.AuctionServer
+	},
+}, {
+	name: EnvProxy,
+	option: func(cfg *Config) *string {
+		return &cfg.Proxy
+	},
+}, {
+	name: EnvLndHost,
+	option: func(cfg *Config) *string {
+		if cfg.Lnd == nil {
+			return nil
+		}
+		return &cfg.Lnd.Host
+	},
+}}
+
+// applyEnvOptions sets the config options from their environment variables.
+// If overwrite is false, only options that are empty are set.
+func applyEnvOptions(cfg *Config, overwrite bool) {
+	for _, env := range envOptions {
+		value, ok := os.LookupEnv(env.name)
+		if !ok || value == "" {
+			continue
+		}
+
+		option := env.option(cfg)
+		if option == nil || (*option != "" && !overwrite) {
+			continue
+		}
+
+		*option = value
+	}
+}
+
+// DefaultConfig returns the default value for the Config struct. Options that
+// can be set with an environment variable default to the variable's value, so
+// flags and the config file still take precedence.
 func DefaultConfig() Config {
-	return Config{
+	cfg := Config{
 		Network:           DefaultNetwork,
 		RPCListen:         "localhost:12010",
 		RESTListen:        "localhost:8281",
@@ -212,29 +500,257 @@ func DefaultConfig() Config {
 		TLSKeyPath:        DefaultTLSKeyPath,
 		MacaroonPath:      DefaultMacaroonPath,
 		LsatMaxRoutingFee: defaultLsatMaxFee,
+		DBBackend:         clientdb.BackendBolt,
+		FeeSource:         FeeSourceLnd,
+		MaxFeeRatePolicy:  MaxFeeRatePolicyClamp,
+		NodeRole:          NodeRoleBoth,
+		AccountOutputType: AccountOutputTypeP2TR,
+		AccountVersion:    -1,
+		Etcd:              &etcd.Config{},
+		Postgres:          &postgres.Config{},
 		Lnd: &LndConfig{
 			Host:         "localhost:10009",
 			MacaroonPath: DefaultLndMacaroonPath,
+			Chain:        DefaultLndChain,
 		},
+		Tracing:                     &TracingConfig{},
+		DBAutoCompactMinAge:         kvdb.DefaultBoltAutoCompactMinAge,
+		ServerMaxRecvMsgSize:        defaultServerMaxRecvMsgSize,
+		ServerMaxSendMsgSize:        defaultServerMaxSendMsgSize,
+		ShutdownDrainTimeout:        defaultShutdownDrainTimeout,
+		CancelOrdersShutdownTimeout: defaultCancelOrdersShutdownTimeout,
+		OrderSubmitRetryBackoff:     defaultOrderSubmitRetryBackoff,
+		MarketDataCacheTTL:          defaultMarketDataCacheTTL,
+		OrderRateTickPolicy:         OrderRateTickPolicyRound,
+		ProtocolMismatch:            ProtocolMismatchFail,
+		BatchConfirmTimeout:         defaultBatchConfirmTimeout,
+		AutoRenewThreshold:          defaultAutoRenewThreshold,
+		AutoRenewExpiry:             defaultAutoRenewExpiry,
+		AutoRenewConfTarget:         defaultAutoRenewConfTarget,
+		AutoRenewMaxFeeRate:         defaultAutoRenewMaxFeeRate,
+		LsatPaymentTimeout:          lsat.PaymentTimeout,
+		RejectExternalChansWindow:   defaultBatchExecutionWindow,
 		DebugConfig: &DebugConfig{
 			// The default value is dynamic depending on the lnd
 			// version. So we set an invalid value here to signal
 			// "no value set".
 			BatchVersion: -1,
 		},
-		RequestShutdown: func() {},
+		ExpiryWarningThreshold: defaultExpiryWarningThreshold,
+		AuctioneerCompression:  auctioneer.CompressionNone,
+		DuplicateChannelPolicy: funding.DuplicateChannelPolicyAllow,
+		RequestShutdown:        func() {},
+	}
+	applyEnvOptions(&cfg, true)
+
+	return cfg
+}
+
+// networkProfiles are the names of the config file sections that only apply
+// to a single network.
+var networkProfiles = map[string]struct{}{
+	"mainnet": {},
+	"testnet": {},
+	"regtest": {},
+	"simnet":  {},
+}
+
+// LoadConfigFile parses the config file at the given path into the config. A
+// file that doesn't exist is ignored. Options outside of any section or in a
+// section that isn't named after a network apply to all networks. Options in a
+// section named after a network, like [testnet], form the profile of that
+// network and are only applied if it is selected, on top of the general ones.
+// The profile is selected by the given network or, if it is empty, by the
+// network the config is set to after the general options were parsed.
+func LoadConfigFile(cfg *Config, configFile, network string) error {
+	content, err := os.ReadFile(configFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// We split the file into the general options and one set of options
+	// per network profile. Lines that don't belong to a set are left empty
+	// so the line numbers in parsing errors still match the file.
+	lines := strings.Split(string(content), "\n")
+	generalLines := make([]string, len(lines))
+	profileLines := make(map[string][]string)
+
+	var profile string
+	for idx, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") &&
+			strings.HasSuffix(trimmed, "]") {
+
+			section := strings.ToLower(strings.TrimSpace(
+				trimmed[1 : len(trimmed)-1],
+			))
+
+			profile = ""
+			if _, ok := networkProfiles[section]; ok {
+				profile = section
+				continue
+			}
+		}
+
+		if profile == "" {
+			generalLines[idx] = line
+			continue
+		}
+
+		if _, ok := profileLines[profile]; !ok {
+			profileLines[profile] = make([]string, len(lines))
+		}
+		profileLines[profile][idx] = line
+	}
+
+	parser := flags.NewParser(cfg, flags.None)
+	parse := func(lines []string) error {
+		err := flags.NewIniParser(parser).Parse(
+			strings.NewReader(strings.Join(lines, "\n")),
+		)
+
+		var iniErr *flags.IniError
+		if errors.As(err, &iniErr) {
+			iniErr.File = configFile
+		}
+
+		return err
+	}
+
+	if err := parse(generalLines); err != nil {
+		return err
+	}
+
+	if network == "" {
+		network = cfg.Network
+	}
+	if lines, ok := profileLines[network]; ok {
+		return parse(lines)
+	}
+
+	return nil
+}
+
+// Merge copies all fields of the overlay that are set onto the config. This
+// allows layering configs from different sources on top of each other. A field
+// is considered set if it is
+//   - a non-empty string,
+//   - a non-zero number or duration,
+//   - a true bool (so a bool can't be reset to false by an overlay),
+//   - a slice or map with at least one element, replacing the existing one,
+//   - a non-nil pointer to a struct, which is merged field by field the same
+//     way (this covers the nested lnd, etcd, postgres and debug configs),
+//   - any other non-nil pointer, interface or function, or
+//   - any other struct that has at least one non-zero field.
+func (c *Config) Merge(overlay Config) {
+	mergeStruct(reflect.ValueOf(c).Elem(), reflect.ValueOf(overlay))
+}
+
+// mergeStruct copies all fields of the src struct that are set onto the dst
+// struct as described in Config.Merge.
+func mergeStruct(dst, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		srcField, dstField := src.Field(i), dst.Field(i)
+		if !dstField.CanSet() || isUnsetOption(srcField) {
+			continue
+		}
+
+		// Nested configs are merged instead of replaced. The overlay's
+		// struct is copied if there is nothing to merge it into, so the
+		// config never shares it with the overlay.
+		if srcField.Kind() == reflect.Ptr &&
+			srcField.Elem().Kind() == reflect.Struct {
+
+			if dstField.IsNil() {
+				elemType := srcField.Type().Elem()
+				dstField.Set(reflect.New(elemType))
+			}
+			mergeStruct(dstField.Elem(), srcField.Elem())
+
+			continue
+		}
+
+		dstField.Set(srcField)
+	}
+}
+
+// isUnsetOption returns true if the given field of an overlay config is not
+// set and therefore shouldn't overwrite the value of the merged config.
+func isUnsetOption(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Slice, reflect.Map:
+		return field.Len() == 0
+
+	default:
+		return field.IsZero()
 	}
 }
 
 // Validate cleans up paths in the config provided and validates it.
 func Validate(cfg *Config) error {
+	// Configs that weren't created from the default config can still use
+	// the environment variables for options that aren't set.
+	applyEnvOptions(cfg, false)
+
 	// Cleanup any paths before we use them.
 	cfg.BaseDir = lncfg.CleanAndExpandPath(cfg.BaseDir)
 	cfg.LogDir = lncfg.CleanAndExpandPath(cfg.LogDir)
+	cfg.OrderReceiptDir = lncfg.CleanAndExpandPath(cfg.OrderReceiptDir)
 	cfg.TLSCertPath = lncfg.CleanAndExpandPath(cfg.TLSCertPath)
 	cfg.TLSKeyPath = lncfg.CleanAndExpandPath(cfg.TLSKeyPath)
 	cfg.MacaroonPath = lncfg.CleanAndExpandPath(cfg.MacaroonPath)
 
+	// Without macaroons there is no file to write, so a custom path is
+	// most likely a mistake.
+	if cfg.NoMacaroons && cfg.MacaroonPath != DefaultMacaroonPath {
+		return fmt.Errorf("nomacaroons cannot be combined with a " +
+			"custom macaroonpath")
+	}
+
+	// A bypass list only makes sense if there is a proxy to bypass.
+	if len(cfg.ProxyBypass) > 0 {
+		if cfg.Proxy == "" {
+			return fmt.Errorf("proxybypass requires a proxy to be " +
+				"set")
+		}
+
+		err := auctioneer.ValidateProxyBypass(cfg.ProxyBypass)
+		if err != nil {
+			return fmt.Errorf("invalid proxybypass: %v", err)
+		}
+	}
+
+	if cfg.Tracing != nil && cfg.Tracing.Enable &&
+		cfg.Tracing.OTLPEndpoint == "" {
+
+		return fmt.Errorf("tracing.otlpendpoint must be set if tracing " +
+			"is enabled")
+	}
+
+	err := auctioneer.ValidateCompression(cfg.AuctioneerCompression)
+	if err != nil {
+		return fmt.Errorf("invalid auctioneercompression: %v", err)
+	}
+
+	err = funding.ValidateDuplicateChannelPolicy(cfg.DuplicateChannelPolicy)
+	if err != nil {
+		return fmt.Errorf("invalid duplicatechannelpolicy: %v", err)
+	}
+
+	if len(cfg.AuctioneerMetadata) > 0 {
+		err := auctioneer.ValidateMetadata(cfg.AuctioneerMetadata)
+		if err != nil {
+			return fmt.Errorf("invalid auctioneermetadata: %v", err)
+		}
+	}
+
+	if err := auctioneer.ValidateClientAlias(cfg.ClientAlias); err != nil {
+		return fmt.Errorf("invalid clientalias: %v", err)
+	}
+
 	// Since our pool directory overrides our log and TLS dir values, make
 	// sure that they are not set when base dir is set. We hard here rather
 	// than overwriting and potentially confusing the user.
@@ -311,6 +827,25 @@ func Validate(cfg *Config) error {
 
 		return fmt.Errorf("use --lnd.macaroonpath only")
 
+	case len(cfg.Lnd.MacaroonPaths) > 0 &&
+		(cfg.Lnd.MacaroonPath != DefaultLndMacaroonPath ||
+			cfg.Lnd.MacaroonDir != ""):
+
+		return fmt.Errorf("use either --lnd.macaroonpaths or " +
+			"--lnd.macaroonpath")
+
+	case len(cfg.Lnd.MacaroonPaths) > 0:
+		macPaths := make([]string, len(cfg.Lnd.MacaroonPaths))
+		for idx, macPath := range cfg.Lnd.MacaroonPaths {
+			macPaths[idx] = lncfg.CleanAndExpandPath(macPath)
+		}
+
+		macPath, err := selectLndMacaroon(macPaths)
+		if err != nil {
+			return fmt.Errorf("invalid --lnd.macaroonpaths: %v", err)
+		}
+		cfg.Lnd.MacaroonPath = macPath
+
 	case cfg.Lnd.MacaroonDir != "":
 		// With the new version of lndclient we can only specify a
 		// single macaroon instead of all of them. If the old
@@ -330,17 +865,213 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("must specify --lnd.macaroonpath")
 	}
 
-	// Adjust the default lnd macaroon path if only the network is
-	// specified.
-	if cfg.Network != DefaultNetwork &&
+	// Adjust the default lnd macaroon path if only the network or chain
+	// is specified.
+	if cfg.Lnd.Chain == "" {
+		cfg.Lnd.Chain = DefaultLndChain
+	}
+	if (cfg.Network != DefaultNetwork || cfg.Lnd.Chain != DefaultLndChain) &&
 		cfg.Lnd.MacaroonPath == DefaultLndMacaroonPath {
 
-		cfg.Lnd.MacaroonPath = path.Join(
-			DefaultLndDir, "data", "chain", "bitcoin", cfg.Network,
-			defaultLndMacaroon,
+		cfg.Lnd.MacaroonPath = lndMacaroonPath(
+			cfg.Lnd.Chain, cfg.Network,
 		)
 	}
 
+	// A broken lnd TLS certificate would only lead to a confusing error
+	// once we try to connect, so we check it right away.
+	if cfg.Lnd.TLSPath != "" {
+		err := validateLndTLSCert(
+			lncfg.CleanAndExpandPath(cfg.Lnd.TLSPath),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.TLSCommonName != "" && !isValidHostname(cfg.TLSCommonName) {
+		return fmt.Errorf("tlscommonname %s is not a valid host name",
+			cfg.TLSCommonName)
+	}
+
+	if cfg.Lnd.MacaroonWaitTimeout < 0 {
+		return fmt.Errorf("lnd.macaroonwaittimeout cannot be negative")
+	}
+
+	if cfg.BatchPrepareTimeout < 0 {
+		return fmt.Errorf("batchpreparetimeout cannot be negative")
+	}
+
+	if cfg.TLSCertRenewBefore < 0 {
+		return fmt.Errorf("tlscertrenewbefore cannot be negative")
+	}
+
+	if cfg.ChainSyncTimeout < 0 {
+		return fmt.Errorf("chainsynctimeout cannot be negative")
+	}
+
+	// The LSAT interceptor stops waiting for the payment after its own
+	// fixed timeout, so we can only ever shorten it.
+	if cfg.LsatPaymentTimeout < 0 ||
+		cfg.LsatPaymentTimeout > lsat.PaymentTimeout {
+
+		return fmt.Errorf("lsatpaymenttimeout cannot be negative or "+
+			"exceed %v", lsat.PaymentTimeout)
+	}
+
+	if cfg.LsatMaxRoutingFeePPM > lsatMaxRoutingFeePPM {
+		return fmt.Errorf("lsatmaxroutingfeeppm cannot exceed %d",
+			lsatMaxRoutingFeePPM)
+	}
+
+	if cfg.BatchSignTimeout < 0 {
+		return fmt.Errorf("batchsigntimeout cannot be negative")
+	}
+
+	if cfg.RejectExternalChansWindow < 0 {
+		return fmt.Errorf("rejectexternalchanswindow cannot be " +
+			"negative")
+	}
+
+	if cfg.BatchHistoryRetention < 0 {
+		return fmt.Errorf("batchhistoryretention cannot be negative")
+	}
+
+	if _, err := cfg.AllowedPeerKeys(); err != nil {
+		return err
+	}
+
+	if _, err := cfg.DisabledRPCMethods(); err != nil {
+		return err
+	}
+
+	if cfg.OrderSubmitRetries < 0 {
+		return fmt.Errorf("ordersubmitretries cannot be negative")
+	}
+	if cfg.OrderSubmitRetryBackoff < 0 {
+		return fmt.Errorf("ordersubmitretrybackoff cannot be negative")
+	}
+
+	if cfg.MaxBatchAccountUtilization < 0 ||
+		cfg.MaxBatchAccountUtilization > 1 {
+
+		return fmt.Errorf("maxbatchaccountutilization must be between " +
+			"0 and 1")
+	}
+
+	if cfg.MarketDataCacheTTL < 0 {
+		return fmt.Errorf("marketdatacachettl cannot be negative")
+	}
+
+	if cfg.ShutdownDrainTimeout < 0 {
+		return fmt.Errorf("shutdowndraintimeout cannot be negative")
+	}
+
+	if cfg.CancelOrdersOnShutdown && cfg.CancelOrdersShutdownTimeout <= 0 {
+		return fmt.Errorf("cancelordersshutdowntimeout must be " +
+			"positive if cancelordersonshutdown is set")
+	}
+
+	if cfg.MaxPendingOrders < 0 {
+		return fmt.Errorf("maxpendingorders cannot be negative")
+	}
+
+	if cfg.BatchConfirmMinPremium < 0 || cfg.BatchConfirmMaxFee < 0 {
+		return fmt.Errorf("batchconfirmminpremium and " +
+			"batchconfirmmaxfee cannot be negative")
+	}
+	if cfg.BatchConfirmTimeout <= 0 && (cfg.BatchConfirmMinPremium > 0 ||
+		cfg.BatchConfirmMaxFee > 0) {
+
+		return fmt.Errorf("batchconfirmtimeout must be positive")
+	}
+
+	switch cfg.FeeSource {
+	case FeeSourceStatic:
+		if cfg.StaticFeeRate == 0 {
+			return fmt.Errorf("staticfeerate must be set if " +
+				"feesource is static")
+		}
+
+	case FeeSourceExternalURL:
+		if cfg.FeeURL == "" {
+			return fmt.Errorf("feeurl must be set if feesource " +
+				"is external-url")
+		}
+	}
+
+	// A static fee rate above the maximum would make every estimation
+	// fail.
+	if cfg.MaxFeeRate > 0 && cfg.FeeSource == FeeSourceStatic &&
+		cfg.MaxFeeRatePolicy == MaxFeeRatePolicyReject &&
+		cfg.StaticFeeRate > cfg.MaxFeeRate {
+
+		return fmt.Errorf("staticfeerate cannot exceed maxfeerate if "+
+			"maxfeeratepolicy is %v", MaxFeeRatePolicyReject)
+	}
+
+	err = account.ValidateCoinSelectionStrategy(cfg.CoinSelectionStrategy)
+	if err != nil {
+		return err
+	}
+
+	if _, err := accountVersionForOutputType(cfg.AccountOutputType); err != nil {
+		return err
+	}
+	if cfg.AccountVersion >= 0 {
+		version := account.Version(cfg.AccountVersion)
+		if cfg.AccountVersion > int32(^account.Version(0)) ||
+			account.ValidateVersion(version) != nil {
+
+			return fmt.Errorf("unknown account version %d",
+				cfg.AccountVersion)
+		}
+	}
+
+	if cfg.MaxConcurrentBatches < 0 {
+		return fmt.Errorf("maxconcurrentbatches cannot be negative")
+	}
+
+	// An account renewed for fewer blocks than the threshold would be
+	// renewed again on the very next block.
+	if cfg.AutoRenewAccounts {
+		if cfg.AutoRenewThreshold == 0 {
+			return fmt.Errorf("autorenewthreshold must be set")
+		}
+		if cfg.AutoRenewExpiry <= cfg.AutoRenewThreshold {
+			return fmt.Errorf("autorenewexpiry must be greater " +
+				"than autorenewthreshold")
+		}
+		if cfg.AutoRenewConfTarget == 0 {
+			return fmt.Errorf("autorenewconftarget must be set")
+		}
+		if cfg.AutoRenewMaxFeeRate == 0 {
+			return fmt.Errorf("autorenewmaxfeerate must be set")
+		}
+	}
+
+	// A message size of 0 means the default should be used.
+	if cfg.ServerMaxRecvMsgSize == 0 {
+		cfg.ServerMaxRecvMsgSize = defaultServerMaxRecvMsgSize
+	}
+	if cfg.ServerMaxSendMsgSize == 0 {
+		cfg.ServerMaxSendMsgSize = defaultServerMaxSendMsgSize
+	}
+	if cfg.ServerMaxRecvMsgSize < minServerMsgSize {
+		return fmt.Errorf("servermaxrecvmsgsize must be at least %d "+
+			"bytes", minServerMsgSize)
+	}
+	if cfg.ServerMaxSendMsgSize < minServerMsgSize {
+		return fmt.Errorf("servermaxsendmsgsize must be at least %d "+
+			"bytes", minServerMsgSize)
+	}
+
+	// Make sure the selected database backend has all the connection
+	// parameters it needs.
+	if err := cfg.DatabaseConfig().Validate(); err != nil {
+		return fmt.Errorf("invalid database config: %v", err)
+	}
+
 	// Enable http profiling and Validate profile port number if requested.
 	if cfg.Profile != "" {
 		portErr := fmt.Errorf("the profile port must be between 1024 " +
@@ -373,11 +1104,172 @@ func Validate(cfg *Config) error {
 	return nil
 }
 
+// validateLndDirOverlap makes sure the directories of the lnd TLS certificate
+// and macaroon pool connects with don't overlap with pool's own base directory
+// or the directories of pool's TLS and macaroon files. Such an overlap is most
+// likely unintentional and can lead to pool overwriting lnd's files or the
+// wrong files being picked up. Because existing setups might already use such
+// a layout, the returned error is only logged as a warning once the loggers
+// are set up on startup.
+func validateLndDirOverlap(cfg *Config) error {
+	type namedPath struct {
+		name string
+		path string
+	}
+
+	lndFiles := []namedPath{{
+		name: "lnd.macaroonpath",
+		path: cfg.Lnd.MacaroonPath,
+	}}
+	if cfg.Lnd.TLSPath != "" {
+		lndFiles = append(lndFiles, namedPath{
+			name: "lnd.tlspath",
+			path: lncfg.CleanAndExpandPath(cfg.Lnd.TLSPath),
+		})
+	}
+
+	poolFiles := []namedPath{{
+		name: "tlscertpath",
+		path: cfg.TLSCertPath,
+	}, {
+		name: "tlskeypath",
+		path: cfg.TLSKeyPath,
+	}, {
+		name: "macaroonpath",
+		path: cfg.MacaroonPath,
+	}}
+
+	for _, lndFile := range lndFiles {
+		lndDir := filepath.Dir(lndFile.path)
+		if lndDir == cfg.BaseDir || isSubDir(cfg.BaseDir, lndDir) {
+			return fmt.Errorf("%s %s is located inside the pool "+
+				"base directory %s, please use a separate "+
+				"directory for pool", lndFile.name,
+				lndFile.path, cfg.BaseDir)
+		}
+
+		for _, poolFile := range poolFiles {
+			if filepath.Dir(poolFile.path) != lndDir {
+				continue
+			}
+
+			return fmt.Errorf("%s %s is located in the same "+
+				"directory as %s %s, please use a separate "+
+				"directory for pool", poolFile.name,
+				poolFile.path, lndFile.name, lndFile.path)
+		}
+	}
+
+	return nil
+}
+
+// validateLndTLSCert makes sure the file at the given path contains a PEM
+// encoded x509 certificate.
+func validateLndTLSCert(certPath string) error {
+	certBytes, err := os.ReadFile(certPath)
+	if err != nil {
+		return fmt.Errorf("unable to read lnd.tlspath %s: %v", certPath,
+			err)
+	}
+
+	block, _ := pem.Decode(certBytes)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("lnd.tlspath %s does not contain a PEM "+
+			"encoded certificate", certPath)
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return fmt.Errorf("unable to parse lnd.tlspath %s: %v",
+			certPath, err)
+	}
+
+	return nil
+}
+
+// isSubDir returns true if the given directory is located somewhere below the
+// given parent directory.
+func isSubDir(parent, dir string) bool {
+	rel, err := filepath.Rel(parent, dir)
+	if err != nil {
+		return false
+	}
+
+	return rel != "." && rel != ".." &&
+		!strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// AllowedPeerKeys parses the identity keys of the allowed batch peers.
+func (c *Config) AllowedPeerKeys() ([][33]byte, error) {
+	keys := make([][33]byte, 0, len(c.AllowedPeers))
+	for _, peer := range c.AllowedPeers {
+		keyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowedpeer %v: %v",
+				peer, err)
+		}
+		if _, err := btcec.ParsePubKey(keyBytes); err != nil {
+			return nil, fmt.Errorf("invalid allowedpeer %v: %v",
+				peer, err)
+		}
+
+		var key [33]byte
+		copy(key[:], keyBytes)
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// DisabledRPCMethods returns the set of full method names of all RPCs that
+// are disabled. Methods can be configured with their full name or with their
+// name within the Trader service only.
+func (c *Config) DisabledRPCMethods() (map[string]struct{}, error) {
+	methods := make(map[string]struct{}, len(c.DisabledRPCs))
+	for _, name := range c.DisabledRPCs {
+		method := name
+		if !strings.HasPrefix(method, "/") {
+			method = traderServicePrefix + method
+		}
+
+		if _, ok := perms.RequiredPermissions[method]; !ok {
+			return nil, fmt.Errorf("invalid disablerpc %v: unknown "+
+				"RPC method", name)
+		}
+
+		methods[method] = struct{}{}
+	}
+
+	return methods, nil
+}
+
+// DatabaseConfig returns the configuration for opening the client database
+// with the backend selected by the user. The bolt database file is always
+// located in the network specific base directory. If no backend is selected, we
+// fall back to bolt to stay compatible with configs created before the option
+// existed.
+func (c *Config) DatabaseConfig() *clientdb.BackendConfig {
+	backend := c.DBBackend
+	if backend == "" {
+		backend = clientdb.BackendBolt
+	}
+
+	return &clientdb.BackendConfig{
+		Backend:           backend,
+		Dir:               c.BaseDir,
+		FileName:          clientdb.DBFilename,
+		AutoCompact:       c.DBAutoCompact,
+		AutoCompactMinAge: c.DBAutoCompactMinAge,
+		Etcd:              c.Etcd,
+		Postgres:          c.Postgres,
+	}
+}
+
 // getTLSConfig generates a new self signed certificate or refreshes an existing
 // one if necessary, then returns the full TLS configuration for initializing
-// a secure server interface.
-func getTLSConfig(cfg *Config) (*tls.Config, *credentials.TransportCredentials,
-	error) {
+// a secure server interface. The given clock is used to decide whether the
+// existing certificate is expired.
+func getTLSConfig(cfg *Config, clk clock.Clock) (*tls.Config,
+	*credentials.TransportCredentials, error) {
 
 	// Let's load our certificate first or create then load if it doesn't
 	// yet exist.
@@ -386,11 +1278,12 @@ func getTLSConfig(cfg *Config) (*tls.Config, *credentials.TransportCredentials,
 		return nil, nil, err
 	}
 
-	// If the certificate expired or it was outdated, delete it and the TLS
-	// key and generate a new pair.
-	if time.Now().After(parsedCert.NotAfter) {
-		log.Info("TLS certificate is expired or outdated, " +
-			"removing old file then generating a new one")
+	// If the certificate expired, is about to expire or it was outdated,
+	// delete it and the TLS key and generate a new pair.
+	renewAt := parsedCert.NotAfter.Add(-cfg.TLSCertRenewBefore)
+	if clk.Now().After(renewAt) {
+		log.Info("TLS certificate is expired, about to expire or " +
+			"outdated, removing old file then generating a new one")
 
 		err := os.Remove(cfg.TLSCertPath)
 		if err != nil {
@@ -402,12 +1295,16 @@ func getTLSConfig(cfg *Config) (*tls.Config, *credentials.TransportCredentials,
 			return nil, nil, err
 		}
 
-		certData, _, err = loadCertWithCreate(cfg)
+		certData, parsedCert, err = loadCertWithCreate(cfg)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
+	// Keep the parsed certificate around so it can be inspected later on
+	// without parsing it again.
+	certData.Leaf = parsedCert
+
 	tlsCfg := cert.TLSConfFromCert(certData)
 	tlsCfg.NextProtos = []string{"h2"}
 	restCreds, err := credentials.NewClientTLSFromFile(
@@ -431,13 +1328,7 @@ func loadCertWithCreate(cfg *Config) (tls.Certificate, *x509.Certificate,
 		!lnrpc.FileExists(cfg.TLSKeyPath) {
 
 		log.Infof("Generating TLS certificates...")
-		err := cert.GenCertPair(
-			defaultSelfSignedOrganization, cfg.TLSCertPath,
-			cfg.TLSKeyPath, cfg.TLSExtraIPs,
-			cfg.TLSExtraDomains, cfg.TLSDisableAutofill,
-			DefaultAutogenValidity,
-		)
-		if err != nil {
+		if err := GenerateTLSPair(cfg); err != nil {
 			return tls.Certificate{}, nil, err
 		}
 		log.Infof("Done generating TLS certificates")
@@ -445,3 +1336,133 @@ func loadCertWithCreate(cfg *Config) (tls.Certificate, *x509.Certificate,
 
 	return cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
 }
+
+// GenerateTLSPair generates a new self signed TLS certificate and key and
+// writes them to the paths specified in the given config, overwriting any
+// existing files. The certificate contains the configured extra IPs and
+// domains and, unless autofill is disabled, the interface IPs and host name of
+// the system. This can be used to create the certificate without starting the
+// server.
+func GenerateTLSPair(cfg *Config) error {
+	var (
+		addrs    []net.Addr
+		hostname string
+	)
+	if !cfg.TLSDisableAutofill {
+		var err error
+		addrs, err = net.InterfaceAddrs()
+		if err != nil {
+			return err
+		}
+
+		// Just like lnd, we fall back to only using localhost if the
+		// host name can't be determined.
+		hostname, _ = os.Hostname()
+	}
+
+	// We do the autofill ourselves to make sure no addresses end up in the
+	// certificate that would make its generation fail. That's why we tell
+	// lnd to not add any addresses on its own.
+	ips, domains := certAddresses(cfg, addrs, hostname)
+
+	return cert.GenCertPair(
+		defaultSelfSignedOrganization, cfg.TLSCertPath, cfg.TLSKeyPath,
+		ips, domains, true, DefaultAutogenValidity,
+	)
+}
+
+// certAddresses returns the IP addresses and domains that should be included in
+// a newly generated TLS certificate. Unless autofill is disabled, the IPs of the
+// given interface addresses and the given host name are added to the ones
+// configured by the user. If set, the configured common name is always the
+// first domain, which lnd uses as the common name. Link-local IPv6 addresses
+// are only reachable together with a zone identifier (fe80::1%eth0) that can't
+// be encoded in a certificate, so they are skipped during autofill. The zone
+// identifier of any IP configured by the user is stripped.
+func certAddresses(cfg *Config, addrs []net.Addr,
+	hostname string) ([]string, []string) {
+
+	var ips []string
+	if !cfg.TLSDisableAutofill {
+		for _, addr := range addrs {
+			var (
+				ip   net.IP
+				zone string
+			)
+			switch a := addr.(type) {
+			case *net.IPNet:
+				ip = a.IP
+
+			case *net.IPAddr:
+				ip, zone = a.IP, a.Zone
+
+			default:
+				ip, _, _ = net.ParseCIDR(addr.String())
+			}
+
+			scoped := zone != "" ||
+				(ip.To4() == nil && ip.IsLinkLocalUnicast())
+			if ip == nil || scoped {
+				continue
+			}
+
+			ips = append(ips, ip.String())
+		}
+	}
+
+	for _, ip := range cfg.TLSExtraIPs {
+		if idx := strings.IndexByte(ip, '%'); idx >= 0 {
+			ip = ip[:idx]
+		}
+		ips = append(ips, ip)
+	}
+
+	// lnd uses the first domain as the certificate's common name if its
+	// own autofill is turned off, so an explicitly set common name must
+	// come first, followed by the host name.
+	var domains []string
+	if cfg.TLSCommonName != "" {
+		domains = append(domains, cfg.TLSCommonName)
+	}
+	if !cfg.TLSDisableAutofill && hostname != "" &&
+		hostname != "localhost" && hostname != cfg.TLSCommonName {
+
+		domains = append(domains, hostname)
+	}
+	for _, domain := range cfg.TLSExtraDomains {
+		if domain == cfg.TLSCommonName {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+
+	return ips, domains
+}
+
+// isValidHostname returns true if the given name is a syntactically valid host
+// name as defined in RFC 1123.
+func isValidHostname(name string) bool {
+	if len(name) == 0 || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 ||
+			label[0] == '-' || label[len(label)-1] == '-' {
+
+			return false
+		}
+
+		for _, c := range label {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z',
+				c >= '0' && c <= '9', c == '-':
+
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,898 @@
+package pool
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+var lndDirOverlapTestCases = []struct {
+	name        string
+	baseDir     string
+	tlsCertDir  string
+	lndDir      string
+	lndTLSDir   string
+	expectedErr string
+}{{
+	name:    "separate directories",
+	baseDir: "/home/user/.pool/mainnet",
+	lndDir:  "/home/user/.lnd/data/chain/bitcoin/mainnet",
+}, {
+	name:    "pool directory inside lnd directory",
+	baseDir: "/home/user/.lnd/pool/mainnet",
+	lndDir:  "/home/user/.lnd",
+}, {
+	name:    "similar directory names",
+	baseDir: "/data/pool",
+	lndDir:  "/data/pool-lnd",
+}, {
+	name:    "lnd macaroon in pool directory",
+	baseDir: "/home/user/.pool/mainnet",
+	lndDir:  "/home/user/.pool/mainnet",
+	expectedErr: "lnd.macaroonpath /home/user/.pool/mainnet/admin.macaroon " +
+		"is located inside the pool base directory",
+}, {
+	name:        "lnd macaroon below pool directory",
+	baseDir:     "/home/user/.pool/mainnet",
+	lndDir:      "/home/user/.pool/mainnet/lnd",
+	expectedErr: "is located inside the pool base directory",
+}, {
+	name:        "lnd tls cert below pool directory",
+	baseDir:     "/home/user/.pool/mainnet",
+	lndDir:      "/home/user/.lnd/data/chain/bitcoin/mainnet",
+	lndTLSDir:   "/home/user/.pool/mainnet/lnd",
+	expectedErr: "lnd.tlspath",
+}, {
+	name:       "pool tls cert next to lnd tls cert",
+	baseDir:    "/home/user/.pool/mainnet",
+	tlsCertDir: "/home/user/.lnd",
+	lndDir:     "/home/user/.lnd/data/chain/bitcoin/mainnet",
+	lndTLSDir:  "/home/user/.lnd",
+	expectedErr: "tlscertpath /home/user/.lnd/tls.cert is located in the " +
+		"same directory as lnd.tlspath",
+}}
+
+// TestValidateLndDirOverlap makes sure a config is rejected if lnd's files are
+// located in pool's base directory or if pool would create its TLS or macaroon
+// files in the same directory as lnd's.
+func TestValidateLndDirOverlap(t *testing.T) {
+	for _, tc := range lndDirOverlapTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultConfig()
+			cfg.BaseDir = tc.baseDir
+			cfg.TLSCertPath = filepath.Join(
+				tc.baseDir, DefaultTLSCertFilename,
+			)
+			if tc.tlsCertDir != "" {
+				cfg.TLSCertPath = filepath.Join(
+					tc.tlsCertDir, DefaultTLSCertFilename,
+				)
+			}
+			cfg.TLSKeyPath = filepath.Join(
+				tc.baseDir, DefaultTLSKeyFilename,
+			)
+			cfg.MacaroonPath = filepath.Join(
+				tc.baseDir, DefaultMacaroonFilename,
+			)
+			cfg.Lnd.MacaroonPath = filepath.Join(
+				tc.lndDir, defaultLndMacaroon,
+			)
+			if tc.lndTLSDir != "" {
+				cfg.Lnd.TLSPath = filepath.Join(
+					tc.lndTLSDir, "tls.cert",
+				)
+			}
+
+			err := validateLndDirOverlap(&cfg)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// TestValidateServerMsgSize makes sure message size limits below the minimum
+// are rejected and a limit of 0 is replaced by the default.
+func TestValidateServerMsgSize(t *testing.T) {
+	baseDir, err := ioutil.TempDir("", "pool-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.ServerMaxRecvMsgSize = minServerMsgSize - 1
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "servermaxrecvmsgsize must be at least")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.ServerMaxSendMsgSize = minServerMsgSize - 1
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "servermaxsendmsgsize must be at least")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.ServerMaxRecvMsgSize = 0
+	cfg.ServerMaxSendMsgSize = 0
+	require.NoError(t, Validate(&cfg))
+	require.Equal(t, defaultServerMaxRecvMsgSize, cfg.ServerMaxRecvMsgSize)
+	require.Equal(t, defaultServerMaxSendMsgSize, cfg.ServerMaxSendMsgSize)
+}
+
+var certAddressesTestCases = []struct {
+	name               string
+	disableAutofill    bool
+	commonName         string
+	extraIPs           []string
+	extraDomains       []string
+	expectedIPs        []string
+	expectedDomains    []string
+	expectedCommonName string
+}{{
+	name:               "autofill skips scoped addresses",
+	expectedIPs:        []string{"192.168.1.2", "169.254.1.1", "2001:db8::1"},
+	expectedDomains:    []string{"pool-host"},
+	expectedCommonName: "pool-host",
+}, {
+	name:         "extra ips with zone are normalized",
+	extraIPs:     []string{"fe80::2%eth0", "10.0.0.1"},
+	extraDomains: []string{"pool.example.com"},
+	expectedIPs: []string{
+		"192.168.1.2", "169.254.1.1", "2001:db8::1", "fe80::2",
+		"10.0.0.1",
+	},
+	expectedDomains:    []string{"pool-host", "pool.example.com"},
+	expectedCommonName: "pool-host",
+}, {
+	name:               "autofill disabled",
+	disableAutofill:    true,
+	extraIPs:           []string{"fe80::2%eth0"},
+	extraDomains:       []string{"pool.example.com"},
+	expectedIPs:        []string{"fe80::2"},
+	expectedDomains:    []string{"pool.example.com"},
+	expectedCommonName: "pool.example.com",
+}, {
+	name:         "common name with autofill",
+	commonName:   "trader.example.com",
+	extraDomains: []string{"pool.example.com"},
+	expectedIPs:  []string{"192.168.1.2", "169.254.1.1", "2001:db8::1"},
+	expectedDomains: []string{
+		"trader.example.com", "pool-host", "pool.example.com",
+	},
+	expectedCommonName: "trader.example.com",
+}, {
+	name:            "common name with autofill disabled",
+	disableAutofill: true,
+	commonName:      "trader.example.com",
+	extraDomains: []string{
+		"pool.example.com", "trader.example.com",
+	},
+	expectedDomains: []string{
+		"trader.example.com", "pool.example.com",
+	},
+	expectedCommonName: "trader.example.com",
+}}
+
+// TestCertAddresses makes sure link-local IPv6 addresses with a zone identifier
+// don't end up in the autogenerated TLS certificate and don't break its
+// generation.
+func TestCertAddresses(t *testing.T) {
+	addrs := []net.Addr{
+		&net.IPNet{
+			IP:   net.ParseIP("192.168.1.2"),
+			Mask: net.CIDRMask(24, 32),
+		},
+		&net.IPNet{
+			IP:   net.ParseIP("169.254.1.1"),
+			Mask: net.CIDRMask(16, 32),
+		},
+		&net.IPNet{
+			IP:   net.ParseIP("fe80::1"),
+			Mask: net.CIDRMask(64, 128),
+		},
+		&net.IPAddr{
+			IP:   net.ParseIP("fe80::1"),
+			Zone: "eth0",
+		},
+		&net.IPNet{
+			IP:   net.ParseIP("2001:db8::1"),
+			Mask: net.CIDRMask(64, 128),
+		},
+	}
+
+	for _, tc := range certAddressesTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultConfig()
+			cfg.TLSDisableAutofill = tc.disableAutofill
+			cfg.TLSCommonName = tc.commonName
+			cfg.TLSExtraIPs = tc.extraIPs
+			cfg.TLSExtraDomains = tc.extraDomains
+
+			ips, domains := certAddresses(&cfg, addrs, "pool-host")
+			require.Equal(t, tc.expectedIPs, ips)
+			require.Equal(t, tc.expectedDomains, domains)
+
+			// Make sure a certificate can be generated with the
+			// addresses and contains all of them.
+			tempDir, err := ioutil.TempDir("", "pool-tls")
+			require.NoError(t, err)
+			defer os.RemoveAll(tempDir)
+
+			certPath := filepath.Join(tempDir, DefaultTLSCertFilename)
+			keyPath := filepath.Join(tempDir, DefaultTLSKeyFilename)
+			err = cert.GenCertPair(
+				defaultSelfSignedOrganization, certPath,
+				keyPath, ips, domains, true,
+				DefaultAutogenValidity,
+			)
+			require.NoError(t, err)
+
+			_, parsedCert, err := cert.LoadCert(certPath, keyPath)
+			require.NoError(t, err)
+
+			for _, ip := range tc.expectedIPs {
+				found := false
+				for _, certIP := range parsedCert.IPAddresses {
+					if certIP.Equal(net.ParseIP(ip)) {
+						found = true
+					}
+				}
+				require.True(t, found, "ip %v not found", ip)
+			}
+			for _, certIP := range parsedCert.IPAddresses {
+				require.False(t, certIP.Equal(
+					net.ParseIP("fe80::1"),
+				))
+			}
+			require.Subset(t, parsedCert.DNSNames, tc.expectedDomains)
+			require.Equal(
+				t, tc.expectedCommonName,
+				parsedCert.Subject.CommonName,
+			)
+		})
+	}
+}
+
+var hostnameTestCases = []struct {
+	name  string
+	valid bool
+}{
+	{"localhost", true},
+	{"pool.example.com", true},
+	{"pool-1.example.com", true},
+	{"", false},
+	{"-pool.example.com", false},
+	{"pool-.example.com", false},
+	{"pool..example.com", false},
+	{"pool_1.example.com", false},
+	{"pool example.com", false},
+}
+
+// TestIsValidHostname makes sure only plausible host names are accepted as the
+// TLS certificate's common name.
+func TestIsValidHostname(t *testing.T) {
+	for _, tc := range hostnameTestCases {
+		require.Equal(t, tc.valid, isValidHostname(tc.name), tc.name)
+	}
+}
+
+// TestValidateNoMacaroons makes sure disabling macaroons can't be combined with
+// a custom macaroon path.
+func TestValidateNoMacaroons(t *testing.T) {
+	baseDir, err := ioutil.TempDir("", "pool-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	cfg := DefaultConfig()
+	cfg.NoMacaroons = true
+	cfg.MacaroonPath = filepath.Join(baseDir, DefaultMacaroonFilename)
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nomacaroons cannot be combined")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.NoMacaroons = true
+	require.NoError(t, Validate(&cfg))
+}
+
+// TestValidateLndChain makes sure the default lnd macaroon path is built from
+// the configured chain and network, while an explicit path is never changed.
+func TestValidateLndChain(t *testing.T) {
+	baseDir, err := ioutil.TempDir("", "pool-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	require.NoError(t, Validate(&cfg))
+	require.Equal(t, DefaultLndMacaroonPath, cfg.Lnd.MacaroonPath)
+	require.Equal(
+		t, filepath.Join(
+			DefaultLndDir, "data", "chain", "bitcoin", DefaultNetwork,
+			"admin.macaroon",
+		), cfg.Lnd.MacaroonPath,
+	)
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Network = "testnet"
+	cfg.Lnd.Chain = "litecoin"
+	require.NoError(t, Validate(&cfg))
+	require.Equal(
+		t, filepath.Join(
+			DefaultLndDir, "data", "chain", "litecoin", "testnet",
+			"admin.macaroon",
+		), cfg.Lnd.MacaroonPath,
+	)
+
+	// A custom chain alone is enough to adjust the default path and an
+	// empty chain falls back to the default one.
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.Chain = "mychain"
+	require.NoError(t, Validate(&cfg))
+	require.Equal(
+		t, lndMacaroonPath("mychain", DefaultNetwork),
+		cfg.Lnd.MacaroonPath,
+	)
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Network = "regtest"
+	cfg.Lnd.Chain = ""
+	require.NoError(t, Validate(&cfg))
+	require.Equal(t, DefaultLndChain, cfg.Lnd.Chain)
+	require.Equal(
+		t, lndMacaroonPath(DefaultLndChain, "regtest"),
+		cfg.Lnd.MacaroonPath,
+	)
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.Chain = "litecoin"
+	cfg.Lnd.MacaroonPath = "/custom/lnd/admin.macaroon"
+	require.NoError(t, Validate(&cfg))
+	require.Equal(t, "/custom/lnd/admin.macaroon", cfg.Lnd.MacaroonPath)
+}
+
+// TestValidateLndTLSCert makes sure an lnd TLS certificate that can't be
+// parsed is rejected with an error that names the file.
+func TestValidateLndTLSCert(t *testing.T) {
+	baseDir := t.TempDir()
+	lndDir := t.TempDir()
+
+	// We use the certificate generation of pool itself to get a valid
+	// certificate.
+	certCfg := DefaultConfig()
+	certCfg.TLSCertPath = filepath.Join(lndDir, DefaultTLSCertFilename)
+	certCfg.TLSKeyPath = filepath.Join(lndDir, DefaultTLSKeyFilename)
+	certCfg.TLSDisableAutofill = true
+	require.NoError(t, GenerateTLSPair(&certCfg))
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.TLSPath = certCfg.TLSCertPath
+	require.NoError(t, Validate(&cfg))
+
+	garbagePath := filepath.Join(lndDir, "garbage.cert")
+	require.NoError(t, os.WriteFile(garbagePath, []byte("garbage"), 0600))
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.TLSPath = garbagePath
+	err := Validate(&cfg)
+	require.ErrorContains(t, err, garbagePath)
+	require.ErrorContains(t, err, "does not contain a PEM encoded")
+
+	// A PEM block that isn't a valid certificate is rejected as well.
+	brokenPath := filepath.Join(lndDir, "broken.cert")
+	brokenPEM := "-----BEGIN CERTIFICATE-----\nZ2FyYmFnZQ==\n" +
+		"-----END CERTIFICATE-----\n"
+	require.NoError(t, os.WriteFile(brokenPath, []byte(brokenPEM), 0600))
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.TLSPath = brokenPath
+	err = Validate(&cfg)
+	require.ErrorContains(t, err, "unable to parse lnd.tlspath "+brokenPath)
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Lnd.TLSPath = filepath.Join(lndDir, "missing.cert")
+	err = Validate(&cfg)
+	require.ErrorContains(t, err, "unable to read lnd.tlspath")
+}
+
+// TestValidateProxyBypass makes sure a proxy bypass list is only accepted
+// together with a proxy and if all entries are valid.
+func TestValidateProxyBypass(t *testing.T) {
+	baseDir, err := ioutil.TempDir("", "pool-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.ProxyBypass = []string{"localhost"}
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "proxybypass requires a proxy")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Proxy = "localhost:9050"
+	cfg.ProxyBypass = []string{"localhost", "10.0.0.0/33"}
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid proxybypass")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.Proxy = "localhost:9050"
+	cfg.ProxyBypass = []string{"localhost", "10.0.0.0/8", "::1"}
+	require.NoError(t, Validate(&cfg))
+}
+
+// TestValidateAutoRenew makes sure the auto renewal options are only checked
+// if auto renewal is enabled and that an account can't be renewed for fewer
+// blocks than the renewal threshold.
+func TestValidateAutoRenew(t *testing.T) {
+	baseDir, err := ioutil.TempDir("", "pool-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.AutoRenewThreshold = 0
+	require.NoError(t, Validate(&cfg))
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.AutoRenewAccounts = true
+	cfg.AutoRenewExpiry = cfg.AutoRenewThreshold
+	err = Validate(&cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be greater than autorenew")
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.AutoRenewAccounts = true
+	require.NoError(t, Validate(&cfg))
+}
+
+// TestAllowedPeerKeys makes sure only valid node identity keys are accepted as
+// allowed batch peers.
+func TestAllowedPeerKeys(t *testing.T) {
+	cfg := DefaultConfig()
+	keys, err := cfg.AllowedPeerKeys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	validKey := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b" +
+		"16f81798"
+	cfg.AllowedPeers = []string{validKey}
+	keys, err = cfg.AllowedPeerKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, validKey, hex.EncodeToString(keys[0][:]))
+
+	cfg.AllowedPeers = []string{validKey, "02abcd"}
+	_, err = cfg.AllowedPeerKeys()
+	require.ErrorContains(t, err, "invalid allowedpeer 02abcd")
+
+	cfg.AllowedPeers = []string{"not hex"}
+	_, err = cfg.AllowedPeerKeys()
+	require.ErrorContains(t, err, "invalid allowedpeer")
+}
+
+// TestDisabledRPCMethods makes sure disabled RPCs can be configured with their
+// full or short method name and unknown methods are rejected.
+func TestDisabledRPCMethods(t *testing.T) {
+	cfg := DefaultConfig()
+	methods, err := cfg.DisabledRPCMethods()
+	require.NoError(t, err)
+	require.Empty(t, methods)
+
+	cfg.DisabledRPCs = []string{
+		"WithdrawAccount", "/poolrpc.Trader/CloseAccount",
+	}
+	methods, err = cfg.DisabledRPCMethods()
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{
+		"/poolrpc.Trader/WithdrawAccount": {},
+		"/poolrpc.Trader/CloseAccount":    {},
+	}, methods)
+
+	cfg.DisabledRPCs = []string{"WithdrawAccount", "StealFunds"}
+	_, err = cfg.DisabledRPCMethods()
+	require.ErrorContains(t, err, "invalid disablerpc StealFunds")
+
+	cfg.DisabledRPCs = []string{"/lnrpc.Lightning/GetInfo"}
+	_, err = cfg.DisabledRPCMethods()
+	require.ErrorContains(t, err, "unknown RPC method")
+}
+
+// TestValidateMaxBatchAccountUtilization makes sure the maximum account
+// utilization of a batch must be a fraction between 0 and 1.
+func TestValidateMaxBatchAccountUtilization(t *testing.T) {
+	baseDir := t.TempDir()
+
+	for _, utilization := range []float64{0, 0.8, 1} {
+		cfg := DefaultConfig()
+		cfg.BaseDir = baseDir
+		cfg.MaxBatchAccountUtilization = utilization
+		require.NoError(t, Validate(&cfg))
+	}
+
+	for _, utilization := range []float64{-0.1, 1.5} {
+		cfg := DefaultConfig()
+		cfg.BaseDir = baseDir
+		cfg.MaxBatchAccountUtilization = utilization
+		err := Validate(&cfg)
+		require.ErrorContains(t, err, "must be between 0 and 1")
+	}
+}
+
+// TestValidateLsatMaxRoutingFeePPM makes sure the proportional LSAT routing
+// fee can't exceed the token's cost.
+func TestValidateLsatMaxRoutingFeePPM(t *testing.T) {
+	baseDir := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.LsatMaxRoutingFeePPM = lsatMaxRoutingFeePPM
+	require.NoError(t, Validate(&cfg))
+
+	cfg = DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.LsatMaxRoutingFeePPM = lsatMaxRoutingFeePPM + 1
+	err := Validate(&cfg)
+	require.ErrorContains(t, err, "lsatmaxroutingfeeppm cannot exceed")
+}
+
+// TestValidateCoinSelectionStrategy makes sure only the coin selection
+// strategies lnd supports are accepted.
+func TestValidateCoinSelectionStrategy(t *testing.T) {
+	baseDir := t.TempDir()
+
+	for _, strategy := range []string{"", "largest", "random"} {
+		cfg := DefaultConfig()
+		cfg.BaseDir = baseDir
+		cfg.CoinSelectionStrategy = strategy
+		require.NoError(t, Validate(&cfg))
+	}
+
+	cfg := DefaultConfig()
+	cfg.BaseDir = baseDir
+	cfg.CoinSelectionStrategy = "smallest"
+	err := Validate(&cfg)
+	require.ErrorContains(t, err, "unknown coin selection strategy")
+}
+
+// TestGetTLSConfigExpiry makes sure the TLS certificate is only regenerated
+// once it is expired according to the given clock.
+func TestGetTLSConfigExpiry(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "pool-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := DefaultConfig()
+	cfg.TLSCertPath = filepath.Join(tempDir, DefaultTLSCertFilename)
+	cfg.TLSKeyPath = filepath.Join(tempDir, DefaultTLSKeyFilename)
+	cfg.TLSDisableAutofill = true
+
+	// The first call creates the certificate.
+	_, _, err = getTLSConfig(&cfg, clock.NewDefaultClock())
+	require.NoError(t, err)
+
+	_, origCert, err := cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	require.NoError(t, err)
+	origCertBytes, err := ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+
+	// As long as the certificate isn't expired, it is re-used.
+	validClock := clock.NewTestClock(origCert.NotAfter.Add(-time.Hour))
+	_, _, err = getTLSConfig(&cfg, validClock)
+	require.NoError(t, err)
+
+	certBytes, err := ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+	require.Equal(t, origCertBytes, certBytes)
+
+	// Once the clock is past the expiry, a new certificate is generated.
+	expiredClock := clock.NewTestClock(origCert.NotAfter.Add(time.Hour))
+	_, _, err = getTLSConfig(&cfg, expiredClock)
+	require.NoError(t, err)
+
+	certBytes, err = ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+	require.NotEqual(t, origCertBytes, certBytes)
+
+	_, newCert, err := cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	require.NoError(t, err)
+	require.NotEqual(t, origCert.SerialNumber, newCert.SerialNumber)
+}
+
+// TestGetTLSConfigRenewBefore makes sure the TLS certificate is regenerated
+// once it expires within the configured renewal window.
+func TestGetTLSConfigRenewBefore(t *testing.T) {
+	tempDir := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.TLSCertPath = filepath.Join(tempDir, DefaultTLSCertFilename)
+	cfg.TLSKeyPath = filepath.Join(tempDir, DefaultTLSKeyFilename)
+	cfg.TLSDisableAutofill = true
+	cfg.TLSCertRenewBefore = 7 * 24 * time.Hour
+
+	_, _, err := getTLSConfig(&cfg, clock.NewDefaultClock())
+	require.NoError(t, err)
+
+	_, origCert, err := cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	require.NoError(t, err)
+	origCertBytes, err := ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+
+	// A certificate that expires after the renewal window is re-used.
+	renewAt := origCert.NotAfter.Add(-cfg.TLSCertRenewBefore)
+	outsideClock := clock.NewTestClock(renewAt.Add(-time.Hour))
+	_, _, err = getTLSConfig(&cfg, outsideClock)
+	require.NoError(t, err)
+
+	certBytes, err := ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+	require.Equal(t, origCertBytes, certBytes)
+
+	// Within the window, a new certificate is generated even though the
+	// old one isn't expired yet.
+	insideClock := clock.NewTestClock(renewAt.Add(time.Hour))
+	require.True(t, insideClock.Now().Before(origCert.NotAfter))
+	_, _, err = getTLSConfig(&cfg, insideClock)
+	require.NoError(t, err)
+
+	certBytes, err = ioutil.ReadFile(cfg.TLSCertPath)
+	require.NoError(t, err)
+	require.NotEqual(t, origCertBytes, certBytes)
+
+	// A negative window isn't allowed.
+	cfg = DefaultConfig()
+	cfg.BaseDir = tempDir
+	cfg.TLSCertRenewBefore = -time.Hour
+	err = Validate(&cfg)
+	require.ErrorContains(t, err, "tlscertrenewbefore cannot be negative")
+}
+
+// TestGenerateTLSPair makes sure the TLS certificate and key can be generated
+// without starting the server and that the certificate contains the configured
+// extra IPs and domains.
+func TestGenerateTLSPair(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "pool-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := DefaultConfig()
+	cfg.TLSCertPath = filepath.Join(tempDir, DefaultTLSCertFilename)
+	cfg.TLSKeyPath = filepath.Join(tempDir, DefaultTLSKeyFilename)
+	cfg.TLSExtraIPs = []string{"10.0.0.1"}
+	cfg.TLSExtraDomains = []string{"pool.example.com"}
+	cfg.TLSDisableAutofill = true
+
+	require.NoError(t, GenerateTLSPair(&cfg))
+	require.FileExists(t, cfg.TLSCertPath)
+	require.FileExists(t, cfg.TLSKeyPath)
+
+	_, parsedCert, err := cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	require.NoError(t, err)
+
+	require.Equal(t, "pool.example.com", parsedCert.Subject.CommonName)
+	require.Contains(t, parsedCert.DNSNames, "pool.example.com")
+
+	found := false
+	for _, ip := range parsedCert.IPAddresses {
+		if ip.Equal(net.ParseIP("10.0.0.1")) {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+// TestEnvOptions makes sure the environment variables populate the config
+// while flags and options that are already set take precedence.
+func TestEnvOptions(t *testing.T) {
+	t.Setenv(EnvAuctionServer, "auction.example.com:12010")
+	t.Setenv(EnvProxy, "127.0.0.1:9050")
+	t.Setenv(EnvLndHost, "lnd.example.com:10009")
+
+	// The default config uses the values of the environment variables.
+	cfg := DefaultConfig()
+	require.Equal(t, "auction.example.com:12010", cfg.AuctionServer)
+	require.Equal(t, "127.0.0.1:9050", cfg.Proxy)
+	require.Equal(t, "lnd.example.com:10009", cfg.Lnd.Host)
+
+	// Flags overwrite the values of the environment variables.
+	parser := flags.NewParser(&cfg, flags.None)
+	_, err := parser.ParseArgs([]string{
+		"--auctionserver=flag.example.com:12010",
+		"--lnd.host=localhost:10010",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "flag.example.com:12010", cfg.AuctionServer)
+	require.Equal(t, "127.0.0.1:9050", cfg.Proxy)
+	require.Equal(t, "localhost:10010", cfg.Lnd.Host)
+
+	// Validate only fills in options that are empty.
+	cfg = DefaultConfig()
+	cfg.BaseDir = t.TempDir()
+	cfg.AuctionServer = "custom.example.com:12010"
+	cfg.Proxy = ""
+	require.NoError(t, Validate(&cfg))
+	require.Equal(t, "custom.example.com:12010", cfg.AuctionServer)
+	require.Equal(t, "127.0.0.1:9050", cfg.Proxy)
+}
+
+// TestLoadConfigFileProfiles makes sure the options of the selected network's
+// profile are applied on top of the general options of a config file.
+func TestLoadConfigFileProfiles(t *testing.T) {
+	t.Parallel()
+
+	configFile := filepath.Join(t.TempDir(), "poold.conf")
+	content := `
+network=testnet
+debuglevel=info
+lnd.host=localhost:10009
+
+[testnet]
+auctionserver=test.pool.example.com:12010
+lnd.macaroonpath=/testnet/admin.macaroon
+
+[Application Options]
+lnd.tlspath=/lnd/tls.cert
+
+[Regtest]
+debuglevel=trace
+auctionserver=localhost:12009
+lnd.host=localhost:10010
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	// Without an explicit network, the network set in the file selects
+	// the profile. Sections that aren't named after a network hold
+	// general options.
+	cfg := Config{Lnd: &LndConfig{}}
+	require.NoError(t, LoadConfigFile(&cfg, configFile, ""))
+	require.Equal(t, "testnet", cfg.Network)
+	require.Equal(t, "info", cfg.DebugLevel)
+	require.Equal(t, "test.pool.example.com:12010", cfg.AuctionServer)
+	require.Equal(t, "localhost:10009", cfg.Lnd.Host)
+	require.Equal(t, "/testnet/admin.macaroon", cfg.Lnd.MacaroonPath)
+	require.Equal(t, "/lnd/tls.cert", cfg.Lnd.TLSPath)
+
+	// An explicit network selects its profile, whose options take
+	// precedence over the general ones. Section names are case
+	// insensitive.
+	cfg = Config{Lnd: &LndConfig{}}
+	require.NoError(t, LoadConfigFile(&cfg, configFile, "regtest"))
+	require.Equal(t, "trace", cfg.DebugLevel)
+	require.Equal(t, "localhost:12009", cfg.AuctionServer)
+	require.Equal(t, "localhost:10010", cfg.Lnd.Host)
+	require.Empty(t, cfg.Lnd.MacaroonPath)
+	require.Equal(t, "/lnd/tls.cert", cfg.Lnd.TLSPath)
+
+	// A network without a profile only gets the general options.
+	cfg = Config{Lnd: &LndConfig{}}
+	require.NoError(t, LoadConfigFile(&cfg, configFile, "mainnet"))
+	require.Equal(t, "info", cfg.DebugLevel)
+	require.Empty(t, cfg.AuctionServer)
+
+	// A missing file is ignored.
+	cfg = Config{Lnd: &LndConfig{}}
+	require.NoError(t, LoadConfigFile(
+		&cfg, filepath.Join(t.TempDir(), "missing.conf"), "testnet",
+	))
+
+	// Errors in a profile point to the right line of the file.
+	content = "debuglevel=info\n\n[testnet]\nunknownoption=1\n"
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	cfg = Config{Lnd: &LndConfig{}}
+	err := LoadConfigFile(&cfg, configFile, "testnet")
+	var iniErr *flags.IniError
+	require.ErrorAs(t, err, &iniErr)
+	require.Equal(t, configFile, iniErr.File)
+	require.EqualValues(t, 4, iniErr.LineNumber)
+	require.Contains(t, iniErr.Message, "unknown option: unknownoption")
+
+	// The broken profile doesn't matter for other networks.
+	cfg = Config{Lnd: &LndConfig{}}
+	require.NoError(t, LoadConfigFile(&cfg, configFile, "regtest"))
+}
+
+// TestConfigMerge makes sure only the fields that are set in an overlay
+// overwrite the fields of the config, including the ones of nested configs.
+func TestConfigMerge(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Network:            "testnet",
+		AuctionServer:      "auction.example.com:12010",
+		ProxyBypass:        []string{"10.0.0.0/8"},
+		AutoRenewAccounts:  true,
+		AutoRenewThreshold: 144,
+		MinBackoff:         time.Second,
+		AuctioneerMetadata: map[string]string{"x-route": "a"},
+		Lnd: &LndConfig{
+			Host:    "localhost:10009",
+			TLSPath: "/lnd/tls.cert",
+		},
+	}
+
+	// Zero values, empty slices and maps as well as false bools don't
+	// overwrite anything.
+	cfg.Merge(Config{
+		ProxyBypass:        []string{},
+		AuctioneerMetadata: map[string]string{},
+		AutoRenewAccounts:  false,
+		Lnd:                &LndConfig{},
+	})
+	require.Equal(t, "testnet", cfg.Network)
+	require.Equal(t, []string{"10.0.0.0/8"}, cfg.ProxyBypass)
+	require.Equal(
+		t, map[string]string{"x-route": "a"}, cfg.AuctioneerMetadata,
+	)
+	require.True(t, cfg.AutoRenewAccounts)
+	require.Equal(t, "localhost:10009", cfg.Lnd.Host)
+
+	// Set fields overwrite the existing values, slices and maps are
+	// replaced and nested configs are merged field by field.
+	debugOverlay := &DebugConfig{BatchVersion: 2}
+	cfg.Merge(Config{
+		AuctionServer:        "other.example.com:12010",
+		ProxyBypass:          []string{"192.168.0.0/16"},
+		AutoRenewThreshold:   288,
+		NoMacaroons:          true,
+		ShutdownDrainTimeout: time.Minute,
+		Lnd:                  &LndConfig{Host: "lnd.example.com:10009"},
+		DebugConfig:          debugOverlay,
+	})
+	require.Equal(t, "testnet", cfg.Network)
+	require.Equal(t, "other.example.com:12010", cfg.AuctionServer)
+	require.Equal(t, []string{"192.168.0.0/16"}, cfg.ProxyBypass)
+	require.Equal(
+		t, map[string]string{"x-route": "a"}, cfg.AuctioneerMetadata,
+	)
+	require.Equal(t, uint32(288), cfg.AutoRenewThreshold)
+	require.Equal(t, time.Second, cfg.MinBackoff)
+	require.Equal(t, time.Minute, cfg.ShutdownDrainTimeout)
+	require.True(t, cfg.NoMacaroons)
+	require.True(t, cfg.AutoRenewAccounts)
+	require.Equal(t, "lnd.example.com:10009", cfg.Lnd.Host)
+	require.Equal(t, "/lnd/tls.cert", cfg.Lnd.TLSPath)
+
+	// A nested config that didn't exist is copied, so it isn't shared
+	// with the overlay.
+	require.NotNil(t, cfg.DebugConfig)
+	require.Equal(t, int32(2), cfg.DebugConfig.BatchVersion)
+	require.NotSame(t, debugOverlay, cfg.DebugConfig)
+}
@@ -0,0 +1,125 @@
+package pool
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"runtime"
+
+	"github.com/lightninglabs/pool/poolrpc"
+)
+
+const (
+	// redactedConfigValue is returned instead of the value of a config
+	// option that may contain secrets.
+	redactedConfigValue = "<redacted>"
+)
+
+var (
+	// redactedConfigOptions is the set of config options, as named on the
+	// command line, whose values may contain secrets and are never
+	// returned by the debug dump.
+	redactedConfigOptions = map[string]struct{}{
+		"auctioneermetadata": {},
+		"etcd.pass":          {},
+		"postgres.dsn":       {},
+	}
+)
+
+// debugDump collects the runtime statistics of the daemon and the redacted
+// version of the given config.
+func debugDump(cfg *Config) *poolrpc.DebugDumpResponse {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	return &poolrpc.DebugDumpResponse{
+		NumGoroutines:   uint32(runtime.NumGoroutine()),
+		HeapAllocBytes:  memStats.HeapAlloc,
+		HeapSysBytes:    memStats.HeapSys,
+		HeapObjects:     memStats.HeapObjects,
+		TotalAllocBytes: memStats.TotalAlloc,
+		NumGc:           memStats.NumGC,
+		GoVersion:       runtime.Version(),
+		Config:          redactedConfig(cfg),
+	}
+}
+
+// redactedConfig returns all options of the given config that can be set on
+// the command line, keyed by their name including the namespace of their
+// group. The values of options that may contain secrets are redacted if set
+// and credentials are stripped from all URLs.
+func redactedConfig(cfg *Config) map[string]string {
+	options := make(map[string]string)
+	addConfigOptions(options, "", reflect.ValueOf(cfg).Elem())
+
+	return options
+}
+
+// addConfigOptions adds all options of the given config struct value to the
+// options map, recursing into option groups.
+func addConfigOptions(options map[string]string, prefix string,
+	value reflect.Value) {
+
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		fieldValue := value.Field(i)
+
+		// Option groups are pointers to structs with their own
+		// namespace.
+		if namespace, ok := field.Tag.Lookup("namespace"); ok {
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					continue
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() != reflect.Struct {
+				continue
+			}
+
+			addConfigOptions(
+				options, prefix+namespace+".", fieldValue,
+			)
+			continue
+		}
+
+		name, ok := field.Tag.Lookup("long")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		name = prefix + name
+
+		options[name] = fmt.Sprint(fieldValue.Interface())
+		if _, ok := redactedConfigOptions[name]; ok &&
+			!fieldValue.IsZero() {
+
+			options[name] = redactedConfigValue
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.String {
+			options[name] = redactURL(fieldValue.String())
+		}
+	}
+}
+
+// redactURL removes the user info, query string and fragment from the given
+// value if it is a URL, as those can contain credentials or API keys. Any other
+// value is returned unchanged.
+func redactURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return value
+	}
+
+	if u.User == nil && u.RawQuery == "" && u.Fragment == "" {
+		return value
+	}
+
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String()
+}
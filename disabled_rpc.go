@@ -0,0 +1,55 @@
+package pool
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// traderServicePrefix is the prefix of the full method names of all
+	// RPCs of the Trader service.
+	traderServicePrefix = "/poolrpc.Trader/"
+)
+
+// disabledRPCError returns the error that is returned for a call to a disabled
+// RPC method.
+func disabledRPCError(method string) error {
+	return status.Errorf(codes.Unimplemented, "method %v is disabled by "+
+		"the poold configuration", method)
+}
+
+// disabledRPCUnaryServerInterceptor is a UnaryServerInterceptor that refuses
+// all unary calls to the given disabled methods.
+func disabledRPCUnaryServerInterceptor(
+	disabled map[string]struct{}) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := disabled[info.FullMethod]; ok {
+			return nil, disabledRPCError(info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// disabledRPCStreamServerInterceptor is a StreamServerInterceptor that refuses
+// all streaming calls to the given disabled methods.
+func disabledRPCStreamServerInterceptor(
+	disabled map[string]struct{}) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if _, ok := disabled[info.FullMethod]; ok {
+			return disabledRPCError(info.FullMethod)
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"sort"
 	"time"
 
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 const (
@@ -48,6 +48,10 @@ const (
 	// If a reject happens for any reason, the order might not make it to
 	// the final batch and not all match states would therefore be present.
 	TypeOrderMatch Type = 3
+
+	// TypeAccountUpdate is the type of event that is emitted when an
+	// account is created or modified in the account database.
+	TypeAccountUpdate Type = 4
 )
 
 // Event is the main interface all events have to implement.
@@ -96,7 +100,7 @@ type Predicate func(time.Time, Type) bool
 // function should be called first to ensure the timestamps are already unique
 // among themselves. If no "gap" in the timestamps can be found after 1000 tries
 // an error is returned.
-func StoreEvent(bucket *bbolt.Bucket, event Event) error {
+func StoreEvent(bucket kvdb.RwBucket, event Event) error {
 	// We use a fixed length array as our buffer so we don't allocate new
 	// memory for each try.
 	var tsScratchSpace [TimestampLength]byte
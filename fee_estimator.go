@@ -0,0 +1,258 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// FeeSourceLnd is the fee source that uses the fee estimator of the
+	// connected lnd node.
+	FeeSourceLnd = "lnd"
+
+	// FeeSourceStatic is the fee source that always uses the same,
+	// configured fee rate.
+	FeeSourceStatic = "static"
+
+	// FeeSourceExternalURL is the fee source that queries an external fee
+	// estimation API.
+	FeeSourceExternalURL = "external-url"
+
+	// MaxFeeRatePolicyClamp is the policy that lowers fee rates above the
+	// configured maximum fee rate to the maximum.
+	MaxFeeRatePolicyClamp = "clamp"
+
+	// MaxFeeRatePolicyReject is the policy that refuses to use fee rates
+	// above the configured maximum fee rate.
+	MaxFeeRatePolicyReject = "reject"
+
+	// feeURLTimeout is the maximum time we wait for the external fee
+	// estimation API to respond.
+	feeURLTimeout = 30 * time.Second
+)
+
+// newFeeEstimator creates the fee estimator for the configured fee source. If a
+// maximum fee rate is configured, it is enforced on all estimates.
+func newFeeEstimator(cfg *Config,
+	walletKit lndclient.WalletKitClient) (account.FeeEstimator, error) {
+
+	var estimator account.FeeEstimator
+	switch cfg.FeeSource {
+	case FeeSourceLnd, "":
+		estimator = walletKit
+
+	case FeeSourceStatic:
+		estimator = newStaticFeeEstimator(
+			chainfee.SatPerKVByte(cfg.StaticFeeRate * 1000),
+		)
+
+	case FeeSourceExternalURL:
+		estimator = newExternalFeeEstimator(cfg.FeeURL)
+
+	default:
+		return nil, fmt.Errorf("unknown fee source %q", cfg.FeeSource)
+	}
+
+	ceiling := newFeeRateCeiling(cfg)
+	if ceiling == nil {
+		return estimator, nil
+	}
+
+	return &cappedFeeEstimator{
+		estimator: estimator,
+		ceiling:   ceiling,
+	}, nil
+}
+
+// feeRateCeiling is the global maximum fee rate of all transactions poold
+// creates or takes part in.
+type feeRateCeiling struct {
+	maxFeeRate chainfee.SatPerKWeight
+	policy     string
+}
+
+// newFeeRateCeiling creates the fee rate ceiling of the given config. Nil is
+// returned if no maximum fee rate is configured.
+func newFeeRateCeiling(cfg *Config) *feeRateCeiling {
+	if cfg.MaxFeeRate == 0 {
+		return nil
+	}
+
+	// The minimum relay fee rate is slightly above 1 sat/vByte, so we never
+	// go below it.
+	maxFeeRate := chainfee.SatPerKVByte(cfg.MaxFeeRate * 1000).FeePerKWeight()
+	if maxFeeRate < chainfee.FeePerKwFloor {
+		maxFeeRate = chainfee.FeePerKwFloor
+	}
+
+	return &feeRateCeiling{
+		maxFeeRate: maxFeeRate,
+		policy:     cfg.MaxFeeRatePolicy,
+	}
+}
+
+// apply returns the fee rate to use instead of the given one. A fee rate above
+// the maximum is either lowered to the maximum or rejected, depending on the
+// policy. A nil ceiling doesn't limit the fee rate.
+func (c *feeRateCeiling) apply(
+	feeRate chainfee.SatPerKWeight) (chainfee.SatPerKWeight, error) {
+
+	if c == nil || feeRate <= c.maxFeeRate {
+		return feeRate, nil
+	}
+
+	switch c.policy {
+	case MaxFeeRatePolicyClamp, "":
+		log.Warnf("Lowering fee rate of %v to the configured maximum "+
+			"of %v", feeRate, c.maxFeeRate)
+
+		return c.maxFeeRate, nil
+
+	case MaxFeeRatePolicyReject:
+		return 0, fmt.Errorf("fee rate of %v exceeds the configured "+
+			"maximum of %v", feeRate, c.maxFeeRate)
+
+	default:
+		return 0, fmt.Errorf("unknown max fee rate policy %q",
+			c.policy)
+	}
+}
+
+// cappedFeeEstimator is a fee estimator that enforces the fee rate ceiling on
+// the estimates of another fee estimator.
+type cappedFeeEstimator struct {
+	estimator account.FeeEstimator
+	ceiling   *feeRateCeiling
+}
+
+// EstimateFeeRate returns the estimate of the wrapped fee estimator after
+// applying the fee rate ceiling to it.
+//
+// NOTE: This is part of the account.FeeEstimator interface.
+func (e *cappedFeeEstimator) EstimateFeeRate(ctx context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := e.estimator.EstimateFeeRate(ctx, confTarget)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.ceiling.apply(feeRate)
+}
+
+// staticFeeEstimator is a fee estimator that returns the same fee rate for
+// every confirmation target.
+type staticFeeEstimator struct {
+	feeRate chainfee.SatPerKWeight
+}
+
+// newStaticFeeEstimator creates a fee estimator that always returns the given
+// fee rate.
+func newStaticFeeEstimator(
+	feeRate chainfee.SatPerKVByte) *staticFeeEstimator {
+
+	return &staticFeeEstimator{
+		feeRate: feeRate.FeePerKWeight(),
+	}
+}
+
+// EstimateFeeRate returns the configured fee rate, regardless of the target.
+//
+// NOTE: This is part of the account.FeeEstimator interface.
+func (e *staticFeeEstimator) EstimateFeeRate(_ context.Context,
+	_ int32) (chainfee.SatPerKWeight, error) {
+
+	return e.feeRate, nil
+}
+
+// externalFeeEstimator is a fee estimator that queries an external fee
+// estimation API. The API must return its estimates in the same format lnd
+// expects for its --feeurl option.
+type externalFeeEstimator struct {
+	source chainfee.SparseConfFeeSource
+	client *http.Client
+}
+
+// newExternalFeeEstimator creates a fee estimator that queries the fee
+// estimation API at the given URL.
+func newExternalFeeEstimator(url string) *externalFeeEstimator {
+	return &externalFeeEstimator{
+		source: chainfee.SparseConfFeeSource{
+			URL: url,
+		},
+		client: &http.Client{
+			Timeout: feeURLTimeout,
+		},
+	}
+}
+
+// EstimateFeeRate queries the external API for its current estimates and
+// returns the one for the given target. If the API has no estimate for the
+// exact target, the one for the next lower target is used. If there is none,
+// the estimate for the lowest target it knows of is used.
+//
+// NOTE: This is part of the account.FeeEstimator interface.
+func (e *externalFeeEstimator) EstimateFeeRate(ctx context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, e.source.GenQueryURL(), nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query fee estimation API: %v",
+			err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("fee estimation API returned status %v",
+			resp.Status)
+	}
+
+	feesByTarget, err := e.source.ParseResponse(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse fee estimates: %v", err)
+	}
+	if len(feesByTarget) == 0 {
+		return 0, fmt.Errorf("fee estimation API returned no " +
+			"estimates")
+	}
+
+	var (
+		bestTarget, minTarget uint32
+		found                 bool
+	)
+	for target := range feesByTarget {
+		if minTarget == 0 || target < minTarget {
+			minTarget = target
+		}
+
+		if target <= uint32(confTarget) && target > bestTarget {
+			bestTarget = target
+			found = true
+		}
+	}
+	if !found {
+		bestTarget = minTarget
+	}
+
+	feeRate := chainfee.SatPerKVByte(
+		feesByTarget[bestTarget],
+	).FeePerKWeight()
+
+	log.Debugf("Fee estimation API returned %v for conf target %d",
+		feeRate, confTarget)
+
+	return feeRate, nil
+}
@@ -0,0 +1,190 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewFeeEstimator makes sure the fee estimator of the configured fee
+// source is created.
+func TestNewFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	walletKit := test.NewMockWalletKit()
+
+	estimator, err := newFeeEstimator(&Config{}, walletKit)
+	require.NoError(t, err)
+	require.Equal(t, walletKit, estimator)
+
+	estimator, err = newFeeEstimator(
+		&Config{FeeSource: FeeSourceLnd}, walletKit,
+	)
+	require.NoError(t, err)
+	require.Equal(t, walletKit, estimator)
+
+	estimator, err = newFeeEstimator(&Config{
+		FeeSource:     FeeSourceStatic,
+		StaticFeeRate: 10,
+	}, walletKit)
+	require.NoError(t, err)
+	require.IsType(t, &staticFeeEstimator{}, estimator)
+
+	estimator, err = newFeeEstimator(&Config{
+		FeeSource: FeeSourceExternalURL,
+		FeeURL:    "https://example.com/fees.json",
+	}, walletKit)
+	require.NoError(t, err)
+	require.IsType(t, &externalFeeEstimator{}, estimator)
+
+	_, err = newFeeEstimator(&Config{FeeSource: "magic"}, walletKit)
+	require.ErrorContains(t, err, "unknown fee source")
+}
+
+// TestStaticFeeEstimator makes sure the static fee source returns the
+// configured fee rate for any confirmation target.
+func TestStaticFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	estimator, err := newFeeEstimator(&Config{
+		FeeSource:     FeeSourceStatic,
+		StaticFeeRate: 12,
+	}, nil)
+	require.NoError(t, err)
+
+	for _, confTarget := range []int32{1, 6, 144, 1008} {
+		feeRate, err := estimator.EstimateFeeRate(
+			context.Background(), confTarget,
+		)
+		require.NoError(t, err)
+		require.Equal(t, chainfee.SatPerKWeight(3000), feeRate)
+	}
+}
+
+// TestExternalFeeEstimator makes sure the estimate for the closest target is
+// picked from the response of the external fee estimation API.
+func TestExternalFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	var (
+		status   = http.StatusOK
+		response = `{"fee_by_block_target": {"2": 40000, "6": 20000, ` +
+			`"144": 4000}}`
+	)
+	api := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			_, _ = fmt.Fprint(w, response)
+		},
+	))
+	defer api.Close()
+
+	estimator := newExternalFeeEstimator(api.URL)
+	ctx := context.Background()
+
+	testCases := []struct {
+		confTarget int32
+		feeRate    chainfee.SatPerKWeight
+	}{
+		// Targets below the lowest known one use the most expensive
+		// estimate.
+		{confTarget: 1, feeRate: 10000},
+		{confTarget: 2, feeRate: 10000},
+		{confTarget: 6, feeRate: 5000},
+		{confTarget: 100, feeRate: 5000},
+		{confTarget: 1008, feeRate: 1000},
+	}
+	for _, tc := range testCases {
+		feeRate, err := estimator.EstimateFeeRate(ctx, tc.confTarget)
+		require.NoError(t, err)
+		require.Equal(t, tc.feeRate, feeRate, "conf target %d",
+			tc.confTarget)
+	}
+
+	response = `{"fee_by_block_target": {}}`
+	_, err := estimator.EstimateFeeRate(ctx, 6)
+	require.ErrorContains(t, err, "no estimates")
+
+	status = http.StatusInternalServerError
+	_, err = estimator.EstimateFeeRate(ctx, 6)
+	require.ErrorContains(t, err, "500")
+}
+
+// TestMaxFeeRate makes sure estimates above the configured maximum fee rate
+// are clamped or rejected, depending on the configured policy.
+func TestMaxFeeRate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Without a maximum, estimates aren't limited at all.
+	estimator, err := newFeeEstimator(&Config{
+		FeeSource:     FeeSourceStatic,
+		StaticFeeRate: 100,
+	}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &staticFeeEstimator{}, estimator)
+
+	var nilCeiling *feeRateCeiling
+	feeRate, err := nilCeiling.apply(25_000)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.SatPerKWeight(25_000), feeRate)
+
+	// Estimates above the maximum of 50 sat/vByte are lowered to it.
+	estimator, err = newFeeEstimator(&Config{
+		FeeSource:        FeeSourceStatic,
+		StaticFeeRate:    100,
+		MaxFeeRate:       50,
+		MaxFeeRatePolicy: MaxFeeRatePolicyClamp,
+	}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &cappedFeeEstimator{}, estimator)
+
+	feeRate, err = estimator.EstimateFeeRate(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.SatPerKWeight(12_500), feeRate)
+
+	// With the reject policy, they result in an error instead.
+	estimator, err = newFeeEstimator(&Config{
+		FeeSource:        FeeSourceStatic,
+		StaticFeeRate:    100,
+		MaxFeeRate:       50,
+		MaxFeeRatePolicy: MaxFeeRatePolicyReject,
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = estimator.EstimateFeeRate(ctx, 6)
+	require.ErrorContains(t, err, "exceeds the configured maximum")
+
+	// Estimates at or below the maximum are never touched.
+	estimator, err = newFeeEstimator(&Config{
+		FeeSource:        FeeSourceStatic,
+		StaticFeeRate:    50,
+		MaxFeeRate:       50,
+		MaxFeeRatePolicy: MaxFeeRatePolicyReject,
+	}, nil)
+	require.NoError(t, err)
+
+	feeRate, err = estimator.EstimateFeeRate(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.SatPerKWeight(12_500), feeRate)
+
+	// The maximum can't be lower than the minimum relay fee rate.
+	ceiling := newFeeRateCeiling(&Config{
+		MaxFeeRate:       1,
+		MaxFeeRatePolicy: MaxFeeRatePolicyClamp,
+	})
+	feeRate, err = ceiling.apply(chainfee.FeePerKwFloor)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.FeePerKwFloor, feeRate)
+
+	feeRate, err = ceiling.apply(1000)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.FeePerKwFloor, feeRate)
+}
@@ -15,13 +15,13 @@ import (
 // preparing for the orders in a batch. This should be called if for any reason
 // we need to reject the batch, so we're able to process any subsequent modified
 // batches.
-func CancelPendingFundingShims(matchedOrders map[order.Nonce][]*order.MatchedOrder,
+func CancelPendingFundingShims(ctx context.Context,
+	matchedOrders map[order.Nonce][]*order.MatchedOrder,
 	baseClient BaseClient, fetchOrder order.Fetcher) error {
 
 	// Since we support partial matches, a given bid of ours could've been
 	// matched with multiple asks, so we'll iterate through all those to
 	// ensure we unregister all the created shims.
-	ctxb := context.Background()
 	for ourOrderNonce, matchedOrders := range matchedOrders {
 		ourOrder, err := fetchOrder(ourOrderNonce)
 		if err != nil {
@@ -54,7 +54,7 @@ func CancelPendingFundingShims(matchedOrders map[order.Nonce][]*order.MatchedOrd
 			}
 
 			_, err = baseClient.FundingStateStep(
-				ctxb, &lnrpc.FundingTransitionMsg{
+				ctx, &lnrpc.FundingTransitionMsg{
 					Trigger: cancelShimMsg,
 				},
 			)
@@ -77,14 +77,14 @@ func CancelPendingFundingShims(matchedOrders map[order.Nonce][]*order.MatchedOrd
 // or extracting the channel outpoint went wrong and we should fail hard. If the
 // channel cannot be abandoned for some reason, the error is just logged but not
 // returned.
-func AbandonCanceledChannels(matchedOrders map[order.Nonce][]*order.MatchedOrder,
+func AbandonCanceledChannels(ctx context.Context,
+	matchedOrders map[order.Nonce][]*order.MatchedOrder,
 	batchTx *wire.MsgTx, wallet lndclient.WalletKitClient,
 	baseClient BaseClient, fetchOrder order.Fetcher) error {
 
 	// Since we support partial matches, a given bid of ours could've been
 	// matched with multiple asks, so we'll iterate through all those to
 	// ensure we remove all channels that never made it to chain.
-	ctxb := context.Background()
 	txHash := batchTx.TxHash()
 	for ourOrderNonce, matchedOrders := range matchedOrders {
 		ourOrder, err := fetchOrder(ourOrderNonce)
@@ -116,7 +116,7 @@ func AbandonCanceledChannels(matchedOrders map[order.Nonce][]*order.MatchedOrder
 				},
 			}
 			_, err = baseClient.AbandonChannel(
-				ctxb, &lnrpc.AbandonChannelRequest{
+				ctx, &lnrpc.AbandonChannelRequest{
 					ChannelPoint:           channelPoint,
 					PendingFundingShimOnly: true,
 				},
@@ -39,21 +39,38 @@ var (
 	rpcCodeFundingFailed = auctioneerrpc.OrderReject_CHANNEL_FUNDING_FAILED
 )
 
-// MatchRejectErr is an error type that is returned from the funding manager if
-// the trader rejects certain orders instead of the whole batch.
-type MatchRejectErr struct {
-	// RejectedOrders is the map of matches we reject, keyed with our order
-	// nonce.
-	RejectedOrders map[order.Nonce]*auctioneerrpc.OrderReject
-}
+const (
+	// DuplicateChannelPolicyAllow accepts matched orders with channels to
+	// peers the connected lnd node already has channels with.
+	DuplicateChannelPolicyAllow = "allow"
+
+	// DuplicateChannelPolicyReject rejects matched orders with channels to
+	// peers the connected lnd node already has open or pending channels
+	// with.
+	DuplicateChannelPolicyReject = "reject"
+)
 
-// Error returns the underlying error string.
-//
-// NOTE: This is part of the error interface.
-func (e *MatchRejectErr) Error() string {
-	return fmt.Sprintf("trader rejected orders: %v", e.RejectedOrders)
+// ValidateDuplicateChannelPolicy makes sure the given policy is one of the
+// supported duplicate channel policies. An empty policy is treated the same as
+// DuplicateChannelPolicyAllow.
+func ValidateDuplicateChannelPolicy(policy string) error {
+	switch policy {
+	case "", DuplicateChannelPolicyAllow, DuplicateChannelPolicyReject:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown duplicate channel policy %q, must "+
+			"be one of %s or %s", policy,
+			DuplicateChannelPolicyAllow,
+			DuplicateChannelPolicyReject)
+	}
 }
 
+// MatchRejectErr is an error type that is returned from the funding manager if
+// the trader rejects certain orders instead of the whole batch. The order
+// manager uses the same type for matches it rejects while validating a batch.
+type MatchRejectErr = order.MatchRejectErr
+
 // BaseClient is an interface that contains all methods necessary to open a
 // channel with a funding shim and query peer connections.
 type BaseClient interface {
@@ -124,6 +141,14 @@ type ManagerConfig struct {
 	// node doesn't already have channels with.
 	NewNodesOnly bool
 
+	// DuplicateChannelPolicy is the policy that decides whether matched
+	// orders with channels to nodes that the connected lnd node already
+	// has channels with are accepted. Must be one of the
+	// DuplicateChannelPolicy* constants. An empty value is treated the
+	// same as DuplicateChannelPolicyAllow. NewNodesOnly takes precedence
+	// over this policy.
+	DuplicateChannelPolicy string
+
 	// BatchStepTimeout is the timeout the manager uses when executing a
 	// single batch step.
 	BatchStepTimeout time.Duration
@@ -406,12 +431,10 @@ func (m *Manager) deriveFundingShim(ourOrder order.Order,
 // registerFundingShim is used when we're on the taker (our bid was executed)
 // side of a new matched order. To prepare ourselves for their incoming funding
 // request, we'll register a shim with all the expected parameters.
-func (m *Manager) registerFundingShim(ourBid *order.Bid,
+func (m *Manager) registerFundingShim(ctx context.Context, ourBid *order.Bid,
 	matchedOrder *order.MatchedOrder, batchTx *wire.MsgTx,
 	batchHeightHint uint32) error {
 
-	ctxb := context.Background()
-
 	fundingShim, pendingChanID, err := m.deriveFundingShim(
 		ourBid, matchedOrder, batchTx, batchHeightHint,
 	)
@@ -419,7 +442,7 @@ func (m *Manager) registerFundingShim(ourBid *order.Bid,
 		return err
 	}
 	_, err = m.cfg.BaseClient.FundingStateStep(
-		ctxb, &lnrpc.FundingTransitionMsg{
+		ctx, &lnrpc.FundingTransitionMsg{
 			Trigger: &lnrpc.FundingTransitionMsg_ShimRegister{
 				ShimRegister: fundingShim,
 			},
@@ -441,7 +464,7 @@ func (m *Manager) registerFundingShim(ourBid *order.Bid,
 
 // PrepChannelFunding preps the backing node to either receive or initiate a
 // channel funding based on the items in the order batch.
-func (m *Manager) PrepChannelFunding(batch *order.Batch,
+func (m *Manager) PrepChannelFunding(ctx context.Context, batch *order.Batch,
 	getOrder order.Fetcher) error {
 
 	log.Infof("Batch(%x): preparing channel funding for %v orders",
@@ -449,7 +472,7 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 
 	// As we need to change our behavior if the node has any Tor addresses,
 	// we'll fetch the current state of our advertised addrs now.
-	nodeInfo, err := m.cfg.LightningClient.GetInfo(context.Background())
+	nodeInfo, err := m.cfg.LightningClient.GetInfo(ctx)
 	if err != nil {
 		log.Errorf("error in GetInfo: %v", err)
 		return err
@@ -459,16 +482,16 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 	// We need to make sure our whole process doesn't take too long overall
 	// so we create a context that is valid for the whole funding step and
 	// use that everywhere.
-	setupCtx, cancel := context.WithTimeout(
-		context.Background(), m.cfg.BatchStepTimeout,
-	)
+	setupCtx, cancel := context.WithTimeout(ctx, m.cfg.BatchStepTimeout)
 	defer cancel()
 
 	// Before we connect out to peers, we check that we don't get any new
 	// channels from peers we already have channels with, in case this is
 	// requested by the trader.
-	if m.cfg.NewNodesOnly {
-		fundingRejects, err := m.rejectDuplicateChannels(batch)
+	if m.rejectsDuplicateChannels() {
+		fundingRejects, err := m.rejectDuplicateChannels(
+			setupCtx, batch,
+		)
 		if err != nil {
 			return err
 		}
@@ -565,7 +588,7 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 			// phase w/o any issues and accept the incoming channel
 			// from the asker.
 			err := m.registerFundingShim(
-				ourOrderBid, matchedOrder, batch.BatchTX,
+				setupCtx, ourOrderBid, matchedOrder, batch.BatchTX,
 				batch.HeightHint,
 			)
 			if err != nil {
@@ -983,12 +1006,12 @@ func (m *Manager) DeletePendingBatch() error {
 // be handled as terminal error.
 //
 // NOTE: This is part of the auctioneer.BatchCleaner interface.
-func (m *Manager) RemovePendingBatchArtifacts(
+func (m *Manager) RemovePendingBatchArtifacts(ctx context.Context,
 	matchedOrders map[order.Nonce][]*order.MatchedOrder,
 	batchTx *wire.MsgTx) error {
 
 	err := CancelPendingFundingShims(
-		matchedOrders, m.cfg.BaseClient, m.cfg.DB.GetOrder,
+		ctx, matchedOrders, m.cfg.BaseClient, m.cfg.DB.GetOrder,
 	)
 	if err != nil {
 		// CancelPendingFundingShims only returns hard errors that
@@ -1001,7 +1024,7 @@ func (m *Manager) RemovePendingBatchArtifacts(
 	// from a previous round of the same batch or a previous
 	// batch that we didn't make it into the final round.
 	err = AbandonCanceledChannels(
-		matchedOrders, batchTx, m.cfg.WalletKit, m.cfg.BaseClient,
+		ctx, matchedOrders, batchTx, m.cfg.WalletKit, m.cfg.BaseClient,
 		m.cfg.DB.GetOrder,
 	)
 	if err != nil {
@@ -1208,20 +1231,26 @@ func (m *Manager) waitForPeerConnections(ctx context.Context,
 	}
 }
 
+// rejectsDuplicateChannels returns true if matched orders with channels to
+// peers the trader already has channels with should be rejected.
+func (m *Manager) rejectsDuplicateChannels() bool {
+	return m.cfg.NewNodesOnly ||
+		m.cfg.DuplicateChannelPolicy == DuplicateChannelPolicyReject
+}
+
 // rejectDuplicateChannels gathers a list of matched orders that should be
 // rejected because they would create channels to peers that the trader already
 // has channels with.
-func (m *Manager) rejectDuplicateChannels(
+func (m *Manager) rejectDuplicateChannels(ctx context.Context,
 	batch *order.Batch) (map[order.Nonce]*auctioneerrpc.OrderReject, error) {
 
 	// We gather all peers from the open and pending channels.
-	ctxb := context.Background()
 	peers := make(map[route.Vertex]struct{})
-	openChans, err := m.cfg.LightningClient.ListChannels(ctxb, false, false)
+	openChans, err := m.cfg.LightningClient.ListChannels(ctx, false, false)
 	if err != nil {
 		return nil, fmt.Errorf("error listing open channels: %v", err)
 	}
-	pendingChans, err := m.cfg.LightningClient.PendingChannels(ctxb)
+	pendingChans, err := m.cfg.LightningClient.PendingChannels(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error listing pending channels: %v",
 			err)
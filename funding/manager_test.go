@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -124,6 +125,9 @@ type fundingBaseClientMock struct {
 	lightningClient *test.MockLightning
 	fundingShims    map[[32]byte]*lnrpc.ChanPointShim
 
+	openChanReqs    []*lnrpc.OpenChannelRequest
+	openChanReqsMtx sync.Mutex
+
 	peerList      map[route.Vertex]string
 	peerEvents    chan *lnrpc.PeerEvent
 	channelEvents chan *lnrpc.ChannelEventUpdate
@@ -167,6 +171,10 @@ func (m *fundingBaseClientMock) OpenChannel(ctx context.Context,
 		return nil, err
 	}
 
+	m.openChanReqsMtx.Lock()
+	m.openChanReqs = append(m.openChanReqs, req)
+	m.openChanReqsMtx.Unlock()
+
 	op, _ := m.lightningClient.OpenChannel(
 		ctx, node, btcutil.Amount(req.LocalFundingAmount),
 		btcutil.Amount(req.PushSat), false,
@@ -392,7 +400,9 @@ func TestFundingManager(t *testing.T) {
 	h.baseClientMock.peerList = map[route.Vertex]string{
 		node1Key: "1.1.1.1",
 	}
-	err = h.mgr.PrepChannelFunding(batch, h.db.GetOrder)
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
 	require.NoError(t, err)
 
 	// Verify we have the expected connections and funding shims registered.
@@ -425,7 +435,9 @@ func TestFundingManager(t *testing.T) {
 	h.lnMock.Channels = append(h.lnMock.Channels, lndclient.ChannelInfo{
 		PubKeyBytes: node1Key,
 	})
-	err = h.mgr.PrepChannelFunding(batch, h.db.GetOrder)
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
 	require.Error(t, err)
 
 	expectedErr := &MatchRejectErr{
@@ -439,11 +451,36 @@ func TestFundingManager(t *testing.T) {
 	}
 	require.Equal(t, expectedErr, err)
 
+	// Without the "new nodes only" flag, the duplicate channel policy
+	// decides whether the match with the node we already have a channel
+	// with is rejected.
+	h.mgr.cfg.NewNodesOnly = false
+	h.mgr.cfg.DuplicateChannelPolicy = DuplicateChannelPolicyReject
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
+	require.Equal(t, expectedErr, err)
+
+	h.mgr.cfg.DuplicateChannelPolicy = DuplicateChannelPolicyAllow
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
+	require.NoError(t, err)
+
+	// The "new nodes only" flag takes precedence over the allow policy.
+	h.mgr.cfg.NewNodesOnly = true
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
+	require.Equal(t, expectedErr, err)
+
 	// As a last check of the funding preparation, make sure we get a reject
 	// error if the connections to the remote peers couldn't be established.
 	h.mgr.cfg.NewNodesOnly = false
 	h.baseClientMock.peerList = make(map[route.Vertex]string)
-	err = h.mgr.PrepChannelFunding(batch, h.db.GetOrder)
+	err = h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	)
 	require.Error(t, err)
 
 	expectedErr = &MatchRejectErr{
@@ -489,6 +526,97 @@ func TestFundingManager(t *testing.T) {
 	require.Equal(t, expectedErr, err)
 }
 
+// TestBatchChannelSetupUnannounced makes sure the preference of a bidder for
+// an unannounced channel is forwarded to the channel open request of the
+// asker.
+func TestBatchChannelSetupUnannounced(t *testing.T) {
+	h := newManagerHarness(t)
+	defer h.stop()
+
+	_, pubKeyAsk := test.CreateKey(0)
+	_, pubKeyBid := test.CreateKey(1)
+	ask := &order.Ask{
+		Kit: newKitFromTemplate(order.Nonce{0x01}, &order.Kit{
+			MultiSigKeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyTowerSession,
+				Index:  0,
+			},
+			Units:            4,
+			UnitsUnfulfilled: 4,
+			FixedRate:        10000,
+			LeaseDuration:    2500,
+		}),
+	}
+	require.NoError(t, h.db.SubmitOrder(ask))
+	bid := &order.Bid{
+		Kit: newKitFromTemplate(order.Nonce{0x02}, &order.Kit{
+			MultiSigKeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyTowerSession,
+				Index:  1,
+			},
+			Units:            4,
+			UnitsUnfulfilled: 4,
+			FixedRate:        10000,
+			LeaseDuration:    2500,
+		}),
+		UnannouncedChannel: true,
+	}
+	require.NoError(t, h.db.SubmitOrder(bid))
+	matchedAsk := &order.MatchedOrder{
+		Order:       ask,
+		UnitsFilled: 4,
+		MultiSigKey: [33]byte{2, 3, 4},
+		NodeKey:     node1Key,
+		NodeAddrs:   []net.Addr{addr1},
+	}
+	matchedBid := &order.MatchedOrder{
+		Order:       bid,
+		UnitsFilled: 4,
+		MultiSigKey: [33]byte{3, 4, 5},
+		NodeKey:     node2Key,
+		NodeAddrs:   []net.Addr{addr2},
+	}
+
+	_, fundingOutput1, _ := input.GenFundingPkScript(
+		pubKeyAsk.SerializeCompressed(),
+		matchedBid.MultiSigKey[:], int64(4*order.BaseSupplyUnit),
+	)
+	_, fundingOutput2, _ := input.GenFundingPkScript(
+		pubKeyBid.SerializeCompressed(),
+		matchedAsk.MultiSigKey[:], int64(4*order.BaseSupplyUnit),
+	)
+	batch := &order.Batch{
+		ID: order.BatchID{9, 8, 7},
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			ask.Nonce(): {matchedBid},
+			bid.Nonce(): {matchedAsk},
+		},
+		BatchTX: &wire.MsgTx{
+			TxOut: []*wire.TxOut{fundingOutput1, fundingOutput2},
+		},
+	}
+
+	h.baseClientMock.peerList = map[route.Vertex]string{
+		node1Key: "1.1.1.1",
+	}
+	require.NoError(t, h.mgr.PrepChannelFunding(
+		context.Background(), batch, h.db.GetOrder,
+	))
+
+	h.lnMock.ScbKeyRing.EncryptionKey.PubKey = pubKeyAsk
+	callBatchChannelSetup(t, h, batch, false)
+
+	// The asker opens the channel, so the bidder's preference for an
+	// unannounced channel must be forwarded to the open request.
+	h.baseClientMock.openChanReqsMtx.Lock()
+	defer h.baseClientMock.openChanReqsMtx.Unlock()
+
+	require.NotEmpty(t, h.baseClientMock.openChanReqs)
+	for _, req := range h.baseClientMock.openChanReqs {
+		require.True(t, req.Private)
+	}
+}
+
 func callBatchChannelSetup(t *testing.T, h *managerHarness, batch *order.Batch,
 	sidecar bool) {
 
@@ -0,0 +1,17 @@
+package retry
+
+import "time"
+
+// NextBackoff doubles the given back off period, starting at the min back off
+// and never exceeding the max back off.
+func NextBackoff(backoff, minBackoff, maxBackoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff == 0 {
+		backoff = minBackoff
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,108 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lndConnection tracks the state of the connection to lnd as observed through
+// the long-lived block notification stream. The gRPC connection itself is
+// re-established transparently after lnd restarts, but all streams are
+// terminated and need to be registered again.
+type lndConnection struct {
+	clock clock.Clock
+
+	connected  bool
+	lastErr    error
+	changedAt  time.Time
+	reconnects uint32
+
+	mu sync.Mutex
+}
+
+// newLndConnection creates a new lnd connection state that starts out as
+// connected.
+func newLndConnection(clk clock.Clock) *lndConnection {
+	return &lndConnection{
+		clock:     clk,
+		connected: true,
+		changedAt: clk.Now(),
+	}
+}
+
+// setDisconnected marks lnd as disconnected because of the given error.
+func (c *lndConnection) setDisconnected(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastErr = err
+	if c.connected {
+		c.connected = false
+		c.changedAt = c.clock.Now()
+	}
+}
+
+// setConnected marks lnd as connected again after it was disconnected.
+func (c *lndConnection) setConnected() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.connected {
+		c.connected = true
+		c.changedAt = c.clock.Now()
+		c.reconnects++
+	}
+}
+
+// isConnected returns true if lnd is currently connected.
+func (c *lndConnection) isConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.connected
+}
+
+// rpcStatus returns the current connection state in its RPC representation.
+func (c *lndConnection) rpcStatus() *poolrpc.LndConnectionStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rpcStatus := &poolrpc.LndConnectionStatus{
+		Connected:     c.connected,
+		ChangedAtNs:   uint64(c.changedAt.UnixNano()),
+		NumReconnects: c.reconnects,
+	}
+	if c.lastErr != nil {
+		rpcStatus.LastError = c.lastErr.Error()
+	}
+
+	return rpcStatus
+}
+
+// unaryServerInterceptor returns a server interceptor that turns the errors
+// of calls that fail while lnd is disconnected into retryable errors with the
+// Unavailable code, so clients know to try again once poold has reconnected.
+func (c *lndConnection) unaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		resp, err := handler(ctx, req)
+		if err == nil || c.isConnected() ||
+			status.Code(err) == codes.Unavailable {
+
+			return resp, err
+		}
+
+		return nil, status.Errorf(codes.Unavailable, "lnd is "+
+			"currently unreachable and poold is reconnecting, "+
+			"please retry: %v", err)
+	}
+}
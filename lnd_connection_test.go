@@ -0,0 +1,164 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconnectTestNotifier is a fake chain notifier whose block epoch
+// registrations fail with the errors sent to its results channel. A nil error
+// makes the registration succeed and send the configured height.
+type reconnectTestNotifier struct {
+	lndclient.ChainNotifierClient
+
+	height  int32
+	results chan error
+}
+
+// RegisterBlockEpochNtfn fails or succeeds depending on the next result.
+func (n *reconnectTestNotifier) RegisterBlockEpochNtfn(
+	context.Context) (chan int32, chan error, error) {
+
+	if err := <-n.results; err != nil {
+		return nil, nil, err
+	}
+
+	blockChan := make(chan int32, 1)
+	blockChan <- n.height
+
+	return blockChan, make(chan error, 1), nil
+}
+
+// newReconnectTestServer creates an RPC server that reconnects to lnd through
+// the given notifier.
+func newReconnectTestServer(
+	notifier *reconnectTestNotifier) *rpcServer {
+
+	return &rpcServer{
+		server: &Server{
+			cfg: &Config{
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			},
+			lndConn: newLndConnection(clock.NewDefaultClock()),
+		},
+		lndServices: &lndclient.LndServices{
+			ChainNotifier: notifier,
+		},
+		blockNtfnCancel: func() {},
+		quit:            make(chan struct{}),
+	}
+}
+
+// TestReconnectLnd makes sure an lnd disconnect is reflected in the connection
+// status and turns errors of calls into retryable ones until the block
+// notifications could be registered again.
+func TestReconnectLnd(t *testing.T) {
+	t.Parallel()
+
+	notifier := &reconnectTestNotifier{
+		height:  700,
+		results: make(chan error),
+	}
+	srv := newReconnectTestServer(notifier)
+	lndConn := srv.server.lndConn
+
+	type reconnectResult struct {
+		blockChan chan int32
+		ok        bool
+	}
+	resultChan := make(chan reconnectResult, 1)
+	go func() {
+		blockChan, _, ok := srv.reconnectLnd(
+			errors.New("stream terminated"),
+		)
+		resultChan <- reconnectResult{blockChan: blockChan, ok: ok}
+	}()
+
+	// The first attempt fails because lnd is still down.
+	notifier.results <- status.Error(codes.Unavailable, "lnd down")
+
+	rpcStatus := lndConn.rpcStatus()
+	require.False(t, rpcStatus.Connected)
+	require.Zero(t, rpcStatus.NumReconnects)
+
+	// Calls failing in the meantime get a retryable error.
+	interceptor := lndConn.unaryServerInterceptor()
+	_, err := interceptor(
+		context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.New("unable to derive key")
+		},
+	)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "please retry")
+
+	// The next attempt succeeds and we're connected again.
+	notifier.results <- nil
+
+	select {
+	case result := <-resultChan:
+		require.True(t, result.ok)
+		require.NotNil(t, result.blockChan)
+
+	case <-time.After(time.Second):
+		t.Fatalf("lnd not reconnected")
+	}
+
+	rpcStatus = lndConn.rpcStatus()
+	require.True(t, rpcStatus.Connected)
+	require.EqualValues(t, 1, rpcStatus.NumReconnects)
+	require.Contains(t, rpcStatus.LastError, "lnd down")
+	require.EqualValues(t, 700, atomic.LoadUint32(&srv.bestHeight))
+
+	// Now that lnd is connected, errors are returned unchanged.
+	_, err = interceptor(
+		context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.New("unable to derive key")
+		},
+	)
+	require.Equal(t, codes.Unknown, status.Code(err))
+}
+
+// TestReconnectLndShutdown makes sure reconnecting to lnd is aborted if the
+// server shuts down.
+func TestReconnectLndShutdown(t *testing.T) {
+	t.Parallel()
+
+	notifier := &reconnectTestNotifier{
+		results: make(chan error),
+	}
+	srv := newReconnectTestServer(notifier)
+
+	// Make sure we're still backing off when shutting down.
+	srv.server.cfg.MinBackoff = time.Hour
+	srv.server.cfg.MaxBackoff = time.Hour
+
+	okChan := make(chan bool, 1)
+	go func() {
+		_, _, ok := srv.reconnectLnd(errors.New("stream terminated"))
+		okChan <- ok
+	}()
+
+	close(srv.quit)
+
+	select {
+	case ok := <-okChan:
+		require.False(t, ok)
+
+	case <-time.After(time.Second):
+		t.Fatalf("reconnect not aborted")
+	}
+	require.False(t, srv.server.lndConn.isConnected())
+}
@@ -0,0 +1,356 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// lsatInvalidTokenSuffix is appended to the file name of a paid token
+	// the server no longer accepts. The token is kept around so it still
+	// shows up in the list of tokens that were paid for.
+	lsatInvalidTokenSuffix = ".invalid."
+
+	// lsatMaxRoutingFeePPM is the highest proportional routing fee that
+	// can be configured for paying the LSAT token, which is the token's
+	// full cost.
+	lsatMaxRoutingFeePPM = 1_000_000
+)
+
+// invalidatingLsatStore is an LSAT token store that can remove a paid token
+// from being the current one.
+type invalidatingLsatStore interface {
+	lsat.Store
+
+	// InvalidateToken removes the current paid token so a new one is paid
+	// for on the next call that requires one.
+	InvalidateToken() error
+}
+
+// lsatFileStore is an LSAT file store that persists the paid token in the
+// pool data directory and can invalidate it if the server rejects it.
+type lsatFileStore struct {
+	*lsat.FileStore
+
+	dir string
+}
+
+// A compile-time flag to ensure that lsatFileStore implements the
+// invalidatingLsatStore interface.
+var _ invalidatingLsatStore = (*lsatFileStore)(nil)
+
+// newLsatFileStore creates a new LSAT file store in the given directory.
+func newLsatFileStore(dir string) (*lsatFileStore, error) {
+	fileStore, err := lsat.NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lsatFileStore{
+		FileStore: fileStore,
+		dir:       dir,
+	}, nil
+}
+
+// InvalidateToken moves the current paid token out of the way so the file
+// store no longer returns it as the current token.
+//
+// NOTE: This is part of the invalidatingLsatStore interface.
+func (s *lsatFileStore) InvalidateToken() error {
+	token, err := s.CurrentToken()
+	if err != nil {
+		return err
+	}
+	if token.Preimage == (lntypes.Preimage{}) {
+		return fmt.Errorf("cannot invalidate pending token")
+	}
+
+	// The file store doesn't tell us the name of the file it keeps the
+	// current token in, so we look it up among all tokens it knows of.
+	tokens, err := s.AllTokens()
+	if err != nil {
+		return err
+	}
+	for fileName, t := range tokens {
+		if t.PaymentHash != token.PaymentHash ||
+			t.Preimage == (lntypes.Preimage{}) ||
+			strings.Contains(fileName, lsatInvalidTokenSuffix) {
+
+			continue
+		}
+
+		invalidFileName := fmt.Sprintf(
+			"%s%s%x", fileName, lsatInvalidTokenSuffix,
+			token.PaymentHash[:],
+		)
+		return os.Rename(fileName, invalidFileName)
+	}
+
+	return fmt.Errorf("current LSAT token not found in %s", s.dir)
+}
+
+// DeleteToken removes the stored token with the given ID, no matter if it is
+// pending, paid or was invalidated before. If the current token is removed, a
+// new one is paid for on the next call that requires one.
+func (s *lsatFileStore) DeleteToken(tokenID lsat.TokenID) error {
+	tokens, err := s.AllTokens()
+	if err != nil {
+		return err
+	}
+
+	for fileName, token := range tokens {
+		macID, err := lsat.DecodeIdentifier(
+			bytes.NewReader(token.BaseMacaroon().Id()),
+		)
+		if err != nil {
+			return err
+		}
+		if macID.TokenID != tokenID {
+			continue
+		}
+
+		return os.Remove(fileName)
+	}
+
+	return fmt.Errorf("LSAT token %x not found", tokenID[:])
+}
+
+// lsatRenewInterceptor wraps an LSAT client interceptor that reuses the paid
+// token of its store for all calls. If the server rejects that token, it is
+// invalidated and the call is repeated once, which makes the wrapped
+// interceptor pay for a new token. Servers that never issue an LSAT challenge
+// never cause a payment, as a token is only paid for once a call is rejected.
+type lsatRenewInterceptor struct {
+	Interceptor
+
+	store invalidatingLsatStore
+
+	mu sync.Mutex
+}
+
+// A compile-time flag to ensure that lsatRenewInterceptor implements the
+// Interceptor interface.
+var _ Interceptor = (*lsatRenewInterceptor)(nil)
+
+// newLsatRenewInterceptor creates a new interceptor that renews the paid token
+// of the given store through the given LSAT interceptor once it is rejected.
+func newLsatRenewInterceptor(interceptor Interceptor,
+	store invalidatingLsatStore) *lsatRenewInterceptor {
+
+	return &lsatRenewInterceptor{
+		Interceptor: interceptor,
+		store:       store,
+	}
+}
+
+// UnaryInterceptor intercepts normal, non-streaming requests and renews the
+// LSAT token if the server rejects it.
+//
+// NOTE: This is part of the Interceptor interface.
+func (i *lsatRenewInterceptor) UnaryInterceptor(ctx context.Context,
+	method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	usedToken := i.paidTokenHash()
+	err := i.Interceptor.UnaryInterceptor(
+		ctx, method, req, reply, cc, invoker, opts...,
+	)
+	if !i.invalidateRejected(err, usedToken) {
+		return err
+	}
+
+	return i.Interceptor.UnaryInterceptor(
+		ctx, method, req, reply, cc, invoker, opts...,
+	)
+}
+
+// StreamInterceptor intercepts streaming requests and renews the LSAT token if
+// the server rejects it.
+//
+// NOTE: This is part of the Interceptor interface.
+func (i *lsatRenewInterceptor) StreamInterceptor(ctx context.Context,
+	desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream,
+	error) {
+
+	usedToken := i.paidTokenHash()
+	stream, err := i.Interceptor.StreamInterceptor(
+		ctx, desc, cc, method, streamer, opts...,
+	)
+	if !i.invalidateRejected(err, usedToken) {
+		return stream, err
+	}
+
+	return i.Interceptor.StreamInterceptor(
+		ctx, desc, cc, method, streamer, opts...,
+	)
+}
+
+// paidTokenHash returns the payment hash of the current paid token or nil if
+// there is no paid token.
+func (i *lsatRenewInterceptor) paidTokenHash() *lntypes.Hash {
+	token, err := i.store.CurrentToken()
+	if err != nil || token.Preimage == (lntypes.Preimage{}) {
+		return nil
+	}
+
+	return &token.PaymentHash
+}
+
+// invalidateRejected invalidates the paid token that was used for a call if
+// the call still failed with a payment required error. True is returned if the
+// call should be repeated with a new token.
+func (i *lsatRenewInterceptor) invalidateRejected(err error,
+	usedToken *lntypes.Hash) bool {
+
+	if usedToken == nil || !isLsatPaymentRequired(err) {
+		return false
+	}
+
+	// Parallel calls might all be rejected with the same token. Only the
+	// first one invalidates it, the others just repeat their call with the
+	// new token.
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	currentToken := i.paidTokenHash()
+	if currentToken == nil || *currentToken != *usedToken {
+		return true
+	}
+
+	log.Warnf("LSAT token %x was rejected by the auction server, paying "+
+		"for a new one", usedToken[:])
+
+	if err := i.store.InvalidateToken(); err != nil {
+		log.Errorf("Unable to invalidate rejected LSAT token: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// lsatPaymentClient is a lightning client that gives up on paying an invoice
+// if the payment doesn't complete within a timeout. It is used to pay for LSAT
+// tokens so a payment with poor routing doesn't block requests to the auction
+// server for too long. It also limits the routing fee to a proportion of the
+// token's cost.
+type lsatPaymentClient struct {
+	lndclient.LightningClient
+
+	timeout     time.Duration
+	maxFeePPM   uint64
+	chainParams *chaincfg.Params
+}
+
+// newLsatPaymentClient creates a new lightning client that limits payments to
+// the given timeout and routing fee in parts per million of the paid amount.
+// A zero timeout or proportional fee isn't enforced.
+func newLsatPaymentClient(client lndclient.LightningClient,
+	timeout time.Duration, maxFeePPM uint64,
+	chainParams *chaincfg.Params) *lsatPaymentClient {
+
+	return &lsatPaymentClient{
+		LightningClient: client,
+		timeout:         timeout,
+		maxFeePPM:       maxFeePPM,
+		chainParams:     chainParams,
+	}
+}
+
+// PayInvoice pays the given invoice and returns an error result if the payment
+// doesn't complete within the timeout.
+func (c *lsatPaymentClient) PayInvoice(ctx context.Context, invoice string,
+	maxFee btcutil.Amount,
+	outgoingChannel *uint64) chan lndclient.PaymentResult {
+
+	if c.maxFeePPM > 0 {
+		decoded, err := zpay32.Decode(invoice, c.chainParams)
+		if err != nil {
+			resultChan := make(chan lndclient.PaymentResult, 1)
+			resultChan <- lndclient.PaymentResult{
+				Err: fmt.Errorf("unable to decode LSAT "+
+					"invoice: %v", err),
+			}
+			return resultChan
+		}
+
+		// Invoices without an amount can only be limited by the
+		// absolute routing fee.
+		if decoded.MilliSat != nil {
+			maxFee = lsatMaxRoutingFee(
+				maxFee, c.maxFeePPM, *decoded.MilliSat,
+			)
+		}
+	}
+
+	if c.timeout == 0 {
+		return c.LightningClient.PayInvoice(
+			ctx, invoice, maxFee, outgoingChannel,
+		)
+	}
+
+	payCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	respChan := c.LightningClient.PayInvoice(
+		payCtx, invoice, maxFee, outgoingChannel,
+	)
+
+	resultChan := make(chan lndclient.PaymentResult, 1)
+	go func() {
+		defer cancel()
+
+		select {
+		case result := <-respChan:
+			resultChan <- result
+
+		case <-payCtx.Done():
+			resultChan <- lndclient.PaymentResult{
+				Err: fmt.Errorf("LSAT payment didn't "+
+					"complete within %v, check the "+
+					"routing of lnd to the auction "+
+					"server", c.timeout),
+			}
+		}
+	}()
+
+	return resultChan
+}
+
+// lsatMaxRoutingFee returns the routing fee limit for paying an LSAT token of
+// the given cost, which is the lower of the absolute limit and the limit in
+// parts per million of the cost. The proportional limit is rounded down to
+// full satoshis.
+func lsatMaxRoutingFee(maxFee btcutil.Amount, maxFeePPM uint64,
+	tokenCost lnwire.MilliSatoshi) btcutil.Amount {
+
+	propFee := lnwire.MilliSatoshi(
+		uint64(tokenCost) * maxFeePPM / lsatMaxRoutingFeePPM,
+	).ToSatoshis()
+	if propFee < maxFee {
+		return propFee
+	}
+
+	return maxFee
+}
+
+// isLsatPaymentRequired returns true if the given error is the gRPC error the
+// auction server returns if no valid LSAT token was presented.
+func isLsatPaymentRequired(err error) bool {
+	statusErr, ok := status.FromError(err)
+	return ok && statusErr.Code() == lsat.GRPCErrCode &&
+		statusErr.Message() == lsat.GRPCErrMessage
+}
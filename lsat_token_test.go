@@ -0,0 +1,680 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gopkg.in/macaroon.v2"
+)
+
+// testLsatTokenFileName is the name of the file aperture's LSAT file store
+// keeps the current paid token in.
+const testLsatTokenFileName = "lsat.token"
+
+// mockLsatStore is an in-memory LSAT token store.
+type mockLsatStore struct {
+	token       *lsat.Token
+	invalidated []*lsat.Token
+}
+
+func (s *mockLsatStore) CurrentToken() (*lsat.Token, error) {
+	if s.token == nil {
+		return nil, lsat.ErrNoToken
+	}
+	return s.token, nil
+}
+
+func (s *mockLsatStore) AllTokens() (map[string]*lsat.Token, error) {
+	return map[string]*lsat.Token{"current": s.token}, nil
+}
+
+func (s *mockLsatStore) StoreToken(token *lsat.Token) error {
+	s.token = token
+	return nil
+}
+
+func (s *mockLsatStore) RemovePendingToken() error {
+	s.token = nil
+	return nil
+}
+
+func (s *mockLsatStore) InvalidateToken() error {
+	s.invalidated = append(s.invalidated, s.token)
+	s.token = nil
+	return nil
+}
+
+// fakeLsatInterceptor mimics the LSAT client interceptor by paying for a new
+// token if the store doesn't contain one and otherwise attaching the current
+// token. Tokens the server rejects result in a payment required error.
+type fakeLsatInterceptor struct {
+	store    lsat.Store
+	pay      func(payment int) error
+	rejected map[lntypes.Hash]bool
+	payments int
+	used     []lntypes.Hash
+}
+
+// newFakeLsatInterceptor creates a fake LSAT interceptor that stores a new
+// in-memory token for every payment.
+func newFakeLsatInterceptor(store lsat.Store) *fakeLsatInterceptor {
+	return &fakeLsatInterceptor{
+		store: store,
+		pay: func(payment int) error {
+			return store.StoreToken(&lsat.Token{
+				PaymentHash: lntypes.Hash{byte(payment)},
+				Preimage:    lntypes.Preimage{byte(payment)},
+			})
+		},
+		rejected: make(map[lntypes.Hash]bool),
+	}
+}
+
+func (f *fakeLsatInterceptor) currentToken() (*lsat.Token, error) {
+	token, err := f.store.CurrentToken()
+	if err == lsat.ErrNoToken {
+		f.payments++
+		if err := f.pay(f.payments); err != nil {
+			return nil, err
+		}
+		token, err = f.store.CurrentToken()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.used = append(f.used, token.PaymentHash)
+	if f.rejected[token.PaymentHash] {
+		return nil, status.Error(lsat.GRPCErrCode, lsat.GRPCErrMessage)
+	}
+
+	return token, nil
+}
+
+func (f *fakeLsatInterceptor) UnaryInterceptor(ctx context.Context,
+	method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	if _, err := f.currentToken(); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (f *fakeLsatInterceptor) StreamInterceptor(ctx context.Context,
+	desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream,
+	error) {
+
+	if _, err := f.currentToken(); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+var (
+	cachedLsatToken = &lsat.Token{
+		PaymentHash: lntypes.Hash{99},
+		Preimage:    lntypes.Preimage{99},
+	}
+
+	lsatRenewTestCases = []struct {
+		name                string
+		cachedToken         *lsat.Token
+		rejectCached        bool
+		expectedPayments    int
+		expectedInvalidated int
+	}{{
+		name:             "no cached token",
+		expectedPayments: 1,
+	}, {
+		name:        "cached valid token reused",
+		cachedToken: cachedLsatToken,
+	}, {
+		name:                "cached token rejected",
+		cachedToken:         cachedLsatToken,
+		rejectCached:        true,
+		expectedPayments:    1,
+		expectedInvalidated: 1,
+	}}
+)
+
+// TestLsatRenewInterceptor makes sure a cached LSAT token is reused as long as
+// the server accepts it and a rejected one is replaced by paying for a new one.
+func TestLsatRenewInterceptor(t *testing.T) {
+	for _, tc := range lsatRenewTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &mockLsatStore{token: tc.cachedToken}
+			inner := newFakeLsatInterceptor(store)
+			if tc.rejectCached {
+				cachedHash := cachedLsatToken.PaymentHash
+				inner.rejected[cachedHash] = true
+			}
+			interceptor := newLsatRenewInterceptor(inner, store)
+
+			var unaryCalls, streamCalls int
+			err := interceptor.UnaryInterceptor(
+				context.Background(), "/test/Unary", nil, nil,
+				nil, func(context.Context, string, interface{},
+					interface{}, *grpc.ClientConn,
+					...grpc.CallOption) error {
+
+					unaryCalls++
+					return nil
+				},
+			)
+			require.NoError(t, err)
+
+			_, err = interceptor.StreamInterceptor(
+				context.Background(), &grpc.StreamDesc{}, nil,
+				"/test/Stream", func(context.Context,
+					*grpc.StreamDesc, *grpc.ClientConn,
+					string, ...grpc.CallOption) (
+					grpc.ClientStream, error) {
+
+					streamCalls++
+					return nil, nil
+				},
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, 1, unaryCalls)
+			require.Equal(t, 1, streamCalls)
+			require.Equal(t, tc.expectedPayments, inner.payments)
+			require.Len(
+				t, store.invalidated, tc.expectedInvalidated,
+			)
+
+			// The last call must have used the token that is now
+			// stored.
+			require.NotNil(t, store.token)
+			lastUsed := inner.used[len(inner.used)-1]
+			require.Equal(t, store.token.PaymentHash, lastUsed)
+			if tc.cachedToken != nil && !tc.rejectCached {
+				require.Equal(
+					t, []lntypes.Hash{
+						cachedLsatToken.PaymentHash,
+						cachedLsatToken.PaymentHash,
+					}, inner.used,
+				)
+			}
+		})
+	}
+}
+
+// writeTestLsatToken writes a paid LSAT token with the given token ID and
+// amounts to the token file of the given directory, serialized the same way
+// the LSAT file store does.
+func writeTestLsatToken(t *testing.T, dir string, tokenID lsat.TokenID,
+	amountPaid, routingFeePaid lnwire.MilliSatoshi) lntypes.Preimage {
+
+	preimage := lntypes.Preimage(sha256.Sum256(tokenID[:]))
+	paymentHash := preimage.Hash()
+
+	var id bytes.Buffer
+	err := lsat.EncodeIdentifier(&id, &lsat.Identifier{
+		Version:     lsat.LatestVersion,
+		PaymentHash: paymentHash,
+		TokenID:     tokenID,
+	})
+	require.NoError(t, err)
+
+	mac, err := macaroon.New(
+		[]byte("root key"), id.Bytes(), "pool", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	for _, field := range []interface{}{
+		uint32(len(macBytes)), macBytes, paymentHash, preimage,
+		amountPaid, routingFeePaid, time.Now().UnixNano(),
+	} {
+		require.NoError(t, binary.Write(&b, binary.BigEndian, field))
+	}
+
+	err = ioutil.WriteFile(
+		filepath.Join(dir, testLsatTokenFileName), b.Bytes(), 0600,
+	)
+	require.NoError(t, err)
+
+	return preimage
+}
+
+// TestMarshallLsatTokens makes sure all stored LSAT tokens are listed,
+// including tokens that were invalidated, and only the token in use is marked
+// as current.
+func TestMarshallLsatTokens(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := newLsatFileStore(dir)
+	require.NoError(t, err)
+
+	// Without any token, the list is empty.
+	tokens, err := marshallLsatTokens(store)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
+	// Store a first token and invalidate it, then store a second one that
+	// replaces it.
+	oldID := lsat.TokenID{1}
+	oldPreimage := writeTestLsatToken(t, dir, oldID, 1000, 10)
+	require.NoError(t, store.InvalidateToken())
+
+	newID := lsat.TokenID{2}
+	newPreimage := writeTestLsatToken(t, dir, newID, 2000, 20)
+
+	tokens, err = marshallLsatTokens(store)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	byID := make(map[lsat.TokenID]*poolrpc.LsatToken, len(tokens))
+	for _, token := range tokens {
+		var id lsat.TokenID
+		copy(id[:], token.TokenId)
+		byID[id] = token
+	}
+
+	oldToken := byID[oldID]
+	require.NotNil(t, oldToken)
+	require.False(t, oldToken.Current)
+	require.Equal(t, oldPreimage[:], oldToken.PaymentPreimage)
+	require.EqualValues(t, 1000, oldToken.AmountPaidMsat)
+	require.EqualValues(t, 10, oldToken.RoutingFeePaidMsat)
+	require.Contains(t, oldToken.StorageName, lsatInvalidTokenSuffix)
+
+	newToken := byID[newID]
+	require.NotNil(t, newToken)
+	require.True(t, newToken.Current)
+	newHash := newPreimage.Hash()
+	require.Equal(t, newHash[:], newToken.PaymentHash)
+	require.Equal(t, newPreimage[:], newToken.PaymentPreimage)
+	require.EqualValues(t, 2000, newToken.AmountPaidMsat)
+	require.EqualValues(t, 20, newToken.RoutingFeePaidMsat)
+	require.Equal(
+		t, filepath.Join(dir, testLsatTokenFileName), newToken.StorageName,
+	)
+}
+
+// TestDeleteLsatToken makes sure a deleted LSAT token is no longer listed and
+// a new token is paid for on the next call.
+func TestDeleteLsatToken(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := newLsatFileStore(dir)
+	require.NoError(t, err)
+	srv := &rpcServer{
+		server: &Server{
+			lsatStore: store,
+		},
+	}
+
+	oldID := lsat.TokenID{1}
+	oldPreimage := writeTestLsatToken(t, dir, oldID, 1000, 10)
+
+	// The token ID must be complete.
+	_, err = srv.DeleteLsatToken(
+		context.Background(), &poolrpc.DeleteLsatTokenRequest{
+			TokenId: oldID[:5],
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid token ID length")
+
+	// Deleting an unknown token fails.
+	unknownID := lsat.TokenID{9}
+	_, err = srv.DeleteLsatToken(
+		context.Background(), &poolrpc.DeleteLsatTokenRequest{
+			TokenId: unknownID[:],
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	_, err = srv.DeleteLsatToken(
+		context.Background(), &poolrpc.DeleteLsatTokenRequest{
+			TokenId: oldID[:],
+		},
+	)
+	require.NoError(t, err)
+
+	resp, err := srv.GetLsatTokens(
+		context.Background(), &poolrpc.TokensRequest{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp.Tokens)
+
+	// The next call that requires a token must pay for a new one.
+	newID := lsat.TokenID{2}
+	inner := newFakeLsatInterceptor(store)
+	inner.pay = func(int) error {
+		_ = writeTestLsatToken(t, dir, newID, 2000, 20)
+		return nil
+	}
+	interceptor := newLsatRenewInterceptor(inner, store)
+	err = interceptor.UnaryInterceptor(
+		context.Background(), "/test/Unary", nil, nil, nil,
+		func(context.Context, string, interface{}, interface{},
+			*grpc.ClientConn, ...grpc.CallOption) error {
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, inner.payments)
+
+	resp, err = srv.GetLsatTokens(
+		context.Background(), &poolrpc.TokensRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Tokens, 1)
+	require.Equal(t, newID[:], resp.Tokens[0].TokenId)
+	require.True(t, resp.Tokens[0].Current)
+	require.NotEqual(t, oldPreimage[:], resp.Tokens[0].PaymentPreimage)
+}
+
+// slowPaymentClient is a lightning client that only completes a payment after
+// a delay.
+type slowPaymentClient struct {
+	lndclient.LightningClient
+
+	delay time.Duration
+}
+
+func (c *slowPaymentClient) PayInvoice(ctx context.Context, _ string,
+	_ btcutil.Amount, _ *uint64) chan lndclient.PaymentResult {
+
+	resultChan := make(chan lndclient.PaymentResult, 1)
+	go func() {
+		select {
+		case <-time.After(c.delay):
+			resultChan <- lndclient.PaymentResult{
+				Preimage: lntypes.Preimage{1},
+			}
+
+		case <-ctx.Done():
+		}
+	}()
+
+	return resultChan
+}
+
+var lsatPaymentTimeoutTestCases = []struct {
+	name        string
+	delay       time.Duration
+	expectedErr string
+}{{
+	name:  "payment completes in time",
+	delay: 0,
+}, {
+	name:        "payment times out",
+	delay:       time.Hour,
+	expectedErr: "LSAT payment didn't complete within",
+}}
+
+// TestLsatPaymentTimeout makes sure an LSAT payment that doesn't complete
+// within the configured timeout fails with an error.
+func TestLsatPaymentTimeout(t *testing.T) {
+	for _, tc := range lsatPaymentTimeoutTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newLsatPaymentClient(
+				&slowPaymentClient{delay: tc.delay},
+				50*time.Millisecond, 0, nil,
+			)
+			respChan := client.PayInvoice(
+				context.Background(), "invoice", 0, nil,
+			)
+
+			var result lndclient.PaymentResult
+			select {
+			case result = <-respChan:
+			case <-time.After(time.Second):
+				t.Fatal("no payment result received")
+			}
+
+			if tc.expectedErr == "" {
+				require.NoError(t, result.Err)
+				require.Equal(
+					t, lntypes.Preimage{1}, result.Preimage,
+				)
+				return
+			}
+
+			require.Error(t, result.Err)
+			require.Contains(t, result.Err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// TestLsatMaxRoutingFee makes sure the tighter of the absolute and the
+// proportional routing fee limits applies to LSAT payments.
+func TestLsatMaxRoutingFee(t *testing.T) {
+	testCases := []struct {
+		name      string
+		maxFee    btcutil.Amount
+		maxFeePPM uint64
+		tokenCost lnwire.MilliSatoshi
+		expected  btcutil.Amount
+	}{{
+		name:      "proportional limit is tighter",
+		maxFee:    50,
+		maxFeePPM: 5_000,
+		tokenCost: 1_000_000,
+		expected:  5,
+	}, {
+		name:      "absolute limit is tighter",
+		maxFee:    50,
+		maxFeePPM: 100_000,
+		tokenCost: 1_000_000,
+		expected:  50,
+	}, {
+		name:      "proportional limit is rounded down",
+		maxFee:    50,
+		maxFeePPM: 5_000,
+		tokenCost: 1_999_000,
+		expected:  9,
+	}, {
+		name:      "full cost",
+		maxFee:    2_000,
+		maxFeePPM: lsatMaxRoutingFeePPM,
+		tokenCost: 1_000_000,
+		expected:  1_000,
+	}}
+	for _, tc := range testCases {
+		fee := lsatMaxRoutingFee(tc.maxFee, tc.maxFeePPM, tc.tokenCost)
+		require.Equal(t, tc.expected, fee, tc.name)
+	}
+}
+
+// feeRecordingPaymentClient is a lightning client that records the maximum
+// routing fee of the payments it is asked to make.
+type feeRecordingPaymentClient struct {
+	lndclient.LightningClient
+
+	maxFee btcutil.Amount
+}
+
+func (c *feeRecordingPaymentClient) PayInvoice(_ context.Context, _ string,
+	maxFee btcutil.Amount, _ *uint64) chan lndclient.PaymentResult {
+
+	c.maxFee = maxFee
+
+	resultChan := make(chan lndclient.PaymentResult, 1)
+	resultChan <- lndclient.PaymentResult{Preimage: lntypes.Preimage{1}}
+	return resultChan
+}
+
+// TestLsatPaymentMaxFeePPM makes sure the LSAT payment client limits the
+// routing fee to the configured proportion of the invoice amount.
+func TestLsatPaymentMaxFeePPM(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	params := &chaincfg.RegressionNetParams
+	newInvoice := func(opts ...func(*zpay32.Invoice)) string {
+		opts = append(opts, zpay32.Description("LSAT"))
+		invoice, err := zpay32.NewInvoice(
+			params, lntypes.Hash{1}, time.Now(), opts...,
+		)
+		require.NoError(t, err)
+
+		invoiceStr, err := invoice.Encode(zpay32.MessageSigner{
+			SignCompact: func(msg []byte) ([]byte, error) {
+				hash := chainhash.HashB(msg)
+				return ecdsa.SignCompact(privKey, hash, true)
+			},
+		})
+		require.NoError(t, err)
+
+		return invoiceStr
+	}
+
+	lnd := &feeRecordingPaymentClient{}
+	client := newLsatPaymentClient(lnd, 0, 5_000, params)
+
+	// A 1000 sat token can cost at most 5 sat in routing fees.
+	result := <-client.PayInvoice(
+		context.Background(), newInvoice(zpay32.Amount(1_000_000)), 50,
+		nil,
+	)
+	require.NoError(t, result.Err)
+	require.Equal(t, btcutil.Amount(5), lnd.maxFee)
+
+	// If the absolute limit is tighter, it is used instead.
+	result = <-client.PayInvoice(
+		context.Background(), newInvoice(zpay32.Amount(1_000_000)), 2,
+		nil,
+	)
+	require.NoError(t, result.Err)
+	require.Equal(t, btcutil.Amount(2), lnd.maxFee)
+
+	// Invoices without an amount are only limited by the absolute limit.
+	result = <-client.PayInvoice(
+		context.Background(), newInvoice(), 50, nil,
+	)
+	require.NoError(t, result.Err)
+	require.Equal(t, btcutil.Amount(50), lnd.maxFee)
+
+	// Invalid invoices aren't paid at all.
+	lnd.maxFee = 0
+	result = <-client.PayInvoice(context.Background(), "invoice", 50, nil)
+	require.ErrorContains(t, result.Err, "unable to decode LSAT invoice")
+	require.Zero(t, lnd.maxFee)
+}
+
+// noChallengeServer is an auction server that answers terms requests without
+// ever issuing an LSAT challenge.
+type noChallengeServer struct {
+	auctioneerrpc.UnimplementedChannelAuctioneerServer
+}
+
+func (s *noChallengeServer) Terms(context.Context,
+	*auctioneerrpc.TermsRequest) (*auctioneerrpc.TermsResponse, error) {
+
+	return &auctioneerrpc.TermsResponse{}, nil
+}
+
+// noPaymentClient is a lightning client that fails the test if any payment is
+// attempted.
+type noPaymentClient struct {
+	lndclient.LightningClient
+
+	t *testing.T
+}
+
+func (c *noPaymentClient) PayInvoice(context.Context, string, btcutil.Amount,
+	*uint64) chan lndclient.PaymentResult {
+
+	c.t.Errorf("unexpected LSAT payment")
+
+	resultChan := make(chan lndclient.PaymentResult, 1)
+	resultChan <- lndclient.PaymentResult{
+		Err: fmt.Errorf("unexpected payment"),
+	}
+	return resultChan
+}
+
+// TestLsatNoChallenge makes sure no LSAT token is paid for if the auction
+// server doesn't issue a challenge.
+func TestLsatNoChallenge(t *testing.T) {
+	t.Parallel()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	auctioneerrpc.RegisterChannelAuctioneerServer(
+		server, &noChallengeServer{},
+	)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	store, err := newLsatFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	lnd := &lndclient.LndServices{
+		Client: &noPaymentClient{t: t},
+	}
+	interceptor := newLsatRenewInterceptor(
+		lsat.NewInterceptor(
+			lnd, store, time.Second, defaultLsatMaxCost,
+			defaultLsatMaxFee, false,
+		), store,
+	)
+
+	conn, err := grpc.Dial(
+		"bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context,
+			string) (net.Conn, error) {
+
+			return listener.Dial()
+		}),
+		grpc.WithUnaryInterceptor(interceptor.UnaryInterceptor),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := auctioneerrpc.NewChannelAuctioneerClient(conn)
+	for i := 0; i < 2; i++ {
+		_, err := client.Terms(
+			context.Background(), &auctioneerrpc.TermsRequest{},
+		)
+		require.NoError(t, err)
+	}
+
+	_, err = store.CurrentToken()
+	require.Equal(t, lsat.ErrNoToken, err)
+}
@@ -1,5 +1,18 @@
 package pool
 
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/lightninglabs/pool/perms"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
 const (
 	// poolMacaroonLocation is the value we use for the pool macaroons'
 	// "Location" field when baking them.
@@ -18,3 +31,98 @@ var (
 	// though.
 	macDbDefaultPw = []byte("")
 )
+
+// lndMacaroonPermissions reads the lnd macaroon file at the given path and
+// returns the set of permissions encoded in its ID, formatted as
+// "entity:action".
+func lndMacaroonPermissions(macPath string) (map[string]struct{}, error) {
+	macBytes, err := ioutil.ReadFile(macPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon %s: %v", macPath,
+			err)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon %s: %v",
+			macPath, err)
+	}
+
+	rawID := mac.Id()
+	if len(rawID) == 0 || rawID[0] != byte(bakery.LatestVersion) {
+		return nil, fmt.Errorf("invalid macaroon version in %s",
+			macPath)
+	}
+
+	decodedID := &lnrpc.MacaroonId{}
+	if err := proto.Unmarshal(rawID[1:], decodedID); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon ID of %s: %v",
+			macPath, err)
+	}
+
+	permissions := make(map[string]struct{})
+	for _, op := range decodedID.Ops {
+		for _, action := range op.Actions {
+			permissions[op.Entity+":"+action] = struct{}{}
+		}
+	}
+
+	return permissions, nil
+}
+
+// selectLndMacaroon returns the first of the given lnd macaroon files that
+// contains all permissions poold requires. Macaroons can't be merged because
+// each of them is signed on its own, so one of them needs to cover all
+// permissions. If none does, the returned error lists the permissions that are
+// missing from all macaroons combined or, if they cover all permissions
+// together, tells the user to bake a single macaroon with exactly those.
+func selectLndMacaroon(macPaths []string) (string, error) {
+	required := make([]string, 0, len(perms.RequiredLndPermissions))
+	for _, op := range perms.RequiredLndPermissions {
+		required = append(required, op.Entity+":"+op.Action)
+	}
+
+	combined := make(map[string]struct{})
+	for _, macPath := range macPaths {
+		permissions, err := lndMacaroonPermissions(macPath)
+		if err != nil {
+			return "", err
+		}
+
+		if len(missingPermissions(required, permissions)) == 0 {
+			return macPath, nil
+		}
+
+		for permission := range permissions {
+			combined[permission] = struct{}{}
+		}
+	}
+
+	missing := missingPermissions(required, combined)
+	if len(missing) > 0 {
+		return "", fmt.Errorf("the given lnd macaroons are missing "+
+			"the permissions %s", strings.Join(missing, " "))
+	}
+
+	return "", fmt.Errorf("the given lnd macaroons only cover all "+
+		"required permissions together, but macaroons can't be "+
+		"combined; please bake a single macaroon with "+
+		"'lncli bakemacaroon %s' and use it as --lnd.macaroonpath",
+		strings.Join(required, " "))
+}
+
+// missingPermissions returns the sorted list of the required permissions that
+// are not contained in the given set.
+func missingPermissions(required []string,
+	permissions map[string]struct{}) []string {
+
+	var missing []string
+	for _, permission := range required {
+		if _, ok := permissions[permission]; !ok {
+			missing = append(missing, permission)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
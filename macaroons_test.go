@@ -0,0 +1,150 @@
+package pool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+var (
+	// adminPermissions are all permissions poold requires from lnd.
+	adminPermissions = []string{
+		"info:read", "onchain:read", "onchain:write", "offchain:read",
+		"offchain:write", "address:read", "address:write",
+		"peers:read", "peers:write", "signer:read", "signer:generate",
+	}
+
+	// subserverMacaroons are macaroons that resemble the ones lnd bakes
+	// for its subservers by default.
+	subserverMacaroons = map[string][]string{
+		"readonly.macaroon": {
+			"info:read", "onchain:read", "offchain:read",
+			"address:read", "peers:read",
+		},
+		"walletkit.macaroon": {
+			"onchain:write", "address:write",
+		},
+		"signer.macaroon": {
+			"signer:read", "signer:generate",
+		},
+		"router.macaroon": {
+			"offchain:write", "peers:write",
+		},
+		"chainnotifier.macaroon": {
+			"onchain:read",
+		},
+	}
+)
+
+var selectLndMacaroonTestCases = []struct {
+	name        string
+	macaroons   []string
+	expected    string
+	expectedErr string
+}{{
+	name:      "admin macaroon",
+	macaroons: []string{"admin.macaroon"},
+	expected:  "admin.macaroon",
+}, {
+	name: "admin macaroon among subserver macaroons",
+	macaroons: []string{
+		"readonly.macaroon", "signer.macaroon", "admin.macaroon",
+		"walletkit.macaroon",
+	},
+	expected: "admin.macaroon",
+}, {
+	name: "subserver macaroons cover permissions together",
+	macaroons: []string{
+		"readonly.macaroon", "walletkit.macaroon", "signer.macaroon",
+		"router.macaroon",
+	},
+	expectedErr: "only cover all required permissions together",
+}, {
+	name: "subserver macaroons missing permissions",
+	macaroons: []string{
+		"readonly.macaroon", "chainnotifier.macaroon",
+		"signer.macaroon",
+	},
+	expectedErr: "missing the permissions address:write " +
+		"offchain:write onchain:write peers:write",
+}, {
+	name:        "macaroon not found",
+	macaroons:   []string{"unknown.macaroon"},
+	expectedErr: "unable to read macaroon",
+}}
+
+// TestSelectLndMacaroon makes sure the first of the given lnd macaroons that
+// contains all required permissions is selected and that a set of subserver
+// macaroons is validated to cover the required permissions together.
+func TestSelectLndMacaroon(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "pool-macaroons")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	writeTestLndMacaroon(t, tempDir, "admin.macaroon", adminPermissions)
+	for fileName, permissions := range subserverMacaroons {
+		writeTestLndMacaroon(t, tempDir, fileName, permissions)
+	}
+
+	for _, tc := range selectLndMacaroonTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			macPaths := make([]string, len(tc.macaroons))
+			for idx, fileName := range tc.macaroons {
+				macPaths[idx] = filepath.Join(tempDir, fileName)
+			}
+
+			macPath, err := selectLndMacaroon(macPaths)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(tempDir, tc.expected), macPath)
+		})
+	}
+}
+
+// writeTestLndMacaroon writes a macaroon with the given permissions in the same
+// format as lnd encodes them to a file with the given name.
+func writeTestLndMacaroon(t *testing.T, dir, fileName string,
+	permissions []string) {
+
+	macID := &lnrpc.MacaroonId{
+		Nonce:     []byte(fileName),
+		StorageId: []byte("0"),
+	}
+	for _, permission := range permissions {
+		parts := strings.Split(permission, ":")
+		macID.Ops = append(macID.Ops, &lnrpc.Op{
+			Entity:  parts[0],
+			Actions: []string{parts[1]},
+		})
+	}
+
+	idBytes, err := proto.Marshal(macID)
+	require.NoError(t, err)
+
+	rawID := append([]byte{byte(bakery.LatestVersion)}, idBytes...)
+	mac, err := macaroon.New(
+		[]byte("root-key"), rawID, "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, fileName), macBytes, 0600)
+	require.NoError(t, err)
+}
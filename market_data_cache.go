@@ -0,0 +1,62 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+// marketDataCache caches the market data of the auction server, like its
+// current terms, so RPCs that only display or quote market data don't cause a
+// round trip to the server each time. Anything that submits orders or streams
+// updates must query the server directly instead.
+type marketDataCache struct {
+	clock      clock.Clock
+	ttl        time.Duration
+	fetchTerms func(context.Context) (*terms.AuctioneerTerms, error)
+
+	mu        sync.Mutex
+	terms     *terms.AuctioneerTerms
+	fetchedAt time.Time
+}
+
+// newMarketDataCache creates a new cache for the auction server's market data
+// that queries it again once it is older than the given TTL. A TTL of 0
+// disables caching.
+func newMarketDataCache(clk clock.Clock, ttl time.Duration,
+	fetchTerms func(context.Context) (*terms.AuctioneerTerms,
+		error)) *marketDataCache {
+
+	return &marketDataCache{
+		clock:      clk,
+		ttl:        ttl,
+		fetchTerms: fetchTerms,
+	}
+}
+
+// Terms returns the cached terms of the auction server or queries them again
+// if they haven't been queried yet or are stale.
+func (c *marketDataCache) Terms(ctx context.Context) (*terms.AuctioneerTerms,
+	error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if c.terms != nil && now.Sub(c.fetchedAt) < c.ttl {
+		return c.terms, nil
+	}
+
+	auctionTerms, err := c.fetchTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.terms = auctionTerms
+	c.fetchedAt = now
+
+	return auctionTerms, nil
+}
@@ -0,0 +1,107 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMarketDataCache makes sure the cached market data is served as long as
+// it is younger than the TTL and queried again afterwards.
+func TestMarketDataCache(t *testing.T) {
+	t.Parallel()
+
+	const ttl = time.Minute
+
+	var (
+		numFetches int
+		fetchErr   error
+	)
+	fetchTerms := func(context.Context) (*terms.AuctioneerTerms, error) {
+		numFetches++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return &terms.AuctioneerTerms{
+			OrderExecBaseFee: 1,
+			OrderExecFeeRate: btcutil.Amount(numFetches * 1000),
+		}, nil
+	}
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	cache := newMarketDataCache(testClock, ttl, fetchTerms)
+	s := &rpcServer{
+		marketData: cache,
+	}
+
+	ctx := context.Background()
+	req := &poolrpc.QuoteOrderRequest{
+		Amt:                     100_000,
+		RateFixed:               1000,
+		LeaseDurationBlocks:     2016,
+		MinUnitsMatch:           1,
+		MaxBatchFeeRateSatPerKw: 253,
+	}
+
+	// The first quote needs to query the server.
+	first, err := s.QuoteOrder(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 1, numFetches)
+
+	// Within the TTL, the cached terms are used.
+	testClock.SetTime(testClock.Now().Add(ttl - time.Second))
+	cached, err := s.QuoteOrder(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 1, numFetches)
+	require.Equal(t, first, cached)
+
+	// Once the TTL passed, the terms are queried again and the quote is
+	// based on the new ones.
+	testClock.SetTime(testClock.Now().Add(time.Second))
+	refreshed, err := s.QuoteOrder(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 2, numFetches)
+	require.Greater(
+		t, refreshed.TotalExecutionFeeSat, first.TotalExecutionFeeSat,
+	)
+
+	// A failed refresh isn't cached, the next call tries again.
+	testClock.SetTime(testClock.Now().Add(ttl))
+	fetchErr = errors.New("server unavailable")
+	_, err = cache.Terms(ctx)
+	require.ErrorContains(t, err, "server unavailable")
+	require.Equal(t, 3, numFetches)
+
+	fetchErr = nil
+	_, err = cache.Terms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, numFetches)
+}
+
+// TestMarketDataCacheDisabled makes sure the server is queried every time if
+// the TTL is 0.
+func TestMarketDataCacheDisabled(t *testing.T) {
+	t.Parallel()
+
+	var numFetches int
+	fetchTerms := func(context.Context) (*terms.AuctioneerTerms, error) {
+		numFetches++
+		return &terms.AuctioneerTerms{}, nil
+	}
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	cache := newMarketDataCache(testClock, 0, fetchTerms)
+
+	for i := 1; i <= 3; i++ {
+		_, err := cache.Terms(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, i, numFetches)
+	}
+}
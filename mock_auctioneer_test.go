@@ -0,0 +1,457 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	mockLeaseDuration = 2016
+)
+
+// mockAuctioneerClient is a mock of the auction server's RPC that can be
+// injected into the trader through Config.AuctioneerClient. All calls that
+// aren't implemented panic.
+type mockAuctioneerClient struct {
+	auctioneerrpc.ChannelAuctioneerClient
+
+	mu        sync.Mutex
+	submitted []*auctioneerrpc.ServerSubmitOrderRequest
+	canceled  []*auctioneerrpc.ServerCancelOrderRequest
+	rejectAll bool
+}
+
+func (m *mockAuctioneerClient) Terms(context.Context,
+	*auctioneerrpc.TermsRequest, ...grpc.CallOption) (
+	*auctioneerrpc.TermsResponse, error) {
+
+	return &auctioneerrpc.TermsResponse{
+		MaxAccountValue: btcutil.SatoshiPerBitcoin,
+		ExecutionFee: &auctioneerrpc.ExecutionFee{
+			BaseFee: 1,
+			FeeRate: 1,
+		},
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			mockLeaseDuration: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}, nil
+}
+
+func (m *mockAuctioneerClient) SubmitOrder(_ context.Context,
+	req *auctioneerrpc.ServerSubmitOrderRequest, _ ...grpc.CallOption) (
+	*auctioneerrpc.ServerSubmitOrderResponse, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.submitted = append(m.submitted, req)
+
+	if m.rejectAll {
+		return &auctioneerrpc.ServerSubmitOrderResponse{
+			Details: &auctioneerrpc.ServerSubmitOrderResponse_InvalidOrder{
+				InvalidOrder: &auctioneerrpc.InvalidOrder{
+					FailString: "order rejected",
+				},
+			},
+		}, nil
+	}
+
+	return &auctioneerrpc.ServerSubmitOrderResponse{
+		Details: &auctioneerrpc.ServerSubmitOrderResponse_Accepted{
+			Accepted: true,
+		},
+	}, nil
+}
+
+func (m *mockAuctioneerClient) CancelOrder(_ context.Context,
+	req *auctioneerrpc.ServerCancelOrderRequest, _ ...grpc.CallOption) (
+	*auctioneerrpc.ServerCancelOrderResponse, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.canceled = append(m.canceled, req)
+
+	return &auctioneerrpc.ServerCancelOrderResponse{}, nil
+}
+
+// newMockAuctioneerServer creates an RPC server that talks to the given mock
+// auctioneer instead of a real auction server and that has an open account.
+func newMockAuctioneerServer(t *testing.T,
+	mock *mockAuctioneerClient) (*rpcServer, *account.Account) {
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	// The mock is used instead of dialing the auction server, so no
+	// address is needed.
+	client, err := auctioneer.NewClient(&auctioneer.Config{
+		AuctioneerClient: mock,
+		GenUserAgent: func(ctx context.Context) string {
+			return UserAgent(InitiatorFromContext(ctx))
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Start())
+	t.Cleanup(func() {
+		require.NoError(t, client.Stop())
+	})
+
+	wallet := test.NewMockWalletKit()
+	keyDesc, err := wallet.DeriveNextKey(
+		context.Background(), int32(keychain.KeyFamilyMultiSig),
+	)
+	require.NoError(t, err)
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1000,
+		TraderKey:     keyDesc,
+		AuctioneerKey: keyDesc.PubKey,
+		BatchKey:      keyDesc.PubKey,
+		State:         account.StateOpen,
+		Version:       account.VersionTaprootEnabled,
+		LatestTx: &wire.MsgTx{
+			Version: 2,
+			TxIn:    []*wire.TxIn{{}},
+			TxOut: []*wire.TxOut{{
+				Value: btcutil.SatoshiPerBitcoin,
+			}},
+		},
+	}
+	require.NoError(t, db.AddAccount(acct))
+
+	signer := test.NewMockSigner()
+	signer.Signature = []byte("signature")
+
+	return &rpcServer{
+		auctioneer: client,
+		orderManager: order.NewManager(&order.ManagerConfig{
+			Store:     db,
+			AcctStore: &accountStore{DB: db},
+			Lightning: test.NewMockLightning(),
+			Wallet:    wallet,
+			Signer:    signer,
+		}),
+		server: &Server{
+			cfg: &Config{
+				DebugConfig: &DebugConfig{
+					BatchVersion: int32(
+						order.LatestBatchVersion,
+					),
+				},
+			},
+			db:          db,
+			lndServices: &lndclient.GrpcLndServices{},
+		},
+	}, acct
+}
+
+// newMockBidRequest returns a request for a bid of the given account.
+func newMockBidRequest(acct *account.Account) *poolrpc.SubmitOrderRequest {
+	return &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Bid{
+			Bid: &poolrpc.Bid{
+				Details: &poolrpc.Order{
+					TraderKey: acct.TraderKey.PubKey.
+						SerializeCompressed(),
+					RateFixed:               100,
+					Amt:                     200_000,
+					MinUnitsMatch:           1,
+					MaxBatchFeeRateSatPerKw: uint64(chainfee.FeePerKwFloor),
+				},
+				LeaseDurationBlocks: mockLeaseDuration,
+				Version:             uint32(order.VersionChannelType),
+				MinNodeTier:         auctioneerrpc.NodeTier_TIER_0,
+			},
+		},
+	}
+}
+
+// TestSubmitOrderMockAuctioneer submits orders end-to-end through the RPC
+// server against an injected mock auctioneer, without running an auction
+// server.
+func TestSubmitOrderMockAuctioneer(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	ctx := context.Background()
+
+	// An order accepted by the auctioneer is signed, sent to it and stored
+	// in the local database.
+	resp, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	nonceBytes := resp.GetAcceptedOrderNonce()
+	require.NotNil(t, nonceBytes)
+
+	require.Len(t, mock.submitted, 1)
+	serverBid := mock.submitted[0].GetBid()
+	require.NotNil(t, serverBid)
+	require.Equal(t, nonceBytes, serverBid.Details.OrderNonce)
+	require.Equal(
+		t, acct.TraderKey.PubKey.SerializeCompressed(),
+		serverBid.Details.TraderKey,
+	)
+	require.EqualValues(t, 200_000, serverBid.Details.Amt)
+	require.EqualValues(t, mockLeaseDuration, serverBid.LeaseDurationBlocks)
+	require.Equal(t, []byte("signature"), serverBid.Details.OrderSig)
+
+	var nonce order.Nonce
+	copy(nonce[:], nonceBytes)
+	dbOrder, err := srv.server.db.GetOrder(nonce)
+	require.NoError(t, err)
+	require.Equal(t, order.StateSubmitted, dbOrder.Details().State)
+
+	// An order rejected by the auctioneer is reported to the user and
+	// marked as failed.
+	mock.rejectAll = true
+	resp, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	require.Equal(t, "order rejected", resp.GetInvalidOrder().FailString)
+
+	require.Len(t, mock.submitted, 2)
+	copy(nonce[:], mock.submitted[1].GetBid().Details.OrderNonce)
+	dbOrder, err = srv.server.db.GetOrder(nonce)
+	require.NoError(t, err)
+	require.Equal(t, order.StateFailed, dbOrder.Details().State)
+}
+
+// TestSubmitOrderTargetAccount makes sure orders are submitted against the
+// account selected by their trader key and that accounts that aren't open or
+// can't cover the order are rejected.
+func TestSubmitOrderTargetAccount(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	ctx := context.Background()
+
+	newAccount := func(value btcutil.Amount,
+		state account.State) *account.Account {
+
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		newAcct := *acct
+		newAcct.TraderKey = &keychain.KeyDescriptor{
+			PubKey: privKey.PubKey(),
+		}
+		newAcct.Value = value
+		newAcct.State = state
+		require.NoError(t, srv.server.db.AddAccount(&newAcct))
+
+		return &newAcct
+	}
+	smallAcct := newAccount(100_000, account.StateOpen)
+	expiredAcct := newAccount(
+		btcutil.SatoshiPerBitcoin, account.StateExpired,
+	)
+
+	// An order is submitted against the account it targets, even if other
+	// accounts exist.
+	resp, err := srv.SubmitOrder(ctx, newMockAskRequest(acct))
+	require.NoError(t, err)
+	require.NotNil(t, resp.GetAcceptedOrderNonce())
+	require.Len(t, mock.submitted, 1)
+	require.Equal(
+		t, acct.TraderKey.PubKey.SerializeCompressed(),
+		mock.submitted[0].GetAsk().Details.TraderKey,
+	)
+
+	// An account that can't cover the order is rejected without sending
+	// the order to the auctioneer.
+	_, err = srv.SubmitOrder(ctx, newMockAskRequest(smallAcct))
+	require.ErrorContains(t, err, order.ErrInsufficientBalance.Error())
+
+	// So is an account that is no longer open.
+	_, err = srv.SubmitOrder(ctx, newMockAskRequest(expiredAcct))
+	require.ErrorContains(t, err, "cannot make order")
+
+	require.Len(t, mock.submitted, 1)
+}
+
+// TestSubmitOrderReservation makes sure orders can't reserve more than the
+// balance of their account and that canceling an order frees its reservation.
+func TestSubmitOrderReservation(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	srv.wumboSupported = true
+	ctx := context.Background()
+
+	newAskRequest := func() *poolrpc.SubmitOrderRequest {
+		req := newMockAskRequest(acct)
+		req.GetAsk().Details.Amt = 60_000_000
+		return req
+	}
+
+	// The first ask reserves more than half of the account's balance.
+	resp, err := srv.SubmitOrder(ctx, newAskRequest())
+	require.NoError(t, err)
+	nonce := resp.GetAcceptedOrderNonce()
+	require.NotNil(t, nonce)
+
+	// So a second one of the same size would over-commit the account.
+	_, err = srv.SubmitOrder(ctx, newAskRequest())
+	require.ErrorContains(t, err, order.ErrInsufficientBalance.Error())
+
+	// Once the first ask is canceled, its reservation is freed and the
+	// second ask can be submitted.
+	_, err = srv.CancelOrder(ctx, &poolrpc.CancelOrderRequest{
+		OrderNonce: nonce,
+	})
+	require.NoError(t, err)
+
+	resp, err = srv.SubmitOrder(ctx, newAskRequest())
+	require.NoError(t, err)
+	require.NotNil(t, resp.GetAcceptedOrderNonce())
+	require.Len(t, mock.submitted, 2)
+}
+
+// TestCancelOrdersOnShutdown makes sure all active orders are canceled with the
+// auction server on shutdown if configured, but archived orders are left
+// alone.
+func TestCancelOrdersOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	srv.server.rpcServer = srv
+	srv.server.cfg.CancelOrdersShutdownTimeout = time.Second
+	ctx := context.Background()
+
+	resp, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	activeNonce := resp.GetAcceptedOrderNonce()
+	require.NotNil(t, activeNonce)
+
+	resp, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	canceledNonce := resp.GetAcceptedOrderNonce()
+	_, err = srv.CancelOrder(ctx, &poolrpc.CancelOrderRequest{
+		OrderNonce: canceledNonce,
+	})
+	require.NoError(t, err)
+	require.Len(t, mock.canceled, 1)
+
+	// Without the option set, nothing is canceled on shutdown.
+	srv.server.cancelOrdersOnShutdown()
+	require.Len(t, mock.canceled, 1)
+
+	// With the option set, only the order that is still active is
+	// canceled with the auction server and locally.
+	srv.server.cfg.CancelOrdersOnShutdown = true
+	srv.server.cancelOrdersOnShutdown()
+	require.Len(t, mock.canceled, 2)
+
+	var nonce order.Nonce
+	copy(nonce[:], activeNonce)
+	dbOrder, err := srv.server.db.GetOrder(nonce)
+	require.NoError(t, err)
+	require.Equal(t, order.StateCanceled, dbOrder.Details().State)
+	require.Equal(
+		t, dbOrder.Details().Preimage[:],
+		mock.canceled[1].OrderNoncePreimage,
+	)
+}
+
+// TestSubmitOrderAccountPolicy makes sure submissions that violate the policy
+// of the account backing them are rejected.
+func TestSubmitOrderAccountPolicy(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	ctx := context.Background()
+
+	// Setting a policy requires an existing account.
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	_, err = srv.SetAccountPolicy(ctx, &poolrpc.SetAccountPolicyRequest{
+		TraderKey: privKey.PubKey().SerializeCompressed(),
+		Policy:    &poolrpc.AccountPolicy{MaxOrders: 1},
+	})
+	require.Error(t, err)
+
+	setPolicy := func(maxOrders uint32, maxOutstanding uint64) {
+		_, err := srv.SetAccountPolicy(
+			ctx, &poolrpc.SetAccountPolicyRequest{
+				TraderKey: acct.TraderKey.PubKey.
+					SerializeCompressed(),
+				Policy: &poolrpc.AccountPolicy{
+					MaxOrders:         maxOrders,
+					MaxOutstandingSat: maxOutstanding,
+				},
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// Only two orders may be active at the same time.
+	setPolicy(2, 0)
+	for i := 0; i < 2; i++ {
+		_, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+		require.NoError(t, err)
+	}
+	_, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.ErrorIs(t, err, account.ErrPolicyMaxOrders)
+	require.Len(t, mock.submitted, 2)
+
+	// The two bids already commit 400k satoshis, so a third bid of 200k
+	// only fits below an outstanding limit of 600k.
+	setPolicy(0, 599_999)
+	_, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.ErrorIs(t, err, account.ErrPolicyMaxOutstanding)
+	require.Len(t, mock.submitted, 2)
+
+	setPolicy(0, 600_000)
+	_, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	require.Len(t, mock.submitted, 3)
+
+	// Once the policy is removed, the account isn't limited anymore.
+	setPolicy(0, 0)
+	resp, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	require.Len(t, mock.submitted, 4)
+
+	// With four active orders that commit 800k satoshis, the account is
+	// at both limits, so no new order is accepted.
+	setPolicy(4, 800_000)
+	_, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.ErrorIs(t, err, account.ErrPolicyMaxOrders)
+	require.Len(t, mock.submitted, 4)
+
+	// But a replacement doesn't add to the active orders or the
+	// outstanding amount, as the replaced order is canceled.
+	replaceResp, err := srv.ReplaceOrder(ctx, &poolrpc.ReplaceOrderRequest{
+		OrderNonce:  resp.GetAcceptedOrderNonce(),
+		Replacement: newMockBidRequest(acct),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, replaceResp.Replacement.GetAcceptedOrderNonce())
+	require.Len(t, mock.canceled, 1)
+	require.Len(t, mock.submitted, 5)
+}
@@ -0,0 +1,47 @@
+package pool
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+const (
+	// NodeRoleMaker is the node role of traders that only sell liquidity
+	// and therefore only submit ask orders.
+	NodeRoleMaker = "maker"
+
+	// NodeRoleTaker is the node role of traders that only buy liquidity
+	// and therefore only submit bid orders.
+	NodeRoleTaker = "taker"
+
+	// NodeRoleBoth is the node role of traders that both buy and sell
+	// liquidity.
+	NodeRoleBoth = "both"
+)
+
+// checkNodeRole returns an error if the given node role doesn't allow orders
+// of the given type to be submitted. An empty role allows all order types.
+func checkNodeRole(role string, orderType order.Type) error {
+	switch role {
+	case NodeRoleBoth, "":
+		return nil
+
+	case NodeRoleMaker:
+		if orderType != order.TypeAsk {
+			return fmt.Errorf("node role is %v, only ask orders "+
+				"can be submitted", role)
+		}
+
+	case NodeRoleTaker:
+		if orderType != order.TypeBid {
+			return fmt.Errorf("node role is %v, only bid orders "+
+				"can be submitted", role)
+		}
+
+	default:
+		return fmt.Errorf("unknown node role %q", role)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckNodeRole makes sure only the order types allowed by the node role
+// can be submitted.
+func TestCheckNodeRole(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		role      string
+		orderType order.Type
+		allowed   bool
+	}{
+		{NodeRoleBoth, order.TypeAsk, true},
+		{NodeRoleBoth, order.TypeBid, true},
+		{"", order.TypeBid, true},
+		{NodeRoleMaker, order.TypeAsk, true},
+		{NodeRoleMaker, order.TypeBid, false},
+		{NodeRoleTaker, order.TypeAsk, false},
+		{NodeRoleTaker, order.TypeBid, true},
+		{"market-maker", order.TypeAsk, false},
+	}
+	for _, tc := range testCases {
+		err := checkNodeRole(tc.role, tc.orderType)
+		if tc.allowed {
+			require.NoError(t, err, "%v %v", tc.role, tc.orderType)
+		} else {
+			require.Error(t, err, "%v %v", tc.role, tc.orderType)
+		}
+	}
+}
+
+// newMockAskRequest returns a request for an ask of the given account.
+func newMockAskRequest(acct *account.Account) *poolrpc.SubmitOrderRequest {
+	return &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Ask{
+			Ask: &poolrpc.Ask{
+				Details: &poolrpc.Order{
+					TraderKey: acct.TraderKey.PubKey.
+						SerializeCompressed(),
+					RateFixed:               100,
+					Amt:                     200_000,
+					MinUnitsMatch:           1,
+					MaxBatchFeeRateSatPerKw: uint64(chainfee.FeePerKwFloor),
+				},
+				LeaseDurationBlocks: mockLeaseDuration,
+				Version:             uint32(order.VersionChannelType),
+			},
+		},
+	}
+}
+
+// TestSubmitOrderMakerOnly makes sure a maker-only node rejects bids without
+// sending them to the auctioneer but still accepts asks.
+func TestSubmitOrderMakerOnly(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	srv.server.cfg.NodeRole = NodeRoleMaker
+	ctx := context.Background()
+
+	_, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.ErrorContains(t, err, "only ask orders can be submitted")
+	require.Empty(t, mock.submitted)
+
+	resp, err := srv.SubmitOrder(ctx, newMockAskRequest(acct))
+	require.NoError(t, err)
+	require.NotNil(t, resp.GetAcceptedOrderNonce())
+
+	require.Len(t, mock.submitted, 1)
+	require.NotNil(t, mock.submitted[0].GetAsk())
+}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// BlocksPerYear is the number of blocks that are expected to be mined
+	// in a year, assuming one block every ten minutes.
+	BlocksPerYear = 144 * 365
+)
+
+// APRToPerBlockRate converts an annual percentage rate into the per-block
+// fixed rate in parts per billion that is used for orders. The APR is
+// expressed as a fraction, a value of 0.05 means 5% per year.
+//
+// The premium of a lease grows linearly with its duration, so the conversion
+// is simple, non-compounding interest:
+//
+//	rateFixed = round(apr * FeeRateTotalParts / BlocksPerYear)
+//
+// The lump sum premium for an amount leased over a duration then is:
+//
+//	premium = amt * rateFixed / FeeRateTotalParts * durationBlocks
+//	        ~ amt * apr * durationBlocks / BlocksPerYear
+//
+// Because the rate is per block, the same APR results in the same fixed rate
+// for any lease duration, only the total premium changes with the duration.
+// An error is returned if the APR can't be expressed with the precision of the
+// fixed rate, which is roughly 0.0053% per year.
+func APRToPerBlockRate(apr float64) (FixedRatePremium, error) {
+	if math.IsNaN(apr) || apr <= 0 {
+		return 0, fmt.Errorf("APR must be positive")
+	}
+
+	rateFixed := math.Round(apr * FeeRateTotalParts / BlocksPerYear)
+	switch {
+	case rateFixed < 1:
+		return 0, fmt.Errorf("APR of %v is too small, min is %v", apr,
+			PerBlockRateToAPR(1))
+
+	case rateFixed > math.MaxUint32:
+		return 0, fmt.Errorf("APR of %v is too large, max is %v", apr,
+			PerBlockRateToAPR(math.MaxUint32))
+	}
+
+	return FixedRatePremium(rateFixed), nil
+}
+
+// PerBlockRateToAPR converts a per-block fixed rate in parts per billion into
+// the annual percentage rate it corresponds to, expressed as a fraction. This
+// is the inverse of APRToPerBlockRate:
+//
+//	apr = rateFixed * BlocksPerYear / FeeRateTotalParts
+func PerBlockRateToAPR(rate FixedRatePremium) float64 {
+	return float64(rate) * BlocksPerYear / FeeRateTotalParts
+}
@@ -0,0 +1,114 @@
+package order
+
+import (
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+var aprTestCases = []struct {
+	name          string
+	apr           float64
+	expectedRate  FixedRatePremium
+	leaseDuration uint32
+}{{
+	name:          "1% over two weeks",
+	apr:           0.01,
+	expectedRate:  190,
+	leaseDuration: 2016,
+}, {
+	name:          "2.5% over four weeks",
+	apr:           0.025,
+	expectedRate:  476,
+	leaseDuration: 4032,
+}, {
+	name:          "5% over half a year",
+	apr:           0.05,
+	expectedRate:  951,
+	leaseDuration: BlocksPerYear / 2,
+}, {
+	name:          "12% over a year",
+	apr:           0.12,
+	expectedRate:  2283,
+	leaseDuration: BlocksPerYear,
+}, {
+	name:          "150% over a day",
+	apr:           1.5,
+	expectedRate:  28539,
+	leaseDuration: 144,
+}}
+
+// TestAPRConversion makes sure APR values are converted into per-block fixed
+// rates and back with just the rounding error of the fixed rate precision, and
+// that the resulting lump sum premium matches the APR for different lease
+// durations.
+func TestAPRConversion(t *testing.T) {
+	t.Parallel()
+
+	const amt = btcutil.Amount(10_000_000)
+
+	// The largest rounding error of a conversion is half a fixed rate unit.
+	maxAPRError := PerBlockRateToAPR(1) / 2
+
+	for _, tc := range aprTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate, err := APRToPerBlockRate(tc.apr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRate, rate)
+
+			apr := PerBlockRateToAPR(rate)
+			require.InDelta(t, tc.apr, apr, maxAPRError)
+
+			// Converting the APR we got back must result in the
+			// exact same rate again.
+			rate2, err := APRToPerBlockRate(apr)
+			require.NoError(t, err)
+			require.Equal(t, rate, rate2)
+
+			// The premium paid over the lease duration must match
+			// the APR, give or take the rounding of the rate and
+			// the premium itself.
+			premium := rate.LumpSumPremium(amt, tc.leaseDuration)
+			expectedPremium := float64(amt) * tc.apr *
+				float64(tc.leaseDuration) / BlocksPerYear
+			maxPremiumError := float64(amt) * maxAPRError *
+				float64(tc.leaseDuration) / BlocksPerYear
+			require.InDelta(
+				t, expectedPremium, float64(premium),
+				maxPremiumError+1,
+			)
+		})
+	}
+}
+
+// TestAPRConversionErrors makes sure APR values that can't be expressed as a
+// fixed rate are rejected.
+func TestAPRConversionErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := APRToPerBlockRate(0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "APR must be positive")
+
+	_, err = APRToPerBlockRate(-0.05)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "APR must be positive")
+
+	_, err = APRToPerBlockRate(math.NaN())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "APR must be positive")
+
+	_, err = APRToPerBlockRate(0.00001)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "too small")
+
+	_, err = APRToPerBlockRate(1e6)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "too large")
+}
@@ -0,0 +1,53 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// RecreateOrder creates a new order with the same parameters as the given
+// fully filled order. The new order gets a fresh nonce and its remaining
+// recreation limit is decreased by one. Once the limit is used up, the new
+// order isn't recreated anymore.
+func RecreateOrder(o Order) (Order, error) {
+	details := o.Details()
+	if !details.AutoRecreate {
+		return nil, errors.New("order is not set to be recreated")
+	}
+
+	preimageBytes, err := randomPreimage()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %v", err)
+	}
+	var preimage lntypes.Preimage
+	copy(preimage[:], preimageBytes)
+
+	kit := *details
+	newKit := NewKitWithPreimage(preimage)
+	kit.nonce = newKit.nonce
+	kit.Preimage = preimage
+	kit.State = StateSubmitted
+	kit.UnitsUnfulfilled = kit.Units
+
+	// A new multisig key is derived when the order is prepared for
+	// submission.
+	kit.MultiSigKeyLocator = keychain.KeyLocator{}
+
+	switch kit.AutoRecreateLimit {
+	// No limit, the new order is recreated again once it is filled.
+	case 0:
+
+	// This is the last recreation.
+	case 1:
+		kit.AutoRecreate = false
+		kit.AutoRecreateLimit = 0
+
+	default:
+		kit.AutoRecreateLimit--
+	}
+
+	return withKit(o, kit)
+}
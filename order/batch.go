@@ -396,8 +396,9 @@ type BatchVerifier interface {
 // a batch.
 type BatchSigner interface {
 	// Sign returns the witness stack of all account inputs in a batch that
-	// belong to the trader.
-	Sign(*Batch) (BatchSignature, AccountNonces, error)
+	// belong to the trader. Signing is aborted once the context is
+	// canceled.
+	Sign(context.Context, *Batch) (BatchSignature, AccountNonces, error)
 }
 
 // BatchStorer is an interface that can store a batch to the local database by
@@ -23,7 +23,9 @@ type batchSigner struct {
 // belong to the trader.
 //
 // NOTE: This method is part of the BatchSigner interface.
-func (s *batchSigner) Sign(batch *Batch) (BatchSignature, AccountNonces, error) {
+func (s *batchSigner) Sign(ctx context.Context, batch *Batch) (BatchSignature,
+	AccountNonces, error) {
+
 	ourSigs := make(BatchSignature, len(batch.AccountDiffs))
 	ourNonces := make(AccountNonces, len(batch.AccountDiffs))
 
@@ -59,7 +61,7 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, AccountNonces, error)
 		// MuSig2 signing works differently!
 		if acct.Version >= account.VersionTaprootEnabled {
 			partialSig, nonces, err := s.signInputMuSig2(
-				acctKey, acct, inputIndex, batch,
+				ctx, acctKey, acct, inputIndex, batch,
 			)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error MuSig2 "+
@@ -94,7 +96,7 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, AccountNonces, error)
 			InputIndex:    inputIndex,
 		}
 		sigs, err := s.signer.SignOutputRaw(
-			context.Background(), batch.BatchTX,
+			ctx, batch.BatchTX,
 			[]*lndclient.SignDescriptor{signDesc}, nil,
 		)
 		if err != nil {
@@ -116,11 +118,10 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, AccountNonces, error)
 
 // signInputMuSig2 creates a MuSig2 partial signature for the given account's
 // input.
-func (s *batchSigner) signInputMuSig2(acctKey [33]byte,
+func (s *batchSigner) signInputMuSig2(ctx context.Context, acctKey [33]byte,
 	account *account.Account, accountInputIdx int, batch *Batch) ([]byte,
 	poolscript.MuSig2Nonces, error) {
 
-	ctx := context.Background()
 	var emptyNonces poolscript.MuSig2Nonces
 
 	serverNonces, ok := batch.ServerNonces[acctKey]
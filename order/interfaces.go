@@ -30,6 +30,9 @@ type Nonce [32]byte
 // that bug is fixed, we can change back to [sha256.Size]byte.
 const hashSize = 32
 
+// MaxTagLength is the maximum number of characters an order tag can have.
+const MaxTagLength = 64
+
 // String returns the hex encoded representation of the nonce.
 func (n Nonce) String() string {
 	return hex.EncodeToString(n[:])
@@ -310,6 +313,44 @@ func (a AuctionType) String() string {
 	}
 }
 
+// PartialMatchPolicy denotes what happens to the unmatched remainder of an
+// order after it was only partially matched in a batch.
+type PartialMatchPolicy uint8
+
+// NOTE: We avoid the use of iota as this type is stored on disk.
+const (
+	// PartialMatchKeep keeps the unmatched remainder of an order in the
+	// order book.
+	PartialMatchKeep PartialMatchPolicy = 0
+
+	// PartialMatchCancel cancels the unmatched remainder of an order.
+	PartialMatchCancel PartialMatchPolicy = 1
+
+	// PartialMatchReprice cancels the unmatched remainder of an order and
+	// submits it again as a new order at the clearing rate of the batch
+	// the order was partially matched in. A bid is never repriced above
+	// and an ask never below the order's own rate.
+	PartialMatchReprice PartialMatchPolicy = 2
+)
+
+// String returns a human readable string representation of the partial match
+// policy.
+func (p PartialMatchPolicy) String() string {
+	switch p {
+	case PartialMatchKeep:
+		return "keep"
+
+	case PartialMatchCancel:
+		return "cancel"
+
+	case PartialMatchReprice:
+		return "reprice"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", p)
+	}
+}
+
 var (
 	// ErrInsufficientBalance is the error that is returned if an account
 	// has insufficient balance to perform a requested action.
@@ -459,6 +500,26 @@ type Kit struct {
 	// IsPublic is the flag used to signal if the details of this order can
 	// be shared in public marketplaces or not.
 	IsPublic bool
+
+	// Tag is an optional freeform label that is attached to the order to
+	// group it with other orders. The tag is only stored locally and
+	// never sent to the auctioneer.
+	Tag string
+
+	// PartialMatchPolicy is what happens to the unmatched remainder of the
+	// order after it was only partially matched in a batch. The policy is
+	// only applied locally and never sent to the auctioneer.
+	PartialMatchPolicy PartialMatchPolicy
+
+	// AutoRecreate signals that an identical new order should be submitted
+	// once this order is fully filled. This is only done locally and never
+	// sent to the auctioneer.
+	AutoRecreate bool
+
+	// AutoRecreateLimit is the number of times the order is still
+	// recreated if AutoRecreate is set. A value of 0 means there is no
+	// limit.
+	AutoRecreateLimit uint32
 }
 
 // Nonce is the unique identifier of each order and MUST be created by hashing a
@@ -512,6 +573,12 @@ type Ask struct {
 	// ConfirmationConstraints specifies the constraints for the matched
 	// channels in terms of confirmed/zero conf.
 	ConfirmationConstraints ChannelConfirmationConstraints
+
+	// MinPremium is the minimum total premium the ask wants to earn for
+	// all its matches in a single batch. Batches that pay less are
+	// rejected. The limit is only enforced locally and never sent to the
+	// auctioneer. A value of 0 means no limit.
+	MinPremium btcutil.Amount
 }
 
 // Type returns the order type.
@@ -723,6 +790,12 @@ type Bid struct {
 	// ZeroConfChannel signals if the resulting channels need to be zero
 	// conf or not.
 	ZeroConfChannel bool
+
+	// MaxPremium is the maximum total premium the bid is willing to pay
+	// for all its matches in a single batch. Batches that charge more are
+	// rejected. The limit is only enforced locally and never sent to the
+	// auctioneer. A value of 0 means no limit.
+	MaxPremium btcutil.Amount
 }
 
 // Type returns the order type.
@@ -1077,4 +1150,9 @@ type Manager interface {
 	// OurNodePubkey returns our lnd node's public identity key or an error
 	// if the manager wasn't fully started yet.
 	OurNodePubkey() ([33]byte, error)
+
+	// SetBatchVersion changes the batch version that incoming batches are
+	// expected to use. It must not be called while a batch is being
+	// validated.
+	SetBatchVersion(version BatchVersion)
 }
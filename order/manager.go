@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -29,6 +30,11 @@ const (
 	// MinimumOrderDurationBlocks is the minimum for a bid's MinDuration or
 	// an ask's MaxDuration.
 	MinimumOrderDurationBlocks = 144
+
+	// batchSignWarnFraction is the fraction of the batch sign timeout
+	// after which we warn the user that signing is taking suspiciously
+	// long.
+	batchSignWarnFraction = 0.75
 )
 
 var (
@@ -37,8 +43,69 @@ var (
 	// trader's acceptable range.
 	ErrInvalidBatchHeightHint = errors.New("proposed batch height hint is " +
 		"outside of acceptable range")
+
+	// ErrBatchSignTimeout is the error that is returned if lnd did not
+	// produce all signatures for a batch within the configured batch sign
+	// timeout.
+	ErrBatchSignTimeout = errors.New("timeout waiting for lnd to sign " +
+		"batch")
+
+	// ErrPeerNotAllowed is the error that is returned if one of our orders
+	// is matched with a node that isn't on the configured list of allowed
+	// peers.
+	ErrPeerNotAllowed = errors.New("matched node is not an allowed peer")
+
+	// ErrAccountUtilizationExceeded is the error that is returned if a
+	// batch would consume a larger fraction of one of our accounts than
+	// configured.
+	ErrAccountUtilizationExceeded = errors.New("batch exceeds maximum " +
+		"account utilization")
 )
 
+// MatchRejectErr is an error type that is returned if the trader rejects
+// certain orders instead of the whole batch.
+type MatchRejectErr struct {
+	// RejectedOrders is the map of matches we reject, keyed with the nonce
+	// of the matched order.
+	RejectedOrders map[Nonce]*auctioneerrpc.OrderReject
+}
+
+// Error returns the underlying error string.
+//
+// NOTE: This is part of the error interface.
+func (e *MatchRejectErr) Error() string {
+	return fmt.Sprintf("trader rejected orders: %v", e.RejectedOrders)
+}
+
+// rejectMatches adds a reject with the given reason for each of the given
+// matches to the map of rejected orders. The server only knows reasons for
+// partial rejects that are caused by the trader's preferences or by failed
+// channel fundings. Only the former doesn't count against the reputation of
+// the matched node, so we use it for all matches we don't want to take part
+// in.
+func rejectMatches(rejects map[Nonce]*auctioneerrpc.OrderReject,
+	reason error, matches ...*MatchedOrder) {
+
+	for _, match := range matches {
+		rejects[match.Order.Nonce()] = &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_DUPLICATE_PEER,
+			Reason:     reason.Error(),
+		}
+	}
+}
+
+// RejectAllMatches returns an error that partially rejects all matches of our
+// orders in the given batch with the given reason. Unlike a full reject of the
+// batch, this lets the auctioneer execute the batch without us.
+func RejectAllMatches(batch *Batch, reason error) *MatchRejectErr {
+	rejects := make(map[Nonce]*auctioneerrpc.OrderReject)
+	for _, matches := range batch.MatchedOrders {
+		rejectMatches(rejects, reason, matches...)
+	}
+
+	return &MatchRejectErr{RejectedOrders: rejects}
+}
+
 // ErrVersionMismatch is the error that is returned if we don't implement the
 // same batch verification version as the server.
 type ErrVersionMismatch struct {
@@ -83,6 +150,24 @@ type ManagerConfig struct {
 	// BatchVersion is the batch version that we should use to verify new
 	// batches against.
 	BatchVersion BatchVersion
+
+	// BatchSignTimeout is the maximum amount of time we wait for lnd to
+	// sign all our account inputs of a batch. If signing takes longer, the
+	// batch is rejected instead of risking a silent removal by the
+	// auctioneer. A value of zero disables the timeout.
+	BatchSignTimeout time.Duration
+
+	// AllowedPeers is the list of node IDs our orders may be matched
+	// with. If it isn't empty, matches with any other node are rejected,
+	// independent of the node IDs allowed by each order.
+	AllowedPeers [][33]byte
+
+	// MaxBatchAccountUtilization is the maximum fraction of an account's
+	// balance a single batch is allowed to consume. If a batch would
+	// reduce the balance of one of our accounts by more than that, the
+	// matches of that account's orders are rejected. A value of zero
+	// disables the limit.
+	MaxBatchAccountUtilization float64
 }
 
 // manager is responsible for the management of orders.
@@ -160,6 +245,16 @@ func (m *manager) Start() error {
 	return err
 }
 
+// SetBatchVersion changes the batch version that incoming batches are expected
+// to use. It must not be called while a batch is being validated.
+func (m *manager) SetBatchVersion(version BatchVersion) {
+	m.cfg.BatchVersion = version
+
+	if verifier, ok := m.batchVerifier.(*batchVerifier); ok {
+		verifier.version = version
+	}
+}
+
 // Stop stops all concurrent tasks the manager is responsible for.
 func (m *manager) Stop() {
 	log.Debugf("Stopping order manager")
@@ -286,10 +381,8 @@ func (m *manager) validateOrder(order Order, acct *account.Account,
 	terms *terms.AuctioneerTerms) error {
 
 	duration := order.Details().LeaseDuration
-	_, ok := terms.LeaseDurationBuckets[duration]
-	if !ok {
-		return fmt.Errorf("invalid lease duration, must be one of %v",
-			terms.LeaseDurationBuckets)
+	if err := terms.ValidateLeaseDuration(duration); err != nil {
+		return err
 	}
 
 	if order.Details().MaxBatchFeeRate < chainfee.FeePerKwFloor {
@@ -347,7 +440,15 @@ func (m *manager) OrderMatchValidate(batch *Batch, bestHeight uint32) error {
 		return fmt.Errorf("error validating batch: %w", err)
 	}
 
-	// Verify that the id of the matched node was not filtered out.
+	overUtilized, err := m.checkAccountUtilization(batch)
+	if err != nil {
+		return err
+	}
+
+	// Verify that the id of the matched node was not filtered out. Matches
+	// that only violate one of our own limits are collected, so we can
+	// reject just those instead of the whole batch.
+	rejects := make(map[Nonce]*auctioneerrpc.OrderReject)
 	for nonce, matches := range batch.MatchedOrders {
 		o, err := m.cfg.Store.GetOrder(nonce)
 		if err != nil {
@@ -355,6 +456,14 @@ func (m *manager) OrderMatchValidate(batch *Batch, bestHeight uint32) error {
 				err)
 		}
 
+		// None of the matches of an order can be accepted if its
+		// account would be consumed more than allowed.
+		reason, ok := overUtilized[o.Details().AcctKey]
+		if ok {
+			rejectMatches(rejects, reason, matches...)
+			continue
+		}
+
 		for _, match := range matches {
 			isValidMatch := IsNodeIDAValidMatch(
 				match.NodeKey, o.Details().AllowedNodeIDs,
@@ -364,6 +473,30 @@ func (m *manager) OrderMatchValidate(batch *Batch, bestHeight uint32) error {
 				return fmt.Errorf("invalid match with %x in "+
 					"order %x", match.NodeKey, nonce)
 			}
+
+			isAllowedPeer := IsNodeIDAValidMatch(
+				match.NodeKey, m.cfg.AllowedPeers, nil,
+			)
+			if !isAllowedPeer {
+				err := fmt.Errorf("%w: match with %x in order "+
+					"%x", ErrPeerNotAllowed, match.NodeKey,
+					nonce)
+				rejectMatches(rejects, err, match)
+			}
+		}
+
+		// Don't participate with this order if the premium at the
+		// batch's clearing price violates its premium limit.
+		clearingPrice := batch.ClearingPrices[o.Details().LeaseDuration]
+		err = CheckPremiumLimit(o, matches, clearingPrice)
+		if err != nil {
+			rejectMatches(rejects, err, matches...)
+		}
+	}
+
+	if len(rejects) > 0 {
+		return &MatchRejectErr{
+			RejectedOrders: rejects,
 		}
 	}
 
@@ -373,6 +506,46 @@ func (m *manager) OrderMatchValidate(batch *Batch, bestHeight uint32) error {
 	return nil
 }
 
+// checkAccountUtilization returns the reason why each of our accounts is
+// rejected if the batch consumes a larger fraction of its balance than
+// configured.
+func (m *manager) checkAccountUtilization(batch *Batch) (map[[33]byte]error,
+	error) {
+
+	overUtilized := make(map[[33]byte]error)
+	maxUtilization := m.cfg.MaxBatchAccountUtilization
+	if maxUtilization <= 0 {
+		return overUtilized, nil
+	}
+
+	for _, diff := range batch.AccountDiffs {
+		acct, err := m.cfg.AcctStore.Account(diff.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching account %x: %w",
+				diff.AccountKeyRaw[:], err)
+		}
+
+		// Accounts that only earn in this batch aren't consumed at
+		// all.
+		if acct.Value == 0 || diff.EndingBalance >= acct.Value {
+			continue
+		}
+
+		consumed := acct.Value - diff.EndingBalance
+		utilization := float64(consumed) / float64(acct.Value)
+		if utilization > maxUtilization {
+			overUtilized[diff.AccountKeyRaw] = fmt.Errorf("%w: "+
+				"batch consumes %v of %v (%.2f%%) of account "+
+				"%x, maximum is %.2f%%",
+				ErrAccountUtilizationExceeded, consumed,
+				acct.Value, utilization*100,
+				diff.AccountKeyRaw[:], maxUtilization*100)
+		}
+	}
+
+	return overUtilized, nil
+}
+
 // HasPendingBatch returns whether a pending batch is currently being processed.
 func (m *manager) HasPendingBatch() bool {
 	return atomic.LoadUint32(&m.hasPendingBatch) == 1
@@ -388,7 +561,7 @@ func (m *manager) PendingBatch() *Batch {
 // we'll also persist the batch to disk as pending to ensure we can recover
 // after a crash.
 func (m *manager) BatchSign() (BatchSignature, AccountNonces, error) {
-	sig, nonces, err := m.batchSigner.Sign(m.pendingBatch)
+	sig, nonces, err := m.signWithTimeout(m.pendingBatch)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -401,6 +574,68 @@ func (m *manager) BatchSign() (BatchSignature, AccountNonces, error) {
 	return sig, nonces, nil
 }
 
+// signWithTimeout signs the given batch with the batch signer. If a batch sign
+// timeout is configured, a warning is logged once signing approaches the limit
+// and ErrBatchSignTimeout is returned if it's exceeded. Signing is canceled
+// once we stop waiting for it, so it doesn't keep running in the background.
+func (m *manager) signWithTimeout(batch *Batch) (BatchSignature, AccountNonces,
+	error) {
+
+	timeout := m.cfg.BatchSignTimeout
+	if timeout <= 0 {
+		return m.batchSigner.Sign(context.Background(), batch)
+	}
+
+	type signResult struct {
+		sig    BatchSignature
+		nonces AccountNonces
+		err    error
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The result channel is buffered so the goroutine can exit even if we
+	// already gave up waiting for it.
+	resultChan := make(chan *signResult, 1)
+	go func() {
+		sig, nonces, err := m.batchSigner.Sign(ctx, batch)
+		resultChan <- &signResult{sig: sig, nonces: nonces, err: err}
+	}()
+
+	start := time.Now()
+	warnTimer := time.NewTimer(
+		time.Duration(float64(timeout) * batchSignWarnFraction),
+	)
+	defer warnTimer.Stop()
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	for {
+		select {
+		case res := <-resultChan:
+			return res.sig, res.nonces, res.err
+
+		case <-warnTimer.C:
+			log.Warnf("Signing batch %x is taking unusually long "+
+				"(%v so far, timeout is %v), lnd's signer "+
+				"might be overloaded", batch.ID[:],
+				time.Since(start), timeout)
+
+		case <-timeoutTimer.C:
+			log.Errorf("Signing batch %x did not complete within "+
+				"%v, rejecting batch", batch.ID[:], timeout)
+
+			return nil, nil, fmt.Errorf("%w after %v",
+				ErrBatchSignTimeout, timeout)
+
+		case <-m.quit:
+			return nil, nil, fmt.Errorf("order manager shutting " +
+				"down")
+		}
+	}
+}
+
 // BatchFinalize marks a batch as complete upon receiving the finalize message
 // from the auctioneer.
 func (m *manager) BatchFinalize(batchID BatchID) error {
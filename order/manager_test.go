@@ -2,8 +2,11 @@ package order
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
@@ -134,7 +137,7 @@ func TestValidateOrder(t *testing.T) {
 		order       Order
 	}{{
 		name:        "invalid lease duration",
-		expectedErr: "invalid lease duration, must be one of map[144",
+		expectedErr: "invalid channel lease duration 123 blocks",
 		order: &Ask{
 			Kit: Kit{
 				LeaseDuration: 123,
@@ -399,3 +402,420 @@ func TestPrepareOrderSidecarTicket(t *testing.T) {
 		}
 	}
 }
+
+// slowBatchSigner is a BatchSigner that takes a configurable amount of time
+// before returning a signature.
+type slowBatchSigner struct {
+	delay time.Duration
+
+	// exited is closed once a Sign call returned.
+	exited chan struct{}
+}
+
+// Sign returns an empty signature after the configured delay or an error if
+// the context is canceled first.
+func (s *slowBatchSigner) Sign(ctx context.Context, _ *Batch) (BatchSignature,
+	AccountNonces, error) {
+
+	defer close(s.exited)
+
+	select {
+	case <-time.After(s.delay):
+		return BatchSignature{}, AccountNonces{}, nil
+
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+// TestBatchSignTimeout makes sure a slow lnd signer causes the batch signing
+// to be aborted once the configured timeout is reached.
+func TestBatchSignTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		signDelay   time.Duration
+		timeout     time.Duration
+		expectedErr error
+	}{{
+		name:      "no timeout configured",
+		signDelay: 50 * time.Millisecond,
+	}, {
+		name:      "signing within timeout",
+		signDelay: 10 * time.Millisecond,
+		timeout:   time.Second,
+	}, {
+		name:        "signing exceeds timeout",
+		signDelay:   time.Second,
+		timeout:     50 * time.Millisecond,
+		expectedErr: ErrBatchSignTimeout,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := NewManager(&ManagerConfig{
+				Store:            newMockStore(),
+				BatchSignTimeout: tc.timeout,
+			})
+			signer := &slowBatchSigner{
+				delay:  tc.signDelay,
+				exited: make(chan struct{}),
+			}
+			mgr.batchSigner = signer
+			mgr.batchStorer = &batchStorer{
+				orderStore: mgr.cfg.Store,
+			}
+			mgr.pendingBatch = &Batch{ID: BatchID{1, 2, 3}}
+
+			start := time.Now()
+			sig, _, err := mgr.BatchSign()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, sig)
+
+				// We shouldn't have waited for the signer to
+				// complete, but the signer must have been
+				// canceled.
+				require.Less(t, time.Since(start), tc.signDelay)
+				select {
+				case <-signer.exited:
+				case <-time.After(tc.signDelay):
+					t.Fatalf("signer was not canceled")
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, sig)
+		})
+	}
+}
+
+// noopBatchVerifier is a batch verifier that accepts every batch.
+type noopBatchVerifier struct{}
+
+// Verify accepts the batch without any checks.
+func (noopBatchVerifier) Verify(*Batch, uint32) error {
+	return nil
+}
+
+// TestOrderMatchValidateAllowedPeers makes sure a batch is only accepted if
+// all our orders are matched with nodes on the configured list of allowed
+// peers and that only the matches with other nodes are rejected otherwise.
+func TestOrderMatchValidateAllowedPeers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		allowedPeer1 = [33]byte{2, 1}
+		allowedPeer2 = [33]byte{2, 2}
+		otherPeer    = [33]byte{3, 3}
+	)
+
+	testCases := []struct {
+		name           string
+		allowedPeers   [][33]byte
+		matchedPeers   [][33]byte
+		expectedReject []int
+	}{{
+		name:         "no allowlist",
+		matchedPeers: [][33]byte{otherPeer},
+	}, {
+		name:         "all peers allowed",
+		allowedPeers: [][33]byte{allowedPeer1, allowedPeer2},
+		matchedPeers: [][33]byte{allowedPeer1, allowedPeer2},
+	}, {
+		name:           "peer not on allowlist",
+		allowedPeers:   [][33]byte{allowedPeer1, allowedPeer2},
+		matchedPeers:   [][33]byte{allowedPeer1, otherPeer},
+		expectedReject: []int{1},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockStore()
+			mgr := NewManager(&ManagerConfig{
+				Store:        store,
+				AllowedPeers: tc.allowedPeers,
+			})
+			mgr.batchVerifier = noopBatchVerifier{}
+
+			ask := &Ask{Kit: *NewKit(Nonce{1})}
+			ask.LeaseDuration = 2016
+			require.NoError(t, store.SubmitOrder(ask))
+
+			var matches []*MatchedOrder
+			for idx, peer := range tc.matchedPeers {
+				matches = append(matches, &MatchedOrder{
+					Order: &Bid{
+						Kit: *NewKit(Nonce{2, byte(idx)}),
+					},
+					NodeKey:     peer,
+					UnitsFilled: 1,
+				})
+			}
+			batch := &Batch{
+				ID: BatchID{1, 2, 3},
+				MatchedOrders: map[Nonce][]*MatchedOrder{
+					ask.Nonce(): matches,
+				},
+				ClearingPrices: map[uint32]FixedRatePremium{
+					2016: 100,
+				},
+			}
+
+			// Only the matches with peers that aren't allowed
+			// should be rejected.
+			err := mgr.OrderMatchValidate(batch, 100)
+			if len(tc.expectedReject) > 0 {
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				require.Len(
+					t, rejectErr.RejectedOrders,
+					len(tc.expectedReject),
+				)
+				for _, idx := range tc.expectedReject {
+					nonce := matches[idx].Order.Nonce()
+					require.Contains(
+						t, rejectErr.RejectedOrders,
+						nonce,
+					)
+				}
+				require.False(t, mgr.HasPendingBatch())
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, mgr.HasPendingBatch())
+		})
+	}
+}
+
+// TestOrderMatchValidatePremiumLimit makes sure only the matches of an order
+// whose premium limit is violated are rejected, not the whole batch.
+func TestOrderMatchValidatePremiumLimit(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+	})
+	mgr.batchVerifier = noopBatchVerifier{}
+
+	// The premium of a single unit at the clearing price is 20 satoshis
+	// over the lease duration, so only the limit of the first ask is
+	// violated.
+	limitedAsk := &Ask{Kit: *NewKit(Nonce{1}), MinPremium: 21}
+	limitedAsk.LeaseDuration = 2016
+	require.NoError(t, store.SubmitOrder(limitedAsk))
+
+	otherAsk := &Ask{Kit: *NewKit(Nonce{2}), MinPremium: 20}
+	otherAsk.LeaseDuration = 2016
+	require.NoError(t, store.SubmitOrder(otherAsk))
+
+	limitedMatch := &MatchedOrder{
+		Order:       &Bid{Kit: *NewKit(Nonce{3})},
+		NodeKey:     [33]byte{2, 1},
+		UnitsFilled: 1,
+	}
+	otherMatch := &MatchedOrder{
+		Order:       &Bid{Kit: *NewKit(Nonce{4})},
+		NodeKey:     [33]byte{2, 2},
+		UnitsFilled: 1,
+	}
+	batch := &Batch{
+		ID: BatchID{1, 2, 3},
+		MatchedOrders: map[Nonce][]*MatchedOrder{
+			limitedAsk.Nonce(): {limitedMatch},
+			otherAsk.Nonce():   {otherMatch},
+		},
+		ClearingPrices: map[uint32]FixedRatePremium{
+			2016: 100,
+		},
+	}
+
+	err := mgr.OrderMatchValidate(batch, 100)
+
+	var rejectErr *MatchRejectErr
+	require.ErrorAs(t, err, &rejectErr)
+	require.Len(t, rejectErr.RejectedOrders, 1)
+	require.Contains(
+		t, rejectErr.RejectedOrders, limitedMatch.Order.Nonce(),
+	)
+	require.False(t, mgr.HasPendingBatch())
+}
+
+// mockStoreAccounts is an account store that looks up the accounts of a mock
+// store.
+type mockStoreAccounts struct {
+	account.Store
+
+	store *mockStore
+}
+
+// Account returns the account with the given key from the mock store.
+func (a *mockStoreAccounts) Account(
+	acctKey *btcec.PublicKey) (*account.Account, error) {
+
+	return a.store.getAccount(acctKey)
+}
+
+// TestOrderMatchValidateAccountUtilization makes sure the matches of an
+// account are rejected if the batch would consume a larger fraction of the
+// account than configured.
+func TestOrderMatchValidateAccountUtilization(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		maxUtilization float64
+		endingBalance  btcutil.Amount
+		expectReject   bool
+	}{{
+		name:          "no limit",
+		endingBalance: 100_000,
+	}, {
+		name:           "below threshold",
+		maxUtilization: 0.8,
+		endingBalance:  300_000,
+	}, {
+		name:           "exactly at threshold",
+		maxUtilization: 0.8,
+		endingBalance:  200_000,
+	}, {
+		name:           "balance increases",
+		maxUtilization: 0.1,
+		endingBalance:  1_200_000,
+	}, {
+		name:           "above threshold",
+		maxUtilization: 0.8,
+		endingBalance:  199_999,
+		expectReject:   true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockStore()
+			mgr := NewManager(&ManagerConfig{
+				Store:                      store,
+				AcctStore:                  &mockStoreAccounts{store: store},
+				MaxBatchAccountUtilization: tc.maxUtilization,
+			})
+			mgr.batchVerifier = noopBatchVerifier{}
+
+			_, acctKey := test.CreateKey(1)
+			var acctKeyRaw [33]byte
+			copy(acctKeyRaw[:], acctKey.SerializeCompressed())
+			store.accounts[acctKeyRaw] = &account.Account{
+				Value: 1_000_000,
+				TraderKey: &keychain.KeyDescriptor{
+					PubKey: acctKey,
+				},
+			}
+
+			ask := &Ask{Kit: *NewKit(Nonce{1})}
+			ask.LeaseDuration = 2016
+			ask.AcctKey = acctKeyRaw
+			require.NoError(t, store.SubmitOrder(ask))
+
+			// An order of another account isn't affected by the
+			// utilization of the first one.
+			otherAsk := &Ask{Kit: *NewKit(Nonce{2})}
+			otherAsk.LeaseDuration = 2016
+			otherAsk.AcctKey = [33]byte{3, 3}
+			require.NoError(t, store.SubmitOrder(otherAsk))
+
+			match := &MatchedOrder{
+				Order:       &Bid{Kit: *NewKit(Nonce{3})},
+				NodeKey:     [33]byte{2, 1},
+				UnitsFilled: 1,
+			}
+			batch := &Batch{
+				ID: BatchID{1, 2, 3},
+				AccountDiffs: []*AccountDiff{{
+					AccountKeyRaw: acctKeyRaw,
+					AccountKey:    acctKey,
+					EndingBalance: tc.endingBalance,
+				}},
+				MatchedOrders: map[Nonce][]*MatchedOrder{
+					ask.Nonce(): {match},
+					otherAsk.Nonce(): {{
+						Order: &Bid{
+							Kit: *NewKit(Nonce{4}),
+						},
+						NodeKey:     [33]byte{2, 2},
+						UnitsFilled: 1,
+					}},
+				},
+				ClearingPrices: map[uint32]FixedRatePremium{
+					2016: 100,
+				},
+			}
+
+			// Only the matches of the order of the over utilized
+			// account should be rejected.
+			err := mgr.OrderMatchValidate(batch, 100)
+			if tc.expectReject {
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				require.Len(t, rejectErr.RejectedOrders, 1)
+
+				nonce := match.Order.Nonce()
+				reject, ok := rejectErr.RejectedOrders[nonce]
+				require.True(t, ok)
+				require.Contains(
+					t, reject.Reason,
+					ErrAccountUtilizationExceeded.Error(),
+				)
+				require.False(t, mgr.HasPendingBatch())
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, mgr.HasPendingBatch())
+		})
+	}
+}
+
+// TestRejectAllMatches makes sure all matches of all our orders in a batch are
+// partially rejected with the given reason.
+func TestRejectAllMatches(t *testing.T) {
+	t.Parallel()
+
+	newMatch := func(nonce Nonce) *MatchedOrder {
+		return &MatchedOrder{
+			Order: &Bid{Kit: *NewKit(nonce)},
+		}
+	}
+	batch := &Batch{
+		MatchedOrders: map[Nonce][]*MatchedOrder{
+			{1}: {newMatch(Nonce{3}), newMatch(Nonce{4})},
+			{2}: {newMatch(Nonce{5})},
+		},
+	}
+
+	reason := errors.New("batch was not confirmed")
+	rejectErr := RejectAllMatches(batch, reason)
+	require.Len(t, rejectErr.RejectedOrders, 3)
+	for _, nonce := range []Nonce{{3}, {4}, {5}} {
+		reject, ok := rejectErr.RejectedOrders[nonce]
+		require.True(t, ok)
+		require.Equal(t, reason.Error(), reject.Reason)
+		require.Equal(
+			t, auctioneerrpc.OrderReject_DUPLICATE_PEER,
+			reject.ReasonCode,
+		)
+	}
+}
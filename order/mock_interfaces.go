@@ -375,6 +375,18 @@ func (mr *MockManagerMockRecorder) PrepareOrder(ctx, order, acct, terms interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareOrder", reflect.TypeOf((*MockManager)(nil).PrepareOrder), ctx, order, acct, terms)
 }
 
+// SetBatchVersion mocks base method.
+func (m *MockManager) SetBatchVersion(version BatchVersion) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBatchVersion", version)
+}
+
+// SetBatchVersion indicates an expected call of SetBatchVersion.
+func (mr *MockManagerMockRecorder) SetBatchVersion(version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBatchVersion", reflect.TypeOf((*MockManager)(nil).SetBatchVersion), version)
+}
+
 // Start mocks base method.
 func (m *MockManager) Start() error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,96 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// RepricedRate returns the rate the unfulfilled remainder of the given order
+// is submitted at again if it's repriced to the given clearing rate. The
+// order's own rate is the limit the trader agreed to, so a bid is never
+// repriced above and an ask never below it. An order that was matched in a
+// batch always has a rate at least as good for the trader as the clearing
+// rate, so in practice this moves a bid down and an ask up to the clearing
+// rate.
+func RepricedRate(o Order, clearingRate uint32) uint32 {
+	limit := o.Details().FixedRate
+
+	switch o.Type() {
+	case TypeBid:
+		if clearingRate > limit {
+			return limit
+		}
+
+	case TypeAsk:
+		if clearingRate < limit {
+			return limit
+		}
+	}
+
+	return clearingRate
+}
+
+// RepriceRemainder creates a new order for the unfulfilled units of the given
+// order at the given fixed rate. The new order gets a fresh nonce, all other
+// order parameters are copied from the given order.
+func RepriceRemainder(o Order, fixedRate uint32) (Order, error) {
+	details := o.Details()
+	if details.UnitsUnfulfilled == 0 {
+		return nil, errors.New("order has no unfulfilled units")
+	}
+
+	preimageBytes, err := randomPreimage()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %v", err)
+	}
+	var preimage lntypes.Preimage
+	copy(preimage[:], preimageBytes)
+
+	kit := *details
+	newKit := NewKitWithPreimage(preimage)
+	kit.nonce = newKit.nonce
+	kit.Preimage = preimage
+	kit.State = StateSubmitted
+	kit.FixedRate = fixedRate
+	kit.Units = details.UnitsUnfulfilled
+	kit.UnitsUnfulfilled = details.UnitsUnfulfilled
+	kit.Amt = details.UnitsUnfulfilled.ToSatoshis()
+	if kit.MinUnitsMatch > kit.Units {
+		kit.MinUnitsMatch = kit.Units
+	}
+
+	// A new multisig key is derived when the order is prepared for
+	// submission.
+	kit.MultiSigKeyLocator = keychain.KeyLocator{}
+
+	return withKit(o, kit)
+}
+
+// withKit returns a copy of the given order that uses the given kit instead of
+// its own.
+func withKit(o Order, kit Kit) (Order, error) {
+	switch castOrder := o.(type) {
+	case *Ask:
+		ask := *castOrder
+		ask.Kit = kit
+		return &ask, nil
+
+	case *Bid:
+		// The sidecar ticket is bound to the nonce of the original
+		// order, so it can't be moved to a new one.
+		if castOrder.SidecarTicket != nil {
+			return nil, errors.New("cannot copy sidecar bid to new " +
+				"order")
+		}
+
+		bid := *castOrder
+		bid.Kit = kit
+		return &bid, nil
+
+	default:
+		return nil, fmt.Errorf("unknown order type %T", o)
+	}
+}
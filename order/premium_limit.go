@@ -0,0 +1,75 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// MatchedPremium returns the total premium that is paid (for a bid) or earned
+// (for an ask) for all the given matches of our order at the given clearing
+// price.
+func MatchedPremium(ourOrder Order, matches []*MatchedOrder,
+	clearingPrice FixedRatePremium) btcutil.Amount {
+
+	duration := ourOrder.Details().LeaseDuration
+	outbound := ourOrder.Details().AuctionType == BTCOutboundLiquidity
+
+	var total btcutil.Amount
+	for _, match := range matches {
+		premiumAmt := match.UnitsFilled.ToSatoshis()
+
+		// For outbound liquidity, the premium is also paid on the
+		// bidder's self channel balance.
+		if outbound {
+			switch ours := ourOrder.(type) {
+			case *Ask:
+				if other, ok := match.Order.(*Bid); ok {
+					premiumAmt += other.SelfChanBalance
+				}
+
+			case *Bid:
+				premiumAmt += ours.SelfChanBalance
+			}
+		}
+
+		total += clearingPrice.LumpSumPremium(premiumAmt, duration)
+	}
+
+	return total
+}
+
+// CheckPremiumLimit makes sure the total premium of all matches of our order
+// in a batch doesn't exceed the maximum premium of a bid or fall short of the
+// minimum premium of an ask.
+func CheckPremiumLimit(ourOrder Order, matches []*MatchedOrder,
+	clearingPrice FixedRatePremium) error {
+
+	switch o := ourOrder.(type) {
+	case *Ask:
+		if o.MinPremium == 0 {
+			return nil
+		}
+
+		premium := MatchedPremium(o, matches, clearingPrice)
+		if premium < o.MinPremium {
+			return fmt.Errorf("premium of %v for ask %v is below "+
+				"its minimum premium of %v", premium,
+				o.Nonce(), o.MinPremium)
+		}
+
+	case *Bid:
+		if o.MaxPremium == 0 {
+			return nil
+		}
+
+		premium := MatchedPremium(o, matches, clearingPrice)
+		if premium > o.MaxPremium {
+			return fmt.Errorf("premium of %v for bid %v exceeds "+
+				"its maximum premium of %v", premium,
+				o.Nonce(), o.MaxPremium)
+		}
+	}
+
+	return nil
+}
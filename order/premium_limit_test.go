@@ -0,0 +1,108 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckPremiumLimit tests that the premium of a batch match is computed
+// at the clearing price and enforced against the premium limits of our
+// orders.
+func TestCheckPremiumLimit(t *testing.T) {
+	t.Parallel()
+
+	const (
+		duration      = 2016
+		clearingPrice = FixedRatePremium(10_000)
+	)
+
+	// 10 units at a rate of 10_000 parts per billion per block is a premium
+	// of 10 satoshis per block, so 20_160 satoshis over the lease duration.
+	const expectedPremium = btcutil.Amount(20_160)
+
+	newAsk := func(minPremium btcutil.Amount) *Ask {
+		return &Ask{
+			Kit: newKitFromTemplate(Nonce{0x01}, &Kit{
+				LeaseDuration: duration,
+			}),
+			MinPremium: minPremium,
+		}
+	}
+	newBid := func(maxPremium btcutil.Amount) *Bid {
+		return &Bid{
+			Kit: newKitFromTemplate(Nonce{0x02}, &Kit{
+				LeaseDuration: duration,
+			}),
+			MaxPremium: maxPremium,
+		}
+	}
+
+	// Our order is matched with two orders of 5 units each.
+	askMatches := []*MatchedOrder{
+		{Order: newBid(0), UnitsFilled: 5},
+		{Order: newBid(0), UnitsFilled: 5},
+	}
+	bidMatches := []*MatchedOrder{
+		{Order: newAsk(0), UnitsFilled: 5},
+		{Order: newAsk(0), UnitsFilled: 5},
+	}
+
+	testCases := []struct {
+		name      string
+		ourOrder  Order
+		matches   []*MatchedOrder
+		expectErr string
+	}{{
+		name:     "ask without limit",
+		ourOrder: newAsk(0),
+		matches:  askMatches,
+	}, {
+		name:     "ask premium at minimum",
+		ourOrder: newAsk(expectedPremium),
+		matches:  askMatches,
+	}, {
+		name:      "ask premium below minimum",
+		ourOrder:  newAsk(expectedPremium + 1),
+		matches:   askMatches,
+		expectErr: "is below its minimum premium",
+	}, {
+		name:     "bid without limit",
+		ourOrder: newBid(0),
+		matches:  bidMatches,
+	}, {
+		name:     "bid premium at maximum",
+		ourOrder: newBid(expectedPremium),
+		matches:  bidMatches,
+	}, {
+		name:      "bid premium above maximum",
+		ourOrder:  newBid(expectedPremium - 1),
+		matches:   bidMatches,
+		expectErr: "exceeds its maximum premium",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			premium := MatchedPremium(
+				tc.ourOrder, tc.matches, clearingPrice,
+			)
+			require.Equal(t, expectedPremium, premium)
+
+			err := CheckPremiumLimit(
+				tc.ourOrder, tc.matches, clearingPrice,
+			)
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectErr)
+		})
+	}
+}
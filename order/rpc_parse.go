@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"unicode/utf8"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
@@ -147,6 +148,30 @@ func ParseRPCOrder(version, leaseDuration uint32,
 
 	kit.IsPublic = details.IsPublic
 
+	if utf8.RuneCountInString(details.Tag) > MaxTagLength {
+		return nil, fmt.Errorf("order tag cannot be longer than %d "+
+			"characters", MaxTagLength)
+	}
+	kit.Tag = details.Tag
+
+	switch details.PartialMatchPolicy {
+	case poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_KEEP:
+		kit.PartialMatchPolicy = PartialMatchKeep
+
+	case poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_CANCEL:
+		kit.PartialMatchPolicy = PartialMatchCancel
+
+	case poolrpc.PartialMatchPolicy_PARTIAL_MATCH_POLICY_REPRICE:
+		kit.PartialMatchPolicy = PartialMatchReprice
+
+	default:
+		return nil, fmt.Errorf("unhandled partial match policy %v",
+			details.PartialMatchPolicy)
+	}
+
+	kit.AutoRecreate = details.AutoRecreate
+	kit.AutoRecreateLimit = details.AutoRecreateLimit
+
 	return kit, nil
 }
 
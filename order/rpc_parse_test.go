@@ -0,0 +1,28 @@
+package order
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseRPCOrderTag makes sure the length of an order tag is limited by
+// the number of characters, not bytes.
+func TestParseRPCOrderTag(t *testing.T) {
+	t.Parallel()
+
+	details := &poolrpc.Order{
+		Amt:           100_000,
+		MinUnitsMatch: 1,
+		Tag:           strings.Repeat("ä", MaxTagLength),
+	}
+	kit, err := ParseRPCOrder(0, 0, details)
+	require.NoError(t, err)
+	require.Equal(t, details.Tag, kit.Tag)
+
+	details.Tag += "a"
+	_, err = ParseRPCOrder(0, 0, details)
+	require.ErrorContains(t, err, "cannot be longer than 64 characters")
+}
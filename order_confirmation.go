@@ -0,0 +1,121 @@
+package pool
+
+import (
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// orderConfirmationTimeout is the time after which a confirmation
+	// token of a prepared order expires.
+	orderConfirmationTimeout = 5 * time.Minute
+
+	// orderConfirmationTokenSize is the size of a confirmation token in
+	// bytes.
+	orderConfirmationTokenSize = 32
+)
+
+var (
+	// errUnknownConfirmationToken is returned if a confirmation token is
+	// not known, either because it was never issued or because it has
+	// already been used.
+	errUnknownConfirmationToken = errors.New("unknown or already used " +
+		"order confirmation token")
+
+	// errExpiredConfirmationToken is returned if a confirmation token is
+	// used after it expired.
+	errExpiredConfirmationToken = errors.New("order confirmation token " +
+		"expired, prepare the order again")
+)
+
+// preparedOrder is an order that was prepared but not yet confirmed.
+type preparedOrder struct {
+	req    *poolrpc.SubmitOrderRequest
+	expiry time.Time
+}
+
+// orderConfirmations keeps track of all prepared orders that are waiting for
+// their confirmation.
+type orderConfirmations struct {
+	clock   clock.Clock
+	timeout time.Duration
+
+	mu       sync.Mutex
+	prepared map[[orderConfirmationTokenSize]byte]*preparedOrder
+}
+
+// newOrderConfirmations creates a new store for prepared orders whose tokens
+// expire after the given timeout.
+func newOrderConfirmations(clk clock.Clock,
+	timeout time.Duration) *orderConfirmations {
+
+	return &orderConfirmations{
+		clock:    clk,
+		timeout:  timeout,
+		prepared: make(map[[orderConfirmationTokenSize]byte]*preparedOrder),
+	}
+}
+
+// add stores the given order request and returns the token that needs to be
+// presented to confirm it together with the token's expiry.
+func (c *orderConfirmations) add(req *poolrpc.SubmitOrderRequest) (
+	[orderConfirmationTokenSize]byte, time.Time, error) {
+
+	var token [orderConfirmationTokenSize]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		return token, time.Time{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Tokens that were never used would otherwise pile up, so we clean up
+	// the expired ones whenever a new token is issued.
+	now := c.clock.Now()
+	for existingToken, prepared := range c.prepared {
+		if now.After(prepared.expiry) {
+			delete(c.prepared, existingToken)
+		}
+	}
+
+	expiry := now.Add(c.timeout)
+	c.prepared[token] = &preparedOrder{
+		req:    req,
+		expiry: expiry,
+	}
+
+	return token, expiry, nil
+}
+
+// take removes the order request with the given token from the store and
+// returns it. A token can only be taken once and only before it expires.
+func (c *orderConfirmations) take(
+	token []byte) (*poolrpc.SubmitOrderRequest, error) {
+
+	if len(token) != orderConfirmationTokenSize {
+		return nil, errUnknownConfirmationToken
+	}
+
+	var key [orderConfirmationTokenSize]byte
+	copy(key[:], token)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prepared, ok := c.prepared[key]
+	if !ok {
+		return nil, errUnknownConfirmationToken
+	}
+	delete(c.prepared, key)
+
+	if c.clock.Now().After(prepared.expiry) {
+		return nil, errExpiredConfirmationToken
+	}
+
+	return prepared.req, nil
+}
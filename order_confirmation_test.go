@@ -0,0 +1,107 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+var orderConfirmationsTestCases = []struct {
+	name        string
+	timePassed  time.Duration
+	reuse       bool
+	badToken    []byte
+	expectedErr error
+}{{
+	name:       "confirmed within timeout",
+	timePassed: orderConfirmationTimeout,
+}, {
+	name:        "confirmed after timeout",
+	timePassed:  orderConfirmationTimeout + time.Second,
+	expectedErr: errExpiredConfirmationToken,
+}, {
+	name:        "token reused",
+	reuse:       true,
+	expectedErr: errUnknownConfirmationToken,
+}, {
+	name:        "unknown token",
+	badToken:    make([]byte, orderConfirmationTokenSize),
+	expectedErr: errUnknownConfirmationToken,
+}, {
+	name:        "token of wrong size",
+	badToken:    []byte{1, 2, 3},
+	expectedErr: errUnknownConfirmationToken,
+}}
+
+// TestOrderConfirmations makes sure a prepared order can only be confirmed
+// once with its token and only before the token expires.
+func TestOrderConfirmations(t *testing.T) {
+	for _, tc := range orderConfirmationsTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testClock := clock.NewTestClock(time.Unix(1000, 0))
+			confirmations := newOrderConfirmations(
+				testClock, orderConfirmationTimeout,
+			)
+
+			req := &poolrpc.SubmitOrderRequest{
+				Initiator: "test",
+			}
+			token, expiry, err := confirmations.add(req)
+			require.NoError(t, err)
+			require.Equal(
+				t, testClock.Now().Add(orderConfirmationTimeout),
+				expiry,
+			)
+
+			testClock.SetTime(testClock.Now().Add(tc.timePassed))
+
+			presented := token[:]
+			if tc.badToken != nil {
+				presented = tc.badToken
+			}
+			if tc.reuse {
+				_, err := confirmations.take(presented)
+				require.NoError(t, err)
+			}
+
+			confirmedReq, err := confirmations.take(presented)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, req, confirmedReq)
+		})
+	}
+}
+
+// TestOrderConfirmationsCleanup makes sure expired tokens are removed once a
+// new token is issued.
+func TestOrderConfirmationsCleanup(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	confirmations := newOrderConfirmations(
+		testClock, orderConfirmationTimeout,
+	)
+
+	_, _, err := confirmations.add(&poolrpc.SubmitOrderRequest{})
+	require.NoError(t, err)
+
+	testClock.SetTime(
+		testClock.Now().Add(orderConfirmationTimeout + time.Second),
+	)
+	token, _, err := confirmations.add(&poolrpc.SubmitOrderRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, confirmations.prepared, 1)
+	require.Contains(t, confirmations.prepared, token)
+}
@@ -0,0 +1,66 @@
+package pool
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+const (
+	// OrderRateTickPolicyRound is the rate tick policy that rounds the
+	// fixed rate of an order to the closest valid tick in the trader's
+	// favor. Bids are rounded down so they never pay more than requested,
+	// asks are rounded up so they never earn less than requested.
+	OrderRateTickPolicyRound = "round"
+
+	// OrderRateTickPolicyReject is the rate tick policy that refuses to
+	// submit orders with a fixed rate that isn't a valid tick.
+	OrderRateTickPolicyReject = "reject"
+)
+
+// applyRateTick makes sure the fixed rate of the order is a multiple of the
+// given tick size, either by rounding it to a valid tick in the trader's favor
+// or by returning an error, depending on the policy. If there is no valid tick
+// in the trader's favor, which is the case for bids below a single tick and
+// asks above the highest tick, an error is returned as well. The returned
+// boolean is true if the rate of the order was changed.
+func applyRateTick(o order.Order, tickSize uint32, policy string) (bool,
+	error) {
+
+	rate := o.Details().FixedRate
+	if tickSize == 0 || rate%tickSize == 0 {
+		return false, nil
+	}
+
+	switch policy {
+	case OrderRateTickPolicyRound, "":
+		// Bids pay the rate, so rounding them down is in the trader's
+		// favor. Asks earn the rate, so they are rounded up.
+		rounded := uint64(rate) / uint64(tickSize) * uint64(tickSize)
+		if o.Type() == order.TypeAsk {
+			rounded += uint64(tickSize)
+		}
+
+		switch {
+		case rounded == 0:
+			return false, fmt.Errorf("fixed rate %d of bid is below "+
+				"the rate tick size %d", rate, tickSize)
+
+		case rounded > uint64(^uint32(0)):
+			return false, fmt.Errorf("fixed rate %d of ask is above "+
+				"the highest multiple of the rate tick size %d",
+				rate, tickSize)
+		}
+
+		o.Details().FixedRate = uint32(rounded)
+
+		return true, nil
+
+	case OrderRateTickPolicyReject:
+		return false, fmt.Errorf("fixed rate %d is not a multiple of "+
+			"the rate tick size %d", rate, tickSize)
+
+	default:
+		return false, fmt.Errorf("unknown rate tick policy %q", policy)
+	}
+}
@@ -0,0 +1,139 @@
+package pool
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplyRateTick makes sure off-grid rates are rounded to a valid tick in
+// the trader's favor or rejected, depending on the policy.
+func TestApplyRateTick(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		rate         uint32
+		ask          bool
+		tickSize     uint32
+		policy       string
+		expectedRate uint32
+		rounded      bool
+		expectedErr  string
+	}{{
+		name:         "no tick size",
+		rate:         123,
+		policy:       OrderRateTickPolicyReject,
+		expectedRate: 123,
+	}, {
+		name:         "on grid",
+		rate:         150,
+		tickSize:     50,
+		policy:       OrderRateTickPolicyReject,
+		expectedRate: 150,
+	}, {
+		name:         "bid rounds down",
+		rate:         149,
+		tickSize:     50,
+		policy:       OrderRateTickPolicyRound,
+		expectedRate: 100,
+		rounded:      true,
+	}, {
+		name:         "ask rounds up",
+		rate:         101,
+		ask:          true,
+		tickSize:     50,
+		policy:       OrderRateTickPolicyRound,
+		expectedRate: 150,
+		rounded:      true,
+	}, {
+		name:        "bid below single tick",
+		rate:        10,
+		tickSize:    50,
+		policy:      OrderRateTickPolicyRound,
+		expectedErr: "below the rate tick size",
+	}, {
+		name:         "ask below single tick",
+		rate:         10,
+		ask:          true,
+		tickSize:     50,
+		policy:       OrderRateTickPolicyRound,
+		expectedRate: 50,
+		rounded:      true,
+	}, {
+		name:         "bid at maximum",
+		rate:         math.MaxUint32,
+		tickSize:     10,
+		policy:       OrderRateTickPolicyRound,
+		expectedRate: math.MaxUint32 - 5,
+		rounded:      true,
+	}, {
+		name:        "ask never overflows",
+		rate:        math.MaxUint32,
+		ask:         true,
+		tickSize:    10,
+		policy:      OrderRateTickPolicyRound,
+		expectedErr: "above the highest multiple",
+	}, {
+		name:        "reject off grid",
+		rate:        124,
+		tickSize:    50,
+		policy:      OrderRateTickPolicyReject,
+		expectedErr: "not a multiple of the rate tick size",
+	}}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var o order.Order = &order.Bid{
+				Kit: order.Kit{FixedRate: tc.rate},
+			}
+			if tc.ask {
+				o = &order.Ask{
+					Kit: order.Kit{FixedRate: tc.rate},
+				}
+			}
+			rounded, err := applyRateTick(o, tc.tickSize, tc.policy)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.rounded, rounded)
+			require.Equal(t, tc.expectedRate, o.Details().FixedRate)
+		})
+	}
+}
+
+// TestSubmitOrderRateTick makes sure an off-grid rate is either rounded before
+// the order is sent to the auctioneer or the order is refused.
+func TestSubmitOrderRateTick(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockAuctioneerClient{}
+	srv, acct := newMockAuctioneerServer(t, mock)
+	srv.server.cfg.OrderRateTick = 30
+	ctx := context.Background()
+
+	// The mock bid has a rate of 100 which is rounded down to the closest
+	// tick, so the bidder doesn't pay more than requested.
+	srv.server.cfg.OrderRateTickPolicy = OrderRateTickPolicyRound
+	resp, err := srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.NoError(t, err)
+	require.EqualValues(t, 90, resp.RateFixed)
+
+	require.Len(t, mock.submitted, 1)
+	require.EqualValues(t, 90, mock.submitted[0].GetBid().Details.RateFixed)
+
+	// With the reject policy, the order isn't sent to the auctioneer.
+	srv.server.cfg.OrderRateTickPolicy = OrderRateTickPolicyReject
+	_, err = srv.SubmitOrder(ctx, newMockBidRequest(acct))
+	require.ErrorContains(t, err, "rate tick size 30")
+	require.Len(t, mock.submitted, 1)
+}
@@ -0,0 +1,93 @@
+package pool
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+// orderReceipt is the audit record of an order that was accepted by the
+// auction server.
+type orderReceipt struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Nonce           string    `json:"nonce"`
+	Type            string    `json:"type"`
+	TraderKey       string    `json:"trader_key"`
+	AuctionType     string    `json:"auction_type"`
+	AmtSat          uint64    `json:"amt_sat"`
+	RateFixed       uint32    `json:"rate_fixed"`
+	LeaseDuration   uint32    `json:"lease_duration_blocks"`
+	MinUnitsMatch   uint32    `json:"min_units_match"`
+	MaxBatchFeeRate uint64    `json:"max_batch_fee_rate_sat_per_kw"`
+	ChannelType     uint8     `json:"channel_type"`
+	IsPublic        bool      `json:"is_public"`
+	Tag             string    `json:"tag,omitempty"`
+
+	// The following fields are only set for bids.
+	MinNodeTier     *uint32 `json:"min_node_tier,omitempty"`
+	SelfChanBalance *uint64 `json:"self_chan_balance,omitempty"`
+	IsSidecar       bool    `json:"is_sidecar,omitempty"`
+}
+
+// newOrderReceipt creates the receipt of the given order that was submitted at
+// the given time.
+func newOrderReceipt(o order.Order, timestamp time.Time) *orderReceipt {
+	details := o.Details()
+	receipt := &orderReceipt{
+		Timestamp:       timestamp.UTC(),
+		Nonce:           o.Nonce().String(),
+		Type:            o.Type().String(),
+		TraderKey:       hex.EncodeToString(details.AcctKey[:]),
+		AuctionType:     details.AuctionType.String(),
+		AmtSat:          uint64(details.Amt),
+		RateFixed:       details.FixedRate,
+		LeaseDuration:   details.LeaseDuration,
+		MinUnitsMatch:   uint32(details.MinUnitsMatch),
+		MaxBatchFeeRate: uint64(details.MaxBatchFeeRate),
+		ChannelType:     uint8(details.ChannelType),
+		IsPublic:        details.IsPublic,
+		Tag:             details.Tag,
+	}
+
+	if bid, ok := o.(*order.Bid); ok {
+		minNodeTier := uint32(bid.MinNodeTier)
+		selfChanBalance := uint64(bid.SelfChanBalance)
+		receipt.MinNodeTier = &minNodeTier
+		receipt.SelfChanBalance = &selfChanBalance
+		receipt.IsSidecar = bid.SidecarTicket != nil
+	}
+
+	return receipt
+}
+
+// writeOrderReceipt writes the receipt as a JSON file to the given directory,
+// which is created if it doesn't exist yet. The file is named after the time
+// and the nonce of the order so receipts are listed in submission order. The
+// full path of the written file is returned.
+func writeOrderReceipt(dir string, receipt *orderReceipt) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("unable to create order receipt "+
+			"directory: %v", err)
+	}
+
+	receiptBytes, err := json.MarshalIndent(receipt, "", "\t")
+	if err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf(
+		"%s_%s.json", receipt.Timestamp.Format("20060102T150405.000000000Z"),
+		receipt.Nonce,
+	)
+	path := filepath.Join(dir, fileName)
+	if err := os.WriteFile(path, receiptBytes, 0600); err != nil {
+		return "", fmt.Errorf("unable to write order receipt: %v", err)
+	}
+
+	return path, nil
+}
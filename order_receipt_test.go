@@ -0,0 +1,90 @@
+package pool
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteOrderReceipt makes sure a receipt file with the parameters of the
+// submitted order is created in the configured directory.
+func TestWriteOrderReceipt(t *testing.T) {
+	t.Parallel()
+
+	var (
+		timestamp = time.Date(2022, 3, 4, 5, 6, 7, 8, time.UTC)
+		dir       = filepath.Join(t.TempDir(), "receipts")
+		nonce     = order.Nonce{1, 2, 3}
+		acctKey   = [33]byte{2, 9, 9}
+	)
+
+	bid := &order.Bid{
+		Kit:             *order.NewKit(nonce),
+		MinNodeTier:     order.NodeTier1,
+		SelfChanBalance: 50_000,
+	}
+	bid.AcctKey = acctKey
+	bid.Amt = 1_000_000
+	bid.FixedRate = 1234
+	bid.LeaseDuration = 2016
+	bid.MinUnitsMatch = 2
+	bid.MaxBatchFeeRate = chainfee.FeePerKwFloor
+	bid.Tag = "audit"
+
+	// The receipt directory is created on the first write.
+	path, err := writeOrderReceipt(dir, newOrderReceipt(bid, timestamp))
+	require.NoError(t, err)
+	require.Equal(t, dir, filepath.Dir(path))
+	require.Equal(
+		t, "20220304T050607.000000008Z_"+nonce.String()+".json",
+		filepath.Base(path),
+	)
+
+	receiptBytes, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var receipt map[string]interface{}
+	require.NoError(t, json.Unmarshal(receiptBytes, &receipt))
+	require.Equal(t, map[string]interface{}{
+		"timestamp":                     "2022-03-04T05:06:07.000000008Z",
+		"nonce":                         nonce.String(),
+		"type":                          "Bid",
+		"trader_key":                    "020909" + strings.Repeat("00", 30),
+		"auction_type":                  "btc_inbound_liquidity",
+		"amt_sat":                       float64(1_000_000),
+		"rate_fixed":                    float64(1234),
+		"lease_duration_blocks":         float64(2016),
+		"min_units_match":               float64(2),
+		"max_batch_fee_rate_sat_per_kw": float64(chainfee.FeePerKwFloor),
+		"channel_type":                  float64(0),
+		"is_public":                     false,
+		"tag":                           "audit",
+		"min_node_tier":                 float64(order.NodeTier1),
+		"self_chan_balance":             float64(50_000),
+	}, receipt)
+
+	// Asks don't have any of the bid specific fields.
+	ask := &order.Ask{Kit: *order.NewKit(order.Nonce{4})}
+	path, err = writeOrderReceipt(dir, newOrderReceipt(ask, timestamp))
+	require.NoError(t, err)
+
+	receiptBytes, err = os.ReadFile(path)
+	require.NoError(t, err)
+
+	receipt = nil
+	require.NoError(t, json.Unmarshal(receiptBytes, &receipt))
+	require.Equal(t, "Ask", receipt["type"])
+	require.NotContains(t, receipt, "min_node_tier")
+	require.NotContains(t, receipt, "self_chan_balance")
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
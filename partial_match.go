@@ -0,0 +1,107 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+// partialMatchHandler applies the partial match policy of our orders after a
+// batch they were partially matched in was finalized.
+type partialMatchHandler struct {
+	// getOrder fetches the current state of an order from the local
+	// database.
+	getOrder func(order.Nonce) (order.Order, error)
+
+	// cancelOrder cancels the given order with the auctioneer and locally.
+	cancelOrder func(context.Context, order.Order) error
+
+	// submitOrder submits the given new order to the auctioneer.
+	submitOrder func(context.Context, order.Order) error
+}
+
+// handleBatch applies the partial match policy of all our orders that were
+// matched in the given batch. Errors are only logged as the batch itself was
+// already finalized successfully.
+func (h *partialMatchHandler) handleBatch(ctx context.Context,
+	batch *order.Batch) {
+
+	for nonce := range batch.MatchedOrders {
+		if err := h.handleOrder(ctx, batch, nonce); err != nil {
+			rpcLog.Errorf("Unable to apply partial match "+
+				"policy of order %v: %v", nonce, err)
+		}
+	}
+}
+
+// handleOrder applies the partial match policy of a single order if it was
+// only partially filled in the given batch.
+func (h *partialMatchHandler) handleOrder(ctx context.Context,
+	batch *order.Batch, nonce order.Nonce) error {
+
+	o, err := h.getOrder(nonce)
+	if err != nil {
+		return err
+	}
+
+	details := o.Details()
+	if details.State != order.StatePartiallyFilled {
+		return nil
+	}
+
+	switch details.PartialMatchPolicy {
+	case order.PartialMatchKeep:
+		return nil
+
+	case order.PartialMatchCancel:
+		rpcLog.Infof("Cancelling remaining %d units of partially "+
+			"matched order %v", details.UnitsUnfulfilled, nonce)
+
+		return h.cancelOrder(ctx, o)
+
+	case order.PartialMatchReprice:
+		clearingPrice, ok := batch.ClearingPrices[details.LeaseDuration]
+		if !ok {
+			return fmt.Errorf("no clearing price for duration %d "+
+				"in batch %x", details.LeaseDuration,
+				batch.ID[:])
+		}
+
+		// If the order already asks for the clearing rate, there is
+		// nothing to adjust and we keep the remainder as is.
+		newRate := order.RepricedRate(o, uint32(clearingPrice))
+		if newRate == details.FixedRate {
+			rpcLog.Infof("Keeping remaining %d units of partially "+
+				"matched order %v, fixed rate %d already "+
+				"matches clearing rate %d",
+				details.UnitsUnfulfilled, nonce,
+				details.FixedRate, clearingPrice)
+
+			return nil
+		}
+
+		repriced, err := order.RepriceRemainder(o, newRate)
+		if err != nil {
+			return err
+		}
+
+		rpcLog.Infof("Repricing remaining %d units of partially "+
+			"matched order %v at fixed rate %d as order %v",
+			details.UnitsUnfulfilled, nonce, newRate,
+			repriced.Nonce())
+
+		// The remainder must be removed from the order book before we
+		// submit it again, otherwise the same units could be matched
+		// twice.
+		if err := h.cancelOrder(ctx, o); err != nil {
+			return err
+		}
+
+		return h.submitOrder(ctx, repriced)
+
+	default:
+		return fmt.Errorf("unknown partial match policy %d",
+			details.PartialMatchPolicy)
+	}
+}
@@ -0,0 +1,179 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPartialMatchDuration = 2016
+	testClearingRate         = 1234
+)
+
+var partialMatchTestCases = []struct {
+	name           string
+	ask            bool
+	fixedRate      uint32
+	policy         order.PartialMatchPolicy
+	state          order.State
+	expectCancel   bool
+	expectRepriced bool
+}{{
+	name:      "keep remainder",
+	fixedRate: 2000,
+	policy:    order.PartialMatchKeep,
+	state:     order.StatePartiallyFilled,
+}, {
+	name:         "cancel remainder",
+	fixedRate:    2000,
+	policy:       order.PartialMatchCancel,
+	state:        order.StatePartiallyFilled,
+	expectCancel: true,
+}, {
+	name:           "reprice bid above clearing rate",
+	fixedRate:      2000,
+	policy:         order.PartialMatchReprice,
+	state:          order.StatePartiallyFilled,
+	expectCancel:   true,
+	expectRepriced: true,
+}, {
+	name:      "reprice bid at clearing rate",
+	fixedRate: testClearingRate,
+	policy:    order.PartialMatchReprice,
+	state:     order.StatePartiallyFilled,
+}, {
+	name:           "reprice ask below clearing rate",
+	ask:            true,
+	fixedRate:      1000,
+	policy:         order.PartialMatchReprice,
+	state:          order.StatePartiallyFilled,
+	expectCancel:   true,
+	expectRepriced: true,
+}, {
+	name:      "reprice ask at clearing rate",
+	ask:       true,
+	fixedRate: testClearingRate,
+	policy:    order.PartialMatchReprice,
+	state:     order.StatePartiallyFilled,
+}, {
+	name:      "fully filled order",
+	fixedRate: 2000,
+	policy:    order.PartialMatchCancel,
+	state:     order.StateExecuted,
+}}
+
+// TestPartialMatchHandler makes sure the remainder of a partially matched
+// order is handled according to the order's partial match policy.
+func TestPartialMatchHandler(t *testing.T) {
+	for _, tc := range partialMatchTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kit := order.NewKit(order.Nonce{1, 2, 3})
+			kit.State = tc.state
+			kit.PartialMatchPolicy = tc.policy
+			kit.FixedRate = tc.fixedRate
+			kit.LeaseDuration = testPartialMatchDuration
+			kit.Units = 10
+			kit.UnitsUnfulfilled = 4
+			kit.Amt = kit.Units.ToSatoshis()
+			kit.MinUnitsMatch = 5
+			var o order.Order = &order.Bid{
+				Kit:         *kit,
+				MinNodeTier: order.NodeTier1,
+			}
+			if tc.ask {
+				o = &order.Ask{Kit: *kit}
+			}
+
+			matched := map[order.Nonce][]*order.MatchedOrder{
+				o.Nonce(): nil,
+			}
+			clearingPrices := map[uint32]order.FixedRatePremium{
+				testPartialMatchDuration: testClearingRate,
+			}
+			batch := &order.Batch{
+				MatchedOrders:  matched,
+				ClearingPrices: clearingPrices,
+			}
+
+			var (
+				canceled []order.Nonce
+				repriced []order.Order
+			)
+			handler := &partialMatchHandler{
+				getOrder: func(nonce order.Nonce) (order.Order,
+					error) {
+
+					require.Equal(t, o.Nonce(), nonce)
+					return o, nil
+				},
+				cancelOrder: func(_ context.Context,
+					c order.Order) error {
+
+					canceled = append(canceled, c.Nonce())
+					return nil
+				},
+				submitOrder: func(_ context.Context,
+					s order.Order) error {
+
+					// The remainder must only be submitted
+					// once the original order was canceled.
+					require.Len(t, canceled, 1)
+					repriced = append(repriced, s)
+					return nil
+				},
+			}
+			handler.handleBatch(context.Background(), batch)
+
+			if !tc.expectCancel {
+				require.Empty(t, canceled)
+			} else {
+				require.Equal(
+					t, []order.Nonce{o.Nonce()}, canceled,
+				)
+			}
+
+			if !tc.expectRepriced {
+				require.Empty(t, repriced)
+				return
+			}
+
+			require.Len(t, repriced, 1)
+			newOrder := repriced[0]
+			require.Equal(t, o.Type(), newOrder.Type())
+
+			details := newOrder.Details()
+			require.NotEqual(t, o.Nonce(), newOrder.Nonce())
+			require.Equal(
+				t, newOrder.Nonce(),
+				order.Nonce(details.Preimage.Hash()),
+			)
+			require.Equal(t, order.StateSubmitted, details.State)
+			require.EqualValues(
+				t, testClearingRate, details.FixedRate,
+			)
+
+			remainder := o.Details().UnitsUnfulfilled
+			require.Equal(t, remainder, details.Units)
+			require.Equal(t, remainder, details.UnitsUnfulfilled)
+			require.Equal(t, remainder.ToSatoshis(), details.Amt)
+			require.Equal(t, remainder, details.MinUnitsMatch)
+			require.Equal(
+				t, order.PartialMatchReprice,
+				details.PartialMatchPolicy,
+			)
+
+			if newBid, ok := newOrder.(*order.Bid); ok {
+				require.Equal(
+					t, order.NodeTier1, newBid.MinNodeTier,
+				)
+			}
+		})
+	}
+}
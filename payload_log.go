@@ -0,0 +1,209 @@
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btclog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// redactedValue is logged in place of the value of a redacted field.
+	redactedValue = "[redacted]"
+)
+
+var (
+	// redactedPayloadFields is the set of proto field names whose values
+	// are never written to the log when logging the payloads exchanged
+	// with the auction server, in addition to all signature fields that
+	// end in one of redactedPayloadSuffixes. Anyone knowing an order nonce
+	// preimage can cancel the order and the signatures authenticate the
+	// trader.
+	redactedPayloadFields = map[string]struct{}{
+		"order_nonce_preimage": {},
+		"sig_script":           {},
+	}
+
+	// redactedPayloadSuffixes are the suffixes of the proto field names
+	// that hold signatures, like auth_sig or account_sigs.
+	redactedPayloadSuffixes = []string{"_sig", "_sigs"}
+
+	// payloadMarshaler is the marshaler used to turn logged payloads into
+	// JSON, using the same field names as the proto files.
+	payloadMarshaler = protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+)
+
+// redactPayload returns the JSON representation of the given message with the
+// values of all fields in redactedPayloadFields replaced.
+func redactPayload(msg interface{}) string {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return fmt.Sprintf("<non-proto message %T>", msg)
+	}
+
+	jsonBytes, err := payloadMarshaler.Marshal(protoMsg)
+	if err != nil {
+		return fmt.Sprintf("<unable to marshal %T: %v>", msg, err)
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(jsonBytes, &payload); err != nil {
+		return fmt.Sprintf("<unable to decode %T: %v>", msg, err)
+	}
+
+	redactedBytes, err := json.Marshal(redactFields(payload))
+	if err != nil {
+		return fmt.Sprintf("<unable to encode %T: %v>", msg, err)
+	}
+
+	return string(redactedBytes)
+}
+
+// isRedactedField returns true if the value of the proto field with the given
+// name must not be logged.
+func isRedactedField(name string) bool {
+	if _, ok := redactedPayloadFields[name]; ok {
+		return true
+	}
+
+	for _, suffix := range redactedPayloadSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactFields recursively replaces the values of all redacted fields in the
+// decoded JSON value.
+func redactFields(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if isRedactedField(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactFields(fieldValue)
+		}
+
+	case []interface{}:
+		for idx := range v {
+			v[idx] = redactFields(v[idx])
+		}
+	}
+
+	return value
+}
+
+// logPayload logs the redacted payload of a message that was sent to or
+// received from the auction server. Nothing is marshaled unless the logger is
+// set to the trace level.
+func logPayload(logger btclog.Logger, method, direction string,
+	msg interface{}) {
+
+	if logger.Level() > btclog.LevelTrace {
+		return
+	}
+
+	logger.Tracef("[%v] %s %T: %s", method, direction, msg,
+		redactPayload(msg))
+}
+
+// payloadLogUnaryClientInterceptor is a UnaryClientInterceptor that logs the
+// request and response payloads of all unary calls to the auction server.
+func payloadLogUnaryClientInterceptor(
+	logger btclog.Logger) grpc.UnaryClientInterceptor {
+
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+
+		logPayload(logger, method, "sent", req)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			return err
+		}
+
+		logPayload(logger, method, "received", reply)
+
+		return nil
+	}
+}
+
+// payloadLoggingClientStream wraps around a client stream to log all messages
+// that are sent or received on it.
+type payloadLoggingClientStream struct {
+	grpc.ClientStream
+
+	methodName string
+
+	logger btclog.Logger
+}
+
+// RecvMsg receives a message and logs its payload if successful.
+func (p *payloadLoggingClientStream) RecvMsg(m interface{}) error {
+	if err := p.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	logPayload(p.logger, p.methodName, "received", m)
+
+	return nil
+}
+
+// SendMsg sends a message and logs its payload if successful.
+func (p *payloadLoggingClientStream) SendMsg(m interface{}) error {
+	if err := p.ClientStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	logPayload(p.logger, p.methodName, "sent", m)
+
+	return nil
+}
+
+// payloadLogStreamClientInterceptor is a StreamClientInterceptor that logs the
+// payloads of all messages exchanged on streams with the auction server.
+func payloadLogStreamClientInterceptor(
+	logger btclog.Logger) grpc.StreamClientInterceptor {
+
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return &payloadLoggingClientStream{
+			ClientStream: stream,
+			logger:       logger,
+			methodName:   method,
+		}, nil
+	}
+}
+
+// payloadLogDialOpts returns the dial options that add the payload logging
+// interceptors to the connection to the auction server.
+func payloadLogDialOpts(logger btclog.Logger) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(
+			payloadLogUnaryClientInterceptor(logger),
+		),
+		grpc.WithChainStreamInterceptor(
+			payloadLogStreamClientInterceptor(logger),
+		),
+	}
+}
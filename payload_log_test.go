@@ -0,0 +1,136 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// mockClientStream is a client stream that echoes back the last message that
+// was sent on it.
+type mockClientStream struct {
+	grpc.ClientStream
+
+	lastMsg *auctioneerrpc.ClientAuctionMessage
+}
+
+func (m *mockClientStream) SendMsg(msg interface{}) error {
+	m.lastMsg = msg.(*auctioneerrpc.ClientAuctionMessage)
+	return nil
+}
+
+func (m *mockClientStream) RecvMsg(msg interface{}) error {
+	proto.Merge(msg.(*auctioneerrpc.ClientAuctionMessage), m.lastMsg)
+	return nil
+}
+
+// TestPayloadLogInterceptors makes sure the payloads exchanged with the
+// auction server are logged with all secrets redacted when the trace level is
+// enabled.
+func TestPayloadLogInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		logBuf    bytes.Buffer
+		logger    = btclog.NewBackend(&logBuf).Logger("AUCT")
+		preimage  = bytes.Repeat([]byte{0xaa}, 32)
+		authSig   = bytes.Repeat([]byte{0xbb}, 64)
+		traderKey = bytes.Repeat([]byte{0x02}, 33)
+	)
+
+	const method = "/poolrpc.ChannelAuctioneer/OrderCancel"
+	unary := payloadLogUnaryClientInterceptor(logger)
+	invoker := func(context.Context, string, interface{}, interface{},
+		*grpc.ClientConn, ...grpc.CallOption) error {
+
+		return nil
+	}
+	req := &auctioneerrpc.ServerCancelOrderRequest{
+		OrderNoncePreimage: preimage,
+	}
+	reply := &auctioneerrpc.ServerCancelOrderResponse{}
+
+	// Nothing is logged below the trace level.
+	logger.SetLevel(btclog.LevelDebug)
+	err := unary(context.Background(), method, req, reply, nil, invoker)
+	require.NoError(t, err)
+	require.Zero(t, logBuf.Len())
+
+	// With the trace level, both the request and the response are logged
+	// but the preimage is redacted.
+	logger.SetLevel(btclog.LevelTrace)
+	err = unary(context.Background(), method, req, reply, nil, invoker)
+	require.NoError(t, err)
+
+	logged := logBuf.String()
+	require.Contains(t, logged, method+"] sent")
+	require.Contains(t, logged, method+"] received")
+	require.Contains(t, logged, `"order_nonce_preimage":"[redacted]"`)
+	require.NotContains(t, logged, base64.StdEncoding.EncodeToString(preimage))
+
+	// The messages on a stream are logged in both directions as well.
+	logBuf.Reset()
+	const streamMethod = "/poolrpc.ChannelAuctioneer/SubscribeBatchAuction"
+	streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn,
+		string, ...grpc.CallOption) (grpc.ClientStream, error) {
+
+		return &mockClientStream{}, nil
+	}
+	stream, err := payloadLogStreamClientInterceptor(logger)(
+		context.Background(), &grpc.StreamDesc{}, nil, streamMethod,
+		streamer,
+	)
+	require.NoError(t, err)
+
+	msg := &auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Subscribe{
+			Subscribe: &auctioneerrpc.AccountSubscription{
+				TraderKey: traderKey,
+				AuthSig:   authSig,
+			},
+		},
+	}
+	require.NoError(t, stream.SendMsg(msg))
+	require.NoError(t, stream.RecvMsg(&auctioneerrpc.ClientAuctionMessage{}))
+
+	logged = logBuf.String()
+	require.Contains(t, logged, streamMethod+"] sent")
+	require.Contains(t, logged, streamMethod+"] received")
+	require.Contains(
+		t, logged, base64.StdEncoding.EncodeToString(traderKey),
+	)
+	require.Contains(t, logged, `"auth_sig":"[redacted]"`)
+	require.NotContains(t, logged, base64.StdEncoding.EncodeToString(authSig))
+
+	// The account signatures of a batch are redacted as a whole, while the
+	// other parts of the message are still logged.
+	logBuf.Reset()
+	accountSig := bytes.Repeat([]byte{0xcc}, 64)
+	batchID := bytes.Repeat([]byte{0x03}, 33)
+	msg = &auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Sign{
+			Sign: &auctioneerrpc.OrderMatchSign{
+				BatchId: batchID,
+				AccountSigs: map[string][]byte{
+					hex.EncodeToString(traderKey): accountSig,
+				},
+			},
+		},
+	}
+	require.NoError(t, stream.SendMsg(msg))
+
+	logged = logBuf.String()
+	require.Contains(t, logged, base64.StdEncoding.EncodeToString(batchID))
+	require.Contains(t, logged, `"account_sigs":"[redacted]"`)
+	require.NotContains(
+		t, logged, base64.StdEncoding.EncodeToString(accountSig),
+	)
+}
@@ -22,6 +22,48 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/GetTLSInfo": {{
+		Entity: "auth",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/TestConnections": {{
+		Entity: "auth",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/GetConnectionStatus": {{
+		Entity: "auth",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/DebugDump": {{
+		Entity: "account",
+		Action: "write",
+	}, {
+		Entity: "order",
+		Action: "write",
+	}, {
+		Entity: "auction",
+		Action: "read",
+	}, {
+		Entity: "auth",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ExportState": {{
+		Entity: "account",
+		Action: "read",
+	}, {
+		Entity: "order",
+		Action: "read",
+	}, {
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ImportState": {{
+		Entity: "account",
+		Action: "write",
+	}, {
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/QuoteAccount": {{
 		Entity: "account",
 		Action: "read",
@@ -30,10 +72,41 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/InitExternalAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/PublishExternalAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/CancelExternalAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/PreviewAccountFunding": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ConfirmAccountFunding": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/ListAccounts": {{
 		Entity: "account",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/ExportAccountKey": {{
+		Entity: "account",
+		Action: "write",
+	}, {
+		Entity: "auth",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/SetAccountPolicy": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/CloseAccount": {{
 		Entity: "account",
 		Action: "write",
@@ -42,6 +115,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/SplitAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/MergeAccounts": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/DepositAccount": {{
 		Entity: "account",
 		Action: "write",
@@ -50,6 +131,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/QuoteAccountRenewal": {{
+		Entity: "account",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SimulateAccountFees": {{
+		Entity: "account",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/BumpAccountFee": {{
 		Entity: "account",
 		Action: "write",
@@ -62,10 +151,22 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/AccountAuditLog": {{
+		Entity: "account",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/SubmitOrder": {{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/PrepareOrder": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ConfirmOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/ListOrders": {{
 		Entity: "order",
 		Action: "read",
@@ -74,6 +175,10 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/ReplaceOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/QuoteOrder": {{
 		Entity: "order",
 		Action: "read",
@@ -86,6 +191,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auction",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/LeasePerformanceReport": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ListPendingBatchChannels": {{
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/BatchSnapshot": {{
 		Entity: "auction",
 		Action: "read",
@@ -94,6 +207,10 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auth",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/DeleteLsatToken": {{
+		Entity: "auth",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/LeaseDurations": {{
 		Entity: "auction",
 		Action: "read",
@@ -102,6 +219,22 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auction",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/GetServerParams": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ListBatchConfirmations": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SubscribeBatchConfirmations": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/AcceptBatch": {{
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/NodeRatings": {{
 		Entity: "auction",
 		Action: "read",
@@ -135,3 +268,40 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Action: "write",
 	}},
 }
+
+// RequiredLndPermissions is the list of lnd macaroon permissions poold needs
+// for all the lnd RPCs it uses, including the ones of the subservers.
+var RequiredLndPermissions = []bakery.Op{{
+	Entity: "info",
+	Action: "read",
+}, {
+	Entity: "onchain",
+	Action: "read",
+}, {
+	Entity: "onchain",
+	Action: "write",
+}, {
+	Entity: "offchain",
+	Action: "read",
+}, {
+	Entity: "offchain",
+	Action: "write",
+}, {
+	Entity: "address",
+	Action: "read",
+}, {
+	Entity: "address",
+	Action: "write",
+}, {
+	Entity: "peers",
+	Action: "read",
+}, {
+	Entity: "peers",
+	Action: "write",
+}, {
+	Entity: "signer",
+	Action: "read",
+}, {
+	Entity: "signer",
+	Action: "generate",
+}}
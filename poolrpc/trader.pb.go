@@ -111,6 +111,14 @@ const (
 	//
 	//The account has recently participated in a batch and is not yet confirmed.
 	AccountState_PENDING_BATCH AccountState = 7
+	//
+	//The state of an account that was reserved with the auction server and is
+	//waiting for its funding transaction to be created by an external service.
+	AccountState_PENDING_EXTERNAL_FUNDING AccountState = 8
+	//
+	//The state of an account that was waiting for its external funding
+	//transaction but was canceled before it was funded.
+	AccountState_EXTERNAL_FUNDING_CANCELED AccountState = 9
 )
 
 // Enum value maps for AccountState.
@@ -124,16 +132,20 @@ var (
 		5: "CLOSED",
 		6: "RECOVERY_FAILED",
 		7: "PENDING_BATCH",
+		8: "PENDING_EXTERNAL_FUNDING",
+		9: "EXTERNAL_FUNDING_CANCELED",
 	}
 	AccountState_value = map[string]int32{
-		"PENDING_OPEN":    0,
-		"PENDING_UPDATE":  1,
-		"OPEN":            2,
-		"EXPIRED":         3,
-		"PENDING_CLOSED":  4,
-		"CLOSED":          5,
-		"RECOVERY_FAILED": 6,
-		"PENDING_BATCH":   7,
+		"PENDING_OPEN":              0,
+		"PENDING_UPDATE":            1,
+		"OPEN":                      2,
+		"EXPIRED":                   3,
+		"PENDING_CLOSED":            4,
+		"CLOSED":                    5,
+		"RECOVERY_FAILED":           6,
+		"PENDING_BATCH":             7,
+		"PENDING_EXTERNAL_FUNDING":  8,
+		"EXTERNAL_FUNDING_CANCELED": 9,
 	}
 )
 
@@ -164,6 +176,219 @@ func (AccountState) EnumDescriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{1}
 }
 
+type OrderStateFilter int32
+
+const (
+	//
+	//Use the active_only flag of the request to decide whether archived orders
+	//should be listed.
+	OrderStateFilter_ORDER_STATE_FILTER_DEFAULT OrderStateFilter = 0
+	// Only list orders that are still active.
+	OrderStateFilter_ORDER_STATE_FILTER_ACTIVE OrderStateFilter = 1
+	// Only list orders that are archived.
+	OrderStateFilter_ORDER_STATE_FILTER_ARCHIVED OrderStateFilter = 2
+	// List orders regardless of their state.
+	OrderStateFilter_ORDER_STATE_FILTER_ALL OrderStateFilter = 3
+)
+
+// Enum value maps for OrderStateFilter.
+var (
+	OrderStateFilter_name = map[int32]string{
+		0: "ORDER_STATE_FILTER_DEFAULT",
+		1: "ORDER_STATE_FILTER_ACTIVE",
+		2: "ORDER_STATE_FILTER_ARCHIVED",
+		3: "ORDER_STATE_FILTER_ALL",
+	}
+	OrderStateFilter_value = map[string]int32{
+		"ORDER_STATE_FILTER_DEFAULT":  0,
+		"ORDER_STATE_FILTER_ACTIVE":   1,
+		"ORDER_STATE_FILTER_ARCHIVED": 2,
+		"ORDER_STATE_FILTER_ALL":      3,
+	}
+)
+
+func (x OrderStateFilter) Enum() *OrderStateFilter {
+	p := new(OrderStateFilter)
+	*p = x
+	return p
+}
+
+func (x OrderStateFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStateFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderStateFilter) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[2]
+}
+
+func (x OrderStateFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStateFilter.Descriptor instead.
+func (OrderStateFilter) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{2}
+}
+
+type OrderTypeFilter int32
+
+const (
+	// List both asks and bids.
+	OrderTypeFilter_ORDER_TYPE_FILTER_ALL OrderTypeFilter = 0
+	// Only list asks.
+	OrderTypeFilter_ORDER_TYPE_FILTER_ASK OrderTypeFilter = 1
+	// Only list bids.
+	OrderTypeFilter_ORDER_TYPE_FILTER_BID OrderTypeFilter = 2
+)
+
+// Enum value maps for OrderTypeFilter.
+var (
+	OrderTypeFilter_name = map[int32]string{
+		0: "ORDER_TYPE_FILTER_ALL",
+		1: "ORDER_TYPE_FILTER_ASK",
+		2: "ORDER_TYPE_FILTER_BID",
+	}
+	OrderTypeFilter_value = map[string]int32{
+		"ORDER_TYPE_FILTER_ALL": 0,
+		"ORDER_TYPE_FILTER_ASK": 1,
+		"ORDER_TYPE_FILTER_BID": 2,
+	}
+)
+
+func (x OrderTypeFilter) Enum() *OrderTypeFilter {
+	p := new(OrderTypeFilter)
+	*p = x
+	return p
+}
+
+func (x OrderTypeFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderTypeFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[3].Descriptor()
+}
+
+func (OrderTypeFilter) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[3]
+}
+
+func (x OrderTypeFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderTypeFilter.Descriptor instead.
+func (OrderTypeFilter) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{3}
+}
+
+type OrderSortBy int32
+
+const (
+	// Sort the orders by the time they were created.
+	OrderSortBy_ORDER_SORT_BY_CREATION_TIME OrderSortBy = 0
+	// Sort the orders by their fixed rate.
+	OrderSortBy_ORDER_SORT_BY_RATE OrderSortBy = 1
+)
+
+// Enum value maps for OrderSortBy.
+var (
+	OrderSortBy_name = map[int32]string{
+		0: "ORDER_SORT_BY_CREATION_TIME",
+		1: "ORDER_SORT_BY_RATE",
+	}
+	OrderSortBy_value = map[string]int32{
+		"ORDER_SORT_BY_CREATION_TIME": 0,
+		"ORDER_SORT_BY_RATE":          1,
+	}
+)
+
+func (x OrderSortBy) Enum() *OrderSortBy {
+	p := new(OrderSortBy)
+	*p = x
+	return p
+}
+
+func (x OrderSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderSortBy) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[4]
+}
+
+func (x OrderSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSortBy.Descriptor instead.
+func (OrderSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{4}
+}
+
+type PartialMatchPolicy int32
+
+const (
+	// Keep the unmatched remainder of the order in the order book.
+	PartialMatchPolicy_PARTIAL_MATCH_POLICY_KEEP PartialMatchPolicy = 0
+	// Cancel the unmatched remainder of the order.
+	PartialMatchPolicy_PARTIAL_MATCH_POLICY_CANCEL PartialMatchPolicy = 1
+	//
+	//Cancel the unmatched remainder of the order and submit it again as a new
+	//order at the clearing rate of the batch it was partially matched in. A bid
+	//is never repriced above and an ask never below the order's own rate.
+	PartialMatchPolicy_PARTIAL_MATCH_POLICY_REPRICE PartialMatchPolicy = 2
+)
+
+// Enum value maps for PartialMatchPolicy.
+var (
+	PartialMatchPolicy_name = map[int32]string{
+		0: "PARTIAL_MATCH_POLICY_KEEP",
+		1: "PARTIAL_MATCH_POLICY_CANCEL",
+		2: "PARTIAL_MATCH_POLICY_REPRICE",
+	}
+	PartialMatchPolicy_value = map[string]int32{
+		"PARTIAL_MATCH_POLICY_KEEP":    0,
+		"PARTIAL_MATCH_POLICY_CANCEL":  1,
+		"PARTIAL_MATCH_POLICY_REPRICE": 2,
+	}
+)
+
+func (x PartialMatchPolicy) Enum() *PartialMatchPolicy {
+	p := new(PartialMatchPolicy)
+	*p = x
+	return p
+}
+
+func (x PartialMatchPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartialMatchPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[5].Descriptor()
+}
+
+func (PartialMatchPolicy) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[5]
+}
+
+func (x PartialMatchPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartialMatchPolicy.Descriptor instead.
+func (PartialMatchPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{5}
+}
+
 type MatchState int32
 
 const (
@@ -217,11 +442,11 @@ func (x MatchState) String() string {
 }
 
 func (MatchState) Descriptor() protoreflect.EnumDescriptor {
-	return file_trader_proto_enumTypes[2].Descriptor()
+	return file_trader_proto_enumTypes[6].Descriptor()
 }
 
 func (MatchState) Type() protoreflect.EnumType {
-	return &file_trader_proto_enumTypes[2]
+	return &file_trader_proto_enumTypes[6]
 }
 
 func (x MatchState) Number() protoreflect.EnumNumber {
@@ -230,7 +455,7 @@ func (x MatchState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MatchState.Descriptor instead.
 func (MatchState) EnumDescriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{2}
+	return file_trader_proto_rawDescGZIP(), []int{6}
 }
 
 type MatchRejectReason int32
@@ -297,11 +522,11 @@ func (x MatchRejectReason) String() string {
 }
 
 func (MatchRejectReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_trader_proto_enumTypes[3].Descriptor()
+	return file_trader_proto_enumTypes[7].Descriptor()
 }
 
 func (MatchRejectReason) Type() protoreflect.EnumType {
-	return &file_trader_proto_enumTypes[3]
+	return &file_trader_proto_enumTypes[7]
 }
 
 func (x MatchRejectReason) Number() protoreflect.EnumNumber {
@@ -310,7 +535,243 @@ func (x MatchRejectReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MatchRejectReason.Descriptor instead.
 func (MatchRejectReason) EnumDescriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{3}
+	return file_trader_proto_rawDescGZIP(), []int{7}
+}
+
+type AccountAuditAction int32
+
+const (
+	//
+	//The account was updated without changing its value or expiry, for example
+	//when its latest transaction confirmed.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_STATE_CHANGE AccountAuditAction = 0
+	// The account was created.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_CREATED AccountAuditAction = 1
+	// Funds were deposited into the account.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_DEPOSIT AccountAuditAction = 2
+	// Funds were withdrawn from the account.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_WITHDRAW AccountAuditAction = 3
+	// The expiry of the account was extended.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_RENEW AccountAuditAction = 4
+	// The account participated in a batch.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_BATCH AccountAuditAction = 5
+	// The account reached its expiry.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_EXPIRED AccountAuditAction = 6
+	// The account was closed.
+	AccountAuditAction_ACCOUNT_AUDIT_ACTION_CLOSED AccountAuditAction = 7
+)
+
+// Enum value maps for AccountAuditAction.
+var (
+	AccountAuditAction_name = map[int32]string{
+		0: "ACCOUNT_AUDIT_ACTION_STATE_CHANGE",
+		1: "ACCOUNT_AUDIT_ACTION_CREATED",
+		2: "ACCOUNT_AUDIT_ACTION_DEPOSIT",
+		3: "ACCOUNT_AUDIT_ACTION_WITHDRAW",
+		4: "ACCOUNT_AUDIT_ACTION_RENEW",
+		5: "ACCOUNT_AUDIT_ACTION_BATCH",
+		6: "ACCOUNT_AUDIT_ACTION_EXPIRED",
+		7: "ACCOUNT_AUDIT_ACTION_CLOSED",
+	}
+	AccountAuditAction_value = map[string]int32{
+		"ACCOUNT_AUDIT_ACTION_STATE_CHANGE": 0,
+		"ACCOUNT_AUDIT_ACTION_CREATED":      1,
+		"ACCOUNT_AUDIT_ACTION_DEPOSIT":      2,
+		"ACCOUNT_AUDIT_ACTION_WITHDRAW":     3,
+		"ACCOUNT_AUDIT_ACTION_RENEW":        4,
+		"ACCOUNT_AUDIT_ACTION_BATCH":        5,
+		"ACCOUNT_AUDIT_ACTION_EXPIRED":      6,
+		"ACCOUNT_AUDIT_ACTION_CLOSED":       7,
+	}
+)
+
+func (x AccountAuditAction) Enum() *AccountAuditAction {
+	p := new(AccountAuditAction)
+	*p = x
+	return p
+}
+
+func (x AccountAuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountAuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[8].Descriptor()
+}
+
+func (AccountAuditAction) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[8]
+}
+
+func (x AccountAuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountAuditAction.Descriptor instead.
+func (AccountAuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{8}
+}
+
+type LeaseStatus int32
+
+const (
+	// The channel of the lease is still waiting to be confirmed.
+	LeaseStatus_LEASE_STATUS_PENDING LeaseStatus = 0
+	// The channel of the lease is open and the lease hasn't expired yet.
+	LeaseStatus_LEASE_STATUS_ACTIVE LeaseStatus = 1
+	//
+	//The channel of the lease is still open but the lease expiry height has been
+	//reached.
+	LeaseStatus_LEASE_STATUS_MATURED LeaseStatus = 2
+	// The channel of the lease is closed.
+	LeaseStatus_LEASE_STATUS_CLOSED LeaseStatus = 3
+)
+
+// Enum value maps for LeaseStatus.
+var (
+	LeaseStatus_name = map[int32]string{
+		0: "LEASE_STATUS_PENDING",
+		1: "LEASE_STATUS_ACTIVE",
+		2: "LEASE_STATUS_MATURED",
+		3: "LEASE_STATUS_CLOSED",
+	}
+	LeaseStatus_value = map[string]int32{
+		"LEASE_STATUS_PENDING": 0,
+		"LEASE_STATUS_ACTIVE":  1,
+		"LEASE_STATUS_MATURED": 2,
+		"LEASE_STATUS_CLOSED":  3,
+	}
+)
+
+func (x LeaseStatus) Enum() *LeaseStatus {
+	p := new(LeaseStatus)
+	*p = x
+	return p
+}
+
+func (x LeaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[9].Descriptor()
+}
+
+func (LeaseStatus) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[9]
+}
+
+func (x LeaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseStatus.Descriptor instead.
+func (LeaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{9}
+}
+
+type LeaseRoleFilter int32
+
+const (
+	// List both purchased and sold leases.
+	LeaseRoleFilter_LEASE_ROLE_FILTER_ALL LeaseRoleFilter = 0
+	// Only list leases that were purchased with a bid order.
+	LeaseRoleFilter_LEASE_ROLE_FILTER_TAKER LeaseRoleFilter = 1
+	// Only list leases that were sold with an ask order.
+	LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER LeaseRoleFilter = 2
+)
+
+// Enum value maps for LeaseRoleFilter.
+var (
+	LeaseRoleFilter_name = map[int32]string{
+		0: "LEASE_ROLE_FILTER_ALL",
+		1: "LEASE_ROLE_FILTER_TAKER",
+		2: "LEASE_ROLE_FILTER_MAKER",
+	}
+	LeaseRoleFilter_value = map[string]int32{
+		"LEASE_ROLE_FILTER_ALL":   0,
+		"LEASE_ROLE_FILTER_TAKER": 1,
+		"LEASE_ROLE_FILTER_MAKER": 2,
+	}
+)
+
+func (x LeaseRoleFilter) Enum() *LeaseRoleFilter {
+	p := new(LeaseRoleFilter)
+	*p = x
+	return p
+}
+
+func (x LeaseRoleFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseRoleFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[10].Descriptor()
+}
+
+func (LeaseRoleFilter) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[10]
+}
+
+func (x LeaseRoleFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseRoleFilter.Descriptor instead.
+func (LeaseRoleFilter) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{10}
+}
+
+type BatchChannelState int32
+
+const (
+	// The channel is not known to lnd, neither as pending nor as open.
+	BatchChannelState_BATCH_CHANNEL_STATE_UNKNOWN BatchChannelState = 0
+	// The channel is pending and waiting for the batch to confirm.
+	BatchChannelState_BATCH_CHANNEL_STATE_PENDING_OPEN BatchChannelState = 1
+	// The batch confirmed and the channel is open.
+	BatchChannelState_BATCH_CHANNEL_STATE_OPEN BatchChannelState = 2
+)
+
+// Enum value maps for BatchChannelState.
+var (
+	BatchChannelState_name = map[int32]string{
+		0: "BATCH_CHANNEL_STATE_UNKNOWN",
+		1: "BATCH_CHANNEL_STATE_PENDING_OPEN",
+		2: "BATCH_CHANNEL_STATE_OPEN",
+	}
+	BatchChannelState_value = map[string]int32{
+		"BATCH_CHANNEL_STATE_UNKNOWN":      0,
+		"BATCH_CHANNEL_STATE_PENDING_OPEN": 1,
+		"BATCH_CHANNEL_STATE_OPEN":         2,
+	}
+)
+
+func (x BatchChannelState) Enum() *BatchChannelState {
+	p := new(BatchChannelState)
+	*p = x
+	return p
+}
+
+func (x BatchChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[11].Descriptor()
+}
+
+func (BatchChannelState) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[11]
+}
+
+func (x BatchChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchChannelState.Descriptor instead.
+func (BatchChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{11}
 }
 
 type InitAccountRequest struct {
@@ -471,19 +932,27 @@ func (*InitAccountRequest_ConfTarget) isInitAccountRequest_Fees() {}
 
 func (*InitAccountRequest_FeeRateSatPerKw) isInitAccountRequest_Fees() {}
 
-type QuoteAccountRequest struct {
+type InitExternalAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	AccountValue uint64 `protobuf:"varint,1,opt,name=account_value,json=accountValue,proto3" json:"account_value,omitempty"`
-	// Types that are assignable to Fees:
-	//	*QuoteAccountRequest_ConfTarget
-	Fees isQuoteAccountRequest_Fees `protobuf_oneof:"fees"`
+	// Types that are assignable to AccountExpiry:
+	//	*InitExternalAccountRequest_AbsoluteHeight
+	//	*InitExternalAccountRequest_RelativeHeight
+	AccountExpiry isInitExternalAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of pool.
+	Initiator string `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//The version of account to create.
+	Version AccountVersion `protobuf:"varint,5,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
 }
 
-func (x *QuoteAccountRequest) Reset() {
-	*x = QuoteAccountRequest{}
+func (x *InitExternalAccountRequest) Reset() {
+	*x = InitExternalAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,13 +960,13 @@ func (x *QuoteAccountRequest) Reset() {
 	}
 }
 
-func (x *QuoteAccountRequest) String() string {
+func (x *InitExternalAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteAccountRequest) ProtoMessage() {}
+func (*InitExternalAccountRequest) ProtoMessage() {}
 
-func (x *QuoteAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *InitExternalAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,55 +978,88 @@ func (x *QuoteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteAccountRequest.ProtoReflect.Descriptor instead.
-func (*QuoteAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitExternalAccountRequest.ProtoReflect.Descriptor instead.
+func (*InitExternalAccountRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *QuoteAccountRequest) GetAccountValue() uint64 {
+func (x *InitExternalAccountRequest) GetAccountValue() uint64 {
 	if x != nil {
 		return x.AccountValue
 	}
 	return 0
 }
 
-func (m *QuoteAccountRequest) GetFees() isQuoteAccountRequest_Fees {
+func (m *InitExternalAccountRequest) GetAccountExpiry() isInitExternalAccountRequest_AccountExpiry {
 	if m != nil {
-		return m.Fees
+		return m.AccountExpiry
 	}
 	return nil
 }
 
-func (x *QuoteAccountRequest) GetConfTarget() uint32 {
-	if x, ok := x.GetFees().(*QuoteAccountRequest_ConfTarget); ok {
-		return x.ConfTarget
+func (x *InitExternalAccountRequest) GetAbsoluteHeight() uint32 {
+	if x, ok := x.GetAccountExpiry().(*InitExternalAccountRequest_AbsoluteHeight); ok {
+		return x.AbsoluteHeight
 	}
 	return 0
 }
 
-type isQuoteAccountRequest_Fees interface {
-	isQuoteAccountRequest_Fees()
+func (x *InitExternalAccountRequest) GetRelativeHeight() uint32 {
+	if x, ok := x.GetAccountExpiry().(*InitExternalAccountRequest_RelativeHeight); ok {
+		return x.RelativeHeight
+	}
+	return 0
 }
 
-type QuoteAccountRequest_ConfTarget struct {
-	//
-	//The target number of blocks that the transaction should be confirmed in.
-	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3,oneof"`
+func (x *InitExternalAccountRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
 }
 
-func (*QuoteAccountRequest_ConfTarget) isQuoteAccountRequest_Fees() {}
+func (x *InitExternalAccountRequest) GetVersion() AccountVersion {
+	if x != nil {
+		return x.Version
+	}
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
+}
 
-type QuoteAccountResponse struct {
+type isInitExternalAccountRequest_AccountExpiry interface {
+	isInitExternalAccountRequest_AccountExpiry()
+}
+
+type InitExternalAccountRequest_AbsoluteHeight struct {
+	AbsoluteHeight uint32 `protobuf:"varint,2,opt,name=absolute_height,json=absoluteHeight,proto3,oneof"`
+}
+
+type InitExternalAccountRequest_RelativeHeight struct {
+	RelativeHeight uint32 `protobuf:"varint,3,opt,name=relative_height,json=relativeHeight,proto3,oneof"`
+}
+
+func (*InitExternalAccountRequest_AbsoluteHeight) isInitExternalAccountRequest_AccountExpiry() {}
+
+func (*InitExternalAccountRequest_RelativeHeight) isInitExternalAccountRequest_AccountExpiry() {}
+
+type InitExternalAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerFeeRateSatPerKw uint64 `protobuf:"varint,1,opt,name=miner_fee_rate_sat_per_kw,json=minerFeeRateSatPerKw,proto3" json:"miner_fee_rate_sat_per_kw,omitempty"`
-	MinerFeeTotal        uint64 `protobuf:"varint,2,opt,name=miner_fee_total,json=minerFeeTotal,proto3" json:"miner_fee_total,omitempty"`
+	//
+	//The reserved account that is waiting for its funding transaction.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	//
+	//The output script the funding transaction must pay exactly the account
+	//value to.
+	OutputScript []byte `protobuf:"bytes,2,opt,name=output_script,json=outputScript,proto3" json:"output_script,omitempty"`
+	//
+	//The address that corresponds to the output script.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QuoteAccountResponse) Reset() {
-	*x = QuoteAccountResponse{}
+func (x *InitExternalAccountResponse) Reset() {
+	*x = InitExternalAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,13 +1067,13 @@ func (x *QuoteAccountResponse) Reset() {
 	}
 }
 
-func (x *QuoteAccountResponse) String() string {
+func (x *InitExternalAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteAccountResponse) ProtoMessage() {}
+func (*InitExternalAccountResponse) ProtoMessage() {}
 
-func (x *QuoteAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *InitExternalAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -583,37 +1085,47 @@ func (x *QuoteAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteAccountResponse.ProtoReflect.Descriptor instead.
-func (*QuoteAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitExternalAccountResponse.ProtoReflect.Descriptor instead.
+func (*InitExternalAccountResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *QuoteAccountResponse) GetMinerFeeRateSatPerKw() uint64 {
+func (x *InitExternalAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.MinerFeeRateSatPerKw
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteAccountResponse) GetMinerFeeTotal() uint64 {
+func (x *InitExternalAccountResponse) GetOutputScript() []byte {
 	if x != nil {
-		return x.MinerFeeTotal
+		return x.OutputScript
 	}
-	return 0
+	return nil
 }
 
-type ListAccountsRequest struct {
+func (x *InitExternalAccountResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type PublishExternalAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Only list accounts that are still active.
-	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	//The trader key of the account reserved with InitExternalAccount.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The fully signed funding transaction in its raw serialized form.
+	FundingTx []byte `protobuf:"bytes,2,opt,name=funding_tx,json=fundingTx,proto3" json:"funding_tx,omitempty"`
 }
 
-func (x *ListAccountsRequest) Reset() {
-	*x = ListAccountsRequest{}
+func (x *PublishExternalAccountRequest) Reset() {
+	*x = PublishExternalAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,13 +1133,13 @@ func (x *ListAccountsRequest) Reset() {
 	}
 }
 
-func (x *ListAccountsRequest) String() string {
+func (x *PublishExternalAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsRequest) ProtoMessage() {}
+func (*PublishExternalAccountRequest) ProtoMessage() {}
 
-func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishExternalAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,28 +1151,37 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishExternalAccountRequest.ProtoReflect.Descriptor instead.
+func (*PublishExternalAccountRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListAccountsRequest) GetActiveOnly() bool {
+func (x *PublishExternalAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.ActiveOnly
+		return x.TraderKey
 	}
-	return false
+	return nil
 }
 
-type ListAccountsResponse struct {
+func (x *PublishExternalAccountRequest) GetFundingTx() []byte {
+	if x != nil {
+		return x.FundingTx
+	}
+	return nil
+}
+
+type CancelExternalAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	//
+	//The trader key of the account that is waiting for its funding transaction.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
+func (x *CancelExternalAccountRequest) Reset() {
+	*x = CancelExternalAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,13 +1189,13 @@ func (x *ListAccountsResponse) Reset() {
 	}
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *CancelExternalAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*CancelExternalAccountRequest) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelExternalAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,31 +1207,43 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelExternalAccountRequest.ProtoReflect.Descriptor instead.
+func (*CancelExternalAccountRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListAccountsResponse) GetAccounts() []*Account {
+func (x *CancelExternalAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Accounts
+		return x.TraderKey
 	}
 	return nil
 }
 
-type Output struct {
+type PreviewAccountFundingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The value, in satoshis, of the output.
-	ValueSat uint64 `protobuf:"varint,1,opt,name=value_sat,json=valueSat,proto3" json:"value_sat,omitempty"`
-	// The address corresponding to the output.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AccountValue uint64 `protobuf:"varint,1,opt,name=account_value,json=accountValue,proto3" json:"account_value,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*PreviewAccountFundingRequest_AbsoluteHeight
+	//	*PreviewAccountFundingRequest_RelativeHeight
+	AccountExpiry isPreviewAccountFundingRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	// Types that are assignable to Fees:
+	//	*PreviewAccountFundingRequest_ConfTarget
+	//	*PreviewAccountFundingRequest_FeeRateSatPerKw
+	Fees isPreviewAccountFundingRequest_Fees `protobuf_oneof:"fees"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of pool.
+	Initiator string `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//The version of account to create.
+	Version AccountVersion `protobuf:"varint,7,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
 }
 
-func (x *Output) Reset() {
-	*x = Output{}
+func (x *PreviewAccountFundingRequest) Reset() {
+	*x = PreviewAccountFundingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,13 +1251,13 @@ func (x *Output) Reset() {
 	}
 }
 
-func (x *Output) String() string {
+func (x *PreviewAccountFundingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Output) ProtoMessage() {}
+func (*PreviewAccountFundingRequest) ProtoMessage() {}
 
-func (x *Output) ProtoReflect() protoreflect.Message {
+func (x *PreviewAccountFundingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -736,143 +1269,138 @@ func (x *Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Output.ProtoReflect.Descriptor instead.
-func (*Output) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewAccountFundingRequest.ProtoReflect.Descriptor instead.
+func (*PreviewAccountFundingRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Output) GetValueSat() uint64 {
+func (x *PreviewAccountFundingRequest) GetAccountValue() uint64 {
 	if x != nil {
-		return x.ValueSat
+		return x.AccountValue
 	}
 	return 0
 }
 
-func (x *Output) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (m *PreviewAccountFundingRequest) GetAccountExpiry() isPreviewAccountFundingRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
-	return ""
+	return nil
 }
 
-type OutputWithFee struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The address corresponding to the output.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Types that are assignable to Fees:
-	//	*OutputWithFee_ConfTarget
-	//	*OutputWithFee_FeeRateSatPerKw
-	Fees isOutputWithFee_Fees `protobuf_oneof:"fees"`
+func (x *PreviewAccountFundingRequest) GetAbsoluteHeight() uint32 {
+	if x, ok := x.GetAccountExpiry().(*PreviewAccountFundingRequest_AbsoluteHeight); ok {
+		return x.AbsoluteHeight
+	}
+	return 0
 }
 
-func (x *OutputWithFee) Reset() {
-	*x = OutputWithFee{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PreviewAccountFundingRequest) GetRelativeHeight() uint32 {
+	if x, ok := x.GetAccountExpiry().(*PreviewAccountFundingRequest_RelativeHeight); ok {
+		return x.RelativeHeight
 	}
+	return 0
 }
 
-func (x *OutputWithFee) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *PreviewAccountFundingRequest) GetFees() isPreviewAccountFundingRequest_Fees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
 }
 
-func (*OutputWithFee) ProtoMessage() {}
-
-func (x *OutputWithFee) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PreviewAccountFundingRequest) GetConfTarget() uint32 {
+	if x, ok := x.GetFees().(*PreviewAccountFundingRequest_ConfTarget); ok {
+		return x.ConfTarget
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use OutputWithFee.ProtoReflect.Descriptor instead.
-func (*OutputWithFee) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{6}
+func (x *PreviewAccountFundingRequest) GetFeeRateSatPerKw() uint64 {
+	if x, ok := x.GetFees().(*PreviewAccountFundingRequest_FeeRateSatPerKw); ok {
+		return x.FeeRateSatPerKw
+	}
+	return 0
 }
 
-func (x *OutputWithFee) GetAddress() string {
+func (x *PreviewAccountFundingRequest) GetInitiator() string {
 	if x != nil {
-		return x.Address
+		return x.Initiator
 	}
 	return ""
 }
 
-func (m *OutputWithFee) GetFees() isOutputWithFee_Fees {
-	if m != nil {
-		return m.Fees
+func (x *PreviewAccountFundingRequest) GetVersion() AccountVersion {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-func (x *OutputWithFee) GetConfTarget() uint32 {
-	if x, ok := x.GetFees().(*OutputWithFee_ConfTarget); ok {
-		return x.ConfTarget
-	}
-	return 0
+type isPreviewAccountFundingRequest_AccountExpiry interface {
+	isPreviewAccountFundingRequest_AccountExpiry()
 }
 
-func (x *OutputWithFee) GetFeeRateSatPerKw() uint64 {
-	if x, ok := x.GetFees().(*OutputWithFee_FeeRateSatPerKw); ok {
-		return x.FeeRateSatPerKw
-	}
-	return 0
+type PreviewAccountFundingRequest_AbsoluteHeight struct {
+	AbsoluteHeight uint32 `protobuf:"varint,2,opt,name=absolute_height,json=absoluteHeight,proto3,oneof"`
 }
 
-type isOutputWithFee_Fees interface {
-	isOutputWithFee_Fees()
+type PreviewAccountFundingRequest_RelativeHeight struct {
+	RelativeHeight uint32 `protobuf:"varint,3,opt,name=relative_height,json=relativeHeight,proto3,oneof"`
 }
 
-type OutputWithFee_ConfTarget struct {
+func (*PreviewAccountFundingRequest_AbsoluteHeight) isPreviewAccountFundingRequest_AccountExpiry() {}
+
+func (*PreviewAccountFundingRequest_RelativeHeight) isPreviewAccountFundingRequest_AccountExpiry() {}
+
+type isPreviewAccountFundingRequest_Fees interface {
+	isPreviewAccountFundingRequest_Fees()
+}
+
+type PreviewAccountFundingRequest_ConfTarget struct {
 	//
 	//The target number of blocks that the transaction should be confirmed in.
-	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3,oneof"`
+	ConfTarget uint32 `protobuf:"varint,4,opt,name=conf_target,json=confTarget,proto3,oneof"`
 }
 
-type OutputWithFee_FeeRateSatPerKw struct {
+type PreviewAccountFundingRequest_FeeRateSatPerKw struct {
 	//
-	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3,oneof"`
+	//The fee rate, in satoshis per kw, to use for the funding transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,5,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3,oneof"`
 }
 
-func (*OutputWithFee_ConfTarget) isOutputWithFee_Fees() {}
+func (*PreviewAccountFundingRequest_ConfTarget) isPreviewAccountFundingRequest_Fees() {}
 
-func (*OutputWithFee_FeeRateSatPerKw) isOutputWithFee_Fees() {}
+func (*PreviewAccountFundingRequest_FeeRateSatPerKw) isPreviewAccountFundingRequest_Fees() {}
 
-type OutputsWithImplicitFee struct {
+type FundingInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Outputs []*Output `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// The outpoint of the wallet UTXO that is spent.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The value, in satoshis, of the wallet UTXO that is spent.
+	ValueSat uint64 `protobuf:"varint,2,opt,name=value_sat,json=valueSat,proto3" json:"value_sat,omitempty"`
 }
 
-func (x *OutputsWithImplicitFee) Reset() {
-	*x = OutputsWithImplicitFee{}
+func (x *FundingInput) Reset() {
+	*x = FundingInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[7]
+		mi := &file_trader_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutputsWithImplicitFee) String() string {
+func (x *FundingInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutputsWithImplicitFee) ProtoMessage() {}
+func (*FundingInput) ProtoMessage() {}
 
-func (x *OutputsWithImplicitFee) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[7]
+func (x *FundingInput) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,48 +1411,69 @@ func (x *OutputsWithImplicitFee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutputsWithImplicitFee.ProtoReflect.Descriptor instead.
-func (*OutputsWithImplicitFee) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use FundingInput.ProtoReflect.Descriptor instead.
+func (*FundingInput) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OutputsWithImplicitFee) GetOutputs() []*Output {
+func (x *FundingInput) GetOutpoint() *auctioneerrpc.OutPoint {
 	if x != nil {
-		return x.Outputs
+		return x.Outpoint
 	}
 	return nil
 }
 
-type CloseAccountRequest struct {
+func (x *FundingInput) GetValueSat() uint64 {
+	if x != nil {
+		return x.ValueSat
+	}
+	return 0
+}
+
+type PreviewAccountFundingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The trader key associated with the account that will be closed.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	// Types that are assignable to FundsDestination:
-	//	*CloseAccountRequest_OutputWithFee
-	//	*CloseAccountRequest_Outputs
-	FundsDestination isCloseAccountRequest_FundsDestination `protobuf_oneof:"funds_destination"`
+	//
+	//The reserved account that is waiting for its previewed funding transaction
+	//to be confirmed.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	//
+	//The unsigned funding transaction in its raw serialized form.
+	UnsignedTx []byte `protobuf:"bytes,2,opt,name=unsigned_tx,json=unsignedTx,proto3" json:"unsigned_tx,omitempty"`
+	//
+	//The total chain fee, in satoshis, the funding transaction pays.
+	FeeSat uint64 `protobuf:"varint,3,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//
+	//The wallet inputs the funding transaction spends.
+	Inputs []*FundingInput `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	//
+	//All outputs of the funding transaction, which are the account output and
+	//an optional change output.
+	Outputs []*Output `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//
+	//The index of the account output in the list of outputs.
+	AccountOutputIndex uint32 `protobuf:"varint,6,opt,name=account_output_index,json=accountOutputIndex,proto3" json:"account_output_index,omitempty"`
 }
 
-func (x *CloseAccountRequest) Reset() {
-	*x = CloseAccountRequest{}
+func (x *PreviewAccountFundingResponse) Reset() {
+	*x = PreviewAccountFundingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[8]
+		mi := &file_trader_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseAccountRequest) String() string {
+func (x *PreviewAccountFundingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseAccountRequest) ProtoMessage() {}
+func (*PreviewAccountFundingResponse) ProtoMessage() {}
 
-func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[8]
+func (x *PreviewAccountFundingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,91 +1484,80 @@ func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
-func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PreviewAccountFundingResponse.ProtoReflect.Descriptor instead.
+func (*PreviewAccountFundingResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CloseAccountRequest) GetTraderKey() []byte {
+func (x *PreviewAccountFundingResponse) GetAccount() *Account {
 	if x != nil {
-		return x.TraderKey
+		return x.Account
 	}
 	return nil
 }
 
-func (m *CloseAccountRequest) GetFundsDestination() isCloseAccountRequest_FundsDestination {
-	if m != nil {
-		return m.FundsDestination
+func (x *PreviewAccountFundingResponse) GetUnsignedTx() []byte {
+	if x != nil {
+		return x.UnsignedTx
 	}
 	return nil
 }
 
-func (x *CloseAccountRequest) GetOutputWithFee() *OutputWithFee {
-	if x, ok := x.GetFundsDestination().(*CloseAccountRequest_OutputWithFee); ok {
-		return x.OutputWithFee
+func (x *PreviewAccountFundingResponse) GetFeeSat() uint64 {
+	if x != nil {
+		return x.FeeSat
 	}
-	return nil
+	return 0
 }
 
-func (x *CloseAccountRequest) GetOutputs() *OutputsWithImplicitFee {
-	if x, ok := x.GetFundsDestination().(*CloseAccountRequest_Outputs); ok {
-		return x.Outputs
+func (x *PreviewAccountFundingResponse) GetInputs() []*FundingInput {
+	if x != nil {
+		return x.Inputs
 	}
 	return nil
 }
 
-type isCloseAccountRequest_FundsDestination interface {
-	isCloseAccountRequest_FundsDestination()
-}
-
-type CloseAccountRequest_OutputWithFee struct {
-	//
-	//A single output/address to which the remaining funds of the account will
-	//be sent to at the specified fee. If an address is not specified, then
-	//the funds are sent to an address the backing lnd node controls.
-	OutputWithFee *OutputWithFee `protobuf:"bytes,2,opt,name=output_with_fee,json=outputWithFee,proto3,oneof"`
+func (x *PreviewAccountFundingResponse) GetOutputs() []*Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
 }
 
-type CloseAccountRequest_Outputs struct {
-	//
-	//The outputs to which the remaining funds of the account will be sent to.
-	//This should only be used when wanting to create two or more outputs,
-	//otherwise OutputWithFee should be used instead. The fee of the account's
-	//closing transaction is implicitly defined by the combined value of all
-	//outputs.
-	Outputs *OutputsWithImplicitFee `protobuf:"bytes,3,opt,name=outputs,proto3,oneof"`
+func (x *PreviewAccountFundingResponse) GetAccountOutputIndex() uint32 {
+	if x != nil {
+		return x.AccountOutputIndex
+	}
+	return 0
 }
 
-func (*CloseAccountRequest_OutputWithFee) isCloseAccountRequest_FundsDestination() {}
-
-func (*CloseAccountRequest_Outputs) isCloseAccountRequest_FundsDestination() {}
-
-type CloseAccountResponse struct {
+type ConfirmAccountFundingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The hash of the closing transaction.
-	CloseTxid []byte `protobuf:"bytes,1,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
+	//
+	//The trader key of the account reserved with PreviewAccountFunding.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 }
 
-func (x *CloseAccountResponse) Reset() {
-	*x = CloseAccountResponse{}
+func (x *ConfirmAccountFundingRequest) Reset() {
+	*x = ConfirmAccountFundingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[9]
+		mi := &file_trader_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseAccountResponse) String() string {
+func (x *ConfirmAccountFundingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseAccountResponse) ProtoMessage() {}
+func (*ConfirmAccountFundingRequest) ProtoMessage() {}
 
-func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[9]
+func (x *ConfirmAccountFundingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,60 +1568,46 @@ func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
-func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ConfirmAccountFundingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmAccountFundingRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CloseAccountResponse) GetCloseTxid() []byte {
+func (x *ConfirmAccountFundingRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.CloseTxid
+		return x.TraderKey
 	}
 	return nil
 }
 
-type WithdrawAccountRequest struct {
+type QuoteAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The trader key associated with the account that funds will be withdrawed
-	//from.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	// The outputs we'll withdraw funds from the account into.
-	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	//
-	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
-	// Types that are assignable to AccountExpiry:
-	//	*WithdrawAccountRequest_AbsoluteExpiry
-	//	*WithdrawAccountRequest_RelativeExpiry
-	AccountExpiry isWithdrawAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
-	//
-	//The new version of the account. If this is set and is a valid version
-	//greater than the account's current version, then the account is upgraded to
-	//that version during the withdrawal.
-	NewVersion AccountVersion `protobuf:"varint,6,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
+	AccountValue uint64 `protobuf:"varint,1,opt,name=account_value,json=accountValue,proto3" json:"account_value,omitempty"`
+	// Types that are assignable to Fees:
+	//	*QuoteAccountRequest_ConfTarget
+	Fees isQuoteAccountRequest_Fees `protobuf_oneof:"fees"`
 }
 
-func (x *WithdrawAccountRequest) Reset() {
-	*x = WithdrawAccountRequest{}
+func (x *QuoteAccountRequest) Reset() {
+	*x = QuoteAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[10]
+		mi := &file_trader_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawAccountRequest) String() string {
+func (x *QuoteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawAccountRequest) ProtoMessage() {}
+func (*QuoteAccountRequest) ProtoMessage() {}
 
-func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[10]
+func (x *QuoteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,106 +1618,70 @@ func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawAccountRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *WithdrawAccountRequest) GetTraderKey() []byte {
-	if x != nil {
-		return x.TraderKey
-	}
-	return nil
-}
-
-func (x *WithdrawAccountRequest) GetOutputs() []*Output {
-	if x != nil {
-		return x.Outputs
-	}
-	return nil
+// Deprecated: Use QuoteAccountRequest.ProtoReflect.Descriptor instead.
+func (*QuoteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *WithdrawAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *QuoteAccountRequest) GetAccountValue() uint64 {
 	if x != nil {
-		return x.FeeRateSatPerKw
+		return x.AccountValue
 	}
 	return 0
 }
 
-func (m *WithdrawAccountRequest) GetAccountExpiry() isWithdrawAccountRequest_AccountExpiry {
+func (m *QuoteAccountRequest) GetFees() isQuoteAccountRequest_Fees {
 	if m != nil {
-		return m.AccountExpiry
+		return m.Fees
 	}
 	return nil
 }
 
-func (x *WithdrawAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_AbsoluteExpiry); ok {
-		return x.AbsoluteExpiry
-	}
-	return 0
-}
-
-func (x *WithdrawAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_RelativeExpiry); ok {
-		return x.RelativeExpiry
+func (x *QuoteAccountRequest) GetConfTarget() uint32 {
+	if x, ok := x.GetFees().(*QuoteAccountRequest_ConfTarget); ok {
+		return x.ConfTarget
 	}
 	return 0
 }
 
-func (x *WithdrawAccountRequest) GetNewVersion() AccountVersion {
-	if x != nil {
-		return x.NewVersion
-	}
-	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
-}
-
-type isWithdrawAccountRequest_AccountExpiry interface {
-	isWithdrawAccountRequest_AccountExpiry()
-}
-
-type WithdrawAccountRequest_AbsoluteExpiry struct {
-	// The new absolute expiration height of the account.
-	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
+type isQuoteAccountRequest_Fees interface {
+	isQuoteAccountRequest_Fees()
 }
 
-type WithdrawAccountRequest_RelativeExpiry struct {
-	// The new relative expiration height of the account.
-	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+type QuoteAccountRequest_ConfTarget struct {
+	//
+	//The target number of blocks that the transaction should be confirmed in.
+	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3,oneof"`
 }
 
-func (*WithdrawAccountRequest_AbsoluteExpiry) isWithdrawAccountRequest_AccountExpiry() {}
-
-func (*WithdrawAccountRequest_RelativeExpiry) isWithdrawAccountRequest_AccountExpiry() {}
+func (*QuoteAccountRequest_ConfTarget) isQuoteAccountRequest_Fees() {}
 
-type WithdrawAccountResponse struct {
+type QuoteAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the withdrawal.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to withdraw funds from the account.
-	WithdrawTxid []byte `protobuf:"bytes,2,opt,name=withdraw_txid,json=withdrawTxid,proto3" json:"withdraw_txid,omitempty"`
+	MinerFeeRateSatPerKw uint64 `protobuf:"varint,1,opt,name=miner_fee_rate_sat_per_kw,json=minerFeeRateSatPerKw,proto3" json:"miner_fee_rate_sat_per_kw,omitempty"`
+	MinerFeeTotal        uint64 `protobuf:"varint,2,opt,name=miner_fee_total,json=minerFeeTotal,proto3" json:"miner_fee_total,omitempty"`
 }
 
-func (x *WithdrawAccountResponse) Reset() {
-	*x = WithdrawAccountResponse{}
+func (x *QuoteAccountResponse) Reset() {
+	*x = QuoteAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[11]
+		mi := &file_trader_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawAccountResponse) String() string {
+func (x *QuoteAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawAccountResponse) ProtoMessage() {}
+func (*QuoteAccountResponse) ProtoMessage() {}
 
-func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[11]
+func (x *QuoteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,67 +1692,63 @@ func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawAccountResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QuoteAccountResponse.ProtoReflect.Descriptor instead.
+func (*QuoteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WithdrawAccountResponse) GetAccount() *Account {
+func (x *QuoteAccountResponse) GetMinerFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.Account
+		return x.MinerFeeRateSatPerKw
 	}
-	return nil
+	return 0
 }
 
-func (x *WithdrawAccountResponse) GetWithdrawTxid() []byte {
+func (x *QuoteAccountResponse) GetMinerFeeTotal() uint64 {
 	if x != nil {
-		return x.WithdrawTxid
+		return x.MinerFeeTotal
 	}
-	return nil
+	return 0
 }
 
-type DepositAccountRequest struct {
+type ListAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The trader key associated with the account that funds will be deposited
-	//into.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	// The amount in satoshis to deposit into the account.
-	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	//Only list accounts that are still active.
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	//
-	//The fee rate, in satoshis per kw, to use for the deposit transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
-	// Types that are assignable to AccountExpiry:
-	//	*DepositAccountRequest_AbsoluteExpiry
-	//	*DepositAccountRequest_RelativeExpiry
-	AccountExpiry isDepositAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//Only list accounts that are in one of the given states. If empty, accounts
+	//are not filtered by their state.
+	States []AccountState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=poolrpc.AccountState" json:"states,omitempty"`
 	//
-	//The new version of the account. If this is set and is a valid version
-	//greater than the account's current version, then the account is upgraded to
-	//that version during the deposit.
-	NewVersion AccountVersion `protobuf:"varint,6,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
+	//Only list accounts with a value of at least the given amount of satoshis.
+	MinValueSat uint64 `protobuf:"varint,3,opt,name=min_value_sat,json=minValueSat,proto3" json:"min_value_sat,omitempty"`
+	//
+	//Only list accounts with a value of at most the given amount of satoshis. If
+	//zero, accounts are not filtered by a maximum value.
+	MaxValueSat uint64 `protobuf:"varint,4,opt,name=max_value_sat,json=maxValueSat,proto3" json:"max_value_sat,omitempty"`
 }
 
-func (x *DepositAccountRequest) Reset() {
-	*x = DepositAccountRequest{}
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[12]
+		mi := &file_trader_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositAccountRequest) String() string {
+func (x *ListAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAccountRequest) ProtoMessage() {}
+func (*ListAccountsRequest) ProtoMessage() {}
 
-func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[12]
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,91 +1759,97 @@ func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAccountRequest.ProtoReflect.Descriptor instead.
-func (*DepositAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DepositAccountRequest) GetTraderKey() []byte {
+func (x *ListAccountsRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.TraderKey
+		return x.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-func (x *DepositAccountRequest) GetAmountSat() uint64 {
+func (x *ListAccountsRequest) GetStates() []AccountState {
 	if x != nil {
-		return x.AmountSat
+		return x.States
 	}
-	return 0
+	return nil
 }
 
-func (x *DepositAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *ListAccountsRequest) GetMinValueSat() uint64 {
 	if x != nil {
-		return x.FeeRateSatPerKw
+		return x.MinValueSat
 	}
 	return 0
 }
 
-func (m *DepositAccountRequest) GetAccountExpiry() isDepositAccountRequest_AccountExpiry {
-	if m != nil {
-		return m.AccountExpiry
-	}
-	return nil
-}
-
-func (x *DepositAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_AbsoluteExpiry); ok {
-		return x.AbsoluteExpiry
+func (x *ListAccountsRequest) GetMaxValueSat() uint64 {
+	if x != nil {
+		return x.MaxValueSat
 	}
 	return 0
 }
 
-func (x *DepositAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_RelativeExpiry); ok {
-		return x.RelativeExpiry
-	}
-	return 0
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (x *DepositAccountRequest) GetNewVersion() AccountVersion {
-	if x != nil {
-		return x.NewVersion
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-type isDepositAccountRequest_AccountExpiry interface {
-	isDepositAccountRequest_AccountExpiry()
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type DepositAccountRequest_AbsoluteExpiry struct {
-	// The new absolute expiration height of the account.
-	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
-}
+func (*ListAccountsResponse) ProtoMessage() {}
 
-type DepositAccountRequest_RelativeExpiry struct {
-	// The new relative expiration height of the account.
-	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*DepositAccountRequest_AbsoluteExpiry) isDepositAccountRequest_AccountExpiry() {}
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{12}
+}
 
-func (*DepositAccountRequest_RelativeExpiry) isDepositAccountRequest_AccountExpiry() {}
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
 
-type DepositAccountResponse struct {
+type ExportAccountKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the deposit.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to deposit funds into the account.
-	DepositTxid []byte `protobuf:"bytes,2,opt,name=deposit_txid,json=depositTxid,proto3" json:"deposit_txid,omitempty"`
+	// The trader key associated with the account to export the key of.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 }
 
-func (x *DepositAccountResponse) Reset() {
-	*x = DepositAccountResponse{}
+func (x *ExportAccountKeyRequest) Reset() {
+	*x = ExportAccountKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,13 +1857,13 @@ func (x *DepositAccountResponse) Reset() {
 	}
 }
 
-func (x *DepositAccountResponse) String() string {
+func (x *ExportAccountKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAccountResponse) ProtoMessage() {}
+func (*ExportAccountKeyRequest) ProtoMessage() {}
 
-func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportAccountKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,47 +1875,37 @@ func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAccountResponse.ProtoReflect.Descriptor instead.
-func (*DepositAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportAccountKeyRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountKeyRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DepositAccountResponse) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *DepositAccountResponse) GetDepositTxid() []byte {
+func (x *ExportAccountKeyRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.DepositTxid
+		return x.TraderKey
 	}
 	return nil
 }
 
-type RenewAccountRequest struct {
+type ExportAccountKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The key associated with the account to renew.
-	AccountKey []byte `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
-	// Types that are assignable to AccountExpiry:
-	//	*RenewAccountRequest_AbsoluteExpiry
-	//	*RenewAccountRequest_RelativeExpiry
-	AccountExpiry isRenewAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
-	// The fee rate, in satoshis per kw, to use for the renewal transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// The trader key associated with the account.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The key family the trader key was derived from.
+	KeyFamily uint32 `protobuf:"varint,2,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
+	// The index of the trader key within its key family.
+	KeyIndex uint32 `protobuf:"varint,3,opt,name=key_index,json=keyIndex,proto3" json:"key_index,omitempty"`
 	//
-	//The new version of the account. If this is set and is a valid version
-	//greater than the account's current version, then the account is upgraded to
-	//that version during the renewal.
-	NewVersion AccountVersion `protobuf:"varint,5,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
+	//The full BIP32 derivation path of the trader key within the wallet of the
+	//connected lnd node.
+	DerivationPath string `protobuf:"bytes,4,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
 }
 
-func (x *RenewAccountRequest) Reset() {
-	*x = RenewAccountRequest{}
+func (x *ExportAccountKeyResponse) Reset() {
+	*x = ExportAccountKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1433,13 +1913,13 @@ func (x *RenewAccountRequest) Reset() {
 	}
 }
 
-func (x *RenewAccountRequest) String() string {
+func (x *ExportAccountKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewAccountRequest) ProtoMessage() {}
+func (*ExportAccountKeyResponse) ProtoMessage() {}
 
-func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportAccountKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1451,84 +1931,57 @@ func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewAccountRequest.ProtoReflect.Descriptor instead.
-func (*RenewAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportAccountKeyResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountKeyResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RenewAccountRequest) GetAccountKey() []byte {
+func (x *ExportAccountKeyResponse) GetTraderKey() []byte {
 	if x != nil {
-		return x.AccountKey
-	}
-	return nil
-}
-
-func (m *RenewAccountRequest) GetAccountExpiry() isRenewAccountRequest_AccountExpiry {
-	if m != nil {
-		return m.AccountExpiry
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *RenewAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_AbsoluteExpiry); ok {
-		return x.AbsoluteExpiry
-	}
-	return 0
-}
-
-func (x *RenewAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_RelativeExpiry); ok {
-		return x.RelativeExpiry
+func (x *ExportAccountKeyResponse) GetKeyFamily() uint32 {
+	if x != nil {
+		return x.KeyFamily
 	}
 	return 0
 }
 
-func (x *RenewAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *ExportAccountKeyResponse) GetKeyIndex() uint32 {
 	if x != nil {
-		return x.FeeRateSatPerKw
+		return x.KeyIndex
 	}
 	return 0
 }
 
-func (x *RenewAccountRequest) GetNewVersion() AccountVersion {
+func (x *ExportAccountKeyResponse) GetDerivationPath() string {
 	if x != nil {
-		return x.NewVersion
+		return x.DerivationPath
 	}
-	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
-}
-
-type isRenewAccountRequest_AccountExpiry interface {
-	isRenewAccountRequest_AccountExpiry()
-}
-
-type RenewAccountRequest_AbsoluteExpiry struct {
-	// The new absolute expiration height of the account.
-	AbsoluteExpiry uint32 `protobuf:"varint,2,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
-}
-
-type RenewAccountRequest_RelativeExpiry struct {
-	// The new relative expiration height of the account.
-	RelativeExpiry uint32 `protobuf:"varint,3,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+	return ""
 }
 
-func (*RenewAccountRequest_AbsoluteExpiry) isRenewAccountRequest_AccountExpiry() {}
-
-func (*RenewAccountRequest_RelativeExpiry) isRenewAccountRequest_AccountExpiry() {}
-
-type RenewAccountResponse struct {
+type AccountPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the renewal.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to renew the expiration of the account.
-	RenewalTxid []byte `protobuf:"bytes,2,opt,name=renewal_txid,json=renewalTxid,proto3" json:"renewal_txid,omitempty"`
+	//
+	//The maximum number of orders backed by the account that can be active at
+	//the same time. Set to 0 to allow any number of orders.
+	MaxOrders uint32 `protobuf:"varint,1,opt,name=max_orders,json=maxOrders,proto3" json:"max_orders,omitempty"`
+	//
+	//The maximum amount in satoshis that can be committed to the unfilled part
+	//of all active orders backed by the account. Set to 0 to allow committing
+	//the full account balance.
+	MaxOutstandingSat uint64 `protobuf:"varint,2,opt,name=max_outstanding_sat,json=maxOutstandingSat,proto3" json:"max_outstanding_sat,omitempty"`
 }
 
-func (x *RenewAccountResponse) Reset() {
-	*x = RenewAccountResponse{}
+func (x *AccountPolicy) Reset() {
+	*x = AccountPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1536,13 +1989,13 @@ func (x *RenewAccountResponse) Reset() {
 	}
 }
 
-func (x *RenewAccountResponse) String() string {
+func (x *AccountPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewAccountResponse) ProtoMessage() {}
+func (*AccountPolicy) ProtoMessage() {}
 
-func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1554,41 +2007,40 @@ func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewAccountResponse.ProtoReflect.Descriptor instead.
-func (*RenewAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountPolicy.ProtoReflect.Descriptor instead.
+func (*AccountPolicy) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RenewAccountResponse) GetAccount() *Account {
+func (x *AccountPolicy) GetMaxOrders() uint32 {
 	if x != nil {
-		return x.Account
+		return x.MaxOrders
 	}
-	return nil
+	return 0
 }
 
-func (x *RenewAccountResponse) GetRenewalTxid() []byte {
+func (x *AccountPolicy) GetMaxOutstandingSat() uint64 {
 	if x != nil {
-		return x.RenewalTxid
+		return x.MaxOutstandingSat
 	}
-	return nil
+	return 0
 }
 
-type BumpAccountFeeRequest struct {
+type SetAccountPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The trader key associated with the account that will have its fee bumped.
+	// The trader key associated with the account to set the policy of.
 	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//The new fee rate, in satoshis per kw, to use for the child of the account
-	//transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	//The new policy of the account that replaces the current one. An empty
+	//policy removes the account's policy.
+	Policy *AccountPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *BumpAccountFeeRequest) Reset() {
-	*x = BumpAccountFeeRequest{}
+func (x *SetAccountPolicyRequest) Reset() {
+	*x = SetAccountPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,13 +2048,13 @@ func (x *BumpAccountFeeRequest) Reset() {
 	}
 }
 
-func (x *BumpAccountFeeRequest) String() string {
+func (x *SetAccountPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpAccountFeeRequest) ProtoMessage() {}
+func (*SetAccountPolicyRequest) ProtoMessage() {}
 
-func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1614,33 +2066,36 @@ func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpAccountFeeRequest.ProtoReflect.Descriptor instead.
-func (*BumpAccountFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountPolicyRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BumpAccountFeeRequest) GetTraderKey() []byte {
+func (x *SetAccountPolicyRequest) GetTraderKey() []byte {
 	if x != nil {
 		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *BumpAccountFeeRequest) GetFeeRateSatPerKw() uint64 {
+func (x *SetAccountPolicyRequest) GetPolicy() *AccountPolicy {
 	if x != nil {
-		return x.FeeRateSatPerKw
+		return x.Policy
 	}
-	return 0
+	return nil
 }
 
-type BumpAccountFeeResponse struct {
+type SetAccountPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The policy that is now in effect for the account.
+	Policy *AccountPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *BumpAccountFeeResponse) Reset() {
-	*x = BumpAccountFeeResponse{}
+func (x *SetAccountPolicyResponse) Reset() {
+	*x = SetAccountPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,13 +2103,13 @@ func (x *BumpAccountFeeResponse) Reset() {
 	}
 }
 
-func (x *BumpAccountFeeResponse) String() string {
+func (x *SetAccountPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpAccountFeeResponse) ProtoMessage() {}
+func (*SetAccountPolicyResponse) ProtoMessage() {}
 
-func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPolicyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1666,42 +2121,31 @@ func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpAccountFeeResponse.ProtoReflect.Descriptor instead.
-func (*BumpAccountFeeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountPolicyResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{17}
 }
 
-type Account struct {
+func (x *SetAccountPolicyResponse) GetPolicy() *AccountPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The identifying component of an account. This is the key used for the trader
-	//in the 2-of-2 multi-sig construction of an account with an auctioneer.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	//
-	//The current outpoint associated with the account. This will change every
-	//time the account has been updated.
-	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// The current total amount of satoshis in the account.
-	Value uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	//
-	//The amount of satoshis in the account that is available, meaning not
-	//allocated to any oustanding orders.
-	AvailableBalance uint64 `protobuf:"varint,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	// The height at which the account will expire.
-	ExpirationHeight uint32 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
-	// The current state of the account.
-	State AccountState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.AccountState" json:"state,omitempty"`
-	// The hash of the account's latest transaction.
-	LatestTxid []byte `protobuf:"bytes,7,opt,name=latest_txid,json=latestTxid,proto3" json:"latest_txid,omitempty"`
-	// The current version of the account.
-	Version AccountVersion `protobuf:"varint,8,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
+	// The value, in satoshis, of the output.
+	ValueSat uint64 `protobuf:"varint,1,opt,name=value_sat,json=valueSat,proto3" json:"value_sat,omitempty"`
+	// The address corresponding to the output.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *Output) Reset() {
+	*x = Output{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1709,13 +2153,13 @@ func (x *Account) Reset() {
 	}
 }
 
-func (x *Account) String() string {
+func (x *Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*Output) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *Output) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1727,87 +2171,40 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use Output.ProtoReflect.Descriptor instead.
+func (*Output) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Account) GetTraderKey() []byte {
+func (x *Output) GetValueSat() uint64 {
 	if x != nil {
-		return x.TraderKey
-	}
-	return nil
-}
-
-func (x *Account) GetOutpoint() *auctioneerrpc.OutPoint {
-	if x != nil {
-		return x.Outpoint
-	}
-	return nil
-}
-
-func (x *Account) GetValue() uint64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
-}
-
-func (x *Account) GetAvailableBalance() uint64 {
-	if x != nil {
-		return x.AvailableBalance
-	}
-	return 0
-}
-
-func (x *Account) GetExpirationHeight() uint32 {
-	if x != nil {
-		return x.ExpirationHeight
+		return x.ValueSat
 	}
 	return 0
 }
 
-func (x *Account) GetState() AccountState {
-	if x != nil {
-		return x.State
-	}
-	return AccountState_PENDING_OPEN
-}
-
-func (x *Account) GetLatestTxid() []byte {
-	if x != nil {
-		return x.LatestTxid
-	}
-	return nil
-}
-
-func (x *Account) GetVersion() AccountVersion {
+func (x *Output) GetAddress() string {
 	if x != nil {
-		return x.Version
+		return x.Address
 	}
-	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
+	return ""
 }
 
-type SubmitOrderRequest struct {
+type OutputWithFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Details:
-	//	*SubmitOrderRequest_Ask
-	//	*SubmitOrderRequest_Bid
-	Details isSubmitOrderRequest_Details `protobuf_oneof:"details"`
-	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of pool. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (poold, LiT) and the method used for
-	//submitting the order (pool CLI, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// The address corresponding to the output.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Types that are assignable to Fees:
+	//	*OutputWithFee_ConfTarget
+	//	*OutputWithFee_FeeRateSatPerKw
+	Fees isOutputWithFee_Fees `protobuf_oneof:"fees"`
 }
 
-func (x *SubmitOrderRequest) Reset() {
-	*x = SubmitOrderRequest{}
+func (x *OutputWithFee) Reset() {
+	*x = OutputWithFee{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,13 +2212,13 @@ func (x *SubmitOrderRequest) Reset() {
 	}
 }
 
-func (x *SubmitOrderRequest) String() string {
+func (x *OutputWithFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitOrderRequest) ProtoMessage() {}
+func (*OutputWithFee) ProtoMessage() {}
 
-func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *OutputWithFee) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1833,72 +2230,69 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
-func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputWithFee.ProtoReflect.Descriptor instead.
+func (*OutputWithFee) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *SubmitOrderRequest) GetDetails() isSubmitOrderRequest_Details {
-	if m != nil {
-		return m.Details
+func (x *OutputWithFee) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *SubmitOrderRequest) GetAsk() *Ask {
-	if x, ok := x.GetDetails().(*SubmitOrderRequest_Ask); ok {
-		return x.Ask
+func (m *OutputWithFee) GetFees() isOutputWithFee_Fees {
+	if m != nil {
+		return m.Fees
 	}
 	return nil
 }
 
-func (x *SubmitOrderRequest) GetBid() *Bid {
-	if x, ok := x.GetDetails().(*SubmitOrderRequest_Bid); ok {
-		return x.Bid
+func (x *OutputWithFee) GetConfTarget() uint32 {
+	if x, ok := x.GetFees().(*OutputWithFee_ConfTarget); ok {
+		return x.ConfTarget
 	}
-	return nil
+	return 0
 }
 
-func (x *SubmitOrderRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
+func (x *OutputWithFee) GetFeeRateSatPerKw() uint64 {
+	if x, ok := x.GetFees().(*OutputWithFee_FeeRateSatPerKw); ok {
+		return x.FeeRateSatPerKw
 	}
-	return ""
+	return 0
 }
 
-type isSubmitOrderRequest_Details interface {
-	isSubmitOrderRequest_Details()
+type isOutputWithFee_Fees interface {
+	isOutputWithFee_Fees()
 }
 
-type SubmitOrderRequest_Ask struct {
-	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+type OutputWithFee_ConfTarget struct {
+	//
+	//The target number of blocks that the transaction should be confirmed in.
+	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3,oneof"`
 }
 
-type SubmitOrderRequest_Bid struct {
-	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3,oneof"`
+type OutputWithFee_FeeRateSatPerKw struct {
+	//
+	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3,oneof"`
 }
 
-func (*SubmitOrderRequest_Ask) isSubmitOrderRequest_Details() {}
+func (*OutputWithFee_ConfTarget) isOutputWithFee_Fees() {}
 
-func (*SubmitOrderRequest_Bid) isSubmitOrderRequest_Details() {}
+func (*OutputWithFee_FeeRateSatPerKw) isOutputWithFee_Fees() {}
 
-type SubmitOrderResponse struct {
+type OutputsWithImplicitFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Details:
-	//	*SubmitOrderResponse_InvalidOrder
-	//	*SubmitOrderResponse_AcceptedOrderNonce
-	Details isSubmitOrderResponse_Details `protobuf_oneof:"details"`
-	//
-	//In case a bid order was submitted for a sidecar ticket, that ticket is
-	//updated with the new state and bid order nonce.
-	UpdatedSidecarTicket string `protobuf:"bytes,3,opt,name=updated_sidecar_ticket,json=updatedSidecarTicket,proto3" json:"updated_sidecar_ticket,omitempty"`
+	Outputs []*Output `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
 }
 
-func (x *SubmitOrderResponse) Reset() {
-	*x = SubmitOrderResponse{}
+func (x *OutputsWithImplicitFee) Reset() {
+	*x = OutputsWithImplicitFee{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1906,13 +2300,13 @@ func (x *SubmitOrderResponse) Reset() {
 	}
 }
 
-func (x *SubmitOrderResponse) String() string {
+func (x *OutputsWithImplicitFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitOrderResponse) ProtoMessage() {}
+func (*OutputsWithImplicitFee) ProtoMessage() {}
 
-func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputsWithImplicitFee) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1924,75 +2318,33 @@ func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
-func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputsWithImplicitFee.ProtoReflect.Descriptor instead.
+func (*OutputsWithImplicitFee) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *SubmitOrderResponse) GetDetails() isSubmitOrderResponse_Details {
-	if m != nil {
-		return m.Details
-	}
-	return nil
-}
-
-func (x *SubmitOrderResponse) GetInvalidOrder() *auctioneerrpc.InvalidOrder {
-	if x, ok := x.GetDetails().(*SubmitOrderResponse_InvalidOrder); ok {
-		return x.InvalidOrder
-	}
-	return nil
-}
-
-func (x *SubmitOrderResponse) GetAcceptedOrderNonce() []byte {
-	if x, ok := x.GetDetails().(*SubmitOrderResponse_AcceptedOrderNonce); ok {
-		return x.AcceptedOrderNonce
-	}
-	return nil
-}
-
-func (x *SubmitOrderResponse) GetUpdatedSidecarTicket() string {
+func (x *OutputsWithImplicitFee) GetOutputs() []*Output {
 	if x != nil {
-		return x.UpdatedSidecarTicket
+		return x.Outputs
 	}
-	return ""
-}
-
-type isSubmitOrderResponse_Details interface {
-	isSubmitOrderResponse_Details()
-}
-
-type SubmitOrderResponse_InvalidOrder struct {
-	//
-	//Order failed with the given reason.
-	InvalidOrder *auctioneerrpc.InvalidOrder `protobuf:"bytes,1,opt,name=invalid_order,json=invalidOrder,proto3,oneof"`
-}
-
-type SubmitOrderResponse_AcceptedOrderNonce struct {
-	//
-	//The order nonce of the accepted order.
-	AcceptedOrderNonce []byte `protobuf:"bytes,2,opt,name=accepted_order_nonce,json=acceptedOrderNonce,proto3,oneof"`
+	return nil
 }
 
-func (*SubmitOrderResponse_InvalidOrder) isSubmitOrderResponse_Details() {}
-
-func (*SubmitOrderResponse_AcceptedOrderNonce) isSubmitOrderResponse_Details() {}
-
-type ListOrdersRequest struct {
+type CloseAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//Can be set to true to list the orders including all events, which can be
-	//very verbose.
-	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	//
-	//Only list orders that are still active.
-	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// The trader key associated with the account that will be closed.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// Types that are assignable to FundsDestination:
+	//	*CloseAccountRequest_OutputWithFee
+	//	*CloseAccountRequest_Outputs
+	FundsDestination isCloseAccountRequest_FundsDestination `protobuf_oneof:"funds_destination"`
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
+func (x *CloseAccountRequest) Reset() {
+	*x = CloseAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2000,13 +2352,13 @@ func (x *ListOrdersRequest) Reset() {
 	}
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *CloseAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*CloseAccountRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2018,105 +2370,91 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListOrdersRequest) GetVerbose() bool {
+func (x *CloseAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Verbose
+		return x.TraderKey
 	}
-	return false
+	return nil
 }
 
-func (x *ListOrdersRequest) GetActiveOnly() bool {
-	if x != nil {
-		return x.ActiveOnly
+func (m *CloseAccountRequest) GetFundsDestination() isCloseAccountRequest_FundsDestination {
+	if m != nil {
+		return m.FundsDestination
 	}
-	return false
-}
-
-type ListOrdersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Asks []*Ask `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks,omitempty"`
-	Bids []*Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	return nil
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CloseAccountRequest) GetOutputWithFee() *OutputWithFee {
+	if x, ok := x.GetFundsDestination().(*CloseAccountRequest_OutputWithFee); ok {
+		return x.OutputWithFee
 	}
+	return nil
 }
 
-func (x *ListOrdersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListOrdersResponse) ProtoMessage() {}
-
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CloseAccountRequest) GetOutputs() *OutputsWithImplicitFee {
+	if x, ok := x.GetFundsDestination().(*CloseAccountRequest_Outputs); ok {
+		return x.Outputs
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{22}
+type isCloseAccountRequest_FundsDestination interface {
+	isCloseAccountRequest_FundsDestination()
 }
 
-func (x *ListOrdersResponse) GetAsks() []*Ask {
-	if x != nil {
-		return x.Asks
-	}
-	return nil
+type CloseAccountRequest_OutputWithFee struct {
+	//
+	//A single output/address to which the remaining funds of the account will
+	//be sent to at the specified fee. If an address is not specified, then
+	//the funds are sent to an address the backing lnd node controls.
+	OutputWithFee *OutputWithFee `protobuf:"bytes,2,opt,name=output_with_fee,json=outputWithFee,proto3,oneof"`
 }
 
-func (x *ListOrdersResponse) GetBids() []*Bid {
-	if x != nil {
-		return x.Bids
-	}
-	return nil
+type CloseAccountRequest_Outputs struct {
+	//
+	//The outputs to which the remaining funds of the account will be sent to.
+	//This should only be used when wanting to create two or more outputs,
+	//otherwise OutputWithFee should be used instead. The fee of the account's
+	//closing transaction is implicitly defined by the combined value of all
+	//outputs.
+	Outputs *OutputsWithImplicitFee `protobuf:"bytes,3,opt,name=outputs,proto3,oneof"`
 }
 
-type CancelOrderRequest struct {
+func (*CloseAccountRequest_OutputWithFee) isCloseAccountRequest_FundsDestination() {}
+
+func (*CloseAccountRequest_Outputs) isCloseAccountRequest_FundsDestination() {}
+
+type CloseAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// The hash of the closing transaction.
+	CloseTxid []byte `protobuf:"bytes,1,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
+func (x *CloseAccountResponse) Reset() {
+	*x = CloseAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[23]
+		mi := &file_trader_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *CloseAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*CloseAccountResponse) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[23]
+func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,131 +2465,60 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CancelOrderRequest) GetOrderNonce() []byte {
+func (x *CloseAccountResponse) GetCloseTxid() []byte {
 	if x != nil {
-		return x.OrderNonce
+		return x.CloseTxid
 	}
 	return nil
 }
 
-type CancelOrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CancelOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CancelOrderResponse) ProtoMessage() {}
-
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{24}
-}
-
-type Order struct {
+type WithdrawAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The trader's account key of the account that is used for the order.
+	//The trader key associated with the account that funds will be withdrawed
+	//from.
 	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The outputs we'll withdraw funds from the account into.
+	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	//
-	//Fixed order rate in parts per billion.
-	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
-	//
-	//Order amount in satoshis.
-	Amt uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Maximum fee rate the trader is willing to pay for the batch transaction,
-	//expressed in satoshis per 1000 weight units (sat/KW).
-	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
-	//
-	//Order nonce, acts as unique order identifier.
-	OrderNonce []byte `protobuf:"bytes,5,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
-	//
-	//The state the order currently is in.
-	State auctioneerrpc.OrderState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.OrderState" json:"state,omitempty"`
-	//
-	//The number of order units the amount corresponds to.
-	Units uint32 `protobuf:"varint,7,opt,name=units,proto3" json:"units,omitempty"`
-	//
-	//The number of currently unfilled units of this order. This will be equal to
-	//the total amount of units until the order has reached the state PARTIAL_FILL
-	//or EXECUTED.
-	UnitsUnfulfilled uint32 `protobuf:"varint,8,opt,name=units_unfulfilled,json=unitsUnfulfilled,proto3" json:"units_unfulfilled,omitempty"`
-	// The value reserved from the account by this order to ensure the account
-	// can pay execution and chain fees in case it gets matched.
-	ReservedValueSat uint64 `protobuf:"varint,9,opt,name=reserved_value_sat,json=reservedValueSat,proto3" json:"reserved_value_sat,omitempty"`
-	// The unix timestamp in nanoseconds the order was first created/submitted.
-	CreationTimestampNs uint64 `protobuf:"varint,10,opt,name=creation_timestamp_ns,json=creationTimestampNs,proto3" json:"creation_timestamp_ns,omitempty"`
+	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*WithdrawAccountRequest_AbsoluteExpiry
+	//	*WithdrawAccountRequest_RelativeExpiry
+	AccountExpiry isWithdrawAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
 	//
-	//A list of events that were emitted for this order. This field is only set
-	//when the verbose flag is set to true in the request.
-	Events []*OrderEvent `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
-	// The minimum number of order units that must be matched per order pair.
-	MinUnitsMatch uint32 `protobuf:"varint,12,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
-	// The channel type to use for the resulting matched channels.
-	ChannelType auctioneerrpc.OrderChannelType `protobuf:"varint,13,opt,name=channel_type,json=channelType,proto3,enum=poolrpc.OrderChannelType" json:"channel_type,omitempty"`
-	// List of nodes that will be allowed to match with our order. Incompatible
-	// with the `not_allowed_node_ids` field.
-	AllowedNodeIds [][]byte `protobuf:"bytes,14,rep,name=allowed_node_ids,json=allowedNodeIds,proto3" json:"allowed_node_ids,omitempty"`
-	// List of nodes that won't be allowed to match with our order. Incompatible
-	// with the `allowed_node_ids` field.
-	NotAllowedNodeIds [][]byte `protobuf:"bytes,15,rep,name=not_allowed_node_ids,json=notAllowedNodeIds,proto3" json:"not_allowed_node_ids,omitempty"`
-	// Auction type where this order must be considered during the matching.
-	AuctionType auctioneerrpc.AuctionType `protobuf:"varint,16,opt,name=auction_type,json=auctionType,proto3,enum=poolrpc.AuctionType" json:"auction_type,omitempty"`
-	// Flag used to signal that this order can be shared in public market
-	// places.
-	IsPublic bool `protobuf:"varint,17,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	//The new version of the account. If this is set and is a valid version
+	//greater than the account's current version, then the account is upgraded to
+	//that version during the withdrawal.
+	NewVersion AccountVersion `protobuf:"varint,6,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *WithdrawAccountRequest) Reset() {
+	*x = WithdrawAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[25]
+		mi := &file_trader_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order) String() string {
+func (x *WithdrawAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*WithdrawAccountRequest) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[25]
+func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,189 +2529,170 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WithdrawAccountRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Order) GetTraderKey() []byte {
+func (x *WithdrawAccountRequest) GetTraderKey() []byte {
 	if x != nil {
 		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *Order) GetRateFixed() uint32 {
-	if x != nil {
-		return x.RateFixed
-	}
-	return 0
-}
-
-func (x *Order) GetAmt() uint64 {
+func (x *WithdrawAccountRequest) GetOutputs() []*Output {
 	if x != nil {
-		return x.Amt
+		return x.Outputs
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetMaxBatchFeeRateSatPerKw() uint64 {
+func (x *WithdrawAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.MaxBatchFeeRateSatPerKw
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *Order) GetOrderNonce() []byte {
-	if x != nil {
-		return x.OrderNonce
+func (m *WithdrawAccountRequest) GetAccountExpiry() isWithdrawAccountRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
 	return nil
 }
 
-func (x *Order) GetState() auctioneerrpc.OrderState {
-	if x != nil {
-		return x.State
+func (x *WithdrawAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
-	return auctioneerrpc.OrderState(0)
+	return 0
 }
 
-func (x *Order) GetUnits() uint32 {
-	if x != nil {
-		return x.Units
+func (x *WithdrawAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
 	}
 	return 0
 }
 
-func (x *Order) GetUnitsUnfulfilled() uint32 {
+func (x *WithdrawAccountRequest) GetNewVersion() AccountVersion {
 	if x != nil {
-		return x.UnitsUnfulfilled
+		return x.NewVersion
 	}
-	return 0
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-func (x *Order) GetReservedValueSat() uint64 {
-	if x != nil {
-		return x.ReservedValueSat
-	}
-	return 0
+type isWithdrawAccountRequest_AccountExpiry interface {
+	isWithdrawAccountRequest_AccountExpiry()
 }
 
-func (x *Order) GetCreationTimestampNs() uint64 {
-	if x != nil {
-		return x.CreationTimestampNs
-	}
-	return 0
+type WithdrawAccountRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-func (x *Order) GetEvents() []*OrderEvent {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+type WithdrawAccountRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
 }
 
-func (x *Order) GetMinUnitsMatch() uint32 {
-	if x != nil {
-		return x.MinUnitsMatch
-	}
-	return 0
+func (*WithdrawAccountRequest_AbsoluteExpiry) isWithdrawAccountRequest_AccountExpiry() {}
+
+func (*WithdrawAccountRequest_RelativeExpiry) isWithdrawAccountRequest_AccountExpiry() {}
+
+type WithdrawAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the account after processing the withdrawal.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to withdraw funds from the account.
+	WithdrawTxid []byte `protobuf:"bytes,2,opt,name=withdraw_txid,json=withdrawTxid,proto3" json:"withdraw_txid,omitempty"`
 }
 
-func (x *Order) GetChannelType() auctioneerrpc.OrderChannelType {
-	if x != nil {
-		return x.ChannelType
+func (x *WithdrawAccountResponse) Reset() {
+	*x = WithdrawAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return auctioneerrpc.OrderChannelType(0)
 }
 
-func (x *Order) GetAllowedNodeIds() [][]byte {
-	if x != nil {
-		return x.AllowedNodeIds
-	}
-	return nil
+func (x *WithdrawAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Order) GetNotAllowedNodeIds() [][]byte {
-	if x != nil {
-		return x.NotAllowedNodeIds
+func (*WithdrawAccountResponse) ProtoMessage() {}
+
+func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetAuctionType() auctioneerrpc.AuctionType {
+// Deprecated: Use WithdrawAccountResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WithdrawAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.AuctionType
+		return x.Account
 	}
-	return auctioneerrpc.AuctionType(0)
+	return nil
 }
 
-func (x *Order) GetIsPublic() bool {
+func (x *WithdrawAccountResponse) GetWithdrawTxid() []byte {
 	if x != nil {
-		return x.IsPublic
+		return x.WithdrawTxid
 	}
-	return false
+	return nil
 }
 
-type Bid struct {
+type SplitAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The trader key associated with the account that is split.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The amount in satoshis to move into the new account.
+	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
 	//
-	//The common fields shared between both ask and bid order types.
-	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	//
-	//Required number of blocks that a channel opened as a result of this bid
-	//should be kept open.
-	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
-	//
-	//The version of the order format that is used. Will be increased once new
-	//features are added.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	//
-	//The minimum node tier this order should be matched with. Only asks backed by
-	//a node this tier or higher will be eligible for matching with this bid.
-	MinNodeTier auctioneerrpc.NodeTier `protobuf:"varint,4,opt,name=min_node_tier,json=minNodeTier,proto3,enum=poolrpc.NodeTier" json:"min_node_tier,omitempty"`
-	//
-	//Give the incoming channel that results from this bid being matched an
-	//initial outbound balance by adding additional funds from the taker's account
-	//into the channel. As a simplification for the execution protocol and the
-	//channel reserve calculations the min_chan_amt must be set to the full order
-	//amount. For the inbound liquidity market the self_chan_balance can be at
-	//most the same as the order amount.
-	SelfChanBalance uint64 `protobuf:"varint,5,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
-	//
-	//If this bid order is meant to lease a channel for another node (which is
-	//dubbed a "sidecar channel") then this ticket contains all information
-	//required for setting up that sidecar channel. The ticket is expected to be
-	//the base58 encoded ticket, including the prefix and the checksum.
-	SidecarTicket string `protobuf:"bytes,6,opt,name=sidecar_ticket,json=sidecarTicket,proto3" json:"sidecar_ticket,omitempty"`
-	//
-	//Signals if this bid is interested in an announced or unannounced channel.
-	UnannouncedChannel bool `protobuf:"varint,7,opt,name=unannounced_channel,json=unannouncedChannel,proto3" json:"unannounced_channel,omitempty"`
-	//
-	//Signals if this bid is interested in a zero conf channel or not.
-	ZeroConfChannel bool `protobuf:"varint,8,opt,name=zero_conf_channel,json=zeroConfChannel,proto3" json:"zero_conf_channel,omitempty"`
+	//The fee rate, in satoshis per kw, to use for the split transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*SplitAccountRequest_AbsoluteExpiry
+	//	*SplitAccountRequest_RelativeExpiry
+	AccountExpiry isSplitAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
 }
 
-func (x *Bid) Reset() {
-	*x = Bid{}
+func (x *SplitAccountRequest) Reset() {
+	*x = SplitAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[26]
+		mi := &file_trader_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Bid) String() string {
+func (x *SplitAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bid) ProtoMessage() {}
+func (*SplitAccountRequest) ProtoMessage() {}
 
-func (x *Bid) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[26]
+func (x *SplitAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,109 +2703,101 @@ func (x *Bid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
-func (*Bid) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SplitAccountRequest.ProtoReflect.Descriptor instead.
+func (*SplitAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Bid) GetDetails() *Order {
+func (x *SplitAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Details
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *Bid) GetLeaseDurationBlocks() uint32 {
+func (x *SplitAccountRequest) GetAmountSat() uint64 {
 	if x != nil {
-		return x.LeaseDurationBlocks
+		return x.AmountSat
 	}
 	return 0
 }
 
-func (x *Bid) GetVersion() uint32 {
+func (x *SplitAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.Version
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *Bid) GetMinNodeTier() auctioneerrpc.NodeTier {
-	if x != nil {
-		return x.MinNodeTier
+func (m *SplitAccountRequest) GetAccountExpiry() isSplitAccountRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
-	return auctioneerrpc.NodeTier(0)
+	return nil
 }
 
-func (x *Bid) GetSelfChanBalance() uint64 {
-	if x != nil {
-		return x.SelfChanBalance
+func (x *SplitAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*SplitAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
 	return 0
 }
 
-func (x *Bid) GetSidecarTicket() string {
-	if x != nil {
-		return x.SidecarTicket
+func (x *SplitAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*SplitAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
 	}
-	return ""
+	return 0
 }
 
-func (x *Bid) GetUnannouncedChannel() bool {
-	if x != nil {
-		return x.UnannouncedChannel
-	}
-	return false
+type isSplitAccountRequest_AccountExpiry interface {
+	isSplitAccountRequest_AccountExpiry()
 }
 
-func (x *Bid) GetZeroConfChannel() bool {
-	if x != nil {
-		return x.ZeroConfChannel
-	}
-	return false
+type SplitAccountRequest_AbsoluteExpiry struct {
+	// The absolute expiration height of the new account.
+	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-type Ask struct {
+type SplitAccountRequest_RelativeExpiry struct {
+	// The relative expiration height of the new account.
+	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+}
+
+func (*SplitAccountRequest_AbsoluteExpiry) isSplitAccountRequest_AccountExpiry() {}
+
+func (*SplitAccountRequest_RelativeExpiry) isSplitAccountRequest_AccountExpiry() {}
+
+type SplitAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The common fields shared between both ask and bid order types.
-	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	//
-	//The number of blocks the liquidity provider is willing to provide the
-	//channel funds for.
-	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
-	//
-	//The version of the order format that is used. Will be increased once new
-	//features are added.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	//
-	//The constraints for selling the liquidity based on channel discoverability.
-	AnnouncementConstraints auctioneerrpc.ChannelAnnouncementConstraints `protobuf:"varint,4,opt,name=announcement_constraints,json=announcementConstraints,proto3,enum=poolrpc.ChannelAnnouncementConstraints" json:"announcement_constraints,omitempty"`
-	//
-	//The constraints for selling the liquidity based on the number of
-	//blocks before considering the channel confirmed.
-	ConfirmationConstraints auctioneerrpc.ChannelConfirmationConstraints `protobuf:"varint,5,opt,name=confirmation_constraints,json=confirmationConstraints,proto3,enum=poolrpc.ChannelConfirmationConstraints" json:"confirmation_constraints,omitempty"`
+	// The state of the existing account after processing the split.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The new account funded by the split.
+	NewAccount *Account `protobuf:"bytes,2,opt,name=new_account,json=newAccount,proto3" json:"new_account,omitempty"`
+	// The transaction used to split the account.
+	SplitTxid []byte `protobuf:"bytes,3,opt,name=split_txid,json=splitTxid,proto3" json:"split_txid,omitempty"`
 }
 
-func (x *Ask) Reset() {
-	*x = Ask{}
+func (x *SplitAccountResponse) Reset() {
+	*x = SplitAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[27]
+		mi := &file_trader_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ask) String() string {
+func (x *SplitAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ask) ProtoMessage() {}
+func (*SplitAccountResponse) ProtoMessage() {}
 
-func (x *Ask) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[27]
+func (x *SplitAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,86 +2808,64 @@ func (x *Ask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ask.ProtoReflect.Descriptor instead.
-func (*Ask) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SplitAccountResponse.ProtoReflect.Descriptor instead.
+func (*SplitAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Ask) GetDetails() *Order {
+func (x *SplitAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Details
+		return x.Account
 	}
 	return nil
 }
 
-func (x *Ask) GetLeaseDurationBlocks() uint32 {
-	if x != nil {
-		return x.LeaseDurationBlocks
-	}
-	return 0
-}
-
-func (x *Ask) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *Ask) GetAnnouncementConstraints() auctioneerrpc.ChannelAnnouncementConstraints {
+func (x *SplitAccountResponse) GetNewAccount() *Account {
 	if x != nil {
-		return x.AnnouncementConstraints
+		return x.NewAccount
 	}
-	return auctioneerrpc.ChannelAnnouncementConstraints(0)
+	return nil
 }
 
-func (x *Ask) GetConfirmationConstraints() auctioneerrpc.ChannelConfirmationConstraints {
+func (x *SplitAccountResponse) GetSplitTxid() []byte {
 	if x != nil {
-		return x.ConfirmationConstraints
+		return x.SplitTxid
 	}
-	return auctioneerrpc.ChannelConfirmationConstraints(0)
+	return nil
 }
 
-type QuoteOrderRequest struct {
+type MergeAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The trader key associated with the account that funds are merged into.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//Order amount in satoshis.
-	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Fixed order rate in parts per billion.
-	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
-	//
-	//Required number of blocks that a channel opened as a result of this bid
-	//should be kept open.
-	LeaseDurationBlocks uint32 `protobuf:"varint,3,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//The trader key associated with the account that is merged and closed.
+	MergeTraderKey []byte `protobuf:"bytes,2,opt,name=merge_trader_key,json=mergeTraderKey,proto3" json:"merge_trader_key,omitempty"`
 	//
-	//Maximum fee rate the trader is willing to pay for the batch transaction,
-	//expressed in satoshis per 1000 weight units (sat/KW).
-	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
-	// The minimum number of order units that must be matched per order pair.
-	MinUnitsMatch uint32 `protobuf:"varint,5,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
+	//The fee rate, in satoshis per kw, to use for the merge transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 }
 
-func (x *QuoteOrderRequest) Reset() {
-	*x = QuoteOrderRequest{}
+func (x *MergeAccountsRequest) Reset() {
+	*x = MergeAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[28]
+		mi := &file_trader_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteOrderRequest) String() string {
+func (x *MergeAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteOrderRequest) ProtoMessage() {}
+func (*MergeAccountsRequest) ProtoMessage() {}
 
-func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[28]
+func (x *MergeAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2658,92 +2876,60 @@ func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
-func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *QuoteOrderRequest) GetAmt() uint64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
-}
-
-func (x *QuoteOrderRequest) GetRateFixed() uint32 {
-	if x != nil {
-		return x.RateFixed
-	}
-	return 0
+// Deprecated: Use MergeAccountsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QuoteOrderRequest) GetLeaseDurationBlocks() uint32 {
+func (x *MergeAccountsRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.LeaseDurationBlocks
+		return x.TraderKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteOrderRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
+func (x *MergeAccountsRequest) GetMergeTraderKey() []byte {
 	if x != nil {
-		return x.MaxBatchFeeRateSatPerKw
+		return x.MergeTraderKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteOrderRequest) GetMinUnitsMatch() uint32 {
+func (x *MergeAccountsRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.MinUnitsMatch
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-type QuoteOrderResponse struct {
+type MergeAccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The total order premium in satoshis for filling the entire order. This
-	//represents the interest amount paid to the maker by the taker excluding any
-	//execution or chain fees.
-	TotalPremiumSat uint64 `protobuf:"varint,1,opt,name=total_premium_sat,json=totalPremiumSat,proto3" json:"total_premium_sat,omitempty"`
-	//
-	//The fixed order rate expressed as a fraction instead of parts per billion.
-	RatePerBlock float64 `protobuf:"fixed64,2,opt,name=rate_per_block,json=ratePerBlock,proto3" json:"rate_per_block,omitempty"`
-	//
-	//The fixed order rate expressed as a percentage instead of parts per billion.
-	RatePercent float64 `protobuf:"fixed64,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`
-	//
-	//The total execution fee in satoshis that needs to be paid to the auctioneer
-	//for executing the entire order.
-	TotalExecutionFeeSat uint64 `protobuf:"varint,4,opt,name=total_execution_fee_sat,json=totalExecutionFeeSat,proto3" json:"total_execution_fee_sat,omitempty"`
-	//
-	//The worst case chain fees that need to be paid if fee rates spike up to the
-	//max_batch_fee_rate_sat_per_kw value specified in the request. This value is
-	//highly dependent on the min_units_match parameter as well since the
-	//calculation assumes chain fees for the chain footprint of opening
-	//amt/min_units_match channels (hence worst case calculation).
-	WorstCaseChainFeeSat uint64 `protobuf:"varint,5,opt,name=worst_case_chain_fee_sat,json=worstCaseChainFeeSat,proto3" json:"worst_case_chain_fee_sat,omitempty"`
+	// The state of the account after processing the merge.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to merge the accounts.
+	MergeTxid []byte `protobuf:"bytes,2,opt,name=merge_txid,json=mergeTxid,proto3" json:"merge_txid,omitempty"`
 }
 
-func (x *QuoteOrderResponse) Reset() {
-	*x = QuoteOrderResponse{}
+func (x *MergeAccountsResponse) Reset() {
+	*x = MergeAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[29]
+		mi := &file_trader_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteOrderResponse) String() string {
+func (x *MergeAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteOrderResponse) ProtoMessage() {}
+func (*MergeAccountsResponse) ProtoMessage() {}
 
-func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[29]
+func (x *MergeAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,80 +2940,67 @@ func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
-func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *QuoteOrderResponse) GetTotalPremiumSat() uint64 {
-	if x != nil {
-		return x.TotalPremiumSat
-	}
-	return 0
-}
-
-func (x *QuoteOrderResponse) GetRatePerBlock() float64 {
-	if x != nil {
-		return x.RatePerBlock
-	}
-	return 0
-}
-
-func (x *QuoteOrderResponse) GetRatePercent() float64 {
-	if x != nil {
-		return x.RatePercent
-	}
-	return 0
+// Deprecated: Use MergeAccountsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QuoteOrderResponse) GetTotalExecutionFeeSat() uint64 {
+func (x *MergeAccountsResponse) GetAccount() *Account {
 	if x != nil {
-		return x.TotalExecutionFeeSat
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteOrderResponse) GetWorstCaseChainFeeSat() uint64 {
+func (x *MergeAccountsResponse) GetMergeTxid() []byte {
 	if x != nil {
-		return x.WorstCaseChainFeeSat
+		return x.MergeTxid
 	}
-	return 0
+	return nil
 }
 
-type OrderEvent struct {
+type DepositAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The unix timestamp in nanoseconds the event was emitted at. This is the
-	//primary key of the event and is unique across the database.
-	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
-	// The human readable representation of the event.
-	EventStr string `protobuf:"bytes,2,opt,name=event_str,json=eventStr,proto3" json:"event_str,omitempty"`
-	// Types that are assignable to Event:
-	//	*OrderEvent_StateChange
-	//	*OrderEvent_Matched
-	Event isOrderEvent_Event `protobuf_oneof:"event"`
+	//The trader key associated with the account that funds will be deposited
+	//into.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The amount in satoshis to deposit into the account.
+	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	//
+	//The fee rate, in satoshis per kw, to use for the deposit transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*DepositAccountRequest_AbsoluteExpiry
+	//	*DepositAccountRequest_RelativeExpiry
+	AccountExpiry isDepositAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//
+	//The new version of the account. If this is set and is a valid version
+	//greater than the account's current version, then the account is upgraded to
+	//that version during the deposit.
+	NewVersion AccountVersion `protobuf:"varint,6,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
 }
 
-func (x *OrderEvent) Reset() {
-	*x = OrderEvent{}
+func (x *DepositAccountRequest) Reset() {
+	*x = DepositAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[30]
+		mi := &file_trader_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderEvent) String() string {
+func (x *DepositAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderEvent) ProtoMessage() {}
+func (*DepositAccountRequest) ProtoMessage() {}
 
-func (x *OrderEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[30]
+func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2838,98 +3011,106 @@ func (x *OrderEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
-func (*OrderEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DepositAccountRequest.ProtoReflect.Descriptor instead.
+func (*DepositAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *OrderEvent) GetTimestampNs() int64 {
+func (x *DepositAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.TimestampNs
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *DepositAccountRequest) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
 	}
 	return 0
 }
 
-func (x *OrderEvent) GetEventStr() string {
+func (x *DepositAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.EventStr
+		return x.FeeRateSatPerKw
 	}
-	return ""
+	return 0
 }
 
-func (m *OrderEvent) GetEvent() isOrderEvent_Event {
+func (m *DepositAccountRequest) GetAccountExpiry() isDepositAccountRequest_AccountExpiry {
 	if m != nil {
-		return m.Event
+		return m.AccountExpiry
 	}
 	return nil
 }
 
-func (x *OrderEvent) GetStateChange() *UpdatedEvent {
-	if x, ok := x.GetEvent().(*OrderEvent_StateChange); ok {
-		return x.StateChange
+func (x *DepositAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderEvent) GetMatched() *MatchEvent {
-	if x, ok := x.GetEvent().(*OrderEvent_Matched); ok {
-		return x.Matched
+func (x *DepositAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
 	}
-	return nil
+	return 0
 }
 
-type isOrderEvent_Event interface {
-	isOrderEvent_Event()
+func (x *DepositAccountRequest) GetNewVersion() AccountVersion {
+	if x != nil {
+		return x.NewVersion
+	}
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-type OrderEvent_StateChange struct {
-	// The order was updated in the database.
-	StateChange *UpdatedEvent `protobuf:"bytes,3,opt,name=state_change,json=stateChange,proto3,oneof"`
+type isDepositAccountRequest_AccountExpiry interface {
+	isDepositAccountRequest_AccountExpiry()
 }
 
-type OrderEvent_Matched struct {
-	// The order was involved in a match making attempt.
-	Matched *MatchEvent `protobuf:"bytes,4,opt,name=matched,proto3,oneof"`
+type DepositAccountRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-func (*OrderEvent_StateChange) isOrderEvent_Event() {}
+type DepositAccountRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+}
 
-func (*OrderEvent_Matched) isOrderEvent_Event() {}
+func (*DepositAccountRequest_AbsoluteExpiry) isDepositAccountRequest_AccountExpiry() {}
 
-type UpdatedEvent struct {
+func (*DepositAccountRequest_RelativeExpiry) isDepositAccountRequest_AccountExpiry() {}
+
+type DepositAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The state of the order previous to the change. This is what the state
-	//changed from.
-	PreviousState auctioneerrpc.OrderState `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=poolrpc.OrderState" json:"previous_state,omitempty"`
-	//
-	//The new state of the order after the change. This is what the state changed
-	//to.
-	NewState auctioneerrpc.OrderState `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=poolrpc.OrderState" json:"new_state,omitempty"`
-	// The units that were filled at the time of the event.
-	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The state of the account after processing the deposit.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to deposit funds into the account.
+	DepositTxid []byte `protobuf:"bytes,2,opt,name=deposit_txid,json=depositTxid,proto3" json:"deposit_txid,omitempty"`
 }
 
-func (x *UpdatedEvent) Reset() {
-	*x = UpdatedEvent{}
+func (x *DepositAccountResponse) Reset() {
+	*x = DepositAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[31]
+		mi := &file_trader_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdatedEvent) String() string {
+func (x *DepositAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatedEvent) ProtoMessage() {}
+func (*DepositAccountResponse) ProtoMessage() {}
 
-func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[31]
+func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2940,66 +3121,62 @@ func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatedEvent.ProtoReflect.Descriptor instead.
-func (*UpdatedEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *UpdatedEvent) GetPreviousState() auctioneerrpc.OrderState {
-	if x != nil {
-		return x.PreviousState
-	}
-	return auctioneerrpc.OrderState(0)
+// Deprecated: Use DepositAccountResponse.ProtoReflect.Descriptor instead.
+func (*DepositAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdatedEvent) GetNewState() auctioneerrpc.OrderState {
+func (x *DepositAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.NewState
+		return x.Account
 	}
-	return auctioneerrpc.OrderState(0)
+	return nil
 }
 
-func (x *UpdatedEvent) GetUnitsFilled() uint32 {
+func (x *DepositAccountResponse) GetDepositTxid() []byte {
 	if x != nil {
-		return x.UnitsFilled
+		return x.DepositTxid
 	}
-	return 0
+	return nil
 }
 
-type MatchEvent struct {
+type RenewAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the match making process the order went through.
-	MatchState MatchState `protobuf:"varint,1,opt,name=match_state,json=matchState,proto3,enum=poolrpc.MatchState" json:"match_state,omitempty"`
-	// The number of units that would be (or were) filled with this match.
-	UnitsFilled uint32 `protobuf:"varint,2,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
-	// The nonce of the order we were matched to.
-	MatchedOrder []byte `protobuf:"bytes,3,opt,name=matched_order,json=matchedOrder,proto3" json:"matched_order,omitempty"`
+	// The key associated with the account to renew.
+	AccountKey []byte `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*RenewAccountRequest_AbsoluteExpiry
+	//	*RenewAccountRequest_RelativeExpiry
+	AccountExpiry isRenewAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	// The fee rate, in satoshis per kw, to use for the renewal transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 	//
-	//The reason why the trader daemon rejected the order. Is only set if
-	//match_state is set to REJECTED.
-	RejectReason MatchRejectReason `protobuf:"varint,4,opt,name=reject_reason,json=rejectReason,proto3,enum=poolrpc.MatchRejectReason" json:"reject_reason,omitempty"`
+	//The new version of the account. If this is set and is a valid version
+	//greater than the account's current version, then the account is upgraded to
+	//that version during the renewal.
+	NewVersion AccountVersion `protobuf:"varint,5,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
 }
 
-func (x *MatchEvent) Reset() {
-	*x = MatchEvent{}
+func (x *RenewAccountRequest) Reset() {
+	*x = RenewAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[32]
+		mi := &file_trader_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MatchEvent) String() string {
+func (x *RenewAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchEvent) ProtoMessage() {}
+func (*RenewAccountRequest) ProtoMessage() {}
 
-func (x *MatchEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[32]
+func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,84 +3187,152 @@ func (x *MatchEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
-func (*MatchEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RenewAccountRequest.ProtoReflect.Descriptor instead.
+func (*RenewAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MatchEvent) GetMatchState() MatchState {
+func (x *RenewAccountRequest) GetAccountKey() []byte {
 	if x != nil {
-		return x.MatchState
+		return x.AccountKey
 	}
-	return MatchState_PREPARE
+	return nil
 }
 
-func (x *MatchEvent) GetUnitsFilled() uint32 {
+func (m *RenewAccountRequest) GetAccountExpiry() isRenewAccountRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
+	}
+	return nil
+}
+
+func (x *RenewAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
+	}
+	return 0
+}
+
+func (x *RenewAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
+	}
+	return 0
+}
+
+func (x *RenewAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.UnitsFilled
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *MatchEvent) GetMatchedOrder() []byte {
+func (x *RenewAccountRequest) GetNewVersion() AccountVersion {
 	if x != nil {
-		return x.MatchedOrder
+		return x.NewVersion
+	}
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
+}
+
+type isRenewAccountRequest_AccountExpiry interface {
+	isRenewAccountRequest_AccountExpiry()
+}
+
+type RenewAccountRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,2,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
+}
+
+type RenewAccountRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,3,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+}
+
+func (*RenewAccountRequest_AbsoluteExpiry) isRenewAccountRequest_AccountExpiry() {}
+
+func (*RenewAccountRequest_RelativeExpiry) isRenewAccountRequest_AccountExpiry() {}
+
+type RenewAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the account after processing the renewal.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to renew the expiration of the account.
+	RenewalTxid []byte `protobuf:"bytes,2,opt,name=renewal_txid,json=renewalTxid,proto3" json:"renewal_txid,omitempty"`
+}
+
+func (x *RenewAccountResponse) Reset() {
+	*x = RenewAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAccountResponse) ProtoMessage() {}
+
+func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewAccountResponse.ProtoReflect.Descriptor instead.
+func (*RenewAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenewAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
 	}
 	return nil
 }
 
-func (x *MatchEvent) GetRejectReason() MatchRejectReason {
+func (x *RenewAccountResponse) GetRenewalTxid() []byte {
 	if x != nil {
-		return x.RejectReason
+		return x.RenewalTxid
 	}
-	return MatchRejectReason_NONE
+	return nil
 }
 
-type RecoverAccountsRequest struct {
+type QuoteAccountRenewalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The key associated with the account to renew.
+	AccountKey []byte `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*QuoteAccountRenewalRequest_AbsoluteExpiry
+	//	*QuoteAccountRenewalRequest_RelativeExpiry
+	AccountExpiry isQuoteAccountRenewalRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
 	//
-	//Recover the latest account states without interacting with the
-	//Lightning Labs server.
-	FullClient bool `protobuf:"varint,1,opt,name=full_client,json=fullClient,proto3" json:"full_client,omitempty"`
-	//
-	//Number of accounts that we are trying to recover. Used during the
-	//full_client recovery process.
-	AccountTarget uint32 `protobuf:"varint,2,opt,name=account_target,json=accountTarget,proto3" json:"account_target,omitempty"`
-	//
-	//Auctioneer's public key. Used during the full_client recovery process.
-	//This field should be left empty for testnet/mainnet, its value is already
-	//hardcoded in our codebase.
-	AuctioneerKey string `protobuf:"bytes,3,opt,name=auctioneer_key,json=auctioneerKey,proto3" json:"auctioneer_key,omitempty"`
+	//The target number of blocks that the renewal transaction should be
+	//confirmed in. This is used to query lnd's fee estimator for the fee rate.
+	ConfTarget uint32 `protobuf:"varint,4,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 	//
-	//Initial block height. We won't try to look for any account with an expiry
-	//height smaller than this value. Used during the full_client recovery
-	//process.
-	HeightHint uint32 `protobuf:"varint,4,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
-	// bitcoind/btcd instance address. Used during the full_client recovery
-	// process.
-	BitcoinHost string `protobuf:"bytes,5,opt,name=bitcoin_host,json=bitcoinHost,proto3" json:"bitcoin_host,omitempty"`
-	// bitcoind/btcd user name. Used during the full_client recovery
-	// process.
-	BitcoinUser string `protobuf:"bytes,6,opt,name=bitcoin_user,json=bitcoinUser,proto3" json:"bitcoin_user,omitempty"`
-	// bitcoind/btcd password. Used during the full_client recovery
-	// process.
-	BitcoinPassword string `protobuf:"bytes,7,opt,name=bitcoin_password,json=bitcoinPassword,proto3" json:"bitcoin_password,omitempty"`
-	// Use HTTP POST mode? bitcoind only supports this mode. Used during the
-	// full_client recovery process.
-	BitcoinHttppostmode bool `protobuf:"varint,8,opt,name=bitcoin_httppostmode,json=bitcoinHttppostmode,proto3" json:"bitcoin_httppostmode,omitempty"`
-	// Use TLS to connect? bitcoind only supports non-TLS connections. Used
-	// during the full_client recovery process.
-	BitcoinUsetls bool `protobuf:"varint,9,opt,name=bitcoin_usetls,json=bitcoinUsetls,proto3" json:"bitcoin_usetls,omitempty"`
-	// Path to btcd's TLS certificate, if TLS is enabled. Used  during the
-	// full_client recovery process.
-	BitcoinTlspath string `protobuf:"bytes,10,opt,name=bitcoin_tlspath,json=bitcoinTlspath,proto3" json:"bitcoin_tlspath,omitempty"`
+	//The new version of the account. If this is set and is a valid version
+	//greater than the account's current version, then the quote is for upgrading
+	//the account to that version during the renewal.
+	NewVersion AccountVersion `protobuf:"varint,5,opt,name=new_version,json=newVersion,proto3,enum=poolrpc.AccountVersion" json:"new_version,omitempty"`
 }
 
-func (x *RecoverAccountsRequest) Reset() {
-	*x = RecoverAccountsRequest{}
+func (x *QuoteAccountRenewalRequest) Reset() {
+	*x = QuoteAccountRenewalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3095,13 +3340,13 @@ func (x *RecoverAccountsRequest) Reset() {
 	}
 }
 
-func (x *RecoverAccountsRequest) String() string {
+func (x *QuoteAccountRenewalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverAccountsRequest) ProtoMessage() {}
+func (*QuoteAccountRenewalRequest) ProtoMessage() {}
 
-func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
+func (x *QuoteAccountRenewalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3113,92 +3358,91 @@ func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverAccountsRequest.ProtoReflect.Descriptor instead.
-func (*RecoverAccountsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteAccountRenewalRequest.ProtoReflect.Descriptor instead.
+func (*QuoteAccountRenewalRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RecoverAccountsRequest) GetFullClient() bool {
+func (x *QuoteAccountRenewalRequest) GetAccountKey() []byte {
 	if x != nil {
-		return x.FullClient
+		return x.AccountKey
 	}
-	return false
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetAccountTarget() uint32 {
-	if x != nil {
-		return x.AccountTarget
+func (m *QuoteAccountRenewalRequest) GetAccountExpiry() isQuoteAccountRenewalRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
-	return 0
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetAuctioneerKey() string {
-	if x != nil {
-		return x.AuctioneerKey
+func (x *QuoteAccountRenewalRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*QuoteAccountRenewalRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
-	return ""
+	return 0
 }
 
-func (x *RecoverAccountsRequest) GetHeightHint() uint32 {
-	if x != nil {
-		return x.HeightHint
+func (x *QuoteAccountRenewalRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*QuoteAccountRenewalRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
 	}
 	return 0
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinHost() string {
+func (x *QuoteAccountRenewalRequest) GetConfTarget() uint32 {
 	if x != nil {
-		return x.BitcoinHost
+		return x.ConfTarget
 	}
-	return ""
+	return 0
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinUser() string {
+func (x *QuoteAccountRenewalRequest) GetNewVersion() AccountVersion {
 	if x != nil {
-		return x.BitcoinUser
+		return x.NewVersion
 	}
-	return ""
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinPassword() string {
-	if x != nil {
-		return x.BitcoinPassword
-	}
-	return ""
+type isQuoteAccountRenewalRequest_AccountExpiry interface {
+	isQuoteAccountRenewalRequest_AccountExpiry()
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinHttppostmode() bool {
-	if x != nil {
-		return x.BitcoinHttppostmode
-	}
-	return false
+type QuoteAccountRenewalRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,2,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinUsetls() bool {
-	if x != nil {
-		return x.BitcoinUsetls
-	}
-	return false
+type QuoteAccountRenewalRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,3,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinTlspath() string {
-	if x != nil {
-		return x.BitcoinTlspath
-	}
-	return ""
-}
+func (*QuoteAccountRenewalRequest_AbsoluteExpiry) isQuoteAccountRenewalRequest_AccountExpiry() {}
 
-type RecoverAccountsResponse struct {
+func (*QuoteAccountRenewalRequest_RelativeExpiry) isQuoteAccountRenewalRequest_AccountExpiry() {}
+
+type QuoteAccountRenewalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The number of accounts that were recovered.
-	NumRecoveredAccounts uint32 `protobuf:"varint,1,opt,name=num_recovered_accounts,json=numRecoveredAccounts,proto3" json:"num_recovered_accounts,omitempty"`
+	// The fee rate, in satoshis per kw, lnd estimated for the conf target.
+	MinerFeeRateSatPerKw uint64 `protobuf:"varint,1,opt,name=miner_fee_rate_sat_per_kw,json=minerFeeRateSatPerKw,proto3" json:"miner_fee_rate_sat_per_kw,omitempty"`
+	// The total on-chain fee, in satoshis, of the renewal transaction.
+	MinerFeeTotal uint64 `protobuf:"varint,2,opt,name=miner_fee_total,json=minerFeeTotal,proto3" json:"miner_fee_total,omitempty"`
+	//
+	//The fee, in satoshis, the auctioneer charges for the renewal. The
+	//auctioneer currently doesn't charge for renewing an account, so this is
+	//always zero.
+	ServerFeeTotal uint64 `protobuf:"varint,3,opt,name=server_fee_total,json=serverFeeTotal,proto3" json:"server_fee_total,omitempty"`
+	// The absolute expiration height the account would be renewed to.
+	NewExpiry uint32 `protobuf:"varint,4,opt,name=new_expiry,json=newExpiry,proto3" json:"new_expiry,omitempty"`
 }
 
-func (x *RecoverAccountsResponse) Reset() {
-	*x = RecoverAccountsResponse{}
+func (x *QuoteAccountRenewalResponse) Reset() {
+	*x = QuoteAccountRenewalResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3206,13 +3450,13 @@ func (x *RecoverAccountsResponse) Reset() {
 	}
 }
 
-func (x *RecoverAccountsResponse) String() string {
+func (x *QuoteAccountRenewalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverAccountsResponse) ProtoMessage() {}
+func (*QuoteAccountRenewalResponse) ProtoMessage() {}
 
-func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
+func (x *QuoteAccountRenewalResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,26 +3468,56 @@ func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverAccountsResponse.ProtoReflect.Descriptor instead.
-func (*RecoverAccountsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteAccountRenewalResponse.ProtoReflect.Descriptor instead.
+func (*QuoteAccountRenewalResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RecoverAccountsResponse) GetNumRecoveredAccounts() uint32 {
+func (x *QuoteAccountRenewalResponse) GetMinerFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.NumRecoveredAccounts
+		return x.MinerFeeRateSatPerKw
 	}
 	return 0
 }
 
-type AccountModificationFeesRequest struct {
+func (x *QuoteAccountRenewalResponse) GetMinerFeeTotal() uint64 {
+	if x != nil {
+		return x.MinerFeeTotal
+	}
+	return 0
+}
+
+func (x *QuoteAccountRenewalResponse) GetServerFeeTotal() uint64 {
+	if x != nil {
+		return x.ServerFeeTotal
+	}
+	return 0
+}
+
+func (x *QuoteAccountRenewalResponse) GetNewExpiry() uint32 {
+	if x != nil {
+		return x.NewExpiry
+	}
+	return 0
+}
+
+type SimulateAccountFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The confirmation targets to estimate the fees for. If empty, the fees are
+	//estimated for confirmation within 2, 6, 12 and 144 blocks.
+	ConfTargets []uint32 `protobuf:"varint,1,rep,packed,name=conf_targets,json=confTargets,proto3" json:"conf_targets,omitempty"`
+	//
+	//The version of the account to estimate the fees for, which determines the
+	//size of the account's inputs.
+	AccountVersion AccountVersion `protobuf:"varint,2,opt,name=account_version,json=accountVersion,proto3,enum=poolrpc.AccountVersion" json:"account_version,omitempty"`
 }
 
-func (x *AccountModificationFeesRequest) Reset() {
-	*x = AccountModificationFeesRequest{}
+func (x *SimulateAccountFeesRequest) Reset() {
+	*x = SimulateAccountFeesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3251,13 +3525,13 @@ func (x *AccountModificationFeesRequest) Reset() {
 	}
 }
 
-func (x *AccountModificationFeesRequest) String() string {
+func (x *SimulateAccountFeesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountModificationFeesRequest) ProtoMessage() {}
+func (*SimulateAccountFeesRequest) ProtoMessage() {}
 
-func (x *AccountModificationFeesRequest) ProtoReflect() protoreflect.Message {
+func (x *SimulateAccountFeesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3269,36 +3543,46 @@ func (x *AccountModificationFeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountModificationFeesRequest.ProtoReflect.Descriptor instead.
-func (*AccountModificationFeesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateAccountFeesRequest.ProtoReflect.Descriptor instead.
+func (*SimulateAccountFeesRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{35}
 }
 
-type AccountModificationFee struct {
+func (x *SimulateAccountFeesRequest) GetConfTargets() []uint32 {
+	if x != nil {
+		return x.ConfTargets
+	}
+	return nil
+}
+
+func (x *SimulateAccountFeesRequest) GetAccountVersion() AccountVersion {
+	if x != nil {
+		return x.AccountVersion
+	}
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
+}
+
+type AccountFeeSimulation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Modification action type.
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	// Transaction ID.
-	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
-	// Action transaction block height.
-	BlockHeight int32 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Action transaction timestamp.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Action transaction output amount.
-	OutputAmount int64 `protobuf:"varint,5,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`
-	// Action transaction fee.
-	//
-	// Types that are assignable to Fee:
-	//	*AccountModificationFee_FeeNull
-	//	*AccountModificationFee_FeeValue
-	Fee isAccountModificationFee_Fee `protobuf_oneof:"fee"`
-}
-
-func (x *AccountModificationFee) Reset() {
-	*x = AccountModificationFee{}
+	// The confirmation target the fees were estimated for.
+	ConfTarget uint32 `protobuf:"varint,1,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// The fee rate, in satoshis per kw, lnd estimated for the conf target.
+	FeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// The estimated on-chain fee, in satoshis, of opening an account.
+	OpenFeeSat uint64 `protobuf:"varint,3,opt,name=open_fee_sat,json=openFeeSat,proto3" json:"open_fee_sat,omitempty"`
+	// The estimated on-chain fee, in satoshis, of depositing to an account.
+	DepositFeeSat uint64 `protobuf:"varint,4,opt,name=deposit_fee_sat,json=depositFeeSat,proto3" json:"deposit_fee_sat,omitempty"`
+	// The estimated on-chain fee, in satoshis, of withdrawing from an account.
+	WithdrawFeeSat uint64 `protobuf:"varint,5,opt,name=withdraw_fee_sat,json=withdrawFeeSat,proto3" json:"withdraw_fee_sat,omitempty"`
+	// The estimated on-chain fee, in satoshis, of closing an account.
+	CloseFeeSat uint64 `protobuf:"varint,6,opt,name=close_fee_sat,json=closeFeeSat,proto3" json:"close_fee_sat,omitempty"`
+}
+
+func (x *AccountFeeSimulation) Reset() {
+	*x = AccountFeeSimulation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3306,13 +3590,13 @@ func (x *AccountModificationFee) Reset() {
 	}
 }
 
-func (x *AccountModificationFee) String() string {
+func (x *AccountFeeSimulation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountModificationFee) ProtoMessage() {}
+func (*AccountFeeSimulation) ProtoMessage() {}
 
-func (x *AccountModificationFee) ProtoReflect() protoreflect.Message {
+func (x *AccountFeeSimulation) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3324,96 +3608,64 @@ func (x *AccountModificationFee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountModificationFee.ProtoReflect.Descriptor instead.
-func (*AccountModificationFee) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountFeeSimulation.ProtoReflect.Descriptor instead.
+func (*AccountFeeSimulation) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AccountModificationFee) GetAction() string {
+func (x *AccountFeeSimulation) GetConfTarget() uint32 {
 	if x != nil {
-		return x.Action
+		return x.ConfTarget
 	}
-	return ""
+	return 0
 }
 
-func (x *AccountModificationFee) GetTxid() string {
+func (x *AccountFeeSimulation) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.Txid
+		return x.FeeRateSatPerKw
 	}
-	return ""
+	return 0
 }
 
-func (x *AccountModificationFee) GetBlockHeight() int32 {
+func (x *AccountFeeSimulation) GetOpenFeeSat() uint64 {
 	if x != nil {
-		return x.BlockHeight
+		return x.OpenFeeSat
 	}
 	return 0
 }
 
-func (x *AccountModificationFee) GetTimestamp() int64 {
+func (x *AccountFeeSimulation) GetDepositFeeSat() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.DepositFeeSat
 	}
 	return 0
 }
 
-func (x *AccountModificationFee) GetOutputAmount() int64 {
+func (x *AccountFeeSimulation) GetWithdrawFeeSat() uint64 {
 	if x != nil {
-		return x.OutputAmount
+		return x.WithdrawFeeSat
 	}
 	return 0
 }
 
-func (m *AccountModificationFee) GetFee() isAccountModificationFee_Fee {
-	if m != nil {
-		return m.Fee
-	}
-	return nil
-}
-
-func (x *AccountModificationFee) GetFeeNull() bool {
-	if x, ok := x.GetFee().(*AccountModificationFee_FeeNull); ok {
-		return x.FeeNull
-	}
-	return false
-}
-
-func (x *AccountModificationFee) GetFeeValue() int64 {
-	if x, ok := x.GetFee().(*AccountModificationFee_FeeValue); ok {
-		return x.FeeValue
+func (x *AccountFeeSimulation) GetCloseFeeSat() uint64 {
+	if x != nil {
+		return x.CloseFeeSat
 	}
 	return 0
 }
 
-type isAccountModificationFee_Fee interface {
-	isAccountModificationFee_Fee()
-}
-
-type AccountModificationFee_FeeNull struct {
-	// A flag which is true if fee value has not been set, and is otherwise
-	// false.
-	FeeNull bool `protobuf:"varint,6,opt,name=fee_null,json=feeNull,proto3,oneof"`
-}
-
-type AccountModificationFee_FeeValue struct {
-	// Action transaction fee value.
-	FeeValue int64 `protobuf:"varint,7,opt,name=fee_value,json=feeValue,proto3,oneof"`
-}
-
-func (*AccountModificationFee_FeeNull) isAccountModificationFee_Fee() {}
-
-func (*AccountModificationFee_FeeValue) isAccountModificationFee_Fee() {}
-
-type ListOfAccountModificationFees struct {
+type SimulateAccountFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModificationFees []*AccountModificationFee `protobuf:"bytes,1,rep,name=modification_fees,json=modificationFees,proto3" json:"modification_fees,omitempty"`
+	// The fee estimates, one for each requested confirmation target.
+	Simulations []*AccountFeeSimulation `protobuf:"bytes,1,rep,name=simulations,proto3" json:"simulations,omitempty"`
 }
 
-func (x *ListOfAccountModificationFees) Reset() {
-	*x = ListOfAccountModificationFees{}
+func (x *SimulateAccountFeesResponse) Reset() {
+	*x = SimulateAccountFeesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3421,13 +3673,13 @@ func (x *ListOfAccountModificationFees) Reset() {
 	}
 }
 
-func (x *ListOfAccountModificationFees) String() string {
+func (x *SimulateAccountFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfAccountModificationFees) ProtoMessage() {}
+func (*SimulateAccountFeesResponse) ProtoMessage() {}
 
-func (x *ListOfAccountModificationFees) ProtoReflect() protoreflect.Message {
+func (x *SimulateAccountFeesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3439,29 +3691,34 @@ func (x *ListOfAccountModificationFees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfAccountModificationFees.ProtoReflect.Descriptor instead.
-func (*ListOfAccountModificationFees) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateAccountFeesResponse.ProtoReflect.Descriptor instead.
+func (*SimulateAccountFeesResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListOfAccountModificationFees) GetModificationFees() []*AccountModificationFee {
+func (x *SimulateAccountFeesResponse) GetSimulations() []*AccountFeeSimulation {
 	if x != nil {
-		return x.ModificationFees
+		return x.Simulations
 	}
 	return nil
 }
 
-type AccountModificationFeesResponse struct {
+type BumpAccountFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A map from account key to an ordered list of account modification fees.
-	Accounts map[string]*ListOfAccountModificationFees `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The trader key associated with the account that will have its fee bumped.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The new fee rate, in satoshis per kw, to use for the child of the account
+	//transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 }
 
-func (x *AccountModificationFeesResponse) Reset() {
-	*x = AccountModificationFeesResponse{}
+func (x *BumpAccountFeeRequest) Reset() {
+	*x = BumpAccountFeeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3469,13 +3726,13 @@ func (x *AccountModificationFeesResponse) Reset() {
 	}
 }
 
-func (x *AccountModificationFeesResponse) String() string {
+func (x *BumpAccountFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountModificationFeesResponse) ProtoMessage() {}
+func (*BumpAccountFeeRequest) ProtoMessage() {}
 
-func (x *AccountModificationFeesResponse) ProtoReflect() protoreflect.Message {
+func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3487,26 +3744,33 @@ func (x *AccountModificationFeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountModificationFeesResponse.ProtoReflect.Descriptor instead.
-func (*AccountModificationFeesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BumpAccountFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpAccountFeeRequest) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AccountModificationFeesResponse) GetAccounts() map[string]*ListOfAccountModificationFees {
+func (x *BumpAccountFeeRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Accounts
+		return x.TraderKey
 	}
 	return nil
 }
 
-type AuctionFeeRequest struct {
+func (x *BumpAccountFeeRequest) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+type BumpAccountFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AuctionFeeRequest) Reset() {
-	*x = AuctionFeeRequest{}
+func (x *BumpAccountFeeResponse) Reset() {
+	*x = BumpAccountFeeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3514,13 +3778,13 @@ func (x *AuctionFeeRequest) Reset() {
 	}
 }
 
-func (x *AuctionFeeRequest) String() string {
+func (x *BumpAccountFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionFeeRequest) ProtoMessage() {}
+func (*BumpAccountFeeResponse) ProtoMessage() {}
 
-func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3532,23 +3796,50 @@ func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionFeeRequest.ProtoReflect.Descriptor instead.
-func (*AuctionFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BumpAccountFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpAccountFeeResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{39}
 }
 
-type AuctionFeeResponse struct {
+type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The execution fee charged per matched order.
-	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,1,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+	//The identifying component of an account. This is the key used for the trader
+	//in the 2-of-2 multi-sig construction of an account with an auctioneer.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The current outpoint associated with the account. This will change every
+	//time the account has been updated.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The current total amount of satoshis in the account.
+	Value uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	//
+	//The amount of satoshis in the account that is available, meaning not
+	//allocated to any oustanding orders.
+	AvailableBalance uint64 `protobuf:"varint,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// The height at which the account will expire.
+	ExpirationHeight uint32 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// The current state of the account.
+	State AccountState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.AccountState" json:"state,omitempty"`
+	// The hash of the account's latest transaction.
+	LatestTxid []byte `protobuf:"bytes,7,opt,name=latest_txid,json=latestTxid,proto3" json:"latest_txid,omitempty"`
+	// The current version of the account.
+	Version AccountVersion `protobuf:"varint,8,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
+	//
+	//The amount of satoshis in the account whose latest transaction has been
+	//confirmed on-chain.
+	ConfirmedBalance uint64 `protobuf:"varint,9,opt,name=confirmed_balance,json=confirmedBalance,proto3" json:"confirmed_balance,omitempty"`
+	//
+	//The amount of satoshis in the account whose latest transaction is still
+	//waiting to be confirmed on-chain.
+	PendingBalance uint64 `protobuf:"varint,10,opt,name=pending_balance,json=pendingBalance,proto3" json:"pending_balance,omitempty"`
 }
 
-func (x *AuctionFeeResponse) Reset() {
-	*x = AuctionFeeResponse{}
+func (x *Account) Reset() {
+	*x = Account{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3556,13 +3847,13 @@ func (x *AuctionFeeResponse) Reset() {
 	}
 }
 
-func (x *AuctionFeeResponse) String() string {
+func (x *Account) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionFeeResponse) ProtoMessage() {}
+func (*Account) ProtoMessage() {}
 
-func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
+func (x *Account) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3574,229 +3865,197 @@ func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionFeeResponse.ProtoReflect.Descriptor instead.
-func (*AuctionFeeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AuctionFeeResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+func (x *Account) GetTraderKey() []byte {
 	if x != nil {
-		return x.ExecutionFee
+		return x.TraderKey
 	}
 	return nil
 }
 
-type Lease struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The outpoint of the channel created.
-	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	// The amount, in satoshis, of the channel created.
-	ChannelAmtSat uint64 `protobuf:"varint,2,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
-	// The intended duration, in blocks, of the channel created.
-	ChannelDurationBlocks uint32 `protobuf:"varint,3,opt,name=channel_duration_blocks,json=channelDurationBlocks,proto3" json:"channel_duration_blocks,omitempty"`
-	// The absolute height that this channel lease expires.
-	ChannelLeaseExpiry uint32 `protobuf:"varint,4,opt,name=channel_lease_expiry,json=channelLeaseExpiry,proto3" json:"channel_lease_expiry,omitempty"`
-	//
-	//The premium, in satoshis, either paid or received for the offered liquidity.
-	PremiumSat uint64 `protobuf:"varint,5,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
-	//
-	//The execution fee, in satoshis, charged by the auctioneer for the channel
-	//created.
-	ExecutionFeeSat uint64 `protobuf:"varint,6,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
-	//
-	//The fee, in satoshis, charged by the auctioneer for the batch execution
-	//transaction that created this lease.
-	ChainFeeSat uint64 `protobuf:"varint,7,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
-	//
-	//The actual fixed rate expressed in parts per billionth this lease was
-	//bought/sold at.
-	ClearingRatePrice uint64 `protobuf:"varint,8,opt,name=clearing_rate_price,json=clearingRatePrice,proto3" json:"clearing_rate_price,omitempty"`
-	//
-	//The actual fixed rate of the bid/ask, this should always be 'better' than
-	//the clearing_rate_price.
-	OrderFixedRate uint64 `protobuf:"varint,9,opt,name=order_fixed_rate,json=orderFixedRate,proto3" json:"order_fixed_rate,omitempty"`
-	// The order executed that resulted in the channel created.
-	OrderNonce []byte `protobuf:"bytes,10,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
-	//
-	//The unique identifier for the order that was matched with that resulted
-	//in the channel created.
-	MatchedOrderNonce []byte `protobuf:"bytes,16,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
-	// Whether this channel was purchased from another trader or not.
-	Purchased bool `protobuf:"varint,11,opt,name=purchased,proto3" json:"purchased,omitempty"`
-	// The pubkey of the node that this channel was bought/sold from.
-	ChannelRemoteNodeKey []byte `protobuf:"bytes,12,opt,name=channel_remote_node_key,json=channelRemoteNodeKey,proto3" json:"channel_remote_node_key,omitempty"`
-	// The tier of the node that this channel was bought/sold from.
-	ChannelNodeTier auctioneerrpc.NodeTier `protobuf:"varint,13,opt,name=channel_node_tier,json=channelNodeTier,proto3,enum=poolrpc.NodeTier" json:"channel_node_tier,omitempty"`
-	// The self channel balance that was pushed to the recipient.
-	SelfChanBalance uint64 `protobuf:"varint,14,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
-	// Whether the channel was leased as a sidecar channel (bid orders only).
-	SidecarChannel bool `protobuf:"varint,15,opt,name=sidecar_channel,json=sidecarChannel,proto3" json:"sidecar_channel,omitempty"`
-}
-
-func (x *Lease) Reset() {
-	*x = Lease{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Lease) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Lease) ProtoMessage() {}
-
-func (x *Lease) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
-func (*Lease) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *Lease) GetChannelPoint() *auctioneerrpc.OutPoint {
+func (x *Account) GetOutpoint() *auctioneerrpc.OutPoint {
 	if x != nil {
-		return x.ChannelPoint
+		return x.Outpoint
 	}
 	return nil
 }
 
-func (x *Lease) GetChannelAmtSat() uint64 {
+func (x *Account) GetValue() uint64 {
 	if x != nil {
-		return x.ChannelAmtSat
+		return x.Value
 	}
 	return 0
 }
 
-func (x *Lease) GetChannelDurationBlocks() uint32 {
+func (x *Account) GetAvailableBalance() uint64 {
 	if x != nil {
-		return x.ChannelDurationBlocks
+		return x.AvailableBalance
 	}
 	return 0
 }
 
-func (x *Lease) GetChannelLeaseExpiry() uint32 {
+func (x *Account) GetExpirationHeight() uint32 {
 	if x != nil {
-		return x.ChannelLeaseExpiry
+		return x.ExpirationHeight
 	}
 	return 0
 }
 
-func (x *Lease) GetPremiumSat() uint64 {
+func (x *Account) GetState() AccountState {
 	if x != nil {
-		return x.PremiumSat
+		return x.State
 	}
-	return 0
+	return AccountState_PENDING_OPEN
 }
 
-func (x *Lease) GetExecutionFeeSat() uint64 {
+func (x *Account) GetLatestTxid() []byte {
 	if x != nil {
-		return x.ExecutionFeeSat
+		return x.LatestTxid
 	}
-	return 0
+	return nil
 }
 
-func (x *Lease) GetChainFeeSat() uint64 {
+func (x *Account) GetVersion() AccountVersion {
 	if x != nil {
-		return x.ChainFeeSat
+		return x.Version
 	}
-	return 0
+	return AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT
 }
 
-func (x *Lease) GetClearingRatePrice() uint64 {
+func (x *Account) GetConfirmedBalance() uint64 {
 	if x != nil {
-		return x.ClearingRatePrice
+		return x.ConfirmedBalance
 	}
 	return 0
 }
 
-func (x *Lease) GetOrderFixedRate() uint64 {
+func (x *Account) GetPendingBalance() uint64 {
 	if x != nil {
-		return x.OrderFixedRate
+		return x.PendingBalance
 	}
 	return 0
 }
 
-func (x *Lease) GetOrderNonce() []byte {
-	if x != nil {
-		return x.OrderNonce
-	}
-	return nil
+type SubmitOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Details:
+	//	*SubmitOrderRequest_Ask
+	//	*SubmitOrderRequest_Bid
+	Details isSubmitOrderRequest_Details `protobuf_oneof:"details"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of pool. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (poold, LiT) and the method used for
+	//submitting the order (pool CLI, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
 }
 
-func (x *Lease) GetMatchedOrderNonce() []byte {
-	if x != nil {
-		return x.MatchedOrderNonce
+func (x *SubmitOrderRequest) Reset() {
+	*x = SubmitOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Lease) GetPurchased() bool {
-	if x != nil {
-		return x.Purchased
+func (x *SubmitOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitOrderRequest) ProtoMessage() {}
+
+func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Lease) GetChannelRemoteNodeKey() []byte {
-	if x != nil {
-		return x.ChannelRemoteNodeKey
+// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *SubmitOrderRequest) GetDetails() isSubmitOrderRequest_Details {
+	if m != nil {
+		return m.Details
 	}
 	return nil
 }
 
-func (x *Lease) GetChannelNodeTier() auctioneerrpc.NodeTier {
-	if x != nil {
-		return x.ChannelNodeTier
+func (x *SubmitOrderRequest) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*SubmitOrderRequest_Ask); ok {
+		return x.Ask
 	}
-	return auctioneerrpc.NodeTier(0)
+	return nil
 }
 
-func (x *Lease) GetSelfChanBalance() uint64 {
-	if x != nil {
-		return x.SelfChanBalance
+func (x *SubmitOrderRequest) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*SubmitOrderRequest_Bid); ok {
+		return x.Bid
 	}
-	return 0
+	return nil
 }
 
-func (x *Lease) GetSidecarChannel() bool {
+func (x *SubmitOrderRequest) GetInitiator() string {
 	if x != nil {
-		return x.SidecarChannel
+		return x.Initiator
 	}
-	return false
+	return ""
 }
 
-type LeasesRequest struct {
+type isSubmitOrderRequest_Details interface {
+	isSubmitOrderRequest_Details()
+}
+
+type SubmitOrderRequest_Ask struct {
+	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+}
+
+type SubmitOrderRequest_Bid struct {
+	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3,oneof"`
+}
+
+func (*SubmitOrderRequest_Ask) isSubmitOrderRequest_Details() {}
+
+func (*SubmitOrderRequest_Bid) isSubmitOrderRequest_Details() {}
+
+type SubmitOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Types that are assignable to Details:
+	//	*SubmitOrderResponse_InvalidOrder
+	//	*SubmitOrderResponse_AcceptedOrderNonce
+	Details isSubmitOrderResponse_Details `protobuf_oneof:"details"`
 	//
-	//An optional list of batches to retrieve the leases of. If empty, leases
-	//throughout all batches are returned.
-	BatchIds [][]byte `protobuf:"bytes,1,rep,name=batch_ids,json=batchIds,proto3" json:"batch_ids,omitempty"`
+	//In case a bid order was submitted for a sidecar ticket, that ticket is
+	//updated with the new state and bid order nonce.
+	UpdatedSidecarTicket string `protobuf:"bytes,3,opt,name=updated_sidecar_ticket,json=updatedSidecarTicket,proto3" json:"updated_sidecar_ticket,omitempty"`
 	//
-	//An optional list of accounts to retrieve the leases of. If empty, leases
-	//for all accounts are returned.
-	Accounts [][]byte `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	//The fixed rate in parts per billion the order was submitted with. This
+	//differs from the requested rate if it was rounded to the configured rate
+	//tick size.
+	RateFixed uint32 `protobuf:"varint,4,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
 }
 
-func (x *LeasesRequest) Reset() {
-	*x = LeasesRequest{}
+func (x *SubmitOrderResponse) Reset() {
+	*x = SubmitOrderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3804,13 +4063,13 @@ func (x *LeasesRequest) Reset() {
 	}
 }
 
-func (x *LeasesRequest) String() string {
+func (x *SubmitOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeasesRequest) ProtoMessage() {}
+func (*SubmitOrderResponse) ProtoMessage() {}
 
-func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3822,40 +4081,94 @@ func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeasesRequest.ProtoReflect.Descriptor instead.
-func (*LeasesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
+func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LeasesRequest) GetBatchIds() [][]byte {
-	if x != nil {
-		return x.BatchIds
+func (m *SubmitOrderResponse) GetDetails() isSubmitOrderResponse_Details {
+	if m != nil {
+		return m.Details
 	}
 	return nil
 }
 
-func (x *LeasesRequest) GetAccounts() [][]byte {
-	if x != nil {
-		return x.Accounts
+func (x *SubmitOrderResponse) GetInvalidOrder() *auctioneerrpc.InvalidOrder {
+	if x, ok := x.GetDetails().(*SubmitOrderResponse_InvalidOrder); ok {
+		return x.InvalidOrder
 	}
 	return nil
 }
 
-type LeasesResponse struct {
+func (x *SubmitOrderResponse) GetAcceptedOrderNonce() []byte {
+	if x, ok := x.GetDetails().(*SubmitOrderResponse_AcceptedOrderNonce); ok {
+		return x.AcceptedOrderNonce
+	}
+	return nil
+}
+
+func (x *SubmitOrderResponse) GetUpdatedSidecarTicket() string {
+	if x != nil {
+		return x.UpdatedSidecarTicket
+	}
+	return ""
+}
+
+func (x *SubmitOrderResponse) GetRateFixed() uint32 {
+	if x != nil {
+		return x.RateFixed
+	}
+	return 0
+}
+
+type isSubmitOrderResponse_Details interface {
+	isSubmitOrderResponse_Details()
+}
+
+type SubmitOrderResponse_InvalidOrder struct {
+	//
+	//Order failed with the given reason.
+	InvalidOrder *auctioneerrpc.InvalidOrder `protobuf:"bytes,1,opt,name=invalid_order,json=invalidOrder,proto3,oneof"`
+}
+
+type SubmitOrderResponse_AcceptedOrderNonce struct {
+	//
+	//The order nonce of the accepted order.
+	AcceptedOrderNonce []byte `protobuf:"bytes,2,opt,name=accepted_order_nonce,json=acceptedOrderNonce,proto3,oneof"`
+}
+
+func (*SubmitOrderResponse_InvalidOrder) isSubmitOrderResponse_Details() {}
+
+func (*SubmitOrderResponse_AcceptedOrderNonce) isSubmitOrderResponse_Details() {}
+
+type PrepareOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The relevant list of leases purchased or sold within the auction.
-	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
-	// The total amount of satoshis earned from the leases returned.
-	TotalAmtEarnedSat uint64 `protobuf:"varint,2,opt,name=total_amt_earned_sat,json=totalAmtEarnedSat,proto3" json:"total_amt_earned_sat,omitempty"`
-	// The total amount of satoshis paid for the leases returned.
-	TotalAmtPaidSat uint64 `protobuf:"varint,3,opt,name=total_amt_paid_sat,json=totalAmtPaidSat,proto3" json:"total_amt_paid_sat,omitempty"`
+	//
+	//The preview of the premium and fees of the prepared order.
+	Quote *QuoteOrderResponse `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	//
+	//The fixed rate in parts per billion the last batch cleared at in the market
+	//of the order's lease duration. Zero if no such batch is known.
+	LastClearingRateFixed uint32 `protobuf:"varint,2,opt,name=last_clearing_rate_fixed,json=lastClearingRateFixed,proto3" json:"last_clearing_rate_fixed,omitempty"`
+	//
+	//The token that needs to be passed to ConfirmOrder to submit the order.
+	ConfirmationToken []byte `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	//
+	//The unix timestamp in nanoseconds after which the confirmation token can no
+	//longer be used.
+	ExpiryTimestampNs int64 `protobuf:"varint,4,opt,name=expiry_timestamp_ns,json=expiryTimestampNs,proto3" json:"expiry_timestamp_ns,omitempty"`
+	//
+	//The fixed rate in parts per billion the order will be submitted with. This
+	//differs from the requested rate if it was rounded to the configured rate
+	//tick size.
+	RateFixed uint32 `protobuf:"varint,5,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
 }
 
-func (x *LeasesResponse) Reset() {
-	*x = LeasesResponse{}
+func (x *PrepareOrderResponse) Reset() {
+	*x = PrepareOrderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_trader_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3863,13 +4176,13 @@ func (x *LeasesResponse) Reset() {
 	}
 }
 
-func (x *LeasesResponse) String() string {
+func (x *PrepareOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeasesResponse) ProtoMessage() {}
+func (*PrepareOrderResponse) ProtoMessage() {}
 
-func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
+func (x *PrepareOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trader_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3881,97 +4194,73 @@ func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeasesResponse.ProtoReflect.Descriptor instead.
-func (*LeasesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrepareOrderResponse.ProtoReflect.Descriptor instead.
+func (*PrepareOrderResponse) Descriptor() ([]byte, []int) {
 	return file_trader_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LeasesResponse) GetLeases() []*Lease {
+func (x *PrepareOrderResponse) GetQuote() *QuoteOrderResponse {
 	if x != nil {
-		return x.Leases
+		return x.Quote
 	}
 	return nil
 }
 
-func (x *LeasesResponse) GetTotalAmtEarnedSat() uint64 {
+func (x *PrepareOrderResponse) GetLastClearingRateFixed() uint32 {
 	if x != nil {
-		return x.TotalAmtEarnedSat
+		return x.LastClearingRateFixed
 	}
 	return 0
 }
 
-func (x *LeasesResponse) GetTotalAmtPaidSat() uint64 {
+func (x *PrepareOrderResponse) GetConfirmationToken() []byte {
 	if x != nil {
-		return x.TotalAmtPaidSat
+		return x.ConfirmationToken
 	}
-	return 0
-}
-
-type TokensRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return nil
 }
 
-func (x *TokensRequest) Reset() {
-	*x = TokensRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PrepareOrderResponse) GetExpiryTimestampNs() int64 {
+	if x != nil {
+		return x.ExpiryTimestampNs
 	}
+	return 0
 }
 
-func (x *TokensRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TokensRequest) ProtoMessage() {}
-
-func (x *TokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PrepareOrderResponse) GetRateFixed() uint32 {
+	if x != nil {
+		return x.RateFixed
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
-func (*TokensRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{44}
+	return 0
 }
 
-type TokensResponse struct {
+type ConfirmOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//List of all tokens the daemon knows of, including old/expired tokens.
-	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	//
+	//The confirmation token returned by PrepareOrder.
+	ConfirmationToken []byte `protobuf:"bytes,1,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
 }
 
-func (x *TokensResponse) Reset() {
-	*x = TokensResponse{}
+func (x *ConfirmOrderRequest) Reset() {
+	*x = ConfirmOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[45]
+		mi := &file_trader_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokensResponse) String() string {
+func (x *ConfirmOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokensResponse) ProtoMessage() {}
+func (*ConfirmOrderRequest) ProtoMessage() {}
 
-func (x *TokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[45]
+func (x *ConfirmOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3982,69 +4271,77 @@ func (x *TokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
-func (*TokensResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ConfirmOrderRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TokensResponse) GetTokens() []*LsatToken {
+func (x *ConfirmOrderRequest) GetConfirmationToken() []byte {
 	if x != nil {
-		return x.Tokens
+		return x.ConfirmationToken
 	}
 	return nil
 }
 
-type LsatToken struct {
+type ListOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//The base macaroon that was baked by the auth server.
-	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
-	//*
-	//The payment hash of the payment that was paid to obtain the token.
-	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
-	//*
-	//The preimage of the payment hash, knowledge of this is proof that the
-	//payment has been paid. If the preimage is set to all zeros, this means the
-	//payment is still pending and the token is not yet fully valid.
-	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
-	//*
-	//The amount of millisatoshis that was paid to get the token.
-	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
-	//*
-	//The amount of millisatoshis paid in routing fee to pay for the token.
-	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
-	//*
-	//The creation time of the token as UNIX timestamp in seconds.
-	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
-	//*
-	//Indicates whether the token is expired or still valid.
-	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
-	//*
-	//Identifying attribute of this token in the store. Currently represents the
-	//file name of the token where it's stored on the file system.
-	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	//
+	//Can be set to true to list the orders including all events, which can be
+	//very verbose.
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	//
+	//Only list orders that are still active.
+	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	//
+	//Only list orders that carry the given tag. If empty, orders are not
+	//filtered by their tag.
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	//
+	//Only list orders in the given state category. If set to anything other
+	//than the default, the active_only flag is ignored.
+	StateFilter OrderStateFilter `protobuf:"varint,4,opt,name=state_filter,json=stateFilter,proto3,enum=poolrpc.OrderStateFilter" json:"state_filter,omitempty"`
+	//
+	//Only list orders of the given type.
+	TypeFilter OrderTypeFilter `protobuf:"varint,5,opt,name=type_filter,json=typeFilter,proto3,enum=poolrpc.OrderTypeFilter" json:"type_filter,omitempty"`
+	//
+	//The number of orders to skip after the filters and the sort order were
+	//applied.
+	Offset uint32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	//
+	//The maximum number of orders to return. If zero, all orders after the
+	//offset are returned.
+	Limit uint32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	//
+	//The field to sort the orders by. Orders are sorted in ascending order
+	//unless reversed is set. Asks and bids are sorted and paginated together,
+	//the response then lists them in two separate arrays that each keep this
+	//order.
+	SortBy OrderSortBy `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=poolrpc.OrderSortBy" json:"sort_by,omitempty"`
+	//
+	//Sort the orders in descending instead of ascending order.
+	Reversed bool `protobuf:"varint,9,opt,name=reversed,proto3" json:"reversed,omitempty"`
 }
 
-func (x *LsatToken) Reset() {
-	*x = LsatToken{}
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[46]
+		mi := &file_trader_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsatToken) String() string {
+func (x *ListOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsatToken) ProtoMessage() {}
+func (*ListOrdersRequest) ProtoMessage() {}
 
-func (x *LsatToken) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[46]
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4055,90 +4352,104 @@ func (x *LsatToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
-func (*LsatToken) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *LsatToken) GetBaseMacaroon() []byte {
+func (x *ListOrdersRequest) GetVerbose() bool {
 	if x != nil {
-		return x.BaseMacaroon
+		return x.Verbose
 	}
-	return nil
+	return false
 }
 
-func (x *LsatToken) GetPaymentHash() []byte {
+func (x *ListOrdersRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.PaymentHash
+		return x.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-func (x *LsatToken) GetPaymentPreimage() []byte {
+func (x *ListOrdersRequest) GetTag() string {
 	if x != nil {
-		return x.PaymentPreimage
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *LsatToken) GetAmountPaidMsat() int64 {
+func (x *ListOrdersRequest) GetStateFilter() OrderStateFilter {
 	if x != nil {
-		return x.AmountPaidMsat
+		return x.StateFilter
 	}
-	return 0
+	return OrderStateFilter_ORDER_STATE_FILTER_DEFAULT
 }
 
-func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+func (x *ListOrdersRequest) GetTypeFilter() OrderTypeFilter {
 	if x != nil {
-		return x.RoutingFeePaidMsat
+		return x.TypeFilter
+	}
+	return OrderTypeFilter_ORDER_TYPE_FILTER_ALL
+}
+
+func (x *ListOrdersRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *LsatToken) GetTimeCreated() int64 {
+func (x *ListOrdersRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.TimeCreated
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *LsatToken) GetExpired() bool {
+func (x *ListOrdersRequest) GetSortBy() OrderSortBy {
 	if x != nil {
-		return x.Expired
+		return x.SortBy
 	}
-	return false
+	return OrderSortBy_ORDER_SORT_BY_CREATION_TIME
 }
 
-func (x *LsatToken) GetStorageName() string {
+func (x *ListOrdersRequest) GetReversed() bool {
 	if x != nil {
-		return x.StorageName
+		return x.Reversed
 	}
-	return ""
+	return false
 }
 
-type LeaseDurationRequest struct {
+type ListOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Asks []*Ask `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks,omitempty"`
+	Bids []*Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	//
+	//The total number of orders that matched the filters of the request, before
+	//the offset and limit were applied.
+	TotalNumOrders uint32 `protobuf:"varint,3,opt,name=total_num_orders,json=totalNumOrders,proto3" json:"total_num_orders,omitempty"`
 }
 
-func (x *LeaseDurationRequest) Reset() {
-	*x = LeaseDurationRequest{}
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[47]
+		mi := &file_trader_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaseDurationRequest) String() string {
+func (x *ListOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseDurationRequest) ProtoMessage() {}
+func (*ListOrdersResponse) ProtoMessage() {}
 
-func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[47]
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,44 +4460,57 @@ func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseDurationRequest.ProtoReflect.Descriptor instead.
-func (*LeaseDurationRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{47}
-}
-
-type LeaseDurationResponse struct {
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListOrdersResponse) GetAsks() []*Ask {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetTotalNumOrders() uint32 {
+	if x != nil {
+		return x.TotalNumOrders
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//Deprecated, use lease_duration_buckets.
-	//
-	// Deprecated: Do not use.
-	LeaseDurations map[uint32]bool `protobuf:"bytes,1,rep,name=lease_durations,json=leaseDurations,proto3" json:"lease_durations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	//
-	//The set of lease durations the market is currently accepting and the state
-	//the duration buckets currently are in.
-	LeaseDurationBuckets map[uint32]auctioneerrpc.DurationBucketState `protobuf:"bytes,2,rep,name=lease_duration_buckets,json=leaseDurationBuckets,proto3" json:"lease_duration_buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=poolrpc.DurationBucketState"`
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
 }
 
-func (x *LeaseDurationResponse) Reset() {
-	*x = LeaseDurationResponse{}
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[48]
+		mi := &file_trader_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaseDurationResponse) String() string {
+func (x *CancelOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseDurationResponse) ProtoMessage() {}
+func (*CancelOrderRequest) ProtoMessage() {}
 
-func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[48]
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4197,49 +4521,41 @@ func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseDurationResponse.ProtoReflect.Descriptor instead.
-func (*LeaseDurationResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{48}
-}
-
-// Deprecated: Do not use.
-func (x *LeaseDurationResponse) GetLeaseDurations() map[uint32]bool {
-	if x != nil {
-		return x.LeaseDurations
-	}
-	return nil
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *LeaseDurationResponse) GetLeaseDurationBuckets() map[uint32]auctioneerrpc.DurationBucketState {
+func (x *CancelOrderRequest) GetOrderNonce() []byte {
 	if x != nil {
-		return x.LeaseDurationBuckets
+		return x.OrderNonce
 	}
 	return nil
 }
 
-type NextBatchInfoRequest struct {
+type CancelOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *NextBatchInfoRequest) Reset() {
-	*x = NextBatchInfoRequest{}
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[49]
+		mi := &file_trader_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NextBatchInfoRequest) String() string {
+func (x *CancelOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextBatchInfoRequest) ProtoMessage() {}
+func (*CancelOrderResponse) ProtoMessage() {}
 
-func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[49]
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4250,51 +4566,41 @@ func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextBatchInfoRequest.ProtoReflect.Descriptor instead.
-func (*NextBatchInfoRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{48}
 }
 
-type NextBatchInfoResponse struct {
+type ReplaceOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The confirmation target the auctioneer will use for fee estimation of the
-	//next batch.
-	ConfTarget uint32 `protobuf:"varint,5,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
-	//
-	//The fee rate, in satoshis per kiloweight, estimated by the auctioneer to use
-	//for the next batch.
-	FeeRateSatPerKw uint64 `protobuf:"varint,6,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
-	//
-	//The absolute unix timestamp in seconds at which the auctioneer will attempt
-	//to clear the next batch.
-	ClearTimestamp uint64 `protobuf:"varint,7,opt,name=clear_timestamp,json=clearTimestamp,proto3" json:"clear_timestamp,omitempty"`
+	//The nonce of the active order to cancel.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
 	//
-	//The value used by the auctioneer to determine if an account expiry height
-	//needs to be extended after participating in a batch and for how long.
-	AutoRenewExtensionBlocks uint32 `protobuf:"varint,8,opt,name=auto_renew_extension_blocks,json=autoRenewExtensionBlocks,proto3" json:"auto_renew_extension_blocks,omitempty"`
+	//The order to submit in place of the canceled one.
+	Replacement *SubmitOrderRequest `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
 }
 
-func (x *NextBatchInfoResponse) Reset() {
-	*x = NextBatchInfoResponse{}
+func (x *ReplaceOrderRequest) Reset() {
+	*x = ReplaceOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[50]
+		mi := &file_trader_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NextBatchInfoResponse) String() string {
+func (x *ReplaceOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextBatchInfoResponse) ProtoMessage() {}
+func (*ReplaceOrderRequest) ProtoMessage() {}
 
-func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[50]
+func (x *ReplaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4305,65 +4611,56 @@ func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextBatchInfoResponse.ProtoReflect.Descriptor instead.
-func (*NextBatchInfoResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *NextBatchInfoResponse) GetConfTarget() uint32 {
-	if x != nil {
-		return x.ConfTarget
-	}
-	return 0
-}
-
-func (x *NextBatchInfoResponse) GetFeeRateSatPerKw() uint64 {
-	if x != nil {
-		return x.FeeRateSatPerKw
-	}
-	return 0
+// Deprecated: Use ReplaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *NextBatchInfoResponse) GetClearTimestamp() uint64 {
+func (x *ReplaceOrderRequest) GetOrderNonce() []byte {
 	if x != nil {
-		return x.ClearTimestamp
+		return x.OrderNonce
 	}
-	return 0
+	return nil
 }
 
-func (x *NextBatchInfoResponse) GetAutoRenewExtensionBlocks() uint32 {
+func (x *ReplaceOrderRequest) GetReplacement() *SubmitOrderRequest {
 	if x != nil {
-		return x.AutoRenewExtensionBlocks
+		return x.Replacement
 	}
-	return 0
+	return nil
 }
 
-type NodeRatingRequest struct {
+type ReplaceOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The target node to obtain ratings information for.
-	NodePubkeys [][]byte `protobuf:"bytes,1,rep,name=node_pubkeys,json=nodePubkeys,proto3" json:"node_pubkeys,omitempty"`
+	//
+	//The nonce of the order that was canceled.
+	CanceledOrderNonce []byte `protobuf:"bytes,1,opt,name=canceled_order_nonce,json=canceledOrderNonce,proto3" json:"canceled_order_nonce,omitempty"`
+	//
+	//The result of the submission of the replacement. Contains the nonce of the
+	//new order if it was accepted by the auction server.
+	Replacement *SubmitOrderResponse `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
 }
 
-func (x *NodeRatingRequest) Reset() {
-	*x = NodeRatingRequest{}
+func (x *ReplaceOrderResponse) Reset() {
+	*x = ReplaceOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[51]
+		mi := &file_trader_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeRatingRequest) String() string {
+func (x *ReplaceOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRatingRequest) ProtoMessage() {}
+func (*ReplaceOrderResponse) ProtoMessage() {}
 
-func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[51]
+func (x *ReplaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4374,44 +4671,127 @@ func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRatingRequest.ProtoReflect.Descriptor instead.
-func (*NodeRatingRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ReplaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *NodeRatingRequest) GetNodePubkeys() [][]byte {
+func (x *ReplaceOrderResponse) GetCanceledOrderNonce() []byte {
 	if x != nil {
-		return x.NodePubkeys
+		return x.CanceledOrderNonce
 	}
 	return nil
 }
 
-type NodeRatingResponse struct {
+func (x *ReplaceOrderResponse) GetReplacement() *SubmitOrderResponse {
+	if x != nil {
+		return x.Replacement
+	}
+	return nil
+}
+
+type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A series of node ratings for each of the queried nodes.
-	NodeRatings []*auctioneerrpc.NodeRating `protobuf:"bytes,1,rep,name=node_ratings,json=nodeRatings,proto3" json:"node_ratings,omitempty"`
+	//
+	//The trader's account key of the account that is used for the order.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//Fixed order rate in parts per billion.
+	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
+	//
+	//Order amount in satoshis.
+	Amt uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Maximum fee rate the trader is willing to pay for the batch transaction,
+	//expressed in satoshis per 1000 weight units (sat/KW).
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
+	//
+	//Order nonce, acts as unique order identifier.
+	OrderNonce []byte `protobuf:"bytes,5,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The state the order currently is in.
+	State auctioneerrpc.OrderState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.OrderState" json:"state,omitempty"`
+	//
+	//The number of order units the amount corresponds to.
+	Units uint32 `protobuf:"varint,7,opt,name=units,proto3" json:"units,omitempty"`
+	//
+	//The number of currently unfilled units of this order. This will be equal to
+	//the total amount of units until the order has reached the state PARTIAL_FILL
+	//or EXECUTED.
+	UnitsUnfulfilled uint32 `protobuf:"varint,8,opt,name=units_unfulfilled,json=unitsUnfulfilled,proto3" json:"units_unfulfilled,omitempty"`
+	// The value reserved from the account by this order to ensure the account
+	// can pay execution and chain fees in case it gets matched.
+	ReservedValueSat uint64 `protobuf:"varint,9,opt,name=reserved_value_sat,json=reservedValueSat,proto3" json:"reserved_value_sat,omitempty"`
+	// The unix timestamp in nanoseconds the order was first created/submitted.
+	CreationTimestampNs uint64 `protobuf:"varint,10,opt,name=creation_timestamp_ns,json=creationTimestampNs,proto3" json:"creation_timestamp_ns,omitempty"`
+	//
+	//A list of events that were emitted for this order. This field is only set
+	//when the verbose flag is set to true in the request.
+	Events []*OrderEvent `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	// The minimum number of order units that must be matched per order pair.
+	MinUnitsMatch uint32 `protobuf:"varint,12,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
+	// The channel type to use for the resulting matched channels.
+	ChannelType auctioneerrpc.OrderChannelType `protobuf:"varint,13,opt,name=channel_type,json=channelType,proto3,enum=poolrpc.OrderChannelType" json:"channel_type,omitempty"`
+	// List of nodes that will be allowed to match with our order. Incompatible
+	// with the `not_allowed_node_ids` field.
+	AllowedNodeIds [][]byte `protobuf:"bytes,14,rep,name=allowed_node_ids,json=allowedNodeIds,proto3" json:"allowed_node_ids,omitempty"`
+	// List of nodes that won't be allowed to match with our order. Incompatible
+	// with the `allowed_node_ids` field.
+	NotAllowedNodeIds [][]byte `protobuf:"bytes,15,rep,name=not_allowed_node_ids,json=notAllowedNodeIds,proto3" json:"not_allowed_node_ids,omitempty"`
+	// Auction type where this order must be considered during the matching.
+	AuctionType auctioneerrpc.AuctionType `protobuf:"varint,16,opt,name=auction_type,json=auctionType,proto3,enum=poolrpc.AuctionType" json:"auction_type,omitempty"`
+	// Flag used to signal that this order can be shared in public market
+	// places.
+	IsPublic bool `protobuf:"varint,17,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// An optional freeform tag to group orders by, for example by trading
+	// strategy. The tag is only stored locally and never sent to the
+	// auctioneer.
+	Tag string `protobuf:"bytes,18,opt,name=tag,proto3" json:"tag,omitempty"`
+	// What to do with the unmatched remainder of the order after it was only
+	// partially matched in a batch. The policy is only applied locally and
+	// never sent to the auctioneer.
+	PartialMatchPolicy PartialMatchPolicy `protobuf:"varint,19,opt,name=partial_match_policy,json=partialMatchPolicy,proto3,enum=poolrpc.PartialMatchPolicy" json:"partial_match_policy,omitempty"`
+	//
+	//The order rate expressed as an annual percentage rate, as an alternative to
+	//rate_fixed when submitting an order. A value of 0.05 means 5% per year. The
+	//premium grows linearly with the lease duration, so the rate is converted
+	//with simple interest into rate_fixed = round(rate_apr * 1e9 / 52560), with
+	//52560 being the number of blocks in a year. Only one of rate_fixed and
+	//rate_apr can be set.
+	RateApr float64 `protobuf:"fixed64,20,opt,name=rate_apr,json=rateApr,proto3" json:"rate_apr,omitempty"`
+	//
+	//Submit an identical new order as soon as this order is fully filled, to
+	//maintain continuous liquidity provision. The recreation is only done
+	//locally by the trader daemon and respects its maximum number of pending
+	//orders.
+	AutoRecreate bool `protobuf:"varint,21,opt,name=auto_recreate,json=autoRecreate,proto3" json:"auto_recreate,omitempty"`
+	//
+	//The number of times the order is recreated after being fully filled if
+	//auto_recreate is set. Each recreated order carries the remaining count. 0
+	//means the order is recreated without limit.
+	AutoRecreateLimit uint32 `protobuf:"varint,22,opt,name=auto_recreate_limit,json=autoRecreateLimit,proto3" json:"auto_recreate_limit,omitempty"`
 }
 
-func (x *NodeRatingResponse) Reset() {
-	*x = NodeRatingResponse{}
+func (x *Order) Reset() {
+	*x = Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[52]
+		mi := &file_trader_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeRatingResponse) String() string {
+func (x *Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRatingResponse) ProtoMessage() {}
+func (*Order) ProtoMessage() {}
 
-func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[52]
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4422,267 +4802,230 @@ func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRatingResponse.ProtoReflect.Descriptor instead.
-func (*NodeRatingResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *NodeRatingResponse) GetNodeRatings() []*auctioneerrpc.NodeRating {
+func (x *Order) GetTraderKey() []byte {
 	if x != nil {
-		return x.NodeRatings
+		return x.TraderKey
 	}
 	return nil
 }
 
-type GetInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[53]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetRateFixed() uint32 {
+	if x != nil {
+		return x.RateFixed
 	}
+	return 0
 }
 
-func (x *GetInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInfoRequest) ProtoMessage() {}
-
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[53]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Order) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{53}
-}
-
-type GetInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The version of the Pool daemon that is running.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// The total number of accounts in the local database.
-	AccountsTotal uint32 `protobuf:"varint,2,opt,name=accounts_total,json=accountsTotal,proto3" json:"accounts_total,omitempty"`
-	//
-	//The total number of accounts that are in an active, non-archived state,
-	//including expired accounts.
-	AccountsActive uint32 `protobuf:"varint,3,opt,name=accounts_active,json=accountsActive,proto3" json:"accounts_active,omitempty"`
-	// The total number of accounts that are active but have expired.
-	AccountsActiveExpired uint32 `protobuf:"varint,4,opt,name=accounts_active_expired,json=accountsActiveExpired,proto3" json:"accounts_active_expired,omitempty"`
-	// The total number of accounts that are in an archived/closed state.
-	AccountsArchived uint32 `protobuf:"varint,5,opt,name=accounts_archived,json=accountsArchived,proto3" json:"accounts_archived,omitempty"`
-	// The total number of orders in the local database.
-	OrdersTotal uint32 `protobuf:"varint,6,opt,name=orders_total,json=ordersTotal,proto3" json:"orders_total,omitempty"`
-	//
-	//The total number of active/pending orders that are still waiting for
-	//execution.
-	OrdersActive uint32 `protobuf:"varint,7,opt,name=orders_active,json=ordersActive,proto3" json:"orders_active,omitempty"`
-	// The total number of orders that have been archived.
-	OrdersArchived uint32 `protobuf:"varint,8,opt,name=orders_archived,json=ordersArchived,proto3" json:"orders_archived,omitempty"`
-	// The current block height as seen by the connected lnd node.
-	CurrentBlockHeight uint32 `protobuf:"varint,9,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
-	// The number of batches an account of this node was ever involved in.
-	BatchesInvolved uint32 `protobuf:"varint,10,opt,name=batches_involved,json=batchesInvolved,proto3" json:"batches_involved,omitempty"`
-	// Our lnd node's rating as judged by the auctioneer server.
-	NodeRating *auctioneerrpc.NodeRating `protobuf:"bytes,11,opt,name=node_rating,json=nodeRating,proto3" json:"node_rating,omitempty"`
-	// The number of available LSAT tokens.
-	LsatTokens uint32 `protobuf:"varint,12,opt,name=lsat_tokens,json=lsatTokens,proto3" json:"lsat_tokens,omitempty"`
-	//
-	//Indicates whether there is an active subscription connection to the
-	//auctioneer. This will never be true if there is no active account. If there
-	//are active accounts, this value represents the network connection status to
-	//the auctioneer server.
-	SubscribedToAuctioneer bool `protobuf:"varint,13,opt,name=subscribed_to_auctioneer,json=subscribedToAuctioneer,proto3" json:"subscribed_to_auctioneer,omitempty"`
-	//
-	//Indicates whether the global `--newnodesonly` command line flag or
-	//`newnodesonly=true` configuration parameter was set on the Pool trader
-	//daemon.
-	NewNodesOnly bool `protobuf:"varint,14,opt,name=new_nodes_only,json=newNodesOnly,proto3" json:"new_nodes_only,omitempty"`
-	//
-	//A map of all markets identified by their lease duration and the current
-	//set of statistics such as number of open orders and total units of open
-	//interest.
-	MarketInfo map[uint32]*auctioneerrpc.MarketInfo `protobuf:"bytes,15,rep,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	return 0
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[54]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetMaxBatchFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxBatchFeeRateSatPerKw
 	}
+	return 0
 }
 
-func (x *GetInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
-
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[54]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Order) GetState() auctioneerrpc.OrderState {
+	if x != nil {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return auctioneerrpc.OrderState(0)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{54}
+func (x *Order) GetUnits() uint32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
 }
 
-func (x *GetInfoResponse) GetVersion() string {
+func (x *Order) GetUnitsUnfulfilled() uint32 {
 	if x != nil {
-		return x.Version
+		return x.UnitsUnfulfilled
 	}
-	return ""
+	return 0
 }
 
-func (x *GetInfoResponse) GetAccountsTotal() uint32 {
+func (x *Order) GetReservedValueSat() uint64 {
 	if x != nil {
-		return x.AccountsTotal
+		return x.ReservedValueSat
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetAccountsActive() uint32 {
+func (x *Order) GetCreationTimestampNs() uint64 {
 	if x != nil {
-		return x.AccountsActive
+		return x.CreationTimestampNs
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetAccountsActiveExpired() uint32 {
+func (x *Order) GetEvents() []*OrderEvent {
 	if x != nil {
-		return x.AccountsActiveExpired
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *GetInfoResponse) GetAccountsArchived() uint32 {
+func (x *Order) GetMinUnitsMatch() uint32 {
 	if x != nil {
-		return x.AccountsArchived
+		return x.MinUnitsMatch
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetOrdersTotal() uint32 {
+func (x *Order) GetChannelType() auctioneerrpc.OrderChannelType {
 	if x != nil {
-		return x.OrdersTotal
+		return x.ChannelType
 	}
-	return 0
+	return auctioneerrpc.OrderChannelType(0)
 }
 
-func (x *GetInfoResponse) GetOrdersActive() uint32 {
+func (x *Order) GetAllowedNodeIds() [][]byte {
 	if x != nil {
-		return x.OrdersActive
+		return x.AllowedNodeIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetInfoResponse) GetOrdersArchived() uint32 {
+func (x *Order) GetNotAllowedNodeIds() [][]byte {
 	if x != nil {
-		return x.OrdersArchived
+		return x.NotAllowedNodeIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetInfoResponse) GetCurrentBlockHeight() uint32 {
+func (x *Order) GetAuctionType() auctioneerrpc.AuctionType {
 	if x != nil {
-		return x.CurrentBlockHeight
+		return x.AuctionType
 	}
-	return 0
+	return auctioneerrpc.AuctionType(0)
 }
 
-func (x *GetInfoResponse) GetBatchesInvolved() uint32 {
+func (x *Order) GetIsPublic() bool {
 	if x != nil {
-		return x.BatchesInvolved
+		return x.IsPublic
 	}
-	return 0
+	return false
 }
 
-func (x *GetInfoResponse) GetNodeRating() *auctioneerrpc.NodeRating {
+func (x *Order) GetTag() string {
 	if x != nil {
-		return x.NodeRating
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInfoResponse) GetLsatTokens() uint32 {
+func (x *Order) GetPartialMatchPolicy() PartialMatchPolicy {
 	if x != nil {
-		return x.LsatTokens
+		return x.PartialMatchPolicy
 	}
-	return 0
+	return PartialMatchPolicy_PARTIAL_MATCH_POLICY_KEEP
 }
 
-func (x *GetInfoResponse) GetSubscribedToAuctioneer() bool {
+func (x *Order) GetRateApr() float64 {
 	if x != nil {
-		return x.SubscribedToAuctioneer
+		return x.RateApr
 	}
-	return false
+	return 0
 }
 
-func (x *GetInfoResponse) GetNewNodesOnly() bool {
+func (x *Order) GetAutoRecreate() bool {
 	if x != nil {
-		return x.NewNodesOnly
+		return x.AutoRecreate
 	}
 	return false
 }
 
-func (x *GetInfoResponse) GetMarketInfo() map[uint32]*auctioneerrpc.MarketInfo {
+func (x *Order) GetAutoRecreateLimit() uint32 {
 	if x != nil {
-		return x.MarketInfo
+		return x.AutoRecreateLimit
 	}
-	return nil
+	return 0
 }
 
-type StopDaemonRequest struct {
+type Bid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The common fields shared between both ask and bid order types.
+	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	//
+	//Required number of blocks that a channel opened as a result of this bid
+	//should be kept open.
+	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//The version of the order format that is used. Will be increased once new
+	//features are added.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	//
+	//The minimum node tier this order should be matched with. Only asks backed by
+	//a node this tier or higher will be eligible for matching with this bid.
+	MinNodeTier auctioneerrpc.NodeTier `protobuf:"varint,4,opt,name=min_node_tier,json=minNodeTier,proto3,enum=poolrpc.NodeTier" json:"min_node_tier,omitempty"`
+	//
+	//Give the incoming channel that results from this bid being matched an
+	//initial outbound balance by adding additional funds from the taker's account
+	//into the channel. As a simplification for the execution protocol and the
+	//channel reserve calculations the min_chan_amt must be set to the full order
+	//amount. For the inbound liquidity market the self_chan_balance can be at
+	//most the same as the order amount.
+	SelfChanBalance uint64 `protobuf:"varint,5,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
+	//
+	//If this bid order is meant to lease a channel for another node (which is
+	//dubbed a "sidecar channel") then this ticket contains all information
+	//required for setting up that sidecar channel. The ticket is expected to be
+	//the base58 encoded ticket, including the prefix and the checksum.
+	SidecarTicket string `protobuf:"bytes,6,opt,name=sidecar_ticket,json=sidecarTicket,proto3" json:"sidecar_ticket,omitempty"`
+	//
+	//Signals if this bid is interested in an announced or unannounced channel.
+	UnannouncedChannel bool `protobuf:"varint,7,opt,name=unannounced_channel,json=unannouncedChannel,proto3" json:"unannounced_channel,omitempty"`
+	//
+	//Signals if this bid is interested in a zero conf channel or not.
+	ZeroConfChannel bool `protobuf:"varint,8,opt,name=zero_conf_channel,json=zeroConfChannel,proto3" json:"zero_conf_channel,omitempty"`
+	//
+	//The maximum total premium in satoshis the bid is willing to pay for all its
+	//matches in a single batch, computed at the batch's clearing rate. Batches
+	//exceeding it are rejected by the trader daemon. The limit is only enforced
+	//locally and never sent to the auctioneer. 0 means no limit.
+	MaxPremiumSat uint64 `protobuf:"varint,9,opt,name=max_premium_sat,json=maxPremiumSat,proto3" json:"max_premium_sat,omitempty"`
 }
 
-func (x *StopDaemonRequest) Reset() {
-	*x = StopDaemonRequest{}
+func (x *Bid) Reset() {
+	*x = Bid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[55]
+		mi := &file_trader_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopDaemonRequest) String() string {
+func (x *Bid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDaemonRequest) ProtoMessage() {}
+func (*Bid) ProtoMessage() {}
 
-func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[55]
+func (x *Bid) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4693,83 +5036,122 @@ func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDaemonRequest.ProtoReflect.Descriptor instead.
-func (*StopDaemonRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
+func (*Bid) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{52}
 }
 
-type StopDaemonResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Bid) GetDetails() *Order {
+	if x != nil {
+		return x.Details
+	}
+	return nil
 }
 
-func (x *StopDaemonResponse) Reset() {
-	*x = StopDaemonResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[56]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Bid) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
 	}
+	return 0
 }
 
-func (x *StopDaemonResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Bid) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (*StopDaemonResponse) ProtoMessage() {}
+func (x *Bid) GetMinNodeTier() auctioneerrpc.NodeTier {
+	if x != nil {
+		return x.MinNodeTier
+	}
+	return auctioneerrpc.NodeTier(0)
+}
 
-func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[56]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Bid) GetSelfChanBalance() uint64 {
+	if x != nil {
+		return x.SelfChanBalance
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StopDaemonResponse.ProtoReflect.Descriptor instead.
-func (*StopDaemonResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{56}
+func (x *Bid) GetSidecarTicket() string {
+	if x != nil {
+		return x.SidecarTicket
+	}
+	return ""
 }
 
-type OfferSidecarRequest struct {
+func (x *Bid) GetUnannouncedChannel() bool {
+	if x != nil {
+		return x.UnannouncedChannel
+	}
+	return false
+}
+
+func (x *Bid) GetZeroConfChannel() bool {
+	if x != nil {
+		return x.ZeroConfChannel
+	}
+	return false
+}
+
+func (x *Bid) GetMaxPremiumSat() uint64 {
+	if x != nil {
+		return x.MaxPremiumSat
+	}
+	return 0
+}
+
+type Ask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//If false, then only the trader_key, unit, self_chan_balance, and
-	//lease_duration_blocks need to be set in the bid below. Otherwise, the
-	//fields as they're set when submitting a bid need to be filled in.
-	AutoNegotiate bool `protobuf:"varint,1,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//The common fields shared between both ask and bid order types.
+	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 	//
-	//The bid template that will be used to populate the initial sidecar ticket
-	//as well as auto negotiate the remainig steps of the sidecar channel if
-	//needed.
-	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	//The number of blocks the liquidity provider is willing to provide the
+	//channel funds for.
+	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//The version of the order format that is used. Will be increased once new
+	//features are added.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	//
+	//The constraints for selling the liquidity based on channel discoverability.
+	AnnouncementConstraints auctioneerrpc.ChannelAnnouncementConstraints `protobuf:"varint,4,opt,name=announcement_constraints,json=announcementConstraints,proto3,enum=poolrpc.ChannelAnnouncementConstraints" json:"announcement_constraints,omitempty"`
+	//
+	//The constraints for selling the liquidity based on the number of
+	//blocks before considering the channel confirmed.
+	ConfirmationConstraints auctioneerrpc.ChannelConfirmationConstraints `protobuf:"varint,5,opt,name=confirmation_constraints,json=confirmationConstraints,proto3,enum=poolrpc.ChannelConfirmationConstraints" json:"confirmation_constraints,omitempty"`
+	//
+	//The minimum total premium in satoshis the ask wants to earn for all its
+	//matches in a single batch, computed at the batch's clearing rate. Batches
+	//falling short of it are rejected by the trader daemon. The limit is only
+	//enforced locally and never sent to the auctioneer. 0 means no limit.
+	MinPremiumSat uint64 `protobuf:"varint,6,opt,name=min_premium_sat,json=minPremiumSat,proto3" json:"min_premium_sat,omitempty"`
 }
 
-func (x *OfferSidecarRequest) Reset() {
-	*x = OfferSidecarRequest{}
+func (x *Ask) Reset() {
+	*x = Ask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[57]
+		mi := &file_trader_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OfferSidecarRequest) String() string {
+func (x *Ask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OfferSidecarRequest) ProtoMessage() {}
+func (*Ask) ProtoMessage() {}
 
-func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[57]
+func (x *Ask) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,144 +5162,93 @@ func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OfferSidecarRequest.ProtoReflect.Descriptor instead.
-func (*OfferSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use Ask.ProtoReflect.Descriptor instead.
+func (*Ask) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *OfferSidecarRequest) GetAutoNegotiate() bool {
+func (x *Ask) GetDetails() *Order {
 	if x != nil {
-		return x.AutoNegotiate
+		return x.Details
 	}
-	return false
+	return nil
 }
 
-func (x *OfferSidecarRequest) GetBid() *Bid {
+func (x *Ask) GetLeaseDurationBlocks() uint32 {
 	if x != nil {
-		return x.Bid
+		return x.LeaseDurationBlocks
 	}
-	return nil
-}
-
-type SidecarTicket struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The complete sidecar ticket in its string encoded form which is base58
-	//encoded, has a human readable prefix ('sidecar...') and a checksum built in.
-	//The string encoded version will only be used on the trader side of the API.
-	//All requests to the auctioneer expect the ticket to be in its raw, tlv
-	//encoded byte form.
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	return 0
 }
 
-func (x *SidecarTicket) Reset() {
-	*x = SidecarTicket{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[58]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Ask) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
 	}
+	return 0
 }
 
-func (x *SidecarTicket) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SidecarTicket) ProtoMessage() {}
-
-func (x *SidecarTicket) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[58]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Ask) GetAnnouncementConstraints() auctioneerrpc.ChannelAnnouncementConstraints {
+	if x != nil {
+		return x.AnnouncementConstraints
 	}
-	return mi.MessageOf(x)
+	return auctioneerrpc.ChannelAnnouncementConstraints(0)
 }
 
-// Deprecated: Use SidecarTicket.ProtoReflect.Descriptor instead.
-func (*SidecarTicket) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{58}
+func (x *Ask) GetConfirmationConstraints() auctioneerrpc.ChannelConfirmationConstraints {
+	if x != nil {
+		return x.ConfirmationConstraints
+	}
+	return auctioneerrpc.ChannelConfirmationConstraints(0)
 }
 
-func (x *SidecarTicket) GetTicket() string {
+func (x *Ask) GetMinPremiumSat() uint64 {
 	if x != nil {
-		return x.Ticket
+		return x.MinPremiumSat
 	}
-	return ""
+	return 0
 }
 
-type DecodedSidecarTicket struct {
+type QuoteOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique, pseudorandom identifier of the ticket.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The version of the ticket encoding format.
-	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// The state of the ticket.
-	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	// The offered channel capacity in satoshis.
-	OfferCapacity uint64 `protobuf:"varint,4,opt,name=offer_capacity,json=offerCapacity,proto3" json:"offer_capacity,omitempty"`
-	// The offered push amount in satoshis.
-	OfferPushAmount uint64 `protobuf:"varint,5,opt,name=offer_push_amount,json=offerPushAmount,proto3" json:"offer_push_amount,omitempty"`
-	// The offered lease duration in blocks.
-	OfferLeaseDurationBlocks uint32 `protobuf:"varint,6,opt,name=offer_lease_duration_blocks,json=offerLeaseDurationBlocks,proto3" json:"offer_lease_duration_blocks,omitempty"`
-	// The public key the offer was signed with.
-	OfferSignPubkey []byte `protobuf:"bytes,7,opt,name=offer_sign_pubkey,json=offerSignPubkey,proto3" json:"offer_sign_pubkey,omitempty"`
-	// The signature over the offer's digest.
-	OfferSignature []byte `protobuf:"bytes,8,opt,name=offer_signature,json=offerSignature,proto3" json:"offer_signature,omitempty"`
-	// Whether the offer was created with the automatic order creation flag.
-	OfferAuto bool `protobuf:"varint,9,opt,name=offer_auto,json=offerAuto,proto3" json:"offer_auto,omitempty"`
-	// The recipient node's public identity key.
-	RecipientNodePubkey []byte `protobuf:"bytes,10,opt,name=recipient_node_pubkey,json=recipientNodePubkey,proto3" json:"recipient_node_pubkey,omitempty"`
 	//
-	//The recipient node's channel multisig public key to be used for the sidecar
-	//channel.
-	RecipientMultisigPubkey []byte `protobuf:"bytes,11,opt,name=recipient_multisig_pubkey,json=recipientMultisigPubkey,proto3" json:"recipient_multisig_pubkey,omitempty"`
-	// The index used when deriving the above multisig pubkey.
-	RecipientMultisigPubkeyIndex uint32 `protobuf:"varint,12,opt,name=recipient_multisig_pubkey_index,json=recipientMultisigPubkeyIndex,proto3" json:"recipient_multisig_pubkey_index,omitempty"`
-	// The nonce of the bid order created for this sidecar ticket.
-	OrderBidNonce []byte `protobuf:"bytes,13,opt,name=order_bid_nonce,json=orderBidNonce,proto3" json:"order_bid_nonce,omitempty"`
+	//Order amount in satoshis.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
 	//
-	//The signature over the order's digest, signed with the private key that
-	//corresponds to the offer_sign_pubkey.
-	OrderSignature []byte `protobuf:"bytes,14,opt,name=order_signature,json=orderSignature,proto3" json:"order_signature,omitempty"`
-	// The pending channel ID of the sidecar channel during the execution phase.
-	ExecutionPendingChannelId []byte `protobuf:"bytes,15,opt,name=execution_pending_channel_id,json=executionPendingChannelId,proto3" json:"execution_pending_channel_id,omitempty"`
-	// The original, base58 encoded ticket.
-	EncodedTicket string `protobuf:"bytes,16,opt,name=encoded_ticket,json=encodedTicket,proto3" json:"encoded_ticket,omitempty"`
-	// If true, the channel acceptor for this ticket will expect an unannounced
-	// channel.
-	OfferUnannouncedChannel bool `protobuf:"varint,17,opt,name=offer_unannounced_channel,json=offerUnannouncedChannel,proto3" json:"offer_unannounced_channel,omitempty"`
-	// If true, the channel acceptor for this ticket will expect a zero conf
-	// channel.
-	OfferZeroConfChannel bool `protobuf:"varint,18,opt,name=offer_zero_conf_channel,json=offerZeroConfChannel,proto3" json:"offer_zero_conf_channel,omitempty"`
+	//Fixed order rate in parts per billion.
+	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
+	//
+	//Required number of blocks that a channel opened as a result of this bid
+	//should be kept open.
+	LeaseDurationBlocks uint32 `protobuf:"varint,3,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//Maximum fee rate the trader is willing to pay for the batch transaction,
+	//expressed in satoshis per 1000 weight units (sat/KW).
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
+	// The minimum number of order units that must be matched per order pair.
+	MinUnitsMatch uint32 `protobuf:"varint,5,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
 }
 
-func (x *DecodedSidecarTicket) Reset() {
-	*x = DecodedSidecarTicket{}
+func (x *QuoteOrderRequest) Reset() {
+	*x = QuoteOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[59]
+		mi := &file_trader_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodedSidecarTicket) String() string {
+func (x *QuoteOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodedSidecarTicket) ProtoMessage() {}
+func (*QuoteOrderRequest) ProtoMessage() {}
 
-func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[59]
+func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4928,172 +5259,176 @@ func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodedSidecarTicket.ProtoReflect.Descriptor instead.
-func (*DecodedSidecarTicket) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *DecodedSidecarTicket) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DecodedSidecarTicket) GetVersion() uint32 {
+func (x *QuoteOrderRequest) GetAmt() uint64 {
 	if x != nil {
-		return x.Version
+		return x.Amt
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetState() string {
+func (x *QuoteOrderRequest) GetRateFixed() uint32 {
 	if x != nil {
-		return x.State
+		return x.RateFixed
 	}
-	return ""
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferCapacity() uint64 {
+func (x *QuoteOrderRequest) GetLeaseDurationBlocks() uint32 {
 	if x != nil {
-		return x.OfferCapacity
+		return x.LeaseDurationBlocks
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferPushAmount() uint64 {
+func (x *QuoteOrderRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.OfferPushAmount
+		return x.MaxBatchFeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferLeaseDurationBlocks() uint32 {
+func (x *QuoteOrderRequest) GetMinUnitsMatch() uint32 {
 	if x != nil {
-		return x.OfferLeaseDurationBlocks
+		return x.MinUnitsMatch
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferSignPubkey() []byte {
-	if x != nil {
-		return x.OfferSignPubkey
-	}
-	return nil
-}
+type QuoteOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *DecodedSidecarTicket) GetOfferSignature() []byte {
-	if x != nil {
-		return x.OfferSignature
-	}
-	return nil
+	//
+	//The total order premium in satoshis for filling the entire order. This
+	//represents the interest amount paid to the maker by the taker excluding any
+	//execution or chain fees.
+	TotalPremiumSat uint64 `protobuf:"varint,1,opt,name=total_premium_sat,json=totalPremiumSat,proto3" json:"total_premium_sat,omitempty"`
+	//
+	//The fixed order rate expressed as a fraction instead of parts per billion.
+	RatePerBlock float64 `protobuf:"fixed64,2,opt,name=rate_per_block,json=ratePerBlock,proto3" json:"rate_per_block,omitempty"`
+	//
+	//The fixed order rate expressed as a percentage instead of parts per billion.
+	RatePercent float64 `protobuf:"fixed64,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`
+	//
+	//The total execution fee in satoshis that needs to be paid to the auctioneer
+	//for executing the entire order.
+	TotalExecutionFeeSat uint64 `protobuf:"varint,4,opt,name=total_execution_fee_sat,json=totalExecutionFeeSat,proto3" json:"total_execution_fee_sat,omitempty"`
+	//
+	//The worst case chain fees that need to be paid if fee rates spike up to the
+	//max_batch_fee_rate_sat_per_kw value specified in the request. This value is
+	//highly dependent on the min_units_match parameter as well since the
+	//calculation assumes chain fees for the chain footprint of opening
+	//amt/min_units_match channels (hence worst case calculation).
+	WorstCaseChainFeeSat uint64 `protobuf:"varint,5,opt,name=worst_case_chain_fee_sat,json=worstCaseChainFeeSat,proto3" json:"worst_case_chain_fee_sat,omitempty"`
 }
 
-func (x *DecodedSidecarTicket) GetOfferAuto() bool {
-	if x != nil {
-		return x.OfferAuto
+func (x *QuoteOrderResponse) Reset() {
+	*x = QuoteOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *DecodedSidecarTicket) GetRecipientNodePubkey() []byte {
-	if x != nil {
-		return x.RecipientNodePubkey
-	}
-	return nil
+func (x *QuoteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DecodedSidecarTicket) GetRecipientMultisigPubkey() []byte {
-	if x != nil {
-		return x.RecipientMultisigPubkey
-	}
-	return nil
-}
+func (*QuoteOrderResponse) ProtoMessage() {}
 
-func (x *DecodedSidecarTicket) GetRecipientMultisigPubkeyIndex() uint32 {
-	if x != nil {
-		return x.RecipientMultisigPubkeyIndex
+func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DecodedSidecarTicket) GetOrderBidNonce() []byte {
-	if x != nil {
-		return x.OrderBidNonce
-	}
-	return nil
+// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DecodedSidecarTicket) GetOrderSignature() []byte {
+func (x *QuoteOrderResponse) GetTotalPremiumSat() uint64 {
 	if x != nil {
-		return x.OrderSignature
+		return x.TotalPremiumSat
 	}
-	return nil
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetExecutionPendingChannelId() []byte {
+func (x *QuoteOrderResponse) GetRatePerBlock() float64 {
 	if x != nil {
-		return x.ExecutionPendingChannelId
+		return x.RatePerBlock
 	}
-	return nil
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetEncodedTicket() string {
+func (x *QuoteOrderResponse) GetRatePercent() float64 {
 	if x != nil {
-		return x.EncodedTicket
+		return x.RatePercent
 	}
-	return ""
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferUnannouncedChannel() bool {
+func (x *QuoteOrderResponse) GetTotalExecutionFeeSat() uint64 {
 	if x != nil {
-		return x.OfferUnannouncedChannel
+		return x.TotalExecutionFeeSat
 	}
-	return false
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferZeroConfChannel() bool {
+func (x *QuoteOrderResponse) GetWorstCaseChainFeeSat() uint64 {
 	if x != nil {
-		return x.OfferZeroConfChannel
+		return x.WorstCaseChainFeeSat
 	}
-	return false
+	return 0
 }
 
-type RegisterSidecarRequest struct {
+type OrderEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The sidecar ticket to register and add the node and channel funding
-	//information to. The ticket must be in the state "offered".
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
-	//
-	//If this value is True, then the daemon will attempt to finish negotiating
-	//the details of the sidecar channel automatically in the background. The
-	//progress of the ticket can be monitored using the SidecarState RPC. In
-	//addition, if this flag is set, then this method will _block_ until the
-	//sidecar negotiation either finishes or breaks down.
-	AutoNegotiate bool `protobuf:"varint,2,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//The unix timestamp in nanoseconds the event was emitted at. This is the
+	//primary key of the event and is unique across the database.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The human readable representation of the event.
+	EventStr string `protobuf:"bytes,2,opt,name=event_str,json=eventStr,proto3" json:"event_str,omitempty"`
+	// Types that are assignable to Event:
+	//	*OrderEvent_StateChange
+	//	*OrderEvent_Matched
+	Event isOrderEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *RegisterSidecarRequest) Reset() {
-	*x = RegisterSidecarRequest{}
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[60]
+		mi := &file_trader_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterSidecarRequest) String() string {
+func (x *OrderEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterSidecarRequest) ProtoMessage() {}
+func (*OrderEvent) ProtoMessage() {}
 
-func (x *RegisterSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[60]
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5104,150 +5439,98 @@ func (x *RegisterSidecarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterSidecarRequest.ProtoReflect.Descriptor instead.
-func (*RegisterSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RegisterSidecarRequest) GetTicket() string {
+func (x *OrderEvent) GetTimestampNs() int64 {
 	if x != nil {
-		return x.Ticket
+		return x.TimestampNs
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterSidecarRequest) GetAutoNegotiate() bool {
+func (x *OrderEvent) GetEventStr() string {
 	if x != nil {
-		return x.AutoNegotiate
+		return x.EventStr
 	}
-	return false
-}
-
-type ExpectSidecarChannelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The sidecar ticket to expect an incoming channel for. The ticket must be in
-	//the state "ordered".
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	return ""
 }
 
-func (x *ExpectSidecarChannelRequest) Reset() {
-	*x = ExpectSidecarChannelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *OrderEvent) GetEvent() isOrderEvent_Event {
+	if m != nil {
+		return m.Event
 	}
+	return nil
 }
 
-func (x *ExpectSidecarChannelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderEvent) GetStateChange() *UpdatedEvent {
+	if x, ok := x.GetEvent().(*OrderEvent_StateChange); ok {
+		return x.StateChange
+	}
+	return nil
 }
 
-func (*ExpectSidecarChannelRequest) ProtoMessage() {}
-
-func (x *ExpectSidecarChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OrderEvent) GetMatched() *MatchEvent {
+	if x, ok := x.GetEvent().(*OrderEvent_Matched); ok {
+		return x.Matched
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ExpectSidecarChannelRequest.ProtoReflect.Descriptor instead.
-func (*ExpectSidecarChannelRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{61}
+type isOrderEvent_Event interface {
+	isOrderEvent_Event()
 }
 
-func (x *ExpectSidecarChannelRequest) GetTicket() string {
-	if x != nil {
-		return x.Ticket
-	}
-	return ""
+type OrderEvent_StateChange struct {
+	// The order was updated in the database.
+	StateChange *UpdatedEvent `protobuf:"bytes,3,opt,name=state_change,json=stateChange,proto3,oneof"`
 }
 
-type ExpectSidecarChannelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ExpectSidecarChannelResponse) Reset() {
-	*x = ExpectSidecarChannelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[62]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ExpectSidecarChannelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type OrderEvent_Matched struct {
+	// The order was involved in a match making attempt.
+	Matched *MatchEvent `protobuf:"bytes,4,opt,name=matched,proto3,oneof"`
 }
 
-func (*ExpectSidecarChannelResponse) ProtoMessage() {}
-
-func (x *ExpectSidecarChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[62]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*OrderEvent_StateChange) isOrderEvent_Event() {}
 
-// Deprecated: Use ExpectSidecarChannelResponse.ProtoReflect.Descriptor instead.
-func (*ExpectSidecarChannelResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{62}
-}
+func (*OrderEvent_Matched) isOrderEvent_Event() {}
 
-type ListSidecarsRequest struct {
+type UpdatedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The optional sidecar ID to filter for. If set, the result should either be
-	//a single ticket or no ticket in most cases. But because the ID is just 8
-	//bytes and is randomly generated, there could be collisions, especially since
-	//tickets can also be crafted by a malicious party and given to any node.
-	//That's why the offer's public key is also used as an identifying element
-	//since that cannot easily be forged without also producing a valid signature.
-	//So an attacker cannot overwrite a ticket a node offered by themselves
-	//offering a ticket with the same ID and tricking the victim into registering
-	//that. Long story sort, there could be multiple tickets with the same ID but
-	//different offer public keys, which is why those keys should be checked as
-	//well.
-	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	//The state of the order previous to the change. This is what the state
+	//changed from.
+	PreviousState auctioneerrpc.OrderState `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=poolrpc.OrderState" json:"previous_state,omitempty"`
+	//
+	//The new state of the order after the change. This is what the state changed
+	//to.
+	NewState auctioneerrpc.OrderState `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=poolrpc.OrderState" json:"new_state,omitempty"`
+	// The units that were filled at the time of the event.
+	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
 }
 
-func (x *ListSidecarsRequest) Reset() {
-	*x = ListSidecarsRequest{}
+func (x *UpdatedEvent) Reset() {
+	*x = UpdatedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[63]
+		mi := &file_trader_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSidecarsRequest) String() string {
+func (x *UpdatedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSidecarsRequest) ProtoMessage() {}
+func (*UpdatedEvent) ProtoMessage() {}
 
-func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[63]
+func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5258,43 +5541,66 @@ func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSidecarsRequest.ProtoReflect.Descriptor instead.
-func (*ListSidecarsRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use UpdatedEvent.ProtoReflect.Descriptor instead.
+func (*UpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListSidecarsRequest) GetSidecarId() []byte {
+func (x *UpdatedEvent) GetPreviousState() auctioneerrpc.OrderState {
 	if x != nil {
-		return x.SidecarId
+		return x.PreviousState
 	}
-	return nil
+	return auctioneerrpc.OrderState(0)
 }
 
-type ListSidecarsResponse struct {
+func (x *UpdatedEvent) GetNewState() auctioneerrpc.OrderState {
+	if x != nil {
+		return x.NewState
+	}
+	return auctioneerrpc.OrderState(0)
+}
+
+func (x *UpdatedEvent) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+type MatchEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tickets []*DecodedSidecarTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	// The state of the match making process the order went through.
+	MatchState MatchState `protobuf:"varint,1,opt,name=match_state,json=matchState,proto3,enum=poolrpc.MatchState" json:"match_state,omitempty"`
+	// The number of units that would be (or were) filled with this match.
+	UnitsFilled uint32 `protobuf:"varint,2,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The nonce of the order we were matched to.
+	MatchedOrder []byte `protobuf:"bytes,3,opt,name=matched_order,json=matchedOrder,proto3" json:"matched_order,omitempty"`
+	//
+	//The reason why the trader daemon rejected the order. Is only set if
+	//match_state is set to REJECTED.
+	RejectReason MatchRejectReason `protobuf:"varint,4,opt,name=reject_reason,json=rejectReason,proto3,enum=poolrpc.MatchRejectReason" json:"reject_reason,omitempty"`
 }
 
-func (x *ListSidecarsResponse) Reset() {
-	*x = ListSidecarsResponse{}
+func (x *MatchEvent) Reset() {
+	*x = MatchEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[64]
+		mi := &file_trader_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSidecarsResponse) String() string {
+func (x *MatchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSidecarsResponse) ProtoMessage() {}
+func (*MatchEvent) ProtoMessage() {}
 
-func (x *ListSidecarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[64]
+func (x *MatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5305,43 +5611,109 @@ func (x *ListSidecarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSidecarsResponse.ProtoReflect.Descriptor instead.
-func (*ListSidecarsResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
+func (*MatchEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListSidecarsResponse) GetTickets() []*DecodedSidecarTicket {
+func (x *MatchEvent) GetMatchState() MatchState {
 	if x != nil {
-		return x.Tickets
+		return x.MatchState
+	}
+	return MatchState_PREPARE
+}
+
+func (x *MatchEvent) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *MatchEvent) GetMatchedOrder() []byte {
+	if x != nil {
+		return x.MatchedOrder
 	}
 	return nil
 }
 
-type CancelSidecarRequest struct {
+func (x *MatchEvent) GetRejectReason() MatchRejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return MatchRejectReason_NONE
+}
+
+type RecoverAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	//
+	//Recover the latest account states without interacting with the
+	//Lightning Labs server.
+	FullClient bool `protobuf:"varint,1,opt,name=full_client,json=fullClient,proto3" json:"full_client,omitempty"`
+	//
+	//Number of accounts that we are trying to recover. Used during the
+	//full_client recovery process.
+	AccountTarget uint32 `protobuf:"varint,2,opt,name=account_target,json=accountTarget,proto3" json:"account_target,omitempty"`
+	//
+	//Auctioneer's public key. Used during the full_client recovery process.
+	//This field should be left empty for testnet/mainnet, its value is already
+	//hardcoded in our codebase.
+	AuctioneerKey string `protobuf:"bytes,3,opt,name=auctioneer_key,json=auctioneerKey,proto3" json:"auctioneer_key,omitempty"`
+	//
+	//Initial block height. We won't try to look for any account with an expiry
+	//height smaller than this value. Used during the full_client recovery
+	//process.
+	HeightHint uint32 `protobuf:"varint,4,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
+	// bitcoind/btcd instance address. Used during the full_client recovery
+	// process. If this is empty, only the transactions known to lnd's wallet
+	// are scanned for account modifications.
+	BitcoinHost string `protobuf:"bytes,5,opt,name=bitcoin_host,json=bitcoinHost,proto3" json:"bitcoin_host,omitempty"`
+	// bitcoind/btcd user name. Used during the full_client recovery
+	// process.
+	BitcoinUser string `protobuf:"bytes,6,opt,name=bitcoin_user,json=bitcoinUser,proto3" json:"bitcoin_user,omitempty"`
+	// bitcoind/btcd password. Used during the full_client recovery
+	// process.
+	BitcoinPassword string `protobuf:"bytes,7,opt,name=bitcoin_password,json=bitcoinPassword,proto3" json:"bitcoin_password,omitempty"`
+	// Use HTTP POST mode? bitcoind only supports this mode. Used during the
+	// full_client recovery process.
+	BitcoinHttppostmode bool `protobuf:"varint,8,opt,name=bitcoin_httppostmode,json=bitcoinHttppostmode,proto3" json:"bitcoin_httppostmode,omitempty"`
+	// Use TLS to connect? bitcoind only supports non-TLS connections. Used
+	// during the full_client recovery process.
+	BitcoinUsetls bool `protobuf:"varint,9,opt,name=bitcoin_usetls,json=bitcoinUsetls,proto3" json:"bitcoin_usetls,omitempty"`
+	// Path to btcd's TLS certificate, if TLS is enabled. Used  during the
+	// full_client recovery process.
+	BitcoinTlspath string `protobuf:"bytes,10,opt,name=bitcoin_tlspath,json=bitcoinTlspath,proto3" json:"bitcoin_tlspath,omitempty"`
+	//
+	//The index of the first account key that is re-derived from lnd's key ring
+	//and checked during recovery. Defaults to 0.
+	FirstKeyIndex uint32 `protobuf:"varint,11,opt,name=first_key_index,json=firstKeyIndex,proto3" json:"first_key_index,omitempty"`
+	//
+	//The number of successive account keys, starting at first_key_index, that
+	//are re-derived from lnd's key ring and checked during recovery. If this is
+	//zero, account_target keys are derived.
+	KeyWindow uint32 `protobuf:"varint,12,opt,name=key_window,json=keyWindow,proto3" json:"key_window,omitempty"`
 }
 
-func (x *CancelSidecarRequest) Reset() {
-	*x = CancelSidecarRequest{}
+func (x *RecoverAccountsRequest) Reset() {
+	*x = RecoverAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[65]
+		mi := &file_trader_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelSidecarRequest) String() string {
+func (x *RecoverAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSidecarRequest) ProtoMessage() {}
+func (*RecoverAccountsRequest) ProtoMessage() {}
 
-func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[65]
+func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5352,1197 +5724,7140 @@ func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSidecarRequest.ProtoReflect.Descriptor instead.
-func (*CancelSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use RecoverAccountsRequest.ProtoReflect.Descriptor instead.
+func (*RecoverAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CancelSidecarRequest) GetSidecarId() []byte {
+func (x *RecoverAccountsRequest) GetFullClient() bool {
 	if x != nil {
-		return x.SidecarId
+		return x.FullClient
 	}
-	return nil
+	return false
 }
 
-type CancelSidecarResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RecoverAccountsRequest) GetAccountTarget() uint32 {
+	if x != nil {
+		return x.AccountTarget
+	}
+	return 0
 }
 
-func (x *CancelSidecarResponse) Reset() {
-	*x = CancelSidecarResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RecoverAccountsRequest) GetAuctioneerKey() string {
+	if x != nil {
+		return x.AuctioneerKey
 	}
+	return ""
 }
 
-func (x *CancelSidecarResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RecoverAccountsRequest) GetHeightHint() uint32 {
+	if x != nil {
+		return x.HeightHint
+	}
+	return 0
 }
 
-func (*CancelSidecarResponse) ProtoMessage() {}
-
-func (x *CancelSidecarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RecoverAccountsRequest) GetBitcoinHost() string {
+	if x != nil {
+		return x.BitcoinHost
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CancelSidecarResponse.ProtoReflect.Descriptor instead.
-func (*CancelSidecarResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{66}
+func (x *RecoverAccountsRequest) GetBitcoinUser() string {
+	if x != nil {
+		return x.BitcoinUser
+	}
+	return ""
 }
 
-var File_trader_proto protoreflect.FileDescriptor
+func (x *RecoverAccountsRequest) GetBitcoinPassword() string {
+	if x != nil {
+		return x.BitcoinPassword
+	}
+	return ""
+}
 
-var file_trader_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x1a, 0x1e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x65, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
-	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
-	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42,
-	0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x77,
-	0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12,
-	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46,
-	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
-	0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74,
-	0x50, 0x65, 0x72, 0x4b, 0x77, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x43, 0x0a,
-	0x16, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c,
-	0x69, 0x63, 0x69, 0x74, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52,
-	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64,
-	0x73, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a,
-	0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x54, 0x78, 0x69, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29,
-	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
-	0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x38, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x77,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x6a, 0x0a, 0x17, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x54, 0x78, 0x69, 0x64, 0x22, 0xa5, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d,
-	0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a,
-	0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61,
-	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x79, 0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x67, 0x0a,
-	0x16, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x54, 0x78, 0x69, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f,
-	0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65,
-	0x72, 0x4b, 0x77, 0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22,
-	0x65, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77,
-	0x61, 0x6c, 0x54, 0x78, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x15, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x18, 0x0a, 0x16,
-	0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x78, 0x69, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
-	0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x69,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x34, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x22, 0x4e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
-	0x22, 0x58, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41,
-	0x73, 0x6b, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63,
-	0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcc, 0x05, 0x0a, 0x05, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61,
-	0x6d, 0x74, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
-	0x4b, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75,
-	0x6e, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x75, 0x6e,
-	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x55, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65,
-	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12,
-	0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x4e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
-	0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11,
-	0x6e, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0xe4, 0x02, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12,
-	0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65,
-	0x72, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x2a,
-	0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43,
-	0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x7a,
-	0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xc5,
-	0x02, 0x0a, 0x03, 0x41, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62,
-	0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x17, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x62, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x17, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x32, 0x0a,
-	0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
-	0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55,
-	0x6e, 0x69, 0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
-	0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x18,
-	0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
-	0x77, 0x6f, 0x72, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x53, 0x61, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
-	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08,
-	0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0a,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c,
-	0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9c, 0x03, 0x0a, 0x16, 0x52, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63, 0x6f,
-	0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
-	0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x69,
-	0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
-	0x5f, 0x68, 0x74, 0x74, 0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x48, 0x74, 0x74, 0x70,
-	0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6c, 0x73, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69,
-	0x6e, 0x54, 0x6c, 0x73, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
-	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x16,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
-	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f,
-	0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65,
-	0x65, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x66, 0x65, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x22, 0x6d, 0x0a, 0x1d, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x11,
-	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x1f, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
-	0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x36, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x1a, 0x63, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x12,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
-	0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xd6,
-	0x05, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12,
-	0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e,
-	0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
-	0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65,
-	0x72, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69,
-	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73,
-	0x65, 0x6c, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x48, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64,
-	0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x41, 0x6d, 0x74, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a,
-	0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x41, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x4c, 0x73,
-	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64,
-	0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50,
-	0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
-	0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x73, 0x65,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x92, 0x03, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x16, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x65, 0x0a,
-	0x19, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01, 0x0a,
-	0x15, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74,
-	0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d,
-	0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x36, 0x0a,
-	0x11, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x06, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x49, 0x6e,
-	0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x6c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x38, 0x0a,
-	0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x61,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x49, 0x0a,
-	0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x52, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11,
-	0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x4f, 0x66, 0x66, 0x65, 0x72,
-	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f,
-	0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64,
-	0x52, 0x03, 0x62, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xbf,
-	0x06, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a,
-	0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72,
-	0x50, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6f, 0x66,
-	0x66, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x18, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x32, 0x0a,
-	0x15, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a,
-	0x1f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x69,
-	0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x42, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3a, 0x0a,
-	0x19, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x17, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x55, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6f, 0x66, 0x66, 0x65,
-	0x72, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x22, 0x57, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74,
-	0x69, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f,
-	0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x45, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x34, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x17,
-	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x6c, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43,
-	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4e, 0x44,
-	0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
-	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f,
-	0x55, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x50, 0x52,
-	0x4f, 0x4f, 0x54, 0x10, 0x02, 0x2a, 0x93, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
-	0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43,
-	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45,
-	0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x07, 0x2a, 0x50, 0x0a, 0x0a, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45,
-	0x50, 0x41, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d,
-	0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xbe, 0x01,
-	0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a,
-	0x12, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56,
-	0x49, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x56,
-	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
-	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a,
-	0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x03,
-	0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45,
-	0x43, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x45,
-	0x52, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52,
-	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x46, 0x55,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32, 0x93,
-	0x12, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x49,
-	0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
-	0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x75, 0x6d,
-	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
-	0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x27, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
-	0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4e, 0x65,
-	0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x51, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x63, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x44, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RecoverAccountsRequest) GetBitcoinHttppostmode() bool {
+	if x != nil {
+		return x.BitcoinHttppostmode
+	}
+	return false
 }
 
-var (
-	file_trader_proto_rawDescOnce sync.Once
-	file_trader_proto_rawDescData = file_trader_proto_rawDesc
-)
+func (x *RecoverAccountsRequest) GetBitcoinUsetls() bool {
+	if x != nil {
+		return x.BitcoinUsetls
+	}
+	return false
+}
 
-func file_trader_proto_rawDescGZIP() []byte {
-	file_trader_proto_rawDescOnce.Do(func() {
-		file_trader_proto_rawDescData = protoimpl.X.CompressGZIP(file_trader_proto_rawDescData)
-	})
-	return file_trader_proto_rawDescData
+func (x *RecoverAccountsRequest) GetBitcoinTlspath() string {
+	if x != nil {
+		return x.BitcoinTlspath
+	}
+	return ""
 }
 
-var file_trader_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_trader_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
-var file_trader_proto_goTypes = []interface{}{
-	(AccountVersion)(0),                               // 0: poolrpc.AccountVersion
-	(AccountState)(0),                                 // 1: poolrpc.AccountState
-	(MatchState)(0),                                   // 2: poolrpc.MatchState
-	(MatchRejectReason)(0),                            // 3: poolrpc.MatchRejectReason
-	(*InitAccountRequest)(nil),                        // 4: poolrpc.InitAccountRequest
-	(*QuoteAccountRequest)(nil),                       // 5: poolrpc.QuoteAccountRequest
-	(*QuoteAccountResponse)(nil),                      // 6: poolrpc.QuoteAccountResponse
-	(*ListAccountsRequest)(nil),                       // 7: poolrpc.ListAccountsRequest
-	(*ListAccountsResponse)(nil),                      // 8: poolrpc.ListAccountsResponse
-	(*Output)(nil),                                    // 9: poolrpc.Output
-	(*OutputWithFee)(nil),                             // 10: poolrpc.OutputWithFee
-	(*OutputsWithImplicitFee)(nil),                    // 11: poolrpc.OutputsWithImplicitFee
-	(*CloseAccountRequest)(nil),                       // 12: poolrpc.CloseAccountRequest
-	(*CloseAccountResponse)(nil),                      // 13: poolrpc.CloseAccountResponse
-	(*WithdrawAccountRequest)(nil),                    // 14: poolrpc.WithdrawAccountRequest
-	(*WithdrawAccountResponse)(nil),                   // 15: poolrpc.WithdrawAccountResponse
-	(*DepositAccountRequest)(nil),                     // 16: poolrpc.DepositAccountRequest
-	(*DepositAccountResponse)(nil),                    // 17: poolrpc.DepositAccountResponse
-	(*RenewAccountRequest)(nil),                       // 18: poolrpc.RenewAccountRequest
-	(*RenewAccountResponse)(nil),                      // 19: poolrpc.RenewAccountResponse
-	(*BumpAccountFeeRequest)(nil),                     // 20: poolrpc.BumpAccountFeeRequest
-	(*BumpAccountFeeResponse)(nil),                    // 21: poolrpc.BumpAccountFeeResponse
-	(*Account)(nil),                                   // 22: poolrpc.Account
-	(*SubmitOrderRequest)(nil),                        // 23: poolrpc.SubmitOrderRequest
-	(*SubmitOrderResponse)(nil),                       // 24: poolrpc.SubmitOrderResponse
-	(*ListOrdersRequest)(nil),                         // 25: poolrpc.ListOrdersRequest
-	(*ListOrdersResponse)(nil),                        // 26: poolrpc.ListOrdersResponse
-	(*CancelOrderRequest)(nil),                        // 27: poolrpc.CancelOrderRequest
-	(*CancelOrderResponse)(nil),                       // 28: poolrpc.CancelOrderResponse
-	(*Order)(nil),                                     // 29: poolrpc.Order
-	(*Bid)(nil),                                       // 30: poolrpc.Bid
-	(*Ask)(nil),                                       // 31: poolrpc.Ask
-	(*QuoteOrderRequest)(nil),                         // 32: poolrpc.QuoteOrderRequest
-	(*QuoteOrderResponse)(nil),                        // 33: poolrpc.QuoteOrderResponse
-	(*OrderEvent)(nil),                                // 34: poolrpc.OrderEvent
-	(*UpdatedEvent)(nil),                              // 35: poolrpc.UpdatedEvent
-	(*MatchEvent)(nil),                                // 36: poolrpc.MatchEvent
-	(*RecoverAccountsRequest)(nil),                    // 37: poolrpc.RecoverAccountsRequest
-	(*RecoverAccountsResponse)(nil),                   // 38: poolrpc.RecoverAccountsResponse
-	(*AccountModificationFeesRequest)(nil),            // 39: poolrpc.AccountModificationFeesRequest
-	(*AccountModificationFee)(nil),                    // 40: poolrpc.AccountModificationFee
-	(*ListOfAccountModificationFees)(nil),             // 41: poolrpc.ListOfAccountModificationFees
-	(*AccountModificationFeesResponse)(nil),           // 42: poolrpc.AccountModificationFeesResponse
-	(*AuctionFeeRequest)(nil),                         // 43: poolrpc.AuctionFeeRequest
-	(*AuctionFeeResponse)(nil),                        // 44: poolrpc.AuctionFeeResponse
-	(*Lease)(nil),                                     // 45: poolrpc.Lease
-	(*LeasesRequest)(nil),                             // 46: poolrpc.LeasesRequest
-	(*LeasesResponse)(nil),                            // 47: poolrpc.LeasesResponse
-	(*TokensRequest)(nil),                             // 48: poolrpc.TokensRequest
-	(*TokensResponse)(nil),                            // 49: poolrpc.TokensResponse
-	(*LsatToken)(nil),                                 // 50: poolrpc.LsatToken
-	(*LeaseDurationRequest)(nil),                      // 51: poolrpc.LeaseDurationRequest
-	(*LeaseDurationResponse)(nil),                     // 52: poolrpc.LeaseDurationResponse
-	(*NextBatchInfoRequest)(nil),                      // 53: poolrpc.NextBatchInfoRequest
-	(*NextBatchInfoResponse)(nil),                     // 54: poolrpc.NextBatchInfoResponse
-	(*NodeRatingRequest)(nil),                         // 55: poolrpc.NodeRatingRequest
-	(*NodeRatingResponse)(nil),                        // 56: poolrpc.NodeRatingResponse
-	(*GetInfoRequest)(nil),                            // 57: poolrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),                           // 58: poolrpc.GetInfoResponse
-	(*StopDaemonRequest)(nil),                         // 59: poolrpc.StopDaemonRequest
-	(*StopDaemonResponse)(nil),                        // 60: poolrpc.StopDaemonResponse
-	(*OfferSidecarRequest)(nil),                       // 61: poolrpc.OfferSidecarRequest
-	(*SidecarTicket)(nil),                             // 62: poolrpc.SidecarTicket
-	(*DecodedSidecarTicket)(nil),                      // 63: poolrpc.DecodedSidecarTicket
-	(*RegisterSidecarRequest)(nil),                    // 64: poolrpc.RegisterSidecarRequest
-	(*ExpectSidecarChannelRequest)(nil),               // 65: poolrpc.ExpectSidecarChannelRequest
-	(*ExpectSidecarChannelResponse)(nil),              // 66: poolrpc.ExpectSidecarChannelResponse
-	(*ListSidecarsRequest)(nil),                       // 67: poolrpc.ListSidecarsRequest
-	(*ListSidecarsResponse)(nil),                      // 68: poolrpc.ListSidecarsResponse
-	(*CancelSidecarRequest)(nil),                      // 69: poolrpc.CancelSidecarRequest
-	(*CancelSidecarResponse)(nil),                     // 70: poolrpc.CancelSidecarResponse
-	nil,                                               // 71: poolrpc.AccountModificationFeesResponse.AccountsEntry
-	nil,                                               // 72: poolrpc.LeaseDurationResponse.LeaseDurationsEntry
-	nil,                                               // 73: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
-	nil,                                               // 74: poolrpc.GetInfoResponse.MarketInfoEntry
-	(*auctioneerrpc.OutPoint)(nil),                    // 75: poolrpc.OutPoint
-	(*auctioneerrpc.InvalidOrder)(nil),                // 76: poolrpc.InvalidOrder
-	(auctioneerrpc.OrderState)(0),                     // 77: poolrpc.OrderState
-	(auctioneerrpc.OrderChannelType)(0),               // 78: poolrpc.OrderChannelType
-	(auctioneerrpc.AuctionType)(0),                    // 79: poolrpc.AuctionType
-	(auctioneerrpc.NodeTier)(0),                       // 80: poolrpc.NodeTier
-	(auctioneerrpc.ChannelAnnouncementConstraints)(0), // 81: poolrpc.ChannelAnnouncementConstraints
-	(auctioneerrpc.ChannelConfirmationConstraints)(0), // 82: poolrpc.ChannelConfirmationConstraints
-	(*auctioneerrpc.ExecutionFee)(nil),                // 83: poolrpc.ExecutionFee
-	(*auctioneerrpc.NodeRating)(nil),                  // 84: poolrpc.NodeRating
-	(auctioneerrpc.DurationBucketState)(0),            // 85: poolrpc.DurationBucketState
-	(*auctioneerrpc.MarketInfo)(nil),                  // 86: poolrpc.MarketInfo
-	(*auctioneerrpc.BatchSnapshotRequest)(nil),        // 87: poolrpc.BatchSnapshotRequest
-	(*auctioneerrpc.BatchSnapshotsRequest)(nil),       // 88: poolrpc.BatchSnapshotsRequest
-	(*auctioneerrpc.BatchSnapshotResponse)(nil),       // 89: poolrpc.BatchSnapshotResponse
-	(*auctioneerrpc.BatchSnapshotsResponse)(nil),      // 90: poolrpc.BatchSnapshotsResponse
+func (x *RecoverAccountsRequest) GetFirstKeyIndex() uint32 {
+	if x != nil {
+		return x.FirstKeyIndex
+	}
+	return 0
 }
-var file_trader_proto_depIdxs = []int32{
-	0,  // 0: poolrpc.InitAccountRequest.version:type_name -> poolrpc.AccountVersion
-	22, // 1: poolrpc.ListAccountsResponse.accounts:type_name -> poolrpc.Account
-	9,  // 2: poolrpc.OutputsWithImplicitFee.outputs:type_name -> poolrpc.Output
-	10, // 3: poolrpc.CloseAccountRequest.output_with_fee:type_name -> poolrpc.OutputWithFee
-	11, // 4: poolrpc.CloseAccountRequest.outputs:type_name -> poolrpc.OutputsWithImplicitFee
-	9,  // 5: poolrpc.WithdrawAccountRequest.outputs:type_name -> poolrpc.Output
-	0,  // 6: poolrpc.WithdrawAccountRequest.new_version:type_name -> poolrpc.AccountVersion
-	22, // 7: poolrpc.WithdrawAccountResponse.account:type_name -> poolrpc.Account
-	0,  // 8: poolrpc.DepositAccountRequest.new_version:type_name -> poolrpc.AccountVersion
-	22, // 9: poolrpc.DepositAccountResponse.account:type_name -> poolrpc.Account
-	0,  // 10: poolrpc.RenewAccountRequest.new_version:type_name -> poolrpc.AccountVersion
-	22, // 11: poolrpc.RenewAccountResponse.account:type_name -> poolrpc.Account
-	75, // 12: poolrpc.Account.outpoint:type_name -> poolrpc.OutPoint
-	1,  // 13: poolrpc.Account.state:type_name -> poolrpc.AccountState
-	0,  // 14: poolrpc.Account.version:type_name -> poolrpc.AccountVersion
-	31, // 15: poolrpc.SubmitOrderRequest.ask:type_name -> poolrpc.Ask
-	30, // 16: poolrpc.SubmitOrderRequest.bid:type_name -> poolrpc.Bid
-	76, // 17: poolrpc.SubmitOrderResponse.invalid_order:type_name -> poolrpc.InvalidOrder
-	31, // 18: poolrpc.ListOrdersResponse.asks:type_name -> poolrpc.Ask
-	30, // 19: poolrpc.ListOrdersResponse.bids:type_name -> poolrpc.Bid
-	77, // 20: poolrpc.Order.state:type_name -> poolrpc.OrderState
-	34, // 21: poolrpc.Order.events:type_name -> poolrpc.OrderEvent
-	78, // 22: poolrpc.Order.channel_type:type_name -> poolrpc.OrderChannelType
-	79, // 23: poolrpc.Order.auction_type:type_name -> poolrpc.AuctionType
-	29, // 24: poolrpc.Bid.details:type_name -> poolrpc.Order
-	80, // 25: poolrpc.Bid.min_node_tier:type_name -> poolrpc.NodeTier
-	29, // 26: poolrpc.Ask.details:type_name -> poolrpc.Order
-	81, // 27: poolrpc.Ask.announcement_constraints:type_name -> poolrpc.ChannelAnnouncementConstraints
-	82, // 28: poolrpc.Ask.confirmation_constraints:type_name -> poolrpc.ChannelConfirmationConstraints
-	35, // 29: poolrpc.OrderEvent.state_change:type_name -> poolrpc.UpdatedEvent
-	36, // 30: poolrpc.OrderEvent.matched:type_name -> poolrpc.MatchEvent
-	77, // 31: poolrpc.UpdatedEvent.previous_state:type_name -> poolrpc.OrderState
-	77, // 32: poolrpc.UpdatedEvent.new_state:type_name -> poolrpc.OrderState
-	2,  // 33: poolrpc.MatchEvent.match_state:type_name -> poolrpc.MatchState
-	3,  // 34: poolrpc.MatchEvent.reject_reason:type_name -> poolrpc.MatchRejectReason
-	40, // 35: poolrpc.ListOfAccountModificationFees.modification_fees:type_name -> poolrpc.AccountModificationFee
-	71, // 36: poolrpc.AccountModificationFeesResponse.accounts:type_name -> poolrpc.AccountModificationFeesResponse.AccountsEntry
-	83, // 37: poolrpc.AuctionFeeResponse.execution_fee:type_name -> poolrpc.ExecutionFee
-	75, // 38: poolrpc.Lease.channel_point:type_name -> poolrpc.OutPoint
-	80, // 39: poolrpc.Lease.channel_node_tier:type_name -> poolrpc.NodeTier
-	45, // 40: poolrpc.LeasesResponse.leases:type_name -> poolrpc.Lease
-	50, // 41: poolrpc.TokensResponse.tokens:type_name -> poolrpc.LsatToken
-	72, // 42: poolrpc.LeaseDurationResponse.lease_durations:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationsEntry
-	73, // 43: poolrpc.LeaseDurationResponse.lease_duration_buckets:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
-	84, // 44: poolrpc.NodeRatingResponse.node_ratings:type_name -> poolrpc.NodeRating
-	84, // 45: poolrpc.GetInfoResponse.node_rating:type_name -> poolrpc.NodeRating
-	74, // 46: poolrpc.GetInfoResponse.market_info:type_name -> poolrpc.GetInfoResponse.MarketInfoEntry
-	30, // 47: poolrpc.OfferSidecarRequest.bid:type_name -> poolrpc.Bid
-	63, // 48: poolrpc.ListSidecarsResponse.tickets:type_name -> poolrpc.DecodedSidecarTicket
-	41, // 49: poolrpc.AccountModificationFeesResponse.AccountsEntry.value:type_name -> poolrpc.ListOfAccountModificationFees
-	85, // 50: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry.value:type_name -> poolrpc.DurationBucketState
-	86, // 51: poolrpc.GetInfoResponse.MarketInfoEntry.value:type_name -> poolrpc.MarketInfo
-	57, // 52: poolrpc.Trader.GetInfo:input_type -> poolrpc.GetInfoRequest
-	59, // 53: poolrpc.Trader.StopDaemon:input_type -> poolrpc.StopDaemonRequest
-	5,  // 54: poolrpc.Trader.QuoteAccount:input_type -> poolrpc.QuoteAccountRequest
-	4,  // 55: poolrpc.Trader.InitAccount:input_type -> poolrpc.InitAccountRequest
-	7,  // 56: poolrpc.Trader.ListAccounts:input_type -> poolrpc.ListAccountsRequest
-	12, // 57: poolrpc.Trader.CloseAccount:input_type -> poolrpc.CloseAccountRequest
-	14, // 58: poolrpc.Trader.WithdrawAccount:input_type -> poolrpc.WithdrawAccountRequest
-	16, // 59: poolrpc.Trader.DepositAccount:input_type -> poolrpc.DepositAccountRequest
-	18, // 60: poolrpc.Trader.RenewAccount:input_type -> poolrpc.RenewAccountRequest
-	20, // 61: poolrpc.Trader.BumpAccountFee:input_type -> poolrpc.BumpAccountFeeRequest
-	37, // 62: poolrpc.Trader.RecoverAccounts:input_type -> poolrpc.RecoverAccountsRequest
-	39, // 63: poolrpc.Trader.AccountModificationFees:input_type -> poolrpc.AccountModificationFeesRequest
-	23, // 64: poolrpc.Trader.SubmitOrder:input_type -> poolrpc.SubmitOrderRequest
-	25, // 65: poolrpc.Trader.ListOrders:input_type -> poolrpc.ListOrdersRequest
-	27, // 66: poolrpc.Trader.CancelOrder:input_type -> poolrpc.CancelOrderRequest
-	32, // 67: poolrpc.Trader.QuoteOrder:input_type -> poolrpc.QuoteOrderRequest
-	43, // 68: poolrpc.Trader.AuctionFee:input_type -> poolrpc.AuctionFeeRequest
-	51, // 69: poolrpc.Trader.LeaseDurations:input_type -> poolrpc.LeaseDurationRequest
-	53, // 70: poolrpc.Trader.NextBatchInfo:input_type -> poolrpc.NextBatchInfoRequest
-	87, // 71: poolrpc.Trader.BatchSnapshot:input_type -> poolrpc.BatchSnapshotRequest
-	48, // 72: poolrpc.Trader.GetLsatTokens:input_type -> poolrpc.TokensRequest
-	46, // 73: poolrpc.Trader.Leases:input_type -> poolrpc.LeasesRequest
-	55, // 74: poolrpc.Trader.NodeRatings:input_type -> poolrpc.NodeRatingRequest
-	88, // 75: poolrpc.Trader.BatchSnapshots:input_type -> poolrpc.BatchSnapshotsRequest
-	61, // 76: poolrpc.Trader.OfferSidecar:input_type -> poolrpc.OfferSidecarRequest
-	64, // 77: poolrpc.Trader.RegisterSidecar:input_type -> poolrpc.RegisterSidecarRequest
-	65, // 78: poolrpc.Trader.ExpectSidecarChannel:input_type -> poolrpc.ExpectSidecarChannelRequest
-	62, // 79: poolrpc.Trader.DecodeSidecarTicket:input_type -> poolrpc.SidecarTicket
-	67, // 80: poolrpc.Trader.ListSidecars:input_type -> poolrpc.ListSidecarsRequest
-	69, // 81: poolrpc.Trader.CancelSidecar:input_type -> poolrpc.CancelSidecarRequest
-	58, // 82: poolrpc.Trader.GetInfo:output_type -> poolrpc.GetInfoResponse
-	60, // 83: poolrpc.Trader.StopDaemon:output_type -> poolrpc.StopDaemonResponse
-	6,  // 84: poolrpc.Trader.QuoteAccount:output_type -> poolrpc.QuoteAccountResponse
-	22, // 85: poolrpc.Trader.InitAccount:output_type -> poolrpc.Account
-	8,  // 86: poolrpc.Trader.ListAccounts:output_type -> poolrpc.ListAccountsResponse
-	13, // 87: poolrpc.Trader.CloseAccount:output_type -> poolrpc.CloseAccountResponse
-	15, // 88: poolrpc.Trader.WithdrawAccount:output_type -> poolrpc.WithdrawAccountResponse
-	17, // 89: poolrpc.Trader.DepositAccount:output_type -> poolrpc.DepositAccountResponse
-	19, // 90: poolrpc.Trader.RenewAccount:output_type -> poolrpc.RenewAccountResponse
-	21, // 91: poolrpc.Trader.BumpAccountFee:output_type -> poolrpc.BumpAccountFeeResponse
-	38, // 92: poolrpc.Trader.RecoverAccounts:output_type -> poolrpc.RecoverAccountsResponse
-	42, // 93: poolrpc.Trader.AccountModificationFees:output_type -> poolrpc.AccountModificationFeesResponse
-	24, // 94: poolrpc.Trader.SubmitOrder:output_type -> poolrpc.SubmitOrderResponse
-	26, // 95: poolrpc.Trader.ListOrders:output_type -> poolrpc.ListOrdersResponse
-	28, // 96: poolrpc.Trader.CancelOrder:output_type -> poolrpc.CancelOrderResponse
-	33, // 97: poolrpc.Trader.QuoteOrder:output_type -> poolrpc.QuoteOrderResponse
-	44, // 98: poolrpc.Trader.AuctionFee:output_type -> poolrpc.AuctionFeeResponse
-	52, // 99: poolrpc.Trader.LeaseDurations:output_type -> poolrpc.LeaseDurationResponse
-	54, // 100: poolrpc.Trader.NextBatchInfo:output_type -> poolrpc.NextBatchInfoResponse
-	89, // 101: poolrpc.Trader.BatchSnapshot:output_type -> poolrpc.BatchSnapshotResponse
-	49, // 102: poolrpc.Trader.GetLsatTokens:output_type -> poolrpc.TokensResponse
-	47, // 103: poolrpc.Trader.Leases:output_type -> poolrpc.LeasesResponse
-	56, // 104: poolrpc.Trader.NodeRatings:output_type -> poolrpc.NodeRatingResponse
-	90, // 105: poolrpc.Trader.BatchSnapshots:output_type -> poolrpc.BatchSnapshotsResponse
-	62, // 106: poolrpc.Trader.OfferSidecar:output_type -> poolrpc.SidecarTicket
-	62, // 107: poolrpc.Trader.RegisterSidecar:output_type -> poolrpc.SidecarTicket
-	66, // 108: poolrpc.Trader.ExpectSidecarChannel:output_type -> poolrpc.ExpectSidecarChannelResponse
-	63, // 109: poolrpc.Trader.DecodeSidecarTicket:output_type -> poolrpc.DecodedSidecarTicket
-	68, // 110: poolrpc.Trader.ListSidecars:output_type -> poolrpc.ListSidecarsResponse
-	70, // 111: poolrpc.Trader.CancelSidecar:output_type -> poolrpc.CancelSidecarResponse
-	82, // [82:112] is the sub-list for method output_type
-	52, // [52:82] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+
+func (x *RecoverAccountsRequest) GetKeyWindow() uint32 {
+	if x != nil {
+		return x.KeyWindow
+	}
+	return 0
 }
 
-func init() { file_trader_proto_init() }
-func file_trader_proto_init() {
-	if File_trader_proto != nil {
-		return
+type RecoverAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of accounts that were recovered.
+	NumRecoveredAccounts uint32 `protobuf:"varint,1,opt,name=num_recovered_accounts,json=numRecoveredAccounts,proto3" json:"num_recovered_accounts,omitempty"`
+}
+
+func (x *RecoverAccountsResponse) Reset() {
+	*x = RecoverAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_trader_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitAccountRequest); i {
+}
+
+func (x *RecoverAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverAccountsResponse) ProtoMessage() {}
+
+func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverAccountsResponse.ProtoReflect.Descriptor instead.
+func (*RecoverAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RecoverAccountsResponse) GetNumRecoveredAccounts() uint32 {
+	if x != nil {
+		return x.NumRecoveredAccounts
+	}
+	return 0
+}
+
+type AccountModificationFeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AccountModificationFeesRequest) Reset() {
+	*x = AccountModificationFeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountModificationFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationFeesRequest) ProtoMessage() {}
+
+func (x *AccountModificationFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationFeesRequest.ProtoReflect.Descriptor instead.
+func (*AccountModificationFeesRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{61}
+}
+
+type AccountModificationFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Modification action type.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Transaction ID.
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Action transaction block height.
+	BlockHeight int32 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Action transaction timestamp.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Action transaction output amount.
+	OutputAmount int64 `protobuf:"varint,5,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`
+	// Action transaction fee.
+	//
+	// Types that are assignable to Fee:
+	//	*AccountModificationFee_FeeNull
+	//	*AccountModificationFee_FeeValue
+	Fee isAccountModificationFee_Fee `protobuf_oneof:"fee"`
+}
+
+func (x *AccountModificationFee) Reset() {
+	*x = AccountModificationFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountModificationFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationFee) ProtoMessage() {}
+
+func (x *AccountModificationFee) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationFee.ProtoReflect.Descriptor instead.
+func (*AccountModificationFee) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AccountModificationFee) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AccountModificationFee) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *AccountModificationFee) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *AccountModificationFee) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AccountModificationFee) GetOutputAmount() int64 {
+	if x != nil {
+		return x.OutputAmount
+	}
+	return 0
+}
+
+func (m *AccountModificationFee) GetFee() isAccountModificationFee_Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (x *AccountModificationFee) GetFeeNull() bool {
+	if x, ok := x.GetFee().(*AccountModificationFee_FeeNull); ok {
+		return x.FeeNull
+	}
+	return false
+}
+
+func (x *AccountModificationFee) GetFeeValue() int64 {
+	if x, ok := x.GetFee().(*AccountModificationFee_FeeValue); ok {
+		return x.FeeValue
+	}
+	return 0
+}
+
+type isAccountModificationFee_Fee interface {
+	isAccountModificationFee_Fee()
+}
+
+type AccountModificationFee_FeeNull struct {
+	// A flag which is true if fee value has not been set, and is otherwise
+	// false.
+	FeeNull bool `protobuf:"varint,6,opt,name=fee_null,json=feeNull,proto3,oneof"`
+}
+
+type AccountModificationFee_FeeValue struct {
+	// Action transaction fee value.
+	FeeValue int64 `protobuf:"varint,7,opt,name=fee_value,json=feeValue,proto3,oneof"`
+}
+
+func (*AccountModificationFee_FeeNull) isAccountModificationFee_Fee() {}
+
+func (*AccountModificationFee_FeeValue) isAccountModificationFee_Fee() {}
+
+type ListOfAccountModificationFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModificationFees []*AccountModificationFee `protobuf:"bytes,1,rep,name=modification_fees,json=modificationFees,proto3" json:"modification_fees,omitempty"`
+}
+
+func (x *ListOfAccountModificationFees) Reset() {
+	*x = ListOfAccountModificationFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOfAccountModificationFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfAccountModificationFees) ProtoMessage() {}
+
+func (x *ListOfAccountModificationFees) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOfAccountModificationFees.ProtoReflect.Descriptor instead.
+func (*ListOfAccountModificationFees) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListOfAccountModificationFees) GetModificationFees() []*AccountModificationFee {
+	if x != nil {
+		return x.ModificationFees
+	}
+	return nil
+}
+
+type AccountModificationFeesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A map from account key to an ordered list of account modification fees.
+	Accounts map[string]*ListOfAccountModificationFees `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AccountModificationFeesResponse) Reset() {
+	*x = AccountModificationFeesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountModificationFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountModificationFeesResponse) ProtoMessage() {}
+
+func (x *AccountModificationFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountModificationFeesResponse.ProtoReflect.Descriptor instead.
+func (*AccountModificationFeesResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AccountModificationFeesResponse) GetAccounts() map[string]*ListOfAccountModificationFees {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type AccountAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The trader key of the account to return the events for.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//Only return events that happened at or after the given unix timestamp in
+	//nanoseconds. If zero, events are not filtered by a start time.
+	StartTimestampNs uint64 `protobuf:"varint,2,opt,name=start_timestamp_ns,json=startTimestampNs,proto3" json:"start_timestamp_ns,omitempty"`
+	//
+	//Only return events that happened at or before the given unix timestamp in
+	//nanoseconds. If zero, events are not filtered by an end time.
+	EndTimestampNs uint64 `protobuf:"varint,3,opt,name=end_timestamp_ns,json=endTimestampNs,proto3" json:"end_timestamp_ns,omitempty"`
+}
+
+func (x *AccountAuditLogRequest) Reset() {
+	*x = AccountAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAuditLogRequest) ProtoMessage() {}
+
+func (x *AccountAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AccountAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AccountAuditLogRequest) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *AccountAuditLogRequest) GetStartTimestampNs() uint64 {
+	if x != nil {
+		return x.StartTimestampNs
+	}
+	return 0
+}
+
+func (x *AccountAuditLogRequest) GetEndTimestampNs() uint64 {
+	if x != nil {
+		return x.EndTimestampNs
+	}
+	return 0
+}
+
+type AccountAuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in nanoseconds the event was recorded at.
+	TimestampNs uint64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The kind of modification the event represents.
+	Action AccountAuditAction `protobuf:"varint,2,opt,name=action,proto3,enum=poolrpc.AccountAuditAction" json:"action,omitempty"`
+	// The state of the account after the event.
+	State AccountState `protobuf:"varint,3,opt,name=state,proto3,enum=poolrpc.AccountState" json:"state,omitempty"`
+	// The value of the account in satoshis before the event.
+	PrevValue uint64 `protobuf:"varint,4,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+	// The value of the account in satoshis after the event.
+	Value uint64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// The expiration height of the account after the event.
+	ExpirationHeight uint32 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	//
+	//The hash of the account's latest transaction after the event. This is empty
+	//if the account doesn't have a transaction yet.
+	Txid []byte `protobuf:"bytes,7,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *AccountAuditEvent) Reset() {
+	*x = AccountAuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountAuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAuditEvent) ProtoMessage() {}
+
+func (x *AccountAuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAuditEvent.ProtoReflect.Descriptor instead.
+func (*AccountAuditEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AccountAuditEvent) GetTimestampNs() uint64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *AccountAuditEvent) GetAction() AccountAuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return AccountAuditAction_ACCOUNT_AUDIT_ACTION_STATE_CHANGE
+}
+
+func (x *AccountAuditEvent) GetState() AccountState {
+	if x != nil {
+		return x.State
+	}
+	return AccountState_PENDING_OPEN
+}
+
+func (x *AccountAuditEvent) GetPrevValue() uint64 {
+	if x != nil {
+		return x.PrevValue
+	}
+	return 0
+}
+
+func (x *AccountAuditEvent) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AccountAuditEvent) GetExpirationHeight() uint32 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
+func (x *AccountAuditEvent) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+type AccountAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events of the account, ordered by their timestamps.
+	Events []*AccountAuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AccountAuditLogResponse) Reset() {
+	*x = AccountAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAuditLogResponse) ProtoMessage() {}
+
+func (x *AccountAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AccountAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AccountAuditLogResponse) GetEvents() []*AccountAuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AuctionFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuctionFeeRequest) Reset() {
+	*x = AuctionFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionFeeRequest) ProtoMessage() {}
+
+func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionFeeRequest.ProtoReflect.Descriptor instead.
+func (*AuctionFeeRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{68}
+}
+
+type AuctionFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The execution fee charged per matched order.
+	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,1,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+}
+
+func (x *AuctionFeeResponse) Reset() {
+	*x = AuctionFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionFeeResponse) ProtoMessage() {}
+
+func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionFeeResponse.ProtoReflect.Descriptor instead.
+func (*AuctionFeeResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AuctionFeeResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+	if x != nil {
+		return x.ExecutionFee
+	}
+	return nil
+}
+
+type Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the channel created.
+	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The amount, in satoshis, of the channel created.
+	ChannelAmtSat uint64 `protobuf:"varint,2,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
+	// The intended duration, in blocks, of the channel created.
+	ChannelDurationBlocks uint32 `protobuf:"varint,3,opt,name=channel_duration_blocks,json=channelDurationBlocks,proto3" json:"channel_duration_blocks,omitempty"`
+	// The absolute height that this channel lease expires.
+	ChannelLeaseExpiry uint32 `protobuf:"varint,4,opt,name=channel_lease_expiry,json=channelLeaseExpiry,proto3" json:"channel_lease_expiry,omitempty"`
+	//
+	//The premium, in satoshis, either paid or received for the offered liquidity.
+	PremiumSat uint64 `protobuf:"varint,5,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	//
+	//The execution fee, in satoshis, charged by the auctioneer for the channel
+	//created.
+	ExecutionFeeSat uint64 `protobuf:"varint,6,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
+	//
+	//The fee, in satoshis, charged by the auctioneer for the batch execution
+	//transaction that created this lease.
+	ChainFeeSat uint64 `protobuf:"varint,7,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
+	//
+	//The actual fixed rate expressed in parts per billionth this lease was
+	//bought/sold at.
+	ClearingRatePrice uint64 `protobuf:"varint,8,opt,name=clearing_rate_price,json=clearingRatePrice,proto3" json:"clearing_rate_price,omitempty"`
+	//
+	//The actual fixed rate of the bid/ask, this should always be 'better' than
+	//the clearing_rate_price.
+	OrderFixedRate uint64 `protobuf:"varint,9,opt,name=order_fixed_rate,json=orderFixedRate,proto3" json:"order_fixed_rate,omitempty"`
+	// The order executed that resulted in the channel created.
+	OrderNonce []byte `protobuf:"bytes,10,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The unique identifier for the order that was matched with that resulted
+	//in the channel created.
+	MatchedOrderNonce []byte `protobuf:"bytes,16,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
+	// Whether this channel was purchased from another trader or not.
+	Purchased bool `protobuf:"varint,11,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	// The pubkey of the node that this channel was bought/sold from.
+	ChannelRemoteNodeKey []byte `protobuf:"bytes,12,opt,name=channel_remote_node_key,json=channelRemoteNodeKey,proto3" json:"channel_remote_node_key,omitempty"`
+	// The tier of the node that this channel was bought/sold from.
+	ChannelNodeTier auctioneerrpc.NodeTier `protobuf:"varint,13,opt,name=channel_node_tier,json=channelNodeTier,proto3,enum=poolrpc.NodeTier" json:"channel_node_tier,omitempty"`
+	// The self channel balance that was pushed to the recipient.
+	SelfChanBalance uint64 `protobuf:"varint,14,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
+	// Whether the channel was leased as a sidecar channel (bid orders only).
+	SidecarChannel bool `protobuf:"varint,15,opt,name=sidecar_channel,json=sidecarChannel,proto3" json:"sidecar_channel,omitempty"`
+	// The current status of the lease.
+	Status LeaseStatus `protobuf:"varint,17,opt,name=status,proto3,enum=poolrpc.LeaseStatus" json:"status,omitempty"`
+	//
+	//The number of blocks until the lease expires. This is zero if the lease is
+	//no longer active or its expiry height isn't known yet.
+	RemainingDurationBlocks uint32 `protobuf:"varint,18,opt,name=remaining_duration_blocks,json=remainingDurationBlocks,proto3" json:"remaining_duration_blocks,omitempty"`
+	//
+	//The share, in satoshis, of the fee rebate the auctioneer granted for the
+	//batch that created this lease. A rebate is offered if another batch
+	//participant paid a higher chain fee for a faster confirmation.
+	FeeRebateSat uint64 `protobuf:"varint,19,opt,name=fee_rebate_sat,json=feeRebateSat,proto3" json:"fee_rebate_sat,omitempty"`
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Lease) GetChannelPoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+func (x *Lease) GetChannelAmtSat() uint64 {
+	if x != nil {
+		return x.ChannelAmtSat
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelDurationBlocks() uint32 {
+	if x != nil {
+		return x.ChannelDurationBlocks
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelLeaseExpiry() uint32 {
+	if x != nil {
+		return x.ChannelLeaseExpiry
+	}
+	return 0
+}
+
+func (x *Lease) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *Lease) GetExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeSat
+	}
+	return 0
+}
+
+func (x *Lease) GetChainFeeSat() uint64 {
+	if x != nil {
+		return x.ChainFeeSat
+	}
+	return 0
+}
+
+func (x *Lease) GetClearingRatePrice() uint64 {
+	if x != nil {
+		return x.ClearingRatePrice
+	}
+	return 0
+}
+
+func (x *Lease) GetOrderFixedRate() uint64 {
+	if x != nil {
+		return x.OrderFixedRate
+	}
+	return 0
+}
+
+func (x *Lease) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *Lease) GetMatchedOrderNonce() []byte {
+	if x != nil {
+		return x.MatchedOrderNonce
+	}
+	return nil
+}
+
+func (x *Lease) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *Lease) GetChannelRemoteNodeKey() []byte {
+	if x != nil {
+		return x.ChannelRemoteNodeKey
+	}
+	return nil
+}
+
+func (x *Lease) GetChannelNodeTier() auctioneerrpc.NodeTier {
+	if x != nil {
+		return x.ChannelNodeTier
+	}
+	return auctioneerrpc.NodeTier(0)
+}
+
+func (x *Lease) GetSelfChanBalance() uint64 {
+	if x != nil {
+		return x.SelfChanBalance
+	}
+	return 0
+}
+
+func (x *Lease) GetSidecarChannel() bool {
+	if x != nil {
+		return x.SidecarChannel
+	}
+	return false
+}
+
+func (x *Lease) GetStatus() LeaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeaseStatus_LEASE_STATUS_PENDING
+}
+
+func (x *Lease) GetRemainingDurationBlocks() uint32 {
+	if x != nil {
+		return x.RemainingDurationBlocks
+	}
+	return 0
+}
+
+func (x *Lease) GetFeeRebateSat() uint64 {
+	if x != nil {
+		return x.FeeRebateSat
+	}
+	return 0
+}
+
+type LeasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//An optional list of batches to retrieve the leases of. If empty, leases
+	//throughout all batches are returned.
+	BatchIds [][]byte `protobuf:"bytes,1,rep,name=batch_ids,json=batchIds,proto3" json:"batch_ids,omitempty"`
+	//
+	//An optional list of accounts to retrieve the leases of. If empty, leases
+	//for all accounts are returned.
+	Accounts [][]byte `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	//
+	//An optional filter to only return the leases that were either purchased
+	//(taker) or sold (maker) by the trader.
+	RoleFilter LeaseRoleFilter `protobuf:"varint,3,opt,name=role_filter,json=roleFilter,proto3,enum=poolrpc.LeaseRoleFilter" json:"role_filter,omitempty"`
+	//
+	//An optional list of lease statuses to filter by. If empty, leases of all
+	//statuses are returned.
+	Statuses []LeaseStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=poolrpc.LeaseStatus" json:"statuses,omitempty"`
+}
+
+func (x *LeasesRequest) Reset() {
+	*x = LeasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasesRequest) ProtoMessage() {}
+
+func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasesRequest.ProtoReflect.Descriptor instead.
+func (*LeasesRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LeasesRequest) GetBatchIds() [][]byte {
+	if x != nil {
+		return x.BatchIds
+	}
+	return nil
+}
+
+func (x *LeasesRequest) GetAccounts() [][]byte {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *LeasesRequest) GetRoleFilter() LeaseRoleFilter {
+	if x != nil {
+		return x.RoleFilter
+	}
+	return LeaseRoleFilter_LEASE_ROLE_FILTER_ALL
+}
+
+func (x *LeasesRequest) GetStatuses() []LeaseStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type LeasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The relevant list of leases purchased or sold within the auction.
+	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+	// The total amount of satoshis earned from the leases returned.
+	TotalAmtEarnedSat uint64 `protobuf:"varint,2,opt,name=total_amt_earned_sat,json=totalAmtEarnedSat,proto3" json:"total_amt_earned_sat,omitempty"`
+	// The total amount of satoshis paid for the leases returned.
+	TotalAmtPaidSat uint64 `protobuf:"varint,3,opt,name=total_amt_paid_sat,json=totalAmtPaidSat,proto3" json:"total_amt_paid_sat,omitempty"`
+	//
+	//The total fee rebate, in satoshis, granted by the auctioneer for the leases
+	//returned.
+	TotalFeeRebateSat uint64 `protobuf:"varint,4,opt,name=total_fee_rebate_sat,json=totalFeeRebateSat,proto3" json:"total_fee_rebate_sat,omitempty"`
+}
+
+func (x *LeasesResponse) Reset() {
+	*x = LeasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasesResponse) ProtoMessage() {}
+
+func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasesResponse.ProtoReflect.Descriptor instead.
+func (*LeasesResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *LeasesResponse) GetLeases() []*Lease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+func (x *LeasesResponse) GetTotalAmtEarnedSat() uint64 {
+	if x != nil {
+		return x.TotalAmtEarnedSat
+	}
+	return 0
+}
+
+func (x *LeasesResponse) GetTotalAmtPaidSat() uint64 {
+	if x != nil {
+		return x.TotalAmtPaidSat
+	}
+	return 0
+}
+
+func (x *LeasesResponse) GetTotalFeeRebateSat() uint64 {
+	if x != nil {
+		return x.TotalFeeRebateSat
+	}
+	return 0
+}
+
+type LeasePerformanceReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Only include leases that were executed at or after the given unix
+	//timestamp in nanoseconds. If zero, leases are not filtered by a start time.
+	StartTimestampNs uint64 `protobuf:"varint,1,opt,name=start_timestamp_ns,json=startTimestampNs,proto3" json:"start_timestamp_ns,omitempty"`
+	//
+	//Only include leases that were executed at or before the given unix
+	//timestamp in nanoseconds. If zero, leases are not filtered by an end time.
+	EndTimestampNs uint64 `protobuf:"varint,2,opt,name=end_timestamp_ns,json=endTimestampNs,proto3" json:"end_timestamp_ns,omitempty"`
+	//
+	//An optional filter to only include the leases that were either purchased
+	//(taker) or sold (maker) by the trader.
+	RoleFilter LeaseRoleFilter `protobuf:"varint,3,opt,name=role_filter,json=roleFilter,proto3,enum=poolrpc.LeaseRoleFilter" json:"role_filter,omitempty"`
+}
+
+func (x *LeasePerformanceReportRequest) Reset() {
+	*x = LeasePerformanceReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasePerformanceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasePerformanceReportRequest) ProtoMessage() {}
+
+func (x *LeasePerformanceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasePerformanceReportRequest.ProtoReflect.Descriptor instead.
+func (*LeasePerformanceReportRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LeasePerformanceReportRequest) GetStartTimestampNs() uint64 {
+	if x != nil {
+		return x.StartTimestampNs
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportRequest) GetEndTimestampNs() uint64 {
+	if x != nil {
+		return x.EndTimestampNs
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportRequest) GetRoleFilter() LeaseRoleFilter {
+	if x != nil {
+		return x.RoleFilter
+	}
+	return LeaseRoleFilter_LEASE_ROLE_FILTER_ALL
+}
+
+type LeasePerformanceReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of leases the report was computed from.
+	NumLeases uint32 `protobuf:"varint,1,opt,name=num_leases,json=numLeases,proto3" json:"num_leases,omitempty"`
+	// The total amount, in satoshis, of all channels leased.
+	TotalChannelAmtSat uint64 `protobuf:"varint,2,opt,name=total_channel_amt_sat,json=totalChannelAmtSat,proto3" json:"total_channel_amt_sat,omitempty"`
+	// The total premium, in satoshis, earned from sold leases.
+	PremiumEarnedSat uint64 `protobuf:"varint,3,opt,name=premium_earned_sat,json=premiumEarnedSat,proto3" json:"premium_earned_sat,omitempty"`
+	// The total premium, in satoshis, paid for purchased leases.
+	PremiumPaidSat uint64 `protobuf:"varint,4,opt,name=premium_paid_sat,json=premiumPaidSat,proto3" json:"premium_paid_sat,omitempty"`
+	// The total execution fees, in satoshis, paid to the auctioneer.
+	ExecutionFeeSat uint64 `protobuf:"varint,5,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
+	// The total chain fees, in satoshis, paid for the batch transactions.
+	ChainFeeSat uint64 `protobuf:"varint,6,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
+	//
+	//The premium earned minus the premium, execution and chain fees paid, in
+	//satoshis. This is negative if more was paid than earned.
+	NetPremiumSat int64 `protobuf:"varint,7,opt,name=net_premium_sat,json=netPremiumSat,proto3" json:"net_premium_sat,omitempty"`
+	//
+	//The net premium expressed as an annual rate of the leased channel amounts,
+	//weighted by their lease durations. A value of 0.05 means 5% per year. This
+	//is negative if more was paid than earned.
+	EffectiveApr float64 `protobuf:"fixed64,8,opt,name=effective_apr,json=effectiveApr,proto3" json:"effective_apr,omitempty"`
+}
+
+func (x *LeasePerformanceReportResponse) Reset() {
+	*x = LeasePerformanceReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasePerformanceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasePerformanceReportResponse) ProtoMessage() {}
+
+func (x *LeasePerformanceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasePerformanceReportResponse.ProtoReflect.Descriptor instead.
+func (*LeasePerformanceReportResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *LeasePerformanceReportResponse) GetNumLeases() uint32 {
+	if x != nil {
+		return x.NumLeases
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetTotalChannelAmtSat() uint64 {
+	if x != nil {
+		return x.TotalChannelAmtSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetPremiumEarnedSat() uint64 {
+	if x != nil {
+		return x.PremiumEarnedSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetPremiumPaidSat() uint64 {
+	if x != nil {
+		return x.PremiumPaidSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetChainFeeSat() uint64 {
+	if x != nil {
+		return x.ChainFeeSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetNetPremiumSat() int64 {
+	if x != nil {
+		return x.NetPremiumSat
+	}
+	return 0
+}
+
+func (x *LeasePerformanceReportResponse) GetEffectiveApr() float64 {
+	if x != nil {
+		return x.EffectiveApr
+	}
+	return 0
+}
+
+type ListPendingBatchChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPendingBatchChannelsRequest) Reset() {
+	*x = ListPendingBatchChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingBatchChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingBatchChannelsRequest) ProtoMessage() {}
+
+func (x *ListPendingBatchChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingBatchChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingBatchChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{75}
+}
+
+type PendingBatchChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the channel in the batch transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The amount of the channel, in satoshis.
+	ChannelAmtSat uint64 `protobuf:"varint,2,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
+	// Whether the channel was purchased with a bid or sold with an ask.
+	Purchased bool `protobuf:"varint,3,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	// The identity key of the channel's peer.
+	ChannelNodeKey []byte `protobuf:"bytes,4,opt,name=channel_node_key,json=channelNodeKey,proto3" json:"channel_node_key,omitempty"`
+	// The state of the channel as reported by lnd.
+	State BatchChannelState `protobuf:"varint,5,opt,name=state,proto3,enum=poolrpc.BatchChannelState" json:"state,omitempty"`
+	//
+	//The number of confirmations of the batch transaction. This is only known
+	//once lnd reports the channel as open.
+	NumConfirmations uint32 `protobuf:"varint,6,opt,name=num_confirmations,json=numConfirmations,proto3" json:"num_confirmations,omitempty"`
+}
+
+func (x *PendingBatchChannel) Reset() {
+	*x = PendingBatchChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingBatchChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingBatchChannel) ProtoMessage() {}
+
+func (x *PendingBatchChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingBatchChannel.ProtoReflect.Descriptor instead.
+func (*PendingBatchChannel) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PendingBatchChannel) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *PendingBatchChannel) GetChannelAmtSat() uint64 {
+	if x != nil {
+		return x.ChannelAmtSat
+	}
+	return 0
+}
+
+func (x *PendingBatchChannel) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *PendingBatchChannel) GetChannelNodeKey() []byte {
+	if x != nil {
+		return x.ChannelNodeKey
+	}
+	return nil
+}
+
+func (x *PendingBatchChannel) GetState() BatchChannelState {
+	if x != nil {
+		return x.State
+	}
+	return BatchChannelState_BATCH_CHANNEL_STATE_UNKNOWN
+}
+
+func (x *PendingBatchChannel) GetNumConfirmations() uint32 {
+	if x != nil {
+		return x.NumConfirmations
+	}
+	return 0
+}
+
+type ListPendingBatchChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the last batch the trader participated in.
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The txid of the last batch's transaction.
+	BatchTxid string `protobuf:"bytes,2,opt,name=batch_txid,json=batchTxid,proto3" json:"batch_txid,omitempty"`
+	// The channels the trader expects to be opened by the last batch.
+	Channels []*PendingBatchChannel `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ListPendingBatchChannelsResponse) Reset() {
+	*x = ListPendingBatchChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingBatchChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingBatchChannelsResponse) ProtoMessage() {}
+
+func (x *ListPendingBatchChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingBatchChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingBatchChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListPendingBatchChannelsResponse) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *ListPendingBatchChannelsResponse) GetBatchTxid() string {
+	if x != nil {
+		return x.BatchTxid
+	}
+	return ""
+}
+
+func (x *ListPendingBatchChannelsResponse) GetChannels() []*PendingBatchChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type TokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokensRequest) Reset() {
+	*x = TokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensRequest) ProtoMessage() {}
+
+func (x *TokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
+func (*TokensRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{78}
+}
+
+type TokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//List of all tokens the daemon knows of, including old/expired tokens.
+	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *TokensResponse) Reset() {
+	*x = TokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensResponse) ProtoMessage() {}
+
+func (x *TokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
+func (*TokensResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TokensResponse) GetTokens() []*LsatToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LsatToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//The base macaroon that was baked by the auth server.
+	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
+	//*
+	//The payment hash of the payment that was paid to obtain the token.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//*
+	//The preimage of the payment hash, knowledge of this is proof that the
+	//payment has been paid. If the preimage is set to all zeros, this means the
+	//payment is still pending and the token is not yet fully valid.
+	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
+	//*
+	//The amount of millisatoshis that was paid to get the token.
+	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
+	//*
+	//The amount of millisatoshis paid in routing fee to pay for the token.
+	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
+	//*
+	//The creation time of the token as UNIX timestamp in seconds.
+	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	//*
+	//Indicates whether the token is expired or still valid.
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	//*
+	//Identifying attribute of this token in the store. Currently represents the
+	//file name of the token where it's stored on the file system.
+	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	//*
+	//The ID of the token as encoded in the base macaroon's identifier. This is
+	//what the auction server identifies the trader by.
+	TokenId []byte `protobuf:"bytes,9,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	//*
+	//Indicates whether this is the token that is currently used to authenticate
+	//with the auction server. Tokens the server no longer accepts are kept for
+	//reference but aren't current anymore.
+	Current bool `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *LsatToken) Reset() {
+	*x = LsatToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsatToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsatToken) ProtoMessage() {}
+
+func (x *LsatToken) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
+func (*LsatToken) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LsatToken) GetBaseMacaroon() []byte {
+	if x != nil {
+		return x.BaseMacaroon
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentPreimage() []byte {
+	if x != nil {
+		return x.PaymentPreimage
+	}
+	return nil
+}
+
+func (x *LsatToken) GetAmountPaidMsat() int64 {
+	if x != nil {
+		return x.AmountPaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+	if x != nil {
+		return x.RoutingFeePaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetTimeCreated() int64 {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return 0
+}
+
+func (x *LsatToken) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *LsatToken) GetStorageName() string {
+	if x != nil {
+		return x.StorageName
+	}
+	return ""
+}
+
+func (x *LsatToken) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *LsatToken) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type DeleteLsatTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The ID of the token to delete, as returned by GetLsatTokens.
+	TokenId []byte `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *DeleteLsatTokenRequest) Reset() {
+	*x = DeleteLsatTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLsatTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLsatTokenRequest) ProtoMessage() {}
+
+func (x *DeleteLsatTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLsatTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLsatTokenRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteLsatTokenRequest) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+type DeleteLsatTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLsatTokenResponse) Reset() {
+	*x = DeleteLsatTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLsatTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLsatTokenResponse) ProtoMessage() {}
+
+func (x *DeleteLsatTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLsatTokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLsatTokenResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{82}
+}
+
+type LeaseDurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaseDurationRequest) Reset() {
+	*x = LeaseDurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseDurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseDurationRequest) ProtoMessage() {}
+
+func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseDurationRequest.ProtoReflect.Descriptor instead.
+func (*LeaseDurationRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{83}
+}
+
+type LeaseDurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Deprecated, use lease_duration_buckets.
+	//
+	// Deprecated: Do not use.
+	LeaseDurations map[uint32]bool `protobuf:"bytes,1,rep,name=lease_durations,json=leaseDurations,proto3" json:"lease_durations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//
+	//The set of lease durations the market is currently accepting and the state
+	//the duration buckets currently are in.
+	LeaseDurationBuckets map[uint32]auctioneerrpc.DurationBucketState `protobuf:"bytes,2,rep,name=lease_duration_buckets,json=leaseDurationBuckets,proto3" json:"lease_duration_buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=poolrpc.DurationBucketState"`
+}
+
+func (x *LeaseDurationResponse) Reset() {
+	*x = LeaseDurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseDurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseDurationResponse) ProtoMessage() {}
+
+func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseDurationResponse.ProtoReflect.Descriptor instead.
+func (*LeaseDurationResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{84}
+}
+
+// Deprecated: Do not use.
+func (x *LeaseDurationResponse) GetLeaseDurations() map[uint32]bool {
+	if x != nil {
+		return x.LeaseDurations
+	}
+	return nil
+}
+
+func (x *LeaseDurationResponse) GetLeaseDurationBuckets() map[uint32]auctioneerrpc.DurationBucketState {
+	if x != nil {
+		return x.LeaseDurationBuckets
+	}
+	return nil
+}
+
+type NextBatchInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NextBatchInfoRequest) Reset() {
+	*x = NextBatchInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextBatchInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextBatchInfoRequest) ProtoMessage() {}
+
+func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextBatchInfoRequest.ProtoReflect.Descriptor instead.
+func (*NextBatchInfoRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{85}
+}
+
+type NextBatchInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The confirmation target the auctioneer will use for fee estimation of the
+	//next batch.
+	ConfTarget uint32 `protobuf:"varint,5,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The fee rate, in satoshis per kiloweight, estimated by the auctioneer to use
+	//for the next batch.
+	FeeRateSatPerKw uint64 `protobuf:"varint,6,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	//
+	//The absolute unix timestamp in seconds at which the auctioneer will attempt
+	//to clear the next batch.
+	ClearTimestamp uint64 `protobuf:"varint,7,opt,name=clear_timestamp,json=clearTimestamp,proto3" json:"clear_timestamp,omitempty"`
+	//
+	//The value used by the auctioneer to determine if an account expiry height
+	//needs to be extended after participating in a batch and for how long.
+	AutoRenewExtensionBlocks uint32 `protobuf:"varint,8,opt,name=auto_renew_extension_blocks,json=autoRenewExtensionBlocks,proto3" json:"auto_renew_extension_blocks,omitempty"`
+}
+
+func (x *NextBatchInfoResponse) Reset() {
+	*x = NextBatchInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextBatchInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextBatchInfoResponse) ProtoMessage() {}
+
+func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextBatchInfoResponse.ProtoReflect.Descriptor instead.
+func (*NextBatchInfoResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NextBatchInfoResponse) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetClearTimestamp() uint64 {
+	if x != nil {
+		return x.ClearTimestamp
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetAutoRenewExtensionBlocks() uint32 {
+	if x != nil {
+		return x.AutoRenewExtensionBlocks
+	}
+	return 0
+}
+
+type ServerParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerParamsRequest) Reset() {
+	*x = ServerParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerParamsRequest) ProtoMessage() {}
+
+func (x *ServerParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerParamsRequest.ProtoReflect.Descriptor instead.
+func (*ServerParamsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{87}
+}
+
+type ServerParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The smallest amount, in satoshis, an order can be submitted for. Order
+	//amounts must always be a multiple of this value.
+	MinOrderAmtSat uint64 `protobuf:"varint,1,opt,name=min_order_amt_sat,json=minOrderAmtSat,proto3" json:"min_order_amt_sat,omitempty"`
+	//
+	//The maximum value, in satoshis, an account can hold. This also caps the
+	//amount of a single order.
+	MaxAccountValueSat uint64 `protobuf:"varint,2,opt,name=max_account_value_sat,json=maxAccountValueSat,proto3" json:"max_account_value_sat,omitempty"`
+	//
+	//The set of lease durations the market is currently accepting and the state
+	//the duration buckets currently are in.
+	LeaseDurationBuckets map[uint32]auctioneerrpc.DurationBucketState `protobuf:"bytes,3,rep,name=lease_duration_buckets,json=leaseDurationBuckets,proto3" json:"lease_duration_buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=poolrpc.DurationBucketState"`
+	//
+	//The execution fee charged per matched order.
+	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,4,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+	//
+	//The minimum duration, in blocks, a bid's min duration or an ask's max
+	//duration can be set to.
+	MinOrderDurationBlocks uint32 `protobuf:"varint,5,opt,name=min_order_duration_blocks,json=minOrderDurationBlocks,proto3" json:"min_order_duration_blocks,omitempty"`
+	//
+	//The smallest fixed rate, in parts per billion per block, an order can be
+	//submitted with. This is the configured rate tick size if order rates must
+	//be a multiple of it.
+	MinRateFixed uint32 `protobuf:"varint,6,opt,name=min_rate_fixed,json=minRateFixed,proto3" json:"min_rate_fixed,omitempty"`
+}
+
+func (x *ServerParamsResponse) Reset() {
+	*x = ServerParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerParamsResponse) ProtoMessage() {}
+
+func (x *ServerParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerParamsResponse.ProtoReflect.Descriptor instead.
+func (*ServerParamsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ServerParamsResponse) GetMinOrderAmtSat() uint64 {
+	if x != nil {
+		return x.MinOrderAmtSat
+	}
+	return 0
+}
+
+func (x *ServerParamsResponse) GetMaxAccountValueSat() uint64 {
+	if x != nil {
+		return x.MaxAccountValueSat
+	}
+	return 0
+}
+
+func (x *ServerParamsResponse) GetLeaseDurationBuckets() map[uint32]auctioneerrpc.DurationBucketState {
+	if x != nil {
+		return x.LeaseDurationBuckets
+	}
+	return nil
+}
+
+func (x *ServerParamsResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+	if x != nil {
+		return x.ExecutionFee
+	}
+	return nil
+}
+
+func (x *ServerParamsResponse) GetMinOrderDurationBlocks() uint32 {
+	if x != nil {
+		return x.MinOrderDurationBlocks
+	}
+	return 0
+}
+
+func (x *ServerParamsResponse) GetMinRateFixed() uint32 {
+	if x != nil {
+		return x.MinRateFixed
+	}
+	return 0
+}
+
+type ListBatchConfirmationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBatchConfirmationsRequest) Reset() {
+	*x = ListBatchConfirmationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchConfirmationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchConfirmationsRequest) ProtoMessage() {}
+
+func (x *ListBatchConfirmationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchConfirmationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{89}
+}
+
+type BatchConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the batch that is waiting to be confirmed.
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	//
+	//The total premium, in satoshis, the asks of the trader would earn in the
+	//batch.
+	PremiumEarnedSat uint64 `protobuf:"varint,2,opt,name=premium_earned_sat,json=premiumEarnedSat,proto3" json:"premium_earned_sat,omitempty"`
+	//
+	//The total premium, in satoshis, the bids of the trader would pay in the
+	//batch.
+	PremiumPaidSat uint64 `protobuf:"varint,3,opt,name=premium_paid_sat,json=premiumPaidSat,proto3" json:"premium_paid_sat,omitempty"`
+	//
+	//The estimated execution and chain fees, in satoshis, the trader would pay
+	//in the batch.
+	FeesSat uint64 `protobuf:"varint,4,opt,name=fees_sat,json=feesSat,proto3" json:"fees_sat,omitempty"`
+	// The reason the batch isn't accepted automatically.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	//
+	//The unix timestamp in nanoseconds after which the batch is rejected if it
+	//wasn't confirmed.
+	ExpiryTimestampNs int64 `protobuf:"varint,6,opt,name=expiry_timestamp_ns,json=expiryTimestampNs,proto3" json:"expiry_timestamp_ns,omitempty"`
+}
+
+func (x *BatchConfirmation) Reset() {
+	*x = BatchConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConfirmation) ProtoMessage() {}
+
+func (x *BatchConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConfirmation.ProtoReflect.Descriptor instead.
+func (*BatchConfirmation) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BatchConfirmation) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *BatchConfirmation) GetPremiumEarnedSat() uint64 {
+	if x != nil {
+		return x.PremiumEarnedSat
+	}
+	return 0
+}
+
+func (x *BatchConfirmation) GetPremiumPaidSat() uint64 {
+	if x != nil {
+		return x.PremiumPaidSat
+	}
+	return 0
+}
+
+func (x *BatchConfirmation) GetFeesSat() uint64 {
+	if x != nil {
+		return x.FeesSat
+	}
+	return 0
+}
+
+func (x *BatchConfirmation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchConfirmation) GetExpiryTimestampNs() int64 {
+	if x != nil {
+		return x.ExpiryTimestampNs
+	}
+	return 0
+}
+
+type ListBatchConfirmationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batches that are waiting to be confirmed.
+	Batches []*BatchConfirmation `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ListBatchConfirmationsResponse) Reset() {
+	*x = ListBatchConfirmationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchConfirmationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchConfirmationsResponse) ProtoMessage() {}
+
+func (x *ListBatchConfirmationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchConfirmationsResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListBatchConfirmationsResponse) GetBatches() []*BatchConfirmation {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type SubscribeBatchConfirmationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeBatchConfirmationsRequest) Reset() {
+	*x = SubscribeBatchConfirmationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeBatchConfirmationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBatchConfirmationsRequest) ProtoMessage() {}
+
+func (x *SubscribeBatchConfirmationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBatchConfirmationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBatchConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{92}
+}
+
+type AcceptBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the batch to accept or reject.
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Reject the batch instead of accepting it.
+	Reject bool `protobuf:"varint,2,opt,name=reject,proto3" json:"reject,omitempty"`
+}
+
+func (x *AcceptBatchRequest) Reset() {
+	*x = AcceptBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptBatchRequest) ProtoMessage() {}
+
+func (x *AcceptBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptBatchRequest.ProtoReflect.Descriptor instead.
+func (*AcceptBatchRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AcceptBatchRequest) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *AcceptBatchRequest) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+type AcceptBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptBatchResponse) Reset() {
+	*x = AcceptBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptBatchResponse) ProtoMessage() {}
+
+func (x *AcceptBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptBatchResponse.ProtoReflect.Descriptor instead.
+func (*AcceptBatchResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{94}
+}
+
+type NodeRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target node to obtain ratings information for.
+	NodePubkeys [][]byte `protobuf:"bytes,1,rep,name=node_pubkeys,json=nodePubkeys,proto3" json:"node_pubkeys,omitempty"`
+}
+
+func (x *NodeRatingRequest) Reset() {
+	*x = NodeRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRatingRequest) ProtoMessage() {}
+
+func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRatingRequest.ProtoReflect.Descriptor instead.
+func (*NodeRatingRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *NodeRatingRequest) GetNodePubkeys() [][]byte {
+	if x != nil {
+		return x.NodePubkeys
+	}
+	return nil
+}
+
+type NodeRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A series of node ratings for each of the queried nodes.
+	NodeRatings []*auctioneerrpc.NodeRating `protobuf:"bytes,1,rep,name=node_ratings,json=nodeRatings,proto3" json:"node_ratings,omitempty"`
+}
+
+func (x *NodeRatingResponse) Reset() {
+	*x = NodeRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRatingResponse) ProtoMessage() {}
+
+func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRatingResponse.ProtoReflect.Descriptor instead.
+func (*NodeRatingResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *NodeRatingResponse) GetNodeRatings() []*auctioneerrpc.NodeRating {
+	if x != nil {
+		return x.NodeRatings
+	}
+	return nil
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{97}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the Pool daemon that is running.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The total number of accounts in the local database.
+	AccountsTotal uint32 `protobuf:"varint,2,opt,name=accounts_total,json=accountsTotal,proto3" json:"accounts_total,omitempty"`
+	//
+	//The total number of accounts that are in an active, non-archived state,
+	//including expired accounts.
+	AccountsActive uint32 `protobuf:"varint,3,opt,name=accounts_active,json=accountsActive,proto3" json:"accounts_active,omitempty"`
+	// The total number of accounts that are active but have expired.
+	AccountsActiveExpired uint32 `protobuf:"varint,4,opt,name=accounts_active_expired,json=accountsActiveExpired,proto3" json:"accounts_active_expired,omitempty"`
+	// The total number of accounts that are in an archived/closed state.
+	AccountsArchived uint32 `protobuf:"varint,5,opt,name=accounts_archived,json=accountsArchived,proto3" json:"accounts_archived,omitempty"`
+	// The total number of orders in the local database.
+	OrdersTotal uint32 `protobuf:"varint,6,opt,name=orders_total,json=ordersTotal,proto3" json:"orders_total,omitempty"`
+	//
+	//The total number of active/pending orders that are still waiting for
+	//execution.
+	OrdersActive uint32 `protobuf:"varint,7,opt,name=orders_active,json=ordersActive,proto3" json:"orders_active,omitempty"`
+	// The total number of orders that have been archived.
+	OrdersArchived uint32 `protobuf:"varint,8,opt,name=orders_archived,json=ordersArchived,proto3" json:"orders_archived,omitempty"`
+	// The current block height as seen by the connected lnd node.
+	CurrentBlockHeight uint32 `protobuf:"varint,9,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
+	// The number of batches an account of this node was ever involved in.
+	BatchesInvolved uint32 `protobuf:"varint,10,opt,name=batches_involved,json=batchesInvolved,proto3" json:"batches_involved,omitempty"`
+	// Our lnd node's rating as judged by the auctioneer server.
+	NodeRating *auctioneerrpc.NodeRating `protobuf:"bytes,11,opt,name=node_rating,json=nodeRating,proto3" json:"node_rating,omitempty"`
+	// The number of available LSAT tokens.
+	LsatTokens uint32 `protobuf:"varint,12,opt,name=lsat_tokens,json=lsatTokens,proto3" json:"lsat_tokens,omitempty"`
+	//
+	//Indicates whether there is an active subscription connection to the
+	//auctioneer. This will never be true if there is no active account. If there
+	//are active accounts, this value represents the network connection status to
+	//the auctioneer server.
+	SubscribedToAuctioneer bool `protobuf:"varint,13,opt,name=subscribed_to_auctioneer,json=subscribedToAuctioneer,proto3" json:"subscribed_to_auctioneer,omitempty"`
+	//
+	//Indicates whether the global `--newnodesonly` command line flag or
+	//`newnodesonly=true` configuration parameter was set on the Pool trader
+	//daemon.
+	NewNodesOnly bool `protobuf:"varint,14,opt,name=new_nodes_only,json=newNodesOnly,proto3" json:"new_nodes_only,omitempty"`
+	//
+	//A map of all markets identified by their lease duration and the current
+	//set of statistics such as number of open orders and total units of open
+	//interest.
+	MarketInfo map[uint32]*auctioneerrpc.MarketInfo `protobuf:"bytes,15,rep,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The alias this trader daemon identifies itself with to the auction server,
+	//as set with the `clientalias` configuration parameter.
+	ClientAlias string `protobuf:"bytes,16,opt,name=client_alias,json=clientAlias,proto3" json:"client_alias,omitempty"`
+	//
+	//The lease duration in blocks the trader daemon uses for bids that don't
+	//specify one, as set with the `defaultleaseduration` configuration
+	//parameter. 0 means bids always need to specify a lease duration.
+	DefaultLeaseDurationBlocks uint32 `protobuf:"varint,17,opt,name=default_lease_duration_blocks,json=defaultLeaseDurationBlocks,proto3" json:"default_lease_duration_blocks,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetAccountsTotal() uint32 {
+	if x != nil {
+		return x.AccountsTotal
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsActive() uint32 {
+	if x != nil {
+		return x.AccountsActive
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsActiveExpired() uint32 {
+	if x != nil {
+		return x.AccountsActiveExpired
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsArchived() uint32 {
+	if x != nil {
+		return x.AccountsArchived
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersTotal() uint32 {
+	if x != nil {
+		return x.OrdersTotal
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersActive() uint32 {
+	if x != nil {
+		return x.OrdersActive
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersArchived() uint32 {
+	if x != nil {
+		return x.OrdersArchived
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetCurrentBlockHeight() uint32 {
+	if x != nil {
+		return x.CurrentBlockHeight
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetBatchesInvolved() uint32 {
+	if x != nil {
+		return x.BatchesInvolved
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetNodeRating() *auctioneerrpc.NodeRating {
+	if x != nil {
+		return x.NodeRating
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetLsatTokens() uint32 {
+	if x != nil {
+		return x.LsatTokens
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetSubscribedToAuctioneer() bool {
+	if x != nil {
+		return x.SubscribedToAuctioneer
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetNewNodesOnly() bool {
+	if x != nil {
+		return x.NewNodesOnly
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetMarketInfo() map[uint32]*auctioneerrpc.MarketInfo {
+	if x != nil {
+		return x.MarketInfo
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetClientAlias() string {
+	if x != nil {
+		return x.ClientAlias
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetDefaultLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.DefaultLeaseDurationBlocks
+	}
+	return 0
+}
+
+type StopDaemonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopDaemonRequest) Reset() {
+	*x = StopDaemonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopDaemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDaemonRequest) ProtoMessage() {}
+
+func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopDaemonRequest.ProtoReflect.Descriptor instead.
+func (*StopDaemonRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{99}
+}
+
+type StopDaemonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopDaemonResponse) Reset() {
+	*x = StopDaemonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopDaemonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDaemonResponse) ProtoMessage() {}
+
+func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopDaemonResponse.ProtoReflect.Descriptor instead.
+func (*StopDaemonResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{100}
+}
+
+type GetTLSInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTLSInfoRequest) Reset() {
+	*x = GetTLSInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTLSInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTLSInfoRequest) ProtoMessage() {}
+
+func (x *GetTLSInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTLSInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetTLSInfoRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{101}
+}
+
+type GetTLSInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The common name of the certificate.
+	CommonName string `protobuf:"bytes,1,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// The unix timestamp in seconds from which on the certificate is valid.
+	NotBefore int64 `protobuf:"varint,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// The unix timestamp in seconds at which the certificate expires.
+	NotAfter int64 `protobuf:"varint,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// The DNS names the certificate is valid for.
+	DnsNames []string `protobuf:"bytes,4,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	// The IP addresses the certificate is valid for.
+	IpAddresses []string `protobuf:"bytes,5,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	// The SHA-256 fingerprint of the DER encoded certificate.
+	FingerprintSha256 []byte `protobuf:"bytes,6,opt,name=fingerprint_sha256,json=fingerprintSha256,proto3" json:"fingerprint_sha256,omitempty"`
+}
+
+func (x *GetTLSInfoResponse) Reset() {
+	*x = GetTLSInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTLSInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTLSInfoResponse) ProtoMessage() {}
+
+func (x *GetTLSInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTLSInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetTLSInfoResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetTLSInfoResponse) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *GetTLSInfoResponse) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *GetTLSInfoResponse) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+func (x *GetTLSInfoResponse) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *GetTLSInfoResponse) GetIpAddresses() []string {
+	if x != nil {
+		return x.IpAddresses
+	}
+	return nil
+}
+
+func (x *GetTLSInfoResponse) GetFingerprintSha256() []byte {
+	if x != nil {
+		return x.FingerprintSha256
+	}
+	return nil
+}
+
+type TestConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TestConnectionsRequest) Reset() {
+	*x = TestConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionsRequest) ProtoMessage() {}
+
+func (x *TestConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*TestConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{103}
+}
+
+type ConnectionTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the endpoint that was tested, either lnd or auctioneer.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The address the endpoint was dialed at.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the endpoint could be reached and responded to a request.
+	Reachable bool `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// The error that occurred while trying to reach the endpoint, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The time in milliseconds it took to dial the endpoint and get a
+	// response.
+	LatencyMs int64 `protobuf:"varint,5,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+}
+
+func (x *ConnectionTestResult) Reset() {
+	*x = ConnectionTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionTestResult) ProtoMessage() {}
+
+func (x *ConnectionTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionTestResult.ProtoReflect.Descriptor instead.
+func (*ConnectionTestResult) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ConnectionTestResult) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ConnectionTestResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ConnectionTestResult) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *ConnectionTestResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConnectionTestResult) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type TestConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results of the connection tests, one for each endpoint.
+	Results []*ConnectionTestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TestConnectionsResponse) Reset() {
+	*x = TestConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionsResponse) ProtoMessage() {}
+
+func (x *TestConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*TestConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TestConnectionsResponse) GetResults() []*ConnectionTestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetConnectionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConnectionStatusRequest) Reset() {
+	*x = GetConnectionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionStatusRequest) ProtoMessage() {}
+
+func (x *GetConnectionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{106}
+}
+
+type LndConnectionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the daemon is currently connected to lnd.
+	Connected bool `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	// The error that caused the last disconnect from lnd, if any.
+	LastError string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The unix timestamp in nanoseconds at which the connection state last
+	// changed.
+	ChangedAtNs uint64 `protobuf:"varint,3,opt,name=changed_at_ns,json=changedAtNs,proto3" json:"changed_at_ns,omitempty"`
+	// The number of times the daemon reconnected to lnd since it started.
+	NumReconnects uint32 `protobuf:"varint,4,opt,name=num_reconnects,json=numReconnects,proto3" json:"num_reconnects,omitempty"`
+}
+
+func (x *LndConnectionStatus) Reset() {
+	*x = LndConnectionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndConnectionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndConnectionStatus) ProtoMessage() {}
+
+func (x *LndConnectionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndConnectionStatus.ProtoReflect.Descriptor instead.
+func (*LndConnectionStatus) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *LndConnectionStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *LndConnectionStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *LndConnectionStatus) GetChangedAtNs() uint64 {
+	if x != nil {
+		return x.ChangedAtNs
+	}
+	return 0
+}
+
+func (x *LndConnectionStatus) GetNumReconnects() uint32 {
+	if x != nil {
+		return x.NumReconnects
+	}
+	return 0
+}
+
+type GetConnectionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the connection to lnd.
+	Lnd *LndConnectionStatus `protobuf:"bytes,1,opt,name=lnd,proto3" json:"lnd,omitempty"`
+}
+
+func (x *GetConnectionStatusResponse) Reset() {
+	*x = GetConnectionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionStatusResponse) ProtoMessage() {}
+
+func (x *GetConnectionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetConnectionStatusResponse) GetLnd() *LndConnectionStatus {
+	if x != nil {
+		return x.Lnd
+	}
+	return nil
+}
+
+type DebugDumpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DebugDumpRequest) Reset() {
+	*x = DebugDumpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugDumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugDumpRequest) ProtoMessage() {}
+
+func (x *DebugDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugDumpRequest.ProtoReflect.Descriptor instead.
+func (*DebugDumpRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{109}
+}
+
+type DebugDumpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of goroutines that currently exist.
+	NumGoroutines uint32 `protobuf:"varint,1,opt,name=num_goroutines,json=numGoroutines,proto3" json:"num_goroutines,omitempty"`
+	// The number of bytes of allocated heap objects.
+	HeapAllocBytes uint64 `protobuf:"varint,2,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	// The number of bytes of heap memory obtained from the OS.
+	HeapSysBytes uint64 `protobuf:"varint,3,opt,name=heap_sys_bytes,json=heapSysBytes,proto3" json:"heap_sys_bytes,omitempty"`
+	// The number of allocated heap objects.
+	HeapObjects uint64 `protobuf:"varint,4,opt,name=heap_objects,json=heapObjects,proto3" json:"heap_objects,omitempty"`
+	// The cumulative number of bytes allocated for heap objects.
+	TotalAllocBytes uint64 `protobuf:"varint,5,opt,name=total_alloc_bytes,json=totalAllocBytes,proto3" json:"total_alloc_bytes,omitempty"`
+	// The number of completed garbage collection cycles.
+	NumGc uint32 `protobuf:"varint,6,opt,name=num_gc,json=numGc,proto3" json:"num_gc,omitempty"`
+	// The version of Go the daemon was built with.
+	GoVersion string `protobuf:"bytes,7,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	//
+	//The active configuration of the daemon, keyed by the name of the config
+	//option. Values of options that may contain secrets are redacted.
+	Config map[string]string `protobuf:"bytes,8,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DebugDumpResponse) Reset() {
+	*x = DebugDumpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugDumpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugDumpResponse) ProtoMessage() {}
+
+func (x *DebugDumpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugDumpResponse.ProtoReflect.Descriptor instead.
+func (*DebugDumpResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DebugDumpResponse) GetNumGoroutines() uint32 {
+	if x != nil {
+		return x.NumGoroutines
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetHeapAllocBytes() uint64 {
+	if x != nil {
+		return x.HeapAllocBytes
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetHeapSysBytes() uint64 {
+	if x != nil {
+		return x.HeapSysBytes
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetHeapObjects() uint64 {
+	if x != nil {
+		return x.HeapObjects
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetTotalAllocBytes() uint64 {
+	if x != nil {
+		return x.TotalAllocBytes
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetNumGc() uint32 {
+	if x != nil {
+		return x.NumGc
+	}
+	return 0
+}
+
+func (x *DebugDumpResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *DebugDumpResponse) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ExportStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportStateRequest) Reset() {
+	*x = ExportStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateRequest) ProtoMessage() {}
+
+func (x *ExportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{111}
+}
+
+type ExportStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized accounts, orders and batch snapshots of the daemon.
+	State []byte `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// The number of accounts contained in the state.
+	NumAccounts uint32 `protobuf:"varint,2,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+	// The number of orders contained in the state.
+	NumOrders uint32 `protobuf:"varint,3,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty"`
+	// The number of batch snapshots contained in the state.
+	NumBatches uint32 `protobuf:"varint,4,opt,name=num_batches,json=numBatches,proto3" json:"num_batches,omitempty"`
+}
+
+func (x *ExportStateResponse) Reset() {
+	*x = ExportStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateResponse) ProtoMessage() {}
+
+func (x *ExportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExportStateResponse) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ExportStateResponse) GetNumAccounts() uint32 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+func (x *ExportStateResponse) GetNumOrders() uint32 {
+	if x != nil {
+		return x.NumOrders
+	}
+	return 0
+}
+
+func (x *ExportStateResponse) GetNumBatches() uint32 {
+	if x != nil {
+		return x.NumBatches
+	}
+	return 0
+}
+
+type ImportStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized state as returned by ExportState.
+	State []byte `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *ImportStateRequest) Reset() {
+	*x = ImportStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateRequest) ProtoMessage() {}
+
+func (x *ImportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateRequest.ProtoReflect.Descriptor instead.
+func (*ImportStateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ImportStateRequest) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type ImportStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of accounts that were imported.
+	NumAccounts uint32 `protobuf:"varint,1,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+	// The number of orders that were imported.
+	NumOrders uint32 `protobuf:"varint,2,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty"`
+	// The number of batch snapshots that were imported.
+	NumBatches uint32 `protobuf:"varint,3,opt,name=num_batches,json=numBatches,proto3" json:"num_batches,omitempty"`
+}
+
+func (x *ImportStateResponse) Reset() {
+	*x = ImportStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateResponse) ProtoMessage() {}
+
+func (x *ImportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateResponse.ProtoReflect.Descriptor instead.
+func (*ImportStateResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ImportStateResponse) GetNumAccounts() uint32 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetNumOrders() uint32 {
+	if x != nil {
+		return x.NumOrders
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetNumBatches() uint32 {
+	if x != nil {
+		return x.NumBatches
+	}
+	return 0
+}
+
+type OfferSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If false, then only the trader_key, unit, self_chan_balance, and
+	//lease_duration_blocks need to be set in the bid below. Otherwise, the
+	//fields as they're set when submitting a bid need to be filled in.
+	AutoNegotiate bool `protobuf:"varint,1,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//
+	//The bid template that will be used to populate the initial sidecar ticket
+	//as well as auto negotiate the remainig steps of the sidecar channel if
+	//needed.
+	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+}
+
+func (x *OfferSidecarRequest) Reset() {
+	*x = OfferSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferSidecarRequest) ProtoMessage() {}
+
+func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferSidecarRequest.ProtoReflect.Descriptor instead.
+func (*OfferSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *OfferSidecarRequest) GetAutoNegotiate() bool {
+	if x != nil {
+		return x.AutoNegotiate
+	}
+	return false
+}
+
+func (x *OfferSidecarRequest) GetBid() *Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+type SidecarTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The complete sidecar ticket in its string encoded form which is base58
+	//encoded, has a human readable prefix ('sidecar...') and a checksum built in.
+	//The string encoded version will only be used on the trader side of the API.
+	//All requests to the auctioneer expect the ticket to be in its raw, tlv
+	//encoded byte form.
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *SidecarTicket) Reset() {
+	*x = SidecarTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidecarTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarTicket) ProtoMessage() {}
+
+func (x *SidecarTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarTicket.ProtoReflect.Descriptor instead.
+func (*SidecarTicket) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SidecarTicket) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type DecodedSidecarTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique, pseudorandom identifier of the ticket.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The version of the ticket encoding format.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The state of the ticket.
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// The offered channel capacity in satoshis.
+	OfferCapacity uint64 `protobuf:"varint,4,opt,name=offer_capacity,json=offerCapacity,proto3" json:"offer_capacity,omitempty"`
+	// The offered push amount in satoshis.
+	OfferPushAmount uint64 `protobuf:"varint,5,opt,name=offer_push_amount,json=offerPushAmount,proto3" json:"offer_push_amount,omitempty"`
+	// The offered lease duration in blocks.
+	OfferLeaseDurationBlocks uint32 `protobuf:"varint,6,opt,name=offer_lease_duration_blocks,json=offerLeaseDurationBlocks,proto3" json:"offer_lease_duration_blocks,omitempty"`
+	// The public key the offer was signed with.
+	OfferSignPubkey []byte `protobuf:"bytes,7,opt,name=offer_sign_pubkey,json=offerSignPubkey,proto3" json:"offer_sign_pubkey,omitempty"`
+	// The signature over the offer's digest.
+	OfferSignature []byte `protobuf:"bytes,8,opt,name=offer_signature,json=offerSignature,proto3" json:"offer_signature,omitempty"`
+	// Whether the offer was created with the automatic order creation flag.
+	OfferAuto bool `protobuf:"varint,9,opt,name=offer_auto,json=offerAuto,proto3" json:"offer_auto,omitempty"`
+	// The recipient node's public identity key.
+	RecipientNodePubkey []byte `protobuf:"bytes,10,opt,name=recipient_node_pubkey,json=recipientNodePubkey,proto3" json:"recipient_node_pubkey,omitempty"`
+	//
+	//The recipient node's channel multisig public key to be used for the sidecar
+	//channel.
+	RecipientMultisigPubkey []byte `protobuf:"bytes,11,opt,name=recipient_multisig_pubkey,json=recipientMultisigPubkey,proto3" json:"recipient_multisig_pubkey,omitempty"`
+	// The index used when deriving the above multisig pubkey.
+	RecipientMultisigPubkeyIndex uint32 `protobuf:"varint,12,opt,name=recipient_multisig_pubkey_index,json=recipientMultisigPubkeyIndex,proto3" json:"recipient_multisig_pubkey_index,omitempty"`
+	// The nonce of the bid order created for this sidecar ticket.
+	OrderBidNonce []byte `protobuf:"bytes,13,opt,name=order_bid_nonce,json=orderBidNonce,proto3" json:"order_bid_nonce,omitempty"`
+	//
+	//The signature over the order's digest, signed with the private key that
+	//corresponds to the offer_sign_pubkey.
+	OrderSignature []byte `protobuf:"bytes,14,opt,name=order_signature,json=orderSignature,proto3" json:"order_signature,omitempty"`
+	// The pending channel ID of the sidecar channel during the execution phase.
+	ExecutionPendingChannelId []byte `protobuf:"bytes,15,opt,name=execution_pending_channel_id,json=executionPendingChannelId,proto3" json:"execution_pending_channel_id,omitempty"`
+	// The original, base58 encoded ticket.
+	EncodedTicket string `protobuf:"bytes,16,opt,name=encoded_ticket,json=encodedTicket,proto3" json:"encoded_ticket,omitempty"`
+	// If true, the channel acceptor for this ticket will expect an unannounced
+	// channel.
+	OfferUnannouncedChannel bool `protobuf:"varint,17,opt,name=offer_unannounced_channel,json=offerUnannouncedChannel,proto3" json:"offer_unannounced_channel,omitempty"`
+	// If true, the channel acceptor for this ticket will expect a zero conf
+	// channel.
+	OfferZeroConfChannel bool `protobuf:"varint,18,opt,name=offer_zero_conf_channel,json=offerZeroConfChannel,proto3" json:"offer_zero_conf_channel,omitempty"`
+}
+
+func (x *DecodedSidecarTicket) Reset() {
+	*x = DecodedSidecarTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedSidecarTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedSidecarTicket) ProtoMessage() {}
+
+func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedSidecarTicket.ProtoReflect.Descriptor instead.
+func (*DecodedSidecarTicket) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DecodedSidecarTicket) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DecodedSidecarTicket) GetOfferCapacity() uint64 {
+	if x != nil {
+		return x.OfferCapacity
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferPushAmount() uint64 {
+	if x != nil {
+		return x.OfferPushAmount
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.OfferLeaseDurationBlocks
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferSignPubkey() []byte {
+	if x != nil {
+		return x.OfferSignPubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOfferSignature() []byte {
+	if x != nil {
+		return x.OfferSignature
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOfferAuto() bool {
+	if x != nil {
+		return x.OfferAuto
+	}
+	return false
+}
+
+func (x *DecodedSidecarTicket) GetRecipientNodePubkey() []byte {
+	if x != nil {
+		return x.RecipientNodePubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetRecipientMultisigPubkey() []byte {
+	if x != nil {
+		return x.RecipientMultisigPubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetRecipientMultisigPubkeyIndex() uint32 {
+	if x != nil {
+		return x.RecipientMultisigPubkeyIndex
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOrderBidNonce() []byte {
+	if x != nil {
+		return x.OrderBidNonce
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOrderSignature() []byte {
+	if x != nil {
+		return x.OrderSignature
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetExecutionPendingChannelId() []byte {
+	if x != nil {
+		return x.ExecutionPendingChannelId
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetEncodedTicket() string {
+	if x != nil {
+		return x.EncodedTicket
+	}
+	return ""
+}
+
+func (x *DecodedSidecarTicket) GetOfferUnannouncedChannel() bool {
+	if x != nil {
+		return x.OfferUnannouncedChannel
+	}
+	return false
+}
+
+func (x *DecodedSidecarTicket) GetOfferZeroConfChannel() bool {
+	if x != nil {
+		return x.OfferZeroConfChannel
+	}
+	return false
+}
+
+type RegisterSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The sidecar ticket to register and add the node and channel funding
+	//information to. The ticket must be in the state "offered".
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	//
+	//If this value is True, then the daemon will attempt to finish negotiating
+	//the details of the sidecar channel automatically in the background. The
+	//progress of the ticket can be monitored using the SidecarState RPC. In
+	//addition, if this flag is set, then this method will _block_ until the
+	//sidecar negotiation either finishes or breaks down.
+	AutoNegotiate bool `protobuf:"varint,2,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+}
+
+func (x *RegisterSidecarRequest) Reset() {
+	*x = RegisterSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSidecarRequest) ProtoMessage() {}
+
+func (x *RegisterSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSidecarRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RegisterSidecarRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *RegisterSidecarRequest) GetAutoNegotiate() bool {
+	if x != nil {
+		return x.AutoNegotiate
+	}
+	return false
+}
+
+type ExpectSidecarChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The sidecar ticket to expect an incoming channel for. The ticket must be in
+	//the state "ordered".
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *ExpectSidecarChannelRequest) Reset() {
+	*x = ExpectSidecarChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectSidecarChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectSidecarChannelRequest) ProtoMessage() {}
+
+func (x *ExpectSidecarChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectSidecarChannelRequest.ProtoReflect.Descriptor instead.
+func (*ExpectSidecarChannelRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ExpectSidecarChannelRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type ExpectSidecarChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpectSidecarChannelResponse) Reset() {
+	*x = ExpectSidecarChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectSidecarChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectSidecarChannelResponse) ProtoMessage() {}
+
+func (x *ExpectSidecarChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectSidecarChannelResponse.ProtoReflect.Descriptor instead.
+func (*ExpectSidecarChannelResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{120}
+}
+
+type ListSidecarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The optional sidecar ID to filter for. If set, the result should either be
+	//a single ticket or no ticket in most cases. But because the ID is just 8
+	//bytes and is randomly generated, there could be collisions, especially since
+	//tickets can also be crafted by a malicious party and given to any node.
+	//That's why the offer's public key is also used as an identifying element
+	//since that cannot easily be forged without also producing a valid signature.
+	//So an attacker cannot overwrite a ticket a node offered by themselves
+	//offering a ticket with the same ID and tricking the victim into registering
+	//that. Long story sort, there could be multiple tickets with the same ID but
+	//different offer public keys, which is why those keys should be checked as
+	//well.
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+}
+
+func (x *ListSidecarsRequest) Reset() {
+	*x = ListSidecarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSidecarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSidecarsRequest) ProtoMessage() {}
+
+func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSidecarsRequest.ProtoReflect.Descriptor instead.
+func (*ListSidecarsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListSidecarsRequest) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+type ListSidecarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*DecodedSidecarTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *ListSidecarsResponse) Reset() {
+	*x = ListSidecarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSidecarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSidecarsResponse) ProtoMessage() {}
+
+func (x *ListSidecarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSidecarsResponse.ProtoReflect.Descriptor instead.
+func (*ListSidecarsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListSidecarsResponse) GetTickets() []*DecodedSidecarTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type CancelSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+}
+
+func (x *CancelSidecarRequest) Reset() {
+	*x = CancelSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSidecarRequest) ProtoMessage() {}
+
+func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSidecarRequest.ProtoReflect.Descriptor instead.
+func (*CancelSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CancelSidecarRequest) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+type CancelSidecarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelSidecarResponse) Reset() {
+	*x = CancelSidecarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSidecarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSidecarResponse) ProtoMessage() {}
+
+func (x *CancelSidecarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSidecarResponse.ProtoReflect.Descriptor instead.
+func (*CancelSidecarResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{124}
+}
+
+var File_trader_proto protoreflect.FileDescriptor
+
+var file_trader_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x1a, 0x1e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
+	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42,
+	0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x1a, 0x49, 0x6e, 0x69, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61,
+	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x69, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x5d, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x22, 0x3d,
+	0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0xd7, 0x02,
+	0x0a, 0x1c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
+	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42,
+	0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x61, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x1d, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x77, 0x0a,
+	0x14, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6b, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x17,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x22, 0x5e, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x74, 0x22, 0x68, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x4a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3f, 0x0a,
+	0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x42, 0x06, 0x0a,
+	0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x65, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46,
+	0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x46, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63,
+	0x69, 0x74, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x42, 0x13, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x22, 0xb2, 0x02, 0x0a,
+	0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12,
+	0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x22, 0x6a, 0x0a, 0x17, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x69, 0x64, 0x22, 0xe9, 0x01,
+	0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31,
+	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x78, 0x69, 0x64,
+	0x22, 0x8d, 0x01, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77,
+	0x22, 0x62, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x54, 0x78, 0x69, 0x64, 0x22, 0xa5, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x16,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x54, 0x78, 0x69, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x4b, 0x77, 0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x65,
+	0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61,
+	0x6c, 0x54, 0x78, 0x69, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x1a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x65, 0x65, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x22, 0x81, 0x01, 0x0a, 0x1a, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x65, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x20,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0x5e, 0x0a, 0x1b, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x15, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x18, 0x0a, 0x16,
+	0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x03, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x78, 0x69, 0x64, 0x12, 0x31,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b,
+	0x12, 0x20, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x13,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd2,
+	0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a,
+	0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x52,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x04,
+	0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e,
+	0x75, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3d,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01,
+	0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x9d, 0x07, 0x0a, 0x05, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61,
+	0x6d, 0x74, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x4b, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x75, 0x6e,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x55, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11,
+	0x6e, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x4d, 0x0a, 0x14, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x70, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x03, 0x0a, 0x03, 0x42, 0x69, 0x64,
+	0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69,
+	0x65, 0x72, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66,
+	0x43, 0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x7a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x22, 0xed, 0x02, 0x0a, 0x03, 0x41, 0x73, 0x6b, 0x12,
+	0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x17, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x62, 0x0a, 0x18, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x4b, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a,
+	0x18, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xcb, 0x01, 0x0a,
+	0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe3, 0x03, 0x0a, 0x16, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x74,
+	0x63, 0x6f, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63,
+	0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x62,
+	0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x48, 0x74, 0x74,
+	0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x69, 0x74,
+	0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x74, 0x6c, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6c, 0x73, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x74, 0x63, 0x6f,
+	0x69, 0x6e, 0x54, 0x6c, 0x73, 0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6e,
+	0x75, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6e, 0x75, 0x6d,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a,
+	0x09, 0x66, 0x65, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x08, 0x66, 0x65, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x22, 0x6d, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x52, 0x10, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x63, 0x0a, 0x0d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x8f, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e,
+	0x73, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x12, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xe6, 0x06, 0x0a, 0x05, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x61,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3a, 0x0a, 0x19, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x17, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x52, 0x65, 0x62, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x72, 0x6f, 0x6c,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d,
+	0x74, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x74, 0x45, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x53,
+	0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x53, 0x61, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x1d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x39, 0x0a,
+	0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x72, 0x6f,
+	0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xe7, 0x02, 0x0a, 0x1e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x50, 0x61,
+	0x69, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6e, 0x65, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x70, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41,
+	0x70, 0x72, 0x22, 0x21, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x96, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x69, 0x64,
+	0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xf0, 0x02, 0x0a, 0x09, 0x4c, 0x73,
+	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50,
+	0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x6e, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a,
+	0x41, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x65, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4e, 0x65, 0x78,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65,
+	0x77, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe7, 0x03, 0x0a, 0x14, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d,
+	0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x31, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x6d, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x0c, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x6d, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x1a, 0x65, 0x0a, 0x19,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x70,
+	0x61, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x73, 0x53, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73,
+	0x22, 0x56, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47,
+	0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x50,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xea, 0x06, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x49,
+	0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x38,
+	0x0a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x49,
+	0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x41, 0x0a, 0x1d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a,
+	0x52, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70,
+	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x4c, 0x53, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e,
+	0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x18, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x9f, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4d, 0x73, 0x22, 0x52, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x4c, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x4e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x03,
+	0x6c, 0x6e, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x62, 0x75, 0x67, 0x44, 0x75, 0x6d, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x68, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x79, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x70,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x47, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x14, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6e, 0x75, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75,
+	0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0x5c, 0x0a, 0x13, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x12,
+	0x1e, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x03, 0x62, 0x69, 0x64, 0x22,
+	0x27, 0x0a, 0x0d, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xbf, 0x06, 0x0a, 0x14, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x1f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26,
+	0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x69,
+	0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x3f, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x55, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72,
+	0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x43,
+	0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x57, 0x0a, 0x16, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69,
+	0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64,
+	0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x6c, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e,
+	0x44, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x50, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x2a,
+	0xd0, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44,
+	0x10, 0x09, 0x2a, 0x8e, 0x01, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x52, 0x43,
+	0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x03, 0x2a, 0x62, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45,
+	0x52, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x02, 0x2a, 0x46, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x2a,
+	0x76, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c,
+	0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4b, 0x45,
+	0x45, 0x50, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c,
+	0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x50, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49,
+	0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xbe, 0x01, 0x0a, 0x11, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44,
+	0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x04, 0x12,
+	0x29, 0x0a, 0x25, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xa5, 0x02, 0x0a, 0x12, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x10, 0x04, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x12,
+	0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x07, 0x2a, 0x73, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c,
+	0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x41, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x66, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4b, 0x45, 0x52, 0x10, 0x02, 0x2a,
+	0x78, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x32, 0x9b, 0x25, 0x0a, 0x06, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x13, 0x49,
+	0x6e, 0x69, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x69,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x16, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x50, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x65, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x65, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30,
+	0x01, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x16, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1f,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x63, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_trader_proto_rawDescOnce sync.Once
+	file_trader_proto_rawDescData = file_trader_proto_rawDesc
+)
+
+func file_trader_proto_rawDescGZIP() []byte {
+	file_trader_proto_rawDescOnce.Do(func() {
+		file_trader_proto_rawDescData = protoimpl.X.CompressGZIP(file_trader_proto_rawDescData)
+	})
+	return file_trader_proto_rawDescData
+}
+
+var file_trader_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_trader_proto_msgTypes = make([]protoimpl.MessageInfo, 131)
+var file_trader_proto_goTypes = []interface{}{
+	(AccountVersion)(0),                               // 0: poolrpc.AccountVersion
+	(AccountState)(0),                                 // 1: poolrpc.AccountState
+	(OrderStateFilter)(0),                             // 2: poolrpc.OrderStateFilter
+	(OrderTypeFilter)(0),                              // 3: poolrpc.OrderTypeFilter
+	(OrderSortBy)(0),                                  // 4: poolrpc.OrderSortBy
+	(PartialMatchPolicy)(0),                           // 5: poolrpc.PartialMatchPolicy
+	(MatchState)(0),                                   // 6: poolrpc.MatchState
+	(MatchRejectReason)(0),                            // 7: poolrpc.MatchRejectReason
+	(AccountAuditAction)(0),                           // 8: poolrpc.AccountAuditAction
+	(LeaseStatus)(0),                                  // 9: poolrpc.LeaseStatus
+	(LeaseRoleFilter)(0),                              // 10: poolrpc.LeaseRoleFilter
+	(BatchChannelState)(0),                            // 11: poolrpc.BatchChannelState
+	(*InitAccountRequest)(nil),                        // 12: poolrpc.InitAccountRequest
+	(*InitExternalAccountRequest)(nil),                // 13: poolrpc.InitExternalAccountRequest
+	(*InitExternalAccountResponse)(nil),               // 14: poolrpc.InitExternalAccountResponse
+	(*PublishExternalAccountRequest)(nil),             // 15: poolrpc.PublishExternalAccountRequest
+	(*CancelExternalAccountRequest)(nil),              // 16: poolrpc.CancelExternalAccountRequest
+	(*PreviewAccountFundingRequest)(nil),              // 17: poolrpc.PreviewAccountFundingRequest
+	(*FundingInput)(nil),                              // 18: poolrpc.FundingInput
+	(*PreviewAccountFundingResponse)(nil),             // 19: poolrpc.PreviewAccountFundingResponse
+	(*ConfirmAccountFundingRequest)(nil),              // 20: poolrpc.ConfirmAccountFundingRequest
+	(*QuoteAccountRequest)(nil),                       // 21: poolrpc.QuoteAccountRequest
+	(*QuoteAccountResponse)(nil),                      // 22: poolrpc.QuoteAccountResponse
+	(*ListAccountsRequest)(nil),                       // 23: poolrpc.ListAccountsRequest
+	(*ListAccountsResponse)(nil),                      // 24: poolrpc.ListAccountsResponse
+	(*ExportAccountKeyRequest)(nil),                   // 25: poolrpc.ExportAccountKeyRequest
+	(*ExportAccountKeyResponse)(nil),                  // 26: poolrpc.ExportAccountKeyResponse
+	(*AccountPolicy)(nil),                             // 27: poolrpc.AccountPolicy
+	(*SetAccountPolicyRequest)(nil),                   // 28: poolrpc.SetAccountPolicyRequest
+	(*SetAccountPolicyResponse)(nil),                  // 29: poolrpc.SetAccountPolicyResponse
+	(*Output)(nil),                                    // 30: poolrpc.Output
+	(*OutputWithFee)(nil),                             // 31: poolrpc.OutputWithFee
+	(*OutputsWithImplicitFee)(nil),                    // 32: poolrpc.OutputsWithImplicitFee
+	(*CloseAccountRequest)(nil),                       // 33: poolrpc.CloseAccountRequest
+	(*CloseAccountResponse)(nil),                      // 34: poolrpc.CloseAccountResponse
+	(*WithdrawAccountRequest)(nil),                    // 35: poolrpc.WithdrawAccountRequest
+	(*WithdrawAccountResponse)(nil),                   // 36: poolrpc.WithdrawAccountResponse
+	(*SplitAccountRequest)(nil),                       // 37: poolrpc.SplitAccountRequest
+	(*SplitAccountResponse)(nil),                      // 38: poolrpc.SplitAccountResponse
+	(*MergeAccountsRequest)(nil),                      // 39: poolrpc.MergeAccountsRequest
+	(*MergeAccountsResponse)(nil),                     // 40: poolrpc.MergeAccountsResponse
+	(*DepositAccountRequest)(nil),                     // 41: poolrpc.DepositAccountRequest
+	(*DepositAccountResponse)(nil),                    // 42: poolrpc.DepositAccountResponse
+	(*RenewAccountRequest)(nil),                       // 43: poolrpc.RenewAccountRequest
+	(*RenewAccountResponse)(nil),                      // 44: poolrpc.RenewAccountResponse
+	(*QuoteAccountRenewalRequest)(nil),                // 45: poolrpc.QuoteAccountRenewalRequest
+	(*QuoteAccountRenewalResponse)(nil),               // 46: poolrpc.QuoteAccountRenewalResponse
+	(*SimulateAccountFeesRequest)(nil),                // 47: poolrpc.SimulateAccountFeesRequest
+	(*AccountFeeSimulation)(nil),                      // 48: poolrpc.AccountFeeSimulation
+	(*SimulateAccountFeesResponse)(nil),               // 49: poolrpc.SimulateAccountFeesResponse
+	(*BumpAccountFeeRequest)(nil),                     // 50: poolrpc.BumpAccountFeeRequest
+	(*BumpAccountFeeResponse)(nil),                    // 51: poolrpc.BumpAccountFeeResponse
+	(*Account)(nil),                                   // 52: poolrpc.Account
+	(*SubmitOrderRequest)(nil),                        // 53: poolrpc.SubmitOrderRequest
+	(*SubmitOrderResponse)(nil),                       // 54: poolrpc.SubmitOrderResponse
+	(*PrepareOrderResponse)(nil),                      // 55: poolrpc.PrepareOrderResponse
+	(*ConfirmOrderRequest)(nil),                       // 56: poolrpc.ConfirmOrderRequest
+	(*ListOrdersRequest)(nil),                         // 57: poolrpc.ListOrdersRequest
+	(*ListOrdersResponse)(nil),                        // 58: poolrpc.ListOrdersResponse
+	(*CancelOrderRequest)(nil),                        // 59: poolrpc.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                       // 60: poolrpc.CancelOrderResponse
+	(*ReplaceOrderRequest)(nil),                       // 61: poolrpc.ReplaceOrderRequest
+	(*ReplaceOrderResponse)(nil),                      // 62: poolrpc.ReplaceOrderResponse
+	(*Order)(nil),                                     // 63: poolrpc.Order
+	(*Bid)(nil),                                       // 64: poolrpc.Bid
+	(*Ask)(nil),                                       // 65: poolrpc.Ask
+	(*QuoteOrderRequest)(nil),                         // 66: poolrpc.QuoteOrderRequest
+	(*QuoteOrderResponse)(nil),                        // 67: poolrpc.QuoteOrderResponse
+	(*OrderEvent)(nil),                                // 68: poolrpc.OrderEvent
+	(*UpdatedEvent)(nil),                              // 69: poolrpc.UpdatedEvent
+	(*MatchEvent)(nil),                                // 70: poolrpc.MatchEvent
+	(*RecoverAccountsRequest)(nil),                    // 71: poolrpc.RecoverAccountsRequest
+	(*RecoverAccountsResponse)(nil),                   // 72: poolrpc.RecoverAccountsResponse
+	(*AccountModificationFeesRequest)(nil),            // 73: poolrpc.AccountModificationFeesRequest
+	(*AccountModificationFee)(nil),                    // 74: poolrpc.AccountModificationFee
+	(*ListOfAccountModificationFees)(nil),             // 75: poolrpc.ListOfAccountModificationFees
+	(*AccountModificationFeesResponse)(nil),           // 76: poolrpc.AccountModificationFeesResponse
+	(*AccountAuditLogRequest)(nil),                    // 77: poolrpc.AccountAuditLogRequest
+	(*AccountAuditEvent)(nil),                         // 78: poolrpc.AccountAuditEvent
+	(*AccountAuditLogResponse)(nil),                   // 79: poolrpc.AccountAuditLogResponse
+	(*AuctionFeeRequest)(nil),                         // 80: poolrpc.AuctionFeeRequest
+	(*AuctionFeeResponse)(nil),                        // 81: poolrpc.AuctionFeeResponse
+	(*Lease)(nil),                                     // 82: poolrpc.Lease
+	(*LeasesRequest)(nil),                             // 83: poolrpc.LeasesRequest
+	(*LeasesResponse)(nil),                            // 84: poolrpc.LeasesResponse
+	(*LeasePerformanceReportRequest)(nil),             // 85: poolrpc.LeasePerformanceReportRequest
+	(*LeasePerformanceReportResponse)(nil),            // 86: poolrpc.LeasePerformanceReportResponse
+	(*ListPendingBatchChannelsRequest)(nil),           // 87: poolrpc.ListPendingBatchChannelsRequest
+	(*PendingBatchChannel)(nil),                       // 88: poolrpc.PendingBatchChannel
+	(*ListPendingBatchChannelsResponse)(nil),          // 89: poolrpc.ListPendingBatchChannelsResponse
+	(*TokensRequest)(nil),                             // 90: poolrpc.TokensRequest
+	(*TokensResponse)(nil),                            // 91: poolrpc.TokensResponse
+	(*LsatToken)(nil),                                 // 92: poolrpc.LsatToken
+	(*DeleteLsatTokenRequest)(nil),                    // 93: poolrpc.DeleteLsatTokenRequest
+	(*DeleteLsatTokenResponse)(nil),                   // 94: poolrpc.DeleteLsatTokenResponse
+	(*LeaseDurationRequest)(nil),                      // 95: poolrpc.LeaseDurationRequest
+	(*LeaseDurationResponse)(nil),                     // 96: poolrpc.LeaseDurationResponse
+	(*NextBatchInfoRequest)(nil),                      // 97: poolrpc.NextBatchInfoRequest
+	(*NextBatchInfoResponse)(nil),                     // 98: poolrpc.NextBatchInfoResponse
+	(*ServerParamsRequest)(nil),                       // 99: poolrpc.ServerParamsRequest
+	(*ServerParamsResponse)(nil),                      // 100: poolrpc.ServerParamsResponse
+	(*ListBatchConfirmationsRequest)(nil),             // 101: poolrpc.ListBatchConfirmationsRequest
+	(*BatchConfirmation)(nil),                         // 102: poolrpc.BatchConfirmation
+	(*ListBatchConfirmationsResponse)(nil),            // 103: poolrpc.ListBatchConfirmationsResponse
+	(*SubscribeBatchConfirmationsRequest)(nil),        // 104: poolrpc.SubscribeBatchConfirmationsRequest
+	(*AcceptBatchRequest)(nil),                        // 105: poolrpc.AcceptBatchRequest
+	(*AcceptBatchResponse)(nil),                       // 106: poolrpc.AcceptBatchResponse
+	(*NodeRatingRequest)(nil),                         // 107: poolrpc.NodeRatingRequest
+	(*NodeRatingResponse)(nil),                        // 108: poolrpc.NodeRatingResponse
+	(*GetInfoRequest)(nil),                            // 109: poolrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                           // 110: poolrpc.GetInfoResponse
+	(*StopDaemonRequest)(nil),                         // 111: poolrpc.StopDaemonRequest
+	(*StopDaemonResponse)(nil),                        // 112: poolrpc.StopDaemonResponse
+	(*GetTLSInfoRequest)(nil),                         // 113: poolrpc.GetTLSInfoRequest
+	(*GetTLSInfoResponse)(nil),                        // 114: poolrpc.GetTLSInfoResponse
+	(*TestConnectionsRequest)(nil),                    // 115: poolrpc.TestConnectionsRequest
+	(*ConnectionTestResult)(nil),                      // 116: poolrpc.ConnectionTestResult
+	(*TestConnectionsResponse)(nil),                   // 117: poolrpc.TestConnectionsResponse
+	(*GetConnectionStatusRequest)(nil),                // 118: poolrpc.GetConnectionStatusRequest
+	(*LndConnectionStatus)(nil),                       // 119: poolrpc.LndConnectionStatus
+	(*GetConnectionStatusResponse)(nil),               // 120: poolrpc.GetConnectionStatusResponse
+	(*DebugDumpRequest)(nil),                          // 121: poolrpc.DebugDumpRequest
+	(*DebugDumpResponse)(nil),                         // 122: poolrpc.DebugDumpResponse
+	(*ExportStateRequest)(nil),                        // 123: poolrpc.ExportStateRequest
+	(*ExportStateResponse)(nil),                       // 124: poolrpc.ExportStateResponse
+	(*ImportStateRequest)(nil),                        // 125: poolrpc.ImportStateRequest
+	(*ImportStateResponse)(nil),                       // 126: poolrpc.ImportStateResponse
+	(*OfferSidecarRequest)(nil),                       // 127: poolrpc.OfferSidecarRequest
+	(*SidecarTicket)(nil),                             // 128: poolrpc.SidecarTicket
+	(*DecodedSidecarTicket)(nil),                      // 129: poolrpc.DecodedSidecarTicket
+	(*RegisterSidecarRequest)(nil),                    // 130: poolrpc.RegisterSidecarRequest
+	(*ExpectSidecarChannelRequest)(nil),               // 131: poolrpc.ExpectSidecarChannelRequest
+	(*ExpectSidecarChannelResponse)(nil),              // 132: poolrpc.ExpectSidecarChannelResponse
+	(*ListSidecarsRequest)(nil),                       // 133: poolrpc.ListSidecarsRequest
+	(*ListSidecarsResponse)(nil),                      // 134: poolrpc.ListSidecarsResponse
+	(*CancelSidecarRequest)(nil),                      // 135: poolrpc.CancelSidecarRequest
+	(*CancelSidecarResponse)(nil),                     // 136: poolrpc.CancelSidecarResponse
+	nil,                                               // 137: poolrpc.AccountModificationFeesResponse.AccountsEntry
+	nil,                                               // 138: poolrpc.LeaseDurationResponse.LeaseDurationsEntry
+	nil,                                               // 139: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
+	nil,                                               // 140: poolrpc.ServerParamsResponse.LeaseDurationBucketsEntry
+	nil,                                               // 141: poolrpc.GetInfoResponse.MarketInfoEntry
+	nil,                                               // 142: poolrpc.DebugDumpResponse.ConfigEntry
+	(*auctioneerrpc.OutPoint)(nil),                    // 143: poolrpc.OutPoint
+	(*auctioneerrpc.InvalidOrder)(nil),                // 144: poolrpc.InvalidOrder
+	(auctioneerrpc.OrderState)(0),                     // 145: poolrpc.OrderState
+	(auctioneerrpc.OrderChannelType)(0),               // 146: poolrpc.OrderChannelType
+	(auctioneerrpc.AuctionType)(0),                    // 147: poolrpc.AuctionType
+	(auctioneerrpc.NodeTier)(0),                       // 148: poolrpc.NodeTier
+	(auctioneerrpc.ChannelAnnouncementConstraints)(0), // 149: poolrpc.ChannelAnnouncementConstraints
+	(auctioneerrpc.ChannelConfirmationConstraints)(0), // 150: poolrpc.ChannelConfirmationConstraints
+	(*auctioneerrpc.ExecutionFee)(nil),                // 151: poolrpc.ExecutionFee
+	(*auctioneerrpc.NodeRating)(nil),                  // 152: poolrpc.NodeRating
+	(auctioneerrpc.DurationBucketState)(0),            // 153: poolrpc.DurationBucketState
+	(*auctioneerrpc.MarketInfo)(nil),                  // 154: poolrpc.MarketInfo
+	(*auctioneerrpc.BatchSnapshotRequest)(nil),        // 155: poolrpc.BatchSnapshotRequest
+	(*auctioneerrpc.BatchSnapshotsRequest)(nil),       // 156: poolrpc.BatchSnapshotsRequest
+	(*auctioneerrpc.BatchSnapshotResponse)(nil),       // 157: poolrpc.BatchSnapshotResponse
+	(*auctioneerrpc.BatchSnapshotsResponse)(nil),      // 158: poolrpc.BatchSnapshotsResponse
+}
+var file_trader_proto_depIdxs = []int32{
+	0,   // 0: poolrpc.InitAccountRequest.version:type_name -> poolrpc.AccountVersion
+	0,   // 1: poolrpc.InitExternalAccountRequest.version:type_name -> poolrpc.AccountVersion
+	52,  // 2: poolrpc.InitExternalAccountResponse.account:type_name -> poolrpc.Account
+	0,   // 3: poolrpc.PreviewAccountFundingRequest.version:type_name -> poolrpc.AccountVersion
+	143, // 4: poolrpc.FundingInput.outpoint:type_name -> poolrpc.OutPoint
+	52,  // 5: poolrpc.PreviewAccountFundingResponse.account:type_name -> poolrpc.Account
+	18,  // 6: poolrpc.PreviewAccountFundingResponse.inputs:type_name -> poolrpc.FundingInput
+	30,  // 7: poolrpc.PreviewAccountFundingResponse.outputs:type_name -> poolrpc.Output
+	1,   // 8: poolrpc.ListAccountsRequest.states:type_name -> poolrpc.AccountState
+	52,  // 9: poolrpc.ListAccountsResponse.accounts:type_name -> poolrpc.Account
+	27,  // 10: poolrpc.SetAccountPolicyRequest.policy:type_name -> poolrpc.AccountPolicy
+	27,  // 11: poolrpc.SetAccountPolicyResponse.policy:type_name -> poolrpc.AccountPolicy
+	30,  // 12: poolrpc.OutputsWithImplicitFee.outputs:type_name -> poolrpc.Output
+	31,  // 13: poolrpc.CloseAccountRequest.output_with_fee:type_name -> poolrpc.OutputWithFee
+	32,  // 14: poolrpc.CloseAccountRequest.outputs:type_name -> poolrpc.OutputsWithImplicitFee
+	30,  // 15: poolrpc.WithdrawAccountRequest.outputs:type_name -> poolrpc.Output
+	0,   // 16: poolrpc.WithdrawAccountRequest.new_version:type_name -> poolrpc.AccountVersion
+	52,  // 17: poolrpc.WithdrawAccountResponse.account:type_name -> poolrpc.Account
+	52,  // 18: poolrpc.SplitAccountResponse.account:type_name -> poolrpc.Account
+	52,  // 19: poolrpc.SplitAccountResponse.new_account:type_name -> poolrpc.Account
+	52,  // 20: poolrpc.MergeAccountsResponse.account:type_name -> poolrpc.Account
+	0,   // 21: poolrpc.DepositAccountRequest.new_version:type_name -> poolrpc.AccountVersion
+	52,  // 22: poolrpc.DepositAccountResponse.account:type_name -> poolrpc.Account
+	0,   // 23: poolrpc.RenewAccountRequest.new_version:type_name -> poolrpc.AccountVersion
+	52,  // 24: poolrpc.RenewAccountResponse.account:type_name -> poolrpc.Account
+	0,   // 25: poolrpc.QuoteAccountRenewalRequest.new_version:type_name -> poolrpc.AccountVersion
+	0,   // 26: poolrpc.SimulateAccountFeesRequest.account_version:type_name -> poolrpc.AccountVersion
+	48,  // 27: poolrpc.SimulateAccountFeesResponse.simulations:type_name -> poolrpc.AccountFeeSimulation
+	143, // 28: poolrpc.Account.outpoint:type_name -> poolrpc.OutPoint
+	1,   // 29: poolrpc.Account.state:type_name -> poolrpc.AccountState
+	0,   // 30: poolrpc.Account.version:type_name -> poolrpc.AccountVersion
+	65,  // 31: poolrpc.SubmitOrderRequest.ask:type_name -> poolrpc.Ask
+	64,  // 32: poolrpc.SubmitOrderRequest.bid:type_name -> poolrpc.Bid
+	144, // 33: poolrpc.SubmitOrderResponse.invalid_order:type_name -> poolrpc.InvalidOrder
+	67,  // 34: poolrpc.PrepareOrderResponse.quote:type_name -> poolrpc.QuoteOrderResponse
+	2,   // 35: poolrpc.ListOrdersRequest.state_filter:type_name -> poolrpc.OrderStateFilter
+	3,   // 36: poolrpc.ListOrdersRequest.type_filter:type_name -> poolrpc.OrderTypeFilter
+	4,   // 37: poolrpc.ListOrdersRequest.sort_by:type_name -> poolrpc.OrderSortBy
+	65,  // 38: poolrpc.ListOrdersResponse.asks:type_name -> poolrpc.Ask
+	64,  // 39: poolrpc.ListOrdersResponse.bids:type_name -> poolrpc.Bid
+	53,  // 40: poolrpc.ReplaceOrderRequest.replacement:type_name -> poolrpc.SubmitOrderRequest
+	54,  // 41: poolrpc.ReplaceOrderResponse.replacement:type_name -> poolrpc.SubmitOrderResponse
+	145, // 42: poolrpc.Order.state:type_name -> poolrpc.OrderState
+	68,  // 43: poolrpc.Order.events:type_name -> poolrpc.OrderEvent
+	146, // 44: poolrpc.Order.channel_type:type_name -> poolrpc.OrderChannelType
+	147, // 45: poolrpc.Order.auction_type:type_name -> poolrpc.AuctionType
+	5,   // 46: poolrpc.Order.partial_match_policy:type_name -> poolrpc.PartialMatchPolicy
+	63,  // 47: poolrpc.Bid.details:type_name -> poolrpc.Order
+	148, // 48: poolrpc.Bid.min_node_tier:type_name -> poolrpc.NodeTier
+	63,  // 49: poolrpc.Ask.details:type_name -> poolrpc.Order
+	149, // 50: poolrpc.Ask.announcement_constraints:type_name -> poolrpc.ChannelAnnouncementConstraints
+	150, // 51: poolrpc.Ask.confirmation_constraints:type_name -> poolrpc.ChannelConfirmationConstraints
+	69,  // 52: poolrpc.OrderEvent.state_change:type_name -> poolrpc.UpdatedEvent
+	70,  // 53: poolrpc.OrderEvent.matched:type_name -> poolrpc.MatchEvent
+	145, // 54: poolrpc.UpdatedEvent.previous_state:type_name -> poolrpc.OrderState
+	145, // 55: poolrpc.UpdatedEvent.new_state:type_name -> poolrpc.OrderState
+	6,   // 56: poolrpc.MatchEvent.match_state:type_name -> poolrpc.MatchState
+	7,   // 57: poolrpc.MatchEvent.reject_reason:type_name -> poolrpc.MatchRejectReason
+	74,  // 58: poolrpc.ListOfAccountModificationFees.modification_fees:type_name -> poolrpc.AccountModificationFee
+	137, // 59: poolrpc.AccountModificationFeesResponse.accounts:type_name -> poolrpc.AccountModificationFeesResponse.AccountsEntry
+	8,   // 60: poolrpc.AccountAuditEvent.action:type_name -> poolrpc.AccountAuditAction
+	1,   // 61: poolrpc.AccountAuditEvent.state:type_name -> poolrpc.AccountState
+	78,  // 62: poolrpc.AccountAuditLogResponse.events:type_name -> poolrpc.AccountAuditEvent
+	151, // 63: poolrpc.AuctionFeeResponse.execution_fee:type_name -> poolrpc.ExecutionFee
+	143, // 64: poolrpc.Lease.channel_point:type_name -> poolrpc.OutPoint
+	148, // 65: poolrpc.Lease.channel_node_tier:type_name -> poolrpc.NodeTier
+	9,   // 66: poolrpc.Lease.status:type_name -> poolrpc.LeaseStatus
+	10,  // 67: poolrpc.LeasesRequest.role_filter:type_name -> poolrpc.LeaseRoleFilter
+	9,   // 68: poolrpc.LeasesRequest.statuses:type_name -> poolrpc.LeaseStatus
+	82,  // 69: poolrpc.LeasesResponse.leases:type_name -> poolrpc.Lease
+	10,  // 70: poolrpc.LeasePerformanceReportRequest.role_filter:type_name -> poolrpc.LeaseRoleFilter
+	11,  // 71: poolrpc.PendingBatchChannel.state:type_name -> poolrpc.BatchChannelState
+	88,  // 72: poolrpc.ListPendingBatchChannelsResponse.channels:type_name -> poolrpc.PendingBatchChannel
+	92,  // 73: poolrpc.TokensResponse.tokens:type_name -> poolrpc.LsatToken
+	138, // 74: poolrpc.LeaseDurationResponse.lease_durations:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationsEntry
+	139, // 75: poolrpc.LeaseDurationResponse.lease_duration_buckets:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
+	140, // 76: poolrpc.ServerParamsResponse.lease_duration_buckets:type_name -> poolrpc.ServerParamsResponse.LeaseDurationBucketsEntry
+	151, // 77: poolrpc.ServerParamsResponse.execution_fee:type_name -> poolrpc.ExecutionFee
+	102, // 78: poolrpc.ListBatchConfirmationsResponse.batches:type_name -> poolrpc.BatchConfirmation
+	152, // 79: poolrpc.NodeRatingResponse.node_ratings:type_name -> poolrpc.NodeRating
+	152, // 80: poolrpc.GetInfoResponse.node_rating:type_name -> poolrpc.NodeRating
+	141, // 81: poolrpc.GetInfoResponse.market_info:type_name -> poolrpc.GetInfoResponse.MarketInfoEntry
+	116, // 82: poolrpc.TestConnectionsResponse.results:type_name -> poolrpc.ConnectionTestResult
+	119, // 83: poolrpc.GetConnectionStatusResponse.lnd:type_name -> poolrpc.LndConnectionStatus
+	142, // 84: poolrpc.DebugDumpResponse.config:type_name -> poolrpc.DebugDumpResponse.ConfigEntry
+	64,  // 85: poolrpc.OfferSidecarRequest.bid:type_name -> poolrpc.Bid
+	129, // 86: poolrpc.ListSidecarsResponse.tickets:type_name -> poolrpc.DecodedSidecarTicket
+	75,  // 87: poolrpc.AccountModificationFeesResponse.AccountsEntry.value:type_name -> poolrpc.ListOfAccountModificationFees
+	153, // 88: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry.value:type_name -> poolrpc.DurationBucketState
+	153, // 89: poolrpc.ServerParamsResponse.LeaseDurationBucketsEntry.value:type_name -> poolrpc.DurationBucketState
+	154, // 90: poolrpc.GetInfoResponse.MarketInfoEntry.value:type_name -> poolrpc.MarketInfo
+	109, // 91: poolrpc.Trader.GetInfo:input_type -> poolrpc.GetInfoRequest
+	111, // 92: poolrpc.Trader.StopDaemon:input_type -> poolrpc.StopDaemonRequest
+	113, // 93: poolrpc.Trader.GetTLSInfo:input_type -> poolrpc.GetTLSInfoRequest
+	115, // 94: poolrpc.Trader.TestConnections:input_type -> poolrpc.TestConnectionsRequest
+	118, // 95: poolrpc.Trader.GetConnectionStatus:input_type -> poolrpc.GetConnectionStatusRequest
+	121, // 96: poolrpc.Trader.DebugDump:input_type -> poolrpc.DebugDumpRequest
+	123, // 97: poolrpc.Trader.ExportState:input_type -> poolrpc.ExportStateRequest
+	125, // 98: poolrpc.Trader.ImportState:input_type -> poolrpc.ImportStateRequest
+	21,  // 99: poolrpc.Trader.QuoteAccount:input_type -> poolrpc.QuoteAccountRequest
+	12,  // 100: poolrpc.Trader.InitAccount:input_type -> poolrpc.InitAccountRequest
+	13,  // 101: poolrpc.Trader.InitExternalAccount:input_type -> poolrpc.InitExternalAccountRequest
+	15,  // 102: poolrpc.Trader.PublishExternalAccount:input_type -> poolrpc.PublishExternalAccountRequest
+	16,  // 103: poolrpc.Trader.CancelExternalAccount:input_type -> poolrpc.CancelExternalAccountRequest
+	17,  // 104: poolrpc.Trader.PreviewAccountFunding:input_type -> poolrpc.PreviewAccountFundingRequest
+	20,  // 105: poolrpc.Trader.ConfirmAccountFunding:input_type -> poolrpc.ConfirmAccountFundingRequest
+	23,  // 106: poolrpc.Trader.ListAccounts:input_type -> poolrpc.ListAccountsRequest
+	25,  // 107: poolrpc.Trader.ExportAccountKey:input_type -> poolrpc.ExportAccountKeyRequest
+	28,  // 108: poolrpc.Trader.SetAccountPolicy:input_type -> poolrpc.SetAccountPolicyRequest
+	33,  // 109: poolrpc.Trader.CloseAccount:input_type -> poolrpc.CloseAccountRequest
+	35,  // 110: poolrpc.Trader.WithdrawAccount:input_type -> poolrpc.WithdrawAccountRequest
+	37,  // 111: poolrpc.Trader.SplitAccount:input_type -> poolrpc.SplitAccountRequest
+	39,  // 112: poolrpc.Trader.MergeAccounts:input_type -> poolrpc.MergeAccountsRequest
+	41,  // 113: poolrpc.Trader.DepositAccount:input_type -> poolrpc.DepositAccountRequest
+	43,  // 114: poolrpc.Trader.RenewAccount:input_type -> poolrpc.RenewAccountRequest
+	45,  // 115: poolrpc.Trader.QuoteAccountRenewal:input_type -> poolrpc.QuoteAccountRenewalRequest
+	47,  // 116: poolrpc.Trader.SimulateAccountFees:input_type -> poolrpc.SimulateAccountFeesRequest
+	50,  // 117: poolrpc.Trader.BumpAccountFee:input_type -> poolrpc.BumpAccountFeeRequest
+	71,  // 118: poolrpc.Trader.RecoverAccounts:input_type -> poolrpc.RecoverAccountsRequest
+	73,  // 119: poolrpc.Trader.AccountModificationFees:input_type -> poolrpc.AccountModificationFeesRequest
+	77,  // 120: poolrpc.Trader.AccountAuditLog:input_type -> poolrpc.AccountAuditLogRequest
+	53,  // 121: poolrpc.Trader.SubmitOrder:input_type -> poolrpc.SubmitOrderRequest
+	53,  // 122: poolrpc.Trader.PrepareOrder:input_type -> poolrpc.SubmitOrderRequest
+	56,  // 123: poolrpc.Trader.ConfirmOrder:input_type -> poolrpc.ConfirmOrderRequest
+	57,  // 124: poolrpc.Trader.ListOrders:input_type -> poolrpc.ListOrdersRequest
+	59,  // 125: poolrpc.Trader.CancelOrder:input_type -> poolrpc.CancelOrderRequest
+	61,  // 126: poolrpc.Trader.ReplaceOrder:input_type -> poolrpc.ReplaceOrderRequest
+	66,  // 127: poolrpc.Trader.QuoteOrder:input_type -> poolrpc.QuoteOrderRequest
+	80,  // 128: poolrpc.Trader.AuctionFee:input_type -> poolrpc.AuctionFeeRequest
+	95,  // 129: poolrpc.Trader.LeaseDurations:input_type -> poolrpc.LeaseDurationRequest
+	97,  // 130: poolrpc.Trader.NextBatchInfo:input_type -> poolrpc.NextBatchInfoRequest
+	99,  // 131: poolrpc.Trader.GetServerParams:input_type -> poolrpc.ServerParamsRequest
+	101, // 132: poolrpc.Trader.ListBatchConfirmations:input_type -> poolrpc.ListBatchConfirmationsRequest
+	104, // 133: poolrpc.Trader.SubscribeBatchConfirmations:input_type -> poolrpc.SubscribeBatchConfirmationsRequest
+	105, // 134: poolrpc.Trader.AcceptBatch:input_type -> poolrpc.AcceptBatchRequest
+	155, // 135: poolrpc.Trader.BatchSnapshot:input_type -> poolrpc.BatchSnapshotRequest
+	90,  // 136: poolrpc.Trader.GetLsatTokens:input_type -> poolrpc.TokensRequest
+	93,  // 137: poolrpc.Trader.DeleteLsatToken:input_type -> poolrpc.DeleteLsatTokenRequest
+	83,  // 138: poolrpc.Trader.Leases:input_type -> poolrpc.LeasesRequest
+	85,  // 139: poolrpc.Trader.LeasePerformanceReport:input_type -> poolrpc.LeasePerformanceReportRequest
+	87,  // 140: poolrpc.Trader.ListPendingBatchChannels:input_type -> poolrpc.ListPendingBatchChannelsRequest
+	107, // 141: poolrpc.Trader.NodeRatings:input_type -> poolrpc.NodeRatingRequest
+	156, // 142: poolrpc.Trader.BatchSnapshots:input_type -> poolrpc.BatchSnapshotsRequest
+	127, // 143: poolrpc.Trader.OfferSidecar:input_type -> poolrpc.OfferSidecarRequest
+	130, // 144: poolrpc.Trader.RegisterSidecar:input_type -> poolrpc.RegisterSidecarRequest
+	131, // 145: poolrpc.Trader.ExpectSidecarChannel:input_type -> poolrpc.ExpectSidecarChannelRequest
+	128, // 146: poolrpc.Trader.DecodeSidecarTicket:input_type -> poolrpc.SidecarTicket
+	133, // 147: poolrpc.Trader.ListSidecars:input_type -> poolrpc.ListSidecarsRequest
+	135, // 148: poolrpc.Trader.CancelSidecar:input_type -> poolrpc.CancelSidecarRequest
+	110, // 149: poolrpc.Trader.GetInfo:output_type -> poolrpc.GetInfoResponse
+	112, // 150: poolrpc.Trader.StopDaemon:output_type -> poolrpc.StopDaemonResponse
+	114, // 151: poolrpc.Trader.GetTLSInfo:output_type -> poolrpc.GetTLSInfoResponse
+	117, // 152: poolrpc.Trader.TestConnections:output_type -> poolrpc.TestConnectionsResponse
+	120, // 153: poolrpc.Trader.GetConnectionStatus:output_type -> poolrpc.GetConnectionStatusResponse
+	122, // 154: poolrpc.Trader.DebugDump:output_type -> poolrpc.DebugDumpResponse
+	124, // 155: poolrpc.Trader.ExportState:output_type -> poolrpc.ExportStateResponse
+	126, // 156: poolrpc.Trader.ImportState:output_type -> poolrpc.ImportStateResponse
+	22,  // 157: poolrpc.Trader.QuoteAccount:output_type -> poolrpc.QuoteAccountResponse
+	52,  // 158: poolrpc.Trader.InitAccount:output_type -> poolrpc.Account
+	14,  // 159: poolrpc.Trader.InitExternalAccount:output_type -> poolrpc.InitExternalAccountResponse
+	52,  // 160: poolrpc.Trader.PublishExternalAccount:output_type -> poolrpc.Account
+	52,  // 161: poolrpc.Trader.CancelExternalAccount:output_type -> poolrpc.Account
+	19,  // 162: poolrpc.Trader.PreviewAccountFunding:output_type -> poolrpc.PreviewAccountFundingResponse
+	52,  // 163: poolrpc.Trader.ConfirmAccountFunding:output_type -> poolrpc.Account
+	24,  // 164: poolrpc.Trader.ListAccounts:output_type -> poolrpc.ListAccountsResponse
+	26,  // 165: poolrpc.Trader.ExportAccountKey:output_type -> poolrpc.ExportAccountKeyResponse
+	29,  // 166: poolrpc.Trader.SetAccountPolicy:output_type -> poolrpc.SetAccountPolicyResponse
+	34,  // 167: poolrpc.Trader.CloseAccount:output_type -> poolrpc.CloseAccountResponse
+	36,  // 168: poolrpc.Trader.WithdrawAccount:output_type -> poolrpc.WithdrawAccountResponse
+	38,  // 169: poolrpc.Trader.SplitAccount:output_type -> poolrpc.SplitAccountResponse
+	40,  // 170: poolrpc.Trader.MergeAccounts:output_type -> poolrpc.MergeAccountsResponse
+	42,  // 171: poolrpc.Trader.DepositAccount:output_type -> poolrpc.DepositAccountResponse
+	44,  // 172: poolrpc.Trader.RenewAccount:output_type -> poolrpc.RenewAccountResponse
+	46,  // 173: poolrpc.Trader.QuoteAccountRenewal:output_type -> poolrpc.QuoteAccountRenewalResponse
+	49,  // 174: poolrpc.Trader.SimulateAccountFees:output_type -> poolrpc.SimulateAccountFeesResponse
+	51,  // 175: poolrpc.Trader.BumpAccountFee:output_type -> poolrpc.BumpAccountFeeResponse
+	72,  // 176: poolrpc.Trader.RecoverAccounts:output_type -> poolrpc.RecoverAccountsResponse
+	76,  // 177: poolrpc.Trader.AccountModificationFees:output_type -> poolrpc.AccountModificationFeesResponse
+	79,  // 178: poolrpc.Trader.AccountAuditLog:output_type -> poolrpc.AccountAuditLogResponse
+	54,  // 179: poolrpc.Trader.SubmitOrder:output_type -> poolrpc.SubmitOrderResponse
+	55,  // 180: poolrpc.Trader.PrepareOrder:output_type -> poolrpc.PrepareOrderResponse
+	54,  // 181: poolrpc.Trader.ConfirmOrder:output_type -> poolrpc.SubmitOrderResponse
+	58,  // 182: poolrpc.Trader.ListOrders:output_type -> poolrpc.ListOrdersResponse
+	60,  // 183: poolrpc.Trader.CancelOrder:output_type -> poolrpc.CancelOrderResponse
+	62,  // 184: poolrpc.Trader.ReplaceOrder:output_type -> poolrpc.ReplaceOrderResponse
+	67,  // 185: poolrpc.Trader.QuoteOrder:output_type -> poolrpc.QuoteOrderResponse
+	81,  // 186: poolrpc.Trader.AuctionFee:output_type -> poolrpc.AuctionFeeResponse
+	96,  // 187: poolrpc.Trader.LeaseDurations:output_type -> poolrpc.LeaseDurationResponse
+	98,  // 188: poolrpc.Trader.NextBatchInfo:output_type -> poolrpc.NextBatchInfoResponse
+	100, // 189: poolrpc.Trader.GetServerParams:output_type -> poolrpc.ServerParamsResponse
+	103, // 190: poolrpc.Trader.ListBatchConfirmations:output_type -> poolrpc.ListBatchConfirmationsResponse
+	102, // 191: poolrpc.Trader.SubscribeBatchConfirmations:output_type -> poolrpc.BatchConfirmation
+	106, // 192: poolrpc.Trader.AcceptBatch:output_type -> poolrpc.AcceptBatchResponse
+	157, // 193: poolrpc.Trader.BatchSnapshot:output_type -> poolrpc.BatchSnapshotResponse
+	91,  // 194: poolrpc.Trader.GetLsatTokens:output_type -> poolrpc.TokensResponse
+	94,  // 195: poolrpc.Trader.DeleteLsatToken:output_type -> poolrpc.DeleteLsatTokenResponse
+	84,  // 196: poolrpc.Trader.Leases:output_type -> poolrpc.LeasesResponse
+	86,  // 197: poolrpc.Trader.LeasePerformanceReport:output_type -> poolrpc.LeasePerformanceReportResponse
+	89,  // 198: poolrpc.Trader.ListPendingBatchChannels:output_type -> poolrpc.ListPendingBatchChannelsResponse
+	108, // 199: poolrpc.Trader.NodeRatings:output_type -> poolrpc.NodeRatingResponse
+	158, // 200: poolrpc.Trader.BatchSnapshots:output_type -> poolrpc.BatchSnapshotsResponse
+	128, // 201: poolrpc.Trader.OfferSidecar:output_type -> poolrpc.SidecarTicket
+	128, // 202: poolrpc.Trader.RegisterSidecar:output_type -> poolrpc.SidecarTicket
+	132, // 203: poolrpc.Trader.ExpectSidecarChannel:output_type -> poolrpc.ExpectSidecarChannelResponse
+	129, // 204: poolrpc.Trader.DecodeSidecarTicket:output_type -> poolrpc.DecodedSidecarTicket
+	134, // 205: poolrpc.Trader.ListSidecars:output_type -> poolrpc.ListSidecarsResponse
+	136, // 206: poolrpc.Trader.CancelSidecar:output_type -> poolrpc.CancelSidecarResponse
+	149, // [149:207] is the sub-list for method output_type
+	91,  // [91:149] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
+}
+
+func init() { file_trader_proto_init() }
+func file_trader_proto_init() {
+	if File_trader_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_trader_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitExternalAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitExternalAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishExternalAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelExternalAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewAccountFundingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundingInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewAccountFundingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmAccountFundingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputWithFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputsWithImplicitFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountRenewalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountRenewalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateAccountFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFeeSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateAccountFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpAccountFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpAccountFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6553,8 +12868,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteAccountRequest); i {
+		file_trader_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6565,8 +12880,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteAccountResponse); i {
+		file_trader_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6577,8 +12892,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsRequest); i {
+		file_trader_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6589,8 +12904,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsResponse); i {
+		file_trader_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6601,8 +12916,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Output); i {
+		file_trader_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6613,8 +12928,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputWithFee); i {
+		file_trader_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6625,8 +12940,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputsWithImplicitFee); i {
+		file_trader_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6637,8 +12952,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseAccountRequest); i {
+		file_trader_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6649,8 +12964,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseAccountResponse); i {
+		file_trader_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6661,8 +12976,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawAccountRequest); i {
+		file_trader_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6673,8 +12988,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawAccountResponse); i {
+		file_trader_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountModificationFeesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6685,8 +13000,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositAccountRequest); i {
+		file_trader_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountModificationFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6697,8 +13012,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositAccountResponse); i {
+		file_trader_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOfAccountModificationFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6709,8 +13024,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewAccountRequest); i {
+		file_trader_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountModificationFeesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6721,8 +13036,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewAccountResponse); i {
+		file_trader_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountAuditLogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6733,8 +13048,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BumpAccountFeeRequest); i {
+		file_trader_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountAuditEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6745,8 +13060,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BumpAccountFeeResponse); i {
+		file_trader_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountAuditLogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6757,8 +13072,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_trader_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6769,8 +13084,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOrderRequest); i {
+		file_trader_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionFeeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6781,8 +13096,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOrderResponse); i {
+		file_trader_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lease); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6793,8 +13108,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrdersRequest); i {
+		file_trader_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6805,8 +13120,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrdersResponse); i {
+		file_trader_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6817,8 +13132,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelOrderRequest); i {
+		file_trader_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasePerformanceReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6829,8 +13144,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelOrderResponse); i {
+		file_trader_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasePerformanceReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6841,8 +13156,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_trader_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingBatchChannelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6853,8 +13168,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bid); i {
+		file_trader_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingBatchChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6865,8 +13180,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ask); i {
+		file_trader_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingBatchChannelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6877,8 +13192,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteOrderRequest); i {
+		file_trader_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6889,8 +13204,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteOrderResponse); i {
+		file_trader_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6901,8 +13216,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderEvent); i {
+		file_trader_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsatToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6913,8 +13228,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatedEvent); i {
+		file_trader_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLsatTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6925,8 +13240,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchEvent); i {
+		file_trader_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLsatTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6937,8 +13252,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverAccountsRequest); i {
+		file_trader_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6949,8 +13264,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverAccountsResponse); i {
+		file_trader_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6961,8 +13276,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountModificationFeesRequest); i {
+		file_trader_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextBatchInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6973,8 +13288,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountModificationFee); i {
+		file_trader_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextBatchInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6985,8 +13300,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOfAccountModificationFees); i {
+		file_trader_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6997,8 +13312,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountModificationFeesResponse); i {
+		file_trader_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7009,8 +13324,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuctionFeeRequest); i {
+		file_trader_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchConfirmationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7021,8 +13336,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuctionFeeResponse); i {
+		file_trader_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7033,8 +13348,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lease); i {
+		file_trader_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchConfirmationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7045,8 +13360,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeasesRequest); i {
+		file_trader_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBatchConfirmationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7057,8 +13372,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeasesResponse); i {
+		file_trader_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7069,8 +13384,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensRequest); i {
+		file_trader_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7081,8 +13396,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensResponse); i {
+		file_trader_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRatingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7093,8 +13408,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsatToken); i {
+		file_trader_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRatingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7105,8 +13420,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseDurationRequest); i {
+		file_trader_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7117,8 +13432,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseDurationResponse); i {
+		file_trader_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7129,8 +13444,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextBatchInfoRequest); i {
+		file_trader_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopDaemonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7141,8 +13456,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextBatchInfoResponse); i {
+		file_trader_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopDaemonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7153,8 +13468,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRatingRequest); i {
+		file_trader_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTLSInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7165,8 +13480,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRatingResponse); i {
+		file_trader_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTLSInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7177,8 +13492,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoRequest); i {
+		file_trader_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestConnectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7189,8 +13504,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
+		file_trader_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionTestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7201,8 +13516,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopDaemonRequest); i {
+		file_trader_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestConnectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7213,8 +13528,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopDaemonResponse); i {
+		file_trader_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConnectionStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7225,7 +13540,103 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndConnectionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConnectionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugDumpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugDumpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OfferSidecarRequest); i {
 			case 0:
 				return &v.state
@@ -7237,7 +13648,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SidecarTicket); i {
 			case 0:
 				return &v.state
@@ -7249,7 +13660,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DecodedSidecarTicket); i {
 			case 0:
 				return &v.state
@@ -7261,7 +13672,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterSidecarRequest); i {
 			case 0:
 				return &v.state
@@ -7273,7 +13684,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExpectSidecarChannelRequest); i {
 			case 0:
 				return &v.state
@@ -7285,7 +13696,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExpectSidecarChannelResponse); i {
 			case 0:
 				return &v.state
@@ -7297,7 +13708,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSidecarsRequest); i {
 			case 0:
 				return &v.state
@@ -7309,7 +13720,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSidecarsResponse); i {
 			case 0:
 				return &v.state
@@ -7321,7 +13732,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelSidecarRequest); i {
 			case 0:
 				return &v.state
@@ -7333,7 +13744,7 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_trader_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelSidecarResponse); i {
 			case 0:
 				return &v.state
@@ -7353,41 +13764,59 @@ func file_trader_proto_init() {
 		(*InitAccountRequest_FeeRateSatPerKw)(nil),
 	}
 	file_trader_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*InitExternalAccountRequest_AbsoluteHeight)(nil),
+		(*InitExternalAccountRequest_RelativeHeight)(nil),
+	}
+	file_trader_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*PreviewAccountFundingRequest_AbsoluteHeight)(nil),
+		(*PreviewAccountFundingRequest_RelativeHeight)(nil),
+		(*PreviewAccountFundingRequest_ConfTarget)(nil),
+		(*PreviewAccountFundingRequest_FeeRateSatPerKw)(nil),
+	}
+	file_trader_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*QuoteAccountRequest_ConfTarget)(nil),
 	}
-	file_trader_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*OutputWithFee_ConfTarget)(nil),
 		(*OutputWithFee_FeeRateSatPerKw)(nil),
 	}
-	file_trader_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*CloseAccountRequest_OutputWithFee)(nil),
 		(*CloseAccountRequest_Outputs)(nil),
 	}
-	file_trader_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*WithdrawAccountRequest_AbsoluteExpiry)(nil),
 		(*WithdrawAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*SplitAccountRequest_AbsoluteExpiry)(nil),
+		(*SplitAccountRequest_RelativeExpiry)(nil),
+	}
+	file_trader_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*DepositAccountRequest_AbsoluteExpiry)(nil),
 		(*DepositAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*RenewAccountRequest_AbsoluteExpiry)(nil),
 		(*RenewAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*QuoteAccountRenewalRequest_AbsoluteExpiry)(nil),
+		(*QuoteAccountRenewalRequest_RelativeExpiry)(nil),
+	}
+	file_trader_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*SubmitOrderRequest_Ask)(nil),
 		(*SubmitOrderRequest_Bid)(nil),
 	}
-	file_trader_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*SubmitOrderResponse_InvalidOrder)(nil),
 		(*SubmitOrderResponse_AcceptedOrderNonce)(nil),
 	}
-	file_trader_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*OrderEvent_StateChange)(nil),
 		(*OrderEvent_Matched)(nil),
 	}
-	file_trader_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[62].OneofWrappers = []interface{}{
 		(*AccountModificationFee_FeeNull)(nil),
 		(*AccountModificationFee_FeeValue)(nil),
 	}
@@ -7396,8 +13825,8 @@ func file_trader_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_trader_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   71,
+			NumEnums:      12,
+			NumMessages:   131,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
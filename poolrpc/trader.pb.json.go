@@ -72,10 +72,485 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.GetTLSInfo"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &GetTLSInfoRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.GetTLSInfo(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.TestConnections"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &TestConnectionsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.TestConnections(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.GetConnectionStatus"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &GetConnectionStatusRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.GetConnectionStatus(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.DebugDump"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DebugDumpRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DebugDump(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ExportState"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ExportStateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ExportState(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ImportState"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ImportStateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ImportState(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.QuoteAccount"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &QuoteAccountRequest{}
+		req := &QuoteAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.QuoteAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.InitAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &InitAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.InitAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.InitExternalAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &InitExternalAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.InitExternalAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.PublishExternalAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &PublishExternalAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.PublishExternalAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CancelExternalAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CancelExternalAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CancelExternalAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.PreviewAccountFunding"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &PreviewAccountFundingRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.PreviewAccountFunding(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ConfirmAccountFunding"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ConfirmAccountFundingRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ConfirmAccountFunding(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListAccounts"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListAccountsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListAccounts(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ExportAccountKey"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ExportAccountKeyRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ExportAccountKey(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SetAccountPolicy"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SetAccountPolicyRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SetAccountPolicy(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CloseAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CloseAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CloseAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.WithdrawAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &WithdrawAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.WithdrawAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SplitAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SplitAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SplitAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.MergeAccounts"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &MergeAccountsRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -83,7 +558,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.QuoteAccount(ctx, req)
+		resp, err := client.MergeAccounts(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -97,10 +572,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.InitAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.DepositAccount"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &InitAccountRequest{}
+		req := &DepositAccountRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -108,7 +583,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.InitAccount(ctx, req)
+		resp, err := client.DepositAccount(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -122,10 +597,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.ListAccounts"] = func(ctx context.Context,
+	registry["poolrpc.Trader.RenewAccount"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &ListAccountsRequest{}
+		req := &RenewAccountRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -133,7 +608,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.ListAccounts(ctx, req)
+		resp, err := client.RenewAccount(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -147,10 +622,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.CloseAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.QuoteAccountRenewal"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &CloseAccountRequest{}
+		req := &QuoteAccountRenewalRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -158,7 +633,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.CloseAccount(ctx, req)
+		resp, err := client.QuoteAccountRenewal(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -172,10 +647,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.WithdrawAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.SimulateAccountFees"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &WithdrawAccountRequest{}
+		req := &SimulateAccountFeesRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -183,7 +658,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.WithdrawAccount(ctx, req)
+		resp, err := client.SimulateAccountFees(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -197,10 +672,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.DepositAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.BumpAccountFee"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &DepositAccountRequest{}
+		req := &BumpAccountFeeRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -208,7 +683,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.DepositAccount(ctx, req)
+		resp, err := client.BumpAccountFee(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -222,10 +697,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.RenewAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.RecoverAccounts"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &RenewAccountRequest{}
+		req := &RecoverAccountsRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -233,7 +708,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.RenewAccount(ctx, req)
+		resp, err := client.RecoverAccounts(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -247,10 +722,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.BumpAccountFee"] = func(ctx context.Context,
+	registry["poolrpc.Trader.AccountModificationFees"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &BumpAccountFeeRequest{}
+		req := &AccountModificationFeesRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -258,7 +733,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.BumpAccountFee(ctx, req)
+		resp, err := client.AccountModificationFees(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -272,10 +747,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.RecoverAccounts"] = func(ctx context.Context,
+	registry["poolrpc.Trader.AccountAuditLog"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &RecoverAccountsRequest{}
+		req := &AccountAuditLogRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -283,7 +758,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.RecoverAccounts(ctx, req)
+		resp, err := client.AccountAuditLog(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -297,10 +772,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.AccountModificationFees"] = func(ctx context.Context,
+	registry["poolrpc.Trader.SubmitOrder"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &AccountModificationFeesRequest{}
+		req := &SubmitOrderRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -308,7 +783,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.AccountModificationFees(ctx, req)
+		resp, err := client.SubmitOrder(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -322,7 +797,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.SubmitOrder"] = func(ctx context.Context,
+	registry["poolrpc.Trader.PrepareOrder"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
 		req := &SubmitOrderRequest{}
@@ -333,7 +808,32 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.SubmitOrder(ctx, req)
+		resp, err := client.PrepareOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ConfirmOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ConfirmOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ConfirmOrder(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -397,6 +897,31 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.ReplaceOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ReplaceOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ReplaceOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.QuoteOrder"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -497,6 +1022,123 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.GetServerParams"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ServerParamsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.GetServerParams(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListBatchConfirmations"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListBatchConfirmationsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListBatchConfirmations(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubscribeBatchConfirmations"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubscribeBatchConfirmationsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		stream, err := client.SubscribeBatchConfirmations(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.AcceptBatch"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &AcceptBatchRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.AcceptBatch(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.BatchSnapshot"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -547,6 +1189,31 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.DeleteLsatToken"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DeleteLsatTokenRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DeleteLsatToken(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.Leases"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -572,6 +1239,56 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.LeasePerformanceReport"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &LeasePerformanceReportRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.LeasePerformanceReport(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListPendingBatchChannels"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListPendingBatchChannelsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListPendingBatchChannels(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.NodeRatings"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -26,6 +26,39 @@ type TraderClient interface {
 	// pool: `stop`
 	//Stop gracefully shuts down the Pool trader daemon.
 	StopDaemon(ctx context.Context, in *StopDaemonRequest, opts ...grpc.CallOption) (*StopDaemonResponse, error)
+	// pool: `tlsinfo`
+	//GetTLSInfo returns information about the TLS certificate that is currently
+	//served by the daemon's RPC and REST interfaces.
+	GetTLSInfo(ctx context.Context, in *GetTLSInfoRequest, opts ...grpc.CallOption) (*GetTLSInfoResponse, error)
+	// pool: `testconnections`
+	//TestConnections dials the connected lnd node and the auction server on new
+	//connections and reports for each endpoint whether it could be reached and
+	//how long it took to get a response. No accounts or orders are touched.
+	TestConnections(ctx context.Context, in *TestConnectionsRequest, opts ...grpc.CallOption) (*TestConnectionsResponse, error)
+	// pool: `connectionstatus`
+	//GetConnectionStatus returns the state of the daemon's connection to lnd.
+	//If lnd restarts, the daemon reconnects automatically and calls that fail in
+	//the meantime return a retryable error with the Unavailable code.
+	GetConnectionStatus(ctx context.Context, in *GetConnectionStatusRequest, opts ...grpc.CallOption) (*GetConnectionStatusResponse, error)
+	// pool: `debug dumpruntime`
+	//DebugDump returns runtime statistics of the daemon like the number of
+	//goroutines and the heap usage, together with the active configuration with
+	//all secrets redacted. It is meant for remote diagnostics when the profiling
+	//port can't be reached and requires the admin macaroon.
+	DebugDump(ctx context.Context, in *DebugDumpRequest, opts ...grpc.CallOption) (*DebugDumpResponse, error)
+	// pool: `state export`
+	//ExportState returns the accounts, orders and batch snapshots of the daemon
+	//in a portable format, for example to migrate them to a new host. The leases
+	//are derived from the batch snapshots. The export doesn't contain any
+	//secrets, the account secrets are derived from lnd again on import.
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error)
+	// pool: `state import`
+	//ImportState loads a state that was exported with ExportState into the
+	//database of this daemon. All trader keys of the state must belong to the
+	//connected lnd node. The import fails without changing anything if any of
+	//the accounts, orders or batches already exist. The daemon needs to be
+	//restarted after the import to resume watching the imported accounts.
+	ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error)
 	//
 	//QuoteAccount gets a fee quote to fund an account of the given size with the
 	//given confirmation target. If the connected lnd wallet doesn't have enough
@@ -35,10 +68,53 @@ type TraderClient interface {
 	//InitAccount creates a new account with the requested size and expiration,
 	//funding it from the wallet of the connected lnd node.
 	InitAccount(ctx context.Context, in *InitAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// pool: `accounts newexternal`
+	//InitExternalAccount reserves a new account with the requested size and
+	//expiration that is funded by a transaction created and signed outside of
+	//the wallet of the connected lnd node, for example by a treasury service.
+	//The response contains the output the funding transaction must create. The
+	//signed transaction is then handed over with PublishExternalAccount.
+	InitExternalAccount(ctx context.Context, in *InitExternalAccountRequest, opts ...grpc.CallOption) (*InitExternalAccountResponse, error)
+	// pool: `accounts publishexternal`
+	//PublishExternalAccount verifies that the given fully signed transaction
+	//pays the exact account output of an account reserved with
+	//InitExternalAccount, then publishes it and tracks the account's
+	//confirmation.
+	PublishExternalAccount(ctx context.Context, in *PublishExternalAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// pool: `accounts cancelexternal`
+	//CancelExternalAccount cancels an account reserved with InitExternalAccount
+	//or PreviewAccountFunding that is still waiting for its funding transaction.
+	//Afterwards, the reservation no longer prevents new accounts from being
+	//created. The account is only canceled locally, the auction server keeps
+	//its reservation bound to the account's trader key and hands out the same
+	//reservation again for the next account that is created.
+	CancelExternalAccount(ctx context.Context, in *CancelExternalAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// pool: `accounts previewfunding`
+	//PreviewAccountFunding reserves a new account with the requested size and
+	//expiration and funds it from the wallet of the connected lnd node without
+	//signing or broadcasting the funding transaction. The response contains the
+	//unsigned transaction, its fee and its inputs and outputs for inspection.
+	//The transaction is signed and broadcast with ConfirmAccountFunding.
+	PreviewAccountFunding(ctx context.Context, in *PreviewAccountFundingRequest, opts ...grpc.CallOption) (*PreviewAccountFundingResponse, error)
+	// pool: `accounts confirmfunding`
+	//ConfirmAccountFunding signs and broadcasts the funding transaction of an
+	//account that was previewed with PreviewAccountFunding and tracks the
+	//account's confirmation.
+	ConfirmAccountFunding(ctx context.Context, in *ConfirmAccountFundingRequest, opts ...grpc.CallOption) (*Account, error)
 	// pool: `accounts list`
 	//ListAccounts returns a list of all accounts known to the trader daemon and
 	//their current state.
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// pool: `accounts exportkey`
+	//ExportAccountKey returns the key locator and derivation path of an
+	//account's trader key so it can be documented for recovery. No private key
+	//material is exposed.
+	ExportAccountKey(ctx context.Context, in *ExportAccountKeyRequest, opts ...grpc.CallOption) (*ExportAccountKeyResponse, error)
+	// pool: `accounts setpolicy`
+	//SetAccountPolicy sets the spending policy of an account, limiting the
+	//number of orders and the amount that can be committed to orders backed by
+	//it. Submissions that violate an account's policy are rejected.
+	SetAccountPolicy(ctx context.Context, in *SetAccountPolicyRequest, opts ...grpc.CallOption) (*SetAccountPolicyResponse, error)
 	// pool: `accounts close`
 	//CloseAccount closes an account and returns the funds locked in that account
 	//to the connected lnd node's wallet.
@@ -47,6 +123,16 @@ type TraderClient interface {
 	//WithdrawAccount splits off parts of the account balance into the specified
 	//outputs while recreating the account with a reduced balance.
 	WithdrawAccount(ctx context.Context, in *WithdrawAccountRequest, opts ...grpc.CallOption) (*WithdrawAccountResponse, error)
+	// pool: `accounts split`
+	//SplitAccount moves parts of an account's balance into a new account. The
+	//new account is funded by a withdrawal from the existing account, which
+	//pays the fee of the transaction.
+	SplitAccount(ctx context.Context, in *SplitAccountRequest, opts ...grpc.CallOption) (*SplitAccountResponse, error)
+	// pool: `accounts merge`
+	//MergeAccounts moves all funds of an account into another account with a
+	//single transaction that spends both accounts. The merged account is closed
+	//once the transaction confirms. Only Taproot accounts can be merged.
+	MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsResponse, error)
 	// pool: `accounts deposit`
 	//DepositAccount adds more funds from the connected lnd node's wallet to an
 	//account.
@@ -54,6 +140,15 @@ type TraderClient interface {
 	// pool: `accounts renew`
 	//RenewAccount renews the expiration of an account.
 	RenewAccount(ctx context.Context, in *RenewAccountRequest, opts ...grpc.CallOption) (*RenewAccountResponse, error)
+	// pool: `accounts quoterenew`
+	//QuoteAccountRenewal returns the estimated fees for renewing the expiration
+	//of an account, without signing or broadcasting any transaction.
+	QuoteAccountRenewal(ctx context.Context, in *QuoteAccountRenewalRequest, opts ...grpc.CallOption) (*QuoteAccountRenewalResponse, error)
+	// pool: `accounts simulatefees`
+	//SimulateAccountFees estimates the on-chain fees of opening, depositing to,
+	//withdrawing from and closing an account at several confirmation targets.
+	//The estimates are purely informational, no account is modified.
+	SimulateAccountFees(ctx context.Context, in *SimulateAccountFeesRequest, opts ...grpc.CallOption) (*SimulateAccountFeesResponse, error)
 	// pool: `accounts bumpfee`
 	//BumpAccountFee attempts to bump the fee of an account's transaction through
 	//child-pays-for-parent (CPFP). Since the CPFP is performed through the
@@ -70,10 +165,26 @@ type TraderClient interface {
 	//AccountModificationFees returns a map from account key to an ordered list of
 	//account action modification fees.
 	AccountModificationFees(ctx context.Context, in *AccountModificationFeesRequest, opts ...grpc.CallOption) (*AccountModificationFeesResponse, error)
+	// pool: `accounts auditlog`
+	//AccountAuditLog returns a chronological list of all persisted events of an
+	//account, like its creation, deposits, withdrawals, renewals, batch
+	//participations, expiry and closure.
+	AccountAuditLog(ctx context.Context, in *AccountAuditLogRequest, opts ...grpc.CallOption) (*AccountAuditLogResponse, error)
 	// pool: `orders submit`
 	//SubmitOrder creates a new ask or bid order and submits for the given account
 	//and submits it to the auction server for matching.
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	// pool: `orders submit --prepare_only`
+	//PrepareOrder validates a new ask or bid order and returns a preview of its
+	//premium and fees together with a short-lived confirmation token. The order
+	//is only submitted to the auction server once ConfirmOrder is called with
+	//that token.
+	PrepareOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*PrepareOrderResponse, error)
+	// pool: `orders confirm`
+	//ConfirmOrder submits an order that was previously prepared with
+	//PrepareOrder to the auction server. Each confirmation token can only be
+	//used once and only until it expires.
+	ConfirmOrder(ctx context.Context, in *ConfirmOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
 	// pool: `orders list`
 	//ListOrders returns a list of all active and archived orders that are
 	//currently known to the trader daemon.
@@ -82,6 +193,15 @@ type TraderClient interface {
 	//CancelOrder cancels an active order with the auction server to remove it
 	//from future matching.
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// pool: `orders replace`
+	//ReplaceOrder cancels an active order and submits a replacement for it with
+	//new parameters, for example to adjust the rate. The replacement must be of
+	//the same type and use the same account as the order it replaces. It is
+	//validated before the old order is canceled, and it is only submitted once
+	//the old order was canceled successfully, so both orders are never active at
+	//the same time. If the submission of the replacement fails, the old order
+	//stays canceled.
+	ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderResponse, error)
 	//
 	//QuoteOrder calculates the premium, execution fees and max batch fee rate for
 	//an order based on the given order parameters.
@@ -98,6 +218,27 @@ type TraderClient interface {
 	//NextBatchInfo returns information about the next batch the auctioneer will
 	//perform.
 	NextBatchInfo(ctx context.Context, in *NextBatchInfoRequest, opts ...grpc.CallOption) (*NextBatchInfoResponse, error)
+	// pool: `auction params`
+	//GetServerParams returns the order parameters the auction server currently
+	//enforces, like the smallest order amount, the maximum account value and the
+	//lease durations it accepts.
+	GetServerParams(ctx context.Context, in *ServerParamsRequest, opts ...grpc.CallOption) (*ServerParamsResponse, error)
+	// pool: `auction pendingbatches`
+	//ListBatchConfirmations lists the batches that are waiting to be accepted
+	//or rejected with AcceptBatch because what the trader would earn or pay in
+	//them is outside of the configured limits for accepting batches
+	//automatically.
+	ListBatchConfirmations(ctx context.Context, in *ListBatchConfirmationsRequest, opts ...grpc.CallOption) (*ListBatchConfirmationsResponse, error)
+	// pool: `auction subscribebatches`
+	//SubscribeBatchConfirmations returns a stream that receives every batch that
+	//starts waiting to be accepted or rejected with AcceptBatch. Batches that
+	//are already waiting when the subscription is created are sent first.
+	SubscribeBatchConfirmations(ctx context.Context, in *SubscribeBatchConfirmationsRequest, opts ...grpc.CallOption) (Trader_SubscribeBatchConfirmationsClient, error)
+	// pool: `auction acceptbatch`
+	//AcceptBatch accepts or rejects a batch that is waiting for its
+	//confirmation. A batch that isn't confirmed before the configured timeout
+	//is rejected.
+	AcceptBatch(ctx context.Context, in *AcceptBatchRequest, opts ...grpc.CallOption) (*AcceptBatchResponse, error)
 	// pool: `auction snapshot`
 	//BatchSnapshot returns the snapshot of a past batch identified by its ID.
 	//If no ID is provided, the snapshot of the last finalized batch is returned.
@@ -106,10 +247,25 @@ type TraderClient interface {
 	// pool: `listauth`
 	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
 	GetLsatTokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (*TokensResponse, error)
+	// pool: `deleteauth`
+	//DeleteLsatToken removes a stored LSAT token. If the token currently used
+	//to authenticate with the auction server is removed, a new one is paid for
+	//on the next request that requires one.
+	DeleteLsatToken(ctx context.Context, in *DeleteLsatTokenRequest, opts ...grpc.CallOption) (*DeleteLsatTokenResponse, error)
 	// pool: `auction leases`
 	//Leases returns the list of channels that were either purchased or sold by
 	//the trader within the auction.
 	Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error)
+	// pool: `auction leasereport`
+	//LeasePerformanceReport returns the premiums earned and paid, the fees paid
+	//and the effective annual percentage rate of all leases that were executed
+	//within the given time range.
+	LeasePerformanceReport(ctx context.Context, in *LeasePerformanceReportRequest, opts ...grpc.CallOption) (*LeasePerformanceReportResponse, error)
+	// pool: `auction pendingchannels`
+	//ListPendingBatchChannels returns the channels the trader expects to be
+	//opened by the last batch it participated in, together with their
+	//confirmation progress as reported by lnd.
+	ListPendingBatchChannels(ctx context.Context, in *ListPendingBatchChannelsRequest, opts ...grpc.CallOption) (*ListPendingBatchChannelsResponse, error)
 	// pool: `auction ratings`
 	//Returns the Node Tier information for this target Lightning node, and other
 	//related ranking information.
@@ -189,6 +345,60 @@ func (c *traderClient) StopDaemon(ctx context.Context, in *StopDaemonRequest, op
 	return out, nil
 }
 
+func (c *traderClient) GetTLSInfo(ctx context.Context, in *GetTLSInfoRequest, opts ...grpc.CallOption) (*GetTLSInfoResponse, error) {
+	out := new(GetTLSInfoResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/GetTLSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) TestConnections(ctx context.Context, in *TestConnectionsRequest, opts ...grpc.CallOption) (*TestConnectionsResponse, error) {
+	out := new(TestConnectionsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/TestConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) GetConnectionStatus(ctx context.Context, in *GetConnectionStatusRequest, opts ...grpc.CallOption) (*GetConnectionStatusResponse, error) {
+	out := new(GetConnectionStatusResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/GetConnectionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) DebugDump(ctx context.Context, in *DebugDumpRequest, opts ...grpc.CallOption) (*DebugDumpResponse, error) {
+	out := new(DebugDumpResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DebugDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error) {
+	out := new(ExportStateResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error) {
+	out := new(ImportStateResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) QuoteAccount(ctx context.Context, in *QuoteAccountRequest, opts ...grpc.CallOption) (*QuoteAccountResponse, error) {
 	out := new(QuoteAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteAccount", in, out, opts...)
@@ -207,6 +417,51 @@ func (c *traderClient) InitAccount(ctx context.Context, in *InitAccountRequest,
 	return out, nil
 }
 
+func (c *traderClient) InitExternalAccount(ctx context.Context, in *InitExternalAccountRequest, opts ...grpc.CallOption) (*InitExternalAccountResponse, error) {
+	out := new(InitExternalAccountResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/InitExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) PublishExternalAccount(ctx context.Context, in *PublishExternalAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/PublishExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) CancelExternalAccount(ctx context.Context, in *CancelExternalAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CancelExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) PreviewAccountFunding(ctx context.Context, in *PreviewAccountFundingRequest, opts ...grpc.CallOption) (*PreviewAccountFundingResponse, error) {
+	out := new(PreviewAccountFundingResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/PreviewAccountFunding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ConfirmAccountFunding(ctx context.Context, in *ConfirmAccountFundingRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ConfirmAccountFunding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
 	out := new(ListAccountsResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListAccounts", in, out, opts...)
@@ -216,6 +471,24 @@ func (c *traderClient) ListAccounts(ctx context.Context, in *ListAccountsRequest
 	return out, nil
 }
 
+func (c *traderClient) ExportAccountKey(ctx context.Context, in *ExportAccountKeyRequest, opts ...grpc.CallOption) (*ExportAccountKeyResponse, error) {
+	out := new(ExportAccountKeyResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ExportAccountKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SetAccountPolicy(ctx context.Context, in *SetAccountPolicyRequest, opts ...grpc.CallOption) (*SetAccountPolicyResponse, error) {
+	out := new(SetAccountPolicyResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SetAccountPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
 	out := new(CloseAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CloseAccount", in, out, opts...)
@@ -234,6 +507,24 @@ func (c *traderClient) WithdrawAccount(ctx context.Context, in *WithdrawAccountR
 	return out, nil
 }
 
+func (c *traderClient) SplitAccount(ctx context.Context, in *SplitAccountRequest, opts ...grpc.CallOption) (*SplitAccountResponse, error) {
+	out := new(SplitAccountResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SplitAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsResponse, error) {
+	out := new(MergeAccountsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/MergeAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) DepositAccount(ctx context.Context, in *DepositAccountRequest, opts ...grpc.CallOption) (*DepositAccountResponse, error) {
 	out := new(DepositAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DepositAccount", in, out, opts...)
@@ -252,6 +543,24 @@ func (c *traderClient) RenewAccount(ctx context.Context, in *RenewAccountRequest
 	return out, nil
 }
 
+func (c *traderClient) QuoteAccountRenewal(ctx context.Context, in *QuoteAccountRenewalRequest, opts ...grpc.CallOption) (*QuoteAccountRenewalResponse, error) {
+	out := new(QuoteAccountRenewalResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteAccountRenewal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SimulateAccountFees(ctx context.Context, in *SimulateAccountFeesRequest, opts ...grpc.CallOption) (*SimulateAccountFeesResponse, error) {
+	out := new(SimulateAccountFeesResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SimulateAccountFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) BumpAccountFee(ctx context.Context, in *BumpAccountFeeRequest, opts ...grpc.CallOption) (*BumpAccountFeeResponse, error) {
 	out := new(BumpAccountFeeResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BumpAccountFee", in, out, opts...)
@@ -279,6 +588,15 @@ func (c *traderClient) AccountModificationFees(ctx context.Context, in *AccountM
 	return out, nil
 }
 
+func (c *traderClient) AccountAuditLog(ctx context.Context, in *AccountAuditLogRequest, opts ...grpc.CallOption) (*AccountAuditLogResponse, error) {
+	out := new(AccountAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/AccountAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
 	out := new(SubmitOrderResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SubmitOrder", in, out, opts...)
@@ -288,6 +606,24 @@ func (c *traderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest,
 	return out, nil
 }
 
+func (c *traderClient) PrepareOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*PrepareOrderResponse, error) {
+	out := new(PrepareOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/PrepareOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ConfirmOrder(ctx context.Context, in *ConfirmOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ConfirmOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
 	out := new(ListOrdersResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListOrders", in, out, opts...)
@@ -306,6 +642,15 @@ func (c *traderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest,
 	return out, nil
 }
 
+func (c *traderClient) ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderResponse, error) {
+	out := new(ReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
 	out := new(QuoteOrderResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteOrder", in, out, opts...)
@@ -342,6 +687,65 @@ func (c *traderClient) NextBatchInfo(ctx context.Context, in *NextBatchInfoReque
 	return out, nil
 }
 
+func (c *traderClient) GetServerParams(ctx context.Context, in *ServerParamsRequest, opts ...grpc.CallOption) (*ServerParamsResponse, error) {
+	out := new(ServerParamsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/GetServerParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListBatchConfirmations(ctx context.Context, in *ListBatchConfirmationsRequest, opts ...grpc.CallOption) (*ListBatchConfirmationsResponse, error) {
+	out := new(ListBatchConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListBatchConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubscribeBatchConfirmations(ctx context.Context, in *SubscribeBatchConfirmationsRequest, opts ...grpc.CallOption) (Trader_SubscribeBatchConfirmationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[0], "/poolrpc.Trader/SubscribeBatchConfirmations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderSubscribeBatchConfirmationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_SubscribeBatchConfirmationsClient interface {
+	Recv() (*BatchConfirmation, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeBatchConfirmationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeBatchConfirmationsClient) Recv() (*BatchConfirmation, error) {
+	m := new(BatchConfirmation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *traderClient) AcceptBatch(ctx context.Context, in *AcceptBatchRequest, opts ...grpc.CallOption) (*AcceptBatchResponse, error) {
+	out := new(AcceptBatchResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/AcceptBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) BatchSnapshot(ctx context.Context, in *auctioneerrpc.BatchSnapshotRequest, opts ...grpc.CallOption) (*auctioneerrpc.BatchSnapshotResponse, error) {
 	out := new(auctioneerrpc.BatchSnapshotResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BatchSnapshot", in, out, opts...)
@@ -360,6 +764,15 @@ func (c *traderClient) GetLsatTokens(ctx context.Context, in *TokensRequest, opt
 	return out, nil
 }
 
+func (c *traderClient) DeleteLsatToken(ctx context.Context, in *DeleteLsatTokenRequest, opts ...grpc.CallOption) (*DeleteLsatTokenResponse, error) {
+	out := new(DeleteLsatTokenResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DeleteLsatToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error) {
 	out := new(LeasesResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/Leases", in, out, opts...)
@@ -369,6 +782,24 @@ func (c *traderClient) Leases(ctx context.Context, in *LeasesRequest, opts ...gr
 	return out, nil
 }
 
+func (c *traderClient) LeasePerformanceReport(ctx context.Context, in *LeasePerformanceReportRequest, opts ...grpc.CallOption) (*LeasePerformanceReportResponse, error) {
+	out := new(LeasePerformanceReportResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/LeasePerformanceReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListPendingBatchChannels(ctx context.Context, in *ListPendingBatchChannelsRequest, opts ...grpc.CallOption) (*ListPendingBatchChannelsResponse, error) {
+	out := new(ListPendingBatchChannelsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListPendingBatchChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) NodeRatings(ctx context.Context, in *NodeRatingRequest, opts ...grpc.CallOption) (*NodeRatingResponse, error) {
 	out := new(NodeRatingResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/NodeRatings", in, out, opts...)
@@ -452,6 +883,39 @@ type TraderServer interface {
 	// pool: `stop`
 	//Stop gracefully shuts down the Pool trader daemon.
 	StopDaemon(context.Context, *StopDaemonRequest) (*StopDaemonResponse, error)
+	// pool: `tlsinfo`
+	//GetTLSInfo returns information about the TLS certificate that is currently
+	//served by the daemon's RPC and REST interfaces.
+	GetTLSInfo(context.Context, *GetTLSInfoRequest) (*GetTLSInfoResponse, error)
+	// pool: `testconnections`
+	//TestConnections dials the connected lnd node and the auction server on new
+	//connections and reports for each endpoint whether it could be reached and
+	//how long it took to get a response. No accounts or orders are touched.
+	TestConnections(context.Context, *TestConnectionsRequest) (*TestConnectionsResponse, error)
+	// pool: `connectionstatus`
+	//GetConnectionStatus returns the state of the daemon's connection to lnd.
+	//If lnd restarts, the daemon reconnects automatically and calls that fail in
+	//the meantime return a retryable error with the Unavailable code.
+	GetConnectionStatus(context.Context, *GetConnectionStatusRequest) (*GetConnectionStatusResponse, error)
+	// pool: `debug dumpruntime`
+	//DebugDump returns runtime statistics of the daemon like the number of
+	//goroutines and the heap usage, together with the active configuration with
+	//all secrets redacted. It is meant for remote diagnostics when the profiling
+	//port can't be reached and requires the admin macaroon.
+	DebugDump(context.Context, *DebugDumpRequest) (*DebugDumpResponse, error)
+	// pool: `state export`
+	//ExportState returns the accounts, orders and batch snapshots of the daemon
+	//in a portable format, for example to migrate them to a new host. The leases
+	//are derived from the batch snapshots. The export doesn't contain any
+	//secrets, the account secrets are derived from lnd again on import.
+	ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error)
+	// pool: `state import`
+	//ImportState loads a state that was exported with ExportState into the
+	//database of this daemon. All trader keys of the state must belong to the
+	//connected lnd node. The import fails without changing anything if any of
+	//the accounts, orders or batches already exist. The daemon needs to be
+	//restarted after the import to resume watching the imported accounts.
+	ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error)
 	//
 	//QuoteAccount gets a fee quote to fund an account of the given size with the
 	//given confirmation target. If the connected lnd wallet doesn't have enough
@@ -461,10 +925,53 @@ type TraderServer interface {
 	//InitAccount creates a new account with the requested size and expiration,
 	//funding it from the wallet of the connected lnd node.
 	InitAccount(context.Context, *InitAccountRequest) (*Account, error)
+	// pool: `accounts newexternal`
+	//InitExternalAccount reserves a new account with the requested size and
+	//expiration that is funded by a transaction created and signed outside of
+	//the wallet of the connected lnd node, for example by a treasury service.
+	//The response contains the output the funding transaction must create. The
+	//signed transaction is then handed over with PublishExternalAccount.
+	InitExternalAccount(context.Context, *InitExternalAccountRequest) (*InitExternalAccountResponse, error)
+	// pool: `accounts publishexternal`
+	//PublishExternalAccount verifies that the given fully signed transaction
+	//pays the exact account output of an account reserved with
+	//InitExternalAccount, then publishes it and tracks the account's
+	//confirmation.
+	PublishExternalAccount(context.Context, *PublishExternalAccountRequest) (*Account, error)
+	// pool: `accounts cancelexternal`
+	//CancelExternalAccount cancels an account reserved with InitExternalAccount
+	//or PreviewAccountFunding that is still waiting for its funding transaction.
+	//Afterwards, the reservation no longer prevents new accounts from being
+	//created. The account is only canceled locally, the auction server keeps
+	//its reservation bound to the account's trader key and hands out the same
+	//reservation again for the next account that is created.
+	CancelExternalAccount(context.Context, *CancelExternalAccountRequest) (*Account, error)
+	// pool: `accounts previewfunding`
+	//PreviewAccountFunding reserves a new account with the requested size and
+	//expiration and funds it from the wallet of the connected lnd node without
+	//signing or broadcasting the funding transaction. The response contains the
+	//unsigned transaction, its fee and its inputs and outputs for inspection.
+	//The transaction is signed and broadcast with ConfirmAccountFunding.
+	PreviewAccountFunding(context.Context, *PreviewAccountFundingRequest) (*PreviewAccountFundingResponse, error)
+	// pool: `accounts confirmfunding`
+	//ConfirmAccountFunding signs and broadcasts the funding transaction of an
+	//account that was previewed with PreviewAccountFunding and tracks the
+	//account's confirmation.
+	ConfirmAccountFunding(context.Context, *ConfirmAccountFundingRequest) (*Account, error)
 	// pool: `accounts list`
 	//ListAccounts returns a list of all accounts known to the trader daemon and
 	//their current state.
 	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// pool: `accounts exportkey`
+	//ExportAccountKey returns the key locator and derivation path of an
+	//account's trader key so it can be documented for recovery. No private key
+	//material is exposed.
+	ExportAccountKey(context.Context, *ExportAccountKeyRequest) (*ExportAccountKeyResponse, error)
+	// pool: `accounts setpolicy`
+	//SetAccountPolicy sets the spending policy of an account, limiting the
+	//number of orders and the amount that can be committed to orders backed by
+	//it. Submissions that violate an account's policy are rejected.
+	SetAccountPolicy(context.Context, *SetAccountPolicyRequest) (*SetAccountPolicyResponse, error)
 	// pool: `accounts close`
 	//CloseAccount closes an account and returns the funds locked in that account
 	//to the connected lnd node's wallet.
@@ -473,6 +980,16 @@ type TraderServer interface {
 	//WithdrawAccount splits off parts of the account balance into the specified
 	//outputs while recreating the account with a reduced balance.
 	WithdrawAccount(context.Context, *WithdrawAccountRequest) (*WithdrawAccountResponse, error)
+	// pool: `accounts split`
+	//SplitAccount moves parts of an account's balance into a new account. The
+	//new account is funded by a withdrawal from the existing account, which
+	//pays the fee of the transaction.
+	SplitAccount(context.Context, *SplitAccountRequest) (*SplitAccountResponse, error)
+	// pool: `accounts merge`
+	//MergeAccounts moves all funds of an account into another account with a
+	//single transaction that spends both accounts. The merged account is closed
+	//once the transaction confirms. Only Taproot accounts can be merged.
+	MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsResponse, error)
 	// pool: `accounts deposit`
 	//DepositAccount adds more funds from the connected lnd node's wallet to an
 	//account.
@@ -480,6 +997,15 @@ type TraderServer interface {
 	// pool: `accounts renew`
 	//RenewAccount renews the expiration of an account.
 	RenewAccount(context.Context, *RenewAccountRequest) (*RenewAccountResponse, error)
+	// pool: `accounts quoterenew`
+	//QuoteAccountRenewal returns the estimated fees for renewing the expiration
+	//of an account, without signing or broadcasting any transaction.
+	QuoteAccountRenewal(context.Context, *QuoteAccountRenewalRequest) (*QuoteAccountRenewalResponse, error)
+	// pool: `accounts simulatefees`
+	//SimulateAccountFees estimates the on-chain fees of opening, depositing to,
+	//withdrawing from and closing an account at several confirmation targets.
+	//The estimates are purely informational, no account is modified.
+	SimulateAccountFees(context.Context, *SimulateAccountFeesRequest) (*SimulateAccountFeesResponse, error)
 	// pool: `accounts bumpfee`
 	//BumpAccountFee attempts to bump the fee of an account's transaction through
 	//child-pays-for-parent (CPFP). Since the CPFP is performed through the
@@ -496,10 +1022,26 @@ type TraderServer interface {
 	//AccountModificationFees returns a map from account key to an ordered list of
 	//account action modification fees.
 	AccountModificationFees(context.Context, *AccountModificationFeesRequest) (*AccountModificationFeesResponse, error)
+	// pool: `accounts auditlog`
+	//AccountAuditLog returns a chronological list of all persisted events of an
+	//account, like its creation, deposits, withdrawals, renewals, batch
+	//participations, expiry and closure.
+	AccountAuditLog(context.Context, *AccountAuditLogRequest) (*AccountAuditLogResponse, error)
 	// pool: `orders submit`
 	//SubmitOrder creates a new ask or bid order and submits for the given account
 	//and submits it to the auction server for matching.
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	// pool: `orders submit --prepare_only`
+	//PrepareOrder validates a new ask or bid order and returns a preview of its
+	//premium and fees together with a short-lived confirmation token. The order
+	//is only submitted to the auction server once ConfirmOrder is called with
+	//that token.
+	PrepareOrder(context.Context, *SubmitOrderRequest) (*PrepareOrderResponse, error)
+	// pool: `orders confirm`
+	//ConfirmOrder submits an order that was previously prepared with
+	//PrepareOrder to the auction server. Each confirmation token can only be
+	//used once and only until it expires.
+	ConfirmOrder(context.Context, *ConfirmOrderRequest) (*SubmitOrderResponse, error)
 	// pool: `orders list`
 	//ListOrders returns a list of all active and archived orders that are
 	//currently known to the trader daemon.
@@ -508,6 +1050,15 @@ type TraderServer interface {
 	//CancelOrder cancels an active order with the auction server to remove it
 	//from future matching.
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// pool: `orders replace`
+	//ReplaceOrder cancels an active order and submits a replacement for it with
+	//new parameters, for example to adjust the rate. The replacement must be of
+	//the same type and use the same account as the order it replaces. It is
+	//validated before the old order is canceled, and it is only submitted once
+	//the old order was canceled successfully, so both orders are never active at
+	//the same time. If the submission of the replacement fails, the old order
+	//stays canceled.
+	ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderResponse, error)
 	//
 	//QuoteOrder calculates the premium, execution fees and max batch fee rate for
 	//an order based on the given order parameters.
@@ -524,6 +1075,27 @@ type TraderServer interface {
 	//NextBatchInfo returns information about the next batch the auctioneer will
 	//perform.
 	NextBatchInfo(context.Context, *NextBatchInfoRequest) (*NextBatchInfoResponse, error)
+	// pool: `auction params`
+	//GetServerParams returns the order parameters the auction server currently
+	//enforces, like the smallest order amount, the maximum account value and the
+	//lease durations it accepts.
+	GetServerParams(context.Context, *ServerParamsRequest) (*ServerParamsResponse, error)
+	// pool: `auction pendingbatches`
+	//ListBatchConfirmations lists the batches that are waiting to be accepted
+	//or rejected with AcceptBatch because what the trader would earn or pay in
+	//them is outside of the configured limits for accepting batches
+	//automatically.
+	ListBatchConfirmations(context.Context, *ListBatchConfirmationsRequest) (*ListBatchConfirmationsResponse, error)
+	// pool: `auction subscribebatches`
+	//SubscribeBatchConfirmations returns a stream that receives every batch that
+	//starts waiting to be accepted or rejected with AcceptBatch. Batches that
+	//are already waiting when the subscription is created are sent first.
+	SubscribeBatchConfirmations(*SubscribeBatchConfirmationsRequest, Trader_SubscribeBatchConfirmationsServer) error
+	// pool: `auction acceptbatch`
+	//AcceptBatch accepts or rejects a batch that is waiting for its
+	//confirmation. A batch that isn't confirmed before the configured timeout
+	//is rejected.
+	AcceptBatch(context.Context, *AcceptBatchRequest) (*AcceptBatchResponse, error)
 	// pool: `auction snapshot`
 	//BatchSnapshot returns the snapshot of a past batch identified by its ID.
 	//If no ID is provided, the snapshot of the last finalized batch is returned.
@@ -532,10 +1104,25 @@ type TraderServer interface {
 	// pool: `listauth`
 	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
 	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
+	// pool: `deleteauth`
+	//DeleteLsatToken removes a stored LSAT token. If the token currently used
+	//to authenticate with the auction server is removed, a new one is paid for
+	//on the next request that requires one.
+	DeleteLsatToken(context.Context, *DeleteLsatTokenRequest) (*DeleteLsatTokenResponse, error)
 	// pool: `auction leases`
 	//Leases returns the list of channels that were either purchased or sold by
 	//the trader within the auction.
 	Leases(context.Context, *LeasesRequest) (*LeasesResponse, error)
+	// pool: `auction leasereport`
+	//LeasePerformanceReport returns the premiums earned and paid, the fees paid
+	//and the effective annual percentage rate of all leases that were executed
+	//within the given time range.
+	LeasePerformanceReport(context.Context, *LeasePerformanceReportRequest) (*LeasePerformanceReportResponse, error)
+	// pool: `auction pendingchannels`
+	//ListPendingBatchChannels returns the channels the trader expects to be
+	//opened by the last batch it participated in, together with their
+	//confirmation progress as reported by lnd.
+	ListPendingBatchChannels(context.Context, *ListPendingBatchChannelsRequest) (*ListPendingBatchChannelsResponse, error)
 	// pool: `auction ratings`
 	//Returns the Node Tier information for this target Lightning node, and other
 	//related ranking information.
@@ -600,27 +1187,78 @@ func (UnimplementedTraderServer) GetInfo(context.Context, *GetInfoRequest) (*Get
 func (UnimplementedTraderServer) StopDaemon(context.Context, *StopDaemonRequest) (*StopDaemonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
+func (UnimplementedTraderServer) GetTLSInfo(context.Context, *GetTLSInfoRequest) (*GetTLSInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTLSInfo not implemented")
+}
+func (UnimplementedTraderServer) TestConnections(context.Context, *TestConnectionsRequest) (*TestConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestConnections not implemented")
+}
+func (UnimplementedTraderServer) GetConnectionStatus(context.Context, *GetConnectionStatusRequest) (*GetConnectionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionStatus not implemented")
+}
+func (UnimplementedTraderServer) DebugDump(context.Context, *DebugDumpRequest) (*DebugDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugDump not implemented")
+}
+func (UnimplementedTraderServer) ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedTraderServer) ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportState not implemented")
+}
 func (UnimplementedTraderServer) QuoteAccount(context.Context, *QuoteAccountRequest) (*QuoteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteAccount not implemented")
 }
 func (UnimplementedTraderServer) InitAccount(context.Context, *InitAccountRequest) (*Account, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitAccount not implemented")
 }
+func (UnimplementedTraderServer) InitExternalAccount(context.Context, *InitExternalAccountRequest) (*InitExternalAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitExternalAccount not implemented")
+}
+func (UnimplementedTraderServer) PublishExternalAccount(context.Context, *PublishExternalAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishExternalAccount not implemented")
+}
+func (UnimplementedTraderServer) CancelExternalAccount(context.Context, *CancelExternalAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExternalAccount not implemented")
+}
+func (UnimplementedTraderServer) PreviewAccountFunding(context.Context, *PreviewAccountFundingRequest) (*PreviewAccountFundingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAccountFunding not implemented")
+}
+func (UnimplementedTraderServer) ConfirmAccountFunding(context.Context, *ConfirmAccountFundingRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAccountFunding not implemented")
+}
 func (UnimplementedTraderServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
+func (UnimplementedTraderServer) ExportAccountKey(context.Context, *ExportAccountKeyRequest) (*ExportAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountKey not implemented")
+}
+func (UnimplementedTraderServer) SetAccountPolicy(context.Context, *SetAccountPolicyRequest) (*SetAccountPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPolicy not implemented")
+}
 func (UnimplementedTraderServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
 }
 func (UnimplementedTraderServer) WithdrawAccount(context.Context, *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAccount not implemented")
 }
+func (UnimplementedTraderServer) SplitAccount(context.Context, *SplitAccountRequest) (*SplitAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitAccount not implemented")
+}
+func (UnimplementedTraderServer) MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAccounts not implemented")
+}
 func (UnimplementedTraderServer) DepositAccount(context.Context, *DepositAccountRequest) (*DepositAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositAccount not implemented")
 }
 func (UnimplementedTraderServer) RenewAccount(context.Context, *RenewAccountRequest) (*RenewAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenewAccount not implemented")
 }
+func (UnimplementedTraderServer) QuoteAccountRenewal(context.Context, *QuoteAccountRenewalRequest) (*QuoteAccountRenewalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteAccountRenewal not implemented")
+}
+func (UnimplementedTraderServer) SimulateAccountFees(context.Context, *SimulateAccountFeesRequest) (*SimulateAccountFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAccountFees not implemented")
+}
 func (UnimplementedTraderServer) BumpAccountFee(context.Context, *BumpAccountFeeRequest) (*BumpAccountFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpAccountFee not implemented")
 }
@@ -630,15 +1268,27 @@ func (UnimplementedTraderServer) RecoverAccounts(context.Context, *RecoverAccoun
 func (UnimplementedTraderServer) AccountModificationFees(context.Context, *AccountModificationFeesRequest) (*AccountModificationFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountModificationFees not implemented")
 }
+func (UnimplementedTraderServer) AccountAuditLog(context.Context, *AccountAuditLogRequest) (*AccountAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuditLog not implemented")
+}
 func (UnimplementedTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedTraderServer) PrepareOrder(context.Context, *SubmitOrderRequest) (*PrepareOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareOrder not implemented")
+}
+func (UnimplementedTraderServer) ConfirmOrder(context.Context, *ConfirmOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
 func (UnimplementedTraderServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
 func (UnimplementedTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedTraderServer) ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
 func (UnimplementedTraderServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
 }
@@ -651,15 +1301,36 @@ func (UnimplementedTraderServer) LeaseDurations(context.Context, *LeaseDurationR
 func (UnimplementedTraderServer) NextBatchInfo(context.Context, *NextBatchInfoRequest) (*NextBatchInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextBatchInfo not implemented")
 }
+func (UnimplementedTraderServer) GetServerParams(context.Context, *ServerParamsRequest) (*ServerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerParams not implemented")
+}
+func (UnimplementedTraderServer) ListBatchConfirmations(context.Context, *ListBatchConfirmationsRequest) (*ListBatchConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchConfirmations not implemented")
+}
+func (UnimplementedTraderServer) SubscribeBatchConfirmations(*SubscribeBatchConfirmationsRequest, Trader_SubscribeBatchConfirmationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBatchConfirmations not implemented")
+}
+func (UnimplementedTraderServer) AcceptBatch(context.Context, *AcceptBatchRequest) (*AcceptBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptBatch not implemented")
+}
 func (UnimplementedTraderServer) BatchSnapshot(context.Context, *auctioneerrpc.BatchSnapshotRequest) (*auctioneerrpc.BatchSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchSnapshot not implemented")
 }
 func (UnimplementedTraderServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
 }
+func (UnimplementedTraderServer) DeleteLsatToken(context.Context, *DeleteLsatTokenRequest) (*DeleteLsatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLsatToken not implemented")
+}
 func (UnimplementedTraderServer) Leases(context.Context, *LeasesRequest) (*LeasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
 }
+func (UnimplementedTraderServer) LeasePerformanceReport(context.Context, *LeasePerformanceReportRequest) (*LeasePerformanceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeasePerformanceReport not implemented")
+}
+func (UnimplementedTraderServer) ListPendingBatchChannels(context.Context, *ListPendingBatchChannelsRequest) (*ListPendingBatchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingBatchChannels not implemented")
+}
 func (UnimplementedTraderServer) NodeRatings(context.Context, *NodeRatingRequest) (*NodeRatingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeRatings not implemented")
 }
@@ -733,139 +1404,445 @@ func _Trader_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_QuoteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteAccountRequest)
+func _Trader_GetTLSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTLSInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).QuoteAccount(ctx, in)
+		return srv.(TraderServer).GetTLSInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/QuoteAccount",
+		FullMethod: "/poolrpc.Trader/GetTLSInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).QuoteAccount(ctx, req.(*QuoteAccountRequest))
+		return srv.(TraderServer).GetTLSInfo(ctx, req.(*GetTLSInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_InitAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitAccountRequest)
+func _Trader_TestConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestConnectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).InitAccount(ctx, in)
+		return srv.(TraderServer).TestConnections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/InitAccount",
+		FullMethod: "/poolrpc.Trader/TestConnections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).InitAccount(ctx, req.(*InitAccountRequest))
+		return srv.(TraderServer).TestConnections(ctx, req.(*TestConnectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
+func _Trader_GetConnectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).ListAccounts(ctx, in)
+		return srv.(TraderServer).GetConnectionStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/ListAccounts",
+		FullMethod: "/poolrpc.Trader/GetConnectionStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+		return srv.(TraderServer).GetConnectionStatus(ctx, req.(*GetConnectionStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseAccountRequest)
+func _Trader_DebugDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugDumpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).CloseAccount(ctx, in)
+		return srv.(TraderServer).DebugDump(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/CloseAccount",
+		FullMethod: "/poolrpc.Trader/DebugDump",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+		return srv.(TraderServer).DebugDump(ctx, req.(*DebugDumpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_WithdrawAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawAccountRequest)
+func _Trader_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).WithdrawAccount(ctx, in)
+		return srv.(TraderServer).ExportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/WithdrawAccount",
+		FullMethod: "/poolrpc.Trader/ExportState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).WithdrawAccount(ctx, req.(*WithdrawAccountRequest))
+		return srv.(TraderServer).ExportState(ctx, req.(*ExportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositAccountRequest)
+func _Trader_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).DepositAccount(ctx, in)
+		return srv.(TraderServer).ImportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/DepositAccount",
+		FullMethod: "/poolrpc.Trader/ImportState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).DepositAccount(ctx, req.(*DepositAccountRequest))
+		return srv.(TraderServer).ImportState(ctx, req.(*ImportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_RenewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewAccountRequest)
+func _Trader_QuoteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).RenewAccount(ctx, in)
+		return srv.(TraderServer).QuoteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/RenewAccount",
+		FullMethod: "/poolrpc.Trader/QuoteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).RenewAccount(ctx, req.(*RenewAccountRequest))
+		return srv.(TraderServer).QuoteAccount(ctx, req.(*QuoteAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_BumpAccountFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BumpAccountFeeRequest)
+func _Trader_InitAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).BumpAccountFee(ctx, in)
+		return srv.(TraderServer).InitAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/InitAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).InitAccount(ctx, req.(*InitAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_InitExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).InitExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/InitExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).InitExternalAccount(ctx, req.(*InitExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_PublishExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).PublishExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/PublishExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).PublishExternalAccount(ctx, req.(*PublishExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_CancelExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).CancelExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/CancelExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).CancelExternalAccount(ctx, req.(*CancelExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_PreviewAccountFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewAccountFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).PreviewAccountFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/PreviewAccountFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).PreviewAccountFunding(ctx, req.(*PreviewAccountFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ConfirmAccountFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAccountFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ConfirmAccountFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ConfirmAccountFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ConfirmAccountFunding(ctx, req.(*ConfirmAccountFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ExportAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ExportAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ExportAccountKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ExportAccountKey(ctx, req.(*ExportAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SetAccountPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SetAccountPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SetAccountPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SetAccountPolicy(ctx, req.(*SetAccountPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/CloseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_WithdrawAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).WithdrawAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/WithdrawAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).WithdrawAccount(ctx, req.(*WithdrawAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SplitAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SplitAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SplitAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SplitAccount(ctx, req.(*SplitAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_MergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).MergeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/MergeAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).MergeAccounts(ctx, req.(*MergeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).DepositAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/DepositAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).DepositAccount(ctx, req.(*DepositAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_RenewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).RenewAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/RenewAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).RenewAccount(ctx, req.(*RenewAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_QuoteAccountRenewal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteAccountRenewalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).QuoteAccountRenewal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/QuoteAccountRenewal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).QuoteAccountRenewal(ctx, req.(*QuoteAccountRenewalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SimulateAccountFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAccountFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SimulateAccountFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SimulateAccountFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SimulateAccountFees(ctx, req.(*SimulateAccountFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_BumpAccountFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpAccountFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).BumpAccountFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -913,6 +1890,24 @@ func _Trader_AccountModificationFees_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_AccountAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).AccountAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/AccountAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).AccountAuditLog(ctx, req.(*AccountAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitOrderRequest)
 	if err := dec(in); err != nil {
@@ -931,6 +1926,42 @@ func _Trader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_PrepareOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).PrepareOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/PrepareOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).PrepareOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ConfirmOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ConfirmOrder(ctx, req.(*ConfirmOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrdersRequest)
 	if err := dec(in); err != nil {
@@ -967,6 +1998,24 @@ func _Trader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ReplaceOrder(ctx, req.(*ReplaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuoteOrderRequest)
 	if err := dec(in); err != nil {
@@ -1039,6 +2088,81 @@ func _Trader_NextBatchInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_GetServerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).GetServerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/GetServerParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).GetServerParams(ctx, req.(*ServerParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListBatchConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListBatchConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListBatchConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListBatchConfirmations(ctx, req.(*ListBatchConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubscribeBatchConfirmations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBatchConfirmationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeBatchConfirmations(m, &traderSubscribeBatchConfirmationsServer{stream})
+}
+
+type Trader_SubscribeBatchConfirmationsServer interface {
+	Send(*BatchConfirmation) error
+	grpc.ServerStream
+}
+
+type traderSubscribeBatchConfirmationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeBatchConfirmationsServer) Send(m *BatchConfirmation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_AcceptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).AcceptBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/AcceptBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).AcceptBatch(ctx, req.(*AcceptBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_BatchSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(auctioneerrpc.BatchSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -1075,6 +2199,24 @@ func _Trader_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_DeleteLsatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLsatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).DeleteLsatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/DeleteLsatToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).DeleteLsatToken(ctx, req.(*DeleteLsatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeasesRequest)
 	if err := dec(in); err != nil {
@@ -1093,6 +2235,42 @@ func _Trader_Leases_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_LeasePerformanceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeasePerformanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).LeasePerformanceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/LeasePerformanceReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).LeasePerformanceReport(ctx, req.(*LeasePerformanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListPendingBatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingBatchChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListPendingBatchChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListPendingBatchChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListPendingBatchChannels(ctx, req.(*ListPendingBatchChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_NodeRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeRatingRequest)
 	if err := dec(in); err != nil {
@@ -1252,6 +2430,30 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopDaemon",
 			Handler:    _Trader_StopDaemon_Handler,
 		},
+		{
+			MethodName: "GetTLSInfo",
+			Handler:    _Trader_GetTLSInfo_Handler,
+		},
+		{
+			MethodName: "TestConnections",
+			Handler:    _Trader_TestConnections_Handler,
+		},
+		{
+			MethodName: "GetConnectionStatus",
+			Handler:    _Trader_GetConnectionStatus_Handler,
+		},
+		{
+			MethodName: "DebugDump",
+			Handler:    _Trader_DebugDump_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _Trader_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _Trader_ImportState_Handler,
+		},
 		{
 			MethodName: "QuoteAccount",
 			Handler:    _Trader_QuoteAccount_Handler,
@@ -1260,10 +2462,38 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitAccount",
 			Handler:    _Trader_InitAccount_Handler,
 		},
+		{
+			MethodName: "InitExternalAccount",
+			Handler:    _Trader_InitExternalAccount_Handler,
+		},
+		{
+			MethodName: "PublishExternalAccount",
+			Handler:    _Trader_PublishExternalAccount_Handler,
+		},
+		{
+			MethodName: "CancelExternalAccount",
+			Handler:    _Trader_CancelExternalAccount_Handler,
+		},
+		{
+			MethodName: "PreviewAccountFunding",
+			Handler:    _Trader_PreviewAccountFunding_Handler,
+		},
+		{
+			MethodName: "ConfirmAccountFunding",
+			Handler:    _Trader_ConfirmAccountFunding_Handler,
+		},
 		{
 			MethodName: "ListAccounts",
 			Handler:    _Trader_ListAccounts_Handler,
 		},
+		{
+			MethodName: "ExportAccountKey",
+			Handler:    _Trader_ExportAccountKey_Handler,
+		},
+		{
+			MethodName: "SetAccountPolicy",
+			Handler:    _Trader_SetAccountPolicy_Handler,
+		},
 		{
 			MethodName: "CloseAccount",
 			Handler:    _Trader_CloseAccount_Handler,
@@ -1272,6 +2502,14 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawAccount",
 			Handler:    _Trader_WithdrawAccount_Handler,
 		},
+		{
+			MethodName: "SplitAccount",
+			Handler:    _Trader_SplitAccount_Handler,
+		},
+		{
+			MethodName: "MergeAccounts",
+			Handler:    _Trader_MergeAccounts_Handler,
+		},
 		{
 			MethodName: "DepositAccount",
 			Handler:    _Trader_DepositAccount_Handler,
@@ -1280,6 +2518,14 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenewAccount",
 			Handler:    _Trader_RenewAccount_Handler,
 		},
+		{
+			MethodName: "QuoteAccountRenewal",
+			Handler:    _Trader_QuoteAccountRenewal_Handler,
+		},
+		{
+			MethodName: "SimulateAccountFees",
+			Handler:    _Trader_SimulateAccountFees_Handler,
+		},
 		{
 			MethodName: "BumpAccountFee",
 			Handler:    _Trader_BumpAccountFee_Handler,
@@ -1292,10 +2538,22 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountModificationFees",
 			Handler:    _Trader_AccountModificationFees_Handler,
 		},
+		{
+			MethodName: "AccountAuditLog",
+			Handler:    _Trader_AccountAuditLog_Handler,
+		},
 		{
 			MethodName: "SubmitOrder",
 			Handler:    _Trader_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "PrepareOrder",
+			Handler:    _Trader_PrepareOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _Trader_ConfirmOrder_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _Trader_ListOrders_Handler,
@@ -1304,6 +2562,10 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Trader_CancelOrder_Handler,
 		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Trader_ReplaceOrder_Handler,
+		},
 		{
 			MethodName: "QuoteOrder",
 			Handler:    _Trader_QuoteOrder_Handler,
@@ -1320,6 +2582,18 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NextBatchInfo",
 			Handler:    _Trader_NextBatchInfo_Handler,
 		},
+		{
+			MethodName: "GetServerParams",
+			Handler:    _Trader_GetServerParams_Handler,
+		},
+		{
+			MethodName: "ListBatchConfirmations",
+			Handler:    _Trader_ListBatchConfirmations_Handler,
+		},
+		{
+			MethodName: "AcceptBatch",
+			Handler:    _Trader_AcceptBatch_Handler,
+		},
 		{
 			MethodName: "BatchSnapshot",
 			Handler:    _Trader_BatchSnapshot_Handler,
@@ -1328,10 +2602,22 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLsatTokens",
 			Handler:    _Trader_GetLsatTokens_Handler,
 		},
+		{
+			MethodName: "DeleteLsatToken",
+			Handler:    _Trader_DeleteLsatToken_Handler,
+		},
 		{
 			MethodName: "Leases",
 			Handler:    _Trader_Leases_Handler,
 		},
+		{
+			MethodName: "LeasePerformanceReport",
+			Handler:    _Trader_LeasePerformanceReport_Handler,
+		},
+		{
+			MethodName: "ListPendingBatchChannels",
+			Handler:    _Trader_ListPendingBatchChannels_Handler,
+		},
 		{
 			MethodName: "NodeRatings",
 			Handler:    _Trader_NodeRatings_Handler,
@@ -1365,6 +2651,12 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Trader_CancelSidecar_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBatchConfirmations",
+			Handler:       _Trader_SubscribeBatchConfirmations_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "trader.proto",
 }
@@ -0,0 +1,97 @@
+package pool
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/order"
+)
+
+const (
+	// ProtocolMismatchFail is the protocol mismatch behavior that shuts
+	// down the trader daemon if the auction server uses a newer protocol
+	// version than this trader client supports.
+	ProtocolMismatchFail = "fail"
+
+	// ProtocolMismatchWarn is the protocol mismatch behavior that only
+	// logs a warning and keeps running if the auction server uses a newer
+	// protocol version than this trader client supports.
+	ProtocolMismatchWarn = "warn"
+)
+
+// ErrProtocolVersionMismatch is the error that is returned if the auction
+// server uses a newer protocol version than this trader client supports.
+type ErrProtocolVersionMismatch struct {
+	*order.ErrVersionMismatch
+}
+
+// Error returns the underlying error message.
+func (e *ErrProtocolVersionMismatch) Error() string {
+	return fmt.Sprintf("auction server protocol version not supported, "+
+		"this version of poold only supports up to version %d (%v), "+
+		"please upgrade poold to the latest release",
+		order.LatestBatchVersion, e.ErrVersionMismatch)
+}
+
+// Unwrap returns the batch version mismatch that caused the error.
+func (e *ErrProtocolVersionMismatch) Unwrap() error {
+	return e.ErrVersionMismatch
+}
+
+// checkBatchVersion compares the version of a batch the auction server
+// prepared with the latest batch version we support and returns the version
+// the batch should be verified with. The auction server signals the version of
+// its protocol through the batch version. Servers that use a newer version than
+// we implement are rejected with an error asking the user to upgrade. Servers
+// that use an older version are backward compatible, so we continue with their
+// version instead of ours.
+func checkBatchVersion(serverVersion,
+	supportedVersion order.BatchVersion) (order.BatchVersion, error) {
+
+	switch {
+	case serverVersion > order.LatestBatchVersion:
+		return 0, &ErrProtocolVersionMismatch{
+			ErrVersionMismatch: order.NewErrVersionMismatch(
+				supportedVersion, serverVersion,
+			),
+		}
+
+	case serverVersion <= supportedVersion:
+		return serverVersion, nil
+	}
+
+	// The server uses a newer version we implement but can't use, for
+	// example because our lnd node doesn't support it. The batch
+	// verification rejects the batch in that case.
+	return supportedVersion, nil
+}
+
+// negotiateBatchVersion makes sure we verify the batch prepared by the auction
+// server with the batch version the server uses, as long as we support it. The
+// version is used for all later batches and account subscriptions too.
+func (s *rpcServer) negotiateBatchVersion(batch *order.Batch) error {
+	ourVersion, err := s.server.determineBatchVersion()
+	if err != nil {
+		return err
+	}
+	supportedVersion, err := s.server.supportedBatchVersion()
+	if err != nil {
+		return err
+	}
+
+	version, err := checkBatchVersion(batch.Version, supportedVersion)
+	if err != nil {
+		return err
+	}
+
+	if version != ourVersion {
+		rpcLog.Infof("Auction server uses batch version %d, "+
+			"continuing with it instead of version %d", version,
+			ourVersion)
+
+		s.server.limitBatchVersion(version)
+		s.auctioneer.SetBatchVersion(version)
+		s.orderManager.SetBatchVersion(version)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckBatchVersion makes sure a newer auction server is rejected with an
+// actionable error and that we continue with the version of an older server.
+func TestCheckBatchVersion(t *testing.T) {
+	t.Parallel()
+
+	newerVersion := order.LatestBatchVersion + 1
+
+	testCases := []struct {
+		name             string
+		serverVersion    order.BatchVersion
+		supportedVersion order.BatchVersion
+		expected         order.BatchVersion
+		expectedErr      string
+	}{{
+		name:             "same version",
+		serverVersion:    order.LatestBatchVersion,
+		supportedVersion: order.LatestBatchVersion,
+		expected:         order.LatestBatchVersion,
+	}, {
+		name:             "older server",
+		serverVersion:    order.UpgradeAccountTaprootBatchVersion,
+		supportedVersion: order.LatestBatchVersion,
+		expected:         order.UpgradeAccountTaprootBatchVersion,
+	}, {
+		name:             "older server with default version",
+		serverVersion:    order.DefaultBatchVersion,
+		supportedVersion: order.LatestBatchVersion,
+		expected:         order.DefaultBatchVersion,
+	}, {
+		name:             "known version we can't use",
+		serverVersion:    order.LatestBatchVersion,
+		supportedVersion: order.UnannouncedChannelsBatchVersion,
+		expected:         order.UnannouncedChannelsBatchVersion,
+	}, {
+		name:             "newer server",
+		serverVersion:    newerVersion,
+		supportedVersion: order.LatestBatchVersion,
+		expectedErr:      "please upgrade poold",
+	}}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := checkBatchVersion(
+				tc.serverVersion, tc.supportedVersion,
+			)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, version)
+		})
+	}
+
+	// The error of a newer server can be identified by the caller and
+	// leads to a batch version mismatch reject.
+	_, err := checkBatchVersion(newerVersion, order.LatestBatchVersion)
+
+	var protocolMismatch *ErrProtocolVersionMismatch
+	require.ErrorAs(t, err, &protocolMismatch)
+
+	var versionMismatch *order.ErrVersionMismatch
+	require.ErrorAs(t, err, &versionMismatch)
+}
+
+// TestHandlePrepareBatchVersion makes sure a prepare message of a newer auction
+// server is rejected and shuts us down unless configured otherwise, and that we
+// switch to the batch version of an older auction server.
+func TestHandlePrepareBatchVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		serverVersion order.BatchVersion
+		behavior      string
+		expectFailure bool
+	}{{
+		name:          "newer server",
+		serverVersion: order.LatestBatchVersion + 1,
+		behavior:      ProtocolMismatchFail,
+		expectFailure: true,
+	}, {
+		name:          "newer server, warn only",
+		serverVersion: order.LatestBatchVersion + 1,
+		behavior:      ProtocolMismatchWarn,
+	}, {
+		name:          "older server",
+		serverVersion: order.UpgradeAccountTaprootBatchVersion,
+		behavior:      ProtocolMismatchFail,
+	}}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			db, err := clientdb.New(
+				t.TempDir(), clientdb.DBFilename,
+			)
+			require.NoError(t, err)
+			defer db.Close()
+
+			// The validation is only reached if we continue with
+			// the version of an older server. We stop there, as
+			// only the version negotiation is of interest.
+			orderMgr := order.NewMockManager(mockCtrl)
+			orderMgr.EXPECT().HasPendingBatch().Return(false).
+				AnyTimes()
+			if tc.serverVersion < order.LatestBatchVersion {
+				orderMgr.EXPECT().SetBatchVersion(
+					tc.serverVersion,
+				)
+				orderMgr.EXPECT().OrderMatchValidate(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("invalid batch"))
+			}
+
+			client := &auctioneer.Client{}
+			client.SetBatchVersion(order.LatestBatchVersion)
+
+			lnd := &slowLightningClient{}
+			srv := &rpcServer{
+				server: &Server{
+					cfg: &Config{
+						ProtocolMismatch: tc.behavior,
+						DebugConfig: &DebugConfig{
+							BatchVersion: int32(
+								order.LatestBatchVersion,
+							),
+						},
+					},
+					db: db,
+					channelAcceptor: NewChannelAcceptor(
+						lnd, ChannelAcceptorConfig{},
+					),
+				},
+				orderManager: orderMgr,
+				auctioneer:   client,
+				quit:         make(chan struct{}),
+			}
+
+			tx := wire.NewMsgTx(2)
+			tx.AddTxIn(&wire.TxIn{})
+			tx.AddTxOut(&wire.TxOut{Value: 1})
+
+			var batchTx bytes.Buffer
+			require.NoError(t, tx.Serialize(&batchTx))
+
+			prepare := &auctioneerrpc.OrderMatchPrepare{
+				BatchId:          traderKeyRaw,
+				BatchTransaction: batchTx.Bytes(),
+				ExecutionFee:     &auctioneerrpc.ExecutionFee{},
+				BatchVersion:     uint32(tc.serverVersion),
+			}
+			err = srv.handleServerMessage(
+				context.Background(),
+				&auctioneerrpc.ServerAuctionMessage{
+					Msg: &auctioneerrpc.ServerAuctionMessage_Prepare{
+						Prepare: prepare,
+					},
+				},
+			)
+
+			// We're not connected to the auctioneer, so sending
+			// the reject message fails. A newer server is a
+			// terminal error instead, unless we only warn about
+			// it.
+			if tc.expectFailure {
+				var mismatch *ErrProtocolVersionMismatch
+				require.ErrorAs(t, err, &mismatch)
+				require.ErrorContains(
+					t, err, "please upgrade poold",
+				)
+			} else {
+				require.ErrorContains(
+					t, err, "error sending reject message",
+				)
+			}
+
+			expectedVersion := order.LatestBatchVersion
+			if tc.serverVersion < order.LatestBatchVersion {
+				expectedVersion = tc.serverVersion
+			}
+			version, err := srv.server.determineBatchVersion()
+			require.NoError(t, err)
+			require.Equal(t, expectedVersion, version)
+			require.Equal(t, expectedVersion, client.BatchVersion())
+		})
+	}
+}
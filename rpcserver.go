@@ -3,18 +3,27 @@ package pool
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneer"
@@ -23,23 +32,42 @@ import (
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/internal/retry"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
-	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/clock"
 	lndFunding "github.com/lightningnetwork/lnd/funding"
-	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	// getInfoTimeout is the maximum time we allow for the initial getInfo
 	// call to the connected lnd node.
 	getInfoTimeout = 5 * time.Second
+
+	// connectionTestTimeout is the maximum time we allow for dialing a
+	// single endpoint and getting a response when testing the connections.
+	connectionTestTimeout = 10 * time.Second
+
+	// blocksPerYear is the number of blocks that are expected to be mined
+	// in a year, assuming one block every ten minutes.
+	blocksPerYear = order.BlocksPerYear
+)
+
+var (
+	// defaultSimulationConfTargets are the confirmation targets the fees of
+	// account actions are simulated for if none are requested.
+	defaultSimulationConfTargets = []uint32{2, 6, 12, 144}
 )
 
 // rpcServer implements the gRPC server on the client side and answers RPC calls
@@ -62,8 +90,30 @@ type rpcServer struct {
 	lndClient      lnrpc.LightningClient
 	auctioneer     *auctioneer.Client
 	accountManager account.Manager
+	autoRenewer    *account.AutoRenewer
+	expiryMonitor  *account.ExpiryMonitor
+	historyPruner  *batchHistoryPruner
 	orderManager   order.Manager
 	marshaler      Marshaler
+	feeEstimator   account.FeeEstimator
+	feeRateCeiling *feeRateCeiling
+
+	// accountVersion is the version of accounts that are created or
+	// modified without requesting a specific version. It is either set in
+	// the config or negotiated with the auctioneer on startup.
+	accountVersion account.Version
+
+	// orderConfirmations holds the orders that were prepared but not yet
+	// confirmed.
+	orderConfirmations *orderConfirmations
+
+	// batchConfirmations holds the batches that are waiting to be
+	// confirmed by the user before they are accepted.
+	batchConfirmations *batchConfirmations
+
+	// marketData caches the auction server's market data for the RPCs
+	// that only display or quote it.
+	marketData *marketDataCache
 
 	quit            chan struct{}
 	wg              sync.WaitGroup
@@ -71,6 +121,11 @@ type rpcServer struct {
 	recoveryMutex   sync.Mutex
 	recoveryPending bool
 
+	// pendingOrdersMtx is held while checking the maximum number of
+	// pending orders and adding a new order to the local database, so
+	// concurrent submissions can't exceed the limit.
+	pendingOrdersMtx sync.Mutex
+
 	// wumboSupported is true if the backing lnd node supports wumbo
 	// channels.
 	wumboSupported bool
@@ -89,6 +144,43 @@ func (s *accountStore) PendingBatch() error {
 	return err
 }
 
+// lndWalletReserve implements the account.WalletReserve interface by querying
+// the backing lnd node.
+type lndWalletReserve struct {
+	lightning lndclient.LightningClient
+	walletKit walletrpc.WalletKitClient
+}
+
+var _ account.WalletReserve = (*lndWalletReserve)(nil)
+
+// ConfirmedBalance returns the confirmed balance of the backing lnd node's
+// wallet.
+func (r *lndWalletReserve) ConfirmedBalance(
+	ctx context.Context) (btcutil.Amount, error) {
+
+	balance, err := r.lightning.WalletBalance(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance.Confirmed, nil
+}
+
+// RequiredReserve returns the minimum amount of satoshis that lnd wants to
+// keep in its wallet to fee bump anchor channels.
+func (r *lndWalletReserve) RequiredReserve(
+	ctx context.Context) (btcutil.Amount, error) {
+
+	resp, err := r.walletKit.RequiredReserve(
+		ctx, &walletrpc.RequiredReserveRequest{},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return btcutil.Amount(resp.RequiredReserve), nil
+}
+
 // newRPCServer creates a new client-side RPC server that uses the given
 // connection to the trader's lnd node and the auction server. A client side
 // database is created in `serverDir` if it does not yet exist.
@@ -99,37 +191,130 @@ func newRPCServer(server *Server) (*rpcServer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &rpcServer{
-		server:      server,
-		lndServices: lndServices,
-		lndClient:   server.lndClient,
-		auctioneer:  server.AuctioneerClient,
-		accountManager: account.NewManager(&account.ManagerConfig{
+
+	// When running as a subserver we only get a lightning client from the
+	// main process, so the anchor reserve can't be checked.
+	var walletReserve account.WalletReserve
+	if server.walletKitClient != nil {
+		walletReserve = &lndWalletReserve{
+			lightning: lndServices.Client,
+			walletKit: server.walletKitClient,
+		}
+	}
+
+	feeEstimator, err := newFeeEstimator(server.cfg, lndServices.WalletKit)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedPeers, err := server.cfg.AllowedPeerKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	accountManager := account.NewManager(&account.ManagerConfig{
+		Store:               accountStore,
+		Auctioneer:          server.AuctioneerClient,
+		Wallet:              lndServices.WalletKit,
+		FeeEstimator:        feeEstimator,
+		Signer:              lndServices.Signer,
+		ChainNotifier:       lndServices.ChainNotifier,
+		TxSource:            lndServices.Client,
+		TxFeeEstimator:      lndServices.Client,
+		TxLabelPrefix:       server.cfg.TxLabelPrefix,
+		ChainParams:         lndServices.ChainParams,
+		LndVersion:          lndServices.Version,
+		WalletReserve:       walletReserve,
+		IgnoreAnchorReserve: server.cfg.IgnoreAnchorReserve,
+		MinBackoff:          server.cfg.MinBackoff,
+		MaxBackoff:          server.cfg.MaxBackoff,
+
+		CoinSelectionStrategy: server.cfg.CoinSelectionStrategy,
+	})
+
+	var autoRenewer *account.AutoRenewer
+	if server.cfg.AutoRenewAccounts {
+		maxFeeRate := chainfee.SatPerKVByte(
+			server.cfg.AutoRenewMaxFeeRate * 1000,
+		).FeePerKWeight()
+
+		renewerCfg := &account.AutoRenewerConfig{
+			Manager:        accountManager,
 			Store:          accountStore,
-			Auctioneer:     server.AuctioneerClient,
-			Wallet:         lndServices.WalletKit,
-			Signer:         lndServices.Signer,
 			ChainNotifier:  lndServices.ChainNotifier,
-			TxSource:       lndServices.Client,
-			TxFeeEstimator: lndServices.Client,
-			TxLabelPrefix:  server.cfg.TxLabelPrefix,
-			ChainParams:    lndServices.ChainParams,
-			LndVersion:     lndServices.Version,
-		}),
+			Threshold:      server.cfg.AutoRenewThreshold,
+			RelativeExpiry: server.cfg.AutoRenewExpiry,
+			ConfTarget:     server.cfg.AutoRenewConfTarget,
+			MaxFeeRate:     maxFeeRate,
+			MinBackoff:     server.cfg.MinBackoff,
+			MaxBackoff:     server.cfg.MaxBackoff,
+		}
+		autoRenewer = account.NewAutoRenewer(renewerCfg)
+	}
+
+	// Without automatic renewals, we at least warn about accounts that are
+	// about to expire.
+	var expiryMonitor *account.ExpiryMonitor
+	warnThreshold := server.cfg.ExpiryWarningThreshold
+	if !server.cfg.AutoRenewAccounts && warnThreshold > 0 {
+		expiryMonitor = account.NewExpiryMonitor(
+			&account.ExpiryMonitorConfig{
+				Store:         accountStore,
+				ChainNotifier: lndServices.ChainNotifier,
+				Threshold:     warnThreshold,
+				MinBackoff:    server.cfg.MinBackoff,
+				MaxBackoff:    server.cfg.MaxBackoff,
+			},
+		)
+	}
+
+	s := &rpcServer{
+		server:         server,
+		lndServices:    lndServices,
+		lndClient:      server.lndClient,
+		auctioneer:     server.AuctioneerClient,
+		accountManager: accountManager,
+		autoRenewer:    autoRenewer,
+		expiryMonitor:  expiryMonitor,
+		feeEstimator:   feeEstimator,
+		feeRateCeiling: newFeeRateCeiling(server.cfg),
 		orderManager: order.NewManager(&order.ManagerConfig{
-			Store:        server.db,
-			AcctStore:    accountStore,
-			Lightning:    lndServices.Client,
-			Wallet:       lndServices.WalletKit,
-			Signer:       lndServices.Signer,
-			BatchVersion: batchVersion,
+			Store:                      server.db,
+			AcctStore:                  accountStore,
+			Lightning:                  lndServices.Client,
+			Wallet:                     lndServices.WalletKit,
+			Signer:                     lndServices.Signer,
+			BatchVersion:               batchVersion,
+			BatchSignTimeout:           server.cfg.BatchSignTimeout,
+			AllowedPeers:               allowedPeers,
+			MaxBatchAccountUtilization: server.cfg.MaxBatchAccountUtilization,
 		}),
 		marshaler: NewMarshaler(&marshalerConfig{
 			GetOrders: server.db.GetOrders,
 			Terms:     server.AuctioneerClient.Terms,
 		}),
+		orderConfirmations: newOrderConfirmations(
+			server.clock, orderConfirmationTimeout,
+		),
+		batchConfirmations: newBatchConfirmations(server.clock),
+		marketData: newMarketDataCache(
+			server.clock, server.cfg.MarketDataCacheTTL,
+			server.AuctioneerClient.Terms,
+		),
 		quit: make(chan struct{}),
-	}, nil
+	}
+
+	// Batch snapshots are needed to list the leases they created, so only
+	// the ones without any active lease are pruned.
+	if server.cfg.BatchHistoryRetention > 0 {
+		s.historyPruner = newBatchHistoryPruner(
+			server.db, s.activeLeaseBatches, server.clock,
+			server.cfg.BatchHistoryRetention,
+			batchHistoryPruneInterval,
+		)
+	}
+
+	return s, nil
 }
 
 // Start starts the rpcServer, making it ready to accept incoming requests.
@@ -142,6 +327,28 @@ func (s *rpcServer) Start() error {
 
 	ctx := context.Background()
 
+	// Most of our operations depend on the current block height, so we
+	// don't enable any of them before lnd is synced to the chain. Waiting
+	// is aborted if the user requests shutdown in the meantime.
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	defer syncCancel()
+	go func() {
+		select {
+		case <-s.server.cfg.ShutdownInterceptor.ShutdownChannel():
+			syncCancel()
+
+		case <-syncCtx.Done():
+		}
+	}()
+	err := waitForChainSync(
+		syncCtx, s.lndServices.Client, chainSyncPollInterval,
+		s.server.cfg.ChainSyncTimeout,
+	)
+	if err != nil {
+		return fmt.Errorf("error waiting for lnd to sync to chain: %v",
+			err)
+	}
+
 	lndCtx, lndCancel := context.WithTimeout(ctx, getInfoTimeout)
 	defer lndCancel()
 	info, err := s.lndClient.GetInfo(lndCtx, &lnrpc.GetInfoRequest{})
@@ -157,6 +364,17 @@ func (s *rpcServer) Start() error {
 	rpcLog.Infof("Connected to lnd node %v with pubkey %v", info.Alias,
 		info.IdentityPubkey)
 
+	// A signer that doesn't work would only show up once we try to sign
+	// a batch, so we fail fast if the self-test is enabled.
+	if s.server.cfg.SignerSelfTest {
+		err := verifyLndSigner(
+			ctx, s.lndServices.WalletKit, s.lndServices.Signer,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	var blockCtx context.Context
 	blockCtx, s.blockNtfnCancel = context.WithCancel(ctx)
 	chainNotifier := s.lndServices.ChainNotifier
@@ -184,6 +402,39 @@ func (s *rpcServer) Start() error {
 		return fmt.Errorf("unable to start auctioneer client: %v", err)
 	}
 
+	// A default lease duration the server doesn't offer would make every
+	// bid without a duration fail, so we warn about it early. The bucket
+	// might still open later, so this isn't treated as an error.
+	defaultDuration := s.server.cfg.DefaultLeaseDuration
+	if defaultDuration != 0 {
+		auctionTerms, err := s.auctioneer.Terms(ctx)
+		if err == nil {
+			err = auctionTerms.ValidateLeaseDuration(
+				defaultDuration,
+			)
+		}
+		if err != nil {
+			rpcLog.Warnf("Unable to validate default lease "+
+				"duration %d: %v", defaultDuration, err)
+		}
+	}
+
+	// The account versions the auctioneer supports follow from the batch
+	// version we use with it.
+	batchVersion, err := s.server.determineBatchVersion()
+	if err != nil {
+		return err
+	}
+	s.accountVersion, err = selectAccountVersion(
+		s.server.cfg, batchVersion,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to determine account version: %v",
+			err)
+	}
+	rpcLog.Infof("Using account version %v for new accounts",
+		s.accountVersion)
+
 	// Start managers.
 	if err := s.accountManager.Start(); err != nil {
 		return fmt.Errorf("unable to start account manager: %v", err)
@@ -191,15 +442,32 @@ func (s *rpcServer) Start() error {
 	if err := s.orderManager.Start(); err != nil {
 		return fmt.Errorf("unable to start order manager: %v", err)
 	}
+	if s.autoRenewer != nil {
+		if err := s.autoRenewer.Start(); err != nil {
+			return fmt.Errorf("unable to start account auto "+
+				"renewer: %v", err)
+		}
+	}
+	if s.expiryMonitor != nil {
+		if err := s.expiryMonitor.Start(); err != nil {
+			return fmt.Errorf("unable to start account expiry "+
+				"monitor: %v", err)
+		}
+	}
+	if s.historyPruner != nil {
+		s.historyPruner.Start()
+	}
 	if err := s.server.fundingManager.Start(); err != nil {
 		return fmt.Errorf("unable to start funding manager: %v", err)
 	}
-	if err := s.server.sidecarAcceptor.Start(blockErrChan); err != nil {
+	acceptorErrChan := make(chan error, 1)
+	err = s.server.sidecarAcceptor.Start(acceptorErrChan)
+	if err != nil {
 		return fmt.Errorf("unable to start sidecar acceptor: %v", err)
 	}
 
 	s.wg.Add(1)
-	go s.serverHandler(blockChan, blockErrChan)
+	go s.serverHandler(blockChan, blockErrChan, acceptorErrChan)
 
 	rpcLog.Infof("Trader server is now active")
 
@@ -221,6 +489,15 @@ func (s *rpcServer) Stop() error {
 	if err := s.server.fundingManager.Stop(); err != nil {
 		rpcLog.Errorf("Error stopping funding manager: %v", err)
 	}
+	if s.autoRenewer != nil {
+		s.autoRenewer.Stop()
+	}
+	if s.expiryMonitor != nil {
+		s.expiryMonitor.Stop()
+	}
+	if s.historyPruner != nil {
+		s.historyPruner.Stop()
+	}
 	s.accountManager.Stop()
 	s.orderManager.Stop()
 	if err := s.auctioneer.Stop(); err != nil {
@@ -238,7 +515,9 @@ func (s *rpcServer) Stop() error {
 }
 
 // serverHandler is the main event loop of the server.
-func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error) {
+func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan,
+	acceptorErrChan chan error) {
+
 	defer s.wg.Done()
 
 	for {
@@ -251,7 +530,12 @@ func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error)
 
 			rpcLog.Debugf("Received message from the server: %s",
 				poolrpc.PrintMsg(msg))
-			err := s.handleServerMessage(msg)
+
+			ctx, span := startSpan(
+				context.Background(), serverMessageSpanName(msg),
+			)
+			err := s.handleServerMessage(ctx, msg)
+			endSpan(span, err)
 
 			// Only shut down if this was a terminal error, and not
 			// a batch reject (should rarely happen, but it's
@@ -282,11 +566,31 @@ func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error)
 				"height=%v", height)
 			s.updateHeight(height)
 
+		// The block notification stream is terminated if lnd restarts.
+		// The connection itself is re-established by gRPC, so we only
+		// need to register for block notifications again. The account
+		// watcher and the channel acceptor register their own streams
+		// again. This blocks the handling of server messages, which
+		// would require lnd anyway.
 		case err := <-blockErrChan:
+			if err == nil {
+				continue
+			}
+
+			rpcLog.Errorf("Unable to receive block notification: "+
+				"%v", err)
+
+			var ok bool
+			blockChan, blockErrChan, ok = s.reconnectLnd(err)
+			if !ok {
+				return
+			}
+
+		// The channel acceptor registers itself with lnd again, we
+		// only need to log its errors.
+		case err := <-acceptorErrChan:
 			if err != nil {
-				rpcLog.Errorf("Unable to receive block "+
-					"notification: %v", err)
-				s.server.cfg.RequestShutdown()
+				rpcLog.Warnf("Channel acceptor error: %v", err)
 			}
 
 		// In case the server is shutting down.
@@ -296,17 +600,106 @@ func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error)
 	}
 }
 
+// reconnectLnd marks lnd as disconnected because of the given error and then
+// registers for block notifications again, backing off between the attempts,
+// until it succeeds. False is returned if the server is shutting down.
+func (s *rpcServer) reconnectLnd(cause error) (chan int32, chan error, bool) {
+	s.server.lndConn.setDisconnected(cause)
+	s.blockNtfnCancel()
+
+	var backoff time.Duration
+	for {
+		backoff = retry.NextBackoff(
+			backoff, s.server.cfg.MinBackoff,
+			s.server.cfg.MaxBackoff,
+		)
+		rpcLog.Infof("Reconnecting to lnd in %v", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-s.quit:
+			return nil, nil, false
+		}
+
+		blockCtx, cancel := context.WithCancel(context.Background())
+		notifier := s.lndServices.ChainNotifier
+		blockChan, blockErrChan, err := notifier.RegisterBlockEpochNtfn(
+			blockCtx,
+		)
+		if err != nil {
+			cancel()
+			s.server.lndConn.setDisconnected(err)
+			rpcLog.Warnf("Unable to reconnect to lnd: %v", err)
+			continue
+		}
+
+		// lnd sends the current block right after the registration,
+		// which tells us the stream is actually working.
+		select {
+		case height := <-blockChan:
+			s.updateHeight(height)
+
+		case err := <-blockErrChan:
+			cancel()
+			s.server.lndConn.setDisconnected(err)
+			rpcLog.Warnf("Unable to reconnect to lnd: %v", err)
+			continue
+
+		case <-s.quit:
+			cancel()
+			return nil, nil, false
+		}
+
+		s.blockNtfnCancel = cancel
+		s.server.lndConn.setConnected()
+		rpcLog.Infof("Reconnected to lnd at height %d",
+			atomic.LoadUint32(&s.bestHeight))
+
+		return blockChan, blockErrChan, true
+	}
+}
+
 func (s *rpcServer) updateHeight(height int32) {
 	// Store height atomically so the incoming request handler can access it
 	// without locking.
 	atomic.StoreUint32(&s.bestHeight, uint32(height))
 }
 
+// serverMessageSpanName returns the name of the tracing span that covers
+// handling the given message of the auction server. The steps of the batch
+// execution each get their own name.
+func serverMessageSpanName(rpcMsg *auctioneerrpc.ServerAuctionMessage) string {
+	switch rpcMsg.Msg.(type) {
+	case *auctioneerrpc.ServerAuctionMessage_Prepare:
+		return "pool.batch.Prepare"
+
+	case *auctioneerrpc.ServerAuctionMessage_Sign:
+		return "pool.batch.Sign"
+
+	case *auctioneerrpc.ServerAuctionMessage_Finalize:
+		return "pool.batch.Finalize"
+
+	default:
+		return "pool.HandleServerMessage"
+	}
+}
+
 // handleServerMessage reads a gRPC message received in the stream from the
-// auctioneer server and passes it to the correct manager.
-func (s *rpcServer) handleServerMessage(
+// auctioneer server and passes it to the correct manager. The given context
+// carries the trace span of the message.
+func (s *rpcServer) handleServerMessage(ctx context.Context,
 	rpcMsg *auctioneerrpc.ServerAuctionMessage) error {
 
+	// Batch steps are queued if too many of them are already processed
+	// concurrently. If we're shutting down while waiting, the batch is
+	// abandoned the same way as if we disconnected.
+	if isBatchStep(rpcMsg) {
+		if !s.server.batchLimiter.acquire(s.quit) {
+			return nil
+		}
+		defer s.server.batchLimiter.release()
+	}
+
 	switch msg := rpcMsg.Msg.(type) {
 	// A new batch has been assembled with some of our orders.
 	case *auctioneerrpc.ServerAuctionMessage_Prepare:
@@ -334,49 +727,110 @@ func (s *rpcServer) handleServerMessage(
 			rpcLog.Errorf("Unable to store order events: %v", err)
 		}
 
+		// All steps that involve lnd calls must complete within the
+		// configured time, otherwise we reject the batch.
+		prep := newBatchPreparation(
+			batch.ID, s.server.cfg.BatchPrepareTimeout, s.quit,
+		)
+
 		// The prepare message can be sent over and over again if the
 		// batch needs adjustment. Clear all previous shims and channels
 		// that will never complete because the funding TX they refer to
 		// will never be published.
 		if s.orderManager.HasPendingBatch() {
 			pendingBatch := s.orderManager.PendingBatch()
-			err = s.server.fundingManager.RemovePendingBatchArtifacts(
-				pendingBatch.MatchedOrders, pendingBatch.BatchTX,
-			)
+			err = prep.run(ctx, "clearing previous batch", func(
+				ctx context.Context) error {
+
+				err := s.server.fundingManager.RemovePendingBatchArtifacts(
+					ctx, pendingBatch.MatchedOrders,
+					pendingBatch.BatchTX,
+				)
+				if err != nil {
+					// The above method only returns hard
+					// errors that justify us rejecting the
+					// batch.
+					rpcLog.Errorf("Error clearing previous "+
+						"batch artifacts: %v", err)
+					return err
+				}
+
+				// Clear our staging area for the new batch
+				// proposal. We consider any errors as a hard
+				// failure and reject the batch.
+				err = s.server.fundingManager.DeletePendingBatch()
+				if err != nil {
+					rpcLog.Errorf("Error clearing previous "+
+						"pending batch: %v", err)
+				}
+
+				return err
+			})
 			if err != nil {
-				// The above method only returns hard errors
-				// that justify us rejecting the batch.
-				rpcLog.Errorf("Error clearing previous batch "+
-					"artifacts: %v", err)
 				return s.sendRejectBatch(batch, err)
 			}
+		}
 
-			// Clear our staging area for the new batch proposal. We
-			// consider any errors as a hard failure and reject the
-			// batch.
-			err = s.server.fundingManager.DeletePendingBatch()
-			if err != nil {
-				rpcLog.Errorf("Error clearing previous "+
-					"pending batch: %v", err)
-				return s.sendRejectBatch(batch, err)
+		// The batch version tells us which protocol version the
+		// auction server speaks. If it's newer than ours, we can't
+		// take part in any batch until the user upgrades, which is a
+		// terminal error unless configured otherwise.
+		if err := s.negotiateBatchVersion(batch); err != nil {
+			rpcLog.Errorf("Error negotiating batch version: %v",
+				err)
+
+			rejectErr := s.sendRejectBatch(batch, err)
+
+			var (
+				mismatch *ErrProtocolVersionMismatch
+				behavior = s.server.cfg.ProtocolMismatch
+			)
+			if errors.As(err, &mismatch) &&
+				behavior != ProtocolMismatchWarn {
+
+				return err
 			}
+
+			return rejectErr
 		}
 
 		// Do an in-depth verification of the batch.
 		bestHeight := atomic.LoadUint32(&s.bestHeight)
-		err = s.orderManager.OrderMatchValidate(batch, bestHeight)
+		err = prep.run(ctx, "validating batch", func(
+			context.Context) error {
+
+			return s.orderManager.OrderMatchValidate(
+				batch, bestHeight,
+			)
+		})
 		if err != nil {
 			// We can't accept the batch, something went wrong.
 			rpcLog.Errorf("Error validating batch: %v", err)
 			return s.sendRejectBatch(batch, err)
 		}
 
+		// Batches that are outside of the configured limits need to be
+		// confirmed by the user before we accept them.
+		if !s.confirmBatch(batch) {
+			return s.sendRejectBatch(
+				batch, order.RejectAllMatches(
+					batch, errBatchNotConfirmed,
+				),
+			)
+		}
+
 		// Before we accept the batch, we'll finish preparations on our
 		// end which include applying any order match predicates,
-		// connecting out to peers, and registering funding shim.
-		err = s.server.fundingManager.PrepChannelFunding(
-			batch, s.server.db.GetOrder,
-		)
+		// connecting out to peers, and registering funding shim. The
+		// time we waited for the batch to be confirmed doesn't count
+		// towards the preparation timeout.
+		err = prep.run(ctx, "preparing channel funding", func(
+			ctx context.Context) error {
+
+			return s.server.fundingManager.PrepChannelFunding(
+				ctx, batch, s.server.db.GetOrder,
+			)
+		})
 		if err != nil {
 			rpcLog.Warnf("Error preparing channel funding: %v",
 				err)
@@ -404,6 +858,11 @@ func (s *rpcServer) handleServerMessage(
 		batch.ServerNonces = serverNonces
 		batch.PreviousOutputs = prevOutputs
 
+		// The channels of the batch are about to be opened, so we let
+		// the channel acceptor know it can start gating external
+		// channel requests if configured to do so.
+		s.server.channelAcceptor.BatchExecutionStarted()
+
 		// We were able to accept the batch. Inform the auctioneer,
 		// then start negotiating with the remote peers. We'll sign
 		// once all channel partners have responded.
@@ -448,6 +907,7 @@ func (s *rpcServer) handleServerMessage(
 		var batchID order.BatchID
 		copy(batchID[:], msg.Finalize.BatchId)
 		err := s.orderManager.BatchFinalize(batchID)
+		s.server.channelAcceptor.BatchExecutionEnded()
 		if err != nil {
 			return fmt.Errorf("error finalizing batch: %v", err)
 		}
@@ -474,6 +934,36 @@ func (s *rpcServer) handleServerMessage(
 			}
 		}
 
+		// Orders that were only partially filled might need their
+		// remainder to be canceled or repriced, depending on their
+		// policy. This involves calls to the auctioneer, so we don't
+		// want to block the processing of further server messages.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			handler := &partialMatchHandler{
+				getOrder:    s.server.db.GetOrder,
+				cancelOrder: s.cancelPartialOrder,
+				submitOrder: s.submitRepricedOrder,
+			}
+			handler.handleBatch(context.Background(), batch)
+		}()
+
+		// Orders that were fully filled are submitted again if they
+		// are set to be recreated.
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			handler := &autoRecreateHandler{
+				getOrder:          s.server.db.GetOrder,
+				checkPendingLimit: s.checkPendingOrderLimit,
+				submitOrder:       s.submitRecreatedOrder,
+			}
+			handler.handleBatch(context.Background(), batch)
+		}()
+
 		// Accounts that were updated in the batch need to start new
 		// confirmation watchers, now that we expect a batch TX to be
 		// published.
@@ -484,7 +974,7 @@ func (s *rpcServer) handleServerMessage(
 			matchedAccounts[idx] = acct.AccountKey
 		}
 		return s.accountManager.WatchMatchedAccounts(
-			context.Background(), matchedAccounts,
+			ctx, matchedAccounts,
 		)
 
 	default:
@@ -522,46 +1012,93 @@ func (s *rpcServer) InitAccount(ctx context.Context,
 	req *poolrpc.InitAccountRequest) (*poolrpc.Account, error) {
 
 	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	expiryHeight, err := accountExpiryHeight(
+		req.GetAbsoluteHeight(), req.GetRelativeHeight(), bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := s.accountFundingFeeRate(
+		ctx, btcutil.Amount(req.AccountValue), req.GetConfTarget(),
+		req.GetFeeRateSatPerKw(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// In order to be able to choose a default value (or to validate the
+	// account version), we need to know if the version of the connected lnd
+	// supports Taproot.
+	version, err := s.determineAccountVersion(req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(
+		ctx, "pool.InitAccount",
+		attribute.Int64("account.value", int64(req.AccountValue)),
+	)
+	acct, err := s.accountManager.InitAccount(
+		ContextWithInitiator(ctx, req.Initiator),
+		btcutil.Amount(req.AccountValue), version, feeRate,
+		expiryHeight, bestHeight,
+	)
+	endSpan(span, err)
+	if err != nil {
+		return nil, anchorReserveErr(err)
+	}
+
+	return MarshallAccount(acct)
+}
+
+// accountExpiryHeight returns the absolute expiry height of a new account from
+// either an absolute or a relative height.
+func accountExpiryHeight(absoluteHeight, relativeHeight,
+	bestHeight uint32) (uint32, error) {
 
-	// Determine the desired expiration value, can be relative or absolute.
-	var expiryHeight uint32
 	switch {
-	case req.GetAbsoluteHeight() != 0 && req.GetRelativeHeight() != 0:
-		return nil, fmt.Errorf("you must set only one of the relative " +
+	case absoluteHeight != 0 && relativeHeight != 0:
+		return 0, fmt.Errorf("you must set only one of the relative " +
 			"and absolute height parameters")
 
-	case req.GetAbsoluteHeight() != 0:
-		expiryHeight = req.GetAbsoluteHeight()
+	case absoluteHeight != 0:
+		return absoluteHeight, nil
 
-	case req.GetRelativeHeight() != 0:
-		expiryHeight = req.GetRelativeHeight() + bestHeight
+	case relativeHeight != 0:
+		return relativeHeight + bestHeight, nil
 
 	default:
-		return nil, fmt.Errorf("either relative or absolute height " +
+		return 0, fmt.Errorf("either relative or absolute height " +
 			"must be specified")
 	}
+}
+
+// accountFundingFeeRate returns the fee rate to use for the funding
+// transaction of a new account, either set explicitly or estimated from the
+// confirmation target.
+func (s *rpcServer) accountFundingFeeRate(ctx context.Context,
+	value btcutil.Amount, confTarget uint32,
+	feeRateSatPerKw uint64) (chainfee.SatPerKWeight, error) {
 
 	var feeRate chainfee.SatPerKWeight
 
 	switch {
-	case req.GetFeeRateSatPerKw() > 0 && req.GetConfTarget() > 0:
-		return nil, fmt.Errorf("you must set only one of the sats/kw " +
+	case feeRateSatPerKw > 0 && confTarget > 0:
+		return 0, fmt.Errorf("you must set only one of the sats/kw " +
 			"and confirmation target parameters")
 
-	case req.GetFeeRateSatPerKw() > 0:
-		feeRate = chainfee.SatPerKWeight(req.GetFeeRateSatPerKw())
+	case feeRateSatPerKw > 0:
+		feeRate = chainfee.SatPerKWeight(feeRateSatPerKw)
 
-	case req.GetConfTarget() > 0:
+	case confTarget > 0:
 		// Determine the desired transaction fee.
-		value := btcutil.Amount(req.AccountValue)
-		confTarget := req.GetConfTarget()
-
 		var err error
 		feeRate, _, err = s.accountManager.QuoteAccount(
 			ctx, value, confTarget,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to estimate on-chain fees: "+
+			return 0, fmt.Errorf("unable to estimate on-chain fees: "+
 				"%v", err)
 		}
 
@@ -575,59 +1112,281 @@ func (s *rpcServer) InitAccount(ctx context.Context,
 			"value=%v, conf_target=%v", feeRate, value, confTarget)
 
 	default:
-		return nil, fmt.Errorf("either sats/kw or confirmation target " +
+		return 0, fmt.Errorf("either sats/kw or confirmation target " +
 			"must be specified")
 	}
 
-	// In order to be able to choose a default value (or to validate the
-	// account version), we need to know if the version of the connected lnd
-	// supports Taproot.
-	version, err := s.determineAccountVersion(req.Version)
+	if feeRate < chainfee.FeePerKwFloor {
+		return 0, fmt.Errorf("fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	return s.feeRateCeiling.apply(feeRate)
+}
+
+// InitExternalAccount reserves a new account that is funded by a transaction
+// that is created and signed outside of the connected lnd node's wallet.
+func (s *rpcServer) InitExternalAccount(ctx context.Context,
+	req *poolrpc.InitExternalAccountRequest) (
+	*poolrpc.InitExternalAccountResponse, error) {
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	expiryHeight, err := accountExpiryHeight(
+		req.GetAbsoluteHeight(), req.GetRelativeHeight(), bestHeight,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if feeRate < chainfee.FeePerKwFloor {
-		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
-			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	version, err := s.determineAccountVersion(req.Version)
+	if err != nil {
+		return nil, err
 	}
 
-	acct, err := s.accountManager.InitAccount(
+	acct, err := s.accountManager.InitExternalAccount(
 		ContextWithInitiator(ctx, req.Initiator),
-		btcutil.Amount(req.AccountValue), version, feeRate,
-		expiryHeight, bestHeight,
+		btcutil.Amount(req.AccountValue), version, expiryHeight,
+		bestHeight,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return MarshallAccount(acct)
-}
-
-func (s *rpcServer) ListAccounts(ctx context.Context,
-	req *poolrpc.ListAccountsRequest) (*poolrpc.ListAccountsResponse, error) {
-
-	accounts, err := s.server.db.Accounts()
+	rpcAccount, err := MarshallAccount(acct)
 	if err != nil {
 		return nil, err
 	}
 
-	validAccounts := make([]*account.Account, 0, len(accounts))
-	for _, acct := range accounts {
-		// Filter out inactive accounts if requested by the user.
-		if req.ActiveOnly && !acct.State.IsActive() {
-			continue
-		}
-
-		validAccounts = append(validAccounts, acct)
+	// The external service needs to know where exactly to send the funds
+	// to, so we include the account output in both formats.
+	accountOutput, err := acct.Output()
+	if err != nil {
+		return nil, err
 	}
-
-	rpcAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
-		ctx, validAccounts,
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		accountOutput.PkScript, s.lndServices.ChainParams,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list marshalled accounts: "+
-			"%v", err)
+		return nil, err
+	}
+	if len(addrs) != 1 {
+		return nil, fmt.Errorf("unable to derive address of account " +
+			"output")
+	}
+
+	return &poolrpc.InitExternalAccountResponse{
+		Account:      rpcAccount,
+		OutputScript: accountOutput.PkScript,
+		Address:      addrs[0].String(),
+	}, nil
+}
+
+// PublishExternalAccount publishes the externally created funding transaction
+// of an account reserved with InitExternalAccount.
+func (s *rpcServer) PublishExternalAccount(ctx context.Context,
+	req *poolrpc.PublishExternalAccountRequest) (*poolrpc.Account, error) {
+
+	// Ensure the trader key is well formed.
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var fundingTx wire.MsgTx
+	err = fundingTx.Deserialize(bytes.NewReader(req.FundingTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode funding transaction: "+
+			"%v", err)
+	}
+
+	acct, err := s.accountManager.FundExternalAccount(
+		ctx, traderKey, &fundingTx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshallAccount(acct)
+}
+
+// CancelExternalAccount cancels an account that is still waiting for its
+// external funding transaction.
+func (s *rpcServer) CancelExternalAccount(ctx context.Context,
+	req *poolrpc.CancelExternalAccountRequest) (*poolrpc.Account, error) {
+
+	// Ensure the trader key is well formed.
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := s.accountManager.CancelExternalAccount(ctx, traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshallAccount(acct)
+}
+
+// PreviewAccountFunding reserves a new account and funds it from lnd's wallet
+// without signing or broadcasting the funding transaction.
+func (s *rpcServer) PreviewAccountFunding(ctx context.Context,
+	req *poolrpc.PreviewAccountFundingRequest) (
+	*poolrpc.PreviewAccountFundingResponse, error) {
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	expiryHeight, err := accountExpiryHeight(
+		req.GetAbsoluteHeight(), req.GetRelativeHeight(), bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	value := btcutil.Amount(req.AccountValue)
+	feeRate, err := s.accountFundingFeeRate(
+		ctx, value, req.GetConfTarget(), req.GetFeeRateSatPerKw(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.determineAccountVersion(req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, packet, fee, err := s.accountManager.PreviewAccountFunding(
+		ContextWithInitiator(ctx, req.Initiator), value, version,
+		feeRate, expiryHeight, bestHeight,
+	)
+	if err != nil {
+		return nil, anchorReserveErr(err)
+	}
+
+	return s.marshallFundingPreview(acct, packet, fee)
+}
+
+// marshallFundingPreview translates a previewed account funding transaction
+// into its RPC counterpart.
+func (s *rpcServer) marshallFundingPreview(acct *account.Account,
+	packet *psbt.Packet,
+	fee btcutil.Amount) (*poolrpc.PreviewAccountFundingResponse, error) {
+
+	rpcAccount, err := MarshallAccount(acct)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	var txBuf bytes.Buffer
+	if err := tx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*poolrpc.FundingInput, len(tx.TxIn))
+	for idx, txIn := range tx.TxIn {
+		var value uint64
+		if utxo := packet.Inputs[idx].WitnessUtxo; utxo != nil {
+			value = uint64(utxo.Value)
+		}
+
+		inputs[idx] = &poolrpc.FundingInput{
+			Outpoint: &auctioneerrpc.OutPoint{
+				Txid:        txIn.PreviousOutPoint.Hash[:],
+				OutputIndex: txIn.PreviousOutPoint.Index,
+			},
+			ValueSat: value,
+		}
+	}
+
+	accountOutput, err := acct.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var accountOutputIndex uint32
+	outputs := make([]*poolrpc.Output, len(tx.TxOut))
+	for idx, txOut := range tx.TxOut {
+		if bytes.Equal(txOut.PkScript, accountOutput.PkScript) {
+			accountOutputIndex = uint32(idx)
+		}
+
+		// Outputs without a standard script don't have an address, we
+		// still want to show their value though.
+		var addr string
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			txOut.PkScript, s.lndServices.ChainParams,
+		)
+		if err == nil && len(addrs) == 1 {
+			addr = addrs[0].String()
+		}
+
+		outputs[idx] = &poolrpc.Output{
+			ValueSat: uint64(txOut.Value),
+			Address:  addr,
+		}
+	}
+
+	return &poolrpc.PreviewAccountFundingResponse{
+		Account:            rpcAccount,
+		UnsignedTx:         txBuf.Bytes(),
+		FeeSat:             uint64(fee),
+		Inputs:             inputs,
+		Outputs:            outputs,
+		AccountOutputIndex: accountOutputIndex,
+	}, nil
+}
+
+// ConfirmAccountFunding signs and broadcasts the funding transaction of an
+// account reserved with PreviewAccountFunding.
+func (s *rpcServer) ConfirmAccountFunding(ctx context.Context,
+	req *poolrpc.ConfirmAccountFundingRequest) (*poolrpc.Account, error) {
+
+	// Ensure the trader key is well formed.
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := s.accountManager.ConfirmAccountFunding(ctx, traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshallAccount(acct)
+}
+
+func (s *rpcServer) ListAccounts(ctx context.Context,
+	req *poolrpc.ListAccountsRequest) (*poolrpc.ListAccountsResponse, error) {
+
+	if err := validateListAccountsRequest(req); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.server.db.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	validAccounts := make([]*account.Account, 0, len(accounts))
+	for _, acct := range accounts {
+		include, err := includeAccount(req, acct)
+		if err != nil {
+			return nil, err
+		}
+		if !include {
+			continue
+		}
+
+		validAccounts = append(validAccounts, acct)
+	}
+
+	rpcAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, validAccounts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list marshalled accounts: "+
+			"%v", err)
 	}
 
 	return &poolrpc.ListAccountsResponse{
@@ -635,39 +1394,115 @@ func (s *rpcServer) ListAccounts(ctx context.Context,
 	}, nil
 }
 
-// MarshallAccount returns the RPC representation of an account.
-func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
-	var rpcState poolrpc.AccountState
-	switch a.State {
+// includeAccount returns true if the given account matches all filters of the
+// given ListAccounts request.
+func includeAccount(req *poolrpc.ListAccountsRequest,
+	acct *account.Account) (bool, error) {
+
+	// Filter out inactive accounts if requested by the user.
+	if req.ActiveOnly && !acct.State.IsActive() {
+		return false, nil
+	}
+
+	if len(req.States) > 0 {
+		rpcState, err := marshallAccountState(acct.State)
+		if err != nil {
+			return false, err
+		}
+
+		var found bool
+		for _, state := range req.States {
+			if state == rpcState {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if uint64(acct.Value) < req.MinValueSat {
+		return false, nil
+	}
+
+	if req.MaxValueSat > 0 && uint64(acct.Value) > req.MaxValueSat {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// anchorReserveErr turns an error of the anchor reserve check into a gRPC error
+// with the FailedPrecondition code, so clients can identify it without parsing
+// its message. All other errors are returned unchanged.
+func anchorReserveErr(err error) error {
+	if errors.Is(err, account.ErrBelowAnchorReserve) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+// validateListAccountsRequest makes sure the filters of the given ListAccounts
+// request are consistent.
+func validateListAccountsRequest(req *poolrpc.ListAccountsRequest) error {
+	if req.MaxValueSat > 0 && req.MinValueSat > req.MaxValueSat {
+		return fmt.Errorf("min value %d cannot be greater than max "+
+			"value %d", req.MinValueSat, req.MaxValueSat)
+	}
+
+	return nil
+}
+
+// marshallAccountState returns the RPC representation of an account state.
+func marshallAccountState(state account.State) (poolrpc.AccountState, error) {
+	switch state {
 	case account.StateInitiated, account.StatePendingOpen:
-		rpcState = poolrpc.AccountState_PENDING_OPEN
+		return poolrpc.AccountState_PENDING_OPEN, nil
 
 	case account.StatePendingUpdate:
-		rpcState = poolrpc.AccountState_PENDING_UPDATE
+		return poolrpc.AccountState_PENDING_UPDATE, nil
 
 	case account.StateOpen:
-		rpcState = poolrpc.AccountState_OPEN
+		return poolrpc.AccountState_OPEN, nil
 
 	case account.StateExpired:
-		rpcState = poolrpc.AccountState_EXPIRED
+		return poolrpc.AccountState_EXPIRED, nil
 
 	case account.StatePendingClosed:
-		rpcState = poolrpc.AccountState_PENDING_CLOSED
+		return poolrpc.AccountState_PENDING_CLOSED, nil
 
 	case account.StateClosed:
-		rpcState = poolrpc.AccountState_CLOSED
+		return poolrpc.AccountState_CLOSED, nil
 
 	case account.StateCanceledAfterRecovery:
-		rpcState = poolrpc.AccountState_RECOVERY_FAILED
+		return poolrpc.AccountState_RECOVERY_FAILED, nil
 
 	case account.StatePendingBatch:
-		rpcState = poolrpc.AccountState_PENDING_BATCH
+		return poolrpc.AccountState_PENDING_BATCH, nil
 
 	case account.StateExpiredPendingUpdate:
-		rpcState = poolrpc.AccountState_PENDING_UPDATE
+		return poolrpc.AccountState_PENDING_UPDATE, nil
+
+	case account.StatePendingExternalFunding,
+		account.StatePendingFundingPreview:
+
+		return poolrpc.AccountState_PENDING_EXTERNAL_FUNDING, nil
+
+	case account.StateExternalFundingCanceled:
+		return poolrpc.AccountState_EXTERNAL_FUNDING_CANCELED, nil
 
 	default:
-		return nil, fmt.Errorf("unknown state %v", a.State)
+		return 0, fmt.Errorf("unknown state %v", state)
+	}
+}
+
+// MarshallAccount returns the RPC representation of an account.
+func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
+	rpcState, err := marshallAccountState(a.State)
+	if err != nil {
+		return nil, err
 	}
 
 	var rpcVersion poolrpc.AccountVersion
@@ -689,6 +1524,20 @@ func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
 		latestTxHash = a.LatestTx.TxHash()
 	}
 
+	// Split the account value depending on whether the latest transaction
+	// of the account is confirmed. Accounts that are no longer active
+	// don't hold any funds anymore.
+	var confirmedBalance, pendingBalance btcutil.Amount
+	switch {
+	case !a.State.IsActive():
+
+	case a.State.IsPending():
+		pendingBalance = a.Value
+
+	default:
+		confirmedBalance = a.Value
+	}
+
 	return &poolrpc.Account{
 		TraderKey: a.TraderKey.PubKey.SerializeCompressed(),
 		Outpoint: &auctioneerrpc.OutPoint{
@@ -700,6 +1549,90 @@ func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
 		State:            rpcState,
 		LatestTxid:       latestTxHash[:],
 		Version:          rpcVersion,
+		ConfirmedBalance: uint64(confirmedBalance),
+		PendingBalance:   uint64(pendingBalance),
+	}, nil
+}
+
+// ExportAccountKey returns the key locator and derivation path of an account's
+// trader key. The key is derived from the connected lnd node again to make sure
+// the exported derivation actually results in the account's key.
+func (s *rpcServer) ExportAccountKey(ctx context.Context,
+	req *poolrpc.ExportAccountKeyRequest) (*poolrpc.ExportAccountKeyResponse,
+	error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := s.server.db.Account(traderKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch account: %v", err)
+	}
+
+	keyLocator := acct.TraderKey.KeyLocator
+	keyDesc, err := s.lndServices.WalletKit.DeriveKey(ctx, &keyLocator)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive trader key: %v", err)
+	}
+	if !keyDesc.PubKey.IsEqual(traderKey) {
+		return nil, fmt.Errorf("trader key %x was not derived by the "+
+			"connected lnd node from key family %d and index %d",
+			req.TraderKey, keyLocator.Family, keyLocator.Index)
+	}
+
+	return &poolrpc.ExportAccountKeyResponse{
+		TraderKey: traderKey.SerializeCompressed(),
+		KeyFamily: uint32(keyLocator.Family),
+		KeyIndex:  keyLocator.Index,
+		DerivationPath: accountKeyDerivationPath(
+			s.lndServices.ChainParams, keyLocator,
+		),
+	}, nil
+}
+
+// accountKeyDerivationPath returns the BIP32 derivation path lnd uses for the
+// key with the given locator on the given network.
+func accountKeyDerivationPath(params *chaincfg.Params,
+	keyLocator keychain.KeyLocator) string {
+
+	return fmt.Sprintf("m/%d'/%d'/%d'/0/%d", keychain.BIP0043Purpose,
+		params.HDCoinType, keyLocator.Family, keyLocator.Index)
+}
+
+// SetAccountPolicy replaces the spending policy of an account that is enforced
+// when submitting orders backed by it.
+func (s *rpcServer) SetAccountPolicy(_ context.Context,
+	req *poolrpc.SetAccountPolicyRequest) (*poolrpc.SetAccountPolicyResponse,
+	error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &account.Policy{}
+	if req.Policy != nil {
+		policy.MaxOrders = req.Policy.MaxOrders
+		policy.MaxOutstanding = btcutil.Amount(
+			req.Policy.MaxOutstandingSat,
+		)
+	}
+
+	rpcLog.Infof("Setting policy of acct=%x to max_orders=%d, "+
+		"max_outstanding=%v", req.TraderKey, policy.MaxOrders,
+		policy.MaxOutstanding)
+
+	if err := s.server.db.SetAccountPolicy(traderKey, policy); err != nil {
+		return nil, fmt.Errorf("unable to set account policy: %v", err)
+	}
+
+	return &poolrpc.SetAccountPolicyResponse{
+		Policy: &poolrpc.AccountPolicy{
+			MaxOrders:         policy.MaxOrders,
+			MaxOutstandingSat: uint64(policy.MaxOutstanding),
+		},
 	}, nil
 }
 
@@ -724,6 +1657,10 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
 			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
 	}
+	feeRate, err = s.feeRateCeiling.apply(feeRate)
+	if err != nil {
+		return nil, err
+	}
 
 	bestHeight := atomic.LoadUint32(&s.bestHeight)
 
@@ -742,7 +1679,9 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 	// In order to be able to choose a default value (or to validate the
 	// account version), we need to know if the version of the connected lnd
 	// supports Taproot.
-	version, err := s.determineAccountVersion(req.NewVersion)
+	version, err := s.determineModifiedAccountVersion(
+		traderKey, req.NewVersion,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -754,7 +1693,7 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 		bestHeight, expiryHeight, version,
 	)
 	if err != nil {
-		return nil, err
+		return nil, anchorReserveErr(err)
 	}
 
 	rpcModAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
@@ -801,6 +1740,10 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
 			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
 	}
+	feeRate, err = s.feeRateCeiling.apply(feeRate)
+	if err != nil {
+		return nil, err
+	}
 
 	bestHeight := atomic.LoadUint32(&s.bestHeight)
 
@@ -819,7 +1762,9 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 	// In order to be able to choose a default value (or to validate the
 	// account version), we need to know if the version of the connected lnd
 	// supports Taproot.
-	version, err := s.determineAccountVersion(req.NewVersion)
+	version, err := s.determineModifiedAccountVersion(
+		traderKey, req.NewVersion,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -848,6 +1793,127 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 	}, nil
 }
 
+// SplitAccount moves parts of an account's balance into a new account that is
+// funded by a withdrawal from the existing account.
+func (s *rpcServer) SplitAccount(ctx context.Context,
+	req *poolrpc.SplitAccountRequest) (*poolrpc.SplitAccountResponse,
+	error) {
+
+	rpcLog.Infof("Splitting %v off acct=%x",
+		btcutil.Amount(req.AmountSat), req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw.
+	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	}
+	feeRate, err = s.feeRateCeiling.apply(feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	expiryHeight, err := accountExpiryHeight(
+		req.GetAbsoluteExpiry(), req.GetRelativeExpiry(), bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	modifiedAccount, newAccount, tx, err := s.accountManager.SplitAccount(
+		ctx, traderKey, btcutil.Amount(req.AmountSat), feeRate,
+		expiryHeight, bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, []*account.Account{modifiedAccount, newAccount},
+	)
+	if err != nil {
+		return nil, err
+	}
+	txHash := tx.TxHash()
+
+	return &poolrpc.SplitAccountResponse{
+		Account:    rpcAccounts[0],
+		NewAccount: rpcAccounts[1],
+		SplitTxid:  txHash[:],
+	}, nil
+}
+
+// MergeAccounts moves all funds of an account into another account with a
+// single transaction that spends both of them.
+func (s *rpcServer) MergeAccounts(ctx context.Context,
+	req *poolrpc.MergeAccountsRequest) (*poolrpc.MergeAccountsResponse,
+	error) {
+
+	rpcLog.Infof("Merging acct=%x into acct=%x", req.MergeTraderKey,
+		req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+	mergeTraderKey, err := btcec.ParsePubKey(req.MergeTraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw.
+	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	}
+	feeRate, err = s.feeRateCeiling.apply(feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Just like when closing an account, none of the merged accounts may
+	// have open orders, as the merge changes or spends their outputs.
+	for _, acctKey := range [][]byte{req.TraderKey, req.MergeTraderKey} {
+		openNonces, err := s.openOrderNonces(ctx, acctKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(openNonces) > 0 {
+			return nil, fmt.Errorf("acct=%x has open orders, "+
+				"cancel them before merging: %v", acctKey,
+				openNonces)
+		}
+	}
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	modifiedAccount, tx, err := s.accountManager.MergeAccounts(
+		ctx, traderKey, mergeTraderKey, feeRate, bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcModAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, []*account.Account{modifiedAccount},
+	)
+	if err != nil {
+		return nil, err
+	}
+	txHash := tx.TxHash()
+
+	return &poolrpc.MergeAccountsResponse{
+		Account:   rpcModAccounts[0],
+		MergeTxid: txHash[:],
+	}, nil
+}
+
 // RenewAccount updates the expiration of an open/expired account. This
 // will always require a signature from the auctioneer, even after the account
 // has expired, to ensure the auctioneer is aware the account is being renewed.
@@ -882,11 +1948,17 @@ func (s *rpcServer) RenewAccount(ctx context.Context,
 		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
 			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
 	}
+	feeRate, err = s.feeRateCeiling.apply(feeRate)
+	if err != nil {
+		return nil, err
+	}
 
 	// In order to be able to choose a default value (or to validate the
 	// account version), we need to know if the version of the connected lnd
 	// supports Taproot.
-	version, err := s.determineAccountVersion(req.NewVersion)
+	version, err := s.determineModifiedAccountVersion(
+		accountKey, req.NewVersion,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -914,6 +1986,123 @@ func (s *rpcServer) RenewAccount(ctx context.Context,
 	}, nil
 }
 
+// QuoteAccountRenewal returns the estimated fees for renewing the expiration of
+// an account without signing or broadcasting any transaction.
+func (s *rpcServer) QuoteAccountRenewal(ctx context.Context,
+	req *poolrpc.QuoteAccountRenewalRequest) (
+	*poolrpc.QuoteAccountRenewalResponse, error) {
+
+	// Ensure the account key is well formed.
+	accountKey, err := btcec.ParsePubKey(req.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine the desired expiration value, can be relative or absolute.
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	var expiryHeight uint32
+	switch {
+	case req.GetAbsoluteExpiry() != 0:
+		expiryHeight = req.GetAbsoluteExpiry()
+	case req.GetRelativeExpiry() != 0:
+		expiryHeight = req.GetRelativeExpiry() + bestHeight
+	default:
+		return nil, errors.New("either relative or absolute height " +
+			"must be specified")
+	}
+
+	if req.ConfTarget < 1 {
+		return nil, fmt.Errorf("confirmation target must be " +
+			"greater than 0")
+	}
+
+	version, err := s.determineModifiedAccountVersion(
+		accountKey, req.NewVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, totalFee, err := s.accountManager.QuoteAccountRenewal(
+		ctx, accountKey, expiryHeight, req.ConfTarget, bestHeight,
+		version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.QuoteAccountRenewalResponse{
+		MinerFeeRateSatPerKw: uint64(feeRate),
+		MinerFeeTotal:        uint64(totalFee),
+		NewExpiry:            expiryHeight,
+	}, nil
+}
+
+// SimulateAccountFees estimates the on-chain fees of opening, depositing to,
+// withdrawing from and closing an account at several confirmation targets.
+func (s *rpcServer) SimulateAccountFees(ctx context.Context,
+	req *poolrpc.SimulateAccountFeesRequest) (
+	*poolrpc.SimulateAccountFeesResponse, error) {
+
+	version, err := s.determineAccountVersion(req.AccountVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The weight of the transactions doesn't depend on the fee rate, so
+	// we only need to estimate it once per action.
+	actions := []account.Action{
+		account.CREATE, account.DEPOSIT, account.WITHDRAW,
+		account.CLOSE,
+	}
+	weights := make(map[account.Action]int64, len(actions))
+	for _, action := range actions {
+		weights[action], err = account.EstimateActionWeight(
+			action, version,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	confTargets := req.ConfTargets
+	if len(confTargets) == 0 {
+		confTargets = defaultSimulationConfTargets
+	}
+
+	simulations := make([]*poolrpc.AccountFeeSimulation, 0, len(confTargets))
+	for _, confTarget := range confTargets {
+		if confTarget < 1 {
+			return nil, fmt.Errorf("confirmation target must be " +
+				"greater than 0")
+		}
+
+		feeRate, err := s.feeEstimator.EstimateFeeRate(
+			ctx, int32(confTarget),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error estimating fee rate for "+
+				"conf target %d: %v", confTarget, err)
+		}
+
+		fee := func(action account.Action) uint64 {
+			return uint64(feeRate.FeeForWeight(weights[action]))
+		}
+		simulations = append(simulations, &poolrpc.AccountFeeSimulation{
+			ConfTarget:      confTarget,
+			FeeRateSatPerKw: uint64(feeRate),
+			OpenFeeSat:      fee(account.CREATE),
+			DepositFeeSat:   fee(account.DEPOSIT),
+			WithdrawFeeSat:  fee(account.WITHDRAW),
+			CloseFeeSat:     fee(account.CLOSE),
+		})
+	}
+
+	return &poolrpc.SimulateAccountFeesResponse{
+		Simulations: simulations,
+	}, nil
+}
+
 // BumpAccountFee attempts to bump the fee of an account's transaction through
 // child-pays-for-parent (CPFP). Since the CPFP is performed through the backing
 // lnd node, the account transaction must contain an output under its control
@@ -928,7 +2117,12 @@ func (s *rpcServer) BumpAccountFee(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
+	feeRate, err := s.feeRateCeiling.apply(
+		chainfee.SatPerKWeight(req.FeeRateSatPerKw),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	err = s.accountManager.BumpAccountFee(ctx, traderKey, feeRate)
 	if err != nil {
@@ -973,7 +2167,7 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 		switch feeExpr := dest.OutputWithFee.Fees.(type) {
 		case *poolrpc.OutputWithFee_ConfTarget:
 			var err error
-			feeRate, err = s.lndServices.WalletKit.EstimateFeeRate(
+			feeRate, err = s.feeEstimator.EstimateFeeRate(
 				ctx, int32(feeExpr.ConfTarget),
 			)
 			if err != nil {
@@ -982,6 +2176,10 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 
 		case *poolrpc.OutputWithFee_FeeRateSatPerKw:
 			feeRate = chainfee.SatPerKWeight(feeExpr.FeeRateSatPerKw)
+
+		case nil:
+			return nil, errors.New("either a confirmation target " +
+				"or fee rate must be specified")
 		}
 
 		// Enforce a minimum fee rate of 253 sat/kw.
@@ -989,6 +2187,10 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 			return nil, fmt.Errorf("fee rate of %v is too low, "+
 				"minimum is %v", feeRate, chainfee.FeePerKwFloor)
 		}
+		feeRate, err = s.feeRateCeiling.apply(feeRate)
+		if err != nil {
+			return nil, err
+		}
 
 		feeExpr = &account.OutputWithFee{
 			PkScript: pkScript,
@@ -1009,9 +2211,41 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 
 		feeExpr = account.OutputsWithImplicitFee(outputs)
 
-	case nil:
-		return nil, errors.New("a funds destination must be specified")
-	}
+	case nil:
+		return nil, errors.New("a funds destination must be specified")
+	}
+
+	openNonces, err := s.openOrderNonces(ctx, req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// We don't allow an account to be closed if it has open orders so they
+	// don't dangle in the order book on the server's side.
+	if len(openNonces) > 0 {
+		return nil, fmt.Errorf("acct=%x has open orders, cancel them "+
+			"before closing: %v", req.TraderKey, openNonces)
+	}
+
+	// Proceed to close the requested account with the parsed fee
+	// expression.
+	closeTx, err := s.accountManager.CloseAccount(
+		ctx, traderKey, feeExpr, atomic.LoadUint32(&s.bestHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+	closeTxHash := closeTx.TxHash()
+
+	return &poolrpc.CloseAccountResponse{
+		CloseTxid: closeTxHash[:],
+	}, nil
+}
+
+// openOrderNonces returns the nonces of all orders of the account with the
+// given trader key that are still active according to the auctioneer.
+func (s *rpcServer) openOrderNonces(ctx context.Context,
+	traderKey []byte) ([]order.Nonce, error) {
 
 	dbOrders, err := s.server.db.GetOrders()
 	if err != nil {
@@ -1023,9 +2257,12 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 		orderDetails := dbOrder.Details()
 		nonce := orderDetails.Nonce()
 
-		// There's no need to query for an order in a terminal state
-		// from our PoV as it can't transition back to active.
-		if dbOrder.Details().State.Archived() {
+		// There's no need to query for an order of another account or
+		// in a terminal state from our PoV as it can't transition back
+		// to active.
+		if !bytes.Equal(orderDetails.AcctKey[:], traderKey) ||
+			orderDetails.State.Archived() {
+
 			continue
 		}
 
@@ -1046,31 +2283,10 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 			continue
 		}
 
-		if bytes.Equal(orderDetails.AcctKey[:], req.TraderKey) {
-			openNonces = append(openNonces, orderDetails.Nonce())
-		}
-	}
-
-	// We don't allow an account to be closed if it has open orders so they
-	// don't dangle in the order book on the server's side.
-	if len(openNonces) > 0 {
-		return nil, fmt.Errorf("acct=%x has open orders, cancel them "+
-			"before closing: %v", req.TraderKey, openNonces)
-	}
-
-	// Proceed to close the requested account with the parsed fee
-	// expression.
-	closeTx, err := s.accountManager.CloseAccount(
-		ctx, traderKey, feeExpr, atomic.LoadUint32(&s.bestHeight),
-	)
-	if err != nil {
-		return nil, err
+		openNonces = append(openNonces, nonce)
 	}
-	closeTxHash := closeTx.TxHash()
 
-	return &poolrpc.CloseAccountResponse{
-		CloseTxid: closeTxHash[:],
-	}, nil
+	return openNonces, nil
 }
 
 // parseOutputScript parses the output script from the string representation of
@@ -1108,8 +2324,8 @@ func (s *rpcServer) parseRPCOutputs(outputs []*poolrpc.Output) ([]*wire.TxOut,
 }
 
 // serverAssistedRecovery executes the server assisted account recovery process.
-func (s *rpcServer) serverAssistedRecovery(ctx context.Context, target uint32) (
-	[]*account.Account, error) {
+func (s *rpcServer) serverAssistedRecovery(ctx context.Context,
+	firstIndex, numKeys uint32) ([]*account.Account, error) {
 
 	// The account recovery process uses a bi-directional streaming RPC on
 	// the server side. Unfortunately, because of the way streaming RPCs
@@ -1124,8 +2340,8 @@ func (s *rpcServer) serverAssistedRecovery(ctx context.Context, target uint32) (
 
 	// Prepare the keys we are going to try. Possibly not all of them will
 	// be used.
-	acctKeys, err := account.GenerateRecoveryKeys(
-		ctx, target, s.lndServices.WalletKit,
+	acctKeys, err := account.GenerateRecoveryKeyRange(
+		ctx, firstIndex, numKeys, s.lndServices.WalletKit,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error generating keys: %v", err)
@@ -1184,17 +2400,11 @@ func (s *rpcServer) fullClientRecovery(ctx context.Context,
 	}
 
 	cfg := account.RecoveryConfig{
-		Network:       s.server.cfg.Network,
-		AccountTarget: req.AccountTarget,
-		FirstBlock:    req.HeightHint,
-		BitcoinConfig: &account.BitcoinConfig{
-			Host:         req.BitcoinHost,
-			User:         req.BitcoinUser,
-			Password:     req.BitcoinPassword,
-			HTTPPostMode: req.BitcoinHttppostmode,
-			UseTLS:       req.BitcoinUsetls,
-			TLSPath:      req.BitcoinTlspath,
-		},
+		Network:          s.server.cfg.Network,
+		AccountTarget:    req.AccountTarget,
+		FirstKeyIndex:    req.FirstKeyIndex,
+		KeyWindow:        req.KeyWindow,
+		FirstBlock:       req.HeightHint,
 		Transactions:     txs,
 		Signer:           s.lndServices.Signer,
 		Wallet:           s.lndServices.WalletKit,
@@ -1203,6 +2413,22 @@ func (s *rpcServer) fullClientRecovery(ctx context.Context,
 		Quit:             s.quit,
 	}
 
+	// If no bitcoin backend was specified, we rebuild the account state
+	// from the transactions lnd's wallet knows of only.
+	if req.BitcoinHost == "" {
+		bestHeight := int64(atomic.LoadUint32(&s.bestHeight))
+		cfg.ChainSource = account.NewLndChainSource(bestHeight, lndTxs)
+	} else {
+		cfg.BitcoinConfig = &account.BitcoinConfig{
+			Host:         req.BitcoinHost,
+			User:         req.BitcoinUser,
+			Password:     req.BitcoinPassword,
+			HTTPPostMode: req.BitcoinHttppostmode,
+			UseTLS:       req.BitcoinUsetls,
+			TLSPath:      req.BitcoinTlspath,
+		}
+	}
+
 	recoveredAccounts, err := account.RecoverAccounts(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error performing recovery: %v", err)
@@ -1241,7 +2467,13 @@ func (s *rpcServer) RecoverAccounts(ctx context.Context,
 	}
 
 	if !req.FullClient {
-		recoveredAccounts, err = s.serverAssistedRecovery(ctx, target)
+		numKeys := req.KeyWindow
+		if numKeys == 0 {
+			numKeys = target
+		}
+		recoveredAccounts, err = s.serverAssistedRecovery(
+			ctx, req.FirstKeyIndex, numKeys,
+		)
 	} else {
 		recoveredAccounts, err = s.fullClientRecovery(ctx, req)
 	}
@@ -1327,6 +2559,22 @@ func (s *rpcServer) validateOrder(o order.Order, acct *account.Account,
 
 	switch castOrder := o.(type) {
 	case *order.Ask:
+		switch castOrder.AnnouncementConstraints {
+		case order.AnnouncementNoPreference:
+
+		case order.OnlyAnnounced, order.OnlyUnannounced:
+			if !batchVersion.SupportsUnannouncedChannels() {
+				return fmt.Errorf("batch version %v does not "+
+					"support channel announcement "+
+					"constraints", batchVersion)
+			}
+
+		default:
+			return fmt.Errorf("unknown channel announcement "+
+				"constraints %d",
+				castOrder.AnnouncementConstraints)
+		}
+
 		if castOrder.ConfirmationConstraints == order.OnlyZeroConf {
 			if !batchVersion.SupportsZeroConfChannels() {
 				return fmt.Errorf("batch version %v does not "+
@@ -1350,6 +2598,13 @@ func (s *rpcServer) validateOrder(o order.Order, acct *account.Account,
 		}
 
 	case *order.Bid:
+		if castOrder.UnannouncedChannel &&
+			!batchVersion.SupportsUnannouncedChannels() {
+
+			return fmt.Errorf("batch version %v does not support "+
+				"unannounced channels", batchVersion)
+		}
+
 		if castOrder.ZeroConfChannel {
 			if !batchVersion.SupportsZeroConfChannels() {
 				return fmt.Errorf("batch version %v does not "+
@@ -1384,10 +2639,8 @@ func (s *rpcServer) validateOrder(o order.Order, acct *account.Account,
 	// If the market isn't currently accepting orders for this particular
 	// lease duration, then we'll exit here as the order will be rejected.
 	leaseDuration := o.Details().LeaseDuration
-	if _, ok := auctionTerms.LeaseDurationBuckets[leaseDuration]; !ok {
-		return fmt.Errorf("invalid channel lease duration %v "+
-			"blocks, active durations are: %v",
-			leaseDuration, auctionTerms.LeaseDurationBuckets)
+	if err := auctionTerms.ValidateLeaseDuration(leaseDuration); err != nil {
+		return err
 	}
 
 	// If the order does not specify any AllowedNodeIDs/NotAllowedNodeIDs
@@ -1421,7 +2674,17 @@ type orderPreparer func(context.Context, order.Order,
 // the order is valid, before submitting it to the auctioneer.
 func prepareAndSubmitOrder(ctx context.Context, o order.Order,
 	auctionTerms *terms.AuctioneerTerms, acct *account.Account,
-	auction *auctioneer.Client, prepareOrder orderPreparer) error {
+	auction *auctioneer.Client, prepareOrder orderPreparer) (err error) {
+
+	nonce := o.Nonce()
+	ctx, span := startSpan(
+		ctx, "pool.SubmitOrder",
+		attribute.String("order.nonce", nonce.String()),
+		attribute.String("order.type", o.Type().String()),
+	)
+	defer func() {
+		endSpan(span, err)
+	}()
 
 	// Collect all the order data and sign it before sending it to the
 	// auction server.
@@ -1455,6 +2718,351 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			"still starting up")
 	}
 
+	// If the user wants every order to be confirmed explicitly, orders
+	// can only be submitted through PrepareOrder and ConfirmOrder.
+	if s.server.cfg.RequireOrderConfirmation {
+		return nil, fmt.Errorf("order confirmation required, use " +
+			"PrepareOrder and ConfirmOrder to submit orders")
+	}
+
+	return s.submitOrder(ctx, req)
+}
+
+// PrepareOrder validates a new ask or bid order and returns a preview of its
+// premium and fees together with a short-lived confirmation token. The order is
+// only submitted to the auction server once ConfirmOrder is called with that
+// token.
+func (s *rpcServer) PrepareOrder(ctx context.Context,
+	req *poolrpc.SubmitOrderRequest) (*poolrpc.PrepareOrderResponse,
+	error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot prepare order, trader daemon " +
+			"still starting up")
+	}
+
+	if err := s.checkPendingOrderLimit(); err != nil {
+		return nil, err
+	}
+
+	o, _, _, err := s.parseAndValidateOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOrderPolicy(o, order.ZeroNonce); err != nil {
+		return nil, err
+	}
+
+	details := o.Details()
+	quote, err := s.QuoteOrder(ctx, &poolrpc.QuoteOrderRequest{
+		Amt:                     uint64(details.Amt),
+		RateFixed:               details.FixedRate,
+		LeaseDurationBlocks:     details.LeaseDuration,
+		MaxBatchFeeRateSatPerKw: uint64(details.MaxBatchFeeRate),
+		MinUnitsMatch:           uint32(details.MinUnitsMatch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The clearing rate of the last batch is only an estimate for the
+	// preview, so we don't fail if it isn't available.
+	var lastClearingRate uint32
+	snapshot, err := s.auctioneer.BatchSnapshot(ctx, order.BatchID{})
+	if err != nil {
+		rpcLog.Debugf("Unable to fetch last batch snapshot for order "+
+			"preview: %v", err)
+	} else {
+		market, ok := snapshot.MatchedMarkets[details.LeaseDuration]
+		if ok {
+			lastClearingRate = market.ClearingPriceRate
+		}
+	}
+
+	token, expiry, err := s.orderConfirmations.add(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create confirmation token: "+
+			"%v", err)
+	}
+
+	rpcLog.Infof("Prepared %v order for confirmation until %v",
+		o.Type(), expiry)
+
+	return &poolrpc.PrepareOrderResponse{
+		Quote:                 quote,
+		LastClearingRateFixed: lastClearingRate,
+		ConfirmationToken:     token[:],
+		ExpiryTimestampNs:     expiry.UnixNano(),
+		RateFixed:             details.FixedRate,
+	}, nil
+}
+
+// ConfirmOrder submits an order that was previously prepared with PrepareOrder
+// to the auction server. Each confirmation token can only be used once and only
+// until it expires.
+func (s *rpcServer) ConfirmOrder(ctx context.Context,
+	req *poolrpc.ConfirmOrderRequest) (*poolrpc.SubmitOrderResponse,
+	error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot confirm order, trader daemon " +
+			"still starting up")
+	}
+
+	orderReq, err := s.orderConfirmations.take(req.ConfirmationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.submitOrder(ctx, orderReq)
+}
+
+// submitOrder parses and validates the order of the given request, signs it and
+// then sends it to the server to be included in the auctioneer's order book.
+func (s *rpcServer) submitOrder(ctx context.Context,
+	req *poolrpc.SubmitOrderRequest) (*poolrpc.SubmitOrderResponse, error) {
+
+	// We fail early if the limit is already reached. The definitive check
+	// is done when the order is added to the local database.
+	if err := s.checkPendingOrderLimit(); err != nil {
+		return nil, err
+	}
+
+	o, auctionTerms, acct, err := s.parseAndValidateOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same goes for the account policy.
+	if err := s.checkOrderPolicy(o, order.ZeroNonce); err != nil {
+		return nil, err
+	}
+
+	return s.submitValidatedOrder(
+		ContextWithInitiator(ctx, req.Initiator), o, auctionTerms, acct,
+		s.prepareOrderWithinLimit,
+	)
+}
+
+// prepareOrderWithinLimit adds an order to the local order database unless the
+// maximum number of pending orders is reached or the order violates the policy
+// of its account. The checks and the insert happen under the pending orders
+// mutex, the submission to the auction server afterwards doesn't.
+func (s *rpcServer) prepareOrderWithinLimit(ctx context.Context,
+	o order.Order, acct *account.Account,
+	auctionTerms *terms.AuctioneerTerms) (*order.ServerOrderParams, error) {
+
+	s.pendingOrdersMtx.Lock()
+	defer s.pendingOrdersMtx.Unlock()
+
+	if err := s.checkPendingOrderLimit(); err != nil {
+		return nil, err
+	}
+	if err := s.checkOrderPolicy(o, order.ZeroNonce); err != nil {
+		return nil, err
+	}
+
+	return s.orderManager.PrepareOrder(ctx, o, acct, auctionTerms)
+}
+
+// prepareReplacementOrder returns an order preparer that adds an order which
+// replaces the order with the given nonce to the local order database, unless
+// it violates the policy of its account. The replacement doesn't add to the
+// number of pending orders, so that limit isn't checked. Just like for new
+// orders, the policy check and the insert happen under the pending orders
+// mutex.
+func (s *rpcServer) prepareReplacementOrder(
+	replaced order.Nonce) orderPreparer {
+
+	return func(ctx context.Context, o order.Order, acct *account.Account,
+		auctionTerms *terms.AuctioneerTerms) (*order.ServerOrderParams,
+		error) {
+
+		s.pendingOrdersMtx.Lock()
+		defer s.pendingOrdersMtx.Unlock()
+
+		if err := s.checkOrderPolicy(o, replaced); err != nil {
+			return nil, err
+		}
+
+		return s.orderManager.PrepareOrder(ctx, o, acct, auctionTerms)
+	}
+}
+
+// submitValidatedOrder adds an order that was already validated to the local
+// order database using the given preparer and submits it to the auction
+// server.
+func (s *rpcServer) submitValidatedOrder(ctx context.Context, o order.Order,
+	auctionTerms *terms.AuctioneerTerms, acct *account.Account,
+	prepareOrder orderPreparer) (*poolrpc.SubmitOrderResponse, error) {
+
+	// Finally add the order to the local order database, and submit it to
+	// the auctioneer server.
+	err := prepareAndSubmitOrder(
+		ctx, o, auctionTerms, acct, s.auctioneer, prepareOrder,
+	)
+	if err != nil {
+		// The server rejected the order. We keep it around for now,
+		// failed orders can be filtered by specifying --active_only
+		// when listing orders.
+		err2 := s.server.db.UpdateOrder(
+			o.Nonce(), order.StateModifier(order.StateFailed),
+		)
+		if err2 != nil {
+			rpcLog.Errorf("Could not update failed order: %v", err2)
+		}
+
+		// If there was something wrong with the information the user
+		// provided, then return this as a nice string instead of an
+		// error type.
+		if userErr, ok := err.(*order.UserError); ok {
+			rpcLog.Warnf("Invalid order details: %v", userErr)
+
+			return &poolrpc.SubmitOrderResponse{
+				Details: &poolrpc.SubmitOrderResponse_InvalidOrder{
+					InvalidOrder: userErr.Details,
+				},
+			}, nil
+		}
+
+		// Any other error we return normally as a gRPC status level
+		// error.
+		return nil, fmt.Errorf("error submitting order to auctioneer: "+
+			"%v", err)
+	}
+
+	rpcLog.Infof("New order submitted: nonce=%v, type=%v", o.Nonce(), o.Type())
+
+	// The order is in the order book now, so failing to write its receipt
+	// must not fail the submission.
+	if s.server.cfg.OrderReceiptDir != "" {
+		receipt := newOrderReceipt(o, time.Now())
+		path, err := writeOrderReceipt(
+			s.server.cfg.OrderReceiptDir, receipt,
+		)
+		if err != nil {
+			rpcLog.Errorf("Unable to write receipt of order %v: %v",
+				o.Nonce(), err)
+		} else {
+			rpcLog.Debugf("Wrote receipt of order %v to %v",
+				o.Nonce(), path)
+		}
+	}
+
+	// We should update the sidecar ticket if this was a bid created for it.
+	// The ticket in the order struct was already updated with the order
+	// information and signature, but it was only stored as TLV data with
+	// the order itself. We should also update the ticket in the main
+	// sidecar ticket bucket.
+	var encodedTicket string
+	bid, isBid := o.(*order.Bid)
+	if isBid && bid.SidecarTicket != nil {
+		err := s.server.db.UpdateSidecar(bid.SidecarTicket)
+		if err != nil {
+			return nil, fmt.Errorf("error updating sidecar "+
+				"ticket: %v", err)
+		}
+
+		encodedTicket, err = sidecar.EncodeToString(bid.SidecarTicket)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding sidecar "+
+				"ticket: %v", err)
+		}
+	}
+
+	// ServerOrder is accepted.
+	orderNonce := o.Nonce()
+	return &poolrpc.SubmitOrderResponse{
+		Details: &poolrpc.SubmitOrderResponse_AcceptedOrderNonce{
+			AcceptedOrderNonce: orderNonce[:],
+		},
+		UpdatedSidecarTicket: encodedTicket,
+		RateFixed:            o.Details().FixedRate,
+	}, nil
+}
+
+// cancelPartialOrder cancels the remainder of an order that was partially
+// filled in a batch.
+func (s *rpcServer) cancelPartialOrder(ctx context.Context,
+	o order.Order) error {
+
+	nonce := o.Nonce()
+	_, err := s.CancelOrder(ctx, &poolrpc.CancelOrderRequest{
+		OrderNonce: nonce[:],
+	})
+	return err
+}
+
+// submitRecreatedOrder submits an order that recreates a fully filled order,
+// unless the maximum number of pending orders is reached.
+func (s *rpcServer) submitRecreatedOrder(ctx context.Context,
+	o order.Order) error {
+
+	return s.submitFollowUpOrder(ctx, o, s.prepareOrderWithinLimit)
+}
+
+// submitRepricedOrder submits an order for the repriced remainder of a
+// partially filled order. The remainder replaces the original order, so it
+// doesn't count against the maximum number of pending orders.
+func (s *rpcServer) submitRepricedOrder(ctx context.Context,
+	o order.Order) error {
+
+	return s.submitFollowUpOrder(ctx, o, s.orderManager.PrepareOrder)
+}
+
+// submitFollowUpOrder validates and submits an order that was created by the
+// daemon itself after a batch, either for the remainder of a partially filled
+// order or to recreate a fully filled one.
+func (s *rpcServer) submitFollowUpOrder(ctx context.Context, o order.Order,
+	prepareOrder orderPreparer) error {
+
+	auctionTerms, err := s.auctioneer.Terms(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query auctioneer terms: %v", err)
+	}
+
+	acctKey, err := btcec.ParsePubKey(o.Details().AcctKey[:])
+	if err != nil {
+		return err
+	}
+	acct, err := s.server.db.Account(acctKey)
+	if err != nil {
+		return fmt.Errorf("cannot accept order: %v", err)
+	}
+
+	if err := s.validateOrder(o, acct, auctionTerms); err != nil {
+		return fmt.Errorf("order valid validation: %w", err)
+	}
+
+	err = prepareAndSubmitOrder(
+		ctx, o, auctionTerms, acct, s.auctioneer, prepareOrder,
+	)
+	if err != nil {
+		err2 := s.server.db.UpdateOrder(
+			o.Nonce(), order.StateModifier(order.StateFailed),
+		)
+		if err2 != nil {
+			rpcLog.Errorf("Could not update failed order: %v", err2)
+		}
+
+		return fmt.Errorf("error submitting order to auctioneer: "+
+			"%v", err)
+	}
+
+	rpcLog.Infof("Follow-up order submitted: nonce=%v, type=%v",
+		o.Nonce(), o.Type())
+
+	return nil
+}
+
+// parseAndValidateOrder parses the order of the given request and validates it
+// against the current auction terms and the state of its account.
+func (s *rpcServer) parseAndValidateOrder(ctx context.Context,
+	req *poolrpc.SubmitOrderRequest) (order.Order, *terms.AuctioneerTerms,
+	*account.Account, error) {
+
 	// We'll use this channel type selector to pick a channel type based on
 	// the current connected lnd version. This lets us graceful update to
 	// new features as they're available, while still supporting older
@@ -1468,16 +3076,20 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 		return order.ChannelTypePeerDependent
 	})
 
-	var o order.Order
+	var (
+		o       order.Order
+		rateAPR float64
+	)
 	switch requestOrder := req.Details.(type) {
 	case *poolrpc.SubmitOrderRequest_Ask:
 		a := requestOrder.Ask
+		rateAPR = a.Details.GetRateApr()
 		kit, err := order.ParseRPCOrder(
 			a.Version, a.LeaseDurationBlocks, a.Details,
 			chanTypeSelector,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		announcement := order.ChannelAnnouncementConstraints(
@@ -1490,24 +3102,26 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			Kit:                     *kit,
 			AnnouncementConstraints: announcement,
 			ConfirmationConstraints: confirmations,
+			MinPremium:              btcutil.Amount(a.MinPremiumSat),
 		}
 
 	case *poolrpc.SubmitOrderRequest_Bid:
 		b := requestOrder.Bid
+		rateAPR = b.Details.GetRateApr()
 		kit, err := order.ParseRPCOrder(
 			b.Version, b.LeaseDurationBlocks, b.Details,
 			chanTypeSelector,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 		nodeTier, err := unmarshallNodeTier(b.MinNodeTier)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 		ticket, err := unmarshallSidecar(kit.Version, b.SidecarTicket)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		o = &order.Bid{
@@ -1517,102 +3131,187 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			SidecarTicket:      ticket,
 			UnannouncedChannel: b.UnannouncedChannel,
 			ZeroConfChannel:    b.ZeroConfChannel,
+			MaxPremium:         btcutil.Amount(b.MaxPremiumSat),
 		}
 
 	default:
-		return nil, fmt.Errorf("invalid order request")
+		return nil, nil, nil, fmt.Errorf("invalid order request")
+	}
+
+	// Reject order types the node isn't configured to submit before
+	// bothering the auctioneer.
+	if err := checkNodeRole(s.server.cfg.NodeRole, o.Type()); err != nil {
+		return nil, nil, nil, err
 	}
 
 	// We also need to know the current maximum order duration.
 	auctionTerms, err := s.auctioneer.Terms(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("could not query auctioneer terms: %v",
-			err)
+		return nil, nil, nil, fmt.Errorf("could not query auctioneer "+
+			"terms: %v", err)
 	}
 
 	// Verify that the account exists.
 	acctKey, err := btcec.ParsePubKey(o.Details().AcctKey[:])
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	acct, err := s.server.db.Account(acctKey)
 	if err != nil {
-		return nil, fmt.Errorf("cannot accept order: %v", err)
+		return nil, nil, nil, fmt.Errorf("cannot accept order: %v",
+			err)
+	}
+
+	err = applyDefaultLeaseDuration(
+		o, s.server.cfg.DefaultLeaseDuration, auctionTerms,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := applyRateAPR(o, rateAPR); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The batch transaction opens the channels of the order, so the fee
+	// rate the order is willing to pay for it is limited as well.
+	details := o.Details()
+	details.MaxBatchFeeRate, err = s.feeRateCeiling.apply(
+		details.MaxBatchFeeRate,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// If the auctioneer only accepts rates on a tick grid, we make sure we
+	// don't submit an order that is going to be rejected anyway.
+	requestedRate := o.Details().FixedRate
+	rounded, err := applyRateTick(
+		o, s.server.cfg.OrderRateTick,
+		s.server.cfg.OrderRateTickPolicy,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if rounded {
+		rpcLog.Infof("Rounded fixed rate of %v order from %d to %d "+
+			"to match the rate tick size %d", o.Type(),
+			requestedRate, o.Details().FixedRate,
+			s.server.cfg.OrderRateTick)
 	}
 
 	// Validate the order to ensure the account is in a live state, and the
 	// target lease duration period actually exists.
 	if err := s.validateOrder(o, acct, auctionTerms); err != nil {
-		return nil, fmt.Errorf("order valid validation: %w", err)
+		return nil, nil, nil, fmt.Errorf("order valid validation: %w",
+			err)
 	}
 
-	// Finally add the order to the local order database, and submit it to
-	// the auctioneer server.
-	err = prepareAndSubmitOrder(
-		ContextWithInitiator(ctx, req.Initiator), o, auctionTerms,
-		acct, s.auctioneer, s.orderManager.PrepareOrder,
-	)
+	return o, auctionTerms, acct, nil
+}
+
+// checkOrderPolicy makes sure the given order stays within the limits the user
+// set for the account backing it. The order with the given replaced nonce is
+// ignored, order.ZeroNonce can be used if the order doesn't replace another
+// one.
+func (s *rpcServer) checkOrderPolicy(o order.Order,
+	replaced order.Nonce) error {
+
+	acctKey, err := btcec.ParsePubKey(o.Details().AcctKey[:])
 	if err != nil {
-		// The server rejected the order. We keep it around for now,
-		// failed orders can be filtered by specifying --active_only
-		// when listing orders.
-		err2 := s.server.db.UpdateOrder(
-			o.Nonce(), order.StateModifier(order.StateFailed),
-		)
-		if err2 != nil {
-			rpcLog.Errorf("Could not update failed order: %v", err2)
-		}
+		return err
+	}
+	policy, err := s.server.db.AccountPolicy(acctKey)
+	if err != nil {
+		return fmt.Errorf("error loading account policy: %v", err)
+	}
+	if policy.IsEmpty() {
+		return nil
+	}
 
-		// If there was something wrong with the information the user
-		// provided, then return this as a nice string instead of an
-		// error type.
-		if userErr, ok := err.(*order.UserError); ok {
-			rpcLog.Warnf("Invalid order details: %v", userErr)
+	orders, err := s.server.db.GetOrders()
+	if err != nil {
+		return fmt.Errorf("error loading orders: %v", err)
+	}
 
-			return &poolrpc.SubmitOrderResponse{
-				Details: &poolrpc.SubmitOrderResponse_InvalidOrder{
-					InvalidOrder: userErr.Details,
-				},
-			}, nil
-		}
+	return checkAccountPolicy(policy, orders, o, replaced)
+}
 
-		// Any other error we return normally as a gRPC status level
-		// error.
-		return nil, fmt.Errorf("error submitting order to auctioneer: "+
-			"%v", err)
+// checkPendingOrderLimit returns an error if the user capped the number of
+// orders that can be pending at the same time and that limit is reached.
+func (s *rpcServer) checkPendingOrderLimit() error {
+	maxPending := s.server.cfg.MaxPendingOrders
+	if maxPending == 0 {
+		return nil
 	}
 
-	rpcLog.Infof("New order submitted: nonce=%v, type=%v", o.Nonce(), o.Type())
+	orders, err := s.server.db.GetOrders()
+	if err != nil {
+		return fmt.Errorf("error loading orders: %v", err)
+	}
 
-	// We should update the sidecar ticket if this was a bid created for it.
-	// The ticket in the order struct was already updated with the order
-	// information and signature, but it was only stored as TLV data with
-	// the order itself. We should also update the ticket in the main
-	// sidecar ticket bucket.
-	var encodedTicket string
-	bid, isBid := o.(*order.Bid)
-	if isBid && bid.SidecarTicket != nil {
-		err := s.server.db.UpdateSidecar(bid.SidecarTicket)
-		if err != nil {
-			return nil, fmt.Errorf("error updating sidecar "+
-				"ticket: %v", err)
-		}
+	return checkMaxPendingOrders(orders, maxPending)
+}
 
-		encodedTicket, err = sidecar.EncodeToString(bid.SidecarTicket)
-		if err != nil {
-			return nil, fmt.Errorf("error encoding sidecar "+
-				"ticket: %v", err)
+// checkMaxPendingOrders returns an error if the number of orders that are not
+// yet archived has already reached the given maximum.
+func checkMaxPendingOrders(orders []order.Order, maxPending int) error {
+	var numPending int
+	for _, o := range orders {
+		if !o.Details().State.Archived() {
+			numPending++
 		}
 	}
 
-	// ServerOrder is accepted.
-	orderNonce := o.Nonce()
-	return &poolrpc.SubmitOrderResponse{
-		Details: &poolrpc.SubmitOrderResponse_AcceptedOrderNonce{
-			AcceptedOrderNonce: orderNonce[:],
-		},
-		UpdatedSidecarTicket: encodedTicket,
-	}, nil
+	if numPending >= maxPending {
+		return fmt.Errorf("maximum number of %d pending orders "+
+			"reached, cancel an order or wait for one to be "+
+			"filled before submitting a new one", maxPending)
+	}
+
+	return nil
+}
+
+// applyRateAPR sets the fixed rate of the order from the annual percentage
+// rate it was submitted with, if any. An order can't be submitted with both a
+// fixed rate and an APR.
+func applyRateAPR(o order.Order, rateAPR float64) error {
+	if rateAPR == 0 {
+		return nil
+	}
+
+	if o.Details().FixedRate != 0 {
+		return fmt.Errorf("rate_fixed and rate_apr cannot both be set")
+	}
+
+	rateFixed, err := order.APRToPerBlockRate(rateAPR)
+	if err != nil {
+		return fmt.Errorf("invalid rate_apr: %v", err)
+	}
+	o.Details().FixedRate = uint32(rateFixed)
+
+	return nil
+}
+
+// applyDefaultLeaseDuration sets the given default lease duration on a bid that
+// doesn't specify one. The default must be one of the duration buckets the
+// auctioneer currently accepts orders for.
+func applyDefaultLeaseDuration(o order.Order, defaultDuration uint32,
+	auctionTerms *terms.AuctioneerTerms) error {
+
+	bid, ok := o.(*order.Bid)
+	if !ok || bid.LeaseDuration != 0 || defaultDuration == 0 {
+		return nil
+	}
+
+	err := auctionTerms.ValidateLeaseDuration(defaultDuration)
+	if err != nil {
+		return fmt.Errorf("invalid default lease duration: %v", err)
+	}
+
+	bid.LeaseDuration = defaultDuration
+
+	return nil
 }
 
 // ListOrders returns a list of all orders that is currently known to the trader
@@ -1621,6 +3320,10 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 func (s *rpcServer) ListOrders(ctx context.Context,
 	req *poolrpc.ListOrdersRequest) (*poolrpc.ListOrdersResponse, error) {
 
+	if err := validateListOrdersRequest(req); err != nil {
+		return nil, err
+	}
+
 	// Because we made sure every order has a creation timestamp in the
 	// events bucket, we can now access the orders in a sorted way if we
 	// first grab all creation events. This might be a bit less efficient
@@ -1657,9 +3360,9 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 		accountVersions[rawKey] = acct.Version
 	}
 
-	// The RPC is split by order type so we have to separate them now.
-	asks := make([]*poolrpc.Ask, 0, len(creationEvents))
-	bids := make([]*poolrpc.Bid, 0, len(creationEvents))
+	// Collect all orders that match the filters of the request first, so
+	// we can sort and paginate them.
+	listed := make([]*listedOrder, 0, len(creationEvents))
 	for _, evt := range creationEvents {
 		orderCreateEvent, ok := evt.(*clientdb.CreatedEvent)
 		if !ok {
@@ -1671,15 +3374,27 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		nonce := dbOrder.Nonce()
-		dbDetails := dbOrder.Details()
 
-		// Skip order if it is archived and only active orders are
-		// requested.
-		if dbDetails.State.Archived() && req.ActiveOnly {
+		if !includeOrder(req, dbOrder) {
 			continue
 		}
 
+		listed = append(listed, &listedOrder{
+			order:   dbOrder,
+			created: evt.Timestamp(),
+		})
+	}
+	totalNumOrders := len(listed)
+	listed = paginateOrders(req, listed)
+
+	// The RPC is split by order type so we have to separate them now.
+	asks := make([]*poolrpc.Ask, 0, len(listed))
+	bids := make([]*poolrpc.Bid, 0, len(listed))
+	for _, l := range listed {
+		dbOrder := l.order
+		nonce := dbOrder.Nonce()
+		dbDetails := dbOrder.Details()
+
 		orderState, err := DBOrderStateToRPCState(dbDetails.State)
 		if err != nil {
 			return nil, err
@@ -1712,7 +3427,7 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 			ReservedValueSat: uint64(dbOrder.ReservedValue(
 				feeSchedule, accountVersions[dbDetails.AcctKey],
 			)),
-			CreationTimestampNs: uint64(evt.Timestamp().UnixNano()),
+			CreationTimestampNs: uint64(l.created.UnixNano()),
 			Events:              rpcEvents,
 			MinUnitsMatch:       uint32(dbOrder.Details().MinUnitsMatch),
 			ChannelType: marshallChannelType(
@@ -1722,6 +3437,12 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 				dbOrder.Details().AuctionType,
 			),
 			IsPublic: dbOrder.Details().IsPublic,
+			Tag:      dbOrder.Details().Tag,
+			PartialMatchPolicy: poolrpc.PartialMatchPolicy(
+				dbOrder.Details().PartialMatchPolicy,
+			),
+			AutoRecreate:      dbOrder.Details().AutoRecreate,
+			AutoRecreateLimit: dbOrder.Details().AutoRecreateLimit,
 		}
 
 		switch o := dbOrder.(type) {
@@ -1738,6 +3459,7 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 				Version:                 uint32(o.Version),
 				AnnouncementConstraints: announcement,
 				ConfirmationConstraints: confirmations,
+				MinPremiumSat:           uint64(o.MinPremium),
 			}
 			asks = append(asks, rpcAsk)
 
@@ -1755,6 +3477,7 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 				SelfChanBalance:     uint64(o.SelfChanBalance),
 				UnannouncedChannel:  o.UnannouncedChannel,
 				ZeroConfChannel:     o.ZeroConfChannel,
+				MaxPremiumSat:       uint64(o.MaxPremium),
 			}
 
 			// The sidecar ticket was given to the auction server
@@ -1783,11 +3506,120 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 		}
 	}
 	return &poolrpc.ListOrdersResponse{
-		Asks: asks,
-		Bids: bids,
+		Asks:           asks,
+		Bids:           bids,
+		TotalNumOrders: uint32(totalNumOrders),
 	}, nil
 }
 
+// validateListOrdersRequest makes sure all enum values of the given ListOrders
+// request are known.
+func validateListOrdersRequest(req *poolrpc.ListOrdersRequest) error {
+	if _, ok := poolrpc.OrderStateFilter_name[int32(req.StateFilter)]; !ok {
+		return fmt.Errorf("unknown state filter %d", req.StateFilter)
+	}
+
+	if _, ok := poolrpc.OrderTypeFilter_name[int32(req.TypeFilter)]; !ok {
+		return fmt.Errorf("unknown type filter %d", req.TypeFilter)
+	}
+
+	if _, ok := poolrpc.OrderSortBy_name[int32(req.SortBy)]; !ok {
+		return fmt.Errorf("unknown sort order %d", req.SortBy)
+	}
+
+	return nil
+}
+
+// includeOrder returns true if the given order matches all filters of the
+// given ListOrders request.
+func includeOrder(req *poolrpc.ListOrdersRequest, o order.Order) bool {
+	details := o.Details()
+	archived := details.State.Archived()
+
+	switch req.StateFilter {
+	// Skip order if it is archived and only active orders are requested.
+	case poolrpc.OrderStateFilter_ORDER_STATE_FILTER_DEFAULT:
+		if archived && req.ActiveOnly {
+			return false
+		}
+
+	case poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ACTIVE:
+		if archived {
+			return false
+		}
+
+	case poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ARCHIVED:
+		if !archived {
+			return false
+		}
+	}
+
+	switch req.TypeFilter {
+	case poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_ASK:
+		if o.Type() != order.TypeAsk {
+			return false
+		}
+
+	case poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_BID:
+		if o.Type() != order.TypeBid {
+			return false
+		}
+	}
+
+	// Skip the order if a tag was requested that doesn't match.
+	if req.Tag != "" && details.Tag != req.Tag {
+		return false
+	}
+
+	return true
+}
+
+// listedOrder is an order that matched the filters of a ListOrders request,
+// together with the time it was created.
+type listedOrder struct {
+	order   order.Order
+	created time.Time
+}
+
+// paginateOrders sorts the given orders as requested and then returns only the
+// page described by the offset and limit of the given ListOrders request. Asks
+// and bids are sorted and paginated together and only split into separate
+// lists afterwards, which keeps the order within each list.
+func paginateOrders(req *poolrpc.ListOrdersRequest,
+	orders []*listedOrder) []*listedOrder {
+
+	less := func(i, j int) bool {
+		return orders[i].created.Before(orders[j].created)
+	}
+	if req.SortBy == poolrpc.OrderSortBy_ORDER_SORT_BY_RATE {
+		less = func(i, j int) bool {
+			rateI := orders[i].order.Details().FixedRate
+			rateJ := orders[j].order.Details().FixedRate
+			return rateI < rateJ
+		}
+	}
+
+	if req.Reversed {
+		sort.SliceStable(orders, func(i, j int) bool {
+			return less(j, i)
+		})
+	} else {
+		sort.SliceStable(orders, less)
+	}
+
+	offset := int(req.Offset)
+	if offset >= len(orders) {
+		return nil
+	}
+	orders = orders[offset:]
+
+	if req.Limit > 0 && int(req.Limit) < len(orders) {
+		orders = orders[:req.Limit]
+	}
+
+	return orders
+}
+
 // CancelOrder cancels the order on the server and updates the state of the
 // local order accordingly.
 func (s *rpcServer) CancelOrder(ctx context.Context,
@@ -1834,15 +3666,171 @@ func (s *rpcServer) CancelOrder(ctx context.Context,
 		return nil, err
 	}
 
-	// If this order was for a sidecar ticket, we also want to cancel the
-	// ticket itself since it will never be completed anyway.
-	err = s.setTicketStateForOrder(sidecar.StateCanceled, nonce)
+	// If this order was for a sidecar ticket, we also want to cancel the
+	// ticket itself since it will never be completed anyway.
+	err = s.setTicketStateForOrder(sidecar.StateCanceled, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("error updating our sidecar ticket "+
+			"after canceling order: %v", err)
+	}
+
+	return &poolrpc.CancelOrderResponse{}, nil
+}
+
+// cancelActiveOrders cancels all orders that are still active. An order that
+// can't be canceled is logged and skipped, unless the context is done, and the
+// first error is returned once all orders were attempted.
+func (s *rpcServer) cancelActiveOrders(ctx context.Context) error {
+	dbOrders, err := s.server.db.GetOrders()
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, dbOrder := range dbOrders {
+		if dbOrder.Details().State.Archived() {
+			continue
+		}
+
+		nonce := dbOrder.Nonce()
+		_, err := s.CancelOrder(ctx, &poolrpc.CancelOrderRequest{
+			OrderNonce: nonce[:],
+		})
+		if err != nil {
+			rpcLog.Errorf("Unable to cancel order_nonce=%v: %v",
+				nonce, err)
+
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	return firstErr
+}
+
+// ReplaceOrder cancels an active order and submits a replacement for it. The
+// replacement is validated first and only submitted after the old order was
+// canceled, so both orders are never active at the same time.
+func (s *rpcServer) ReplaceOrder(ctx context.Context,
+	req *poolrpc.ReplaceOrderRequest) (*poolrpc.ReplaceOrderResponse,
+	error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot replace order, trader daemon " +
+			"still starting up")
+	}
+
+	// A replacement is just another way of submitting an order, so it
+	// can't be used to bypass the confirmation either.
+	if s.server.cfg.RequireOrderConfirmation {
+		return nil, fmt.Errorf("order confirmation required, use " +
+			"CancelOrder, PrepareOrder and ConfirmOrder to replace " +
+			"orders")
+	}
+
+	if req.Replacement == nil {
+		return nil, fmt.Errorf("replacement order missing")
+	}
+
+	var nonce order.Nonce
+	copy(nonce[:], req.OrderNonce)
+	oldOrder, err := s.server.db.GetOrder(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replacement doesn't add to the number of pending orders, so we
+	// don't check the limit here. The replaced order is canceled before
+	// the replacement is submitted, so it doesn't count against the
+	// account policy. We check the policy before canceling anything, the
+	// definitive check is done when the replacement is added to the local
+	// database.
+	newOrder, auctionTerms, acct, err := s.parseAndValidateOrder(
+		ctx, req.Replacement,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOrderPolicy(newOrder, nonce); err != nil {
+		return nil, err
+	}
+
+	cancelOrder := func(ctx context.Context, o order.Order) error {
+		nonce := o.Nonce()
+		_, err := s.CancelOrder(ctx, &poolrpc.CancelOrderRequest{
+			OrderNonce: nonce[:],
+		})
+		return err
+	}
+	submitOrder := func(ctx context.Context,
+		o order.Order) (*poolrpc.SubmitOrderResponse, error) {
+
+		return s.submitValidatedOrder(
+			ContextWithInitiator(ctx, req.Replacement.Initiator),
+			o, auctionTerms, acct, s.prepareReplacementOrder(nonce),
+		)
+	}
+	resp, err := replaceOrder(
+		ctx, oldOrder, newOrder, cancelOrder, submitOrder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.ReplaceOrderResponse{
+		CanceledOrderNonce: nonce[:],
+		Replacement:        resp,
+	}, nil
+}
+
+// replaceOrder makes sure the new order can replace the old one, then cancels
+// the old order and only submits the new one if the cancellation succeeded. If
+// the submission fails, the old order stays canceled.
+func replaceOrder(ctx context.Context, oldOrder, newOrder order.Order,
+	cancelOrder func(context.Context, order.Order) error,
+	submitOrder func(context.Context,
+		order.Order) (*poolrpc.SubmitOrderResponse, error)) (
+	*poolrpc.SubmitOrderResponse, error) {
+
+	oldDetails, newDetails := oldOrder.Details(), newOrder.Details()
+	if oldDetails.State.Archived() {
+		return nil, fmt.Errorf("unable to replace order in terminal "+
+			"state %v", oldDetails.State)
+	}
+	if oldOrder.Type() != newOrder.Type() {
+		return nil, fmt.Errorf("cannot replace %v order with %v order",
+			oldOrder.Type(), newOrder.Type())
+	}
+	if oldDetails.AcctKey != newDetails.AcctKey {
+		return nil, fmt.Errorf("replacement must use the same account " +
+			"as the replaced order")
+	}
+	if oldOrder.Nonce() == newOrder.Nonce() {
+		return nil, fmt.Errorf("replacement must not reuse the nonce " +
+			"of the replaced order")
+	}
+
+	if err := cancelOrder(ctx, oldOrder); err != nil {
+		return nil, fmt.Errorf("unable to cancel order %v, replacement "+
+			"not submitted: %v", oldOrder.Nonce(), err)
+	}
+
+	resp, err := submitOrder(ctx, newOrder)
 	if err != nil {
-		return nil, fmt.Errorf("error updating our sidecar ticket "+
-			"after canceling order: %v", err)
+		return nil, fmt.Errorf("order %v was canceled but its "+
+			"replacement could not be submitted: %v",
+			oldOrder.Nonce(), err)
 	}
 
-	return &poolrpc.CancelOrderResponse{}, nil
+	rpcLog.Infof("Replaced order %v", oldOrder.Nonce())
+
+	return resp, nil
 }
 
 // QuoteOrder calculates the premium, execution fees and max batch fee rate for
@@ -1850,7 +3838,8 @@ func (s *rpcServer) CancelOrder(ctx context.Context,
 func (s *rpcServer) QuoteOrder(ctx context.Context,
 	req *poolrpc.QuoteOrderRequest) (*poolrpc.QuoteOrderResponse, error) {
 
-	auctionTerms, err := s.auctioneer.Terms(ctx)
+	// A quote is only an estimate, so slightly stale market data is fine.
+	auctionTerms, err := s.marketData.Terms(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to query auctioneer terms: %v",
 			err)
@@ -1878,14 +3867,74 @@ func (s *rpcServer) QuoteOrder(ctx context.Context,
 	}, nil
 }
 
+// confirmBatch returns true if the batch can be accepted. Batches that are
+// outside of the configured premium and fee limits are only accepted if the
+// user confirms them with the AcceptBatch call in time.
+func (s *rpcServer) confirmBatch(batch *order.Batch) bool {
+	cfg := s.server.cfg
+	if cfg.BatchConfirmMinPremium == 0 && cfg.BatchConfirmMaxFee == 0 {
+		return true
+	}
+
+	economics, err := computeBatchEconomics(batch, s.server.db.GetOrder)
+	if err != nil {
+		rpcLog.Errorf("Unable to calculate batch economics: %v", err)
+		return false
+	}
+
+	reason := economics.confirmationReason(
+		cfg.BatchConfirmMinPremium, cfg.BatchConfirmMaxFee,
+	)
+	if reason == "" {
+		return true
+	}
+
+	rpcLog.Warnf("Batch %x requires confirmation: %v, waiting %v for it "+
+		"to be accepted", batch.ID[:], reason, cfg.BatchConfirmTimeout)
+
+	accepted := s.awaitBatchConfirmation(batch.ID, economics, reason)
+	if !accepted {
+		rpcLog.Infof("Batch %x was not confirmed, rejecting it",
+			batch.ID[:])
+	}
+
+	return accepted
+}
+
+// awaitBatchConfirmation waits for the user to accept or reject the given batch
+// step. Waiting can take up to the configured timeout, so the batch step gives
+// up its slot in the batch limiter in the meantime to not hold up the steps of
+// other batches.
+func (s *rpcServer) awaitBatchConfirmation(batchID order.BatchID,
+	economics *batchEconomics, reason string) bool {
+
+	s.server.batchLimiter.release()
+
+	accepted := s.batchConfirmations.await(
+		batchID, economics, reason, s.server.cfg.BatchConfirmTimeout,
+		s.quit,
+	)
+
+	// We need the slot back before we continue with the batch. Every
+	// other step gives up its slot once it's done, so this can't block
+	// forever, even if we're shutting down.
+	s.server.batchLimiter.acquire(nil)
+
+	return accepted
+}
+
 // sendRejectBatch sends a reject message to the server with the properly
 // decoded reason code and the full reason message as a string.
 func (s *rpcServer) sendRejectBatch(batch *order.Batch, failure error) error {
+	// The batch won't be executed, so there's no need to gate external
+	// channels anymore.
+	s.server.channelAcceptor.BatchExecutionEnded()
+
 	// As we're rejecting this batch, we'll now cancel all funding shims
 	// that we may have registered since we may be matched with a distinct
 	// set of channels if this batch is repeated.
 	err := funding.CancelPendingFundingShims(
-		batch.MatchedOrders, s.lndClient,
+		context.Background(), batch.MatchedOrders, s.lndClient,
 		func(o order.Nonce) (order.Order, error) {
 			return s.server.db.GetOrder(o)
 		},
@@ -1999,15 +4048,62 @@ func (s *rpcServer) GetLsatTokens(_ context.Context,
 
 	log.Infof("Get LSAT tokens request received")
 
-	tokens, err := s.server.lsatStore.AllTokens()
+	rpcTokens, err := marshallLsatTokens(s.server.lsatStore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.TokensResponse{Tokens: rpcTokens}, nil
+}
+
+// DeleteLsatToken removes the token with the given ID from the LSAT token
+// store.
+func (s *rpcServer) DeleteLsatToken(_ context.Context,
+	req *poolrpc.DeleteLsatTokenRequest) (*poolrpc.DeleteLsatTokenResponse,
+	error) {
+
+	var tokenID lsat.TokenID
+	if len(req.TokenId) != len(tokenID) {
+		return nil, fmt.Errorf("invalid token ID length, expected %d "+
+			"bytes", len(tokenID))
+	}
+	copy(tokenID[:], req.TokenId)
+
+	rpcLog.Infof("Deleting LSAT token %x", tokenID[:])
+
+	if err := s.server.lsatStore.DeleteToken(tokenID); err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.DeleteLsatTokenResponse{}, nil
+}
+
+// marshallLsatTokens translates all tokens of the given LSAT store into their
+// RPC representation, marking the store's current token.
+func marshallLsatTokens(store lsat.Store) ([]*poolrpc.LsatToken, error) {
+	tokens, err := store.AllTokens()
 	if err != nil {
 		return nil, err
 	}
 
+	// There might not be a current token if the last one was rejected by
+	// the auction server and no new one was paid for yet.
+	currentToken, err := store.CurrentToken()
+	if err != nil && err != lsat.ErrNoToken {
+		return nil, err
+	}
+
 	rpcTokens := make([]*poolrpc.LsatToken, len(tokens))
 	idx := 0
 	for key, token := range tokens {
-		macBytes, err := token.BaseMacaroon().MarshalBinary()
+		baseMac := token.BaseMacaroon()
+		macBytes, err := baseMac.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		macID, err := lsat.DecodeIdentifier(
+			bytes.NewReader(baseMac.Id()),
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -2020,11 +4116,14 @@ func (s *rpcServer) GetLsatTokens(_ context.Context,
 			TimeCreated:        token.TimeCreated.Unix(),
 			Expired:            !token.IsValid(),
 			StorageName:        key,
+			TokenId:            macID.TokenID[:],
+			Current: currentToken != nil &&
+				token.PaymentHash == currentToken.PaymentHash,
 		}
 		idx++
 	}
 
-	return &poolrpc.TokensResponse{Tokens: rpcTokens}, nil
+	return rpcTokens, nil
 }
 
 // sendSignBatch sends a sign message to the server with the witness stacks of
@@ -2102,6 +4201,10 @@ func (s *rpcServer) AuctionFee(ctx context.Context,
 func (s *rpcServer) Leases(ctx context.Context,
 	req *poolrpc.LeasesRequest) (*poolrpc.LeasesResponse, error) {
 
+	if err := validateLeasesRequest(req); err != nil {
+		return nil, err
+	}
+
 	// We'll start by parsing the list of accounts we should retrieve lease
 	// for. If none are specified, leases for all accounts will be returned.
 	accounts := make(map[[33]byte]struct{}, len(req.Accounts))
@@ -2111,76 +4214,410 @@ func (s *rpcServer) Leases(ctx context.Context,
 			return nil, fmt.Errorf("invalid account key: %v", err)
 		}
 
-		var accountKey [33]byte
-		copy(accountKey[:], rawAccountKey)
-		accounts[accountKey] = struct{}{}
+		var accountKey [33]byte
+		copy(accountKey[:], rawAccountKey)
+		accounts[accountKey] = struct{}{}
+	}
+
+	// We'll then retrieve the list of specified batches. If none are
+	// specified, we'll retrieve all of them.
+	var batches []*clientdb.LocalBatchSnapshot
+	if len(req.BatchIds) == 0 {
+		var err error
+		batches, err = s.server.db.GetLocalBatchSnapshots()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, rawBatchID := range req.BatchIds {
+			batchKey, err := btcec.ParsePubKey(rawBatchID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid batch id: %v",
+					err)
+			}
+
+			batchID := order.NewBatchID(batchKey)
+			batch, err := s.server.db.GetLocalBatchSnapshot(batchID)
+			if err != nil {
+				return nil, err
+			}
+			batches = append(batches, batch)
+		}
+	}
+
+	leasedChans, err := s.fetchLeasedChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.prepareLeasesResponse(
+		ctx, req, accounts, batches, leasedChans,
+		atomic.LoadUint32(&s.bestHeight),
+	)
+}
+
+// fetchLeasedChannels returns the state of all open and pending channels known
+// to lnd, mapped by the string representation of their channel point. Any
+// leased channel that isn't in the map is considered closed.
+func (s *rpcServer) fetchLeasedChannels(
+	ctx context.Context) (map[string]*leasedChannel, error) {
+
+	// As the true lease expiry of a channel is only known after tit has
+	// been confirmed, we'll assemble a map from the outpoint of a channel
+	// to the thaw_height which is actually the lease expiry height.
+	openChans, err := s.lndClient.ListChannels(
+		ctx, &lnrpc.ListChannelsRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch set of open "+
+			"channels: %v", err)
+	}
+
+	// We'll map the outpoint string representation of a channel to the
+	// actual lease expiry height which we'll use to partially populate our
+	// response. Any channel that isn't in the map is considered closed.
+	leasedChans := make(map[string]*leasedChannel)
+	for _, channel := range openChans.Channels {
+		switch height := channel.ThawHeight; {
+		case height == 0:
+			leasedChans[channel.ChannelPoint] = &leasedChannel{}
+
+		case height < 500_000:
+			// If the value is lower than 500,000, then it's
+			// interpreted as a relative height and we need to add
+			// an offset from the confirmation height of the
+			// channel.
+			sid := lnwire.NewShortChanIDFromInt(channel.ChanId)
+			leasedChans[channel.ChannelPoint] = &leasedChannel{
+				leaseExpiry: height + sid.BlockHeight,
+			}
+
+		default:
+			// If the value is greater than 500,000, it is
+			// interpreted as an absolute value.
+			leasedChans[channel.ChannelPoint] = &leasedChannel{
+				leaseExpiry: height,
+			}
+		}
+	}
+
+	// Channels of batches that aren't confirmed yet are still pending.
+	pendingChans, err := s.lndClient.PendingChannels(
+		ctx, &lnrpc.PendingChannelsRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch set of pending "+
+			"channels: %v", err)
+	}
+	for _, pendingChan := range pendingChans.PendingOpenChannels {
+		if pendingChan.Channel == nil {
+			continue
+		}
+
+		leasedChans[pendingChan.Channel.ChannelPoint] = &leasedChannel{
+			pending: true,
+		}
+	}
+
+	return leasedChans, nil
+}
+
+// activeLeaseBatches returns the IDs of all batches that created a channel
+// whose lease is still pending or active.
+func (s *rpcServer) activeLeaseBatches() (map[order.BatchID]struct{}, error) {
+	ctx := context.Background()
+	leasedChans, err := s.fetchLeasedChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batches, err := s.server.db.GetLocalBatchSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	return batchesWithActiveLeases(
+		batches, leasedChans, atomic.LoadUint32(&s.bestHeight),
+	), nil
+}
+
+// batchesWithActiveLeases returns the IDs of the given batches that created
+// one of the given channels whose lease is still pending or active at the
+// given height.
+func batchesWithActiveLeases(batches []*clientdb.LocalBatchSnapshot,
+	leasedChans map[string]*leasedChannel,
+	bestHeight uint32) map[order.BatchID]struct{} {
+
+	// The channel points are formatted as <txid>:<output index>, so we
+	// only need the part before the colon to match them to a batch.
+	activeTxids := make(map[string]struct{})
+	for chanPointStr, channel := range leasedChans {
+		status, _ := leaseStatus(channel, bestHeight)
+		if status == poolrpc.LeaseStatus_LEASE_STATUS_MATURED {
+			continue
+		}
+
+		txid := strings.SplitN(chanPointStr, ":", 2)[0]
+		activeTxids[txid] = struct{}{}
+	}
+
+	active := make(map[order.BatchID]struct{})
+	for _, batch := range batches {
+		txid := batch.BatchTX.TxHash().String()
+		if _, ok := activeTxids[txid]; ok {
+			active[batch.BatchID] = struct{}{}
+		}
+	}
+
+	return active
+}
+
+// LeasePerformanceReport returns the premiums earned and paid, the fees paid
+// and the effective annual percentage rate of all leases that were executed
+// within the given time range.
+func (s *rpcServer) LeasePerformanceReport(ctx context.Context,
+	req *poolrpc.LeasePerformanceReportRequest) (
+	*poolrpc.LeasePerformanceReportResponse, error) {
+
+	if _, ok := poolrpc.LeaseRoleFilter_name[int32(req.RoleFilter)]; !ok {
+		return nil, fmt.Errorf("unknown role filter %d", req.RoleFilter)
+	}
+
+	start := time.Unix(0, int64(req.StartTimestampNs))
+	end := time.Unix(0, math.MaxInt64)
+	if req.EndTimestampNs > 0 {
+		end = time.Unix(0, int64(req.EndTimestampNs))
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end timestamp must not be before " +
+			"start timestamp")
+	}
+
+	// A lease is executed once the batch that created it is finalized, so
+	// we use the timestamps of the finalized match events to find the
+	// leases of the requested time range.
+	events, err := s.server.db.GetEventsInRange(
+		start, end, event.TypeOrderMatch,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying match events: %v", err)
+	}
+
+	executed := make(map[executedMatch]struct{})
+	for _, evt := range events {
+		matchEvt, ok := evt.(*clientdb.MatchEvent)
+		if !ok || matchEvt.MatchState != order.MatchStateFinalized {
+			continue
+		}
+
+		executed[executedMatch{
+			nonce:        matchEvt.Nonce(),
+			matchedNonce: matchEvt.MatchedOrder,
+		}] = struct{}{}
+	}
+
+	// Without any executed matches there's nothing to report, so there's
+	// no need to assemble the leases at all.
+	if len(executed) == 0 {
+		return &poolrpc.LeasePerformanceReportResponse{}, nil
+	}
+
+	leasesResp, err := s.Leases(ctx, &poolrpc.LeasesRequest{
+		RoleFilter: req.RoleFilter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	leases := make([]*poolrpc.Lease, 0, len(leasesResp.Leases))
+	for _, lease := range leasesResp.Leases {
+		var match executedMatch
+		copy(match.nonce[:], lease.OrderNonce)
+		copy(match.matchedNonce[:], lease.MatchedOrderNonce)
+
+		if _, ok := executed[match]; ok {
+			leases = append(leases, lease)
+		}
+	}
+
+	return leasePerformance(leases), nil
+}
+
+// ListPendingBatchChannels returns the channels the trader expects to be
+// opened by the last batch it participated in, together with their
+// confirmation progress as reported by lnd.
+func (s *rpcServer) ListPendingBatchChannels(ctx context.Context,
+	_ *poolrpc.ListPendingBatchChannelsRequest) (
+	*poolrpc.ListPendingBatchChannelsResponse, error) {
+
+	batches, err := s.server.db.GetLocalBatchSnapshots()
+	if err != nil {
+		return nil, fmt.Errorf("error loading batches: %v", err)
+	}
+
+	// Without any batch there are no channels to wait for.
+	if len(batches) == 0 {
+		return &poolrpc.ListPendingBatchChannelsResponse{}, nil
+	}
+
+	// The snapshots are ordered by their sequence number, so the last one
+	// is the most recent batch.
+	lastBatch := batches[len(batches)-1]
+	expected, err := s.expectedBatchChannels(ctx, lastBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingChans, err := s.lndClient.PendingChannels(
+		ctx, &lnrpc.PendingChannelsRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch set of pending "+
+			"channels: %v", err)
+	}
+	openChans, err := s.lndClient.ListChannels(
+		ctx, &lnrpc.ListChannelsRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch set of open "+
+			"channels: %v", err)
+	}
+
+	return &poolrpc.ListPendingBatchChannelsResponse{
+		BatchId:   lastBatch.BatchID[:],
+		BatchTxid: lastBatch.BatchTX.TxHash().String(),
+		Channels: batchChannelStates(
+			expected, pendingChans, openChans,
+			atomic.LoadUint32(&s.bestHeight),
+		),
+	}, nil
+}
+
+// executedMatch identifies the match of one of our orders with another order
+// in a finalized batch.
+type executedMatch struct {
+	nonce        order.Nonce
+	matchedNonce order.Nonce
+}
+
+// leasePerformance tallies up the premiums and fees of the given leases and
+// computes their effective annual percentage rate. The rate is the net premium
+// divided by the leased channel amounts, each weighted by the share of a year
+// its lease lasts.
+func leasePerformance(
+	leases []*poolrpc.Lease) *poolrpc.LeasePerformanceReportResponse {
+
+	report := &poolrpc.LeasePerformanceReportResponse{
+		NumLeases: uint32(len(leases)),
+	}
+
+	var leasedAmtYears float64
+	for _, lease := range leases {
+		report.TotalChannelAmtSat += lease.ChannelAmtSat
+		if lease.Purchased {
+			report.PremiumPaidSat += lease.PremiumSat
+		} else {
+			report.PremiumEarnedSat += lease.PremiumSat
+		}
+		report.ExecutionFeeSat += lease.ExecutionFeeSat
+		report.ChainFeeSat += lease.ChainFeeSat
+
+		leasedAmtYears += float64(lease.ChannelAmtSat) *
+			float64(lease.ChannelDurationBlocks) / blocksPerYear
+	}
+
+	report.NetPremiumSat = int64(report.PremiumEarnedSat) -
+		int64(report.PremiumPaidSat) - int64(report.ExecutionFeeSat) -
+		int64(report.ChainFeeSat)
+
+	// Without any leased amount or duration there is no rate to report.
+	if leasedAmtYears > 0 {
+		report.EffectiveApr = float64(report.NetPremiumSat) /
+			leasedAmtYears
+	}
+
+	return report
+}
+
+// validateLeasesRequest makes sure all enum values of the given Leases request
+// are known.
+func validateLeasesRequest(req *poolrpc.LeasesRequest) error {
+	if _, ok := poolrpc.LeaseRoleFilter_name[int32(req.RoleFilter)]; !ok {
+		return fmt.Errorf("unknown role filter %d", req.RoleFilter)
+	}
+
+	for _, status := range req.Statuses {
+		if _, ok := poolrpc.LeaseStatus_name[int32(status)]; !ok {
+			return fmt.Errorf("unknown lease status %d", status)
+		}
+	}
+
+	return nil
+}
+
+// leasedChannel is the state of a leased channel as known to lnd.
+type leasedChannel struct {
+	// leaseExpiry is the absolute height at which the lease expires. This
+	// is zero if the expiry isn't known.
+	leaseExpiry uint32
+
+	// pending is true if the channel isn't confirmed yet.
+	pending bool
+}
+
+// leaseStatus determines the status of a lease and the number of blocks until
+// it expires from the state of its channel and the current block height. A nil
+// channel means lnd doesn't know the channel anymore, so it must be closed.
+func leaseStatus(channel *leasedChannel,
+	bestHeight uint32) (poolrpc.LeaseStatus, uint32) {
+
+	switch {
+	case channel == nil:
+		return poolrpc.LeaseStatus_LEASE_STATUS_CLOSED, 0
+
+	case channel.pending:
+		return poolrpc.LeaseStatus_LEASE_STATUS_PENDING, 0
+
+	// Without a known expiry we can't tell how long the lease still lasts,
+	// but the channel is still open.
+	case channel.leaseExpiry == 0:
+		return poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE, 0
+
+	case bestHeight >= channel.leaseExpiry:
+		return poolrpc.LeaseStatus_LEASE_STATUS_MATURED, 0
+
+	default:
+		return poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+			channel.leaseExpiry - bestHeight
 	}
+}
 
-	// We'll then retrieve the list of specified batches. If none are
-	// specified, we'll retrieve all of them.
-	var batches []*clientdb.LocalBatchSnapshot
-	if len(req.BatchIds) == 0 {
-		var err error
-		batches, err = s.server.db.GetLocalBatchSnapshots()
-		if err != nil {
-			return nil, err
+// includeLease returns true if the given lease matches the role and status
+// filters of the given Leases request.
+func includeLease(req *poolrpc.LeasesRequest, lease *poolrpc.Lease) bool {
+	switch req.RoleFilter {
+	case poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_TAKER:
+		if !lease.Purchased {
+			return false
 		}
-	} else {
-		for _, rawBatchID := range req.BatchIds {
-			batchKey, err := btcec.ParsePubKey(rawBatchID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid batch id: %v",
-					err)
-			}
 
-			batchID := order.NewBatchID(batchKey)
-			batch, err := s.server.db.GetLocalBatchSnapshot(batchID)
-			if err != nil {
-				return nil, err
-			}
-			batches = append(batches, batch)
+	case poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER:
+		if lease.Purchased {
+			return false
 		}
 	}
 
-	// As the true lease expiry of a channel is only known after tit has
-	// been confirmed, we'll assemble a map from the outpoint of a channel
-	// to the thaw_height which is actually the lease expiry height.
-	openChans, err := s.lndClient.ListChannels(
-		context.Background(), &lnrpc.ListChannelsRequest{},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch set of open "+
-			"channels: %v", err)
+	if len(req.Statuses) == 0 {
+		return true
 	}
 
-	// We'll map the outpoint string representation of a channel to the
-	// actual lease expiry height which we'll use to partially populate our
-	// response.
-	chanLeaseExpiries := make(map[string]uint32)
-	for _, channel := range openChans.Channels {
-		switch height := channel.ThawHeight; {
-		case height == 0:
-			continue
-
-		case height < 500_000:
-			// If the value is lower than 500,000, then it's
-			// interpreted as a relative height and we need to add
-			// an offset from the confirmation height of the
-			// channel.
-			sid := lnwire.NewShortChanIDFromInt(channel.ChanId)
-			expiryHeight := height + sid.BlockHeight
-			chanLeaseExpiries[channel.ChannelPoint] = expiryHeight
-
-		default:
-			// If the value is greater than 500,000, it is
-			// interpreted as an absolute value.
-			chanLeaseExpiries[channel.ChannelPoint] = height
+	for _, status := range req.Statuses {
+		if lease.Status == status {
+			return true
 		}
 	}
 
-	return s.prepareLeasesResponse(
-		ctx, accounts, batches, chanLeaseExpiries,
-	)
+	return false
 }
 
 // fetchNodeRatings returns an up to date set of ratings for each of the nodes
@@ -2233,8 +4670,10 @@ func fetchNodeRatings(ctx context.Context, batches []*clientdb.LocalBatchSnapsho
 // prepareLeasesResponse prepares a poolrpc.LeasesResponse for the given
 // accounts in the given batches.
 func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
-	accounts map[[33]byte]struct{}, batches []*clientdb.LocalBatchSnapshot,
-	chanLeaseExpiries map[string]uint32) (*poolrpc.LeasesResponse, error) {
+	req *poolrpc.LeasesRequest, accounts map[[33]byte]struct{},
+	batches []*clientdb.LocalBatchSnapshot,
+	leasedChans map[string]*leasedChannel,
+	bestHeight uint32) (*poolrpc.LeasesResponse, error) {
 
 	// First, we'll try to grab our set of node ratings as we want them to
 	// fully populate the lease response.
@@ -2244,11 +4683,7 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 	}
 
 	// Now we're ready to prepare our response.
-	var (
-		rpcLeases      []*poolrpc.Lease
-		totalAmtEarned btcutil.Amount
-		totalAmtPaid   btcutil.Amount
-	)
+	var rpcLeases []*poolrpc.Lease
 	for _, batch := range batches {
 		// Keep a tally of how many channels were created within each
 		// batch in order to estimate the chain fees paid.
@@ -2275,50 +4710,25 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					continue
 				}
 
-				// Derive the channel output script, which we'll
-				// use to locate the channel outpoint within the
-				// batch transaction.
-				ourMultiSigKey, err := s.server.lndServices.WalletKit.DeriveKey(
-					ctx, &ourOrder.Details().MultiSigKeyLocator,
+				chanPoint, ok, err := s.locateBatchChannel(
+					ctx, batch.BatchTX, ourOrder, match,
 				)
 				if err != nil {
 					return nil, err
 				}
-				ourMultiSigPubKey := ourMultiSigKey.PubKey
+				if !ok {
+					continue
+				}
 
-				// For sidecar orders the multisig key used
-				// isn't our own.
-				isSidecarChannel := false
+				// Sidecar channels are opened to the recipient
+				// of the ticket instead of our own node.
 				ourOrderBid, ourOrderIsBid := ourOrder.(*order.Bid)
-				if ourOrderIsBid && ourOrderBid.SidecarTicket != nil {
-					t := ourOrderBid.SidecarTicket
-					if t.Recipient == nil {
-						continue
-					}
-
-					isSidecarChannel = true
-					ourMultiSigPubKey = t.Recipient.MultiSigPubKey
-				}
+				isSidecarChannel := ourOrderIsBid &&
+					ourOrderBid.SidecarTicket != nil
 
 				chanAmt := match.UnitsFilled.ToSatoshis()
-				_, chanOutput, err := input.GenFundingPkScript(
-					ourMultiSigPubKey.SerializeCompressed(),
-					match.MultiSigKey[:], int64(chanAmt),
-				)
-				if err != nil {
-					return nil, err
-				}
-
-				batchTxHash := batch.BatchTX.TxHash()
-				chanOutputIdx, ok := poolscript.LocateOutputScript(
-					batch.BatchTX, chanOutput.PkScript,
-				)
-				if !ok {
-					return nil, fmt.Errorf("channel with "+
-						"script %x not found in batch "+
-						"transaction %v",
-						chanOutput.PkScript, batchTxHash)
-				}
+				batchTxHash := chanPoint.Hash
+				chanOutputIdx := chanPoint.Index
 
 				// The duration of the channel is always that
 				// specified by the bid order.
@@ -2365,17 +4775,17 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 				exeFee := batch.ExecutionFee.BaseFee() +
 					batch.ExecutionFee.ExecutionFee(chanAmt)
 
-				if ourOrder.Type() == order.TypeBid {
-					totalAmtPaid += premium
-				} else {
-					totalAmtEarned += premium
-				}
-				totalAmtPaid += exeFee
-
 				chanPointStr := fmt.Sprintf(
 					"%v:%v", batchTxHash, chanOutputIdx,
 				)
-				leaseExpiryHeight := chanLeaseExpiries[chanPointStr]
+				leasedChan := leasedChans[chanPointStr]
+				var leaseExpiryHeight uint32
+				if leasedChan != nil {
+					leaseExpiryHeight = leasedChan.leaseExpiry
+				}
+				status, remainingBlocks := leaseStatus(
+					leasedChan, bestHeight,
+				)
 
 				purchased := ourOrder.Type() == order.TypeBid
 				nodeTier := nodeRatings[match.NodeKey]
@@ -2394,14 +4804,16 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					OrderFixedRate: uint64(
 						ourOrder.Details().FixedRate,
 					),
-					ExecutionFeeSat:      uint64(exeFee),
-					OrderNonce:           nonce[:],
-					MatchedOrderNonce:    matchedNonce[:],
-					Purchased:            purchased,
-					ChannelRemoteNodeKey: match.NodeKey[:],
-					ChannelNodeTier:      nodeTier,
-					SelfChanBalance:      selfChanBalance,
-					SidecarChannel:       isSidecarChannel,
+					ExecutionFeeSat:         uint64(exeFee),
+					OrderNonce:              nonce[:],
+					MatchedOrderNonce:       matchedNonce[:],
+					Purchased:               purchased,
+					ChannelRemoteNodeKey:    match.NodeKey[:],
+					ChannelNodeTier:         nodeTier,
+					SelfChanBalance:         selfChanBalance,
+					SidecarChannel:          isSidecarChannel,
+					Status:                  status,
+					RemainingDurationBlocks: remainingBlocks,
 				})
 
 				numChans++
@@ -2426,22 +4838,53 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 			// apply the remainder to the first lease in the batch.
 			chainFeePerChan := chainFee / btcutil.Amount(numChans)
 			chainFeePerChanRem := chainFee % btcutil.Amount(numChans)
+
+			// The fee rebate the auctioneer granted us for this
+			// batch is distributed the same way.
+			rebatePerChan := batch.FeeRebate / btcutil.Amount(numChans)
+			rebatePerChanRem := batch.FeeRebate % btcutil.Amount(numChans)
 			for i := firstIdxForBatch; i < len(rpcLeases); i++ {
 				chainFee := chainFeePerChan
+				rebate := rebatePerChan
 				if i == firstIdxForBatch {
 					chainFee += chainFeePerChanRem
+					rebate += rebatePerChanRem
 				}
 				rpcLeases[i].ChainFeeSat = uint64(chainFee)
+				rpcLeases[i].FeeRebateSat = uint64(rebate)
 			}
-			totalAmtPaid += chainFee
 		}
 	}
 
-	return &poolrpc.LeasesResponse{
-		Leases:            rpcLeases,
-		TotalAmtEarnedSat: uint64(totalAmtEarned),
-		TotalAmtPaidSat:   uint64(totalAmtPaid),
-	}, nil
+	// The chain fees are distributed among all leases of a batch, so we
+	// can only apply the role and status filters now.
+	return filterLeases(req, rpcLeases), nil
+}
+
+// filterLeases returns a Leases response with only the leases that match the
+// role and status filters of the given request and tallies up what was earned
+// and paid for them.
+func filterLeases(req *poolrpc.LeasesRequest,
+	leases []*poolrpc.Lease) *poolrpc.LeasesResponse {
+
+	resp := &poolrpc.LeasesResponse{}
+	for _, lease := range leases {
+		if !includeLease(req, lease) {
+			continue
+		}
+
+		if lease.Purchased {
+			resp.TotalAmtPaidSat += lease.PremiumSat
+		} else {
+			resp.TotalAmtEarnedSat += lease.PremiumSat
+		}
+		resp.TotalAmtPaidSat += lease.ExecutionFeeSat + lease.ChainFeeSat
+		resp.TotalFeeRebateSat += lease.FeeRebateSat
+
+		resp.Leases = append(resp.Leases, lease)
+	}
+
+	return resp
 }
 
 // BatchSnapshot returns information about a target batch including the
@@ -2489,11 +4932,8 @@ func (s *rpcServer) LeaseDurations(ctx context.Context,
 	legacyDurations := make(
 		map[uint32]bool, len(auctionTerms.LeaseDurationBuckets),
 	)
-	for duration, market := range auctionTerms.LeaseDurationBuckets {
-		const accept = auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS
-		const open = auctioneerrpc.DurationBucketState_MARKET_OPEN
-
-		legacyDurations[duration] = market == accept || market == open
+	for duration := range auctionTerms.LeaseDurationBuckets {
+		legacyDurations[duration] = auctionTerms.AcceptsOrders(duration)
 	}
 
 	return &poolrpc.LeaseDurationResponse{
@@ -2521,6 +4961,78 @@ func (s *rpcServer) NextBatchInfo(ctx context.Context,
 	}, nil
 }
 
+// GetServerParams returns the order parameters the auction server currently
+// enforces. The server's terms are cached for the configured market data cache
+// TTL, so clients can poll this without querying the server each time.
+func (s *rpcServer) GetServerParams(ctx context.Context,
+	_ *poolrpc.ServerParamsRequest) (*poolrpc.ServerParamsResponse, error) {
+
+	auctionTerms, err := s.marketData.Terms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query auctioneer terms: %v",
+			err)
+	}
+
+	return marshallServerParams(auctionTerms, s.server.cfg.OrderRateTick), nil
+}
+
+// ListBatchConfirmations returns all batches that are waiting to be accepted
+// or rejected with the AcceptBatch call before they are accepted.
+func (s *rpcServer) ListBatchConfirmations(_ context.Context,
+	_ *poolrpc.ListBatchConfirmationsRequest) (
+	*poolrpc.ListBatchConfirmationsResponse, error) {
+
+	return &poolrpc.ListBatchConfirmationsResponse{
+		Batches: s.batchConfirmations.list(),
+	}, nil
+}
+
+// SubscribeBatchConfirmations streams all batches that are waiting to be
+// accepted or rejected with the AcceptBatch call, starting with the ones that
+// are already waiting.
+func (s *rpcServer) SubscribeBatchConfirmations(
+	_ *poolrpc.SubscribeBatchConfirmationsRequest,
+	stream poolrpc.Trader_SubscribeBatchConfirmationsServer) error {
+
+	batches, cancel := s.batchConfirmations.subscribe()
+	defer cancel()
+
+	for {
+		select {
+		case batch := <-batches:
+			if err := stream.Send(batch); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// AcceptBatch accepts or rejects a batch that is outside of the configured
+// limits for accepting batches automatically.
+func (s *rpcServer) AcceptBatch(_ context.Context,
+	req *poolrpc.AcceptBatchRequest) (*poolrpc.AcceptBatchResponse, error) {
+
+	batchKey, err := btcec.ParsePubKey(req.BatchId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch id: %v", err)
+	}
+
+	err = s.batchConfirmations.decide(
+		order.NewBatchID(batchKey), !req.Reject,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.AcceptBatchResponse{}, nil
+}
+
 // NodeRatings returns rating information about the target node. This can be
 // used to query the rating of your own node, or other nodes to determine which
 // asks/bids might be filled based on a target min node tier.
@@ -2550,10 +5062,12 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 	_ *poolrpc.GetInfoRequest) (*poolrpc.GetInfoResponse, error) {
 
 	info := &poolrpc.GetInfoResponse{
-		Version:                Version(),
-		CurrentBlockHeight:     atomic.LoadUint32(&s.bestHeight),
-		SubscribedToAuctioneer: s.auctioneer.IsSubscribed(),
-		NewNodesOnly:           s.server.cfg.NewNodesOnly,
+		Version:                    Version(),
+		CurrentBlockHeight:         atomic.LoadUint32(&s.bestHeight),
+		SubscribedToAuctioneer:     s.auctioneer.IsSubscribed(),
+		NewNodesOnly:               s.server.cfg.NewNodesOnly,
+		ClientAlias:                s.server.cfg.ClientAlias,
+		DefaultLeaseDurationBlocks: s.server.cfg.DefaultLeaseDuration,
 	}
 
 	// Query our own node's rating. We want the GetInfo call to be available
@@ -2653,6 +5167,221 @@ func (s *rpcServer) StopDaemon(_ context.Context,
 	return &poolrpc.StopDaemonResponse{}, nil
 }
 
+// GetTLSInfo returns information about the TLS certificate that is currently
+// served by the daemon's RPC and REST interfaces.
+func (s *rpcServer) GetTLSInfo(_ context.Context,
+	_ *poolrpc.GetTLSInfoRequest) (*poolrpc.GetTLSInfoResponse, error) {
+
+	// When running as a subserver, the TLS connection is terminated by
+	// the main process.
+	if s.server.tlsCert == nil {
+		return nil, fmt.Errorf("no TLS certificate served by poold")
+	}
+
+	return marshallTLSInfo(s.server.tlsCert), nil
+}
+
+// GetConnectionStatus returns the state of the connection to lnd.
+func (s *rpcServer) GetConnectionStatus(_ context.Context,
+	_ *poolrpc.GetConnectionStatusRequest) (
+	*poolrpc.GetConnectionStatusResponse, error) {
+
+	return &poolrpc.GetConnectionStatusResponse{
+		Lnd: s.server.lndConn.rpcStatus(),
+	}, nil
+}
+
+// DebugDump returns runtime statistics of the daemon and its active
+// configuration with all secrets redacted.
+func (s *rpcServer) DebugDump(_ context.Context,
+	_ *poolrpc.DebugDumpRequest) (*poolrpc.DebugDumpResponse, error) {
+
+	return debugDump(s.server.cfg), nil
+}
+
+// ExportState returns the accounts, orders and batch snapshots of the daemon
+// in a portable format without any secrets.
+func (s *rpcServer) ExportState(_ context.Context,
+	_ *poolrpc.ExportStateRequest) (*poolrpc.ExportStateResponse, error) {
+
+	state, err := s.server.db.ExportState()
+	if err != nil {
+		return nil, fmt.Errorf("unable to export state: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := clientdb.SerializeStateExport(&buf, state); err != nil {
+		return nil, fmt.Errorf("unable to serialize state: %v", err)
+	}
+
+	return &poolrpc.ExportStateResponse{
+		State:       buf.Bytes(),
+		NumAccounts: uint32(len(state.Accounts)),
+		NumOrders:   uint32(len(state.Orders)),
+		NumBatches:  uint32(len(state.BatchSnapshots)),
+	}, nil
+}
+
+// ImportState loads an exported state into the database after deriving the
+// account secrets from the connected lnd node again.
+func (s *rpcServer) ImportState(ctx context.Context,
+	req *poolrpc.ImportStateRequest) (*poolrpc.ImportStateResponse, error) {
+
+	state, err := clientdb.DeserializeStateExport(
+		bytes.NewReader(req.State),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to deserialize state: %v", err)
+	}
+
+	err = restoreAccountSecrets(
+		ctx, s.lndServices.WalletKit, s.lndServices.Signer, state,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.server.db.ImportState(state); err != nil {
+		return nil, fmt.Errorf("unable to import state: %v", err)
+	}
+
+	rpcLog.Infof("Imported %d accounts, %d orders and %d batch snapshots, "+
+		"restart the daemon to resume the imported accounts",
+		len(state.Accounts), len(state.Orders),
+		len(state.BatchSnapshots))
+
+	return &poolrpc.ImportStateResponse{
+		NumAccounts: uint32(len(state.Accounts)),
+		NumOrders:   uint32(len(state.Orders)),
+		NumBatches:  uint32(len(state.BatchSnapshots)),
+	}, nil
+}
+
+// restoreAccountSecrets makes sure all trader keys of the exported state were
+// derived by the given wallet and derives the shared secrets of the accounts
+// that aren't part of an export.
+func restoreAccountSecrets(ctx context.Context,
+	wallet lndclient.WalletKitClient, signer lndclient.SignerClient,
+	state *clientdb.StateExport) error {
+
+	// The same account is usually part of multiple batch snapshots, so we
+	// only derive each secret once.
+	secrets := make(map[[33]byte][32]byte)
+	return state.ForEachAccount(func(acct *account.Account) error {
+		var traderKey [33]byte
+		copy(traderKey[:], acct.TraderKey.PubKey.SerializeCompressed())
+
+		if secret, ok := secrets[traderKey]; ok {
+			acct.Secret = secret
+			return nil
+		}
+
+		keyLocator := acct.TraderKey.KeyLocator
+		keyDesc, err := wallet.DeriveKey(ctx, &keyLocator)
+		if err != nil {
+			return fmt.Errorf("unable to derive trader key %x: %v",
+				traderKey[:], err)
+		}
+		if !keyDesc.PubKey.IsEqual(acct.TraderKey.PubKey) {
+			return fmt.Errorf("trader key %x was not derived by "+
+				"the connected lnd node", traderKey[:])
+		}
+
+		secret, err := signer.DeriveSharedKey(
+			ctx, acct.AuctioneerKey, &keyLocator,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to derive account secret: %v",
+				err)
+		}
+
+		secrets[traderKey] = secret
+		acct.Secret = secret
+		return nil
+	})
+}
+
+// TestConnections dials the connected lnd node and the auction server on new
+// connections and reports for each endpoint whether it could be reached and
+// how long it took to get a response.
+func (s *rpcServer) TestConnections(ctx context.Context,
+	_ *poolrpc.TestConnectionsRequest) (*poolrpc.TestConnectionsResponse,
+	error) {
+
+	lndCfg := s.server.cfg.Lnd
+	checks := []connectionCheck{{
+		endpoint: "lnd",
+		address:  lndCfg.Host,
+		check: func(ctx context.Context) error {
+			conn, err := lndclient.NewBasicConn(
+				lndCfg.Host, lndCfg.TLSPath,
+				filepath.Dir(lndCfg.MacaroonPath),
+				s.server.cfg.Network, lndclient.MacFilename(
+					filepath.Base(lndCfg.MacaroonPath),
+				),
+			)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			_, err = lnrpc.NewLightningClient(conn).GetInfo(
+				ctx, &lnrpc.GetInfoRequest{},
+			)
+			return err
+		},
+	}, {
+		endpoint: "auctioneer",
+		address:  s.server.cfg.AuctionServer,
+		check:    s.auctioneer.CheckConnection,
+	}}
+
+	return &poolrpc.TestConnectionsResponse{
+		Results: runConnectionChecks(
+			ctx, s.server.clock, connectionTestTimeout, checks,
+		),
+	}, nil
+}
+
+// connectionCheck is a single endpoint the daemon depends on, together with
+// the function that checks whether it can be reached.
+type connectionCheck struct {
+	endpoint string
+	address  string
+	check    func(ctx context.Context) error
+}
+
+// runConnectionChecks runs the given connection checks one after the other,
+// each limited by the given timeout, and returns their results in the same
+// order.
+func runConnectionChecks(ctx context.Context, clk clock.Clock,
+	timeout time.Duration,
+	checks []connectionCheck) []*poolrpc.ConnectionTestResult {
+
+	results := make([]*poolrpc.ConnectionTestResult, 0, len(checks))
+	for _, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := clk.Now()
+		err := c.check(checkCtx)
+		latency := clk.Now().Sub(start)
+		cancel()
+
+		result := &poolrpc.ConnectionTestResult{
+			Endpoint:  c.endpoint,
+			Address:   c.address,
+			Reachable: err == nil,
+			LatencyMs: latency.Milliseconds(),
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
 // OfferSidecar is step 1/4 of the sidecar negotiation between the provider
 // (the trader submitting the bid order) and the recipient (the trader
 // receiving the sidecar channel).
@@ -3018,7 +5747,7 @@ func (s *rpcServer) determineAccountVersion(
 	// Now we can do the account version validation.
 	switch newVersion {
 	case poolrpc.AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT:
-		return account.VersionTaprootEnabled, nil
+		return s.accountVersion, nil
 
 	case poolrpc.AccountVersion_ACCOUNT_VERSION_LEGACY:
 		return account.VersionInitialNoVersion, nil
@@ -3030,6 +5759,36 @@ func (s *rpcServer) determineAccountVersion(
 	return account.VersionTaprootEnabled, nil
 }
 
+// determineModifiedAccountVersion returns the version an existing account
+// should have after it was modified. If no specific version was requested, the
+// configured or negotiated account version is used, but an account is never
+// downgraded.
+func (s *rpcServer) determineModifiedAccountVersion(
+	traderKey *btcec.PublicKey,
+	newVersion poolrpc.AccountVersion) (account.Version, error) {
+
+	version, err := s.determineAccountVersion(newVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	if newVersion != poolrpc.AccountVersion_ACCOUNT_VERSION_LND_DEPENDENT ||
+		version == account.VersionTaprootEnabled {
+
+		return version, nil
+	}
+
+	acct, err := s.server.db.Account(traderKey)
+	if err != nil {
+		return 0, err
+	}
+	if acct.Version > version {
+		return acct.Version, nil
+	}
+
+	return version, nil
+}
+
 // AccountModificationFees returns a map from account key to an ordered list of
 // account modifying action fees.
 func (s *rpcServer) AccountModificationFees(ctx context.Context,
@@ -3104,6 +5863,63 @@ func (s *rpcServer) AccountModificationFees(ctx context.Context,
 	}, nil
 }
 
+// AccountAuditLog returns a chronological list of all persisted events of an
+// account.
+func (s *rpcServer) AccountAuditLog(_ context.Context,
+	req *poolrpc.AccountAuditLogRequest) (*poolrpc.AccountAuditLogResponse,
+	error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the account exists, so we can distinguish an unknown
+	// account from one without any events.
+	if _, err := s.server.db.Account(traderKey); err != nil {
+		return nil, err
+	}
+
+	start := time.Unix(0, int64(req.StartTimestampNs))
+	end := time.Unix(0, math.MaxInt64)
+	if req.EndTimestampNs > 0 {
+		end = time.Unix(0, int64(req.EndTimestampNs))
+	}
+
+	events, err := s.server.db.GetAccountEvents(traderKey, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error querying account events: %v",
+			err)
+	}
+
+	rpcEvents := make([]*poolrpc.AccountAuditEvent, 0, len(events))
+	for _, evt := range events {
+		rpcState, err := marshallAccountState(evt.NewState)
+		if err != nil {
+			return nil, err
+		}
+
+		var txid []byte
+		if evt.Txid != (chainhash.Hash{}) {
+			txid = evt.Txid[:]
+		}
+
+		rpcEvents = append(rpcEvents, &poolrpc.AccountAuditEvent{
+			TimestampNs:      uint64(evt.Timestamp().UnixNano()),
+			Action:           poolrpc.AccountAuditAction(evt.Action),
+			State:            rpcState,
+			PrevValue:        uint64(evt.PrevValue),
+			Value:            uint64(evt.NewValue),
+			ExpirationHeight: evt.NewExpiry,
+			Txid:             txid,
+		})
+	}
+
+	return &poolrpc.AccountAuditLogResponse{
+		Events: rpcEvents,
+	}, nil
+}
+
 // rpcOrderStateToDBState maps the order state as received over the RPC
 // protocol to the local state that we use in the database.
 func rpcOrderStateToDBState(state auctioneerrpc.OrderState) (order.State,
@@ -3357,6 +6173,26 @@ func unmarshallSidecar(version order.Version,
 	return sidecar.DecodeString(encodedTicket)
 }
 
+// marshallTLSInfo translates a parsed TLS certificate into its RPC
+// counterpart.
+func marshallTLSInfo(c *x509.Certificate) *poolrpc.GetTLSInfoResponse {
+	fingerprint := sha256.Sum256(c.Raw)
+
+	ips := make([]string, 0, len(c.IPAddresses))
+	for _, ip := range c.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+
+	return &poolrpc.GetTLSInfoResponse{
+		CommonName:        c.Subject.CommonName,
+		NotBefore:         c.NotBefore.Unix(),
+		NotAfter:          c.NotAfter.Unix(),
+		DnsNames:          c.DNSNames,
+		IpAddresses:       ips,
+		FingerprintSha256: fingerprint[:],
+	}
+}
+
 // marshallTicket converts a sidecar ticket into its decoded RPC counterpart.
 func marshallTicket(t *sidecar.Ticket) *poolrpc.DecodedSidecarTicket {
 	serializePubKey := func(key *btcec.PublicKey) []byte {
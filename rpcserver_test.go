@@ -2,21 +2,41 @@ package pool
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/internal/test"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -203,6 +223,7 @@ func TestRenewAccount(t *testing.T) {
 				accountManager: accountMgr,
 				orderManager:   orderMgr,
 				marshaler:      marshaler,
+				accountVersion: account.VersionTaprootEnabled,
 				server: &Server{
 					lndServices: &lndclient.GrpcLndServices{
 						LndServices: lndServices,
@@ -228,3 +249,1690 @@ func TestRenewAccount(t *testing.T) {
 		})
 	}
 }
+
+var validateOrderAnnouncementTestCases = []struct {
+	name          string
+	batchVersion  order.BatchVersion
+	order         order.Order
+	expectedError string
+}{{
+	name:         "ask without preference on old version",
+	batchVersion: order.ExtendAccountBatchVersion,
+	order: &order.Ask{
+		AnnouncementConstraints: order.AnnouncementNoPreference,
+	},
+}, {
+	name:         "ask only unannounced on old version",
+	batchVersion: order.ExtendAccountBatchVersion,
+	order: &order.Ask{
+		AnnouncementConstraints: order.OnlyUnannounced,
+	},
+	expectedError: "batch version 1 does not support channel " +
+		"announcement constraints",
+}, {
+	name:         "ask only unannounced",
+	batchVersion: order.UnannouncedChannelsBatchVersion,
+	order: &order.Ask{
+		AnnouncementConstraints: order.OnlyUnannounced,
+	},
+}, {
+	name:         "ask unknown constraints",
+	batchVersion: order.UnannouncedChannelsBatchVersion,
+	order: &order.Ask{
+		AnnouncementConstraints: 3,
+	},
+	expectedError: "unknown channel announcement constraints 3",
+}, {
+	name:         "bid unannounced on old version",
+	batchVersion: order.ExtendAccountBatchVersion,
+	order: &order.Bid{
+		UnannouncedChannel: true,
+	},
+	expectedError: "batch version 1 does not support unannounced " +
+		"channels",
+}, {
+	name:         "bid unannounced",
+	batchVersion: order.UnannouncedChannelsBatchVersion,
+	order: &order.Bid{
+		UnannouncedChannel: true,
+	},
+}}
+
+// TestValidateOrderAnnouncement makes sure the channel announcement
+// preferences of an order are validated against the batch version.
+func TestValidateOrderAnnouncement(t *testing.T) {
+	leaseDuration := order.LegacyLeaseDurationBucket
+	auctionTerms := &terms.AuctioneerTerms{
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			leaseDuration: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}
+
+	for _, tc := range validateOrderAnnouncementTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			srv := rpcServer{
+				server: &Server{
+					cfg: &Config{
+						DebugConfig: &DebugConfig{
+							BatchVersion: int32(
+								tc.batchVersion,
+							),
+						},
+					},
+				},
+			}
+
+			tc.order.Details().Units = 1
+			tc.order.Details().LeaseDuration = leaseDuration
+			acct := &account.Account{
+				State: account.StateOpen,
+			}
+
+			err := srv.validateOrder(tc.order, acct, auctionTerms)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+var includeOrderTestCases = []struct {
+	name     string
+	req      *poolrpc.ListOrdersRequest
+	isAsk    bool
+	state    order.State
+	tag      string
+	expected bool
+}{{
+	name:     "no filter",
+	req:      &poolrpc.ListOrdersRequest{},
+	state:    order.StateExecuted,
+	tag:      "strategy-a",
+	expected: true,
+}, {
+	name: "archived order with active only",
+	req: &poolrpc.ListOrdersRequest{
+		ActiveOnly: true,
+	},
+	state:    order.StateExecuted,
+	expected: false,
+}, {
+	name: "matching tag",
+	req: &poolrpc.ListOrdersRequest{
+		Tag: "strategy-a",
+	},
+	state:    order.StateSubmitted,
+	tag:      "strategy-a",
+	expected: true,
+}, {
+	name: "different tag",
+	req: &poolrpc.ListOrdersRequest{
+		Tag: "strategy-a",
+	},
+	state:    order.StateSubmitted,
+	tag:      "strategy-b",
+	expected: false,
+}, {
+	name: "untagged order with tag filter",
+	req: &poolrpc.ListOrdersRequest{
+		Tag: "strategy-a",
+	},
+	state:    order.StateSubmitted,
+	expected: false,
+}, {
+	name: "state filter overrides active only",
+	req: &poolrpc.ListOrdersRequest{
+		ActiveOnly:  true,
+		StateFilter: poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ALL,
+	},
+	state:    order.StateCanceled,
+	expected: true,
+}, {
+	name: "active order with active state filter",
+	req: &poolrpc.ListOrdersRequest{
+		StateFilter: poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ACTIVE,
+	},
+	state:    order.StatePartiallyFilled,
+	expected: true,
+}, {
+	name: "archived order with active state filter",
+	req: &poolrpc.ListOrdersRequest{
+		StateFilter: poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ACTIVE,
+	},
+	state:    order.StateExpired,
+	expected: false,
+}, {
+	name: "archived order with archived state filter",
+	req: &poolrpc.ListOrdersRequest{
+		StateFilter: poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ARCHIVED,
+	},
+	state:    order.StateCanceled,
+	expected: true,
+}, {
+	name: "active order with archived state filter",
+	req: &poolrpc.ListOrdersRequest{
+		StateFilter: poolrpc.OrderStateFilter_ORDER_STATE_FILTER_ARCHIVED,
+	},
+	state:    order.StateSubmitted,
+	expected: false,
+}, {
+	name: "ask with ask type filter",
+	req: &poolrpc.ListOrdersRequest{
+		TypeFilter: poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_ASK,
+	},
+	isAsk:    true,
+	state:    order.StateSubmitted,
+	expected: true,
+}, {
+	name: "bid with ask type filter",
+	req: &poolrpc.ListOrdersRequest{
+		TypeFilter: poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_ASK,
+	},
+	state:    order.StateSubmitted,
+	expected: false,
+}, {
+	name: "ask with bid type filter",
+	req: &poolrpc.ListOrdersRequest{
+		TypeFilter: poolrpc.OrderTypeFilter_ORDER_TYPE_FILTER_BID,
+	},
+	isAsk:    true,
+	state:    order.StateSubmitted,
+	expected: false,
+}}
+
+// TestIncludeOrder makes sure the ListOrders filters are applied correctly.
+func TestIncludeOrder(t *testing.T) {
+	for _, tc := range includeOrderTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			kit := order.Kit{
+				State: tc.state,
+				Tag:   tc.tag,
+			}
+
+			var o order.Order = &order.Bid{Kit: kit}
+			if tc.isAsk {
+				o = &order.Ask{Kit: kit}
+			}
+
+			require.Equal(t, tc.expected, includeOrder(tc.req, o))
+		})
+	}
+}
+
+var paginateOrdersTestCases = []struct {
+	name     string
+	req      *poolrpc.ListOrdersRequest
+	expected []byte
+}{{
+	name:     "all orders by creation time",
+	req:      &poolrpc.ListOrdersRequest{},
+	expected: []byte{1, 2, 3, 4},
+}, {
+	name: "all orders by creation time reversed",
+	req: &poolrpc.ListOrdersRequest{
+		Reversed: true,
+	},
+	expected: []byte{4, 3, 2, 1},
+}, {
+	name: "all orders by rate",
+	req: &poolrpc.ListOrdersRequest{
+		SortBy: poolrpc.OrderSortBy_ORDER_SORT_BY_RATE,
+	},
+	expected: []byte{3, 1, 4, 2},
+}, {
+	name: "all orders by rate reversed",
+	req: &poolrpc.ListOrdersRequest{
+		SortBy:   poolrpc.OrderSortBy_ORDER_SORT_BY_RATE,
+		Reversed: true,
+	},
+	expected: []byte{2, 4, 1, 3},
+}, {
+	name: "first page",
+	req: &poolrpc.ListOrdersRequest{
+		Limit: 2,
+	},
+	expected: []byte{1, 2},
+}, {
+	name: "last page",
+	req: &poolrpc.ListOrdersRequest{
+		Offset: 2,
+		Limit:  2,
+	},
+	expected: []byte{3, 4},
+}, {
+	name: "partial last page",
+	req: &poolrpc.ListOrdersRequest{
+		Offset: 3,
+		Limit:  2,
+	},
+	expected: []byte{4},
+}, {
+	name: "offset without limit",
+	req: &poolrpc.ListOrdersRequest{
+		Offset: 1,
+	},
+	expected: []byte{2, 3, 4},
+}, {
+	name: "offset at end",
+	req: &poolrpc.ListOrdersRequest{
+		Offset: 4,
+	},
+	expected: []byte{},
+}, {
+	name: "offset beyond end",
+	req: &poolrpc.ListOrdersRequest{
+		Offset: 10,
+		Limit:  2,
+	},
+	expected: []byte{},
+}, {
+	name: "limit larger than orders",
+	req: &poolrpc.ListOrdersRequest{
+		Limit: 10,
+	},
+	expected: []byte{1, 2, 3, 4},
+}}
+
+// TestPaginateOrders makes sure the orders returned by ListOrders are sorted
+// and paginated correctly.
+func TestPaginateOrders(t *testing.T) {
+	now := time.Now()
+	newOrder := func(nonce byte, rate uint32) *listedOrder {
+		kit := order.NewKit(order.Nonce{nonce})
+		kit.FixedRate = rate
+
+		return &listedOrder{
+			order:   &order.Bid{Kit: *kit},
+			created: now.Add(time.Duration(nonce) * time.Second),
+		}
+	}
+
+	for _, tc := range paginateOrdersTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			orders := []*listedOrder{
+				newOrder(2, 400), newOrder(1, 200),
+				newOrder(4, 300), newOrder(3, 100),
+			}
+			result := paginateOrders(tc.req, orders)
+
+			nonces := make([]byte, 0, len(result))
+			for _, o := range result {
+				nonces = append(nonces, o.order.Nonce()[0])
+			}
+			require.Equal(t, tc.expected, nonces)
+		})
+	}
+}
+
+var includeAccountTestCases = []struct {
+	name     string
+	req      *poolrpc.ListAccountsRequest
+	state    account.State
+	value    btcutil.Amount
+	expected bool
+}{{
+	name:     "no filter",
+	req:      &poolrpc.ListAccountsRequest{},
+	state:    account.StateClosed,
+	value:    100_000,
+	expected: true,
+}, {
+	name: "inactive account with active only",
+	req: &poolrpc.ListAccountsRequest{
+		ActiveOnly: true,
+	},
+	state:    account.StateClosed,
+	value:    100_000,
+	expected: false,
+}, {
+	name: "matching state",
+	req: &poolrpc.ListAccountsRequest{
+		States: []poolrpc.AccountState{
+			poolrpc.AccountState_EXPIRED,
+			poolrpc.AccountState_OPEN,
+		},
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: true,
+}, {
+	name: "matching rpc state of different db state",
+	req: &poolrpc.ListAccountsRequest{
+		States: []poolrpc.AccountState{
+			poolrpc.AccountState_PENDING_UPDATE,
+		},
+	},
+	state:    account.StateExpiredPendingUpdate,
+	value:    100_000,
+	expected: true,
+}, {
+	name: "different state",
+	req: &poolrpc.ListAccountsRequest{
+		States: []poolrpc.AccountState{
+			poolrpc.AccountState_EXPIRED,
+		},
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: false,
+}, {
+	name: "value below min",
+	req: &poolrpc.ListAccountsRequest{
+		MinValueSat: 100_001,
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: false,
+}, {
+	name: "value equal to min",
+	req: &poolrpc.ListAccountsRequest{
+		MinValueSat: 100_000,
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: true,
+}, {
+	name: "value above max",
+	req: &poolrpc.ListAccountsRequest{
+		MaxValueSat: 99_999,
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: false,
+}, {
+	name: "value within range",
+	req: &poolrpc.ListAccountsRequest{
+		MinValueSat: 50_000,
+		MaxValueSat: 100_000,
+	},
+	state:    account.StateOpen,
+	value:    100_000,
+	expected: true,
+}}
+
+// TestIncludeAccount makes sure the ListAccounts filters are applied correctly.
+func TestIncludeAccount(t *testing.T) {
+	for _, tc := range includeAccountTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			acct := &account.Account{
+				State: tc.state,
+				Value: tc.value,
+			}
+
+			include, err := includeAccount(tc.req, acct)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, include)
+		})
+	}
+}
+
+// TestAnchorReserveErr makes sure a failed anchor reserve check is returned
+// with a status code clients can match on.
+func TestAnchorReserveErr(t *testing.T) {
+	err := anchorReserveErr(fmt.Errorf("%w: need 10000 sats",
+		account.ErrBelowAnchorReserve))
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "need 10000 sats")
+
+	err = anchorReserveErr(errors.New("other error"))
+	require.Equal(t, codes.Unknown, status.Code(err))
+}
+
+// TestValidateListAccountsRequest makes sure a value range with a minimum
+// above its maximum is rejected.
+func TestValidateListAccountsRequest(t *testing.T) {
+	require.NoError(t, validateListAccountsRequest(
+		&poolrpc.ListAccountsRequest{MinValueSat: 1000},
+	))
+	require.NoError(t, validateListAccountsRequest(
+		&poolrpc.ListAccountsRequest{
+			MinValueSat: 1000,
+			MaxValueSat: 1000,
+		},
+	))
+
+	err := validateListAccountsRequest(&poolrpc.ListAccountsRequest{
+		MinValueSat: 2000,
+		MaxValueSat: 1000,
+	})
+	require.ErrorContains(t, err, "cannot be greater than max value")
+}
+
+// TestMarshallAccountBalances makes sure the account value is reported as
+// either confirmed or pending balance depending on the account state.
+func TestMarshallAccountBalances(t *testing.T) {
+	testCases := []struct {
+		state             account.State
+		expectedConfirmed uint64
+		expectedPending   uint64
+	}{{
+		state:             account.StateOpen,
+		expectedConfirmed: 100_000,
+	}, {
+		state:             account.StateExpired,
+		expectedConfirmed: 100_000,
+	}, {
+		state:           account.StatePendingOpen,
+		expectedPending: 100_000,
+	}, {
+		state:           account.StatePendingUpdate,
+		expectedPending: 100_000,
+	}, {
+		state:           account.StatePendingBatch,
+		expectedPending: 100_000,
+	}, {
+		state: account.StateClosed,
+	}}
+
+	for _, tc := range testCases {
+		acct := &account.Account{
+			TraderKey: &keychain.KeyDescriptor{
+				PubKey: getAccountKey(traderKeyRaw),
+			},
+			State: tc.state,
+			Value: 100_000,
+		}
+
+		rpcAcct, err := MarshallAccount(acct)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedConfirmed, rpcAcct.ConfirmedBalance)
+		require.Equal(t, tc.expectedPending, rpcAcct.PendingBalance)
+	}
+}
+
+// TestGetTLSInfo makes sure the TLS info RPC returns the details of the served
+// certificate.
+func TestGetTLSInfo(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "pool-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := DefaultConfig()
+	cfg.TLSCertPath = filepath.Join(tempDir, DefaultTLSCertFilename)
+	cfg.TLSKeyPath = filepath.Join(tempDir, DefaultTLSKeyFilename)
+	cfg.TLSExtraIPs = []string{"10.0.0.1"}
+	cfg.TLSExtraDomains = []string{"pool.example.com"}
+	cfg.TLSDisableAutofill = true
+	require.NoError(t, GenerateTLSPair(&cfg))
+
+	_, parsedCert, err := cert.LoadCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	require.NoError(t, err)
+
+	// Without a served certificate, there is nothing to return.
+	srv := &rpcServer{
+		server: &Server{},
+	}
+	_, err = srv.GetTLSInfo(context.Background(), nil)
+	require.Error(t, err)
+
+	srv.server.tlsCert = parsedCert
+	resp, err := srv.GetTLSInfo(context.Background(), nil)
+	require.NoError(t, err)
+
+	fingerprint := sha256.Sum256(parsedCert.Raw)
+	require.Equal(t, "pool.example.com", resp.CommonName)
+	require.Equal(t, parsedCert.NotBefore.Unix(), resp.NotBefore)
+	require.Equal(t, parsedCert.NotAfter.Unix(), resp.NotAfter)
+	require.Equal(t, parsedCert.DNSNames, resp.DnsNames)
+	require.Contains(t, resp.DnsNames, "pool.example.com")
+	require.Contains(t, resp.IpAddresses, "10.0.0.1")
+	require.Contains(t, resp.IpAddresses, "127.0.0.1")
+	require.Equal(t, fingerprint[:], resp.FingerprintSha256)
+}
+
+// TestRunConnectionChecks makes sure every endpoint is checked, even if a
+// previous one can't be reached, and that the latency and errors of each check
+// are reported.
+func TestRunConnectionChecks(t *testing.T) {
+	testClock := clock.NewTestClock(time.Unix(1600000000, 0))
+	checks := []connectionCheck{{
+		endpoint: "lnd",
+		address:  "localhost:10009",
+		check: func(context.Context) error {
+			testClock.SetTime(testClock.Now().Add(
+				25 * time.Millisecond,
+			))
+			return nil
+		},
+	}, {
+		endpoint: "auctioneer",
+		address:  "localhost:12009",
+		check: func(context.Context) error {
+			return fmt.Errorf("connection refused")
+		},
+	}, {
+		endpoint: "unresponsive",
+		address:  "localhost:13009",
+		check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}}
+
+	results := runConnectionChecks(
+		context.Background(), testClock, 10*time.Millisecond, checks,
+	)
+	require.Equal(t, []*poolrpc.ConnectionTestResult{{
+		Endpoint:  "lnd",
+		Address:   "localhost:10009",
+		Reachable: true,
+		LatencyMs: 25,
+	}, {
+		Endpoint: "auctioneer",
+		Address:  "localhost:12009",
+		Error:    "connection refused",
+	}, {
+		Endpoint: "unresponsive",
+		Address:  "localhost:13009",
+		Error:    context.DeadlineExceeded.Error(),
+	}}, results)
+}
+
+var leaseStatusTestCases = []struct {
+	name              string
+	channel           *leasedChannel
+	expectedStatus    poolrpc.LeaseStatus
+	expectedRemaining uint32
+}{{
+	name:           "channel closed",
+	expectedStatus: poolrpc.LeaseStatus_LEASE_STATUS_CLOSED,
+}, {
+	name: "channel pending",
+	channel: &leasedChannel{
+		pending: true,
+	},
+	expectedStatus: poolrpc.LeaseStatus_LEASE_STATUS_PENDING,
+}, {
+	name:           "channel open without known expiry",
+	channel:        &leasedChannel{},
+	expectedStatus: poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+}, {
+	name: "lease active",
+	channel: &leasedChannel{
+		leaseExpiry: 2100,
+	},
+	expectedStatus:    poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+	expectedRemaining: 100,
+}, {
+	name: "lease expires at current height",
+	channel: &leasedChannel{
+		leaseExpiry: 2000,
+	},
+	expectedStatus: poolrpc.LeaseStatus_LEASE_STATUS_MATURED,
+}, {
+	name: "lease matured",
+	channel: &leasedChannel{
+		leaseExpiry: 1500,
+	},
+	expectedStatus: poolrpc.LeaseStatus_LEASE_STATUS_MATURED,
+}}
+
+// TestLeaseStatus makes sure the status and remaining duration of a lease are
+// derived correctly from the state of its channel.
+func TestLeaseStatus(t *testing.T) {
+	const bestHeight = 2000
+
+	for _, tc := range leaseStatusTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, remaining := leaseStatus(tc.channel, bestHeight)
+			require.Equal(t, tc.expectedStatus, status)
+			require.Equal(t, tc.expectedRemaining, remaining)
+		})
+	}
+}
+
+var filterLeasesTestCases = []struct {
+	name           string
+	req            *poolrpc.LeasesRequest
+	expectedNonces []byte
+	expectedEarned uint64
+	expectedPaid   uint64
+}{{
+	name:           "no filters",
+	req:            &poolrpc.LeasesRequest{},
+	expectedNonces: []byte{1, 2, 3, 4},
+	expectedEarned: 3000,
+	expectedPaid:   4000 + 4*(10+5),
+}, {
+	name: "taker only",
+	req: &poolrpc.LeasesRequest{
+		RoleFilter: poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_TAKER,
+	},
+	expectedNonces: []byte{1, 3},
+	expectedPaid:   4000 + 2*(10+5),
+}, {
+	name: "maker only",
+	req: &poolrpc.LeasesRequest{
+		RoleFilter: poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER,
+	},
+	expectedNonces: []byte{2, 4},
+	expectedEarned: 3000,
+	expectedPaid:   2 * (10 + 5),
+}, {
+	name: "active only",
+	req: &poolrpc.LeasesRequest{
+		Statuses: []poolrpc.LeaseStatus{
+			poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+		},
+	},
+	expectedNonces: []byte{1, 2},
+	expectedEarned: 1000,
+	expectedPaid:   1000 + 2*(10+5),
+}, {
+	name: "matured or closed maker leases",
+	req: &poolrpc.LeasesRequest{
+		RoleFilter: poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER,
+		Statuses: []poolrpc.LeaseStatus{
+			poolrpc.LeaseStatus_LEASE_STATUS_MATURED,
+			poolrpc.LeaseStatus_LEASE_STATUS_CLOSED,
+		},
+	},
+	expectedNonces: []byte{4},
+	expectedEarned: 2000,
+	expectedPaid:   10 + 5,
+}, {
+	name: "no pending leases",
+	req: &poolrpc.LeasesRequest{
+		Statuses: []poolrpc.LeaseStatus{
+			poolrpc.LeaseStatus_LEASE_STATUS_PENDING,
+		},
+	},
+	expectedNonces: nil,
+}}
+
+// TestFilterLeases makes sure the role and status filters of the Leases call
+// are applied and the totals only include the leases returned.
+func TestFilterLeases(t *testing.T) {
+	newLease := func(nonce byte, purchased bool, premium uint64,
+		status poolrpc.LeaseStatus) *poolrpc.Lease {
+
+		return &poolrpc.Lease{
+			OrderNonce:      []byte{nonce},
+			Purchased:       purchased,
+			PremiumSat:      premium,
+			ExecutionFeeSat: 10,
+			ChainFeeSat:     5,
+			FeeRebateSat:    uint64(nonce),
+			Status:          status,
+		}
+	}
+	leases := []*poolrpc.Lease{
+		newLease(
+			1, true, 1000, poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+		),
+		newLease(
+			2, false, 1000, poolrpc.LeaseStatus_LEASE_STATUS_ACTIVE,
+		),
+		newLease(
+			3, true, 3000, poolrpc.LeaseStatus_LEASE_STATUS_MATURED,
+		),
+		newLease(
+			4, false, 2000, poolrpc.LeaseStatus_LEASE_STATUS_CLOSED,
+		),
+	}
+
+	for _, tc := range filterLeasesTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := filterLeases(tc.req, leases)
+
+			var (
+				nonces         []byte
+				expectedRebate uint64
+			)
+			for _, lease := range resp.Leases {
+				nonces = append(nonces, lease.OrderNonce[0])
+				expectedRebate += uint64(lease.OrderNonce[0])
+			}
+			require.Equal(t, tc.expectedNonces, nonces)
+			require.Equal(t, tc.expectedEarned, resp.TotalAmtEarnedSat)
+			require.Equal(t, tc.expectedPaid, resp.TotalAmtPaidSat)
+			require.Equal(t, expectedRebate, resp.TotalFeeRebateSat)
+		})
+	}
+}
+
+// TestBatchesWithActiveLeases makes sure only batches that created a channel
+// with a pending or active lease are reported.
+func TestBatchesWithActiveLeases(t *testing.T) {
+	t.Parallel()
+
+	const bestHeight = 1000
+
+	newBatch := func(id byte) *clientdb.LocalBatchSnapshot {
+		return &clientdb.LocalBatchSnapshot{
+			BatchID: order.BatchID{id},
+			BatchTX: &wire.MsgTx{LockTime: uint32(id)},
+		}
+	}
+	chanPoint := func(batch *clientdb.LocalBatchSnapshot,
+		index uint32) string {
+
+		return fmt.Sprintf("%v:%d", batch.BatchTX.TxHash(), index)
+	}
+
+	var (
+		active  = newBatch(1)
+		matured = newBatch(2)
+		pending = newBatch(3)
+		closed  = newBatch(4)
+		mixed   = newBatch(5)
+	)
+	leasedChans := map[string]*leasedChannel{
+		chanPoint(active, 0): {
+			leaseExpiry: bestHeight + 1,
+		},
+		chanPoint(matured, 0): {
+			leaseExpiry: bestHeight,
+		},
+		chanPoint(pending, 1): {
+			pending: true,
+		},
+		chanPoint(mixed, 0): {
+			leaseExpiry: bestHeight - 1,
+		},
+		chanPoint(mixed, 1): {},
+	}
+
+	batches := []*clientdb.LocalBatchSnapshot{
+		active, matured, pending, closed, mixed,
+	}
+	require.Equal(t, map[order.BatchID]struct{}{
+		active.BatchID:  {},
+		pending.BatchID: {},
+		mixed.BatchID:   {},
+	}, batchesWithActiveLeases(batches, leasedChans, bestHeight))
+}
+
+// TestValidateLeasesRequest makes sure unknown filter values are rejected.
+func TestValidateLeasesRequest(t *testing.T) {
+	err := validateLeasesRequest(&poolrpc.LeasesRequest{
+		RoleFilter: poolrpc.LeaseRoleFilter_LEASE_ROLE_FILTER_MAKER,
+		Statuses: []poolrpc.LeaseStatus{
+			poolrpc.LeaseStatus_LEASE_STATUS_CLOSED,
+		},
+	})
+	require.NoError(t, err)
+
+	err = validateLeasesRequest(&poolrpc.LeasesRequest{
+		RoleFilter: 99,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown role filter 99")
+
+	err = validateLeasesRequest(&poolrpc.LeasesRequest{
+		Statuses: []poolrpc.LeaseStatus{99},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown lease status 99")
+}
+
+var leasePerformanceTestCases = []struct {
+	name     string
+	leases   []*poolrpc.Lease
+	expected *poolrpc.LeasePerformanceReportResponse
+}{{
+	name:     "no leases",
+	expected: &poolrpc.LeasePerformanceReportResponse{},
+}, {
+	name: "sold for one year",
+	leases: []*poolrpc.Lease{{
+		ChannelAmtSat:         1_000_000,
+		ChannelDurationBlocks: blocksPerYear,
+		PremiumSat:            50_000,
+	}},
+	expected: &poolrpc.LeasePerformanceReportResponse{
+		NumLeases:          1,
+		TotalChannelAmtSat: 1_000_000,
+		PremiumEarnedSat:   50_000,
+		NetPremiumSat:      50_000,
+		EffectiveApr:       0.05,
+	},
+}, {
+	name: "sold for half a year with fees",
+	leases: []*poolrpc.Lease{{
+		ChannelAmtSat:         1_000_000,
+		ChannelDurationBlocks: blocksPerYear / 2,
+		PremiumSat:            25_000,
+		ExecutionFeeSat:       1_000,
+		ChainFeeSat:           500,
+	}},
+	expected: &poolrpc.LeasePerformanceReportResponse{
+		NumLeases:          1,
+		TotalChannelAmtSat: 1_000_000,
+		PremiumEarnedSat:   25_000,
+		ExecutionFeeSat:    1_000,
+		ChainFeeSat:        500,
+		NetPremiumSat:      23_500,
+		EffectiveApr:       0.047,
+	},
+}, {
+	name: "purchased for four weeks",
+	leases: []*poolrpc.Lease{{
+		ChannelAmtSat:         2_000_000,
+		ChannelDurationBlocks: 4032,
+		PremiumSat:            10_000,
+		ExecutionFeeSat:       500,
+		ChainFeeSat:           300,
+		Purchased:             true,
+	}},
+	expected: &poolrpc.LeasePerformanceReportResponse{
+		NumLeases:          1,
+		TotalChannelAmtSat: 2_000_000,
+		PremiumPaidSat:     10_000,
+		ExecutionFeeSat:    500,
+		ChainFeeSat:        300,
+		NetPremiumSat:      -10_800,
+		EffectiveApr:       -10_800 / (2_000_000 * 4032.0 / 52_560),
+	},
+}, {
+	name: "sold and purchased",
+	leases: []*poolrpc.Lease{{
+		ChannelAmtSat:         1_000_000,
+		ChannelDurationBlocks: blocksPerYear,
+		PremiumSat:            50_000,
+	}, {
+		ChannelAmtSat:         1_000_000,
+		ChannelDurationBlocks: blocksPerYear,
+		PremiumSat:            30_000,
+		ExecutionFeeSat:       1_000,
+		Purchased:             true,
+	}},
+	expected: &poolrpc.LeasePerformanceReportResponse{
+		NumLeases:          2,
+		TotalChannelAmtSat: 2_000_000,
+		PremiumEarnedSat:   50_000,
+		PremiumPaidSat:     30_000,
+		ExecutionFeeSat:    1_000,
+		NetPremiumSat:      19_000,
+		EffectiveApr:       0.0095,
+	},
+}, {
+	name: "lease without duration",
+	leases: []*poolrpc.Lease{{
+		ChannelAmtSat: 1_000_000,
+		PremiumSat:    1_000,
+	}},
+	expected: &poolrpc.LeasePerformanceReportResponse{
+		NumLeases:          1,
+		TotalChannelAmtSat: 1_000_000,
+		PremiumEarnedSat:   1_000,
+		NetPremiumSat:      1_000,
+	},
+}}
+
+// TestLeasePerformance makes sure the premiums and fees of leases are tallied
+// up correctly and the effective APR is weighted by the leased amounts and
+// durations.
+func TestLeasePerformance(t *testing.T) {
+	for _, tc := range leasePerformanceTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := leasePerformance(tc.leases)
+			require.InDelta(
+				t, tc.expected.EffectiveApr, report.EffectiveApr,
+				1e-9,
+			)
+
+			report.EffectiveApr = tc.expected.EffectiveApr
+			require.Equal(t, tc.expected, report)
+		})
+	}
+}
+
+// TestLeasePerformanceReportNoLeases makes sure an empty report is returned
+// instead of an error if no leases were executed in the requested time range.
+func TestLeasePerformanceReportNoLeases(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "client-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	db, err := clientdb.New(tempDir, clientdb.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	srv := &rpcServer{
+		server: &Server{
+			db: db,
+		},
+	}
+	resp, err := srv.LeasePerformanceReport(
+		context.Background(), &poolrpc.LeasePerformanceReportRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &poolrpc.LeasePerformanceReportResponse{}, resp)
+
+	_, err = srv.LeasePerformanceReport(
+		context.Background(), &poolrpc.LeasePerformanceReportRequest{
+			StartTimestampNs: 2000,
+			EndTimestampNs:   1000,
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "end timestamp must not be before")
+}
+
+// TestRequireOrderConfirmation makes sure orders can't be submitted directly
+// if order confirmation is required and that an unknown confirmation token is
+// rejected.
+func TestRequireOrderConfirmation(t *testing.T) {
+	t.Parallel()
+
+	srv := &rpcServer{
+		server: &Server{
+			cfg: &Config{
+				RequireOrderConfirmation: true,
+			},
+			lndServices: &lndclient.GrpcLndServices{},
+		},
+		orderConfirmations: newOrderConfirmations(
+			clock.NewDefaultClock(), orderConfirmationTimeout,
+		),
+	}
+
+	ctx := context.Background()
+	_, err := srv.SubmitOrder(ctx, &poolrpc.SubmitOrderRequest{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "order confirmation required")
+
+	_, err = srv.ConfirmOrder(ctx, &poolrpc.ConfirmOrderRequest{
+		ConfirmationToken: make([]byte, orderConfirmationTokenSize),
+	})
+	require.ErrorIs(t, err, errUnknownConfirmationToken)
+}
+
+var applyDefaultLeaseDurationTestCases = []struct {
+	name             string
+	order            order.Order
+	defaultDuration  uint32
+	expectedDuration uint32
+	expectedErr      string
+}{{
+	name:             "bid without duration uses default",
+	order:            &order.Bid{},
+	defaultDuration:  4032,
+	expectedDuration: 4032,
+}, {
+	name: "explicit bid duration takes precedence",
+	order: &order.Bid{
+		Kit: order.Kit{LeaseDuration: 2016},
+	},
+	defaultDuration:  4032,
+	expectedDuration: 2016,
+}, {
+	name:             "no default configured",
+	order:            &order.Bid{},
+	expectedDuration: 0,
+}, {
+	name:             "asks are not defaulted",
+	order:            &order.Ask{},
+	defaultDuration:  4032,
+	expectedDuration: 0,
+}, {
+	name:            "default not offered by server",
+	order:           &order.Bid{},
+	defaultDuration: 1000,
+	expectedErr:     "invalid default lease duration",
+}}
+
+// TestApplyDefaultLeaseDuration makes sure the configured default lease
+// duration is only applied to bids that don't specify a duration and only if
+// the auctioneer offers it.
+func TestApplyDefaultLeaseDuration(t *testing.T) {
+	auctionTerms := &terms.AuctioneerTerms{
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			4032: auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS,
+		},
+	}
+
+	for _, tc := range applyDefaultLeaseDurationTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := applyDefaultLeaseDuration(
+				tc.order, tc.defaultDuration, auctionTerms,
+			)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t, tc.expectedDuration,
+				tc.order.Details().LeaseDuration,
+			)
+		})
+	}
+}
+
+var applyRateAPRTestCases = []struct {
+	name         string
+	order        order.Order
+	rateAPR      float64
+	expectedRate uint32
+	expectedErr  string
+}{{
+	name:         "no APR keeps fixed rate",
+	order:        &order.Bid{Kit: order.Kit{FixedRate: 100}},
+	expectedRate: 100,
+}, {
+	name:         "APR is converted",
+	order:        &order.Ask{},
+	rateAPR:      0.05,
+	expectedRate: 951,
+}, {
+	name:        "fixed rate and APR set",
+	order:       &order.Bid{Kit: order.Kit{FixedRate: 100}},
+	rateAPR:     0.05,
+	expectedErr: "cannot both be set",
+}, {
+	name:        "APR too small",
+	order:       &order.Bid{},
+	rateAPR:     0.00001,
+	expectedErr: "invalid rate_apr",
+}}
+
+// TestApplyRateAPR makes sure orders submitted with an APR get the matching
+// fixed rate.
+func TestApplyRateAPR(t *testing.T) {
+	for _, tc := range applyRateAPRTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := applyRateAPR(tc.order, tc.rateAPR)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t, tc.expectedRate, tc.order.Details().FixedRate,
+			)
+		})
+	}
+}
+
+// TestCheckMaxPendingOrders makes sure new orders are refused once the maximum
+// number of pending orders is reached and allowed again after one of them is
+// canceled.
+func TestCheckMaxPendingOrders(t *testing.T) {
+	t.Parallel()
+
+	newOrder := func(state order.State) order.Order {
+		return &order.Bid{Kit: order.Kit{State: state}}
+	}
+	orders := []order.Order{
+		newOrder(order.StateSubmitted),
+		newOrder(order.StatePartiallyFilled),
+		newOrder(order.StateExecuted),
+		newOrder(order.StateFailed),
+	}
+
+	// Archived orders don't count towards the limit.
+	require.NoError(t, checkMaxPendingOrders(orders, 3))
+
+	err := checkMaxPendingOrders(orders, 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maximum number of 2 pending orders")
+
+	orders[0].Details().State = order.StateCanceled
+	require.NoError(t, checkMaxPendingOrders(orders, 2))
+}
+
+// replaceTestKit returns an order kit with a nonce derived from the given
+// preimage byte for the order replacement tests.
+func replaceTestKit(preimage byte, state order.State, acctKey byte) order.Kit {
+	kit := order.NewKitWithPreimage(lntypes.Preimage{preimage})
+	kit.State = state
+	kit.AcctKey[0] = acctKey
+
+	return *kit
+}
+
+var replaceOrderTestCases = []struct {
+	name         string
+	oldOrder     order.Order
+	newOrder     order.Order
+	cancelErr    error
+	submitErr    error
+	expectCancel bool
+	expectSubmit bool
+	expectedErr  string
+}{{
+	name:         "replaced",
+	oldOrder:     &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder:     &order.Bid{Kit: replaceTestKit(2, 0, 0)},
+	expectCancel: true,
+	expectSubmit: true,
+}, {
+	name: "old order archived",
+	oldOrder: &order.Bid{
+		Kit: replaceTestKit(1, order.StateExecuted, 0),
+	},
+	newOrder:    &order.Bid{Kit: replaceTestKit(2, 0, 0)},
+	expectedErr: "unable to replace order in terminal state",
+}, {
+	name:        "different type",
+	oldOrder:    &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder:    &order.Ask{Kit: replaceTestKit(2, 0, 0)},
+	expectedErr: "cannot replace Bid order with Ask order",
+}, {
+	name:     "different account",
+	oldOrder: &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder: &order.Bid{
+		Kit: replaceTestKit(2, order.StateSubmitted, 2),
+	},
+	expectedErr: "must use the same account",
+}, {
+	name:        "same nonce",
+	oldOrder:    &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder:    &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	expectedErr: "must not reuse the nonce",
+}, {
+	name:         "cancel fails",
+	oldOrder:     &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder:     &order.Bid{Kit: replaceTestKit(2, 0, 0)},
+	cancelErr:    errors.New("server unavailable"),
+	expectCancel: true,
+	expectedErr:  "replacement not submitted: server unavailable",
+}, {
+	name:         "submit fails",
+	oldOrder:     &order.Bid{Kit: replaceTestKit(1, 0, 0)},
+	newOrder:     &order.Bid{Kit: replaceTestKit(2, 0, 0)},
+	submitErr:    errors.New("insufficient balance"),
+	expectCancel: true,
+	expectSubmit: true,
+	expectedErr:  "was canceled but its replacement could not be",
+}}
+
+// TestReplaceOrder makes sure a replacement is only submitted after the old
+// order was canceled successfully and that neither happens if the replacement
+// doesn't fit the old order.
+func TestReplaceOrder(t *testing.T) {
+	for _, tc := range replaceOrderTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			cancelOrder := func(_ context.Context,
+				o order.Order) error {
+
+				require.Equal(t, tc.oldOrder.Nonce(), o.Nonce())
+				calls = append(calls, "cancel")
+				return tc.cancelErr
+			}
+			newNonce := tc.newOrder.Nonce()
+			resp := &poolrpc.SubmitOrderResponse{
+				Details: &poolrpc.SubmitOrderResponse_AcceptedOrderNonce{
+					AcceptedOrderNonce: newNonce[:],
+				},
+			}
+			submitOrder := func(_ context.Context,
+				o order.Order) (*poolrpc.SubmitOrderResponse,
+				error) {
+
+				require.Equal(t, newNonce, o.Nonce())
+				calls = append(calls, "submit")
+				if tc.submitErr != nil {
+					return nil, tc.submitErr
+				}
+				return resp, nil
+			}
+
+			var expectedCalls []string
+			if tc.expectCancel {
+				expectedCalls = append(expectedCalls, "cancel")
+			}
+			if tc.expectSubmit {
+				expectedCalls = append(expectedCalls, "submit")
+			}
+
+			result, err := replaceOrder(
+				context.Background(), tc.oldOrder, tc.newOrder,
+				cancelOrder, submitOrder,
+			)
+			require.Equal(t, expectedCalls, calls)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, resp, result)
+		})
+	}
+}
+
+// TestRestoreAccountSecrets makes sure the secrets of all accounts of an
+// imported state are derived again, but only if the trader keys belong to the
+// connected lnd node.
+func TestRestoreAccountSecrets(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	traderPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	foreignPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	auctioneerPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	keyLocator := keychain.KeyLocator{
+		Family: poolscript.AccountKeyFamily,
+		Index:  3,
+	}
+	newState := func(traderKey *btcec.PublicKey) *clientdb.StateExport {
+		newAccount := func() *account.Account {
+			return &account.Account{
+				TraderKey: &keychain.KeyDescriptor{
+					KeyLocator: keyLocator,
+					PubKey:     traderKey,
+				},
+				AuctioneerKey: auctioneerPriv.PubKey(),
+			}
+		}
+
+		var rawKey [33]byte
+		copy(rawKey[:], traderKey.SerializeCompressed())
+		snapshot := &clientdb.LocalBatchSnapshot{
+			Accounts: map[[33]byte]*account.Account{
+				rawKey: newAccount(),
+			},
+		}
+		return &clientdb.StateExport{
+			Accounts: []*account.Account{newAccount()},
+			BatchSnapshots: []*clientdb.ExportedBatchSnapshot{{
+				LocalBatchSnapshot: snapshot,
+			}},
+		}
+	}
+
+	secret := [32]byte{1, 2, 3}
+	wallet := test.NewMockWalletKitClient(mockCtrl)
+	signer := test.NewMockSignerClient(mockCtrl)
+
+	// The key and secret are derived only once, even though the account is
+	// also part of a batch snapshot.
+	wallet.EXPECT().DeriveKey(gomock.Any(), &keyLocator).Return(
+		&keychain.KeyDescriptor{
+			KeyLocator: keyLocator,
+			PubKey:     traderPriv.PubKey(),
+		}, nil,
+	).Times(2)
+	signer.EXPECT().DeriveSharedKey(
+		gomock.Any(), auctioneerPriv.PubKey(), &keyLocator,
+	).Return(secret, nil)
+
+	ctx := context.Background()
+	state := newState(traderPriv.PubKey())
+	require.NoError(t, restoreAccountSecrets(ctx, wallet, signer, state))
+	err = state.ForEachAccount(func(acct *account.Account) error {
+		require.Equal(t, secret, acct.Secret)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// A state with a trader key the lnd node doesn't know is rejected.
+	state = newState(foreignPriv.PubKey())
+	err = restoreAccountSecrets(ctx, wallet, signer, state)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not derived by the connected lnd")
+}
+
+// recordingFeeEstimator is a fee estimator that returns a fixed fee rate and
+// records the confirmation targets it was queried for.
+type recordingFeeEstimator struct {
+	feeRate     chainfee.SatPerKWeight
+	confTargets []int32
+}
+
+// EstimateFeeRate returns the fixed fee rate and records the confirmation
+// target.
+func (e *recordingFeeEstimator) EstimateFeeRate(_ context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	e.confTargets = append(e.confTargets, confTarget)
+	return e.feeRate, nil
+}
+
+// TestCloseAccountFees makes sure the fee rate of a closing transaction is
+// either estimated for the requested confirmation target or set explicitly
+// and that the funds are sent to the requested address of the correct network.
+func TestCloseAccountFees(t *testing.T) {
+	t.Parallel()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	params := &chaincfg.RegressionNetParams
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), params,
+	)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	mainnetAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		outputWithFee   *poolrpc.OutputWithFee
+		expectedFeeExpr account.FeeExpr
+		expectedTargets []int32
+		expectedErr     string
+	}{{
+		name: "conf target to address",
+		outputWithFee: &poolrpc.OutputWithFee{
+			Address: addr.String(),
+			Fees: &poolrpc.OutputWithFee_ConfTarget{
+				ConfTarget: 6,
+			},
+		},
+		expectedFeeExpr: &account.OutputWithFee{
+			PkScript: pkScript,
+			FeeRate:  2000,
+		},
+		expectedTargets: []int32{6},
+	}, {
+		name: "fee rate to wallet",
+		outputWithFee: &poolrpc.OutputWithFee{
+			Fees: &poolrpc.OutputWithFee_FeeRateSatPerKw{
+				FeeRateSatPerKw: 1000,
+			},
+		},
+		expectedFeeExpr: &account.OutputWithFee{
+			FeeRate: 1000,
+		},
+	}, {
+		name: "address of wrong network",
+		outputWithFee: &poolrpc.OutputWithFee{
+			Address: mainnetAddr.String(),
+			Fees: &poolrpc.OutputWithFee_ConfTarget{
+				ConfTarget: 6,
+			},
+		},
+		expectedErr: "invalid address",
+	}, {
+		name: "fee rate below floor",
+		outputWithFee: &poolrpc.OutputWithFee{
+			Fees: &poolrpc.OutputWithFee_FeeRateSatPerKw{
+				FeeRateSatPerKw: 100,
+			},
+		},
+		expectedErr: "too low",
+	}, {
+		name:          "no fee",
+		outputWithFee: &poolrpc.OutputWithFee{},
+		expectedErr:   "either a confirmation target or fee rate",
+	}}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			estimator := &recordingFeeEstimator{feeRate: 2000}
+			accountMgr := account.NewMockManager(mockCtrl)
+			srv := &rpcServer{
+				accountManager: accountMgr,
+				feeEstimator:   estimator,
+				lndServices: &lndclient.LndServices{
+					ChainParams: params,
+				},
+				server: &Server{
+					db: db,
+				},
+			}
+			srv.bestHeight = 100
+
+			if tc.expectedErr == "" {
+				accountMgr.EXPECT().CloseAccount(
+					gomock.Any(), gomock.Any(),
+					tc.expectedFeeExpr, uint32(100),
+				).Return(&wire.MsgTx{}, nil)
+			}
+
+			_, err := srv.CloseAccount(
+				context.Background(),
+				&poolrpc.CloseAccountRequest{
+					TraderKey: traderKeyRaw,
+					FundsDestination: &poolrpc.CloseAccountRequest_OutputWithFee{
+						OutputWithFee: tc.outputWithFee,
+					},
+				},
+			)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTargets, estimator.confTargets)
+		})
+	}
+}
+
+// confTargetFeeEstimator is a fee estimator that returns a distinct fee rate
+// for each known confirmation target.
+type confTargetFeeEstimator map[int32]chainfee.SatPerKWeight
+
+// EstimateFeeRate returns the fee rate of the confirmation target or an error
+// if the target is unknown.
+func (e confTargetFeeEstimator) EstimateFeeRate(_ context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	feeRate, ok := e[confTarget]
+	if !ok {
+		return 0, fmt.Errorf("no estimate for %d blocks", confTarget)
+	}
+
+	return feeRate, nil
+}
+
+// TestSimulateAccountFees makes sure the fees of all account actions are
+// estimated with the fee rate of each requested confirmation target.
+func TestSimulateAccountFees(t *testing.T) {
+	t.Parallel()
+
+	estimator := confTargetFeeEstimator{
+		2:   20_000,
+		6:   5_000,
+		12:  2_500,
+		144: 253,
+	}
+	srv := &rpcServer{
+		accountVersion: account.VersionTaprootEnabled,
+		feeEstimator:   estimator,
+	}
+	ctx := context.Background()
+
+	expectedFee := func(action account.Action, version account.Version,
+		feeRate chainfee.SatPerKWeight) uint64 {
+
+		weight, err := account.EstimateActionWeight(action, version)
+		require.NoError(t, err)
+
+		return uint64(feeRate.FeeForWeight(weight))
+	}
+
+	// Without any targets, the default targets are used.
+	resp, err := srv.SimulateAccountFees(
+		ctx, &poolrpc.SimulateAccountFeesRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Simulations, len(defaultSimulationConfTargets))
+
+	for idx, sim := range resp.Simulations {
+		confTarget := defaultSimulationConfTargets[idx]
+		feeRate := estimator[int32(confTarget)]
+
+		require.Equal(t, confTarget, sim.ConfTarget)
+		require.EqualValues(t, feeRate, sim.FeeRateSatPerKw)
+
+		version := account.VersionTaprootEnabled
+		require.Equal(
+			t, expectedFee(account.CREATE, version, feeRate),
+			sim.OpenFeeSat,
+		)
+		require.Equal(
+			t, expectedFee(account.DEPOSIT, version, feeRate),
+			sim.DepositFeeSat,
+		)
+		require.Equal(
+			t, expectedFee(account.WITHDRAW, version, feeRate),
+			sim.WithdrawFeeSat,
+		)
+		require.Equal(
+			t, expectedFee(account.CLOSE, version, feeRate),
+			sim.CloseFeeSat,
+		)
+
+		// A deposit spends an additional wallet input, so it's more
+		// expensive than a withdrawal, which re-creates the account
+		// and is therefore more expensive than closing it.
+		require.Greater(t, sim.DepositFeeSat, sim.WithdrawFeeSat)
+		require.Greater(t, sim.WithdrawFeeSat, sim.CloseFeeSat)
+	}
+
+	// Legacy accounts have larger inputs than Taproot accounts.
+	resp, err = srv.SimulateAccountFees(
+		ctx, &poolrpc.SimulateAccountFeesRequest{
+			ConfTargets:    []uint32{6},
+			AccountVersion: poolrpc.AccountVersion_ACCOUNT_VERSION_LEGACY,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Simulations, 1)
+	require.Equal(
+		t, expectedFee(
+			account.CLOSE, account.VersionInitialNoVersion, 5_000,
+		), resp.Simulations[0].CloseFeeSat,
+	)
+	require.Greater(
+		t, resp.Simulations[0].CloseFeeSat, expectedFee(
+			account.CLOSE, account.VersionTaprootEnabled, 5_000,
+		),
+	)
+
+	// Invalid targets and failed estimates are reported.
+	_, err = srv.SimulateAccountFees(
+		ctx, &poolrpc.SimulateAccountFeesRequest{
+			ConfTargets: []uint32{0},
+		},
+	)
+	require.ErrorContains(t, err, "must be greater than 0")
+
+	_, err = srv.SimulateAccountFees(
+		ctx, &poolrpc.SimulateAccountFeesRequest{
+			ConfTargets: []uint32{6, 3},
+		},
+	)
+	require.ErrorContains(t, err, "no estimate for 3 blocks")
+}
+
+// TestExportAccountKey makes sure the exported key locator and derivation path
+// are the ones the account's trader key was derived with.
+func TestExportAccountKey(t *testing.T) {
+	t.Parallel()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// The mock wallet derives the key of a locator from its index only.
+	_, traderKey := test.CreateKey(5)
+	acct := newOutputTypeTestAccount(t, account.VersionTaprootEnabled)
+	acct.TraderKey = &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: poolscript.AccountKeyFamily,
+			Index:  5,
+		},
+		PubKey: traderKey,
+	}
+	require.NoError(t, db.AddAccount(acct))
+
+	// An account whose key doesn't match its locator can't be exported.
+	foreignAcct := newOutputTypeTestAccount(
+		t, account.VersionTaprootEnabled,
+	)
+	foreignAcct.TraderKey.KeyLocator = keychain.KeyLocator{
+		Family: poolscript.AccountKeyFamily,
+		Index:  6,
+	}
+	require.NoError(t, db.AddAccount(foreignAcct))
+
+	srv := &rpcServer{
+		lndServices: &lndclient.LndServices{
+			WalletKit:   test.NewMockWalletKit(),
+			ChainParams: &chaincfg.RegressionNetParams,
+		},
+		server: &Server{db: db},
+	}
+
+	ctx := context.Background()
+	resp, err := srv.ExportAccountKey(ctx, &poolrpc.ExportAccountKeyRequest{
+		TraderKey: traderKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, traderKey.SerializeCompressed(), resp.TraderKey)
+	require.EqualValues(t, poolscript.AccountKeyFamily, resp.KeyFamily)
+	require.EqualValues(t, 5, resp.KeyIndex)
+	require.Equal(t, "m/1017'/1'/220'/0/5", resp.DerivationPath)
+
+	_, err = srv.ExportAccountKey(ctx, &poolrpc.ExportAccountKeyRequest{
+		TraderKey: foreignAcct.TraderKey.PubKey.SerializeCompressed(),
+	})
+	require.ErrorContains(t, err, "was not derived by the connected lnd")
+
+	_, unknownKey := test.CreateKey(7)
+	_, err = srv.ExportAccountKey(ctx, &poolrpc.ExportAccountKeyRequest{
+		TraderKey: unknownKey.SerializeCompressed(),
+	})
+	require.ErrorContains(t, err, "unable to fetch account")
+
+	// Mainnet keys are derived with a different coin type.
+	require.Equal(
+		t, "m/1017'/0'/220'/0/5", accountKeyDerivationPath(
+			&chaincfg.MainNetParams, acct.TraderKey.KeyLocator,
+		),
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -25,18 +27,52 @@ import (
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/perms"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
+const (
+	// maxClockSkewBehind is the maximum time the local clock can be
+	// behind the timestamp of the best block before we consider it to be
+	// skewed.
+	maxClockSkewBehind = 2 * time.Hour
+
+	// maxClockSkewAhead is the maximum time the local clock can be ahead
+	// of the timestamp of the best block while lnd is synced to the chain
+	// before we consider it to be skewed.
+	maxClockSkewAhead = 6 * time.Hour
+
+	// chainSyncPollInterval is the interval in which lnd is asked about
+	// its chain sync progress while we wait for it to be synced.
+	chainSyncPollInterval = 5 * time.Second
+
+	// macaroonPollInterval is the interval in which we check whether the
+	// lnd macaroon file exists while we wait for it to appear.
+	macaroonPollInterval = time.Second
+
+	// signerSelfTestMsg is the message that is signed with an account key
+	// to verify lnd's signer works.
+	signerSelfTestMsg = "pool signer self-test"
+
+	// signerSelfTestPermissions are the lnd macaroon permissions the
+	// signer self-test requires.
+	signerSelfTestPermissions = "address:read signer:generate signer:read"
+)
+
 var (
 	// minimalCompatibleVersion is the minimum version and build tags
 	// required in lnd to run pool.
@@ -76,23 +112,38 @@ type Server struct {
 	cfg             *Config
 	db              *clientdb.DB
 	fundingManager  *funding.Manager
+	channelAcceptor *ChannelAcceptor
 	sidecarAcceptor *SidecarAcceptor
-	lsatStore       *lsat.FileStore
+	batchLimiter    *batchLimiter
+	lsatStore       *lsatFileStore
+	lndConn         *lndConnection
 	lndServices     *lndclient.GrpcLndServices
 	lndClient       lnrpc.LightningClient
+	walletKitClient walletrpc.WalletKitClient
 	grpcServer      *grpc.Server
 	restProxy       *http.Server
 	grpcListener    net.Listener
 	restListener    net.Listener
 	restCancel      func()
 	macaroonService *lndclient.MacaroonService
+	stopTracing     func() error
+	tlsCert         *x509.Certificate
+	clock           clock.Clock
 	wg              sync.WaitGroup
+
+	// maxBatchVersion is the latest batch version the auction server
+	// supports if it is older than the version we support, nil otherwise.
+	maxBatchVersion    *order.BatchVersion
+	maxBatchVersionMtx sync.Mutex
 }
 
 // NewServer creates a new trader server.
 func NewServer(cfg *Config) *Server {
+	clk := clock.NewDefaultClock()
 	return &Server{
-		cfg: cfg,
+		cfg:     cfg,
+		clock:   clk,
+		lndConn: newLndConnection(clk),
 	}
 }
 
@@ -106,6 +157,14 @@ func (s *Server) Start() error {
 	// Print the version before executing either primary directive.
 	log.Infof("Version: %v", Version())
 
+	// Pool creates its own TLS certificate and macaroon, so we warn if
+	// those could end up next to or overwrite the ones of lnd. The config
+	// is validated before the loggers are set up, so we can only do this
+	// here.
+	if err := validateLndDirOverlap(s.cfg); err != nil {
+		log.Warnf("Possible directory overlap with lnd: %v", err)
+	}
+
 	// Depending on how far we got in initializing the server, we might need
 	// to clean up certain services that were already started. Keep track of
 	// them with this map of service name to shutdown function.
@@ -131,12 +190,17 @@ func (s *Server) Start() error {
 		return nil
 	}
 
+	// A badly wrong system clock can lead to our TLS certificate being
+	// regenerated or looking expired to others, so we warn the user about
+	// it before we load the certificate.
+	s.warnClockSkew()
+
 	// As there're some other lower-level operations we may need access to,
 	// we'll also make a connection for a "basic client".
 	//
 	// TODO(roasbeef): more granular macaroons, can ask user to make just
 	// what we need
-	s.lndClient, err = lndclient.NewBasicClient(
+	basicConn, err := lndclient.NewBasicConn(
 		s.cfg.Lnd.Host, s.cfg.Lnd.TLSPath,
 		path.Dir(s.cfg.Lnd.MacaroonPath), s.cfg.Network,
 		lndclient.MacFilename(path.Base(s.cfg.Lnd.MacaroonPath)),
@@ -144,33 +208,52 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+	s.lndClient = lnrpc.NewLightningClient(basicConn)
+
+	// The wallet kit client of lndclient doesn't expose the anchor reserve
+	// yet, so we need our own client for that call.
+	s.walletKitClient = walletrpc.NewWalletKitClient(basicConn)
 
 	// Create and start the macaroon service and let it create its default
-	// macaroon in case it doesn't exist yet.
-	s.macaroonService, err = lndclient.NewMacaroonService(
-		&lndclient.MacaroonServiceConfig{
-			DBPath:           s.cfg.BaseDir,
-			DBTimeout:        clientdb.DefaultPoolDBTimeout,
-			MacaroonLocation: poolMacaroonLocation,
-			MacaroonPath:     s.cfg.MacaroonPath,
-			Checkers: []macaroons.Checker{
-				macaroons.IPLockChecker,
+	// macaroon in case it doesn't exist yet. If macaroons are disabled, we
+	// don't create any macaroon at all.
+	if s.cfg.NoMacaroons {
+		log.Warnf("Macaroon authentication disabled, all RPC calls " +
+			"are allowed without a macaroon")
+	} else {
+		s.macaroonService, err = lndclient.NewMacaroonService(
+			&lndclient.MacaroonServiceConfig{
+				DBPath:           s.cfg.BaseDir,
+				DBTimeout:        clientdb.DefaultPoolDBTimeout,
+				MacaroonLocation: poolMacaroonLocation,
+				MacaroonPath:     s.cfg.MacaroonPath,
+				Checkers: []macaroons.Checker{
+					macaroons.IPLockChecker,
+				},
+				RequiredPerms: perms.RequiredPermissions,
+				DBPassword:    macDbDefaultPw,
+				LndClient:     &s.lndServices.LndServices,
+				EphemeralKey:  lndclient.SharedKeyNUMS,
+				KeyLocator:    lndclient.SharedKeyLocator,
 			},
-			RequiredPerms: perms.RequiredPermissions,
-			DBPassword:    macDbDefaultPw,
-			LndClient:     &s.lndServices.LndServices,
-			EphemeralKey:  lndclient.SharedKeyNUMS,
-			KeyLocator:    lndclient.SharedKeyLocator,
-		},
-	)
-	if err != nil {
-		return err
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := s.macaroonService.Start(); err != nil {
+			return err
+		}
+		shutdownFuncs["macaroon"] = s.macaroonService.Stop
 	}
 
-	if err := s.macaroonService.Start(); err != nil {
+	// Tracing needs to be set up before the auctioneer client so its
+	// connection can be instrumented.
+	s.stopTracing, err = setupTracing(context.Background(), s.cfg.Tracing)
+	if err != nil {
 		return err
 	}
-	shutdownFuncs["macaroon"] = s.macaroonService.Stop
+	shutdownFuncs["tracing"] = s.stopTracing
 
 	// Setup the auctioneer client and interceptor.
 	err = s.setupClient()
@@ -191,33 +274,22 @@ func (s *Server) Start() error {
 	}
 	shutdownFuncs["rpcServer"] = s.rpcServer.Stop
 
-	// Let's create our interceptor chain, starting with the security
-	// interceptors that will check macaroons for their validity.
-	unaryMacIntercept, streamMacIntercept, err := s.macaroonService.Interceptors()
+	serverOpts, err := s.interceptorServerOptions()
 	if err != nil {
-		return fmt.Errorf("error with macaroon interceptor: %v", err)
-	}
-
-	serverOpts := []grpc.ServerOption{
-		grpc.ChainStreamInterceptor(
-			errorLogStreamServerInterceptor(rpcLog),
-			streamMacIntercept,
-		),
-		grpc.ChainUnaryInterceptor(
-			errorLogUnaryServerInterceptor(rpcLog),
-			unaryMacIntercept,
-		),
+		return err
 	}
+	serverOpts = append(serverOpts, msgSizeServerOptions(s.cfg)...)
 	s.grpcServer = grpc.NewServer(serverOpts...)
 	poolrpc.RegisterTraderServer(s.grpcServer, s.rpcServer)
 
 	// We'll need to start the server with TLS and connect the REST proxy
 	// client to it.
-	serverTLSCfg, restClientCreds, err := getTLSConfig(s.cfg)
+	serverTLSCfg, restClientCreds, err := getTLSConfig(s.cfg, s.clock)
 	if err != nil {
 		return fmt.Errorf("could not create gRPC server options: %v",
 			err)
 	}
+	s.tlsCert = serverTLSCfg.Certificates[0].Leaf
 
 	// Next, start the gRPC server listening for HTTP/2 connections.
 	// If the provided grpcListener is not nil, it means poold is being
@@ -254,6 +326,10 @@ func (s *Server) Start() error {
 		mux := proxy.NewServeMux(customMarshalerOption)
 		proxyOpts := []grpc.DialOption{
 			grpc.WithTransportCredentials(*restClientCreds),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(s.cfg.ServerMaxSendMsgSize),
+				grpc.MaxCallSendMsgSize(s.cfg.ServerMaxRecvMsgSize),
+			),
 		}
 
 		// With TLS enabled by default, we cannot call 0.0.0.0
@@ -326,6 +402,8 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	s.logStartupDiagnostics()
+
 	// If we got here successfully, there's no need to shutdown anything
 	// anymore.
 	shutdownFuncs = nil
@@ -361,7 +439,7 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 		}
 	}()
 
-	if withMacaroonService {
+	if withMacaroonService && !s.cfg.NoMacaroons {
 		// Create and start the macaroon service and let it create its
 		// default macaroon in case it doesn't exist yet.
 		var err error
@@ -391,8 +469,17 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 		shutdownFuncs["macaroon"] = s.macaroonService.Stop
 	}
 
+	// Tracing needs to be set up before the auctioneer client so its
+	// connection can be instrumented.
+	var err error
+	s.stopTracing, err = setupTracing(context.Background(), s.cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	shutdownFuncs["tracing"] = s.stopTracing
+
 	// Setup the auctioneer client and interceptor.
-	err := s.setupClient()
+	err = s.setupClient()
 	if err != nil {
 		return err
 	}
@@ -417,6 +504,8 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 		return err
 	}
 
+	s.logStartupDiagnostics()
+
 	// If we got here successfully, there's no need to shutdown anything
 	// anymore.
 	shutdownFuncs = nil
@@ -429,10 +518,23 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 // method are contained within and finally ensures all caveat conditions are
 // met. A non-nil error is returned if any of the checks fail. This method is
 // needed to enable poold running as an external subserver in the same process
-// as lnd but still validate its own macaroons.
+// as lnd but still validate its own macaroons. Our own interceptors don't run
+// in that mode, so calls to disabled RPCs are refused here as well.
 func (s *Server) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op, fullMethod string) error {
 
+	disabledRPCs, err := s.cfg.DisabledRPCMethods()
+	if err != nil {
+		return err
+	}
+	if _, ok := disabledRPCs[fullMethod]; ok {
+		return disabledRPCError(fullMethod)
+	}
+
+	if s.cfg.NoMacaroons {
+		return nil
+	}
+
 	if s.macaroonService == nil {
 		return fmt.Errorf("macaroon service has not been initialised")
 	}
@@ -446,8 +548,11 @@ func (s *Server) ValidateMacaroon(ctx context.Context,
 // setupClient initializes the auctioneer client and its interceptors.
 func (s *Server) setupClient() error {
 	// If no auction server is specified, use the default addresses for
-	// mainnet and testnet.
-	if s.cfg.AuctionServer == "" && len(s.cfg.AuctioneerDialOpts) == 0 {
+	// mainnet and testnet. We don't need an address if we were given a
+	// client to use.
+	if s.cfg.AuctionServer == "" && len(s.cfg.AuctioneerDialOpts) == 0 &&
+		s.cfg.AuctioneerClient == nil {
+
 		switch s.cfg.Network {
 		case "mainnet":
 			s.cfg.AuctionServer = MainnetServer
@@ -462,7 +567,7 @@ func (s *Server) setupClient() error {
 
 	// Open the main database.
 	var err error
-	s.db, err = clientdb.New(s.cfg.BaseDir, clientdb.DBFilename)
+	s.db, err = clientdb.Open(s.cfg.DatabaseConfig())
 	if err != nil {
 		return err
 	}
@@ -474,11 +579,33 @@ func (s *Server) setupClient() error {
 	}
 
 	// Setup the LSAT interceptor for the client.
-	s.lsatStore, err = lsat.NewFileStore(s.cfg.BaseDir)
+	s.lsatStore, err = newLsatFileStore(s.cfg.BaseDir)
 	if err != nil {
 		return err
 	}
 
+	// The paid token is reused across restarts until the auction server
+	// rejects it, only then a new one is paid for. Paying for it must not
+	// take longer than the configured timeout. Servers that don't issue an
+	// LSAT challenge at all are used without paying for a token.
+	//
+	// For any net that isn't mainnet, we allow LSAT auth to be disabled and
+	// create a fixed identity that is used for the whole runtime of the
+	// trader instead.
+	lsatLnd := s.lndServices.LndServices
+	if s.cfg.LsatPaymentTimeout > 0 || s.cfg.LsatMaxRoutingFeePPM > 0 {
+		lsatLnd.Client = newLsatPaymentClient(
+			lsatLnd.Client, s.cfg.LsatPaymentTimeout,
+			s.cfg.LsatMaxRoutingFeePPM, lsatLnd.ChainParams,
+		)
+	}
+	var interceptor Interceptor = newLsatRenewInterceptor(
+		lsat.NewInterceptor(
+			&lsatLnd, s.lsatStore, defaultRPCTimeout,
+			defaultLsatMaxCost, s.cfg.LsatMaxRoutingFee, false,
+		), s.lsatStore,
+	)
+
 	// GetIdentity can be used to determine the current LSAT identification
 	// of the trader.
 	s.GetIdentity = func() (*lsat.TokenID, error) {
@@ -495,13 +622,6 @@ func (s *Server) setupClient() error {
 		return &macID.TokenID, nil
 	}
 
-	// For any net that isn't mainnet, we allow LSAT auth to be disabled and
-	// create a fixed identity that is used for the whole runtime of the
-	// trader instead.
-	var interceptor Interceptor = lsat.NewInterceptor(
-		&s.lndServices.LndServices, s.lsatStore, defaultRPCTimeout,
-		defaultLsatMaxCost, s.cfg.LsatMaxRoutingFee, false,
-	)
 	if s.cfg.FakeAuth && s.cfg.Network == "mainnet" {
 		return fmt.Errorf("cannot use fake LSAT auth for mainnet")
 	}
@@ -529,21 +649,46 @@ func (s *Server) setupClient() error {
 			),
 		),
 	)
+	if s.cfg.LogAuctioneerPayloads {
+		s.cfg.AuctioneerDialOpts = append(
+			s.cfg.AuctioneerDialOpts, payloadLogDialOpts(
+				activeLoggers[auctioneer.Subsystem],
+			)...,
+		)
+	}
+	if s.cfg.Tracing != nil && s.cfg.Tracing.Enable {
+		s.cfg.AuctioneerDialOpts = append(
+			s.cfg.AuctioneerDialOpts, tracingDialOpts()...,
+		)
+	}
+
+	// Both the RPC server and the sidecar acceptor execute batches, so
+	// they share the limit of concurrent batch steps.
+	s.batchLimiter = newBatchLimiter(s.cfg.MaxConcurrentBatches)
 
 	// Create the funding manager. The RPC server is responsible for
 	// starting/stopping it though as all that logic is currently there for
 	// the other managers as well.
-	channelAcceptor := NewChannelAcceptor(s.lndServices.Client)
+	s.channelAcceptor = NewChannelAcceptor(
+		s.lndServices.Client, ChannelAcceptorConfig{
+			MinConfs:                  s.cfg.MinChanConfs,
+			RejectExternalDuringBatch: s.cfg.RejectExternalChans,
+			BatchExecutionWindow:      s.cfg.RejectExternalChansWindow,
+			MinBackoff:                s.cfg.MinBackoff,
+			MaxBackoff:                s.cfg.MaxBackoff,
+		},
+	)
 	s.fundingManager = funding.NewManager(&funding.ManagerConfig{
-		DB:                s.db,
-		WalletKit:         s.lndServices.WalletKit,
-		LightningClient:   s.lndServices.Client,
-		SignerClient:      s.lndServices.Signer,
-		BaseClient:        s.lndClient,
-		NodePubKey:        nodePubKey,
-		BatchStepTimeout:  order.DefaultBatchStepTimeout,
-		NewNodesOnly:      s.cfg.NewNodesOnly,
-		NotifyShimCreated: channelAcceptor.ShimRegistered,
+		DB:                     s.db,
+		WalletKit:              s.lndServices.WalletKit,
+		LightningClient:        s.lndServices.Client,
+		SignerClient:           s.lndServices.Signer,
+		BaseClient:             s.lndClient,
+		NodePubKey:             nodePubKey,
+		BatchStepTimeout:       order.DefaultBatchStepTimeout,
+		NewNodesOnly:           s.cfg.NewNodesOnly,
+		DuplicateChannelPolicy: s.cfg.DuplicateChannelPolicy,
+		NotifyShimCreated:      s.channelAcceptor.ShimRegistered,
 	})
 
 	batchVersion, err := s.determineBatchVersion()
@@ -551,13 +696,30 @@ func (s *Server) setupClient() error {
 		return err
 	}
 
+	// Accounts of the configured output type must be supported with the
+	// batch version we're going to use with the auction server. A
+	// configured account version overrides the output type and is
+	// checked once we're connected.
+	if s.cfg.AccountVersion < 0 {
+		err = validateAccountOutputType(
+			s.cfg.AccountOutputType, batchVersion,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create an instance of the auctioneer client library.
 	clientCfg := &auctioneer.Config{
 		ServerAddress: s.cfg.AuctionServer,
 		ProxyAddress:  s.cfg.Proxy,
+		ProxyBypass:   s.cfg.ProxyBypass,
 		Insecure:      s.cfg.Insecure,
 		TLSPathServer: s.cfg.TLSPathAuctSrv,
 		DialOpts:      s.cfg.AuctioneerDialOpts,
+		Metadata:      s.cfg.AuctioneerMetadata,
+		ClientAlias:   s.cfg.ClientAlias,
+		Compression:   s.cfg.AuctioneerCompression,
 		Signer:        s.lndServices.Signer,
 		MinBackoff:    s.cfg.MinBackoff,
 		MaxBackoff:    s.cfg.MaxBackoff,
@@ -567,22 +729,30 @@ func (s *Server) setupClient() error {
 		GenUserAgent: func(ctx context.Context) string {
 			return UserAgent(InitiatorFromContext(ctx))
 		},
+		ConnectionStateCallback: s.cfg.ConnectionStateCallback,
+		AuctioneerClient:        s.cfg.AuctioneerClient,
+		HashMailClient:          s.cfg.HashMailClient,
+		OrderSubmitRetries:      s.cfg.OrderSubmitRetries,
+		OrderSubmitRetryBackoff: s.cfg.OrderSubmitRetryBackoff,
 	}
 
 	// Create the acceptors for receiving sidecar channels. We need to
 	// create a copy of the auctioneer client configuration because the
-	// acceptor is going to overwrite some of its values.
+	// acceptor is going to overwrite some of its values. The connection
+	// state callback only reports the state of the main connection.
 	clientCfgCopy := *clientCfg
+	clientCfgCopy.ConnectionStateCallback = nil
 	s.sidecarAcceptor = NewSidecarAcceptor(&SidecarAcceptorConfig{
 		SidecarDB:      s.db,
 		AcctDB:         &accountStore{DB: s.db},
 		Signer:         s.lndServices.Signer,
 		Wallet:         s.lndServices.WalletKit,
 		BaseClient:     s.lndClient,
-		Acceptor:       channelAcceptor,
+		Acceptor:       s.channelAcceptor,
 		NodePubKey:     nodePubKey,
 		ClientCfg:      clientCfgCopy,
 		FundingManager: s.fundingManager,
+		BatchLimiter:   s.batchLimiter,
 		PrepareOrder: func(ctx context.Context,
 			order order.Order,
 			acct *account.Account,
@@ -615,6 +785,17 @@ func (s *Server) Stop() error {
 
 	var shutdownErr error
 
+	// Stop accepting new RPCs first but give the ones that are currently
+	// in-flight a chance to complete while all subsystems are still
+	// running. The gRPC server might be nil if started as a subserver.
+	if s.grpcServer != nil {
+		drainGrpcServer(s.grpcServer, s.cfg.ShutdownDrainTimeout)
+	}
+
+	// Cancel the active orders while we're still connected to the auction
+	// server, before we stop any of the subsystems.
+	s.cancelOrdersOnShutdown()
+
 	// Don't return any errors yet, give everything else a chance to shut
 	// down first.
 	err := s.AuctioneerClient.Stop()
@@ -626,11 +807,6 @@ func (s *Server) Stop() error {
 		shutdownErr = err
 	}
 
-	// The gRPC server might be nil if started as a subserver.
-	if s.grpcServer != nil {
-		s.grpcServer.GracefulStop()
-	}
-
 	if s.restCancel != nil {
 		s.restCancel()
 	}
@@ -651,21 +827,312 @@ func (s *Server) Stop() error {
 	s.lndServices.Close()
 	s.wg.Wait()
 
+	// Flush the remaining spans last so the shutdown is traced as well.
+	if s.stopTracing != nil {
+		if err := s.stopTracing(); err != nil {
+			log.Errorf("Error shutting down tracing: %v", err)
+		}
+	}
+
 	if shutdownErr != nil {
 		return fmt.Errorf("error shutting down server: %v", shutdownErr)
 	}
 	return nil
 }
 
+// cancelOrdersOnShutdown cancels all active orders if the trader is configured
+// to do so on shutdown, so it isn't matched while offline. Canceling is
+// aborted once the configured timeout is reached.
+func (s *Server) cancelOrdersOnShutdown() {
+	if !s.cfg.CancelOrdersOnShutdown || s.rpcServer == nil {
+		return
+	}
+
+	log.Infof("Canceling all active orders before shutting down")
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), s.cfg.CancelOrdersShutdownTimeout,
+	)
+	defer cancel()
+
+	if err := s.rpcServer.cancelActiveOrders(ctx); err != nil {
+		log.Errorf("Error canceling orders on shutdown: %v", err)
+	}
+}
+
+// drainGrpcServer gracefully stops the given gRPC server, which rejects all new
+// RPCs but waits for the pending ones to complete. If they don't complete
+// within the given timeout, the server is stopped forcefully, canceling all
+// remaining RPCs. A timeout of 0 waits for the pending RPCs without a limit.
+func drainGrpcServer(server *grpc.Server, timeout time.Duration) {
+	if timeout == 0 {
+		server.GracefulStop()
+		return
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		log.Debugf("All in-flight RPCs completed")
+
+	case <-time.After(timeout):
+		log.Warnf("In-flight RPCs did not complete within %v, "+
+			"forcing gRPC server to stop", timeout)
+		server.Stop()
+		<-drained
+	}
+}
+
+// warnClockSkew logs a warning if the local clock appears to be skewed compared
+// to the timestamp of the best block known to lnd.
+func (s *Server) warnClockSkew() {
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), getInfoTimeout,
+	)
+	defer cancel()
+
+	info, err := s.lndServices.Client.GetInfo(ctxt)
+	if err != nil {
+		log.Debugf("Unable to fetch lnd info for clock skew check: %v",
+			err)
+		return
+	}
+
+	if err := checkClockSkew(s.clock, s.cfg.Network, info); err != nil {
+		log.Warnf("Possible clock skew detected, please check the "+
+			"system time: %v", err)
+	}
+}
+
+// waitForChainSync blocks until lnd reports to be synced to the chain, logging
+// the sync progress every poll interval. If the timeout is non-zero, an error
+// is returned if lnd doesn't become synced within that time.
+func waitForChainSync(ctx context.Context, lnd lndclient.LightningClient,
+	pollInterval, timeout time.Duration) error {
+
+	if timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ctxt, cancel := context.WithTimeout(ctx, getInfoTimeout)
+		info, err := lnd.GetInfo(ctxt)
+		cancel()
+
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Warnf("Unable to fetch lnd chain sync status: %v",
+				err)
+
+		case err == nil && info.SyncedToChain:
+			log.Infof("lnd is synced to chain at height %d",
+				info.BlockHeight)
+			return nil
+
+		case err == nil:
+			log.Infof("Waiting for lnd to sync to chain, current "+
+				"height %d, best block timestamp %v",
+				info.BlockHeight, info.BestHeaderTimeStamp)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("lnd not synced to chain "+
+					"after %v", timeout)
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+// verifyLndSigner makes sure lnd's signer can be used with our account keys by
+// deriving the first account key, signing a test message with it and verifying
+// the resulting signature. This catches an lnd macaroon that lacks the signer
+// permissions before any order is accepted.
+func verifyLndSigner(ctx context.Context, wallet lndclient.WalletKitClient,
+	signer lndclient.SignerClient) error {
+
+	ctxt, cancel := context.WithTimeout(ctx, getInfoTimeout)
+	defer cancel()
+
+	keyDesc, err := wallet.DeriveKey(ctxt, &keychain.KeyLocator{
+		Family: poolscript.AccountKeyFamily,
+	})
+	if err != nil {
+		return signerSelfTestError("unable to derive account key", err)
+	}
+
+	sig, err := signer.SignMessage(
+		ctxt, []byte(signerSelfTestMsg), keyDesc.KeyLocator,
+	)
+	if err != nil {
+		return signerSelfTestError("unable to sign message", err)
+	}
+
+	var pubKey [33]byte
+	copy(pubKey[:], keyDesc.PubKey.SerializeCompressed())
+	valid, err := signer.VerifyMessage(
+		ctxt, []byte(signerSelfTestMsg), sig, pubKey,
+	)
+	if err != nil {
+		return signerSelfTestError("unable to verify signature", err)
+	}
+	if !valid {
+		return fmt.Errorf("lnd signer self-test failed: signature of "+
+			"account key %x is invalid", pubKey[:])
+	}
+
+	log.Infof("lnd signer self-test succeeded")
+
+	return nil
+}
+
+// signerSelfTestError wraps an error of the lnd signer self-test, pointing the
+// user to the macaroon permissions if lnd denied the request.
+func signerSelfTestError(step string, err error) error {
+	if status.Code(err) == codes.PermissionDenied ||
+		strings.Contains(err.Error(), "permission denied") {
+
+		return fmt.Errorf("lnd signer self-test failed, %s: the lnd "+
+			"macaroon is missing permissions, make sure it "+
+			"grants %s: %v", step, signerSelfTestPermissions, err)
+	}
+
+	return fmt.Errorf("lnd signer self-test failed, %s: %v", step, err)
+}
+
+// checkClockSkew compares the current time of the given clock to the timestamp
+// of the best block known to lnd. Because a block's timestamp can't be more
+// than two hours in the future, a clock that is behind that timestamp by more
+// than maxClockSkewBehind is most likely wrong. Blocks can take some time to be
+// found, so we only consider the clock to be ahead if lnd is synced to the
+// chain and the best block is older than maxClockSkewAhead. The check is
+// skipped on regtest and simnet where blocks are mined on demand.
+func checkClockSkew(clk clock.Clock, network string,
+	info *lndclient.Info) error {
+
+	if network == "regtest" || network == "simnet" ||
+		info.BestHeaderTimeStamp.IsZero() {
+
+		return nil
+	}
+
+	now := clk.Now()
+	bestHeader := info.BestHeaderTimeStamp
+	switch {
+	case now.Before(bestHeader.Add(-maxClockSkewBehind)):
+		return fmt.Errorf("local clock %v is behind the best block "+
+			"timestamp %v by %v", now, bestHeader,
+			bestHeader.Sub(now))
+
+	case info.SyncedToChain &&
+		now.After(bestHeader.Add(maxClockSkewAhead)):
+
+		return fmt.Errorf("local clock %v is ahead of the best block "+
+			"timestamp %v by %v even though lnd is synced to the "+
+			"chain", now, bestHeader, now.Sub(bestHeader))
+	}
+
+	return nil
+}
+
+// interceptorServerOptions returns the gRPC server options that install the
+// interceptor chain of poold's own RPC server. Unless macaroons are disabled,
+// the chain contains the security interceptors that check macaroons for their
+// validity, followed by the interceptors that refuse calls to disabled RPCs.
+// Any interceptors configured by a library user are appended at the end of the
+// chain.
+func (s *Server) interceptorServerOptions() ([]grpc.ServerOption, error) {
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		errorLogStreamServerInterceptor(rpcLog),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		errorLogUnaryServerInterceptor(rpcLog),
+	}
+
+	// Calls that fail while lnd is disconnected should be retried by the
+	// client once we've reconnected.
+	if s.lndConn != nil {
+		unaryInterceptors = append(
+			unaryInterceptors, s.lndConn.unaryServerInterceptor(),
+		)
+	}
+
+	if !s.cfg.NoMacaroons {
+		unaryMacIntercept, streamMacIntercept, err :=
+			s.macaroonService.Interceptors()
+		if err != nil {
+			return nil, fmt.Errorf("error with macaroon "+
+				"interceptor: %v", err)
+		}
+
+		streamInterceptors = append(
+			streamInterceptors, streamMacIntercept,
+		)
+		unaryInterceptors = append(unaryInterceptors, unaryMacIntercept)
+	}
+
+	disabledRPCs, err := s.cfg.DisabledRPCMethods()
+	if err != nil {
+		return nil, err
+	}
+	if len(disabledRPCs) > 0 {
+		streamInterceptors = append(
+			streamInterceptors,
+			disabledRPCStreamServerInterceptor(disabledRPCs),
+		)
+		unaryInterceptors = append(
+			unaryInterceptors,
+			disabledRPCUnaryServerInterceptor(disabledRPCs),
+		)
+	}
+
+	// The custom interceptors run last, so they can rely on the call
+	// being authenticated already.
+	streamInterceptors = append(
+		streamInterceptors, s.cfg.StreamInterceptors...,
+	)
+	unaryInterceptors = append(unaryInterceptors, s.cfg.UnaryInterceptors...)
+
+	return []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+	}, nil
+}
+
+// msgSizeServerOptions returns the gRPC server options that limit the size of
+// the messages poold's own RPC server sends and receives. These are
+// independent of the limits used for the connection to the auctioneer.
+func msgSizeServerOptions(cfg *Config) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.ServerMaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.ServerMaxSendMsgSize),
+	}
+}
+
 // getLnd returns an instance of the lnd services proxy.
 func getLnd(network string, cfg *LndConfig,
 	interceptor signal.Interceptor) (*lndclient.GrpcLndServices, error) {
 
-	// We'll want to wait for lnd to be fully synced to its chain backend.
-	// The call to NewLndServices will block until the sync is completed.
-	// But we still want to be able to shutdown the daemon if the user
-	// decides to not wait. For that we can pass down a context that we
-	// cancel on shutdown.
+	// We'll want to wait for lnd to be unlocked. The call to
+	// NewLndServices will block until that's the case. But we still want
+	// to be able to shutdown the daemon if the user decides to not wait.
+	// For that we can pass down a context that we cancel on shutdown. The
+	// chain sync is waited for separately when starting the RPC server, so
+	// we can log the progress and apply a timeout.
 	ctxc, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -682,18 +1149,65 @@ func getLnd(network string, cfg *LndConfig,
 		}
 	}()
 
+	// The macaroon might be provided a bit later than poold is started,
+	// so we wait for it if configured.
+	if cfg.MacaroonWaitTimeout > 0 {
+		err := waitForFile(
+			ctxc, cfg.MacaroonPath, cfg.MacaroonWaitTimeout,
+			macaroonPollInterval,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return lndclient.NewLndServices(&lndclient.LndServicesConfig{
 		LndAddress:            cfg.Host,
 		Network:               lndclient.Network(network),
 		CustomMacaroonPath:    cfg.MacaroonPath,
 		TLSPath:               cfg.TLSPath,
 		CheckVersion:          minimalCompatibleVersion,
-		BlockUntilChainSynced: true,
+		BlockUntilChainSynced: false,
 		BlockUntilUnlocked:    true,
 		CallerCtx:             ctxc,
 	})
 }
 
+// waitForFile polls for the file at the given path until it exists. An error
+// is returned if it doesn't appear within the given timeout or the context is
+// canceled before.
+func waitForFile(ctx context.Context, path string, timeout,
+	pollInterval time.Duration) error {
+
+	if lnrpc.FileExists(path) {
+		return nil
+	}
+
+	log.Infof("Waiting up to %v for file %v to appear", timeout, path)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if lnrpc.FileExists(path) {
+				return nil
+			}
+
+		case <-timeoutTimer.C:
+			return fmt.Errorf("file %v did not appear within %v",
+				path, timeout)
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // Interceptor is the interface a client side gRPC interceptor has to implement.
 type Interceptor interface {
 	// UnaryInterceptor intercepts normal, non-streaming requests from the
@@ -837,8 +1351,36 @@ func (s *Server) syncLocalOrderState() error {
 
 // determineBatchVersion determines the batch version that will be sent to the
 // auctioneer to signal compatibility with the different features the trader
-// client can support.
+// client can support. The version is limited to the latest one the auction
+// server supports, once we know it.
 func (s *Server) determineBatchVersion() (order.BatchVersion, error) {
+	version, err := s.supportedBatchVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	s.maxBatchVersionMtx.Lock()
+	defer s.maxBatchVersionMtx.Unlock()
+
+	if s.maxBatchVersion != nil && *s.maxBatchVersion < version {
+		return *s.maxBatchVersion, nil
+	}
+
+	return version, nil
+}
+
+// limitBatchVersion limits the batch version returned by determineBatchVersion
+// to the given version the auction server supports.
+func (s *Server) limitBatchVersion(version order.BatchVersion) {
+	s.maxBatchVersionMtx.Lock()
+	defer s.maxBatchVersionMtx.Unlock()
+
+	s.maxBatchVersion = &version
+}
+
+// supportedBatchVersion returns the latest batch version the trader client and
+// its lnd node support, unless a version is set in the config.
+func (s *Server) supportedBatchVersion() (order.BatchVersion, error) {
 	configVersion := s.cfg.DebugConfig.BatchVersion
 
 	// We set the default value of the config flag to -1, so we can
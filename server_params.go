@@ -0,0 +1,34 @@
+package pool
+
+import (
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/terms"
+)
+
+// marshallServerParams turns the auction server's terms and the rate tick size
+// order rates must be a multiple of into the order parameters we relay to the
+// client.
+func marshallServerParams(auctionTerms *terms.AuctioneerTerms,
+	rateTick uint32) *poolrpc.ServerParamsResponse {
+
+	// The fixed rate can't express anything below a single part per
+	// billion per block, and rates below a single tick aren't valid.
+	minRate := uint32(1)
+	if rateTick > minRate {
+		minRate = rateTick
+	}
+
+	return &poolrpc.ServerParamsResponse{
+		MinOrderAmtSat:       uint64(order.BaseSupplyUnit),
+		MaxAccountValueSat:   uint64(auctionTerms.MaxAccountValue),
+		LeaseDurationBuckets: auctionTerms.LeaseDurationBuckets,
+		ExecutionFee: &auctioneerrpc.ExecutionFee{
+			BaseFee: uint64(auctionTerms.OrderExecBaseFee),
+			FeeRate: uint64(auctionTerms.OrderExecFeeRate),
+		},
+		MinOrderDurationBlocks: order.MinimumOrderDurationBlocks,
+		MinRateFixed:           minRate,
+	}
+}
@@ -0,0 +1,101 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetServerParams makes sure the terms of the auction server are relayed
+// to the client and only queried again once the cached ones are stale.
+func TestGetServerParams(t *testing.T) {
+	t.Parallel()
+
+	serverTerms := &terms.AuctioneerTerms{
+		MaxAccountValue:  10_000_000,
+		OrderExecBaseFee: 1,
+		OrderExecFeeRate: 100,
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			4032: auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS,
+		},
+	}
+
+	var (
+		numFetches int
+		fetchErr   error
+	)
+	fetchTerms := func(context.Context) (*terms.AuctioneerTerms, error) {
+		numFetches++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return serverTerms, nil
+	}
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	s := &rpcServer{
+		server: &Server{
+			cfg: &Config{
+				OrderRateTick: 30,
+			},
+		},
+		marketData: newMarketDataCache(
+			testClock, defaultMarketDataCacheTTL, fetchTerms,
+		),
+	}
+
+	ctx := context.Background()
+	resp, err := s.GetServerParams(ctx, &poolrpc.ServerParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, numFetches)
+	require.Equal(t, &poolrpc.ServerParamsResponse{
+		MinOrderAmtSat:       uint64(order.BaseSupplyUnit),
+		MaxAccountValueSat:   10_000_000,
+		LeaseDurationBuckets: serverTerms.LeaseDurationBuckets,
+		ExecutionFee: &auctioneerrpc.ExecutionFee{
+			BaseFee: 1,
+			FeeRate: 100,
+		},
+		MinOrderDurationBlocks: order.MinimumOrderDurationBlocks,
+		MinRateFixed:           30,
+	}, resp)
+
+	// As long as the cached terms are fresh, the server isn't queried
+	// again.
+	testClock.SetTime(testClock.Now().Add(defaultMarketDataCacheTTL / 2))
+	_, err = s.GetServerParams(ctx, &poolrpc.ServerParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, numFetches)
+
+	// Once they are stale, a failure to query them is surfaced instead of
+	// relaying the outdated ones.
+	testClock.SetTime(testClock.Now().Add(defaultMarketDataCacheTTL))
+	fetchErr = errors.New("server unavailable")
+	_, err = s.GetServerParams(ctx, &poolrpc.ServerParamsRequest{})
+	require.ErrorContains(t, err, "server unavailable")
+	require.Equal(t, 2, numFetches)
+
+	// The next successful query updates the relayed parameters.
+	fetchErr = nil
+	serverTerms.MaxAccountValue = 20_000_000
+	resp, err = s.GetServerParams(ctx, &poolrpc.ServerParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, numFetches)
+	require.EqualValues(t, 20_000_000, resp.MaxAccountValueSat)
+
+	// Without a rate tick size, the smallest rate the fixed rate can
+	// express is the minimum.
+	s.server.cfg.OrderRateTick = 0
+	resp, err = s.GetServerParams(ctx, &poolrpc.ServerParamsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.MinRateFixed)
+}
@@ -0,0 +1,695 @@
+package pool
+
+import (
+	"context"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// drainTestTimeout is the maximum time we wait for the gRPC server to
+	// react in the drain test.
+	drainTestTimeout = 5 * time.Second
+)
+
+// msgSizeTestServer is a minimal trader server that returns a response of a
+// fixed size for the GetLsatTokens call.
+type msgSizeTestServer struct {
+	poolrpc.UnimplementedTraderServer
+
+	respSize int
+}
+
+// GetLsatTokens returns a single token with a macaroon of the configured
+// response size.
+func (s *msgSizeTestServer) GetLsatTokens(context.Context,
+	*poolrpc.TokensRequest) (*poolrpc.TokensResponse, error) {
+
+	return &poolrpc.TokensResponse{
+		Tokens: []*poolrpc.LsatToken{{
+			BaseMacaroon: make([]byte, s.respSize),
+		}},
+	}, nil
+}
+
+// DecodeSidecarTicket accepts any ticket and returns an empty response.
+func (s *msgSizeTestServer) DecodeSidecarTicket(context.Context,
+	*poolrpc.SidecarTicket) (*poolrpc.DecodedSidecarTicket, error) {
+
+	return &poolrpc.DecodedSidecarTicket{}, nil
+}
+
+// drainTestServer is a minimal trader server whose GetLsatTokens call blocks
+// until it is released.
+type drainTestServer struct {
+	poolrpc.UnimplementedTraderServer
+
+	started chan struct{}
+	release chan struct{}
+}
+
+// GetLsatTokens signals that the call was started and then blocks until it is
+// released or the call's context is canceled.
+func (s *drainTestServer) GetLsatTokens(ctx context.Context,
+	_ *poolrpc.TokensRequest) (*poolrpc.TokensResponse, error) {
+
+	s.started <- struct{}{}
+
+	select {
+	case <-s.release:
+		return &poolrpc.TokensResponse{}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// startTestGrpcServer serves the given gRPC server on an in-memory listener and
+// returns a trader client that is connected to it.
+func startTestGrpcServer(t *testing.T,
+	server *grpc.Server) (poolrpc.TraderClient, func()) {
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+
+	// The client itself shouldn't limit the message size, so we can be
+	// sure any error comes from the server.
+	conn, err := grpc.Dial(
+		"bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context,
+			string) (net.Conn, error) {
+
+			return listener.Dial()
+		}),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(math.MaxInt32),
+			grpc.MaxCallSendMsgSize(math.MaxInt32),
+		),
+	)
+	require.NoError(t, err)
+
+	return poolrpc.NewTraderClient(conn), func() {
+		_ = conn.Close()
+		server.Stop()
+	}
+}
+
+var msgSizeServerOptionsTestCases = []struct {
+	name         string
+	maxRecvSize  int
+	maxSendSize  int
+	reqSize      int
+	respSize     int
+	expectedReq  codes.Code
+	expectedResp codes.Code
+}{{
+	name:         "default limits",
+	maxRecvSize:  defaultServerMaxRecvMsgSize,
+	maxSendSize:  defaultServerMaxSendMsgSize,
+	reqSize:      1024,
+	respSize:     8 * 1024 * 1024,
+	expectedReq:  codes.OK,
+	expectedResp: codes.OK,
+}, {
+	name:         "request exceeds receive limit",
+	maxRecvSize:  minServerMsgSize,
+	maxSendSize:  defaultServerMaxSendMsgSize,
+	reqSize:      2 * minServerMsgSize,
+	respSize:     1024,
+	expectedReq:  codes.ResourceExhausted,
+	expectedResp: codes.OK,
+}, {
+	name:         "response exceeds send limit",
+	maxRecvSize:  defaultServerMaxRecvMsgSize,
+	maxSendSize:  minServerMsgSize,
+	reqSize:      1024,
+	respSize:     2 * minServerMsgSize,
+	expectedReq:  codes.OK,
+	expectedResp: codes.ResourceExhausted,
+}, {
+	name:         "increased limits",
+	maxRecvSize:  16 * 1024 * 1024,
+	maxSendSize:  16 * 1024 * 1024,
+	reqSize:      8 * 1024 * 1024,
+	respSize:     8 * 1024 * 1024,
+	expectedReq:  codes.OK,
+	expectedResp: codes.OK,
+}}
+
+// TestMsgSizeServerOptions makes sure the gRPC server options of poold's own
+// RPC server carry the configured message size limits.
+func TestMsgSizeServerOptions(t *testing.T) {
+	for _, tc := range msgSizeServerOptionsTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultConfig()
+			cfg.ServerMaxRecvMsgSize = tc.maxRecvSize
+			cfg.ServerMaxSendMsgSize = tc.maxSendSize
+
+			server := grpc.NewServer(msgSizeServerOptions(&cfg)...)
+			poolrpc.RegisterTraderServer(server, &msgSizeTestServer{
+				respSize: tc.respSize,
+			})
+			client, cleanup := startTestGrpcServer(t, server)
+			defer cleanup()
+
+			ctx := context.Background()
+			_, err := client.DecodeSidecarTicket(ctx, &poolrpc.SidecarTicket{
+				Ticket: strings.Repeat("a", tc.reqSize),
+			})
+			require.Equal(t, tc.expectedReq, status.Code(err))
+
+			_, err = client.GetLsatTokens(ctx, &poolrpc.TokensRequest{})
+			require.Equal(t, tc.expectedResp, status.Code(err))
+		})
+	}
+}
+
+// TestNoMacaroons makes sure calls without a macaroon are allowed if macaroon
+// authentication is disabled.
+func TestNoMacaroons(t *testing.T) {
+	s := &Server{
+		cfg: &Config{
+			NoMacaroons: true,
+		},
+	}
+
+	serverOpts, err := s.interceptorServerOptions()
+	require.NoError(t, err)
+
+	server := grpc.NewServer(serverOpts...)
+	poolrpc.RegisterTraderServer(server, &msgSizeTestServer{})
+	client, cleanup := startTestGrpcServer(t, server)
+	defer cleanup()
+
+	_, err = client.DecodeSidecarTicket(
+		context.Background(), &poolrpc.SidecarTicket{},
+	)
+	require.NoError(t, err)
+
+	// Calls forwarded to us when running as a subserver shouldn't require
+	// a macaroon either.
+	err = s.ValidateMacaroon(context.Background(), nil, "")
+	require.NoError(t, err)
+}
+
+// TestCustomInterceptors makes sure the interceptors configured by a library
+// user are invoked in the given order after the built-in ones.
+func TestCustomInterceptors(t *testing.T) {
+	var calls []string
+	newInterceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{},
+			info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+
+			calls = append(calls, name+" "+info.FullMethod)
+			return handler(ctx, req)
+		}
+	}
+
+	s := &Server{
+		cfg: &Config{
+			NoMacaroons: true,
+			UnaryInterceptors: []grpc.UnaryServerInterceptor{
+				newInterceptor("first"), newInterceptor("second"),
+			},
+		},
+	}
+
+	serverOpts, err := s.interceptorServerOptions()
+	require.NoError(t, err)
+
+	server := grpc.NewServer(serverOpts...)
+	poolrpc.RegisterTraderServer(server, &msgSizeTestServer{})
+	client, cleanup := startTestGrpcServer(t, server)
+	defer cleanup()
+
+	_, err = client.DecodeSidecarTicket(
+		context.Background(), &poolrpc.SidecarTicket{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"first /poolrpc.Trader/DecodeSidecarTicket",
+		"second /poolrpc.Trader/DecodeSidecarTicket",
+	}, calls)
+}
+
+// TestDisabledRPCs makes sure calls to disabled RPC methods are refused while
+// all other methods still work.
+func TestDisabledRPCs(t *testing.T) {
+	s := &Server{
+		cfg: &Config{
+			NoMacaroons:  true,
+			DisabledRPCs: []string{"DecodeSidecarTicket"},
+		},
+	}
+
+	serverOpts, err := s.interceptorServerOptions()
+	require.NoError(t, err)
+
+	server := grpc.NewServer(serverOpts...)
+	poolrpc.RegisterTraderServer(server, &msgSizeTestServer{})
+	client, cleanup := startTestGrpcServer(t, server)
+	defer cleanup()
+
+	ctx := context.Background()
+	_, err = client.DecodeSidecarTicket(ctx, &poolrpc.SidecarTicket{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	require.Contains(t, err.Error(), "is disabled")
+
+	_, err = client.GetLsatTokens(ctx, &poolrpc.TokensRequest{})
+	require.NoError(t, err)
+}
+
+// TestDisabledRPCsSubserver makes sure calls to disabled RPC methods are also
+// refused when poold runs as a subserver, where lnd validates the calls through
+// ValidateMacaroon instead of our interceptors.
+func TestDisabledRPCsSubserver(t *testing.T) {
+	s := &Server{
+		cfg: &Config{
+			NoMacaroons:  true,
+			DisabledRPCs: []string{"DecodeSidecarTicket"},
+		},
+	}
+
+	ctx := context.Background()
+	err := s.ValidateMacaroon(
+		ctx, nil, traderServicePrefix+"DecodeSidecarTicket",
+	)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	require.Contains(t, err.Error(), "is disabled")
+
+	err = s.ValidateMacaroon(ctx, nil, traderServicePrefix+"GetLsatTokens")
+	require.NoError(t, err)
+}
+
+var drainGrpcServerTestCases = []struct {
+	name            string
+	timeout         time.Duration
+	releaseInFlight bool
+	expectedCode    codes.Code
+}{{
+	name:            "in-flight request completes",
+	timeout:         time.Minute,
+	releaseInFlight: true,
+	expectedCode:    codes.OK,
+}, {
+	name:         "drain timeout exceeded",
+	timeout:      100 * time.Millisecond,
+	expectedCode: codes.Unavailable,
+}, {
+	name:            "no drain timeout",
+	releaseInFlight: true,
+	expectedCode:    codes.OK,
+}}
+
+// TestDrainGrpcServer makes sure in-flight RPCs are allowed to complete while
+// the gRPC server is drained, new RPCs are rejected and the server is stopped
+// forcefully once the drain timeout is exceeded.
+func TestDrainGrpcServer(t *testing.T) {
+	for _, tc := range drainGrpcServerTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			traderServer := &drainTestServer{
+				started: make(chan struct{}, 1),
+				release: make(chan struct{}),
+			}
+			server := grpc.NewServer()
+			poolrpc.RegisterTraderServer(server, traderServer)
+			client, cleanup := startTestGrpcServer(t, server)
+			defer cleanup()
+
+			ctx := context.Background()
+			inFlightErr := make(chan error, 1)
+			go func() {
+				_, err := client.GetLsatTokens(
+					ctx, &poolrpc.TokensRequest{},
+				)
+				inFlightErr <- err
+			}()
+			<-traderServer.started
+
+			drained := make(chan struct{})
+			go func() {
+				drainGrpcServer(server, tc.timeout)
+				close(drained)
+			}()
+
+			// While draining, or after the server was stopped, any
+			// new request must be rejected without reaching the
+			// server.
+			require.Eventually(t, func() bool {
+				_, err := client.DecodeSidecarTicket(
+					ctx, &poolrpc.SidecarTicket{},
+				)
+				return status.Code(err) == codes.Unavailable
+			}, drainTestTimeout, 10*time.Millisecond)
+
+			if tc.releaseInFlight {
+				close(traderServer.release)
+			}
+
+			select {
+			case err := <-inFlightErr:
+				require.Equal(t, tc.expectedCode, status.Code(err))
+
+			case <-time.After(drainTestTimeout):
+				t.Fatalf("in-flight request did not complete")
+			}
+
+			select {
+			case <-drained:
+			case <-time.After(drainTestTimeout):
+				t.Fatalf("gRPC server was not stopped")
+			}
+		})
+	}
+}
+
+var clockSkewTestCases = []struct {
+	name          string
+	network       string
+	clockOffset   time.Duration
+	syncedToChain bool
+	expectedErr   string
+}{{
+	name:          "clock in sync",
+	network:       "mainnet",
+	clockOffset:   10 * time.Minute,
+	syncedToChain: true,
+}, {
+	name:        "block timestamp slightly in the future",
+	network:     "mainnet",
+	clockOffset: -time.Hour,
+}, {
+	name:        "clock behind",
+	network:     "mainnet",
+	clockOffset: -3 * time.Hour,
+	expectedErr: "is behind the best block timestamp",
+}, {
+	name:          "clock ahead while synced",
+	network:       "testnet",
+	clockOffset:   7 * time.Hour,
+	syncedToChain: true,
+	expectedErr:   "is ahead of the best block timestamp",
+}, {
+	name:        "clock ahead while not synced",
+	network:     "mainnet",
+	clockOffset: 7 * time.Hour,
+}, {
+	name:          "regtest is skipped",
+	network:       "regtest",
+	clockOffset:   -30 * 24 * time.Hour,
+	syncedToChain: true,
+}}
+
+// TestCheckClockSkew makes sure a skewed local clock is detected by comparing
+// it to the timestamp of the best block known to lnd.
+func TestCheckClockSkew(t *testing.T) {
+	bestHeader := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range clockSkewTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			skewedClock := clock.NewTestClock(
+				bestHeader.Add(tc.clockOffset),
+			)
+			info := &lndclient.Info{
+				BestHeaderTimeStamp: bestHeader,
+				SyncedToChain:       tc.syncedToChain,
+			}
+
+			err := checkClockSkew(skewedClock, tc.network, info)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// chainSyncTestLnd is a lightning client that reports being synced to the
+// chain only after a number of GetInfo calls.
+type chainSyncTestLnd struct {
+	lndclient.LightningClient
+
+	syncedAfter int
+	calls       int
+}
+
+// GetInfo returns an info that is only synced to the chain once the number of
+// calls exceeds the configured threshold.
+func (l *chainSyncTestLnd) GetInfo(context.Context) (*lndclient.Info, error) {
+	l.calls++
+
+	return &lndclient.Info{
+		BlockHeight:   uint32(l.calls),
+		SyncedToChain: l.calls > l.syncedAfter,
+	}, nil
+}
+
+var waitForChainSyncTestCases = []struct {
+	name          string
+	syncedAfter   int
+	timeout       time.Duration
+	expectedCalls int
+	expectedErr   string
+}{{
+	name:          "already synced",
+	expectedCalls: 1,
+}, {
+	name:          "synced after a few polls",
+	syncedAfter:   3,
+	expectedCalls: 4,
+}, {
+	name:        "not synced within timeout",
+	syncedAfter: math.MaxInt32,
+	timeout:     50 * time.Millisecond,
+	expectedErr: "lnd not synced to chain after 50ms",
+}}
+
+// TestWaitForChainSync makes sure we wait for lnd to report being synced to
+// the chain and give up once the timeout is reached.
+func TestWaitForChainSync(t *testing.T) {
+	for _, tc := range waitForChainSyncTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lnd := &chainSyncTestLnd{
+				syncedAfter: tc.syncedAfter,
+			}
+
+			err := waitForChainSync(
+				context.Background(), lnd, time.Millisecond,
+				tc.timeout,
+			)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCalls, lnd.calls)
+		})
+	}
+}
+
+// signerSelfTestLnd is a fake lnd wallet and signer that sign and verify
+// messages with a fixed key or fail with the configured errors.
+type signerSelfTestLnd struct {
+	lndclient.WalletKitClient
+	lndclient.SignerClient
+
+	privKey *btcec.PrivateKey
+
+	deriveErr  error
+	signErr    error
+	verifyErr  error
+	corruptSig bool
+}
+
+// DeriveKey returns the fixed key for the given locator.
+func (l *signerSelfTestLnd) DeriveKey(_ context.Context,
+	locator *keychain.KeyLocator) (*keychain.KeyDescriptor, error) {
+
+	if l.deriveErr != nil {
+		return nil, l.deriveErr
+	}
+
+	return &keychain.KeyDescriptor{
+		KeyLocator: *locator,
+		PubKey:     l.privKey.PubKey(),
+	}, nil
+}
+
+// SignMessage signs the double SHA256 of the message with the fixed key.
+func (l *signerSelfTestLnd) SignMessage(_ context.Context, msg []byte,
+	_ keychain.KeyLocator) ([]byte, error) {
+
+	if l.signErr != nil {
+		return nil, l.signErr
+	}
+
+	sig := ecdsa.Sign(l.privKey, chainhash.DoubleHashB(msg))
+	if l.corruptSig {
+		sig = ecdsa.Sign(l.privKey, chainhash.DoubleHashB([]byte("x")))
+	}
+
+	return sig.Serialize(), nil
+}
+
+// VerifyMessage verifies the signature over the double SHA256 of the message.
+func (l *signerSelfTestLnd) VerifyMessage(_ context.Context, msg,
+	sig []byte, pubKey [33]byte) (bool, error) {
+
+	if l.verifyErr != nil {
+		return false, l.verifyErr
+	}
+
+	parsedSig, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return false, err
+	}
+	parsedKey, err := btcec.ParsePubKey(pubKey[:])
+	if err != nil {
+		return false, err
+	}
+
+	return parsedSig.Verify(chainhash.DoubleHashB(msg), parsedKey), nil
+}
+
+var verifyLndSignerTestCases = []struct {
+	name        string
+	lnd         *signerSelfTestLnd
+	expectedErr string
+}{{
+	name: "signer works",
+	lnd:  &signerSelfTestLnd{},
+}, {
+	name: "sign permission denied",
+	lnd: &signerSelfTestLnd{
+		signErr: status.Error(
+			codes.Unknown, "verification failed: permission denied",
+		),
+	},
+	expectedErr: "unable to sign message: the lnd macaroon is " +
+		"missing permissions, make sure it grants address:read " +
+		"signer:generate signer:read",
+}, {
+	name: "derive permission denied",
+	lnd: &signerSelfTestLnd{
+		deriveErr: status.Error(codes.PermissionDenied, "denied"),
+	},
+	expectedErr: "unable to derive account key: the lnd macaroon is " +
+		"missing permissions",
+}, {
+	name: "verify unavailable",
+	lnd: &signerSelfTestLnd{
+		verifyErr: status.Error(codes.Unimplemented, "unknown service"),
+	},
+	expectedErr: "lnd signer self-test failed, unable to verify " +
+		"signature: rpc error: code = Unimplemented",
+}, {
+	name: "invalid signature",
+	lnd: &signerSelfTestLnd{
+		corruptSig: true,
+	},
+	expectedErr: "lnd signer self-test failed: signature of account " +
+		"key",
+}}
+
+// TestVerifyLndSigner makes sure the signer self-test succeeds if lnd signs
+// with our account key and fails with a descriptive error otherwise.
+func TestVerifyLndSigner(t *testing.T) {
+	for _, tc := range verifyLndSignerTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			privKey, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			tc.lnd.privKey = privKey
+
+			err = verifyLndSigner(
+				context.Background(), tc.lnd, tc.lnd,
+			)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestWaitForFile makes sure we wait for a file that appears late and give up
+// on one that never appears.
+func TestWaitForFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	pollInterval := 10 * time.Millisecond
+
+	// A file that already exists doesn't need to be waited for.
+	existingFile := filepath.Join(dir, "existing.macaroon")
+	require.NoError(t, os.WriteFile(existingFile, []byte{1}, 0600))
+	require.NoError(t, waitForFile(ctx, existingFile, time.Second, time.Hour))
+
+	// A file that appears within the timeout is picked up.
+	lateFile := filepath.Join(dir, "late.macaroon")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(lateFile, []byte{1}, 0600)
+	}()
+	require.NoError(t, waitForFile(ctx, lateFile, 5*time.Second, pollInterval))
+
+	// A file that never appears results in an error after the timeout.
+	missingFile := filepath.Join(dir, "missing.macaroon")
+	start := time.Now()
+	err := waitForFile(ctx, missingFile, 50*time.Millisecond, pollInterval)
+	require.ErrorContains(t, err, "did not appear within 50ms")
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// Canceling the context aborts the wait.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = waitForFile(cancelCtx, missingFile, time.Hour, pollInterval)
+	require.ErrorIs(t, err, context.Canceled)
+}
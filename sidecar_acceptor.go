@@ -81,6 +81,10 @@ type SidecarAcceptorConfig struct {
 
 	FundingManager *funding.Manager
 
+	// BatchLimiter limits the number of batch steps that are processed
+	// concurrently. If nil, there is no limit.
+	BatchLimiter *batchLimiter
+
 	FetchSidecarBid func(*sidecar.Ticket) (*order.Bid, error)
 }
 
@@ -517,6 +521,15 @@ func (a *SidecarAcceptor) CoordinateSidecar(ticket *sidecar.Ticket,
 func (a *SidecarAcceptor) handleServerMessage(
 	serverMsg *auctioneerrpc.ServerAuctionMessage) error {
 
+	// Wait for our turn before taking the lock so user RPC calls aren't
+	// blocked while the batch step is queued.
+	if isBatchStep(serverMsg) {
+		if !a.cfg.BatchLimiter.acquire(a.quit) {
+			return nil
+		}
+		defer a.cfg.BatchLimiter.release()
+	}
+
 	// We hold the lock during the whole process of reacting to a server
 	// message to make sure no user RPC calls interfere with the execution.
 	a.Lock()
@@ -601,7 +614,9 @@ func (a *SidecarAcceptor) matchPrepare(
 	// peers, and registering funding shim. We don't do a full batch
 	// validation since we don't have any information about the account
 	// that's being used to pay for the sidecar channel.
-	err = a.cfg.FundingManager.PrepChannelFunding(batch, a.getSidecarAsOrder)
+	err = a.cfg.FundingManager.PrepChannelFunding(
+		context.Background(), batch, a.getSidecarAsOrder,
+	)
 	if err != nil {
 		return fmt.Errorf("error preparing channel funding: %w", err)
 	}
@@ -844,7 +859,8 @@ func (a *SidecarAcceptor) removeShims(batch *order.Batch) error {
 	// that we may have registered since we may be matched with a distinct
 	// set of channels if this batch is repeated.
 	if err := funding.CancelPendingFundingShims(
-		batch.MatchedOrders, a.cfg.BaseClient, a.getSidecarAsOrder,
+		context.Background(), batch.MatchedOrders, a.cfg.BaseClient,
+		a.getSidecarAsOrder,
 	); err != nil {
 		return err
 	}
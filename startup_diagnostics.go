@@ -0,0 +1,196 @@
+package pool
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+)
+
+const (
+	// diagnosticsStatusConnected is the auctioneer status reported if the
+	// auction server answered our terms request.
+	diagnosticsStatusConnected = "connected"
+
+	// diagnosticsNotAvailable is reported for values that couldn't be
+	// determined.
+	diagnosticsNotAvailable = "n/a"
+)
+
+// diagnosticsSource bundles everything the startup diagnostics are gathered
+// from.
+type diagnosticsSource struct {
+	// network is the network the daemon is configured for.
+	network string
+
+	// lndServices are the services of the connected lnd node.
+	lndServices *lndclient.LndServices
+
+	// accounts returns all accounts of the local database.
+	accounts func() ([]*account.Account, error)
+
+	// tlsCert is the certificate of the RPC server. It is nil if the
+	// daemon runs as a subserver and doesn't serve RPCs itself.
+	tlsCert *x509.Certificate
+
+	// auctionServer is the address of the auction server.
+	auctionServer string
+
+	// bestHeight is the current block height.
+	bestHeight uint32
+}
+
+// startupDiagnostics is the summary of the daemon's state that is logged once
+// on startup after all connections are established.
+type startupDiagnostics struct {
+	network         string
+	lndVersion      string
+	lndNetwork      string
+	lndAlias        string
+	lndPubkey       string
+	bestHeight      uint32
+	numAccounts     int
+	numOpenAccounts int
+	tlsCertExpiry   time.Time
+	auctionServer   string
+	auctioneer      string
+}
+
+// gatherStartupDiagnostics collects the local startup diagnostics from the
+// given source. Failing to query any of the values is not fatal, the value is
+// then reported as not available instead. The auctioneer status is only
+// filled in by queryAuctioneer.
+func gatherStartupDiagnostics(src *diagnosticsSource) *startupDiagnostics {
+
+	d := &startupDiagnostics{
+		network:       src.network,
+		lndVersion:    diagnosticsNotAvailable,
+		lndNetwork:    diagnosticsNotAvailable,
+		lndAlias:      src.lndServices.NodeAlias,
+		lndPubkey:     src.lndServices.NodePubkey.String(),
+		bestHeight:    src.bestHeight,
+		auctionServer: src.auctionServer,
+		auctioneer:    diagnosticsNotAvailable,
+	}
+
+	if src.lndServices.Version != nil {
+		d.lndVersion = src.lndServices.Version.Version
+	}
+	if src.lndServices.ChainParams != nil {
+		d.lndNetwork = src.lndServices.ChainParams.Name
+	}
+	if src.tlsCert != nil {
+		d.tlsCertExpiry = src.tlsCert.NotAfter
+	}
+
+	accounts, err := src.accounts()
+	if err != nil {
+		log.Debugf("Unable to fetch accounts for diagnostics: %v", err)
+		d.numAccounts = -1
+		d.numOpenAccounts = -1
+	}
+	for _, acct := range accounts {
+		d.numAccounts++
+		if acct.State == account.StateOpen {
+			d.numOpenAccounts++
+		}
+	}
+
+	return d
+}
+
+// queryAuctioneer sets the auctioneer status of the diagnostics depending on
+// whether the auction server answers a terms request within getInfoTimeout.
+func (d *startupDiagnostics) queryAuctioneer(ctx context.Context,
+	auctioneerTerms func(context.Context) (*terms.AuctioneerTerms,
+		error)) {
+
+	ctxt, cancel := context.WithTimeout(ctx, getInfoTimeout)
+	defer cancel()
+
+	if _, err := auctioneerTerms(ctxt); err != nil {
+		d.auctioneer = fmt.Sprintf("error: %v", err)
+		return
+	}
+
+	d.auctioneer = diagnosticsStatusConnected
+}
+
+// fields returns the diagnostics as ordered list of key/value pairs.
+func (d *startupDiagnostics) fields() [][2]string {
+	count := func(n int) string {
+		if n < 0 {
+			return diagnosticsNotAvailable
+		}
+		return strconv.Itoa(n)
+	}
+
+	certExpiry := diagnosticsNotAvailable
+	if !d.tlsCertExpiry.IsZero() {
+		certExpiry = d.tlsCertExpiry.UTC().Format(time.RFC3339)
+	}
+
+	return [][2]string{
+		{"network", d.network},
+		{"lnd_version", d.lndVersion},
+		{"lnd_network", d.lndNetwork},
+		{"lnd_alias", d.lndAlias},
+		{"lnd_pubkey", d.lndPubkey},
+		{"best_height", strconv.FormatUint(uint64(d.bestHeight), 10)},
+		{"accounts", count(d.numAccounts)},
+		{"open_accounts", count(d.numOpenAccounts)},
+		{"tls_cert_expiry", certExpiry},
+		{"auction_server", d.auctionServer},
+		{"auctioneer", d.auctioneer},
+	}
+}
+
+// String returns the diagnostics as a single line of key=value pairs. Values
+// that are empty or contain whitespace, quotes or equal signs are quoted.
+func (d *startupDiagnostics) String() string {
+	fields := d.fields()
+	pairs := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value := field[1]
+		if value == "" || strings.ContainsAny(value, " \t\"=") {
+			value = strconv.Quote(value)
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", field[0], value))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// logStartupDiagnostics logs a single structured summary of the daemon's state
+// once all connections are established. The local state is gathered right
+// away but the auction server is queried in the background so a slow or
+// unreachable server doesn't hold up the startup.
+func (s *Server) logStartupDiagnostics() {
+	src := &diagnosticsSource{
+		network:       s.cfg.Network,
+		lndServices:   &s.lndServices.LndServices,
+		accounts:      s.db.Accounts,
+		tlsCert:       s.tlsCert,
+		auctionServer: s.cfg.AuctionServer,
+		bestHeight:    atomic.LoadUint32(&s.rpcServer.bestHeight),
+	}
+
+	diagnostics := gatherStartupDiagnostics(src)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		diagnostics.queryAuctioneer(
+			context.Background(), s.AuctioneerClient.Terms,
+		)
+		log.Infof("Startup diagnostics: %v", diagnostics)
+	}()
+}
@@ -0,0 +1,102 @@
+package pool
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStartupDiagnostics makes sure the startup diagnostics contain all
+// expected fields gathered from the given source.
+func TestStartupDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	certExpiry := time.Date(2027, 1, 2, 3, 4, 5, 0, time.UTC)
+	src := &diagnosticsSource{
+		network: "mainnet",
+		lndServices: &lndclient.LndServices{
+			ChainParams: &chaincfg.MainNetParams,
+			NodeAlias:   "my node",
+			NodePubkey:  route.Vertex{0x02, 0x01},
+			Version: &verrpc.Version{
+				Version: "0.15.1-beta",
+			},
+		},
+		accounts: func() ([]*account.Account, error) {
+			return []*account.Account{
+				{State: account.StateOpen},
+				{State: account.StateOpen},
+				{State: account.StateClosed},
+			}, nil
+		},
+		tlsCert:       &x509.Certificate{NotAfter: certExpiry},
+		auctionServer: "pool.lightning.finance:12010",
+		bestHeight:    800_000,
+	}
+
+	// Until the auctioneer was queried, its status isn't known.
+	d := gatherStartupDiagnostics(src)
+	require.Contains(t, d.String(), "auctioneer="+diagnosticsNotAvailable)
+
+	d.queryAuctioneer(context.Background(), func(context.Context) (
+		*terms.AuctioneerTerms, error) {
+
+		return &terms.AuctioneerTerms{}, nil
+	})
+	fields := make(map[string]string)
+	for _, field := range d.fields() {
+		fields[field[0]] = field[1]
+	}
+
+	require.Equal(t, map[string]string{
+		"network":         "mainnet",
+		"lnd_version":     "0.15.1-beta",
+		"lnd_network":     "mainnet",
+		"lnd_alias":       "my node",
+		"lnd_pubkey":      src.lndServices.NodePubkey.String(),
+		"best_height":     "800000",
+		"accounts":        "3",
+		"open_accounts":   "2",
+		"tls_cert_expiry": "2027-01-02T03:04:05Z",
+		"auction_server":  "pool.lightning.finance:12010",
+		"auctioneer":      diagnosticsStatusConnected,
+	}, fields)
+
+	// The summary is a single line with values containing spaces quoted.
+	line := d.String()
+	require.NotContains(t, line, "\n")
+	require.True(t, strings.HasPrefix(line, "network=mainnet "))
+	require.Contains(t, line, `lnd_alias="my node"`)
+	require.Contains(t, line, "open_accounts=2")
+
+	// Values that can't be determined are reported as not available, an
+	// unreachable auctioneer with its error.
+	src.lndServices.Version = nil
+	src.tlsCert = nil
+	src.accounts = func() ([]*account.Account, error) {
+		return nil, errors.New("db closed")
+	}
+
+	d = gatherStartupDiagnostics(src)
+	d.queryAuctioneer(context.Background(), func(context.Context) (
+		*terms.AuctioneerTerms, error) {
+
+		return nil, errors.New("connection refused")
+	})
+	line = d.String()
+	require.Contains(t, line, "lnd_version="+diagnosticsNotAvailable)
+	require.Contains(t, line, "tls_cert_expiry="+diagnosticsNotAvailable)
+	require.Contains(t, line, "accounts="+diagnosticsNotAvailable)
+	require.Contains(t, line, `auctioneer="error: connection refused"`)
+}
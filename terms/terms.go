@@ -1,6 +1,8 @@
 package terms
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -47,3 +49,53 @@ func (t *AuctioneerTerms) FeeSchedule() FeeSchedule {
 		t.OrderExecBaseFee, t.OrderExecFeeRate,
 	)
 }
+
+// AcceptsOrders returns true if the given lease duration bucket exists and the
+// auctioneer is currently accepting orders for it.
+func (t *AuctioneerTerms) AcceptsOrders(duration uint32) bool {
+	switch t.LeaseDurationBuckets[duration] {
+	case auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS,
+		auctioneerrpc.DurationBucketState_MARKET_OPEN:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// ActiveLeaseDurations returns a sorted list of all lease durations the
+// auctioneer is currently accepting orders for.
+func (t *AuctioneerTerms) ActiveLeaseDurations() []uint32 {
+	durations := make([]uint32, 0, len(t.LeaseDurationBuckets))
+	for duration := range t.LeaseDurationBuckets {
+		if t.AcceptsOrders(duration) {
+			durations = append(durations, duration)
+		}
+	}
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	return durations
+}
+
+// ValidateLeaseDuration makes sure the given lease duration matches one of the
+// duration buckets the auctioneer is currently accepting orders for.
+func (t *AuctioneerTerms) ValidateLeaseDuration(duration uint32) error {
+	if t.AcceptsOrders(duration) {
+		return nil
+	}
+
+	state, ok := t.LeaseDurationBuckets[duration]
+	if !ok || state == auctioneerrpc.DurationBucketState_NO_MARKET {
+		return fmt.Errorf("invalid channel lease duration %d blocks, "+
+			"valid durations are: %v", duration,
+			t.ActiveLeaseDurations())
+	}
+
+	return fmt.Errorf("market for channel lease duration %d blocks is "+
+		"in state %v, valid durations are: %v", duration, state,
+		t.ActiveLeaseDurations())
+}
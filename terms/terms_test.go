@@ -0,0 +1,61 @@
+package terms
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+var validateLeaseDurationTestCases = []struct {
+	name          string
+	duration      uint32
+	expectedError string
+}{{
+	name:     "market open",
+	duration: 2016,
+}, {
+	name:     "accepting orders",
+	duration: 4032,
+}, {
+	name:     "market closed",
+	duration: 8064,
+	expectedError: "market for channel lease duration 8064 blocks is " +
+		"in state MARKET_CLOSED, valid durations are: [2016 4032]",
+}, {
+	name:     "no market",
+	duration: 12096,
+	expectedError: "invalid channel lease duration 12096 blocks, valid " +
+		"durations are: [2016 4032]",
+}, {
+	name:     "unknown bucket",
+	duration: 144,
+	expectedError: "invalid channel lease duration 144 blocks, valid " +
+		"durations are: [2016 4032]",
+}}
+
+// TestValidateLeaseDuration makes sure only lease durations of buckets the
+// auctioneer is accepting orders for are considered valid.
+func TestValidateLeaseDuration(t *testing.T) {
+	terms := &AuctioneerTerms{
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			4032:  auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS,
+			2016:  auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			8064:  auctioneerrpc.DurationBucketState_MARKET_CLOSED,
+			12096: auctioneerrpc.DurationBucketState_NO_MARKET,
+		},
+	}
+
+	for _, tc := range validateLeaseDurationTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := terms.ValidateLeaseDuration(tc.duration)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// tracerName is the name of the tracer all spans of the trader daemon
+	// are created with.
+	tracerName = "github.com/lightninglabs/pool"
+
+	// tracingServiceName is the service name the spans are reported
+	// under.
+	tracingServiceName = "poold"
+)
+
+// TracingConfig holds the options of the optional OpenTelemetry tracing.
+type TracingConfig struct {
+	Enable       bool   `long:"enable" description:"Export OpenTelemetry tracing spans for order submission, batch execution, account funding and all calls to the auction server."`
+	OTLPEndpoint string `long:"otlpendpoint" description:"The host:port of the OTLP gRPC collector the spans are exported to."`
+	Insecure     bool   `long:"insecure" description:"Connect to the OTLP collector without TLS."`
+}
+
+// setupTracing installs a global tracer provider that exports all spans to
+// the configured OTLP collector. The returned function flushes all pending
+// spans and shuts the exporter down. If tracing is disabled, nothing is
+// installed and the global no-op tracer provider of OpenTelemetry is used.
+func setupTracing(ctx context.Context, cfg *TracingConfig) (func() error,
+	error) {
+
+	if cfg == nil || !cfg.Enable {
+		return func() error { return nil }, nil
+	}
+
+	driverOpts := []otlpgrpc.Option{
+		otlpgrpc.WithEndpoint(cfg.OTLPEndpoint),
+	}
+	if cfg.Insecure {
+		driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(
+		ctx, otlpgrpc.NewDriver(driverOpts...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OTLP exporter: %v",
+			err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.ServiceNameKey.String(tracingServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	log.Infof("Exporting tracing spans to %v", cfg.OTLPEndpoint)
+
+	return func() error {
+		return provider.Shutdown(context.Background())
+	}, nil
+}
+
+// tracingDialOpts returns the dial options that create a span for each call
+// to the auction server and propagate the span context to it.
+func tracingDialOpts() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(
+			otelgrpc.UnaryClientInterceptor(),
+		),
+		grpc.WithChainStreamInterceptor(
+			otelgrpc.StreamClientInterceptor(),
+		),
+	}
+}
+
+// startSpan starts a new span with the given name as a child of the span in
+// the given context, if there is one.
+func startSpan(ctx context.Context, name string,
+	attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+
+	return otel.Tracer(tracerName).Start(
+		ctx, name, trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan marks the span as failed if an error is given and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,112 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestTracingDisabled makes sure no tracer provider is installed and no spans
+// are recorded if tracing isn't enabled.
+func TestTracingDisabled(t *testing.T) {
+	stopTracing, err := setupTracing(
+		context.Background(), &TracingConfig{},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, stopTracing())
+	}()
+
+	_, span := startSpan(context.Background(), "pool.SubmitOrder")
+	require.False(t, span.IsRecording())
+	endSpan(span, errors.New("not recorded"))
+}
+
+// TestSubmitOrderTracing makes sure submitting an order creates a span and that
+// the calls to the auction server made while submitting it are traced as its
+// children.
+func TestSubmitOrderTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+	))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	// The auction server is replaced by a test server that the client
+	// connects to with the same dial options the auctioneer connection
+	// uses when tracing is enabled.
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	poolrpc.RegisterTraderServer(server, &msgSizeTestServer{})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	dialOpts := append(
+		tracingDialOpts(), grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context,
+			string) (net.Conn, error) {
+
+			return listener.Dial()
+		}),
+	)
+	conn, err := grpc.Dial("bufnet", dialOpts...)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := poolrpc.NewTraderClient(conn)
+
+	// We let the preparation of the order fail after calling the server,
+	// so the order is never actually submitted.
+	errPrepare := errors.New("unable to prepare order")
+	prepareOrder := func(ctx context.Context, _ order.Order,
+		_ *account.Account, _ *terms.AuctioneerTerms) (
+		*order.ServerOrderParams, error) {
+
+		_, err := client.DecodeSidecarTicket(
+			ctx, &poolrpc.SidecarTicket{},
+		)
+		require.NoError(t, err)
+
+		return nil, errPrepare
+	}
+
+	o := &order.Bid{Kit: *order.NewKit(order.Nonce{1, 2, 3})}
+	err = prepareAndSubmitOrder(
+		context.Background(), o, &terms.AuctioneerTerms{},
+		&account.Account{}, nil, prepareOrder,
+	)
+	require.ErrorIs(t, err, errPrepare)
+
+	spans := make(map[string]*sdktrace.SpanSnapshot)
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+
+	submitSpan, ok := spans["pool.SubmitOrder"]
+	require.True(t, ok)
+	require.Equal(t, codes.Error, submitSpan.StatusCode)
+	require.Equal(t, errPrepare.Error(), submitSpan.StatusMessage)
+
+	rpcSpan, ok := spans["poolrpc.Trader/DecodeSidecarTicket"]
+	require.True(t, ok)
+	require.Equal(t, submitSpan.SpanContext.SpanID(), rpcSpan.Parent.SpanID())
+	require.Equal(
+		t, submitSpan.SpanContext.TraceID(),
+		rpcSpan.SpanContext.TraceID(),
+	)
+}